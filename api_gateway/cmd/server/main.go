@@ -9,6 +9,7 @@ import (
 	"common_library/logging"
 	"context"
 	filepb "fileservice/pkg/api"
+	"fileservice/pkg/localstorage"
 	"fmt"
 	"github.com/go-chi/chi/v5"
 	"github.com/redis/go-redis/v9"
@@ -58,9 +59,14 @@ func main() {
 	userClient := userpb.NewUserServiceClient(userGrpcClient)
 	userHandler := handler.NewUserHandler(userClient, redisCache)
 	authHandler := handler.NewSignUpHandler(userClient)
+	sessionHandler := handler.NewSessionHandler(userClient)
+	identityHandler := handler.NewIdentityHandler(userClient)
 
 	fileClient := filepb.NewFileServiceClient(fileGrpcClient)
 	fileHandler := handler.NewFileHandler(fileClient, cfg.MinioURL)
+	if cfg.StorageBackend == "local" {
+		fileHandler.WithLocalStorage(localstorage.New(cfg.LocalStorageDir, cfg.StorageSigningKey))
+	}
 
 	homeworkClient := homeworkpb.NewHomeworkServiceClient(homeworkGrpcClient)
 	homeworkHandler := handler.NewHomeworkHandler(homeworkClient)
@@ -71,16 +77,27 @@ func main() {
 	scheduleClient := schedulepb.NewScheduleServiceClient(scheduleGrpcClient)
 	scheduleHandler := handler.NewScheduleHandler(scheduleClient)
 
-	authMiddleware := middleware.NewAuthMiddleware(userClient)
+	adminClient := userpb.NewAdminServiceClient(userGrpcClient)
+	adminHandler := handler.NewAdminHandler(adminClient, scheduleClient, paymentClient)
+
+	tokenVerifier := middleware.NewTokenVerifier(userClient, redisCache, cfg.AuthIssuer, cfg.JWKSRefreshInterval, cfg.SessionCheckTTL)
+	authMiddleware := middleware.NewAuthMiddleware(userClient, tokenVerifier)
+	adminMiddleware := middleware.NewAdminMiddleware()
 	r := chi.NewRouter()
 	r.Use(middleware.NewLoggingMiddleware(logger))
+	r.Use(middleware.NewStripUserHeadersMiddleware())
 	r.Route("/users", func(r chi.Router) {
 		authHandler.RegisterRoutes(r)
 		userHandler.RegisterRoutes(r, authMiddleware)
 	})
 
+	r.Route("/auth", func(r chi.Router) {
+		sessionHandler.RegisterRoutes(r)
+		identityHandler.RegisterRoutes(r, authMiddleware)
+	})
+
 	r.Route("/files", func(r chi.Router) {
-		fileHandler.RegisterRoutes(r)
+		fileHandler.RegisterRoutes(r, authMiddleware)
 	})
 
 	r.Route("/schedule", func(r chi.Router) {
@@ -95,6 +112,10 @@ func main() {
 		homeworkHandler.RegisterRoutes(r, authMiddleware)
 	})
 
+	r.Route("/admin", func(r chi.Router) {
+		adminHandler.RegisterRoutes(r, authMiddleware, adminMiddleware)
+	})
+
 	port := fmt.Sprintf(":%d", cfg.HTTPPort)
 	logger.Info(ctx, "Starting server", zap.String("port", port))
 
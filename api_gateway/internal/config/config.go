@@ -4,6 +4,7 @@ import (
 	"errors"
 	"github.com/ilyakaznacheev/cleanenv"
 	"os"
+	"time"
 )
 
 type Config struct {
@@ -14,7 +15,16 @@ type Config struct {
 	HomeworkServiceURL string `env:"HOMEWORK_SERVICE_URL"`
 	PaymentServiceURL  string `env:"PAYMENT_SERVICE_URL"`
 	MinioURL           string `env:"MINIO_URL"`
+	StorageBackend     string `env:"STORAGE_BACKEND" env-default:"s3"`
+	LocalStorageDir    string `env:"LOCAL_STORAGE_DIR" env-default:"./data/files"`
+	StorageSigningKey  string `env:"STORAGE_SIGNING_KEY"`
 	RedisURL           string `env:"REDIS_URL"`
+	// JWKSRefreshInterval is how often signing keys are refetched from user service
+	JWKSRefreshInterval time.Duration `env:"JWKS_REFRESH_INTERVAL" env-default:"10m"`
+	// SessionCheckTTL is how long a session revocation check is cached
+	SessionCheckTTL time.Duration `env:"SESSION_CHECK_TTL" env-default:"30s"`
+	// AuthIssuer must match AUTH_ISSUER of user service
+	AuthIssuer string `env:"AUTH_ISSUER" env-default:"studyflow"`
 }
 
 func New() (*Config, error) {
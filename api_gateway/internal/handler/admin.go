@@ -0,0 +1,181 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-chi/chi/v5"
+	"net/http"
+	paymentpb "paymentservice/pkg/api"
+	schedulepb "schedule_service/pkg/api"
+	"strconv"
+	userpb "userservice/pkg/api"
+)
+
+type AdminHandler struct {
+	c        userpb.AdminServiceClient
+	schedule schedulepb.ScheduleServiceClient
+	payment  paymentpb.PaymentServiceClient
+}
+
+func NewAdminHandler(c userpb.AdminServiceClient, schedule schedulepb.ScheduleServiceClient, payment paymentpb.PaymentServiceClient) *AdminHandler {
+	return &AdminHandler{c: c, schedule: schedule, payment: payment}
+}
+
+func (h *AdminHandler) RegisterRoutes(r chi.Router, authMiddleware func(http.Handler) http.Handler, adminMiddleware func(http.Handler) http.Handler) {
+	r.With(authMiddleware, adminMiddleware).Group(func(r chi.Router) {
+		r.Get("/users", h.SearchUsers)
+		r.Post("/users/{id}/suspend", h.SuspendUser)
+		r.Post("/users/{id}/reactivate", h.ReactivateUser)
+		r.Get("/users/{id}/tutor-students", h.ListUserTutorStudents)
+		r.Post("/lessons/{id}/cancel", h.CancelLesson)
+		r.Get("/receipts", h.ListReceipts)
+		r.Get("/audit-events", h.ListAuditEvents)
+	})
+}
+
+func parseLimitOffset(r *http.Request) (int32, int32, error) {
+	var limit, offset int32
+	q := r.URL.Query()
+	if v := q.Get("limit"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid limit: %w", err)
+		}
+		limit = int32(n)
+	}
+	if v := q.Get("offset"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid offset: %w", err)
+		}
+		offset = int32(n)
+	}
+	return limit, offset, nil
+}
+
+func parseSearchUsers(ctx context.Context, r *http.Request, req *userpb.SearchUsersRequest) error {
+	q := r.URL.Query()
+	req.Query = q.Get("query")
+	if role := q.Get("role"); role != "" {
+		req.Role = &role
+	}
+	if status := q.Get("status"); status != "" {
+		req.Status = &status
+	}
+	limit, offset, err := parseLimitOffset(r)
+	if err != nil {
+		return err
+	}
+	req.Limit = limit
+	req.Offset = offset
+	return nil
+}
+
+func parseSuspendUser(ctx context.Context, r *http.Request, req *userpb.SuspendUserRequest) error {
+	id, err := parseIDParam(r, "id")
+	if err != nil {
+		return err
+	}
+	req.UserId = id
+	return nil
+}
+
+func parseReactivateUser(ctx context.Context, r *http.Request, req *userpb.ReactivateUserRequest) error {
+	id, err := parseIDParam(r, "id")
+	if err != nil {
+		return err
+	}
+	req.UserId = id
+	return nil
+}
+
+func parseListUserTutorStudents(ctx context.Context, r *http.Request, req *userpb.ListUserTutorStudentsRequest) error {
+	id, err := parseIDParam(r, "id")
+	if err != nil {
+		return err
+	}
+	req.UserId = id
+	return nil
+}
+
+func parseListAuditEvents(ctx context.Context, r *http.Request, req *userpb.ListAuditEventsRequest) error {
+	q := r.URL.Query()
+	if adminID := q.Get("admin_id"); adminID != "" {
+		req.AdminId = &adminID
+	}
+	if targetID := q.Get("target_id"); targetID != "" {
+		req.TargetId = &targetID
+	}
+	limit, offset, err := parseLimitOffset(r)
+	if err != nil {
+		return err
+	}
+	req.Limit = limit
+	req.Offset = offset
+	return nil
+}
+
+func (h *AdminHandler) SearchUsers(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[userpb.SearchUsersRequest, userpb.SearchUsersResponse](h.c.SearchUsers, parseSearchUsers, false)
+	if err != nil {
+		panic(err)
+	}
+
+	handler(w, r)
+}
+
+func (h *AdminHandler) SuspendUser(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[userpb.SuspendUserRequest, userpb.User](h.c.SuspendUser, parseSuspendUser, true)
+	if err != nil {
+		panic(err)
+	}
+
+	handler(w, r)
+}
+
+func (h *AdminHandler) ReactivateUser(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[userpb.ReactivateUserRequest, userpb.User](h.c.ReactivateUser, parseReactivateUser, false)
+	if err != nil {
+		panic(err)
+	}
+
+	handler(w, r)
+}
+
+func (h *AdminHandler) ListUserTutorStudents(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[userpb.ListUserTutorStudentsRequest, userpb.ListTutorStudentsResponse](h.c.ListUserTutorStudents, parseListUserTutorStudents, false)
+	if err != nil {
+		panic(err)
+	}
+
+	handler(w, r)
+}
+
+func (h *AdminHandler) ListAuditEvents(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[userpb.ListAuditEventsRequest, userpb.ListAuditEventsResponse](h.c.ListAuditEvents, parseListAuditEvents, false)
+	if err != nil {
+		panic(err)
+	}
+
+	handler(w, r)
+}
+
+// CancelLesson cancels any lesson, schedule service records it in the audit log.
+func (h *AdminHandler) CancelLesson(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[schedulepb.CancelLessonRequest, schedulepb.Lesson](h.schedule.CancelLesson, parseCancelLesson, true)
+	if err != nil {
+		panic(err)
+	}
+
+	handler(w, r)
+}
+
+// ListReceipts lists receipts of all pairs, payment service records it in the audit log.
+func (h *AdminHandler) ListReceipts(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[paymentpb.ListReceiptsRequest, paymentpb.ListReceiptsResponse](h.payment.ListReceipts, parseListReceipts, false)
+	if err != nil {
+		panic(err)
+	}
+
+	handler(w, r)
+}
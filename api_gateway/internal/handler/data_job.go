@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"common_library/logging"
+	"context"
+	"fmt"
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+	"net/http"
+	userpb "userservice/pkg/api"
+)
+
+func (h *UserHandler) DeleteAccount(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[userpb.Empty, userpb.DataJob](h.c.DeleteAccount, nil, false)
+	if err != nil {
+		panic(err)
+	}
+
+	if id := r.Header.Get("X-User-Id"); id != "" {
+		for _, key := range []string{"user:" + id, "user-public:" + id, "tutor-profile:" + id} {
+			h.cache.Delete(r.Context(), key)
+		}
+	}
+
+	handler(w, r)
+}
+
+func (h *UserHandler) ExportMyData(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[userpb.Empty, userpb.DataJob](h.c.ExportMyData, nil, false)
+	if err != nil {
+		panic(err)
+	}
+
+	handler(w, r)
+}
+
+func (h *UserHandler) GetDataJob(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[userpb.GetDataJobRequest, userpb.DataJob](h.c.GetDataJob, getDataJobParsePath, false)
+	if err != nil {
+		panic(err)
+	}
+
+	handler(w, r)
+}
+
+func getDataJobParsePath(ctx context.Context, httpReq *http.Request, grpcReq *userpb.GetDataJobRequest) error {
+	id := chi.URLParam(httpReq, "id")
+	if id == "" {
+		return fmt.Errorf("%w: %s", BadRequestError, "id is required")
+	}
+	grpcReq.Id = id
+	if logger, ok := logging.GetFromContext(ctx); ok {
+		logger.Debug(ctx, "data job id added to request", zap.Any("req", grpcReq))
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"common_library/logging"
 	"context"
 	filepb "fileservice/pkg/api"
+	"fileservice/pkg/localstorage"
 	"fmt"
 	"github.com/go-chi/chi/v5"
 	"go.uber.org/zap"
@@ -14,19 +15,37 @@ import (
 )
 
 type FileHandler struct {
-	c        filepb.FileServiceClient
-	minioUrl string
+	c            filepb.FileServiceClient
+	minioUrl     string
+	localStorage *localstorage.Storage
 }
 
 func NewFileHandler(c filepb.FileServiceClient, minioUrl string) *FileHandler {
 	return &FileHandler{c: c, minioUrl: minioUrl}
 }
 
-func (h *FileHandler) RegisterRoutes(r chi.Router) {
-	r.Post("/init-upload", h.InitUpload)
-	r.Get("/{id}/meta", h.GetFileMeta)
+// WithLocalStorage serves signed URLs of the file_service local storage backend from the shared directory.
+func (h *FileHandler) WithLocalStorage(storage *localstorage.Storage) *FileHandler {
+	h.localStorage = storage
+	return h
+}
+
+func (h *FileHandler) RegisterRoutes(r chi.Router, authMiddleware func(http.Handler) http.Handler) {
+	r.With(authMiddleware).Group(func(r chi.Router) {
+		r.Post("/init-upload", h.InitUpload)
+		r.Post("/multipart/init", h.InitMultipartUpload)
+		r.Post("/{id}/multipart/parts", h.GenerateUploadPartURLs)
+		r.Post("/{id}/multipart/complete", h.CompleteMultipartUpload)
+		r.Delete("/{id}/multipart", h.AbortMultipartUpload)
+		r.Get("/{id}/meta", h.GetFileMeta)
+		r.Get("/{id}/download-url", h.GenerateDownloadURL)
+	})
+	// storage routes are authorized by the signature of the URL
 	r.Put("/upload/*", h.proxyToMinio("PUT", "/files/upload"))
 	r.Get("/download/*", h.proxyToMinio("GET", "/files/download"))
+	if h.localStorage != nil {
+		r.Handle("/local/*", h.localStorage.Handler("/files/local"))
+	}
 
 }
 
@@ -39,6 +58,51 @@ func (h *FileHandler) InitUpload(w http.ResponseWriter, r *http.Request) {
 	handler(w, r)
 }
 
+func (h *FileHandler) InitMultipartUpload(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[filepb.InitMultipartUploadRequest, filepb.InitMultipartUploadResponse](h.c.InitMultipartUpload, nil, true)
+	if err != nil {
+		panic(err)
+	}
+
+	handler(w, r)
+}
+
+func (h *FileHandler) GenerateUploadPartURLs(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[filepb.GenerateUploadPartURLsRequest, filepb.UploadPartURLs](h.c.GenerateUploadPartURLs, generateUploadPartURLsParsePath, true)
+	if err != nil {
+		panic(err)
+	}
+
+	handler(w, r)
+}
+
+func (h *FileHandler) CompleteMultipartUpload(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[filepb.MultipartUploadRequest, filepb.File](h.c.CompleteMultipartUpload, multipartUploadParsePath, false)
+	if err != nil {
+		panic(err)
+	}
+
+	handler(w, r)
+}
+
+func (h *FileHandler) AbortMultipartUpload(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[filepb.MultipartUploadRequest, filepb.Empty](h.c.AbortMultipartUpload, multipartUploadParsePath, false)
+	if err != nil {
+		panic(err)
+	}
+
+	handler(w, r)
+}
+
+func (h *FileHandler) GenerateDownloadURL(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[filepb.GenerateDownloadURLRequest, filepb.DownloadURL](h.c.GenerateDownloadURL, generateDownloadURLParsePath, false)
+	if err != nil {
+		panic(err)
+	}
+
+	handler(w, r)
+}
+
 func (h *FileHandler) GetFileMeta(w http.ResponseWriter, r *http.Request) {
 	handler, err := Handle[filepb.GetFileMetaRequest, filepb.File](h.c.GetFileMeta, getFileMetaParsePath, false)
 	if err != nil {
@@ -61,6 +125,40 @@ func getFileMetaParsePath(ctx context.Context, httpReq *http.Request, grpcReq *f
 	return nil
 }
 
+func generateDownloadURLParsePath(ctx context.Context, httpReq *http.Request, grpcReq *filepb.GenerateDownloadURLRequest) error {
+	id := chi.URLParam(httpReq, "id")
+	if id == "" {
+		return fmt.Errorf("%w: %s", BadRequestError, "fileId is required")
+	}
+	grpcReq.FileId = id
+
+	if variant := httpReq.URL.Query().Get("variant"); variant != "" {
+		grpcReq.Variant = &variant
+	}
+
+	return nil
+}
+
+func generateUploadPartURLsParsePath(ctx context.Context, httpReq *http.Request, grpcReq *filepb.GenerateUploadPartURLsRequest) error {
+	id := chi.URLParam(httpReq, "id")
+	if id == "" {
+		return fmt.Errorf("%w: %s", BadRequestError, "fileId is required")
+	}
+	grpcReq.FileId = id
+
+	return nil
+}
+
+func multipartUploadParsePath(ctx context.Context, httpReq *http.Request, grpcReq *filepb.MultipartUploadRequest) error {
+	id := chi.URLParam(httpReq, "id")
+	if id == "" {
+		return fmt.Errorf("%w: %s", BadRequestError, "fileId is required")
+	}
+	grpcReq.FileId = id
+
+	return nil
+}
+
 func (h *FileHandler) proxyToMinio(method string, path string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if logger, ok := logging.GetFromContext(r.Context()); ok {
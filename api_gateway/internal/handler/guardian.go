@@ -0,0 +1,139 @@
+package handler
+
+import (
+	"common_library/logging"
+	"context"
+	"fmt"
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+	"net/http"
+	userpb "userservice/pkg/api"
+)
+
+func (h *UserHandler) ListGuardianStudentsByGuardian(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[userpb.ListGuardianStudentsRequest, userpb.ListGuardianStudentsResponse](h.c.ListGuardianStudents, listGuardianStudentsByGuardianParsePath, false)
+	if err != nil {
+		panic(err)
+	}
+
+	handler(w, r)
+}
+
+func (h *UserHandler) ListGuardianStudentsByStudent(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[userpb.ListGuardiansForStudentRequest, userpb.ListGuardianStudentsResponse](h.c.ListGuardiansForStudent, listGuardianStudentsByStudentParsePath, false)
+	if err != nil {
+		panic(err)
+	}
+
+	handler(w, r)
+}
+
+func (h *UserHandler) GetGuardianStudent(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[userpb.GetGuardianStudentRequest, userpb.GuardianStudent](h.c.GetGuardianStudent, getGuardianStudentParsePath, false)
+	if err != nil {
+		panic(err)
+	}
+
+	handler(w, r)
+}
+
+func (h *UserHandler) DeleteGuardianStudent(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[userpb.DeleteGuardianStudentRequest, userpb.Empty](h.c.DeleteGuardianStudent, deleteGuardianStudentParsePath, false)
+	if err != nil {
+		panic(err)
+	}
+
+	handler(w, r)
+}
+
+func (h *UserHandler) CreateGuardianStudent(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[userpb.CreateGuardianStudentRequest, userpb.GuardianStudent](h.c.CreateGuardianStudent, nil, true)
+	if err != nil {
+		panic(err)
+	}
+
+	handler(w, r)
+}
+
+func (h *UserHandler) AcceptGuardianRequest(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[userpb.AcceptGuardianRequestRequest, userpb.Empty](h.c.AcceptGuardianRequest, acceptGuardianRequestParsePath, false)
+	if err != nil {
+		panic(err)
+	}
+
+	handler(w, r)
+}
+
+func listGuardianStudentsByGuardianParsePath(ctx context.Context, httpReq *http.Request, grpcReq *userpb.ListGuardianStudentsRequest) error {
+	userId := chi.URLParam(httpReq, "id")
+	if userId == "" {
+		return fmt.Errorf("%w: %s", BadRequestError, "userId is required")
+	}
+	grpcReq.GuardianId = userId
+	if logger, ok := logging.GetFromContext(ctx); ok {
+		logger.Debug(ctx, "user id added to request", zap.Any("req", grpcReq))
+	}
+	return nil
+}
+
+func listGuardianStudentsByStudentParsePath(ctx context.Context, httpReq *http.Request, grpcReq *userpb.ListGuardiansForStudentRequest) error {
+	userId := chi.URLParam(httpReq, "id")
+	if userId == "" {
+		return fmt.Errorf("%w: %s", BadRequestError, "userId is required")
+	}
+	grpcReq.StudentId = userId
+	if logger, ok := logging.GetFromContext(ctx); ok {
+		logger.Debug(ctx, "user id added to request", zap.Any("req", grpcReq))
+	}
+	return nil
+}
+
+func getGuardianStudentParsePath(ctx context.Context, httpReq *http.Request, grpcReq *userpb.GetGuardianStudentRequest) error {
+	guardianId := chi.URLParam(httpReq, "guardian_id")
+	if guardianId == "" {
+		return fmt.Errorf("%w: %s", BadRequestError, "guardianId is required")
+	}
+	grpcReq.GuardianId = guardianId
+
+	studentId := chi.URLParam(httpReq, "student_id")
+	if studentId == "" {
+		return fmt.Errorf("%w: %s", BadRequestError, "studentId is required")
+	}
+	grpcReq.StudentId = studentId
+
+	if logger, ok := logging.GetFromContext(ctx); ok {
+		logger.Debug(ctx, "guardian, student ids added to request", zap.Any("req", grpcReq))
+	}
+	return nil
+}
+
+func deleteGuardianStudentParsePath(ctx context.Context, httpReq *http.Request, grpcReq *userpb.DeleteGuardianStudentRequest) error {
+	guardianId := chi.URLParam(httpReq, "guardian_id")
+	if guardianId == "" {
+		return fmt.Errorf("%w: %s", BadRequestError, "guardianId is required")
+	}
+	grpcReq.GuardianId = guardianId
+
+	studentId := chi.URLParam(httpReq, "student_id")
+	if studentId == "" {
+		return fmt.Errorf("%w: %s", BadRequestError, "studentId is required")
+	}
+	grpcReq.StudentId = studentId
+
+	if logger, ok := logging.GetFromContext(ctx); ok {
+		logger.Debug(ctx, "guardian, student ids added to request", zap.Any("req", grpcReq))
+	}
+	return nil
+}
+
+func acceptGuardianRequestParsePath(ctx context.Context, httpReq *http.Request, grpcReq *userpb.AcceptGuardianRequestRequest) error {
+	guardianId := chi.URLParam(httpReq, "guardian_id")
+	if guardianId == "" {
+		return fmt.Errorf("%w: %s", BadRequestError, "guardianId is required")
+	}
+	grpcReq.GuardianId = guardianId
+	if logger, ok := logging.GetFromContext(ctx); ok {
+		logger.Debug(ctx, "guardian id added to request", zap.Any("req", grpcReq))
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-chi/chi/v5"
+	"net/http"
+	userpb "userservice/pkg/api"
+)
+
+type IdentityHandler struct {
+	c userpb.UserServiceClient
+}
+
+func NewIdentityHandler(c userpb.UserServiceClient) *IdentityHandler {
+	return &IdentityHandler{c: c}
+}
+
+// RegisterRoutes registers public sign in routes and sign in methods management of the current user.
+func (h *IdentityHandler) RegisterRoutes(r chi.Router, authMiddleware func(http.Handler) http.Handler) {
+	r.Post("/email/request", h.RequestEmailLogin)
+	r.Post("/email/verify", h.VerifyEmailLogin)
+	r.Post("/oidc/start", h.StartOidcLogin)
+	r.Post("/oidc/callback", h.CompleteOidcLogin)
+
+	r.With(authMiddleware).Group(func(r chi.Router) {
+		r.Get("/identities", h.ListMyIdentities)
+		r.Delete("/identities/{id}", h.DeleteIdentity)
+		r.Post("/identities/email", h.RequestEmailLink)
+		r.Post("/identities/oidc", h.StartOidcLink)
+	})
+}
+
+func (h *IdentityHandler) RequestEmailLogin(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[userpb.RequestEmailLoginRequest, userpb.Empty](h.c.RequestEmailLogin, nil, true)
+	if err != nil {
+		panic(err)
+	}
+
+	handler(w, r)
+}
+
+func (h *IdentityHandler) VerifyEmailLogin(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[userpb.VerifyEmailLoginRequest, userpb.SessionTokens](h.c.VerifyEmailLogin, nil, true)
+	if err != nil {
+		panic(err)
+	}
+
+	handler(w, r)
+}
+
+func (h *IdentityHandler) StartOidcLogin(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[userpb.StartOidcLoginRequest, userpb.OidcAuthorization](h.c.StartOidcLogin, nil, true)
+	if err != nil {
+		panic(err)
+	}
+
+	handler(w, r)
+}
+
+func (h *IdentityHandler) CompleteOidcLogin(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[userpb.CompleteOidcLoginRequest, userpb.SessionTokens](h.c.CompleteOidcLogin, nil, true)
+	if err != nil {
+		panic(err)
+	}
+
+	handler(w, r)
+}
+
+func (h *IdentityHandler) ListMyIdentities(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[userpb.Empty, userpb.ListIdentitiesResponse](h.c.ListMyIdentities, nil, false)
+	if err != nil {
+		panic(err)
+	}
+
+	handler(w, r)
+}
+
+func (h *IdentityHandler) DeleteIdentity(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[userpb.DeleteIdentityRequest, userpb.Empty](h.c.DeleteIdentity, deleteIdentityParsePath, false)
+	if err != nil {
+		panic(err)
+	}
+
+	handler(w, r)
+}
+
+func (h *IdentityHandler) RequestEmailLink(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[userpb.RequestEmailLinkRequest, userpb.Empty](h.c.RequestEmailLink, nil, true)
+	if err != nil {
+		panic(err)
+	}
+
+	handler(w, r)
+}
+
+func (h *IdentityHandler) StartOidcLink(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[userpb.Empty, userpb.OidcAuthorization](h.c.StartOidcLink, nil, false)
+	if err != nil {
+		panic(err)
+	}
+
+	handler(w, r)
+}
+
+func deleteIdentityParsePath(ctx context.Context, httpReq *http.Request, grpcReq *userpb.DeleteIdentityRequest) error {
+	id := chi.URLParam(httpReq, "id")
+	if id == "" {
+		return fmt.Errorf("%w: %s", BadRequestError, "id is required")
+	}
+	grpcReq.Id = id
+	return nil
+}
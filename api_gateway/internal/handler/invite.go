@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"common_library/logging"
+	"context"
+	"fmt"
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+	"net/http"
+	userpb "userservice/pkg/api"
+)
+
+func (h *UserHandler) RejectInvitation(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[userpb.RejectInvitationRequest, userpb.Empty](h.c.RejectInvitation, rejectInvitationParsePath, false)
+	if err != nil {
+		panic(err)
+	}
+
+	handler(w, r)
+}
+
+func (h *UserHandler) CreateInvite(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[userpb.CreateInviteRequest, userpb.Invite](h.c.CreateInvite, nil, true)
+	if err != nil {
+		panic(err)
+	}
+
+	handler(w, r)
+}
+
+func (h *UserHandler) ListInvites(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[userpb.ListInvitesRequest, userpb.ListInvitesResponse](h.c.ListInvites, listInvitesParsePath, false)
+	if err != nil {
+		panic(err)
+	}
+
+	handler(w, r)
+}
+
+func (h *UserHandler) RevokeInvite(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[userpb.RevokeInviteRequest, userpb.Invite](h.c.RevokeInvite, revokeInviteParsePath, false)
+	if err != nil {
+		panic(err)
+	}
+
+	handler(w, r)
+}
+
+func (h *UserHandler) RedeemInvite(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[userpb.RedeemInviteRequest, userpb.TutorStudent](h.c.RedeemInvite, nil, true)
+	if err != nil {
+		panic(err)
+	}
+
+	handler(w, r)
+}
+
+func rejectInvitationParsePath(ctx context.Context, httpReq *http.Request, grpcReq *userpb.RejectInvitationRequest) error {
+	tutorId := chi.URLParam(httpReq, "tutor_id")
+	if tutorId == "" {
+		return fmt.Errorf("%w: %s", BadRequestError, "tutorId is required")
+	}
+	grpcReq.TutorId = tutorId
+	if logger, ok := logging.GetFromContext(ctx); ok {
+		logger.Debug(ctx, "tutor id added to request", zap.Any("req", grpcReq))
+	}
+	return nil
+}
+
+func listInvitesParsePath(ctx context.Context, httpReq *http.Request, grpcReq *userpb.ListInvitesRequest) error {
+	tutorId := chi.URLParam(httpReq, "id")
+	if tutorId == "" {
+		return fmt.Errorf("%w: %s", BadRequestError, "tutorId is required")
+	}
+	grpcReq.TutorId = tutorId
+	if logger, ok := logging.GetFromContext(ctx); ok {
+		logger.Debug(ctx, "tutor id added to request", zap.Any("req", grpcReq))
+	}
+	return nil
+}
+
+func revokeInviteParsePath(ctx context.Context, httpReq *http.Request, grpcReq *userpb.RevokeInviteRequest) error {
+	id := chi.URLParam(httpReq, "id")
+	if id == "" {
+		return fmt.Errorf("%w: %s", BadRequestError, "id is required")
+	}
+	grpcReq.Id = id
+	if logger, ok := logging.GetFromContext(ctx); ok {
+		logger.Debug(ctx, "invite id added to request", zap.Any("req", grpcReq))
+	}
+	return nil
+}
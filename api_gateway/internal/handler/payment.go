@@ -2,7 +2,11 @@ package handler
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/go-chi/chi/v5"
 	paymentpb "paymentservice/pkg/api"
@@ -20,10 +24,29 @@ func (h *PaymentHandler) RegisterRoutes(r chi.Router, authMiddleware func(http.H
 	r.With(authMiddleware).Group(func(r chi.Router) {
 		r.Get("/info/{lesson_id}", h.GetPaymentInfo)
 		r.Post("/receipts", h.SubmitReceipt)
+		r.Get("/receipts", h.ListReceipts)
 		r.Get("/receipts/{id}", h.GetReceipt)
 		r.Post("/receipts/{id}/verify", h.VerifyReceipt)
+		r.Post("/receipts/{id}/approve", h.ApproveReceipt)
+		r.Post("/receipts/{id}/reject", h.RejectReceipt)
 		r.Get("/receipts/{id}/file-url", h.GetReceiptFile)
+		r.Get("/balance", h.GetBalance)
+		r.Get("/ledger", h.ListLedgerEntries)
+		r.Post("/packages", h.CreatePackage)
+		r.Get("/packages", h.ListPackages)
+		r.Post("/packages/{id}/purchase", h.PurchasePackage)
+		r.Post("/invoices", h.CreateInvoice)
+		r.Get("/invoices", h.ListInvoices)
+		r.Get("/invoices/{id}/file-url", h.GetInvoiceFile)
+		r.Get("/reports/earnings", h.GetEarningsReport)
+		r.Get("/reports/earnings/export", h.ExportEarningsReport)
+		r.Get("/reports/outstanding", h.GetOutstandingReport)
+		r.Post("/online", h.CreateOnlinePayment)
+		r.Post("/lessons/{lesson_id}/refund", h.RefundLesson)
+		r.Get("/refunds", h.ListRefunds)
 	})
+	// providers authenticate notifications by signature
+	r.Post("/webhooks/{provider}", h.HandleProviderWebhook)
 }
 
 func parseGetPaymentInfo(ctx context.Context, r *http.Request, req *paymentpb.GetPaymentInfoRequest) error {
@@ -53,6 +76,38 @@ func parseVerifyReceipt(ctx context.Context, r *http.Request, req *paymentpb.Ver
 	return nil
 }
 
+func parseApproveReceipt(ctx context.Context, r *http.Request, req *paymentpb.ApproveReceiptRequest) error {
+	id, err := parsePathParam(r, "id")
+	if err != nil {
+		return err
+	}
+	req.ReceiptId = id
+	return nil
+}
+
+func parseRejectReceipt(ctx context.Context, r *http.Request, req *paymentpb.RejectReceiptRequest) error {
+	id, err := parsePathParam(r, "id")
+	if err != nil {
+		return err
+	}
+	req.ReceiptId = id
+	return nil
+}
+
+func parseListReceipts(ctx context.Context, r *http.Request, req *paymentpb.ListReceiptsRequest) error {
+	q := r.URL.Query()
+	if tutorID := q.Get("tutor_id"); tutorID != "" {
+		req.TutorId = &tutorID
+	}
+	if studentID := q.Get("student_id"); studentID != "" {
+		req.StudentId = &studentID
+	}
+	if status := q.Get("status"); status != "" {
+		req.Status = &status
+	}
+	return nil
+}
+
 func parseGetReceiptFile(ctx context.Context, r *http.Request, req *paymentpb.GetReceiptFileRequest) error {
 	id, err := parsePathParam(r, "id")
 	if err != nil {
@@ -62,6 +117,84 @@ func parseGetReceiptFile(ctx context.Context, r *http.Request, req *paymentpb.Ge
 	return nil
 }
 
+func parseGetBalance(ctx context.Context, r *http.Request, req *paymentpb.GetBalanceRequest) error {
+	q := r.URL.Query()
+	req.TutorId = q.Get("tutor_id")
+	req.StudentId = q.Get("student_id")
+	return nil
+}
+
+func parseListLedgerEntries(ctx context.Context, r *http.Request, req *paymentpb.ListLedgerEntriesRequest) error {
+	q := r.URL.Query()
+	req.TutorId = q.Get("tutor_id")
+	req.StudentId = q.Get("student_id")
+	if limit := q.Get("limit"); limit != "" {
+		n, err := strconv.ParseInt(limit, 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid limit: %w", err)
+		}
+		l := int32(n)
+		req.Limit = &l
+	}
+	return nil
+}
+
+func parseListPackages(ctx context.Context, r *http.Request, req *paymentpb.ListPackagesRequest) error {
+	q := r.URL.Query()
+	req.TutorId = q.Get("tutor_id")
+	req.StudentId = q.Get("student_id")
+	return nil
+}
+
+func parsePurchasePackage(ctx context.Context, r *http.Request, req *paymentpb.PurchasePackageRequest) error {
+	id, err := parsePathParam(r, "id")
+	if err != nil {
+		return err
+	}
+	req.PackageId = id
+	return nil
+}
+
+func parseListInvoices(ctx context.Context, r *http.Request, req *paymentpb.ListInvoicesRequest) error {
+	q := r.URL.Query()
+	if tutorID := q.Get("tutor_id"); tutorID != "" {
+		req.TutorId = &tutorID
+	}
+	if studentID := q.Get("student_id"); studentID != "" {
+		req.StudentId = &studentID
+	}
+	return nil
+}
+
+func parseRefundLesson(ctx context.Context, r *http.Request, req *paymentpb.RefundLessonRequest) error {
+	id, err := parsePathParam(r, "lesson_id")
+	if err != nil {
+		return err
+	}
+	req.LessonId = id
+	return nil
+}
+
+func parseListRefunds(ctx context.Context, r *http.Request, req *paymentpb.ListRefundsRequest) error {
+	q := r.URL.Query()
+	if tutorID := q.Get("tutor_id"); tutorID != "" {
+		req.TutorId = &tutorID
+	}
+	if studentID := q.Get("student_id"); studentID != "" {
+		req.StudentId = &studentID
+	}
+	return nil
+}
+
+func parseGetInvoiceFile(ctx context.Context, r *http.Request, req *paymentpb.GetInvoiceFileRequest) error {
+	id, err := parsePathParam(r, "id")
+	if err != nil {
+		return err
+	}
+	req.InvoiceId = id
+	return nil
+}
+
 func (h *PaymentHandler) GetPaymentInfo(w http.ResponseWriter, r *http.Request) {
 	handler, err := Handle[paymentpb.GetPaymentInfoRequest, paymentpb.PaymentInfo](h.c.GetPaymentInfo, parseGetPaymentInfo, false)
 	if err != nil {
@@ -94,6 +227,30 @@ func (h *PaymentHandler) VerifyReceipt(w http.ResponseWriter, r *http.Request) {
 	handler(w, r)
 }
 
+func (h *PaymentHandler) ApproveReceipt(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[paymentpb.ApproveReceiptRequest, paymentpb.Receipt](h.c.ApproveReceipt, parseApproveReceipt, false)
+	if err != nil {
+		panic(err)
+	}
+	handler(w, r)
+}
+
+func (h *PaymentHandler) RejectReceipt(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[paymentpb.RejectReceiptRequest, paymentpb.Receipt](h.c.RejectReceipt, parseRejectReceipt, true)
+	if err != nil {
+		panic(err)
+	}
+	handler(w, r)
+}
+
+func (h *PaymentHandler) ListReceipts(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[paymentpb.ListReceiptsRequest, paymentpb.ListReceiptsResponse](h.c.ListReceipts, parseListReceipts, false)
+	if err != nil {
+		panic(err)
+	}
+	handler(w, r)
+}
+
 func (h *PaymentHandler) GetReceiptFile(w http.ResponseWriter, r *http.Request) {
 	handler, err := Handle[paymentpb.GetReceiptFileRequest, paymentpb.ReceiptFileURL](h.c.GetReceiptFile, parseGetReceiptFile, false)
 	if err != nil {
@@ -101,3 +258,119 @@ func (h *PaymentHandler) GetReceiptFile(w http.ResponseWriter, r *http.Request)
 	}
 	handler(w, r)
 }
+
+func (h *PaymentHandler) GetBalance(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[paymentpb.GetBalanceRequest, paymentpb.Balance](h.c.GetBalance, parseGetBalance, false)
+	if err != nil {
+		panic(err)
+	}
+	handler(w, r)
+}
+
+func (h *PaymentHandler) ListLedgerEntries(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[paymentpb.ListLedgerEntriesRequest, paymentpb.ListLedgerEntriesResponse](h.c.ListLedgerEntries, parseListLedgerEntries, false)
+	if err != nil {
+		panic(err)
+	}
+	handler(w, r)
+}
+
+func (h *PaymentHandler) CreatePackage(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[paymentpb.CreatePackageRequest, paymentpb.LessonPackage](h.c.CreatePackage, nil, true)
+	if err != nil {
+		panic(err)
+	}
+	handler(w, r)
+}
+
+func (h *PaymentHandler) ListPackages(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[paymentpb.ListPackagesRequest, paymentpb.ListPackagesResponse](h.c.ListPackages, parseListPackages, false)
+	if err != nil {
+		panic(err)
+	}
+	handler(w, r)
+}
+
+func (h *PaymentHandler) PurchasePackage(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[paymentpb.PurchasePackageRequest, paymentpb.PackagePurchase](h.c.PurchasePackage, parsePurchasePackage, false)
+	if err != nil {
+		panic(err)
+	}
+	handler(w, r)
+}
+
+func (h *PaymentHandler) CreateInvoice(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[paymentpb.CreateInvoiceRequest, paymentpb.Invoice](h.c.CreateInvoice, nil, true)
+	if err != nil {
+		panic(err)
+	}
+	handler(w, r)
+}
+
+func (h *PaymentHandler) ListInvoices(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[paymentpb.ListInvoicesRequest, paymentpb.ListInvoicesResponse](h.c.ListInvoices, parseListInvoices, false)
+	if err != nil {
+		panic(err)
+	}
+	handler(w, r)
+}
+
+func (h *PaymentHandler) GetInvoiceFile(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[paymentpb.GetInvoiceFileRequest, paymentpb.InvoiceFileURL](h.c.GetInvoiceFile, parseGetInvoiceFile, false)
+	if err != nil {
+		panic(err)
+	}
+	handler(w, r)
+}
+
+func (h *PaymentHandler) CreateOnlinePayment(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[paymentpb.CreateOnlinePaymentRequest, paymentpb.OnlinePayment](h.c.CreateOnlinePayment, nil, true)
+	if err != nil {
+		panic(err)
+	}
+	handler(w, r)
+}
+
+func (h *PaymentHandler) RefundLesson(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[paymentpb.RefundLessonRequest, paymentpb.Refund](h.c.RefundLesson, parseRefundLesson, true)
+	if err != nil {
+		panic(err)
+	}
+	handler(w, r)
+}
+
+func (h *PaymentHandler) ListRefunds(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[paymentpb.ListRefundsRequest, paymentpb.ListRefundsResponse](h.c.ListRefunds, parseListRefunds, false)
+	if err != nil {
+		panic(err)
+	}
+	handler(w, r)
+}
+
+// maxWebhookBodySize limits notifications of payment providers
+const maxWebhookBodySize = 1 << 20
+
+// HandleProviderWebhook passes the raw notification to payment_service, which verifies its signature.
+func (h *PaymentHandler) HandleProviderWebhook(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookBodySize))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	headers := make(map[string]string, len(r.Header))
+	for name := range r.Header {
+		headers[strings.ToLower(name)] = r.Header.Get(name)
+	}
+
+	_, err = h.c.HandleProviderWebhook(outgoingContext(r), &paymentpb.ProviderWebhookRequest{
+		Provider: chi.URLParam(r, "provider"),
+		Body:     body,
+		Headers:  headers,
+	})
+	if err != nil {
+		w.WriteHeader(mapErr(err))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
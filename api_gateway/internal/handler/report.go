@@ -0,0 +1,231 @@
+package handler
+
+import (
+	"common_library/logging"
+	"common_library/money"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xuri/excelize/v2"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	paymentpb "paymentservice/pkg/api"
+)
+
+const (
+	exportFormatCSV  = "csv"
+	exportFormatXLSX = "xlsx"
+
+	groupByStudent = "student"
+	groupByMonth   = "month"
+
+	xlsxContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+)
+
+// months of reports start in Moscow time
+var reportLocation = time.FixedZone("MSK", 3*60*60)
+
+func parseGetEarningsReport(ctx context.Context, r *http.Request, req *paymentpb.GetEarningsReportRequest) error {
+	q := r.URL.Query()
+	periodStart, err := time.Parse(time.RFC3339, q.Get("period_start"))
+	if err != nil {
+		return fmt.Errorf("invalid period_start: %w", err)
+	}
+	periodEnd, err := time.Parse(time.RFC3339, q.Get("period_end"))
+	if err != nil {
+		return fmt.Errorf("invalid period_end: %w", err)
+	}
+	req.PeriodStart = timestamppb.New(periodStart)
+	req.PeriodEnd = timestamppb.New(periodEnd)
+	return nil
+}
+
+func (h *PaymentHandler) GetEarningsReport(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[paymentpb.GetEarningsReportRequest, paymentpb.EarningsReport](h.c.GetEarningsReport, parseGetEarningsReport, false)
+	if err != nil {
+		panic(err)
+	}
+	handler(w, r)
+}
+
+func (h *PaymentHandler) GetOutstandingReport(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[paymentpb.GetOutstandingReportRequest, paymentpb.OutstandingReport](h.c.GetOutstandingReport, nil, false)
+	if err != nil {
+		panic(err)
+	}
+	handler(w, r)
+}
+
+// ExportEarningsReport returns the earnings report as a CSV or XLSX table by student or by month.
+func (h *PaymentHandler) ExportEarningsReport(w http.ResponseWriter, r *http.Request) {
+	ctx := outgoingContext(r)
+	logger, hasLogger := logging.GetFromContext(r.Context())
+
+	q := r.URL.Query()
+	format := q.Get("format")
+	if format == "" {
+		format = exportFormatCSV
+	}
+	groupBy := q.Get("group_by")
+	if groupBy == "" {
+		groupBy = groupByStudent
+	}
+	if (format != exportFormatCSV && format != exportFormatXLSX) || (groupBy != groupByStudent && groupBy != groupByMonth) {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	req := &paymentpb.GetEarningsReportRequest{}
+	if err := parseGetEarningsReport(ctx, r, req); err != nil {
+		if hasLogger {
+			logger.Error(ctx, "Failed to parse request query", zap.Error(err))
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	report, err := h.c.GetEarningsReport(ctx, req)
+	if err != nil {
+		if hasLogger {
+			logger.Error(ctx, "grpc request failed", zap.Error(err))
+		}
+		w.WriteHeader(mapErr(err))
+		return
+	}
+
+	rows := earningsRows(report, groupBy)
+	filename := fmt.Sprintf("earnings-%s-%s.%s",
+		report.PeriodStart.AsTime().In(reportLocation).Format(time.DateOnly),
+		report.PeriodEnd.AsTime().In(reportLocation).Format(time.DateOnly),
+		format,
+	)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+
+	if format == exportFormatXLSX {
+		err = writeXLSX(w, rows)
+	} else {
+		err = writeCSV(w, rows)
+	}
+	if err != nil && hasLogger {
+		logger.Error(ctx, "Failed to write report", zap.Error(err))
+	}
+}
+
+// earningsRows returns the table of the report with a header and a total row. Cells are strings or numbers.
+func earningsRows(report *paymentpb.EarningsReport, groupBy string) [][]any {
+	first := "Ученик"
+	if groupBy == groupByMonth {
+		first = "Месяц"
+	}
+	rows := [][]any{{first, "Валюта", "Занятий", "Без цены", "Начислено", "Оплачено", "Долг", "Дней до оплаты"}}
+
+	if groupBy == groupByMonth {
+		for _, month := range report.Months {
+			rows = append(rows, summaryRows(month.MonthStart.AsTime().In(reportLocation).Format("01.2006"), month.Summary)...)
+		}
+	} else {
+		for _, student := range report.Students {
+			rows = append(rows, summaryRows(student.StudentName, student.Summary)...)
+		}
+	}
+
+	return append(rows, summaryRows("Итого", report.Total)...)
+}
+
+// summaryRows returns a row per currency of the summary, amounts in different currencies are never summed up.
+// Lessons without a price are counted in the first row only.
+func summaryRows(title string, summary *paymentpb.EarningsSummary) [][]any {
+	var avgDaysToPay any = ""
+	if summary.AvgDaysToPay != nil {
+		avgDaysToPay, _ = strconv.ParseFloat(strconv.FormatFloat(summary.GetAvgDaysToPay(), 'f', 1, 64), 64)
+	}
+
+	if len(summary.GetByCurrency()) == 0 {
+		return [][]any{{title, "", summary.GetLessonsCount(), summary.GetUnpricedLessonsCount(), 0, 0, 0, avgDaysToPay}}
+	}
+
+	rows := make([][]any, 0, len(summary.GetByCurrency()))
+	for i, earnings := range summary.GetByCurrency() {
+		lessonsCount := earnings.GetLessonsCount()
+		var unpricedLessonsCount int32
+		if i == 0 {
+			lessonsCount += summary.GetUnpricedLessonsCount()
+			unpricedLessonsCount = summary.GetUnpricedLessonsCount()
+		}
+		currency := money.Currency(earnings.GetCurrency())
+		rows = append(rows, []any{
+			title,
+			earnings.GetCurrency(),
+			lessonsCount,
+			unpricedLessonsCount,
+			majorAmount(earnings.GetEarnedMinor(), currency),
+			majorAmount(earnings.GetPaidMinor(), currency),
+			majorAmount(earnings.GetOutstandingMinor(), currency),
+			avgDaysToPay,
+		})
+	}
+	return rows
+}
+
+// majorAmount returns the amount in major units as a number so spreadsheets can sum it up.
+func majorAmount(amountMinor int64, currency money.Currency) float64 {
+	amount, _ := strconv.ParseFloat(money.New(amountMinor, currency).Major(), 64)
+	return amount
+}
+
+func writeCSV(w http.ResponseWriter, rows [][]any) error {
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	// the byte order mark makes Excel read the file as UTF-8
+	if _, err := w.Write([]byte("\xef\xbb\xbf")); err != nil {
+		return err
+	}
+
+	writer := csv.NewWriter(w)
+	for _, row := range rows {
+		record := make([]string, len(row))
+		for i, cell := range row {
+			record[i] = csvCell(cell)
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// csvCell keeps spreadsheets from evaluating user names as formulas.
+func csvCell(cell any) string {
+	value, ok := cell.(string)
+	if !ok {
+		return fmt.Sprint(cell)
+	}
+	if value != "" && strings.ContainsRune("=+-@", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+func writeXLSX(w http.ResponseWriter, rows [][]any) error {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	sheet := f.GetSheetName(0)
+	for i, row := range rows {
+		cell, err := excelize.CoordinatesToCellName(1, i+1)
+		if err != nil {
+			return err
+		}
+		if err := f.SetSheetRow(sheet, cell, &row); err != nil {
+			return err
+		}
+	}
+
+	w.Header().Set("Content-Type", xlsxContentType)
+	return f.Write(w)
+}
@@ -33,6 +33,14 @@ func (h *ScheduleHandler) RegisterRoutes(r chi.Router, authMiddleware func(http.
 		r.Get("/lessons/{id}", h.GetLesson)
 		r.Patch("/lessons/{id}", h.UpdateLesson)
 		r.Post("/lessons/{id}/cancel", h.CancelLesson)
+		r.Post("/lessons/quote", h.QuotePrice)
+
+		r.Post("/pricing/rules", h.CreatePricingRule)
+		r.Get("/pricing/rules/by-tutor/{tutor_id}", h.ListPricingRules)
+		r.Delete("/pricing/rules/{id}", h.DeletePricingRule)
+		r.Post("/pricing/discount-codes", h.CreateDiscountCode)
+		r.Get("/pricing/discount-codes/by-tutor/{tutor_id}", h.ListDiscountCodes)
+		r.Delete("/pricing/discount-codes/{id}", h.DeleteDiscountCode)
 	})
 }
 
@@ -114,6 +122,42 @@ func parseCancelLesson(ctx context.Context, r *http.Request, req *schedulepb.Can
 	return nil
 }
 
+func parseListPricingRules(ctx context.Context, r *http.Request, req *schedulepb.ListPricingRulesRequest) error {
+	tutorID, err := parseIDParam(r, "tutor_id")
+	if err != nil {
+		return err
+	}
+	req.TutorId = tutorID
+	return nil
+}
+
+func parseDeletePricingRule(ctx context.Context, r *http.Request, req *schedulepb.DeletePricingRuleRequest) error {
+	id, err := parseIDParam(r, "id")
+	if err != nil {
+		return err
+	}
+	req.Id = id
+	return nil
+}
+
+func parseListDiscountCodes(ctx context.Context, r *http.Request, req *schedulepb.ListDiscountCodesRequest) error {
+	tutorID, err := parseIDParam(r, "tutor_id")
+	if err != nil {
+		return err
+	}
+	req.TutorId = tutorID
+	return nil
+}
+
+func parseDeleteDiscountCode(ctx context.Context, r *http.Request, req *schedulepb.DeleteDiscountCodeRequest) error {
+	id, err := parseIDParam(r, "id")
+	if err != nil {
+		return err
+	}
+	req.Id = id
+	return nil
+}
+
 func parseListLessons(ctx context.Context, r *http.Request) (context.Context, any, error) {
 	q := r.URL.Query()
 	tutorID := q.Get("tutor_id")
@@ -229,6 +273,62 @@ func (h *ScheduleHandler) CancelLesson(w http.ResponseWriter, r *http.Request) {
 	handler(w, r)
 }
 
+func (h *ScheduleHandler) QuotePrice(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[schedulepb.QuotePriceRequest, schedulepb.PriceQuote](h.c.QuotePrice, nil, true)
+	if err != nil {
+		panic(err)
+	}
+	handler(w, r)
+}
+
+func (h *ScheduleHandler) CreatePricingRule(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[schedulepb.CreatePricingRuleRequest, schedulepb.PricingRule](h.c.CreatePricingRule, nil, true)
+	if err != nil {
+		panic(err)
+	}
+	handler(w, r)
+}
+
+func (h *ScheduleHandler) ListPricingRules(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[schedulepb.ListPricingRulesRequest, schedulepb.ListPricingRulesResponse](h.c.ListPricingRules, parseListPricingRules, false)
+	if err != nil {
+		panic(err)
+	}
+	handler(w, r)
+}
+
+func (h *ScheduleHandler) DeletePricingRule(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[schedulepb.DeletePricingRuleRequest, schedulepb.Empty](h.c.DeletePricingRule, parseDeletePricingRule, false)
+	if err != nil {
+		panic(err)
+	}
+	handler(w, r)
+}
+
+func (h *ScheduleHandler) CreateDiscountCode(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[schedulepb.CreateDiscountCodeRequest, schedulepb.DiscountCode](h.c.CreateDiscountCode, nil, true)
+	if err != nil {
+		panic(err)
+	}
+	handler(w, r)
+}
+
+func (h *ScheduleHandler) ListDiscountCodes(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[schedulepb.ListDiscountCodesRequest, schedulepb.ListDiscountCodesResponse](h.c.ListDiscountCodes, parseListDiscountCodes, false)
+	if err != nil {
+		panic(err)
+	}
+	handler(w, r)
+}
+
+func (h *ScheduleHandler) DeleteDiscountCode(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[schedulepb.DeleteDiscountCodeRequest, schedulepb.Empty](h.c.DeleteDiscountCode, parseDeleteDiscountCode, false)
+	if err != nil {
+		panic(err)
+	}
+	handler(w, r)
+}
+
 func (h *ScheduleHandler) ListLessons(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	ctx, customReq, err := parseListLessons(ctx, r)
@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"context"
+	"github.com/go-chi/chi/v5"
+	"net/http"
+	userpb "userservice/pkg/api"
+)
+
+type SessionHandler struct {
+	c userpb.UserServiceClient
+}
+
+func NewSessionHandler(c userpb.UserServiceClient) *SessionHandler {
+	return &SessionHandler{c: c}
+}
+
+// CreateSession exchanges the initial telegram or tma authorization header for session tokens.
+func (h *SessionHandler) CreateSession(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[userpb.CreateSessionRequest, userpb.SessionTokens](h.c.CreateSession, parseCreateSessionRequest, false)
+	if err != nil {
+		panic(err)
+	}
+
+	handler(w, r)
+}
+
+func (h *SessionHandler) RefreshSession(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[userpb.RefreshSessionRequest, userpb.SessionTokens](h.c.RefreshSession, nil, true)
+	if err != nil {
+		panic(err)
+	}
+
+	handler(w, r)
+}
+
+func (h *SessionHandler) RevokeSession(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[userpb.RevokeSessionRequest, userpb.Empty](h.c.RevokeSession, nil, true)
+	if err != nil {
+		panic(err)
+	}
+
+	handler(w, r)
+}
+
+func (h *SessionHandler) GetSigningKeys(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[userpb.Empty, userpb.SigningKeySet](h.c.GetSigningKeys, nil, false)
+	if err != nil {
+		panic(err)
+	}
+
+	handler(w, r)
+}
+
+func (h *SessionHandler) RegisterRoutes(r chi.Router) {
+	r.Post("/session", h.CreateSession)
+	r.Post("/refresh", h.RefreshSession)
+	r.Post("/logout", h.RevokeSession)
+	r.Get("/jwks", h.GetSigningKeys)
+}
+
+func parseCreateSessionRequest(ctx context.Context, r *http.Request, req *userpb.CreateSessionRequest) error {
+	req.AuthorizationHeader = r.Header.Get("Authorization")
+	return nil
+}
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"context"
 	"github.com/go-chi/chi/v5"
 	"net/http"
 	userpb "userservice/pkg/api"
@@ -24,7 +25,7 @@ func NewSignUpHandler(c userpb.UserServiceClient) *SignUpHandler {
 }
 
 func (h *SignUpHandler) SignUpViaTelegram(w http.ResponseWriter, r *http.Request) {
-	handler, err := Handle[userpb.RegisterViaTelegramRequest, userpb.User](h.c.RegisterViaTelegram, nil, true)
+	handler, err := Handle[userpb.RegisterViaTelegramRequest, userpb.User](h.c.RegisterViaTelegram, parseSignUpViaTelegram, true)
 	if err != nil {
 		panic(err)
 	}
@@ -32,6 +33,15 @@ func (h *SignUpHandler) SignUpViaTelegram(w http.ResponseWriter, r *http.Request
 	handler(w, r)
 }
 
+// parseSignUpViaTelegram passes the telegram authorization header, user service requires it for the admin role.
+func parseSignUpViaTelegram(ctx context.Context, r *http.Request, req *userpb.RegisterViaTelegramRequest) error {
+	req.AuthorizationHeader = nil
+	if header := r.Header.Get("Authorization"); header != "" {
+		req.AuthorizationHeader = &header
+	}
+	return nil
+}
+
 func (h *SignUpHandler) RegisterRoutes(r chi.Router) {
 	r.Post("/sign-up/telegram", h.SignUpViaTelegram)
 }
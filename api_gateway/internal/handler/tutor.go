@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	userpb "userservice/pkg/api"
+)
+
+// SearchTutors and GetTutorPublicProfile return profiles without the lesson connection link,
+// payment info is returned only to the tutor and their active students.
+func (h *UserHandler) SearchTutors(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[userpb.SearchTutorsRequest, userpb.SearchTutorsResponse](h.c.SearchTutors, parseSearchTutors, false)
+	if err != nil {
+		panic(err)
+	}
+
+	handler(w, r)
+}
+
+func (h *UserHandler) GetTutorPublicProfile(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[userpb.GetTutorPublicProfileRequest, userpb.TutorPublicProfile](h.c.GetTutorPublicProfile, parseGetTutorPublicProfile, false)
+	if err != nil {
+		panic(err)
+	}
+
+	handler(w, r)
+}
+
+// parseSearchTutors reads prices in minor units of the currency query parameter.
+func parseSearchTutors(ctx context.Context, r *http.Request, req *userpb.SearchTutorsRequest) error {
+	q := r.URL.Query()
+	for name, field := range map[string]**string{
+		"query":       &req.Query,
+		"subject":     &req.Subject,
+		"language":    &req.Language,
+		"grade_level": &req.GradeLevel,
+	} {
+		if v := q.Get(name); v != "" {
+			*field = &v
+		}
+	}
+
+	currency := q.Get("currency")
+	for name, field := range map[string]**userpb.Money{
+		"min_price": &req.MinPrice,
+		"max_price": &req.MaxPrice,
+	} {
+		v := q.Get(name)
+		if v == "" {
+			continue
+		}
+		if currency == "" {
+			return fmt.Errorf("%w: currency is required with %s", BadRequestError, name)
+		}
+		amount, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return fmt.Errorf("%w: invalid %s", BadRequestError, name)
+		}
+		*field = &userpb.Money{AmountMinor: amount, Currency: currency}
+	}
+
+	limit, offset, err := parseLimitOffset(r)
+	if err != nil {
+		return err
+	}
+	req.Limit = limit
+	req.Offset = offset
+	return nil
+}
+
+func parseGetTutorPublicProfile(ctx context.Context, r *http.Request, req *userpb.GetTutorPublicProfileRequest) error {
+	id, err := parseIDParam(r, "id")
+	if err != nil {
+		return err
+	}
+	req.UserId = id
+	return nil
+}
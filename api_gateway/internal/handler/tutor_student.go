@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	userpb "userservice/pkg/api"
+)
+
+// ChangeTutorStudentStatus pauses, resumes, archives or restores the pair.
+func (h *UserHandler) ChangeTutorStudentStatus(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[userpb.ChangeTutorStudentStatusRequest, userpb.TutorStudent](h.c.ChangeTutorStudentStatus, parseChangeTutorStudentStatus, true)
+	if err != nil {
+		panic(err)
+	}
+
+	key, err := buildTutorStudentKey(r)
+	if err == nil {
+		h.cache.Delete(r.Context(), key)
+	}
+
+	handler(w, r)
+}
+
+func (h *UserHandler) ListTutorStudentStatusEvents(w http.ResponseWriter, r *http.Request) {
+	handler, err := Handle[userpb.ListTutorStudentStatusEventsRequest, userpb.ListTutorStudentStatusEventsResponse](h.c.ListTutorStudentStatusEvents, parseListTutorStudentStatusEvents, false)
+	if err != nil {
+		panic(err)
+	}
+
+	handler(w, r)
+}
+
+func parseChangeTutorStudentStatus(ctx context.Context, r *http.Request, req *userpb.ChangeTutorStudentStatusRequest) error {
+	tutorId, err := parseIDParam(r, "tutor_id")
+	if err != nil {
+		return err
+	}
+	studentId, err := parseIDParam(r, "student_id")
+	if err != nil {
+		return err
+	}
+	req.TutorId = tutorId
+	req.StudentId = studentId
+	return nil
+}
+
+func parseListTutorStudentStatusEvents(ctx context.Context, r *http.Request, req *userpb.ListTutorStudentStatusEventsRequest) error {
+	tutorId, err := parseIDParam(r, "tutor_id")
+	if err != nil {
+		return err
+	}
+	studentId, err := parseIDParam(r, "student_id")
+	if err != nil {
+		return err
+	}
+	req.TutorId = tutorId
+	req.StudentId = studentId
+	return nil
+}
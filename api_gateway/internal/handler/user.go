@@ -23,17 +23,35 @@ func NewUserHandler(c userpb.UserServiceClient, cache Cache) *UserHandler {
 func (h *UserHandler) RegisterRoutes(r chi.Router, authMiddleware func(http.Handler) http.Handler) {
 	r.With(authMiddleware).Group(func(r chi.Router) {
 		r.Get("/users/me", h.GetMe)
+		r.Delete("/users/me", h.DeleteAccount)
+		r.Post("/users/me/export", h.ExportMyData)
+		r.Get("/users/me/data-jobs/{id}", h.GetDataJob)
 		r.Get("/users/{id}", h.GetUser)
 		r.Patch("/users/{id}", h.UpdateUser)
 		r.Get("/tutor-profiles/{id}", h.GetTutorProfile)
 		r.Patch("/tutor-profiles/{id}", h.UpdateTutorProfile)
+		r.Get("/tutors", h.SearchTutors)
+		r.Get("/tutors/{id}", h.GetTutorPublicProfile)
 		r.Get("/tutor-students/by-tutor/{id}", h.ListTutorStudentByTutor)
 		r.Get("/tutor-students/by-student/{id}", h.ListTutorStudentByStudent)
 		r.Get("/tutor-students/{tutor_id}/{student_id}", h.GetTutorStudent)
 		r.Patch("/tutor-students/{tutor_id}/{student_id}", h.UpdateTutorStudent)
 		r.Delete("/tutor-students/{tutor_id}/{student_id}", h.DeleteTutorStudent)
+		r.Post("/tutor-students/{tutor_id}/{student_id}/status", h.ChangeTutorStudentStatus)
+		r.Get("/tutor-students/{tutor_id}/{student_id}/history", h.ListTutorStudentStatusEvents)
 		r.Post("/tutor-students", h.CreateTutorStudent)
 		r.Post("/tutor-students/{tutor_id}/accept", h.AcceptInvitation)
+		r.Post("/tutor-students/{tutor_id}/reject", h.RejectInvitation)
+		r.Post("/invites", h.CreateInvite)
+		r.Get("/invites/by-tutor/{id}", h.ListInvites)
+		r.Post("/invites/{id}/revoke", h.RevokeInvite)
+		r.Post("/invites/redeem", h.RedeemInvite)
+		r.Get("/guardian-students/by-guardian/{id}", h.ListGuardianStudentsByGuardian)
+		r.Get("/guardian-students/by-student/{id}", h.ListGuardianStudentsByStudent)
+		r.Get("/guardian-students/{guardian_id}/{student_id}", h.GetGuardianStudent)
+		r.Delete("/guardian-students/{guardian_id}/{student_id}", h.DeleteGuardianStudent)
+		r.Post("/guardian-students", h.CreateGuardianStudent)
+		r.Post("/guardian-students/{guardian_id}/accept", h.AcceptGuardianRequest)
 	})
 }
 
@@ -91,7 +109,7 @@ func (h *UserHandler) GetTutorProfile(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *UserHandler) UpdateTutorProfile(w http.ResponseWriter, r *http.Request) {
-	handler, err := Handle[userpb.UpdateTutorProfileRequest, userpb.TutorProfile](h.c.UpdateTutorProfile, updateTutorProfileParsePath, false)
+	handler, err := Handle[userpb.UpdateTutorProfileRequest, userpb.TutorProfile](h.c.UpdateTutorProfile, updateTutorProfileParsePath, true)
 	if err != nil {
 		panic(err)
 	}
@@ -38,12 +38,16 @@ func mapErr(err error) int {
 		switch st.Code() {
 		case codes.InvalidArgument:
 			return http.StatusBadRequest
-		case codes.AlreadyExists:
+		case codes.AlreadyExists, codes.FailedPrecondition:
 			return http.StatusConflict
 		case codes.PermissionDenied:
 			return http.StatusForbidden
 		case codes.NotFound:
 			return http.StatusNotFound
+		case codes.Unauthenticated:
+			return http.StatusUnauthorized
+		case codes.Unimplemented:
+			return http.StatusNotImplemented
 		}
 	}
 	return http.StatusInternalServerError
@@ -67,14 +71,7 @@ func Handle[Req any, Resp any](
 	}
 
 	return func(w http.ResponseWriter, r *http.Request) {
-		ctx := metadata.NewOutgoingContext(r.Context(), metadata.Pairs())
-		if id := r.Header.Get("X-User-Id"); id != "" {
-			ctx = metadata.AppendToOutgoingContext(ctx, "x-user-id", id)
-		}
-
-		if role := r.Header.Get("X-User-Role"); role != "" {
-			ctx = metadata.AppendToOutgoingContext(ctx, "x-user-role", role)
-		}
+		ctx := outgoingContext(r)
 
 		grpcReq := new(Req)
 
@@ -160,13 +157,7 @@ func HandleWithCache[Req any, Resp any](
 	}
 
 	return func(w http.ResponseWriter, r *http.Request) {
-		ctx := metadata.NewOutgoingContext(r.Context(), metadata.Pairs())
-		if id := r.Header.Get("X-User-Id"); id != "" {
-			ctx = metadata.AppendToOutgoingContext(ctx, "x-user-id", id)
-		}
-		if role := r.Header.Get("X-User-Role"); role != "" {
-			ctx = metadata.AppendToOutgoingContext(ctx, "x-user-role", role)
-		}
+		ctx := outgoingContext(r)
 
 		key, err := keyFunc(r)
 		if err == nil {
@@ -217,6 +208,18 @@ func HandleWithCache[Req any, Resp any](
 	}, nil
 }
 
+// outgoingContext passes the authenticated user to the grpc call.
+func outgoingContext(r *http.Request) context.Context {
+	ctx := metadata.NewOutgoingContext(r.Context(), metadata.Pairs())
+	if id := r.Header.Get("X-User-Id"); id != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-user-id", id)
+	}
+	if role := r.Header.Get("X-User-Role"); role != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-user-role", role)
+	}
+	return ctx
+}
+
 func parsePathParam(r *http.Request, key string) (string, error) {
 	val := chi.URLParam(r, key)
 	if val == "" {
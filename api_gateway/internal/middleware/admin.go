@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	"common_library/logging"
+	"go.uber.org/zap"
+	"net/http"
+)
+
+// NewAdminMiddleware lets through admins only, it must run after the auth middleware.
+// Services check the role again, this only keeps the back office closed for everyone else.
+func NewAdminMiddleware() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			if r.Header.Get("X-User-Role") != "admin" {
+				if logger, ok := logging.GetFromContext(ctx); ok {
+					logger.Info(ctx, "admin route denied", zap.String("user_id", r.Header.Get("X-User-Id")))
+				}
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -1,15 +1,20 @@
 package middleware
 
 import (
+	"common_library/authtoken"
 	"common_library/logging"
+	"errors"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"net/http"
+	"strings"
 	userpb "userservice/pkg/api"
 )
 
-func NewAuthMiddleware(userClient userpb.UserServiceClient) func(http.Handler) http.Handler {
+// NewAuthMiddleware verifies bearer access tokens locally with verifier,
+// other authorization headers are checked by user service.
+func NewAuthMiddleware(userClient userpb.UserServiceClient, verifier *TokenVerifier) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			ctx := r.Context()
@@ -21,12 +26,38 @@ func NewAuthMiddleware(userClient userpb.UserServiceClient) func(http.Handler) h
 				w.WriteHeader(http.StatusUnauthorized)
 				return
 			}
+			if token, ok := strings.CutPrefix(header, "Bearer "); ok {
+				claims, err := verifier.Verify(ctx, token)
+				if err != nil {
+					if errors.Is(err, authtoken.ErrInvalidToken) ||
+						errors.Is(err, authtoken.ErrExpiredToken) ||
+						errors.Is(err, authtoken.ErrUnknownKey) {
+						if logger, ok := logging.GetFromContext(ctx); ok {
+							logger.Info(ctx, "invalid access token", zap.Error(err))
+						}
+						w.WriteHeader(http.StatusUnauthorized)
+						return
+					}
+					if logger, ok := logging.GetFromContext(ctx); ok {
+						logger.Error(ctx, "error while verifying access token", zap.Error(err))
+					}
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+
+				r.Header.Set("X-User-Id", claims.Subject)
+				r.Header.Set("X-User-Role", claims.Role)
+				next.ServeHTTP(w, r)
+				return
+			}
+
 			req := &userpb.AuthorizeByAuthHeaderRequest{AuthorizationHeader: header}
 			resp, err := userClient.AuthorizeByAuthHeader(ctx, req)
 			if err != nil {
-				if status.Code(err) == codes.PermissionDenied {
+				switch status.Code(err) {
+				case codes.PermissionDenied, codes.Unauthenticated, codes.InvalidArgument:
 					if logger, ok := logging.GetFromContext(ctx); ok {
-						logger.Info(ctx, "permission denied", zap.Any("request", r))
+						logger.Info(ctx, "authorization failed", zap.String("reason", status.Convert(err).Message()))
 					}
 					w.WriteHeader(http.StatusUnauthorized)
 					return
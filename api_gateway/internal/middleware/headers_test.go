@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestStripUserHeadersMiddleware(t *testing.T) {
+	var got http.Header
+	handler := NewStripUserHeadersMiddleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+	}))
+
+	r := httptest.NewRequest(http.MethodGet, "/files/1/meta", nil)
+	r.Header.Set("X-User-Id", "owner")
+	r.Header.Set("X-User-Role", "admin")
+	r.Header.Set("X-Request-Id", "42")
+	handler.ServeHTTP(httptest.NewRecorder(), r)
+
+	if got.Get("X-User-Id") != "" || got.Get("X-User-Role") != "" {
+		t.Fatalf("client user headers were passed through: %v", got)
+	}
+	if got.Get("X-Request-Id") != "42" {
+		t.Fatalf("other headers must be kept: %v", got)
+	}
+}
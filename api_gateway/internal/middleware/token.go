@@ -0,0 +1,128 @@
+package middleware
+
+import (
+	"common_library/authtoken"
+	"context"
+	"errors"
+	"sync"
+	"time"
+	userpb "userservice/pkg/api"
+)
+
+// keysRefetchInterval limits fetching keys on tokens with an unknown key id.
+const keysRefetchInterval = time.Minute
+
+type SessionCache interface {
+	Get(ctx context.Context, key string) ([]byte, bool)
+	Set(ctx context.Context, key string, data []byte, ttl time.Duration)
+}
+
+// TokenVerifier verifies access tokens with the key set of user service.
+// Only session revocation is checked over gRPC, its result is cached for sessionCheckTTL.
+type TokenVerifier struct {
+	userClient      userpb.UserServiceClient
+	cache           SessionCache
+	issuer          string
+	keysTTL         time.Duration
+	sessionCheckTTL time.Duration
+
+	mu        sync.RWMutex
+	keys      authtoken.KeySet
+	fetchedAt time.Time
+}
+
+func NewTokenVerifier(
+	userClient userpb.UserServiceClient,
+	cache SessionCache,
+	issuer string,
+	keysTTL time.Duration,
+	sessionCheckTTL time.Duration,
+) *TokenVerifier {
+	return &TokenVerifier{
+		userClient:      userClient,
+		cache:           cache,
+		issuer:          issuer,
+		keysTTL:         keysTTL,
+		sessionCheckTTL: sessionCheckTTL,
+	}
+}
+
+// Verify returns claims of a valid access token of an active session.
+func (v *TokenVerifier) Verify(ctx context.Context, token string) (*authtoken.Claims, error) {
+	keys, err := v.keySet(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, err := authtoken.Verify(token, keys, v.issuer, time.Now())
+	if errors.Is(err, authtoken.ErrUnknownKey) {
+		// the key may have been rotated in after the last fetch
+		if keys, err = v.keySet(ctx, true); err != nil {
+			return nil, err
+		}
+		claims, err = authtoken.Verify(token, keys, v.issuer, time.Now())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	active, err := v.isSessionActive(ctx, claims.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	if !active {
+		return nil, authtoken.ErrInvalidToken
+	}
+	return claims, nil
+}
+
+func (v *TokenVerifier) keySet(ctx context.Context, unknownKey bool) (authtoken.KeySet, error) {
+	v.mu.RLock()
+	keys, age := v.keys, time.Since(v.fetchedAt)
+	v.mu.RUnlock()
+
+	if age < v.keysTTL && (!unknownKey || age < keysRefetchInterval) {
+		return keys, nil
+	}
+
+	resp, err := v.userClient.GetSigningKeys(ctx, &userpb.Empty{})
+	if err != nil {
+		return authtoken.KeySet{}, err
+	}
+
+	keys = authtoken.KeySet{Keys: make([]authtoken.JWK, 0, len(resp.Keys))}
+	for _, key := range resp.Keys {
+		keys.Keys = append(keys.Keys, authtoken.JWK{
+			Kty: key.Kty,
+			Crv: key.Crv,
+			Alg: key.Alg,
+			Use: key.Use,
+			Kid: key.Kid,
+			X:   key.X,
+		})
+	}
+
+	v.mu.Lock()
+	v.keys, v.fetchedAt = keys, time.Now()
+	v.mu.Unlock()
+	return keys, nil
+}
+
+func (v *TokenVerifier) isSessionActive(ctx context.Context, sessionId string) (bool, error) {
+	key := "session:" + sessionId
+	if data, ok := v.cache.Get(ctx, key); ok {
+		return string(data) == "1", nil
+	}
+
+	resp, err := v.userClient.CheckSession(ctx, &userpb.CheckSessionRequest{SessionId: sessionId})
+	if err != nil {
+		return false, err
+	}
+
+	data := []byte("0")
+	if resp.Active {
+		data = []byte("1")
+	}
+	v.cache.Set(ctx, key, data, v.sessionCheckTTL)
+	return resp.Active, nil
+}
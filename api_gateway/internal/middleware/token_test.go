@@ -0,0 +1,143 @@
+package middleware
+
+import (
+	"bytes"
+	"common_library/authtoken"
+	"context"
+	"errors"
+	"google.golang.org/grpc"
+	"testing"
+	"time"
+	userpb "userservice/pkg/api"
+)
+
+// fakeUserClient implements only the methods used by TokenVerifier
+type fakeUserClient struct {
+	userpb.UserServiceClient
+	keys          []*authtoken.SigningKey
+	active        map[string]bool
+	keyFetches    int
+	sessionChecks int
+}
+
+func (c *fakeUserClient) GetSigningKeys(ctx context.Context, in *userpb.Empty, opts ...grpc.CallOption) (*userpb.SigningKeySet, error) {
+	c.keyFetches++
+	set := &userpb.SigningKeySet{}
+	for _, key := range c.keys {
+		jwk := key.PublicJWK()
+		set.Keys = append(set.Keys, &userpb.SigningKey{Kty: jwk.Kty, Crv: jwk.Crv, Alg: jwk.Alg, Use: jwk.Use, Kid: jwk.Kid, X: jwk.X})
+	}
+	return set, nil
+}
+
+func (c *fakeUserClient) CheckSession(ctx context.Context, in *userpb.CheckSessionRequest, opts ...grpc.CallOption) (*userpb.CheckSessionResponse, error) {
+	c.sessionChecks++
+	return &userpb.CheckSessionResponse{Active: c.active[in.SessionId]}, nil
+}
+
+type memorySessionCache map[string][]byte
+
+func (c memorySessionCache) Get(ctx context.Context, key string) ([]byte, bool) {
+	data, ok := c[key]
+	return data, ok
+}
+
+func (c memorySessionCache) Set(ctx context.Context, key string, data []byte, ttl time.Duration) {
+	c[key] = data
+}
+
+func newTestSigningKey(t *testing.T, id string, fill byte) *authtoken.SigningKey {
+	t.Helper()
+	key, err := authtoken.NewSigningKey(id, bytes.Repeat([]byte{fill}, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func signTestToken(t *testing.T, key *authtoken.SigningKey, issuer string, sessionId string, expiresAt time.Time) string {
+	t.Helper()
+	token, err := authtoken.Sign(key, &authtoken.Claims{
+		Subject:   "user",
+		Role:      "student",
+		SessionID: sessionId,
+		Issuer:    issuer,
+		IssuedAt:  time.Now().Unix(),
+		ExpiresAt: expiresAt.Unix(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestTokenVerifier_Verify(t *testing.T) {
+	key := newTestSigningKey(t, "k1", 1)
+	later := time.Now().Add(time.Minute)
+
+	tests := []struct {
+		name  string
+		token string
+		want  error
+	}{
+		{"Valid", signTestToken(t, key, "studyflow", "active", later), nil},
+		{"RevokedSession", signTestToken(t, key, "studyflow", "revoked", later), authtoken.ErrInvalidToken},
+		{"Expired", signTestToken(t, key, "studyflow", "active", time.Now().Add(-time.Minute)), authtoken.ErrExpiredToken},
+		{"BadSignature", signTestToken(t, newTestSigningKey(t, "k1", 2), "studyflow", "active", later), authtoken.ErrInvalidToken},
+		{"WrongIssuer", signTestToken(t, key, "other", "active", later), authtoken.ErrInvalidToken},
+		{"UnknownKey", signTestToken(t, newTestSigningKey(t, "k2", 2), "studyflow", "active", later), authtoken.ErrUnknownKey},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &fakeUserClient{
+				keys:   []*authtoken.SigningKey{key},
+				active: map[string]bool{"active": true},
+			}
+			v := NewTokenVerifier(client, memorySessionCache{}, "studyflow", time.Hour, time.Minute)
+			claims, err := v.Verify(context.Background(), tt.token)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("err = %v, want %v", err, tt.want)
+			}
+			if tt.want == nil && claims.SessionID != "active" {
+				t.Errorf("claims = %+v", claims)
+			}
+		})
+	}
+}
+
+func TestTokenVerifier_CachesSessionCheck(t *testing.T) {
+	key := newTestSigningKey(t, "k1", 1)
+	client := &fakeUserClient{keys: []*authtoken.SigningKey{key}, active: map[string]bool{"s": true}}
+	v := NewTokenVerifier(client, memorySessionCache{}, "studyflow", time.Hour, time.Minute)
+	token := signTestToken(t, key, "studyflow", "s", time.Now().Add(time.Minute))
+
+	for i := 0; i < 3; i++ {
+		if _, err := v.Verify(context.Background(), token); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if client.keyFetches != 1 || client.sessionChecks != 1 {
+		t.Errorf("key fetches = %d, session checks = %d, want 1 and 1", client.keyFetches, client.sessionChecks)
+	}
+}
+
+func TestTokenVerifier_RefetchesKeysOnRotation(t *testing.T) {
+	oldKey := newTestSigningKey(t, "k1", 1)
+	newKey := newTestSigningKey(t, "k2", 2)
+	client := &fakeUserClient{keys: []*authtoken.SigningKey{oldKey}, active: map[string]bool{"s": true}}
+	v := NewTokenVerifier(client, memorySessionCache{}, "studyflow", time.Hour, time.Minute)
+
+	if _, err := v.Verify(context.Background(), signTestToken(t, oldKey, "studyflow", "s", time.Now().Add(time.Minute))); err != nil {
+		t.Fatal(err)
+	}
+	// the last fetch is older than keysRefetchInterval
+	v.fetchedAt = time.Now().Add(-2 * keysRefetchInterval)
+	client.keys = append(client.keys, newKey)
+
+	if _, err := v.Verify(context.Background(), signTestToken(t, newKey, "studyflow", "s", time.Now().Add(time.Minute))); err != nil {
+		t.Fatalf("token of the rotated key: %v", err)
+	}
+	if client.keyFetches != 2 {
+		t.Errorf("key fetches = %d, want 2", client.keyFetches)
+	}
+}
@@ -0,0 +1,155 @@
+// Package authtoken signs and verifies access tokens issued by user_service.
+// Tokens are compact JWTs signed with Ed25519 (alg EdDSA), public keys are published as a JWK set.
+package authtoken
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const algorithm = "EdDSA"
+
+var (
+	ErrInvalidToken = errors.New("invalid token")
+	ErrExpiredToken = errors.New("token expired")
+	ErrUnknownKey   = errors.New("unknown signing key")
+)
+
+// Claims of an access token.
+type Claims struct {
+	Subject   string `json:"sub"`
+	Role      string `json:"role"`
+	SessionID string `json:"sid"`
+	Issuer    string `json:"iss,omitempty"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// JWK is a public Ed25519 key in JSON Web Key format.
+type JWK struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	Kid string `json:"kid"`
+	X   string `json:"x"`
+}
+
+// KeySet is a JWK set, tokens signed by any of its keys are accepted.
+type KeySet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// SigningKey is a private key with its key id.
+type SigningKey struct {
+	ID  string
+	Key ed25519.PrivateKey
+}
+
+// NewSigningKey creates a key from a 32-byte Ed25519 seed.
+func NewSigningKey(id string, seed []byte) (*SigningKey, error) {
+	if id == "" {
+		return nil, errors.New("authtoken: key id is empty")
+	}
+	if len(seed) != ed25519.SeedSize {
+		return nil, fmt.Errorf("authtoken: key %s: seed must be %d bytes", id, ed25519.SeedSize)
+	}
+	return &SigningKey{ID: id, Key: ed25519.NewKeyFromSeed(seed)}, nil
+}
+
+// PublicJWK returns the public part of the key.
+func (k *SigningKey) PublicJWK() JWK {
+	return JWK{
+		Kty: "OKP",
+		Crv: "Ed25519",
+		Alg: algorithm,
+		Use: "sig",
+		Kid: k.ID,
+		X:   base64.RawURLEncoding.EncodeToString(k.Key.Public().(ed25519.PublicKey)),
+	}
+}
+
+type header struct {
+	Alg string `json:"alg"`
+	Typ string `json:"typ"`
+	Kid string `json:"kid"`
+}
+
+// Sign encodes claims into a token signed by key.
+func Sign(key *SigningKey, claims *Claims) (string, error) {
+	h, err := json.Marshal(header{Alg: algorithm, Typ: "JWT", Kid: key.ID})
+	if err != nil {
+		return "", err
+	}
+	c, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	signed := base64.RawURLEncoding.EncodeToString(h) + "." + base64.RawURLEncoding.EncodeToString(c)
+	signature := ed25519.Sign(key.Key, []byte(signed))
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// Verify checks the token signature against keys, its issuer and its expiration at now.
+// Returns ErrUnknownKey if the token is signed by a key missing in keys.
+func Verify(token string, keys KeySet, issuer string, now time.Time) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrInvalidToken
+	}
+
+	var h header
+	if err := decodeSegment(parts[0], &h); err != nil || h.Alg != algorithm {
+		return nil, ErrInvalidToken
+	}
+
+	publicKey, ok := keys.find(h.Kid)
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil || !ed25519.Verify(publicKey, []byte(parts[0]+"."+parts[1]), signature) {
+		return nil, ErrInvalidToken
+	}
+
+	var claims Claims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, ErrInvalidToken
+	}
+	if claims.Issuer != issuer {
+		return nil, ErrInvalidToken
+	}
+	if now.Unix() >= claims.ExpiresAt {
+		return nil, ErrExpiredToken
+	}
+	return &claims, nil
+}
+
+func (s KeySet) find(kid string) (ed25519.PublicKey, bool) {
+	for _, key := range s.Keys {
+		if key.Kid != kid || key.Kty != "OKP" || key.Crv != "Ed25519" {
+			continue
+		}
+		x, err := base64.RawURLEncoding.DecodeString(key.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, false
+		}
+		return x, true
+	}
+	return nil, false
+}
+
+func decodeSegment(segment string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
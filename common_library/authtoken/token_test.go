@@ -0,0 +1,68 @@
+package authtoken
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+)
+
+func newTestKey(t *testing.T, id string, fill byte) *SigningKey {
+	t.Helper()
+	key, err := NewSigningKey(id, bytes.Repeat([]byte{fill}, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func TestSignVerify(t *testing.T) {
+	key := newTestKey(t, "k1", 1)
+	keys := KeySet{Keys: []JWK{key.PublicJWK()}}
+	now := time.Unix(1700000000, 0)
+
+	sign := func(claims *Claims, key *SigningKey) string {
+		token, err := Sign(key, claims)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return token
+	}
+	claims := func(issuer string, expiresAt time.Time) *Claims {
+		return &Claims{
+			Subject:   "user",
+			Role:      "student",
+			SessionID: "session",
+			Issuer:    issuer,
+			IssuedAt:  now.Unix(),
+			ExpiresAt: expiresAt.Unix(),
+		}
+	}
+	valid := sign(claims("studyflow", now.Add(time.Minute)), key)
+
+	tests := []struct {
+		name  string
+		token string
+		want  error
+	}{
+		{"Valid", valid, nil},
+		{"Expired", sign(claims("studyflow", now), key), ErrExpiredToken},
+		{"BadSignature", sign(claims("studyflow", now.Add(time.Minute)), newTestKey(t, "k1", 2)), ErrInvalidToken},
+		{"TamperedClaims", valid[:len(valid)-4] + "AAAA", ErrInvalidToken},
+		{"WrongIssuer", sign(claims("other", now.Add(time.Minute)), key), ErrInvalidToken},
+		{"NoIssuer", sign(claims("", now.Add(time.Minute)), key), ErrInvalidToken},
+		{"UnknownKey", sign(claims("studyflow", now.Add(time.Minute)), newTestKey(t, "k2", 1)), ErrUnknownKey},
+		{"Malformed", "not.a-token", ErrInvalidToken},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Verify(tt.token, keys, "studyflow", now)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("err = %v, want %v", err, tt.want)
+			}
+			if tt.want == nil && (got.Subject != "user" || got.SessionID != "session") {
+				t.Errorf("claims = %+v", got)
+			}
+		})
+	}
+}
@@ -7,11 +7,13 @@ import (
 type traceIDKey struct{}
 type userIDKey struct{}
 type userRoleKey struct{}
+type callerServiceKey struct{}
 
 var (
-	traceIDKeyInstance  = traceIDKey{}
-	userIDKeyInstance   = userIDKey{}
-	userRoleKeyInstance = userRoleKey{}
+	traceIDKeyInstance       = traceIDKey{}
+	userIDKeyInstance        = userIDKey{}
+	userRoleKeyInstance      = userRoleKey{}
+	callerServiceKeyInstance = callerServiceKey{}
 )
 
 func WithTraceID(ctx context.Context, traceID string) context.Context {
@@ -43,3 +45,15 @@ func GetUserRole(ctx context.Context) (string, bool) {
 	role, ok := v.(string)
 	return role, ok
 }
+
+// WithCallerService marks the request as made by another service on its own authority.
+// API gateway never sets it, so users cannot reach service-only methods.
+func WithCallerService(ctx context.Context, service string) context.Context {
+	return context.WithValue(ctx, callerServiceKeyInstance, service)
+}
+
+func GetCallerService(ctx context.Context) (string, bool) {
+	v := ctx.Value(callerServiceKeyInstance)
+	service, ok := v.(string)
+	return service, ok && service != ""
+}
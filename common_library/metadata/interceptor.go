@@ -24,6 +24,9 @@ func NewMetadataUnaryInterceptor() grpc.UnaryServerInterceptor {
 			if values := md.Get("x-user-role"); len(values) > 0 {
 				ctx = ctxdata.WithUserRole(ctx, values[0])
 			}
+			if values := md.Get("x-caller-service"); len(values) > 0 {
+				ctx = ctxdata.WithCallerService(ctx, values[0])
+			}
 		}
 
 		return handler(ctx, req)
@@ -0,0 +1,195 @@
+// Package money represents amounts as integer minor units of an ISO 4217 currency.
+package money
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Currency is an upper case ISO 4217 code.
+type Currency string
+
+const (
+	RUB Currency = "RUB"
+	USD Currency = "USD"
+	EUR Currency = "EUR"
+	GBP Currency = "GBP"
+	KZT Currency = "KZT"
+	BYN Currency = "BYN"
+	UAH Currency = "UAH"
+	GEL Currency = "GEL"
+	AMD Currency = "AMD"
+	TRY Currency = "TRY"
+	AED Currency = "AED"
+	RSD Currency = "RSD"
+	JPY Currency = "JPY"
+)
+
+// Default is the currency of amounts stored before currencies were introduced.
+const Default = RUB
+
+// minorUnits maps supported currencies to the number of digits after the decimal point.
+var minorUnits = map[Currency]int{
+	RUB: 2,
+	USD: 2,
+	EUR: 2,
+	GBP: 2,
+	KZT: 2,
+	BYN: 2,
+	UAH: 2,
+	GEL: 2,
+	AMD: 2,
+	TRY: 2,
+	AED: 2,
+	RSD: 2,
+	JPY: 0,
+}
+
+var (
+	ErrUnknownCurrency  = errors.New("unknown currency")
+	ErrCurrencyMismatch = errors.New("currency mismatch")
+)
+
+// ParseCurrency validates the code; lower case codes are accepted.
+func ParseCurrency(code string) (Currency, error) {
+	c := Currency(strings.ToUpper(strings.TrimSpace(code)))
+	if _, ok := minorUnits[c]; !ok {
+		return "", fmt.Errorf("%w: %q", ErrUnknownCurrency, code)
+	}
+	return c, nil
+}
+
+func (c Currency) String() string {
+	return string(c)
+}
+
+// MinorUnits returns the number of digits after the decimal point.
+func (c Currency) MinorUnits() int {
+	return minorUnits[c]
+}
+
+func (c Currency) factor() int64 {
+	f := int64(1)
+	for i := 0; i < c.MinorUnits(); i++ {
+		f *= 10
+	}
+	return f
+}
+
+// Money is an amount in minor units, e.g. kopecks or cents.
+type Money struct {
+	Amount   int64
+	Currency Currency
+}
+
+func New(amount int64, currency Currency) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// FromMajor converts whole units, e.g. rubles, to Money.
+func FromMajor(units int64, currency Currency) Money {
+	return Money{Amount: units * currency.factor(), Currency: currency}
+}
+
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+func (m Money) Neg() Money {
+	return Money{Amount: -m.Amount, Currency: m.Currency}
+}
+
+// Add returns ErrCurrencyMismatch for amounts in different currencies.
+func (m Money) Add(other Money) (Money, error) {
+	if m.Currency != other.Currency {
+		return Money{}, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.Currency, other.Currency)
+	}
+	return Money{Amount: m.Amount + other.Amount, Currency: m.Currency}, nil
+}
+
+// Mul multiplies the amount by a whole number, e.g. a lesson price by the number of lessons.
+func (m Money) Mul(n int64) Money {
+	return Money{Amount: m.Amount * n, Currency: m.Currency}
+}
+
+// Percent returns p percent of the amount rounded half away from zero.
+func (m Money) Percent(p int64) Money {
+	v := m.Amount * p
+	q := v / 100
+	if r := v % 100; r >= 50 {
+		q++
+	} else if r <= -50 {
+		q--
+	}
+	return Money{Amount: q, Currency: m.Currency}
+}
+
+// Major formats the amount in major units with all minor digits, e.g. "1500.00".
+func (m Money) Major() string {
+	digits := m.Currency.MinorUnits()
+	if digits == 0 {
+		return strconv.FormatInt(m.Amount, 10)
+	}
+	sign := ""
+	amount := m.Amount
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+	f := m.Currency.factor()
+	return fmt.Sprintf("%s%d.%0*d", sign, amount/f, digits, amount%f)
+}
+
+// String formats the amount like "1500.00 RUB".
+func (m Money) String() string {
+	return m.Major() + " " + string(m.Currency)
+}
+
+// ParseMajor parses an amount in major units like "1500" or "1500.5".
+func ParseMajor(value string, currency Currency) (Money, error) {
+	value = strings.TrimSpace(value)
+	negative := strings.HasPrefix(value, "-")
+	units, fraction, _ := strings.Cut(strings.TrimPrefix(value, "-"), ".")
+	digits := currency.MinorUnits()
+	if units == "" || len(fraction) > digits {
+		return Money{}, fmt.Errorf("invalid %s amount %q", currency, value)
+	}
+
+	whole, err := strconv.ParseInt(units, 10, 64)
+	if err != nil {
+		return Money{}, fmt.Errorf("invalid %s amount %q", currency, value)
+	}
+	var minor int64
+	if fraction != "" {
+		minor, err = strconv.ParseInt(fraction+strings.Repeat("0", digits-len(fraction)), 10, 64)
+		if err != nil || minor < 0 {
+			return Money{}, fmt.Errorf("invalid %s amount %q", currency, value)
+		}
+	}
+
+	amount := whole*currency.factor() + minor
+	if negative {
+		amount = -amount
+	}
+	return Money{Amount: amount, Currency: currency}, nil
+}
+
+// Totals sums amounts per currency, amounts in different currencies are never added together.
+type Totals map[Currency]int64
+
+func (t Totals) Add(m Money) {
+	t[m.Currency] += m.Amount
+}
+
+// List returns the totals sorted by currency code.
+func (t Totals) List() []Money {
+	list := make([]Money, 0, len(t))
+	for c, amount := range t {
+		list = append(list, Money{Amount: amount, Currency: c})
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Currency < list[j].Currency })
+	return list
+}
@@ -8,8 +8,10 @@ import (
 	"fileservice/internal/data"
 	"fileservice/internal/db"
 	"fileservice/internal/handler"
+	"fileservice/internal/preview"
 	"fileservice/internal/s3_client"
 	"fileservice/internal/service"
+	"fileservice/internal/storage"
 	pb "fileservice/pkg/api"
 	"fmt"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
@@ -47,18 +49,28 @@ func main() {
 
 	repo := data.NewFileRepository(database)
 
-	s3Client, err := s3_client.New(ctx, cfg)
+	store, err := newFileStore(ctx, cfg)
 	if err != nil {
-		logger.Fatal(ctx, "cannot create S3 client", zap.Error(err))
+		logger.Fatal(ctx, "cannot create file store", zap.Error(err))
 	}
 
-	fileService, err := service.NewFileService(ctx, repo, s3Client, "user-files", cfg.GatewayPublicUrl, cfg.S3Endpoint)
-	if err != nil {
-		logger.Fatal(ctx, "cannot create fileService", zap.Error(err))
-	}
+	fileService := service.NewFileService(repo, store, cfg.GCGracePeriod, preview.NewGenerator(cfg.PdftoppmPath, cfg.CwebpPath))
 
 	fileHandler := handler.NewFileHandler(fileService)
 
+	if cfg.GCEnabled {
+		gcWorker := NewGarbageCollectionWorker(fileService, logger, cfg.GCInterval, cfg.GCDryRun)
+		go gcWorker.Start(ctx)
+	}
+
+	if cfg.DerivativesEnabled {
+		derivativesWorker := NewDerivativesWorker(fileService, logger, cfg.DerivativesInterval)
+		go derivativesWorker.Start(ctx)
+	}
+
+	multipartWorker := NewMultipartCleanupWorker(fileService, logger, cfg.MultipartCleanupInterval, cfg.MultipartUploadTTL)
+	go multipartWorker.Start(ctx)
+
 	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.GRPCPort))
 	if err != nil {
 		logger.Fatal(ctx, "cannot create listener", zap.Error(err))
@@ -86,3 +98,18 @@ func main() {
 		logger.Info(ctx, "Server Stopped")
 	}
 }
+
+func newFileStore(ctx context.Context, cfg *config.Config) (storage.FileStore, error) {
+	switch cfg.StorageBackend {
+	case "s3":
+		s3Client, err := s3_client.New(ctx, cfg)
+		if err != nil {
+			return nil, err
+		}
+		return storage.NewS3Store(ctx, s3Client, "user-files", cfg.S3Endpoint, cfg.GatewayPublicUrl)
+	case "local":
+		return storage.NewLocalStore(cfg.LocalStorageDir, cfg.StorageSigningKey, cfg.GatewayPublicUrl)
+	default:
+		return nil, fmt.Errorf("unknown storage backend %q", cfg.StorageBackend)
+	}
+}
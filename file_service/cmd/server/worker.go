@@ -0,0 +1,135 @@
+package main
+
+import (
+	"common_library/ctxdata"
+	"common_library/logging"
+	"context"
+	"fileservice/internal/service"
+	"go.uber.org/zap"
+	"time"
+)
+
+type GarbageCollectionWorker struct {
+	fileService *service.FileService
+	logger      *logging.Logger
+	interval    time.Duration
+	dryRun      bool
+}
+
+func NewGarbageCollectionWorker(fileService *service.FileService, logger *logging.Logger, interval time.Duration, dryRun bool) *GarbageCollectionWorker {
+	return &GarbageCollectionWorker{
+		fileService: fileService,
+		logger:      logger,
+		interval:    interval,
+		dryRun:      dryRun,
+	}
+}
+
+func (w *GarbageCollectionWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info(ctx, "Garbage collection worker stopped")
+			return
+		case <-ticker.C:
+			w.collect(ctx)
+		}
+	}
+}
+
+func (w *GarbageCollectionWorker) collect(ctx context.Context) {
+	files, err := w.fileService.CollectGarbage(ctxdata.WithCallerService(ctx, "file_service"), w.dryRun)
+	if err != nil {
+		w.logger.Error(ctx, "Garbage collection failed", zap.Error(err))
+		return
+	}
+
+	w.logger.Info(ctx, "Garbage collection finished", zap.Int("files", len(files)), zap.Bool("dry_run", w.dryRun))
+}
+
+// MultipartCleanupWorker aborts multipart uploads that were started but never completed.
+type MultipartCleanupWorker struct {
+	fileService *service.FileService
+	logger      *logging.Logger
+	interval    time.Duration
+	ttl         time.Duration
+}
+
+func NewMultipartCleanupWorker(fileService *service.FileService, logger *logging.Logger, interval time.Duration, ttl time.Duration) *MultipartCleanupWorker {
+	return &MultipartCleanupWorker{
+		fileService: fileService,
+		logger:      logger,
+		interval:    interval,
+		ttl:         ttl,
+	}
+}
+
+func (w *MultipartCleanupWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info(ctx, "Multipart cleanup worker stopped")
+			return
+		case <-ticker.C:
+			w.cleanup(ctx)
+		}
+	}
+}
+
+func (w *MultipartCleanupWorker) cleanup(ctx context.Context) {
+	aborted, err := w.fileService.AbortExpiredMultipartUploads(ctx, w.ttl)
+	if err != nil {
+		w.logger.Error(ctx, "Multipart cleanup failed", zap.Error(err))
+		return
+	}
+
+	w.logger.Info(ctx, "Multipart cleanup finished", zap.Int("aborted", aborted))
+}
+
+// DerivativesWorker generates thumbnails and previews of uploaded files.
+type DerivativesWorker struct {
+	fileService *service.FileService
+	logger      *logging.Logger
+	interval    time.Duration
+}
+
+func NewDerivativesWorker(fileService *service.FileService, logger *logging.Logger, interval time.Duration) *DerivativesWorker {
+	return &DerivativesWorker{
+		fileService: fileService,
+		logger:      logger,
+		interval:    interval,
+	}
+}
+
+func (w *DerivativesWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info(ctx, "Derivatives worker stopped")
+			return
+		case <-ticker.C:
+			w.generate(ctx)
+		}
+	}
+}
+
+func (w *DerivativesWorker) generate(ctx context.Context) {
+	processed, err := w.fileService.GenerateDerivatives(ctx)
+	if err != nil {
+		w.logger.Error(ctx, "Derivatives generation failed", zap.Error(err))
+		return
+	}
+
+	if processed > 0 {
+		w.logger.Info(ctx, "Derivatives generation finished", zap.Int("files", processed))
+	}
+}
@@ -2,10 +2,13 @@ package data
 
 import (
 	"context"
+	"fileservice/internal/errdefs"
 	"fileservice/internal/model"
 	"github.com/georgysavva/scany/v2/pgxscan"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"time"
 )
 
 type FileRepository struct {
@@ -46,8 +49,279 @@ WHERE id = $1
 	var file model.File
 	err := pgxscan.Get(ctx, r.db, &file, query, fileId)
 	if err != nil {
-		return nil, err
+		return nil, handleError(err)
 	}
 
 	return &file, nil
 }
+
+func (r *FileRepository) CreateFileReference(ctx context.Context, input *model.FileUsageInput) error {
+	query := `
+INSERT INTO file_references (file_id, owner_type, owner_id)
+VALUES ($1, $2, $3)
+ON CONFLICT DO NOTHING
+`
+	_, err := r.db.Exec(ctx, query, input.FileId, input.OwnerType, input.OwnerId)
+	if err != nil {
+		return handleError(err)
+	}
+	return nil
+}
+
+// DeleteFileReferences removes references of the owner and marks released files.
+// If input.FileId is uuid.Nil all references of the owner are removed.
+func (r *FileRepository) DeleteFileReferences(ctx context.Context, input *model.FileUsageInput) error {
+	query := `
+WITH deleted AS (
+    DELETE FROM file_references
+    WHERE owner_type = $1 AND owner_id = $2 AND ($3::uuid IS NULL OR file_id = $3)
+    RETURNING file_id
+)
+UPDATE files SET released_at = now()
+WHERE id IN (SELECT file_id FROM deleted)
+`
+	var fileId *uuid.UUID
+	if input.FileId != uuid.Nil {
+		fileId = &input.FileId
+	}
+	_, err := r.db.Exec(ctx, query, input.OwnerType, input.OwnerId, fileId)
+	if err != nil {
+		return handleError(err)
+	}
+	return nil
+}
+
+// ListUnreferencedFiles returns files that have had no references for longer than gracePeriod.
+// Files uploaded before usage tracking are returned only after they were released.
+func (r *FileRepository) ListUnreferencedFiles(ctx context.Context, gracePeriod time.Duration, limit int) ([]*model.File, error) {
+	query := `
+SELECT f.id, f.extension, f.uploaded_by, f.filename, f.created_at
+FROM files f
+WHERE COALESCE(f.released_at, f.created_at) < now() - $1::interval
+  AND (f.usage_tracked OR f.released_at IS NOT NULL)
+  AND NOT EXISTS (SELECT 1 FROM file_references r WHERE r.file_id = f.id)
+  AND NOT EXISTS (SELECT 1 FROM multipart_uploads m WHERE m.file_id = f.id)
+ORDER BY f.created_at
+LIMIT $2
+`
+	var files []*model.File
+	err := pgxscan.Select(ctx, r.db, &files, query, gracePeriod, limit)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return files, nil
+}
+
+// DeleteUnreferencedFile deletes the file row unless it got referenced in the meantime.
+// deleteObjects runs while the deleted row is locked, so the file cannot get referenced
+// before its objects are gone. If deleteObjects fails, the row is kept.
+func (r *FileRepository) DeleteUnreferencedFile(ctx context.Context, fileId uuid.UUID, deleteObjects func(ctx context.Context) error) error {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return handleError(err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+DELETE FROM files f
+WHERE f.id = $1
+  AND NOT EXISTS (SELECT 1 FROM file_references r WHERE r.file_id = f.id)
+`
+	tag, err := tx.Exec(ctx, query, fileId)
+	if err != nil {
+		return handleError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return errdefs.ErrNotFound
+	}
+
+	if err := deleteObjects(ctx); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return handleError(err)
+	}
+	return nil
+}
+
+func (r *FileRepository) CreateFileGrants(ctx context.Context, grantedBy uuid.UUID, input *model.FileAccessInput) error {
+	query := `
+INSERT INTO file_grants (file_id, user_id, granted_by)
+SELECT $1, user_id, $2 FROM unnest($3::uuid[]) AS user_id
+ON CONFLICT DO NOTHING
+`
+	_, err := r.db.Exec(ctx, query, input.FileId, grantedBy, input.UserIds)
+	if err != nil {
+		return handleError(err)
+	}
+	return nil
+}
+
+func (r *FileRepository) DeleteFileGrants(ctx context.Context, input *model.FileAccessInput) error {
+	query := `
+DELETE FROM file_grants
+WHERE file_id = $1 AND user_id = ANY($2::uuid[])
+`
+	_, err := r.db.Exec(ctx, query, input.FileId, input.UserIds)
+	if err != nil {
+		return handleError(err)
+	}
+	return nil
+}
+
+func (r *FileRepository) HasFileGrant(ctx context.Context, fileId uuid.UUID, userId uuid.UUID) (bool, error) {
+	query := `SELECT EXISTS (SELECT 1 FROM file_grants WHERE file_id = $1 AND user_id = $2)`
+	var exists bool
+	err := r.db.QueryRow(ctx, query, fileId, userId).Scan(&exists)
+	if err != nil {
+		return false, handleError(err)
+	}
+	return exists, nil
+}
+
+func (r *FileRepository) CreateMultipartUpload(ctx context.Context, fileId uuid.UUID, uploadId string, partCount int32) error {
+	query := `INSERT INTO multipart_uploads (file_id, upload_id, part_count) VALUES ($1, $2, $3)`
+	_, err := r.db.Exec(ctx, query, fileId, uploadId, partCount)
+	if err != nil {
+		return handleError(err)
+	}
+	return nil
+}
+
+func (r *FileRepository) GetMultipartUpload(ctx context.Context, fileId uuid.UUID) (*model.MultipartUpload, error) {
+	query := `
+SELECT m.file_id, m.upload_id, m.part_count, f.extension, m.created_at
+FROM multipart_uploads m
+JOIN files f ON f.id = m.file_id
+WHERE m.file_id = $1
+`
+	var upload model.MultipartUpload
+	err := pgxscan.Get(ctx, r.db, &upload, query, fileId)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return &upload, nil
+}
+
+func (r *FileRepository) DeleteMultipartUpload(ctx context.Context, fileId uuid.UUID) error {
+	query := `DELETE FROM multipart_uploads WHERE file_id = $1`
+	tag, err := r.db.Exec(ctx, query, fileId)
+	if err != nil {
+		return handleError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return errdefs.ErrNotFound
+	}
+	return nil
+}
+
+// ListExpiredMultipartUploads returns multipart uploads started more than ttl ago.
+func (r *FileRepository) ListExpiredMultipartUploads(ctx context.Context, ttl time.Duration, limit int) ([]*model.MultipartUpload, error) {
+	query := `
+SELECT m.file_id, m.upload_id, m.part_count, f.extension, m.created_at
+FROM multipart_uploads m
+JOIN files f ON f.id = m.file_id
+WHERE m.created_at < now() - $1::interval
+ORDER BY m.created_at
+LIMIT $2
+`
+	var uploads []*model.MultipartUpload
+	err := pgxscan.Select(ctx, r.db, &uploads, query, ttl, limit)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return uploads, nil
+}
+
+// ListFilesPendingDerivatives returns recent files with one of the extensions whose derivatives were not generated yet.
+func (r *FileRepository) ListFilesPendingDerivatives(ctx context.Context, extensions []string, maxAge time.Duration, limit int) ([]*model.File, error) {
+	query := `
+SELECT f.id, f.extension, f.uploaded_by, f.filename, f.created_at
+FROM files f
+WHERE f.derivatives_processed_at IS NULL
+  AND lower(f.extension) = ANY($1)
+  AND f.created_at > now() - $2::interval
+  AND NOT EXISTS (SELECT 1 FROM multipart_uploads m WHERE m.file_id = f.id)
+ORDER BY f.created_at
+LIMIT $3
+`
+	var files []*model.File
+	err := pgxscan.Select(ctx, r.db, &files, query, extensions, maxAge, limit)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return files, nil
+}
+
+func (r *FileRepository) MarkDerivativesProcessed(ctx context.Context, fileId uuid.UUID) error {
+	query := `UPDATE files SET derivatives_processed_at = now() WHERE id = $1`
+	_, err := r.db.Exec(ctx, query, fileId)
+	if err != nil {
+		return handleError(err)
+	}
+	return nil
+}
+
+func (r *FileRepository) CreateFileDerivative(ctx context.Context, derivative *model.FileDerivative) error {
+	query := `
+INSERT INTO file_derivatives (file_id, variant, extension)
+VALUES ($1, $2, $3)
+ON CONFLICT (file_id, variant) DO UPDATE SET extension = EXCLUDED.extension, created_at = now()
+`
+	_, err := r.db.Exec(ctx, query, derivative.FileId, derivative.Variant, derivative.Extension)
+	if err != nil {
+		return handleError(err)
+	}
+	return nil
+}
+
+func (r *FileRepository) GetFileDerivative(ctx context.Context, fileId uuid.UUID, variant string) (*model.FileDerivative, error) {
+	query := `
+SELECT file_id, variant, extension, created_at
+FROM file_derivatives
+WHERE file_id = $1 AND variant = $2
+`
+	var derivative model.FileDerivative
+	err := pgxscan.Get(ctx, r.db, &derivative, query, fileId, variant)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return &derivative, nil
+}
+
+func (r *FileRepository) ListFileDerivatives(ctx context.Context, fileId uuid.UUID) ([]*model.FileDerivative, error) {
+	query := `
+SELECT file_id, variant, extension, created_at
+FROM file_derivatives
+WHERE file_id = $1
+`
+	var derivatives []*model.FileDerivative
+	err := pgxscan.Select(ctx, r.db, &derivatives, query, fileId)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return derivatives, nil
+}
+
+// ForgetUser deletes grants to the user and filenames of files uploaded by the user.
+// The files themselves are deleted by the garbage collector once services release them.
+func (r *FileRepository) ForgetUser(ctx context.Context, userId uuid.UUID) error {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return handleError(err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `DELETE FROM file_grants WHERE user_id = $1`, userId); err != nil {
+		return handleError(err)
+	}
+	if _, err := tx.Exec(ctx, `UPDATE files SET filename = NULL WHERE uploaded_by = $1`, userId); err != nil {
+		return handleError(err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return handleError(err)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"github.com/golang-migrate/migrate/v4"
+	_ "github.com/golang-migrate/migrate/v4/database/postgres"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"testing"
+	"time"
+)
+
+// newTestRepository migrates the database of TEST_POSTGRES_URL and empties its files.
+func newTestRepository(t *testing.T) (*FileRepository, *pgxpool.Pool) {
+	url := os.Getenv("TEST_POSTGRES_URL")
+	if url == "" {
+		t.Skip("TEST_POSTGRES_URL is not set")
+	}
+
+	m, err := migrate.New("file://../../migrations", url)
+	require.NoError(t, err)
+	if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		require.NoError(t, err)
+	}
+
+	pool, err := pgxpool.New(context.Background(), url)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	_, err = pool.Exec(context.Background(), `TRUNCATE files CASCADE`)
+	require.NoError(t, err)
+
+	return NewFileRepository(pool), pool
+}
+
+func insertTestFile(t *testing.T, pool *pgxpool.Pool, createdAt time.Time, releasedAt *time.Time, usageTracked bool) uuid.UUID {
+	id := uuid.New()
+	_, err := pool.Exec(context.Background(), `
+INSERT INTO files (id, extension, uploaded_by, created_at, released_at, usage_tracked)
+VALUES ($1, '.pdf', $2, $3, $4, $5)
+`, id, uuid.New(), createdAt, releasedAt, usageTracked)
+	require.NoError(t, err)
+	return id
+}
+
+func TestListUnreferencedFiles(t *testing.T) {
+	repo, pool := newTestRepository(t)
+	ctx := context.Background()
+	old := time.Now().Add(-48 * time.Hour)
+	recent := time.Now().Add(-time.Minute)
+
+	abandoned := insertTestFile(t, pool, old, nil, true)
+	released := insertTestFile(t, pool, old, &old, true)
+	legacyReleased := insertTestFile(t, pool, old, &old, false)
+
+	legacy := insertTestFile(t, pool, old, nil, false)
+	fresh := insertTestFile(t, pool, recent, nil, true)
+	releasedRecently := insertTestFile(t, pool, old, &recent, true)
+	referenced := insertTestFile(t, pool, old, nil, true)
+	_, err := pool.Exec(ctx, `INSERT INTO file_references (file_id, owner_type, owner_id) VALUES ($1, 'assignment', $2)`, referenced, uuid.New())
+	require.NoError(t, err)
+	uploading := insertTestFile(t, pool, old, nil, true)
+	_, err = pool.Exec(ctx, `INSERT INTO multipart_uploads (file_id, upload_id, part_count) VALUES ($1, 'upload', 1)`, uploading)
+	require.NoError(t, err)
+
+	files, err := repo.ListUnreferencedFiles(ctx, 24*time.Hour, 100)
+	require.NoError(t, err)
+
+	ids := make([]uuid.UUID, 0, len(files))
+	for _, file := range files {
+		ids = append(ids, file.Id)
+	}
+	assert.ElementsMatch(t, []uuid.UUID{abandoned, released, legacyReleased}, ids)
+	assert.NotContains(t, ids, legacy)
+	assert.NotContains(t, ids, fresh)
+	assert.NotContains(t, ids, releasedRecently)
+}
@@ -15,8 +15,20 @@ import (
 
 type FileService interface {
 	InitUpload(ctx context.Context, input *model.InitUploadInput) (*model.InitUpload, error)
-	GenerateDownloadURL(ctx context.Context, fileId uuid.UUID) (string, error)
+	UploadFile(ctx context.Context, input *model.UploadFileInput) (*model.File, error)
+	InitMultipartUpload(ctx context.Context, input *model.InitMultipartUploadInput) (*model.InitMultipartUpload, error)
+	GenerateUploadPartURLs(ctx context.Context, input *model.GenerateUploadPartURLsInput) (*model.UploadPartURLs, error)
+	CompleteMultipartUpload(ctx context.Context, fileId uuid.UUID) (*model.File, error)
+	AbortMultipartUpload(ctx context.Context, fileId uuid.UUID) error
+	GenerateDownloadURL(ctx context.Context, fileId uuid.UUID, variant string) (string, error)
 	GetFileMeta(ctx context.Context, fileId uuid.UUID) (*model.File, error)
+	RegisterFileUsage(ctx context.Context, input *model.FileUsageInput) error
+	UnregisterFileUsage(ctx context.Context, input *model.FileUsageInput) error
+	CollectGarbage(ctx context.Context, dryRun bool) ([]*model.File, error)
+	GrantFileAccess(ctx context.Context, input *model.FileAccessInput) error
+	RevokeFileAccess(ctx context.Context, input *model.FileAccessInput) error
+	CreateArchive(ctx context.Context, input *model.CreateArchiveInput) (*model.File, error)
+	ForgetUser(ctx context.Context, userId uuid.UUID) error
 }
 
 type FileHandler struct {
@@ -29,7 +41,7 @@ func NewFileHandler(fileService FileService) *FileHandler {
 }
 
 func (h *FileHandler) InitUpload(ctx context.Context, req *pb.InitUploadRequest) (*pb.InitUploadResponse, error) {
-	userId, err := uuid.Parse(req.UploadedBy)
+	userId, err := parseUploadedBy(req.UploadedBy)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
@@ -41,21 +53,109 @@ func (h *FileHandler) InitUpload(ctx context.Context, req *pb.InitUploadRequest)
 
 	resp, err := h.fileService.InitUpload(ctx, input)
 	if err != nil {
-		return nil, mapError(err, errdefs.ValidationErr)
+		return nil, mapError(err, errdefs.ValidationErr, errdefs.AuthenticationErr)
 	}
 
 	return toPbInitUpload(resp), nil
 }
 
+func (h *FileHandler) UploadFile(ctx context.Context, req *pb.UploadFileRequest) (*pb.File, error) {
+	userId, err := parseUploadedBy(req.UploadedBy)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	input := &model.UploadFileInput{
+		UploadedBy:  userId,
+		Filename:    req.Filename,
+		Content:     req.Content,
+		ContentType: req.ContentType,
+	}
+
+	file, err := h.fileService.UploadFile(ctx, input)
+	if err != nil {
+		return nil, mapError(err, errdefs.ValidationErr, errdefs.AuthenticationErr)
+	}
+
+	return toPbFile(file), nil
+}
+
+func (h *FileHandler) InitMultipartUpload(ctx context.Context, req *pb.InitMultipartUploadRequest) (*pb.InitMultipartUploadResponse, error) {
+	input := &model.InitMultipartUploadInput{
+		Filename:  req.Filename,
+		PartCount: req.PartCount,
+	}
+
+	resp, err := h.fileService.InitMultipartUpload(ctx, input)
+	if err != nil {
+		return nil, mapError(err, errdefs.ValidationErr, errdefs.AuthenticationErr)
+	}
+
+	return &pb.InitMultipartUploadResponse{
+		FileId: resp.FileId.String(),
+		Parts:  toPbUploadPartURLs(resp.Parts),
+	}, nil
+}
+
+func (h *FileHandler) GenerateUploadPartURLs(ctx context.Context, req *pb.GenerateUploadPartURLsRequest) (*pb.UploadPartURLs, error) {
+	id, err := uuid.Parse(req.FileId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	input := &model.GenerateUploadPartURLsInput{
+		FileId:      id,
+		PartNumbers: req.PartNumbers,
+	}
+
+	resp, err := h.fileService.GenerateUploadPartURLs(ctx, input)
+	if err != nil {
+		return nil, mapError(err, errdefs.ValidationErr, errdefs.ErrNotFound, errdefs.ErrPermissionDenied)
+	}
+
+	return &pb.UploadPartURLs{
+		Parts:               toPbUploadPartURLs(resp.Parts),
+		UploadedPartNumbers: resp.UploadedPartNumbers,
+	}, nil
+}
+
+func (h *FileHandler) CompleteMultipartUpload(ctx context.Context, req *pb.MultipartUploadRequest) (*pb.File, error) {
+	id, err := uuid.Parse(req.FileId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := h.fileService.CompleteMultipartUpload(ctx, id)
+	if err != nil {
+		return nil, mapError(err, errdefs.ValidationErr, errdefs.ErrNotFound, errdefs.ErrPermissionDenied)
+	}
+
+	return toPbFile(resp), nil
+}
+
+func (h *FileHandler) AbortMultipartUpload(ctx context.Context, req *pb.MultipartUploadRequest) (*pb.Empty, error) {
+	id, err := uuid.Parse(req.FileId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = h.fileService.AbortMultipartUpload(ctx, id)
+	if err != nil {
+		return nil, mapError(err, errdefs.ErrNotFound, errdefs.ErrPermissionDenied)
+	}
+
+	return &pb.Empty{}, nil
+}
+
 func (h *FileHandler) GenerateDownloadURL(ctx context.Context, req *pb.GenerateDownloadURLRequest) (*pb.DownloadURL, error) {
 	id, err := uuid.Parse(req.FileId)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	resp, err := h.fileService.GenerateDownloadURL(ctx, id)
+	resp, err := h.fileService.GenerateDownloadURL(ctx, id, req.GetVariant())
 	if err != nil {
-		return nil, mapError(err, errdefs.ErrNotFound)
+		return nil, mapError(err, errdefs.ValidationErr, errdefs.ErrNotFound, errdefs.ErrPermissionDenied)
 	}
 
 	return &pb.DownloadURL{Url: resp}, nil
@@ -75,6 +175,174 @@ func (h *FileHandler) GetFileMeta(ctx context.Context, req *pb.GetFileMetaReques
 	return toPbFile(resp), nil
 }
 
+func (h *FileHandler) RegisterFileUsage(ctx context.Context, req *pb.FileUsage) (*pb.Empty, error) {
+	input, err := toFileUsageInput(req, true)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = h.fileService.RegisterFileUsage(ctx, input)
+	if err != nil {
+		return nil, mapError(err, errdefs.ValidationErr, errdefs.ErrNotFound, errdefs.ErrPermissionDenied)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (h *FileHandler) UnregisterFileUsage(ctx context.Context, req *pb.FileUsage) (*pb.Empty, error) {
+	input, err := toFileUsageInput(req, false)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = h.fileService.UnregisterFileUsage(ctx, input)
+	if err != nil {
+		return nil, mapError(err, errdefs.ValidationErr, errdefs.ErrPermissionDenied)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (h *FileHandler) CollectGarbage(ctx context.Context, req *pb.CollectGarbageRequest) (*pb.CollectGarbageResponse, error) {
+	files, err := h.fileService.CollectGarbage(ctx, req.DryRun)
+	if err != nil {
+		return nil, mapError(err, errdefs.ErrPermissionDenied)
+	}
+
+	resp := &pb.CollectGarbageResponse{
+		Files:  make([]*pb.File, len(files)),
+		DryRun: req.DryRun,
+	}
+	for i, file := range files {
+		resp.Files[i] = toPbFile(file)
+	}
+
+	return resp, nil
+}
+
+func (h *FileHandler) GrantFileAccess(ctx context.Context, req *pb.FileAccessRequest) (*pb.Empty, error) {
+	input, err := toFileAccessInput(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = h.fileService.GrantFileAccess(ctx, input)
+	if err != nil {
+		return nil, mapError(err, errdefs.ErrNotFound, errdefs.ErrPermissionDenied)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (h *FileHandler) RevokeFileAccess(ctx context.Context, req *pb.FileAccessRequest) (*pb.Empty, error) {
+	input, err := toFileAccessInput(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = h.fileService.RevokeFileAccess(ctx, input)
+	if err != nil {
+		return nil, mapError(err, errdefs.ErrNotFound, errdefs.ErrPermissionDenied)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (h *FileHandler) CreateArchive(ctx context.Context, req *pb.CreateArchiveRequest) (*pb.File, error) {
+	input, err := toCreateArchiveInput(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	file, err := h.fileService.CreateArchive(ctx, input)
+	if err != nil {
+		return nil, mapError(err, errdefs.ValidationErr, errdefs.ErrPermissionDenied)
+	}
+
+	return toPbFile(file), nil
+}
+
+func (h *FileHandler) ForgetUser(ctx context.Context, req *pb.ForgetUserRequest) (*pb.Empty, error) {
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = h.fileService.ForgetUser(ctx, userId)
+	if err != nil {
+		return nil, mapError(err, errdefs.ErrPermissionDenied)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func toCreateArchiveInput(req *pb.CreateArchiveRequest) (*model.CreateArchiveInput, error) {
+	ownerId, err := uuid.Parse(req.OwnerId)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &model.CreateArchiveInput{
+		OwnerId:  ownerId,
+		Filename: req.Filename,
+		Entries:  make([]*model.ArchiveEntry, len(req.Entries)),
+		Files:    make([]*model.ArchiveFile, len(req.Files)),
+	}
+	for i, entry := range req.Entries {
+		input.Entries[i] = &model.ArchiveEntry{Name: entry.Name, Content: entry.Content}
+	}
+	for i, file := range req.Files {
+		fileId, err := uuid.Parse(file.FileId)
+		if err != nil {
+			return nil, err
+		}
+		input.Files[i] = &model.ArchiveFile{FileId: fileId, Name: file.Name}
+	}
+
+	return input, nil
+}
+
+func toFileAccessInput(req *pb.FileAccessRequest) (*model.FileAccessInput, error) {
+	fileId, err := uuid.Parse(req.FileId)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &model.FileAccessInput{
+		FileId:  fileId,
+		UserIds: make([]uuid.UUID, len(req.UserIds)),
+	}
+	for i, userId := range req.UserIds {
+		input.UserIds[i], err = uuid.Parse(userId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return input, nil
+}
+
+func toFileUsageInput(req *pb.FileUsage, fileIdRequired bool) (*model.FileUsageInput, error) {
+	ownerId, err := uuid.Parse(req.OwnerId)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &model.FileUsageInput{
+		OwnerType: req.OwnerType,
+		OwnerId:   ownerId,
+	}
+
+	if req.FileId != "" || fileIdRequired {
+		input.FileId, err = uuid.Parse(req.FileId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return input, nil
+}
+
 func toPbInitUpload(init *model.InitUpload) *pb.InitUploadResponse {
 	return &pb.InitUploadResponse{
 		FileId:    init.FileId.String(),
@@ -83,6 +351,18 @@ func toPbInitUpload(init *model.InitUpload) *pb.InitUploadResponse {
 	}
 }
 
+func toPbUploadPartURLs(parts []*model.UploadPartURL) []*pb.UploadPartURL {
+	res := make([]*pb.UploadPartURL, len(parts))
+	for i, part := range parts {
+		res[i] = &pb.UploadPartURL{
+			PartNumber: part.PartNumber,
+			UploadUrl:  part.URL,
+			Method:     part.Method,
+		}
+	}
+	return res
+}
+
 func toPbFile(file *model.File) *pb.File {
 	return &pb.File{
 		Id:         file.Id.String(),
@@ -93,27 +373,35 @@ func toPbFile(file *model.File) *pb.File {
 	}
 }
 
+// parseUploadedBy parses the optional uploaded_by, only services set it.
+func parseUploadedBy(uploadedBy string) (uuid.UUID, error) {
+	if uploadedBy == "" {
+		return uuid.Nil, nil
+	}
+	return uuid.Parse(uploadedBy)
+}
+
 func mapError(err error, possibleErrors ...error) error {
 	switch {
 	case err == nil:
 		return nil
 
 	case errors.Is(err, errdefs.ErrAlreadyExists) && slices.Contains(possibleErrors, errdefs.ErrAlreadyExists):
-		return status.Errorf(codes.AlreadyExists, err.Error())
+		return status.Error(codes.AlreadyExists, err.Error())
 
 	case errors.Is(err, errdefs.ValidationErr) && slices.Contains(possibleErrors, errdefs.ValidationErr):
-		return status.Errorf(codes.InvalidArgument, err.Error())
+		return status.Error(codes.InvalidArgument, err.Error())
 
 	case errors.Is(err, errdefs.AuthenticationErr) && slices.Contains(possibleErrors, errdefs.AuthenticationErr):
-		return status.Errorf(codes.Unauthenticated, err.Error())
+		return status.Error(codes.Unauthenticated, err.Error())
 
 	case errors.Is(err, errdefs.ErrNotFound) && slices.Contains(possibleErrors, errdefs.ErrNotFound):
-		return status.Errorf(codes.NotFound, err.Error())
+		return status.Error(codes.NotFound, err.Error())
 
 	case errors.Is(err, errdefs.ErrPermissionDenied) && slices.Contains(possibleErrors, errdefs.ErrPermissionDenied):
-		return status.Errorf(codes.PermissionDenied, err.Error())
+		return status.Error(codes.PermissionDenied, err.Error())
 
 	default:
-		return status.Errorf(codes.Internal, err.Error())
+		return status.Error(codes.Internal, err.Error())
 	}
 }
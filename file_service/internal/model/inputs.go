@@ -9,9 +9,58 @@ type InitUploadInput struct {
 	Filename   string
 }
 
+type UploadFileInput struct {
+	UploadedBy  uuid.UUID
+	Filename    string
+	Content     []byte
+	ContentType string
+}
+
 type RepositoryCreateFileInput struct {
 	Id         uuid.UUID
 	Extension  string
 	UploadedBy uuid.UUID
 	Filename   *string
 }
+
+// FileUsageInput describes a reference from an entity of another service to a file.
+// FileId may be uuid.Nil when releasing all files of the owner.
+type FileUsageInput struct {
+	FileId    uuid.UUID
+	OwnerType string
+	OwnerId   uuid.UUID
+}
+
+type FileAccessInput struct {
+	FileId  uuid.UUID
+	UserIds []uuid.UUID
+}
+
+type InitMultipartUploadInput struct {
+	Filename  string
+	PartCount int32
+}
+
+type GenerateUploadPartURLsInput struct {
+	FileId      uuid.UUID
+	PartNumbers []int32
+}
+
+// CreateArchiveInput describes a zip archive: documents generated by the caller and stored files.
+type CreateArchiveInput struct {
+	OwnerId  uuid.UUID
+	Filename string
+	Entries  []*ArchiveEntry
+	Files    []*ArchiveFile
+}
+
+type ArchiveEntry struct {
+	Name    string
+	Content []byte
+}
+
+// ArchiveFile is a stored file to put into an archive, Name is optional.
+type ArchiveFile struct {
+	FileId uuid.UUID
+	Name   *string
+}
@@ -18,3 +18,43 @@ type InitUpload struct {
 	UploadURL string
 	Method    string
 }
+
+type UploadPartURL struct {
+	PartNumber int32
+	URL        string
+	Method     string
+}
+
+type InitMultipartUpload struct {
+	FileId uuid.UUID
+	Parts  []*UploadPartURL
+}
+
+type UploadPartURLs struct {
+	Parts               []*UploadPartURL
+	UploadedPartNumbers []int32
+}
+
+// MultipartUpload is an S3 multipart upload that was started but not yet completed or aborted.
+type MultipartUpload struct {
+	FileId    uuid.UUID `db:"file_id"`
+	UploadId  string    `db:"upload_id"`
+	PartCount int32     `db:"part_count"` // number of parts requested at initiation
+	Extension string    `db:"extension"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// Derivative is a generated thumbnail or preview of a file.
+type Derivative struct {
+	Variant     string
+	Extension   string
+	ContentType string
+	Data        []byte
+}
+
+type FileDerivative struct {
+	FileId    uuid.UUID `db:"file_id"`
+	Variant   string    `db:"variant"`
+	Extension string    `db:"extension"`
+	CreatedAt time.Time `db:"created_at"`
+}
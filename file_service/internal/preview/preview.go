@@ -0,0 +1,256 @@
+package preview
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fileservice/internal/model"
+	"fmt"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	VariantThumbnail     = "thumbnail"
+	VariantThumbnailWebp = "thumbnail_webp"
+	VariantPreview       = "preview"
+	VariantPreviewWebp   = "preview_webp"
+
+	thumbnailSize = 320
+	previewSize   = 1280
+	jpegQuality   = 80
+	webpQuality   = "80"
+
+	// decoded images take 4 bytes per pixel, larger ones are rejected before decoding
+	maxPixels = 40_000_000
+)
+
+var (
+	ErrUnsupported = errors.New("unsupported file type")
+	ErrTooLarge    = errors.New("image dimensions are too large")
+)
+
+var variants = []struct {
+	name    string
+	maxSize int
+	webp    bool
+}{
+	{VariantThumbnail, thumbnailSize, false},
+	{VariantThumbnailWebp, thumbnailSize, true},
+	{VariantPreview, previewSize, false},
+	{VariantPreviewWebp, previewSize, true},
+}
+
+var imageExtensions = map[string]bool{
+	".jpg":  true,
+	".jpeg": true,
+	".png":  true,
+	".gif":  true,
+	".webp": true,
+}
+
+// IsVariant reports whether name is a known derivative variant.
+func IsVariant(name string) bool {
+	for _, v := range variants {
+		if v.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Generator produces resized JPEG and WebP derivatives of images and of the first page of PDFs.
+// PDF rendering and WebP encoding are delegated to the pdftoppm and cwebp binaries.
+type Generator struct {
+	pdftoppmPath string
+	cwebpPath    string
+}
+
+func NewGenerator(pdftoppmPath string, cwebpPath string) *Generator {
+	return &Generator{pdftoppmPath: pdftoppmPath, cwebpPath: cwebpPath}
+}
+
+// Extensions returns lower-case extensions of the files the generator can process.
+func (g *Generator) Extensions() []string {
+	extensions := []string{".pdf"}
+	for extension := range imageExtensions {
+		extensions = append(extensions, extension)
+	}
+	return extensions
+}
+
+// Generate returns all variants that could be produced from src. A variant that fails to encode
+// is skipped, an error is returned only if no variant was produced.
+func (g *Generator) Generate(ctx context.Context, extension string, src io.Reader) ([]*model.Derivative, error) {
+	img, err := g.decode(ctx, strings.ToLower(extension), src)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		derivatives []*model.Derivative
+		errs        []error
+	)
+	for _, v := range variants {
+		resized := resize(img, v.maxSize)
+
+		var derivative *model.Derivative
+		if v.webp {
+			derivative, err = g.encodeWebp(ctx, resized)
+		} else {
+			derivative, err = encodeJpeg(resized)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", v.name, err))
+			continue
+		}
+
+		derivative.Variant = v.name
+		derivatives = append(derivatives, derivative)
+	}
+
+	if len(derivatives) == 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return derivatives, nil
+}
+
+func (g *Generator) decode(ctx context.Context, extension string, src io.Reader) (image.Image, error) {
+	switch {
+	case imageExtensions[extension]:
+		return decodeImage(src)
+	case extension == ".pdf":
+		return g.renderFirstPage(ctx, src)
+	default:
+		return nil, ErrUnsupported
+	}
+}
+
+func (g *Generator) renderFirstPage(ctx context.Context, src io.Reader) (image.Image, error) {
+	dir, err := os.MkdirTemp("", "preview")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	input := filepath.Join(dir, "input.pdf")
+	if err := writeFile(input, src); err != nil {
+		return nil, err
+	}
+
+	output := filepath.Join(dir, "page")
+	cmd := exec.CommandContext(ctx, g.pdftoppmPath,
+		"-f", "1", "-l", "1", "-singlefile", "-png",
+		"-scale-to", fmt.Sprint(previewSize),
+		input, output,
+	)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return nil, fmt.Errorf("pdftoppm: %w: %s", err, out)
+	}
+
+	f, err := os.Open(output + ".png")
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return decodeImage(f)
+}
+
+// decodeImage decodes src after checking the dimensions from its header against maxPixels.
+func decodeImage(src io.Reader) (image.Image, error) {
+	var header bytes.Buffer
+	config, _, err := image.DecodeConfig(io.TeeReader(src, &header))
+	if err != nil {
+		return nil, err
+	}
+	if config.Width <= 0 || config.Height <= 0 || int64(config.Width)*int64(config.Height) > maxPixels {
+		return nil, fmt.Errorf("%w: %dx%d", ErrTooLarge, config.Width, config.Height)
+	}
+
+	img, _, err := image.Decode(io.MultiReader(&header, src))
+	return img, err
+}
+
+func (g *Generator) encodeWebp(ctx context.Context, img image.Image) (*model.Derivative, error) {
+	dir, err := os.MkdirTemp("", "preview")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+
+	input := filepath.Join(dir, "input.png")
+	if err := writeFile(input, &buf); err != nil {
+		return nil, err
+	}
+
+	output := filepath.Join(dir, "output.webp")
+	cmd := exec.CommandContext(ctx, g.cwebpPath, "-quiet", "-q", webpQuality, input, "-o", output)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return nil, fmt.Errorf("cwebp: %w: %s", err, out)
+	}
+
+	data, err := os.ReadFile(output)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.Derivative{Extension: ".webp", ContentType: "image/webp", Data: data}, nil
+}
+
+func encodeJpeg(img image.Image) (*model.Derivative, error) {
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality}); err != nil {
+		return nil, err
+	}
+
+	return &model.Derivative{Extension: ".jpg", ContentType: "image/jpeg", Data: buf.Bytes()}, nil
+}
+
+// resize scales img down so that its longest side does not exceed maxSize. Smaller images are only flattened.
+func resize(img image.Image, maxSize int) image.Image {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width > maxSize || height > maxSize {
+		if width >= height {
+			height = max(1, height*maxSize/width)
+			width = maxSize
+		} else {
+			width = max(1, width*maxSize/height)
+			height = maxSize
+		}
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	// JPEG has no alpha channel: transparent areas become white
+	draw.Draw(dst, dst.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, bounds, draw.Over, nil)
+
+	return dst
+}
+
+func writeFile(name string, src io.Reader) error {
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(f, src)
+	return err
+}
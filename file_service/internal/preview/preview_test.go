@@ -0,0 +1,88 @@
+package preview
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func encodePng(t *testing.T, width int, height int) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	img.Set(0, 0, color.Black)
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+// pngHeader returns a PNG that only has a header of the given dimensions, its pixels would not fit in memory.
+func pngHeader(width uint32, height uint32) []byte {
+	ihdr := make([]byte, 13)
+	binary.BigEndian.PutUint32(ihdr[0:], width)
+	binary.BigEndian.PutUint32(ihdr[4:], height)
+	ihdr[8] = 8 // bit depth
+	ihdr[9] = 6 // RGBA
+
+	var buf bytes.Buffer
+	buf.WriteString("\x89PNG\r\n\x1a\n")
+	_ = binary.Write(&buf, binary.BigEndian, uint32(len(ihdr)))
+	chunk := append([]byte("IHDR"), ihdr...)
+	buf.Write(chunk)
+	_ = binary.Write(&buf, binary.BigEndian, crc32.ChecksumIEEE(chunk))
+	return buf.Bytes()
+}
+
+func TestGenerate_Image(t *testing.T) {
+	g := NewGenerator("pdftoppm", filepath.Join(t.TempDir(), "cwebp"))
+
+	derivatives, err := g.Generate(context.Background(), ".PNG", bytes.NewReader(encodePng(t, 2000, 1000)))
+	require.NoError(t, err)
+
+	// cwebp is missing, only the JPEG variants are produced
+	require.Len(t, derivatives, 2)
+	assert.Equal(t, VariantThumbnail, derivatives[0].Variant)
+	assert.Equal(t, VariantPreview, derivatives[1].Variant)
+
+	preview, _, err := image.DecodeConfig(bytes.NewReader(derivatives[1].Data))
+	require.NoError(t, err)
+	assert.Equal(t, previewSize, preview.Width)
+	assert.Equal(t, previewSize/2, preview.Height)
+}
+
+func TestGenerate_TooLargeImage(t *testing.T) {
+	g := NewGenerator("pdftoppm", "cwebp")
+
+	_, err := g.Generate(context.Background(), ".png", bytes.NewReader(pngHeader(50000, 50000)))
+	assert.ErrorIs(t, err, ErrTooLarge)
+}
+
+func TestGenerate_TooLargePdfPage(t *testing.T) {
+	dir := t.TempDir()
+	page := filepath.Join(dir, "page.png")
+	require.NoError(t, os.WriteFile(page, pngHeader(100000, 1000), 0o600))
+
+	// writes the page to the output prefix passed as the last argument
+	pdftoppm := filepath.Join(dir, "pdftoppm")
+	script := "#!/bin/sh\nfor last; do :; done\ncp " + page + " \"$last.png\"\n"
+	require.NoError(t, os.WriteFile(pdftoppm, []byte(script), 0o700))
+
+	g := NewGenerator(pdftoppm, "cwebp")
+	_, err := g.Generate(context.Background(), ".pdf", bytes.NewReader([]byte("%PDF-1.4")))
+	assert.ErrorIs(t, err, ErrTooLarge)
+}
+
+func TestGenerate_Unsupported(t *testing.T) {
+	g := NewGenerator("pdftoppm", "cwebp")
+
+	_, err := g.Generate(context.Background(), ".docx", bytes.NewReader(nil))
+	assert.ErrorIs(t, err, ErrUnsupported)
+}
@@ -0,0 +1,183 @@
+package service
+
+import (
+	"archive/zip"
+	"common_library/logging"
+	"context"
+	"errors"
+	"fileservice/internal/errdefs"
+	"fileservice/internal/model"
+	"fileservice/internal/storage"
+	"fmt"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"io"
+	"os"
+	"path"
+	"strings"
+)
+
+const (
+	archiveExtension   = ".zip"
+	archiveContentType = "application/zip"
+	// files that cannot be added to the archive are listed in this entry
+	archiveMissingFilesEntry = "missing_files.txt"
+)
+
+// CreateArchive stores a zip archive with the entries and the files the owner has access to.
+// The archive is not used by any entity, so it is collected as garbage after the grace period.
+func (s *FileService) CreateArchive(ctx context.Context, input *model.CreateArchiveInput) (*model.File, error) {
+	userId, ok := callerId(ctx)
+	if !ok || userId != input.OwnerId {
+		return nil, errdefs.ErrPermissionDenied
+	}
+	if path.Ext(input.Filename) != archiveExtension {
+		return nil, fmt.Errorf("invalid archive extension: %w", errdefs.ValidationErr)
+	}
+
+	tmp, err := os.CreateTemp("", "archive-*"+archiveExtension)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	if err := s.writeArchive(ctx, tmp, input); err != nil {
+		return nil, err
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+	file, err := s.fileRepo.CreateFile(ctx, &model.RepositoryCreateFileInput{
+		Id:         id,
+		Extension:  archiveExtension,
+		UploadedBy: input.OwnerId,
+		Filename:   &input.Filename,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.store.Put(ctx, file.Id.String()+file.Extension, tmp, archiveContentType); err != nil {
+		return nil, err
+	}
+
+	return file, nil
+}
+
+// ForgetUser removes what the file service knows about a deleted user. Only the user can call it.
+func (s *FileService) ForgetUser(ctx context.Context, userId uuid.UUID) error {
+	caller, ok := callerId(ctx)
+	if !ok || caller != userId {
+		return errdefs.ErrPermissionDenied
+	}
+	return s.fileRepo.ForgetUser(ctx, userId)
+}
+
+func (s *FileService) writeArchive(ctx context.Context, w io.Writer, input *model.CreateArchiveInput) error {
+	zw := zip.NewWriter(w)
+
+	for _, entry := range input.Entries {
+		name, err := archiveEntryName(entry.Name)
+		if err != nil {
+			return err
+		}
+		entryWriter, err := zw.Create(name)
+		if err != nil {
+			return err
+		}
+		if _, err := entryWriter.Write(entry.Content); err != nil {
+			return err
+		}
+	}
+
+	var missing []string
+	for _, archiveFile := range input.Files {
+		added, err := s.addArchiveFile(ctx, zw, archiveFile)
+		if err != nil {
+			return err
+		}
+		if !added {
+			missing = append(missing, archiveFile.FileId.String())
+		}
+	}
+
+	if len(missing) > 0 {
+		if logger, ok := logging.GetFromContext(ctx); ok {
+			logger.Info(ctx, "Some files were not added to the archive", zap.Strings("file_ids", missing))
+		}
+		entryWriter, err := zw.Create(archiveMissingFilesEntry)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(entryWriter, strings.Join(missing, "\n")+"\n"); err != nil {
+			return err
+		}
+	}
+
+	return zw.Close()
+}
+
+// addArchiveFile reports false if the file does not exist or the caller has no access to it.
+func (s *FileService) addArchiveFile(ctx context.Context, zw *zip.Writer, archiveFile *model.ArchiveFile) (bool, error) {
+	file, err := s.fileRepo.GetFile(ctx, archiveFile.FileId)
+	if errors.Is(err, errdefs.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	err = s.checkAccess(ctx, file)
+	if errors.Is(err, errdefs.ErrPermissionDenied) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	name := "files/" + file.Id.String() + "/" + file.Id.String() + file.Extension
+	if file.Filename != nil {
+		name = "files/" + file.Id.String() + "/" + path.Base(*file.Filename)
+	}
+	if archiveFile.Name != nil {
+		name = *archiveFile.Name
+	}
+	name, err = archiveEntryName(name)
+	if err != nil {
+		return false, err
+	}
+
+	object, err := s.store.Get(ctx, file.Id.String()+file.Extension)
+	if errors.Is(err, storage.ErrObjectNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	defer object.Close()
+
+	entryWriter, err := zw.Create(name)
+	if err != nil {
+		return false, err
+	}
+	if _, err := io.Copy(entryWriter, object); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// archiveEntryName rejects names that would be extracted outside of the archive directory.
+func archiveEntryName(name string) (string, error) {
+	cleaned := path.Clean(name)
+	if name == "" || path.IsAbs(cleaned) || cleaned == "." || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return "", fmt.Errorf("invalid archive entry name %q: %w", name, errdefs.ValidationErr)
+	}
+	return cleaned, nil
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"bytes"
+	"common_library/logging"
+	"context"
+	"errors"
+	"fileservice/internal/errdefs"
+	"fileservice/internal/model"
+	"fileservice/internal/preview"
+	"fileservice/internal/storage"
+	"fmt"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"time"
+)
+
+const (
+	derivativesBatchSize = 20
+	// files that were not uploaded within this period are not waited for anymore
+	derivativesMaxAge = 24 * time.Hour
+	// larger files are not downloaded to generate previews
+	maxDerivativeSourceSize = 50 << 20
+)
+
+// GenerateDerivatives generates thumbnails and previews for uploaded files that do not have them yet
+// and returns the number of processed files. Files that are not uploaded yet are skipped until the next run.
+func (s *FileService) GenerateDerivatives(ctx context.Context) (int, error) {
+	files, err := s.fileRepo.ListFilesPendingDerivatives(ctx, s.previewGenerator.Extensions(), derivativesMaxAge, derivativesBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	logger, hasLogger := logging.GetFromContext(ctx)
+
+	processed := 0
+	for _, file := range files {
+		uploaded, err := s.generateFileDerivatives(ctx, file)
+		if err != nil && hasLogger {
+			logger.Error(ctx, "Failed to generate file derivatives", zap.String("file_id", file.Id.String()), zap.Error(err))
+		}
+		if !uploaded {
+			continue
+		}
+
+		// broken files are marked as processed as well, so that they are not retried forever
+		if err := s.fileRepo.MarkDerivativesProcessed(ctx, file.Id); err != nil {
+			return processed, err
+		}
+		processed++
+	}
+
+	return processed, nil
+}
+
+// generateFileDerivatives reports whether the file object exists in the storage.
+func (s *FileService) generateFileDerivatives(ctx context.Context, file *model.File) (bool, error) {
+	key := file.Id.String() + file.Extension
+
+	size, err := s.store.Size(ctx, key)
+	if errors.Is(err, storage.ErrObjectNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if size > maxDerivativeSourceSize {
+		return true, fmt.Errorf("file is too large: %d bytes", size)
+	}
+
+	object, err := s.store.Get(ctx, key)
+	if err != nil {
+		return false, err
+	}
+	defer object.Close()
+
+	derivatives, err := s.previewGenerator.Generate(ctx, file.Extension, object)
+	if err != nil {
+		return true, err
+	}
+
+	for _, derivative := range derivatives {
+		key := derivativeKey(file.Id, derivative.Variant, derivative.Extension)
+		err := s.store.Put(ctx, key, bytes.NewReader(derivative.Data), derivative.ContentType)
+		if err != nil {
+			return false, err
+		}
+
+		err = s.fileRepo.CreateFileDerivative(ctx, &model.FileDerivative{
+			FileId:    file.Id,
+			Variant:   derivative.Variant,
+			Extension: derivative.Extension,
+		})
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// derivativeObjectKey returns the storage key of the variant of the file.
+func (s *FileService) derivativeObjectKey(ctx context.Context, fileId uuid.UUID, variant string) (string, error) {
+	if !preview.IsVariant(variant) {
+		return "", fmt.Errorf("unknown variant %q: %w", variant, errdefs.ValidationErr)
+	}
+
+	derivative, err := s.fileRepo.GetFileDerivative(ctx, fileId, variant)
+	if err != nil {
+		return "", err
+	}
+
+	return derivativeKey(fileId, derivative.Variant, derivative.Extension), nil
+}
+
+func (s *FileService) deleteDerivativeObjects(ctx context.Context, derivatives []*model.FileDerivative) error {
+	var errs []error
+	for _, derivative := range derivatives {
+		if err := s.store.Delete(ctx, derivativeKey(derivative.FileId, derivative.Variant, derivative.Extension)); err != nil && !errors.Is(err, storage.ErrObjectNotFound) {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func derivativeKey(fileId uuid.UUID, variant string, extension string) string {
+	return "derived/" + fileId.String() + "/" + variant + extension
+}
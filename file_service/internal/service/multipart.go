@@ -0,0 +1,252 @@
+package service
+
+import (
+	"common_library/logging"
+	"context"
+	"errors"
+	"fileservice/internal/errdefs"
+	"fileservice/internal/model"
+	"fileservice/internal/storage"
+	"fmt"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"path"
+	"time"
+)
+
+const (
+	maxPartCount              = 10000
+	uploadPartURLExpires      = time.Hour
+	multipartCleanupBatchSize = 100
+)
+
+// InitMultipartUpload starts an upload owned by the caller, only the caller can resume, complete or abort it.
+func (s *FileService) InitMultipartUpload(ctx context.Context, input *model.InitMultipartUploadInput) (*model.InitMultipartUpload, error) {
+	if input.PartCount < 1 || input.PartCount > maxPartCount {
+		return nil, fmt.Errorf("invalid part count: %w", errdefs.ValidationErr)
+	}
+	uploadedBy, ok := callerId(ctx)
+	if !ok {
+		return nil, errdefs.AuthenticationErr
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+	extension := path.Ext(input.Filename)
+	if extension == "" {
+		return nil, fmt.Errorf("invalid file extension: %w", errdefs.ValidationErr)
+	}
+	fileInput := &model.RepositoryCreateFileInput{
+		Id:         id,
+		Extension:  extension,
+		UploadedBy: uploadedBy,
+		Filename:   &input.Filename,
+	}
+
+	file, err := s.fileRepo.CreateFile(ctx, fileInput)
+	if err != nil {
+		return nil, err
+	}
+
+	key := file.Id.String() + file.Extension
+	uploadId, err := s.store.CreateMultipartUpload(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.fileRepo.CreateMultipartUpload(ctx, file.Id, uploadId, input.PartCount); err != nil {
+		// the file row stays unreferenced and is removed by the garbage collector
+		if abortErr := s.store.AbortMultipartUpload(ctx, key, uploadId); abortErr != nil {
+			if logger, ok := logging.GetFromContext(ctx); ok {
+				logger.Error(ctx, "Failed to abort multipart upload", zap.String("file_id", file.Id.String()), zap.Error(abortErr))
+			}
+		}
+		return nil, err
+	}
+
+	partNumbers := make([]int32, input.PartCount)
+	for i := range partNumbers {
+		partNumbers[i] = int32(i + 1)
+	}
+
+	parts, err := s.generateUploadPartURLs(ctx, key, uploadId, partNumbers)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.InitMultipartUpload{FileId: file.Id, Parts: parts}, nil
+}
+
+// GenerateUploadPartURLs returns fresh URLs for the requested parts and the numbers of parts that are already uploaded,
+// so that an interrupted upload can be resumed.
+func (s *FileService) GenerateUploadPartURLs(ctx context.Context, input *model.GenerateUploadPartURLsInput) (*model.UploadPartURLs, error) {
+	for _, partNumber := range input.PartNumbers {
+		if partNumber < 1 || partNumber > maxPartCount {
+			return nil, fmt.Errorf("invalid part number: %w", errdefs.ValidationErr)
+		}
+	}
+
+	_, upload, err := s.getOwnMultipartUpload(ctx, input.FileId)
+	if err != nil {
+		return nil, err
+	}
+	for _, partNumber := range input.PartNumbers {
+		if partNumber > upload.PartCount {
+			return nil, fmt.Errorf("part number exceeds part count: %w", errdefs.ValidationErr)
+		}
+	}
+
+	key := upload.FileId.String() + upload.Extension
+	parts, err := s.generateUploadPartURLs(ctx, key, upload.UploadId, input.PartNumbers)
+	if err != nil {
+		return nil, err
+	}
+
+	uploaded, err := s.listUploadedParts(ctx, key, upload.UploadId)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &model.UploadPartURLs{
+		Parts:               parts,
+		UploadedPartNumbers: make([]int32, len(uploaded)),
+	}
+	for i, part := range uploaded {
+		res.UploadedPartNumbers[i] = part.PartNumber
+	}
+
+	return res, nil
+}
+
+// CompleteMultipartUpload assembles the file once every part requested at initiation is uploaded.
+func (s *FileService) CompleteMultipartUpload(ctx context.Context, fileId uuid.UUID) (*model.File, error) {
+	file, upload, err := s.getOwnMultipartUpload(ctx, fileId)
+	if err != nil {
+		return nil, err
+	}
+
+	key := upload.FileId.String() + upload.Extension
+	uploaded, err := s.listUploadedParts(ctx, key, upload.UploadId)
+	if err != nil {
+		return nil, err
+	}
+	// parts are listed in ascending order, so a complete upload has exactly the parts 1..PartCount
+	if len(uploaded) != int(upload.PartCount) || uploaded[len(uploaded)-1].PartNumber != upload.PartCount {
+		return nil, fmt.Errorf("%d of %d parts uploaded: %w", len(uploaded), upload.PartCount, errdefs.ValidationErr)
+	}
+
+	err = s.store.CompleteMultipartUpload(ctx, key, upload.UploadId, uploaded)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	if err := s.fileRepo.DeleteMultipartUpload(ctx, fileId); err != nil && !errors.Is(err, errdefs.ErrNotFound) {
+		return nil, err
+	}
+
+	return file, nil
+}
+
+// AbortMultipartUpload discards uploaded parts. The file row is removed later by the garbage collector.
+func (s *FileService) AbortMultipartUpload(ctx context.Context, fileId uuid.UUID) error {
+	_, upload, err := s.getOwnMultipartUpload(ctx, fileId)
+	if err != nil {
+		return err
+	}
+
+	if err := s.store.AbortMultipartUpload(ctx, upload.FileId.String()+upload.Extension, upload.UploadId); err != nil {
+		return err
+	}
+
+	if err := s.fileRepo.DeleteMultipartUpload(ctx, fileId); err != nil && !errors.Is(err, errdefs.ErrNotFound) {
+		return err
+	}
+
+	return nil
+}
+
+// AbortExpiredMultipartUploads aborts multipart uploads started more than ttl ago and returns their number.
+func (s *FileService) AbortExpiredMultipartUploads(ctx context.Context, ttl time.Duration) (int, error) {
+	uploads, err := s.fileRepo.ListExpiredMultipartUploads(ctx, ttl, multipartCleanupBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	logger, hasLogger := logging.GetFromContext(ctx)
+
+	aborted := 0
+	for _, upload := range uploads {
+		if err := s.store.AbortMultipartUpload(ctx, upload.FileId.String()+upload.Extension, upload.UploadId); err != nil {
+			if hasLogger {
+				logger.Error(ctx, "Failed to abort expired multipart upload", zap.String("file_id", upload.FileId.String()), zap.Error(err))
+			}
+			continue
+		}
+
+		if err := s.fileRepo.DeleteMultipartUpload(ctx, upload.FileId); err != nil && !errors.Is(err, errdefs.ErrNotFound) {
+			return aborted, err
+		}
+		if hasLogger {
+			logger.Info(ctx, "Expired multipart upload aborted", zap.String("file_id", upload.FileId.String()))
+		}
+		aborted++
+	}
+
+	return aborted, nil
+}
+
+// getOwnMultipartUpload returns the pending multipart upload of the file if the caller uploaded it.
+func (s *FileService) getOwnMultipartUpload(ctx context.Context, fileId uuid.UUID) (*model.File, *model.MultipartUpload, error) {
+	file, err := s.fileRepo.GetFile(ctx, fileId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	userId, ok := callerId(ctx)
+	if !ok || userId != file.UploadedBy {
+		return nil, nil, errdefs.ErrPermissionDenied
+	}
+
+	upload, err := s.fileRepo.GetMultipartUpload(ctx, fileId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return file, upload, nil
+}
+
+func (s *FileService) generateUploadPartURLs(ctx context.Context, key string, uploadId string, partNumbers []int32) ([]*model.UploadPartURL, error) {
+	parts := make([]*model.UploadPartURL, len(partNumbers))
+	for i, partNumber := range partNumbers {
+		req, err := s.store.PresignUploadPart(ctx, key, uploadId, partNumber, uploadPartURLExpires)
+		if err != nil {
+			return nil, err
+		}
+
+		parts[i] = &model.UploadPartURL{
+			PartNumber: partNumber,
+			URL:        req.URL,
+			Method:     req.Method,
+		}
+	}
+
+	return parts, nil
+}
+
+func (s *FileService) listUploadedParts(ctx context.Context, key string, uploadId string) ([]storage.Part, error) {
+	parts, err := s.store.ListParts(ctx, key, uploadId)
+	if err != nil {
+		return nil, storageError(err)
+	}
+	return parts, nil
+}
+
+// storageError maps errors of missing objects and uploads to ErrNotFound.
+func storageError(err error) error {
+	if errors.Is(err, storage.ErrObjectNotFound) || errors.Is(err, storage.ErrUploadNotFound) {
+		return fmt.Errorf("%w: %w", err, errdefs.ErrNotFound)
+	}
+	return err
+}
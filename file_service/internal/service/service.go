@@ -1,44 +1,74 @@
 package service
 
 import (
+	"bytes"
+	"common_library/ctxdata"
 	"common_library/logging"
 	"context"
-	"database/sql"
 	"errors"
 	"fileservice/internal/errdefs"
 	"fileservice/internal/model"
+	"fileservice/internal/preview"
+	"fileservice/internal/storage"
 	"fmt"
-	"github.com/aws/aws-sdk-go-v2/aws"
-	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
-	"github.com/aws/aws-sdk-go-v2/aws/transport/http"
-	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 	"path"
-	"strings"
 	"time"
 )
 
+const (
+	maxOwnerTypeLength = 32
+	gcBatchSize        = 100
+	uploadURLExpires   = 5 * time.Minute
+	downloadURLExpires = 5 * time.Minute
+	// larger files must be uploaded by presigned URLs, the limit keeps requests below the gRPC message size
+	maxUploadFileSize = 3 << 20
+)
+
 type FileRepository interface {
 	CreateFile(ctx context.Context, input *model.RepositoryCreateFileInput) (*model.File, error)
 	GetFile(ctx context.Context, fileId uuid.UUID) (*model.File, error)
+
+	CreateFileReference(ctx context.Context, input *model.FileUsageInput) error
+	DeleteFileReferences(ctx context.Context, input *model.FileUsageInput) error
+	ListUnreferencedFiles(ctx context.Context, gracePeriod time.Duration, limit int) ([]*model.File, error)
+	DeleteUnreferencedFile(ctx context.Context, fileId uuid.UUID, deleteObjects func(ctx context.Context) error) error
+
+	CreateFileGrants(ctx context.Context, grantedBy uuid.UUID, input *model.FileAccessInput) error
+	DeleteFileGrants(ctx context.Context, input *model.FileAccessInput) error
+	HasFileGrant(ctx context.Context, fileId uuid.UUID, userId uuid.UUID) (bool, error)
+	ForgetUser(ctx context.Context, userId uuid.UUID) error
+
+	CreateMultipartUpload(ctx context.Context, fileId uuid.UUID, uploadId string, partCount int32) error
+	GetMultipartUpload(ctx context.Context, fileId uuid.UUID) (*model.MultipartUpload, error)
+	DeleteMultipartUpload(ctx context.Context, fileId uuid.UUID) error
+	ListExpiredMultipartUploads(ctx context.Context, ttl time.Duration, limit int) ([]*model.MultipartUpload, error)
+
+	ListFilesPendingDerivatives(ctx context.Context, extensions []string, maxAge time.Duration, limit int) ([]*model.File, error)
+	MarkDerivativesProcessed(ctx context.Context, fileId uuid.UUID) error
+	CreateFileDerivative(ctx context.Context, derivative *model.FileDerivative) error
+	GetFileDerivative(ctx context.Context, fileId uuid.UUID, variant string) (*model.FileDerivative, error)
+	ListFileDerivatives(ctx context.Context, fileId uuid.UUID) ([]*model.FileDerivative, error)
 }
 
 type FileService struct {
 	fileRepo         FileRepository
-	s3Client         *s3.Client
-	bucket           *string
-	gatewayPublicUrl string
-	minioURL         string
+	store            storage.FileStore
+	gcGracePeriod    time.Duration
+	previewGenerator *preview.Generator
 }
 
-func NewFileService(ctx context.Context, fileRepo FileRepository, client *s3.Client, bucketName string, gatewayPublicUrl string, minioUrl string) (*FileService, error) {
-	s := &FileService{fileRepo: fileRepo, s3Client: client, bucket: aws.String(bucketName), gatewayPublicUrl: gatewayPublicUrl, minioURL: minioUrl}
-	err := s.createBucket(ctx, bucketName)
-	return s, err
+func NewFileService(fileRepo FileRepository, store storage.FileStore, gcGracePeriod time.Duration, previewGenerator *preview.Generator) *FileService {
+	return &FileService{fileRepo: fileRepo, store: store, gcGracePeriod: gcGracePeriod, previewGenerator: previewGenerator}
 }
 
 func (s *FileService) InitUpload(ctx context.Context, input *model.InitUploadInput) (*model.InitUpload, error) {
+	uploadedBy, err := uploaderId(ctx, input.UploadedBy)
+	if err != nil {
+		return nil, err
+	}
+
 	id, err := uuid.NewV7()
 	if err != nil {
 		return nil, err
@@ -50,7 +80,7 @@ func (s *FileService) InitUpload(ctx context.Context, input *model.InitUploadInp
 	fileInput := &model.RepositoryCreateFileInput{
 		Id:         id,
 		Extension:  extension,
-		UploadedBy: input.UploadedBy,
+		UploadedBy: uploadedBy,
 		Filename:   &input.Filename,
 	}
 
@@ -60,84 +90,277 @@ func (s *FileService) InitUpload(ctx context.Context, input *model.InitUploadInp
 	}
 
 	key := file.Id.String() + file.Extension
-	uploadRequest, err := s.generateUploadURL(ctx, key)
+	uploadRequest, err := s.store.PresignPut(ctx, key, uploadURLExpires)
 	if err != nil {
 		return nil, err
 	}
 
 	res := &model.InitUpload{
 		FileId:    file.Id,
-		UploadURL: strings.Replace(uploadRequest.URL, s.minioURL, s.gatewayPublicUrl+"/files/upload", 1),
+		UploadURL: uploadRequest.URL,
 		Method:    uploadRequest.Method,
 	}
 
 	return res, nil
 }
 
-func (s *FileService) GenerateDownloadURL(ctx context.Context, fileId uuid.UUID) (string, error) {
+// UploadFile stores a small file sent in the request, so that services can save documents they generate.
+func (s *FileService) UploadFile(ctx context.Context, input *model.UploadFileInput) (*model.File, error) {
+	uploadedBy, err := uploaderId(ctx, input.UploadedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	extension := path.Ext(input.Filename)
+	if extension == "" {
+		return nil, fmt.Errorf("invalid file extension: %w", errdefs.ValidationErr)
+	}
+	if len(input.Content) == 0 || len(input.Content) > maxUploadFileSize {
+		return nil, fmt.Errorf("invalid file size %d: %w", len(input.Content), errdefs.ValidationErr)
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+	file, err := s.fileRepo.CreateFile(ctx, &model.RepositoryCreateFileInput{
+		Id:         id,
+		Extension:  extension,
+		UploadedBy: uploadedBy,
+		Filename:   &input.Filename,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the file record without an object is collected as garbage if the upload fails
+	if err := s.store.Put(ctx, file.Id.String()+file.Extension, bytes.NewReader(input.Content), input.ContentType); err != nil {
+		return nil, err
+	}
+
+	return file, nil
+}
+
+// GenerateDownloadURL returns a link to the file or, if variant is not empty, to its thumbnail or preview.
+func (s *FileService) GenerateDownloadURL(ctx context.Context, fileId uuid.UUID, variant string) (string, error) {
 	file, err := s.fileRepo.GetFile(ctx, fileId)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return "", fmt.Errorf("file not found: %w", errdefs.ErrNotFound)
-		}
+		return "", err
+	}
+
+	if err := s.checkAccess(ctx, file); err != nil {
+		return "", err
 	}
 
 	key := file.Id.String() + file.Extension
-	downloadRequest, err := s.generateDownloadURL(ctx, key)
+	if variant != "" {
+		key, err = s.derivativeObjectKey(ctx, file.Id, variant)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	downloadRequest, err := s.store.PresignGet(ctx, key, downloadURLExpires)
 	if err != nil {
 		return "", err
 	}
 
-	return strings.Replace(downloadRequest.URL, s.minioURL, s.gatewayPublicUrl+"/files/download", 1), nil
+	return downloadRequest.URL, nil
 }
 
 func (s *FileService) GetFileMeta(ctx context.Context, fileId uuid.UUID) (*model.File, error) {
 	file, err := s.fileRepo.GetFile(ctx, fileId)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, fmt.Errorf("file not found: %w", errdefs.ErrNotFound)
-		}
+		return nil, err
 	}
 	return file, nil
 }
 
-func (s *FileService) createBucket(ctx context.Context, name string) error {
-	_, err := s.s3Client.CreateBucket(ctx, &s3.CreateBucketInput{Bucket: aws.String(name)})
+// GrantFileAccess allows users to download the file.
+// Only the uploader of the file and services that attach the file to their entities can grant access.
+func (s *FileService) GrantFileAccess(ctx context.Context, input *model.FileAccessInput) error {
+	file, err := s.fileRepo.GetFile(ctx, input.FileId)
 	if err != nil {
-		var opErr *http.ResponseError
-		if errors.As(err, &opErr) && opErr.HTTPStatusCode() == 409 {
-			if logger, ok := logging.GetFromContext(ctx); ok {
-				logger.Info(ctx, "Bucket already exists", zap.String("bucket", name))
-			}
-			return nil
+		return err
+	}
+
+	userId, _ := callerId(ctx)
+	if _, isService := ctxdata.GetCallerService(ctx); !isService && userId != file.UploadedBy {
+		return errdefs.ErrPermissionDenied
+	}
+
+	if len(input.UserIds) == 0 {
+		return nil
+	}
+
+	return s.fileRepo.CreateFileGrants(ctx, userId, input)
+}
+
+// RevokeFileAccess removes explicit grants. Only the uploader of the file can revoke access.
+func (s *FileService) RevokeFileAccess(ctx context.Context, input *model.FileAccessInput) error {
+	file, err := s.fileRepo.GetFile(ctx, input.FileId)
+	if err != nil {
+		return err
+	}
+
+	userId, ok := callerId(ctx)
+	if !ok || userId != file.UploadedBy {
+		return errdefs.ErrPermissionDenied
+	}
+
+	if len(input.UserIds) == 0 {
+		return nil
+	}
+
+	return s.fileRepo.DeleteFileGrants(ctx, input)
+}
+
+// checkAccess allows the uploader of the file and users with an explicit grant.
+func (s *FileService) checkAccess(ctx context.Context, file *model.File) error {
+	userId, ok := callerId(ctx)
+	if !ok {
+		return errdefs.ErrPermissionDenied
+	}
+
+	if file.UploadedBy == userId {
+		return nil
+	}
+
+	granted, err := s.fileRepo.HasFileGrant(ctx, file.Id, userId)
+	if err != nil {
+		return err
+	}
+	if !granted {
+		return errdefs.ErrPermissionDenied
+	}
+
+	return nil
+}
+
+func callerId(ctx context.Context) (uuid.UUID, bool) {
+	userIdStr, ok := ctxdata.GetUserID(ctx)
+	if !ok {
+		return uuid.Nil, false
+	}
+	userId, err := uuid.Parse(userIdStr)
+	if err != nil {
+		return uuid.Nil, false
+	}
+	return userId, true
+}
+
+// uploaderId returns the owner of a new file. Users upload only as themselves,
+// services upload documents on behalf of the given user.
+func uploaderId(ctx context.Context, onBehalfOf uuid.UUID) (uuid.UUID, error) {
+	if _, isService := ctxdata.GetCallerService(ctx); isService {
+		if onBehalfOf == uuid.Nil {
+			return uuid.Nil, fmt.Errorf("uploaded_by is required: %w", errdefs.ValidationErr)
 		}
+		return onBehalfOf, nil
+	}
+	userId, ok := callerId(ctx)
+	if !ok {
+		return uuid.Nil, errdefs.AuthenticationErr
+	}
+	return userId, nil
+}
+
+// ensureServiceCaller allows only other services, users never manage file usages directly.
+func ensureServiceCaller(ctx context.Context) error {
+	if _, ok := ctxdata.GetCallerService(ctx); !ok {
+		return errdefs.ErrPermissionDenied
 	}
-	return err
+	return nil
 }
 
-func (s *FileService) generateUploadURL(ctx context.Context, key string) (*v4.PresignedHTTPRequest, error) {
-	presigner := s3.NewPresignClient(s.s3Client)
+// RegisterFileUsage is called by the service that owns the file, only other services can call it.
+func (s *FileService) RegisterFileUsage(ctx context.Context, input *model.FileUsageInput) error {
+	if err := ensureServiceCaller(ctx); err != nil {
+		return err
+	}
+	if input.FileId == uuid.Nil {
+		return fmt.Errorf("file id is required: %w", errdefs.ValidationErr)
+	}
+	if err := validateFileOwner(input); err != nil {
+		return err
+	}
+	return s.fileRepo.CreateFileReference(ctx, input)
+}
 
-	req, err := presigner.PresignPutObject(
-		ctx,
-		&s3.PutObjectInput{
-			Bucket: s.bucket,
-			Key:    aws.String(key),
-		},
-		s3.WithPresignExpires(5*time.Minute),
-	)
-	return req, err
+// UnregisterFileUsage releases the file of the owner, only other services can call it.
+func (s *FileService) UnregisterFileUsage(ctx context.Context, input *model.FileUsageInput) error {
+	if err := ensureServiceCaller(ctx); err != nil {
+		return err
+	}
+	if err := validateFileOwner(input); err != nil {
+		return err
+	}
+	return s.fileRepo.DeleteFileReferences(ctx, input)
 }
 
-func (s *FileService) generateDownloadURL(ctx context.Context, key string) (*v4.PresignedHTTPRequest, error) {
-	presigner := s3.NewPresignClient(s.s3Client)
+// CollectGarbage deletes files that have been unreferenced for longer than the grace period.
+// In dry run mode nothing is deleted and the files that would be deleted are returned.
+// Only the garbage collection worker and other services can call it.
+func (s *FileService) CollectGarbage(ctx context.Context, dryRun bool) ([]*model.File, error) {
+	if err := ensureServiceCaller(ctx); err != nil {
+		return nil, err
+	}
+
+	files, err := s.fileRepo.ListUnreferencedFiles(ctx, s.gcGracePeriod, gcBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	logger, hasLogger := logging.GetFromContext(ctx)
+	if dryRun {
+		if hasLogger {
+			for _, file := range files {
+				logger.Info(ctx, "Garbage collection dry run: file would be deleted",
+					zap.String("file_id", file.Id.String()),
+					zap.Time("created_at", file.CreatedAt),
+				)
+			}
+		}
+		return files, nil
+	}
+
+	deleted := make([]*model.File, 0, len(files))
+	for _, file := range files {
+		derivatives, err := s.fileRepo.ListFileDerivatives(ctx, file.Id)
+		if err != nil {
+			return deleted, err
+		}
+
+		err = s.fileRepo.DeleteUnreferencedFile(ctx, file.Id, func(ctx context.Context) error {
+			// objects go before the row: a row left without objects is collected again on the next run
+			if err := s.store.Delete(ctx, file.Id.String()+file.Extension); err != nil && !errors.Is(err, storage.ErrObjectNotFound) {
+				return err
+			}
+			return s.deleteDerivativeObjects(ctx, derivatives)
+		})
+		if errors.Is(err, errdefs.ErrNotFound) {
+			// file was referenced or deleted after listing
+			continue
+		}
+		if err != nil {
+			return deleted, err
+		}
+
+		if hasLogger {
+			logger.Info(ctx, "Garbage collection: file deleted", zap.String("file_id", file.Id.String()))
+		}
+		deleted = append(deleted, file)
+	}
 
-	req, err := presigner.PresignGetObject(ctx, &s3.GetObjectInput{
-		Bucket: s.bucket,
-		Key:    aws.String(key),
-	},
-		s3.WithPresignExpires(5*time.Minute),
-	)
+	return deleted, nil
+}
 
-	return req, err
+func validateFileOwner(input *model.FileUsageInput) error {
+	if input.OwnerType == "" || len(input.OwnerType) > maxOwnerTypeLength {
+		return fmt.Errorf("invalid owner type: %w", errdefs.ValidationErr)
+	}
+	if input.OwnerId == uuid.Nil {
+		return fmt.Errorf("owner id is required: %w", errdefs.ValidationErr)
+	}
+	return nil
 }
@@ -0,0 +1,564 @@
+package service
+
+import (
+	"bytes"
+	"common_library/ctxdata"
+	"context"
+	"errors"
+	"fileservice/internal/errdefs"
+	"fileservice/internal/model"
+	"fileservice/internal/storage"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeFileRepository keeps files in memory, methods not used by the tests panic through the nil interface
+type fakeFileRepository struct {
+	FileRepository
+
+	mu          sync.Mutex
+	files       map[uuid.UUID]*model.File
+	references  map[uuid.UUID]int
+	grants      map[uuid.UUID][]uuid.UUID
+	derivatives map[uuid.UUID][]*model.FileDerivative
+	uploads     map[uuid.UUID]*model.MultipartUpload
+	// unreferenced is returned by ListUnreferencedFiles, the selection itself is done by the query
+	unreferenced []*model.File
+}
+
+func newFakeFileRepository() *fakeFileRepository {
+	return &fakeFileRepository{
+		files:       map[uuid.UUID]*model.File{},
+		references:  map[uuid.UUID]int{},
+		grants:      map[uuid.UUID][]uuid.UUID{},
+		derivatives: map[uuid.UUID][]*model.FileDerivative{},
+		uploads:     map[uuid.UUID]*model.MultipartUpload{},
+	}
+}
+
+func (r *fakeFileRepository) addFile(uploadedBy uuid.UUID) *model.File {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	file := &model.File{Id: uuid.New(), Extension: ".pdf", UploadedBy: uploadedBy, CreatedAt: time.Now()}
+	r.files[file.Id] = file
+	return file
+}
+
+func (r *fakeFileRepository) CreateFile(ctx context.Context, input *model.RepositoryCreateFileInput) (*model.File, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	file := &model.File{Id: input.Id, Extension: input.Extension, UploadedBy: input.UploadedBy, Filename: input.Filename, CreatedAt: time.Now()}
+	r.files[file.Id] = file
+	return file, nil
+}
+
+func (r *fakeFileRepository) GetFile(ctx context.Context, fileId uuid.UUID) (*model.File, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	file, ok := r.files[fileId]
+	if !ok {
+		return nil, errdefs.ErrNotFound
+	}
+	return file, nil
+}
+
+func (r *fakeFileRepository) CreateFileReference(ctx context.Context, input *model.FileUsageInput) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.references[input.FileId]++
+	return nil
+}
+
+func (r *fakeFileRepository) DeleteFileReferences(ctx context.Context, input *model.FileUsageInput) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.references, input.FileId)
+	return nil
+}
+
+func (r *fakeFileRepository) ListUnreferencedFiles(ctx context.Context, gracePeriod time.Duration, limit int) ([]*model.File, error) {
+	return r.unreferenced, nil
+}
+
+func (r *fakeFileRepository) DeleteUnreferencedFile(ctx context.Context, fileId uuid.UUID, deleteObjects func(ctx context.Context) error) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.files[fileId]; !ok || r.references[fileId] > 0 {
+		return errdefs.ErrNotFound
+	}
+	if err := deleteObjects(ctx); err != nil {
+		return err
+	}
+	delete(r.files, fileId)
+	return nil
+}
+
+func (r *fakeFileRepository) CreateFileGrants(ctx context.Context, grantedBy uuid.UUID, input *model.FileAccessInput) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.grants[input.FileId] = append(r.grants[input.FileId], input.UserIds...)
+	return nil
+}
+
+func (r *fakeFileRepository) DeleteFileGrants(ctx context.Context, input *model.FileAccessInput) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	kept := r.grants[input.FileId][:0]
+	for _, id := range r.grants[input.FileId] {
+		if !slices.Contains(input.UserIds, id) {
+			kept = append(kept, id)
+		}
+	}
+	r.grants[input.FileId] = kept
+	return nil
+}
+
+func (r *fakeFileRepository) HasFileGrant(ctx context.Context, fileId uuid.UUID, userId uuid.UUID) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, id := range r.grants[fileId] {
+		if id == userId {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *fakeFileRepository) CreateMultipartUpload(ctx context.Context, fileId uuid.UUID, uploadId string, partCount int32) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.uploads[fileId] = &model.MultipartUpload{FileId: fileId, UploadId: uploadId, PartCount: partCount, Extension: r.files[fileId].Extension, CreatedAt: time.Now()}
+	return nil
+}
+
+func (r *fakeFileRepository) GetMultipartUpload(ctx context.Context, fileId uuid.UUID) (*model.MultipartUpload, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	upload, ok := r.uploads[fileId]
+	if !ok {
+		return nil, errdefs.ErrNotFound
+	}
+	return upload, nil
+}
+
+func (r *fakeFileRepository) DeleteMultipartUpload(ctx context.Context, fileId uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.uploads[fileId]; !ok {
+		return errdefs.ErrNotFound
+	}
+	delete(r.uploads, fileId)
+	return nil
+}
+
+func (r *fakeFileRepository) ListFileDerivatives(ctx context.Context, fileId uuid.UUID) ([]*model.FileDerivative, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.derivatives[fileId], nil
+}
+
+// fakeStore is an in-memory FileStore with S3 multipart semantics
+type fakeStore struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	uploads map[string]map[int32][]byte
+	// deleteErr makes Delete fail when set
+	deleteErr error
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{objects: map[string][]byte{}, uploads: map[string]map[int32][]byte{}}
+}
+
+func (s *fakeStore) PresignPut(ctx context.Context, key string, expires time.Duration) (*storage.PresignedRequest, error) {
+	return &storage.PresignedRequest{URL: "https://storage/" + key, Method: "PUT"}, nil
+}
+
+func (s *fakeStore) PresignGet(ctx context.Context, key string, expires time.Duration) (*storage.PresignedRequest, error) {
+	return &storage.PresignedRequest{URL: "https://storage/" + key, Method: "GET"}, nil
+}
+
+func (s *fakeStore) Size(ctx context.Context, key string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, ok := s.objects[key]
+	if !ok {
+		return 0, storage.ErrObjectNotFound
+	}
+	return int64(len(data)), nil
+}
+
+func (s *fakeStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, ok := s.objects[key]
+	if !ok {
+		return nil, storage.ErrObjectNotFound
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (s *fakeStore) Put(ctx context.Context, key string, body io.Reader, contentType string) error {
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.objects[key] = data
+	return nil
+}
+
+func (s *fakeStore) Delete(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.deleteErr != nil {
+		return s.deleteErr
+	}
+	delete(s.objects, key)
+	return nil
+}
+
+func (s *fakeStore) CreateMultipartUpload(ctx context.Context, key string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	uploadId := uuid.NewString()
+	s.uploads[uploadId] = map[int32][]byte{}
+	return uploadId, nil
+}
+
+func (s *fakeStore) PresignUploadPart(ctx context.Context, key string, uploadId string, partNumber int32, expires time.Duration) (*storage.PresignedRequest, error) {
+	return &storage.PresignedRequest{URL: "https://storage/" + key + "?uploadId=" + uploadId, Method: "PUT"}, nil
+}
+
+// uploadPart stands for the client uploading a part through a presigned URL
+func (s *fakeStore) uploadPart(uploadId string, partNumber int32, data string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.uploads[uploadId][partNumber] = []byte(data)
+}
+
+func (s *fakeStore) ListParts(ctx context.Context, key string, uploadId string) ([]storage.Part, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	upload, ok := s.uploads[uploadId]
+	if !ok {
+		return nil, storage.ErrUploadNotFound
+	}
+	parts := make([]storage.Part, 0, len(upload))
+	for number := int32(1); len(parts) < len(upload); number++ {
+		if _, ok := upload[number]; ok {
+			parts = append(parts, storage.Part{PartNumber: number, ETag: uuid.NewSHA1(uuid.Nil, upload[number]).String()})
+		}
+	}
+	return parts, nil
+}
+
+func (s *fakeStore) CompleteMultipartUpload(ctx context.Context, key string, uploadId string, parts []storage.Part) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	upload, ok := s.uploads[uploadId]
+	if !ok {
+		return storage.ErrUploadNotFound
+	}
+	var data []byte
+	for _, part := range parts {
+		data = append(data, upload[part.PartNumber]...)
+	}
+	s.objects[key] = data
+	delete(s.uploads, uploadId)
+	return nil
+}
+
+func (s *fakeStore) AbortMultipartUpload(ctx context.Context, key string, uploadId string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.uploads, uploadId)
+	return nil
+}
+
+func userCtx(userId uuid.UUID) context.Context {
+	return ctxdata.WithUserID(context.Background(), userId.String())
+}
+
+func serviceCtx() context.Context {
+	return ctxdata.WithCallerService(context.Background(), "homework_service")
+}
+
+func TestCollectGarbage(t *testing.T) {
+	repo := newFakeFileRepository()
+	store := newFakeStore()
+	s := NewFileService(repo, store, time.Hour, nil)
+
+	garbage := repo.addFile(uuid.New())
+	referencedLater := repo.addFile(uuid.New())
+	repo.unreferenced = []*model.File{garbage, referencedLater}
+	repo.derivatives[garbage.Id] = []*model.FileDerivative{{FileId: garbage.Id, Variant: "thumbnail", Extension: ".jpg"}}
+	for _, file := range repo.unreferenced {
+		require.NoError(t, store.Put(context.Background(), file.Id.String()+file.Extension, bytes.NewReader([]byte("data")), ""))
+	}
+	thumbnailKey := derivativeKey(garbage.Id, "thumbnail", ".jpg")
+	require.NoError(t, store.Put(context.Background(), thumbnailKey, bytes.NewReader([]byte("jpg")), ""))
+	// referenced between listing and deleting
+	repo.references[referencedLater.Id] = 1
+
+	t.Run("OnlyServices", func(t *testing.T) {
+		_, err := s.CollectGarbage(userCtx(garbage.UploadedBy), false)
+		assert.ErrorIs(t, err, errdefs.ErrPermissionDenied)
+	})
+
+	t.Run("DryRun", func(t *testing.T) {
+		files, err := s.CollectGarbage(serviceCtx(), true)
+		require.NoError(t, err)
+		assert.Len(t, files, 2)
+		assert.Len(t, repo.files, 2)
+		assert.Len(t, store.objects, 3)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		files, err := s.CollectGarbage(serviceCtx(), false)
+		require.NoError(t, err)
+		require.Len(t, files, 1)
+		assert.Equal(t, garbage.Id, files[0].Id)
+
+		assert.NotContains(t, repo.files, garbage.Id)
+		assert.Contains(t, repo.files, referencedLater.Id)
+		assert.NotContains(t, store.objects, garbage.Id.String()+garbage.Extension)
+		assert.NotContains(t, store.objects, thumbnailKey)
+		assert.Contains(t, store.objects, referencedLater.Id.String()+referencedLater.Extension)
+	})
+
+	t.Run("StorageFailure", func(t *testing.T) {
+		failing := repo.addFile(uuid.New())
+		repo.unreferenced = []*model.File{failing}
+		store.deleteErr = errors.New("storage is unavailable")
+		defer func() { store.deleteErr = nil }()
+
+		files, err := s.CollectGarbage(serviceCtx(), false)
+		assert.Error(t, err)
+		assert.Empty(t, files)
+		assert.Contains(t, repo.files, failing.Id)
+	})
+}
+
+func TestUploadOwner(t *testing.T) {
+	repo := newFakeFileRepository()
+	s := NewFileService(repo, newFakeStore(), time.Hour, nil)
+	uploader := uuid.New()
+	other := uuid.New()
+
+	t.Run("UserUploadsAsThemselves", func(t *testing.T) {
+		init, err := s.InitUpload(userCtx(uploader), &model.InitUploadInput{UploadedBy: other, Filename: "homework.pdf"})
+		require.NoError(t, err)
+		assert.Equal(t, uploader, repo.files[init.FileId].UploadedBy)
+	})
+
+	t.Run("Anonymous", func(t *testing.T) {
+		_, err := s.InitUpload(context.Background(), &model.InitUploadInput{UploadedBy: other, Filename: "homework.pdf"})
+		assert.ErrorIs(t, err, errdefs.AuthenticationErr)
+	})
+
+	t.Run("ServiceOnBehalfOfUser", func(t *testing.T) {
+		file, err := s.UploadFile(serviceCtx(), &model.UploadFileInput{UploadedBy: other, Filename: "invoice.pdf", Content: []byte("%PDF-")})
+		require.NoError(t, err)
+		assert.Equal(t, other, file.UploadedBy)
+	})
+
+	t.Run("ServiceWithoutOwner", func(t *testing.T) {
+		_, err := s.UploadFile(serviceCtx(), &model.UploadFileInput{Filename: "invoice.pdf", Content: []byte("%PDF-")})
+		assert.ErrorIs(t, err, errdefs.ValidationErr)
+	})
+}
+
+func TestFileUsage_OnlyServices(t *testing.T) {
+	repo := newFakeFileRepository()
+	s := NewFileService(repo, newFakeStore(), time.Hour, nil)
+	file := repo.addFile(uuid.New())
+	input := &model.FileUsageInput{FileId: file.Id, OwnerType: "assignment", OwnerId: uuid.New()}
+
+	assert.ErrorIs(t, s.RegisterFileUsage(userCtx(file.UploadedBy), input), errdefs.ErrPermissionDenied)
+	assert.ErrorIs(t, s.UnregisterFileUsage(userCtx(file.UploadedBy), input), errdefs.ErrPermissionDenied)
+	assert.Zero(t, repo.references[file.Id])
+
+	require.NoError(t, s.RegisterFileUsage(serviceCtx(), input))
+	assert.Equal(t, 1, repo.references[file.Id])
+	require.NoError(t, s.UnregisterFileUsage(serviceCtx(), input))
+	assert.Zero(t, repo.references[file.Id])
+}
+
+func TestGenerateDownloadURL_Access(t *testing.T) {
+	repo := newFakeFileRepository()
+	s := NewFileService(repo, newFakeStore(), time.Hour, nil)
+	uploader, grantee, stranger := uuid.New(), uuid.New(), uuid.New()
+	file := repo.addFile(uploader)
+	repo.grants[file.Id] = []uuid.UUID{grantee}
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want error
+	}{
+		{"Uploader", userCtx(uploader), nil},
+		{"Grantee", userCtx(grantee), nil},
+		{"Stranger", userCtx(stranger), errdefs.ErrPermissionDenied},
+		{"NoUser", context.Background(), errdefs.ErrPermissionDenied},
+		{"ServiceWithoutUser", serviceCtx(), errdefs.ErrPermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			url, err := s.GenerateDownloadURL(tt.ctx, file.Id, "")
+			if tt.want != nil {
+				assert.ErrorIs(t, err, tt.want)
+				assert.Empty(t, url)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotEmpty(t, url)
+		})
+	}
+}
+
+func TestGrantFileAccess(t *testing.T) {
+	uploader, grantee, stranger, other := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want error
+	}{
+		{"Uploader", userCtx(uploader), nil},
+		{"Service", serviceCtx(), nil},
+		{"ServiceOnBehalfOfUser", ctxdata.WithCallerService(userCtx(stranger), "payment_service"), nil},
+		{"Grantee", userCtx(grantee), errdefs.ErrPermissionDenied},
+		{"Stranger", userCtx(stranger), errdefs.ErrPermissionDenied},
+		{"NoUser", context.Background(), errdefs.ErrPermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeFileRepository()
+			s := NewFileService(repo, newFakeStore(), time.Hour, nil)
+			file := repo.addFile(uploader)
+			repo.grants[file.Id] = []uuid.UUID{grantee}
+
+			err := s.GrantFileAccess(tt.ctx, &model.FileAccessInput{FileId: file.Id, UserIds: []uuid.UUID{other}})
+			granted, _ := repo.HasFileGrant(context.Background(), file.Id, other)
+			if tt.want != nil {
+				assert.ErrorIs(t, err, tt.want)
+				assert.False(t, granted)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, granted)
+		})
+	}
+}
+
+func TestGrantFileAccess_UnknownFile(t *testing.T) {
+	s := NewFileService(newFakeFileRepository(), newFakeStore(), time.Hour, nil)
+	err := s.GrantFileAccess(serviceCtx(), &model.FileAccessInput{FileId: uuid.New(), UserIds: []uuid.UUID{uuid.New()}})
+	assert.ErrorIs(t, err, errdefs.ErrNotFound)
+}
+
+func TestRevokeFileAccess_OnlyUploader(t *testing.T) {
+	repo := newFakeFileRepository()
+	s := NewFileService(repo, newFakeStore(), time.Hour, nil)
+	uploader, grantee := uuid.New(), uuid.New()
+	file := repo.addFile(uploader)
+	repo.grants[file.Id] = []uuid.UUID{grantee}
+	input := &model.FileAccessInput{FileId: file.Id, UserIds: []uuid.UUID{grantee}}
+
+	assert.ErrorIs(t, s.RevokeFileAccess(userCtx(grantee), input), errdefs.ErrPermissionDenied)
+	assert.ErrorIs(t, s.RevokeFileAccess(serviceCtx(), input), errdefs.ErrPermissionDenied)
+	require.NoError(t, s.RevokeFileAccess(userCtx(uploader), input))
+
+	_, err := s.GenerateDownloadURL(userCtx(grantee), file.Id, "")
+	assert.ErrorIs(t, err, errdefs.ErrPermissionDenied)
+}
+
+func TestMultipartUpload(t *testing.T) {
+	repo := newFakeFileRepository()
+	store := newFakeStore()
+	s := NewFileService(repo, store, time.Hour, nil)
+	uploader := uuid.New()
+	ctx := userCtx(uploader)
+
+	init, err := s.InitMultipartUpload(ctx, &model.InitMultipartUploadInput{Filename: "lecture.mp4", PartCount: 3})
+	require.NoError(t, err)
+	require.Len(t, init.Parts, 3)
+	upload := repo.uploads[init.FileId]
+	require.NotNil(t, upload)
+	assert.Equal(t, int32(3), upload.PartCount)
+	assert.Equal(t, uploader, repo.files[init.FileId].UploadedBy)
+	key := init.FileId.String() + ".mp4"
+
+	store.uploadPart(upload.UploadId, 1, "a")
+	store.uploadPart(upload.UploadId, 2, "b")
+
+	t.Run("MissingPart", func(t *testing.T) {
+		_, err := s.CompleteMultipartUpload(ctx, init.FileId)
+		assert.ErrorIs(t, err, errdefs.ValidationErr)
+		assert.NotContains(t, store.objects, key)
+		assert.Contains(t, repo.uploads, init.FileId)
+	})
+
+	t.Run("PartNumberOutOfRange", func(t *testing.T) {
+		_, err := s.GenerateUploadPartURLs(ctx, &model.GenerateUploadPartURLsInput{FileId: init.FileId, PartNumbers: []int32{4}})
+		assert.ErrorIs(t, err, errdefs.ValidationErr)
+	})
+
+	t.Run("Resume", func(t *testing.T) {
+		urls, err := s.GenerateUploadPartURLs(ctx, &model.GenerateUploadPartURLsInput{FileId: init.FileId, PartNumbers: []int32{3}})
+		require.NoError(t, err)
+		assert.Len(t, urls.Parts, 1)
+		assert.Equal(t, []int32{1, 2}, urls.UploadedPartNumbers)
+	})
+
+	t.Run("NotUploader", func(t *testing.T) {
+		_, err := s.CompleteMultipartUpload(userCtx(uuid.New()), init.FileId)
+		assert.ErrorIs(t, err, errdefs.ErrPermissionDenied)
+		assert.ErrorIs(t, s.AbortMultipartUpload(userCtx(uuid.New()), init.FileId), errdefs.ErrPermissionDenied)
+	})
+
+	t.Run("Anonymous", func(t *testing.T) {
+		_, err := s.InitMultipartUpload(context.Background(), &model.InitMultipartUploadInput{Filename: "lecture.mp4", PartCount: 1})
+		assert.ErrorIs(t, err, errdefs.AuthenticationErr)
+		_, err = s.GenerateUploadPartURLs(context.Background(), &model.GenerateUploadPartURLsInput{FileId: init.FileId, PartNumbers: []int32{3}})
+		assert.ErrorIs(t, err, errdefs.ErrPermissionDenied)
+	})
+
+	t.Run("Complete", func(t *testing.T) {
+		store.uploadPart(upload.UploadId, 3, "c")
+
+		file, err := s.CompleteMultipartUpload(ctx, init.FileId)
+		require.NoError(t, err)
+		assert.Equal(t, init.FileId, file.Id)
+		assert.Equal(t, []byte("abc"), store.objects[key])
+		assert.NotContains(t, repo.uploads, init.FileId)
+	})
+}
+
+func TestCompleteMultipartUpload_PartOutOfRange(t *testing.T) {
+	repo := newFakeFileRepository()
+	store := newFakeStore()
+	s := NewFileService(repo, store, time.Hour, nil)
+	uploader := uuid.New()
+	ctx := userCtx(uploader)
+
+	init, err := s.InitMultipartUpload(ctx, &model.InitMultipartUploadInput{Filename: "lecture.mp4", PartCount: 2})
+	require.NoError(t, err)
+	uploadId := repo.uploads[init.FileId].UploadId
+
+	// as many parts as requested, but not the requested ones
+	store.uploadPart(uploadId, 1, "a")
+	store.uploadPart(uploadId, 3, "c")
+
+	_, err = s.CompleteMultipartUpload(ctx, init.FileId)
+	assert.ErrorIs(t, err, errdefs.ValidationErr)
+	assert.Empty(t, store.objects)
+}
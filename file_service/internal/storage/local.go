@@ -0,0 +1,223 @@
+package storage
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fileservice/pkg/localstorage"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// LocalStore keeps objects in a local directory. Presigned URLs are HMAC-signed and served by the gateway
+// from the same directory, so both services must share it.
+type LocalStore struct {
+	storage *localstorage.Storage
+	baseURL string
+}
+
+func NewLocalStore(root string, signingKey string, gatewayPublicUrl string) (*LocalStore, error) {
+	if signingKey == "" {
+		return nil, errors.New("signing key is required for local storage")
+	}
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return nil, err
+	}
+	return &LocalStore{
+		storage: localstorage.New(root, signingKey),
+		baseURL: gatewayPublicUrl + "/files/local",
+	}, nil
+}
+
+func (s *LocalStore) PresignPut(ctx context.Context, key string, expires time.Duration) (*PresignedRequest, error) {
+	if _, err := s.storage.ObjectPath(key); err != nil {
+		return nil, err
+	}
+	url := s.storage.SignURL(s.baseURL, http.MethodPut, key, "", 0, expires)
+	return &PresignedRequest{URL: url, Method: http.MethodPut}, nil
+}
+
+func (s *LocalStore) PresignGet(ctx context.Context, key string, expires time.Duration) (*PresignedRequest, error) {
+	if _, err := s.storage.ObjectPath(key); err != nil {
+		return nil, err
+	}
+	url := s.storage.SignURL(s.baseURL, http.MethodGet, key, "", 0, expires)
+	return &PresignedRequest{URL: url, Method: http.MethodGet}, nil
+}
+
+func (s *LocalStore) Size(ctx context.Context, key string) (int64, error) {
+	name, err := s.storage.ObjectPath(key)
+	if err != nil {
+		return 0, err
+	}
+	info, err := os.Stat(name)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, ErrObjectNotFound
+	}
+	if err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
+}
+
+func (s *LocalStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	name, err := s.storage.ObjectPath(key)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(name)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrObjectNotFound
+	}
+	return f, err
+}
+
+func (s *LocalStore) Put(ctx context.Context, key string, body io.Reader, contentType string) error {
+	name, err := s.storage.ObjectPath(key)
+	if err != nil {
+		return err
+	}
+	_, err = localstorage.WriteFile(name, body)
+	return err
+}
+
+func (s *LocalStore) Delete(ctx context.Context, key string) error {
+	name, err := s.storage.ObjectPath(key)
+	if err != nil {
+		return err
+	}
+	err = os.Remove(name)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+func (s *LocalStore) CreateMultipartUpload(ctx context.Context, key string) (string, error) {
+	if _, err := s.storage.ObjectPath(key); err != nil {
+		return "", err
+	}
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+	uploadId := hex.EncodeToString(id)
+
+	dir, err := s.storage.UploadDir(uploadId)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+
+	return uploadId, nil
+}
+
+func (s *LocalStore) PresignUploadPart(ctx context.Context, key string, uploadId string, partNumber int32, expires time.Duration) (*PresignedRequest, error) {
+	if _, err := s.storage.PartPath(uploadId, partNumber); err != nil {
+		return nil, err
+	}
+	url := s.storage.SignURL(s.baseURL, http.MethodPut, key, uploadId, partNumber, expires)
+	return &PresignedRequest{URL: url, Method: http.MethodPut}, nil
+}
+
+func (s *LocalStore) ListParts(ctx context.Context, key string, uploadId string) ([]Part, error) {
+	dir, err := s.storage.UploadDir(uploadId)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrUploadNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var parts []Part
+	for _, entry := range entries {
+		partNumber, err := strconv.Atoi(entry.Name())
+		if err != nil || entry.IsDir() {
+			// temporary file of a part that is being uploaded
+			continue
+		}
+		etag, err := fileHash(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, Part{PartNumber: int32(partNumber), ETag: etag})
+	}
+
+	slices.SortFunc(parts, func(a, b Part) int {
+		return int(a.PartNumber - b.PartNumber)
+	})
+
+	return parts, nil
+}
+
+func (s *LocalStore) CompleteMultipartUpload(ctx context.Context, key string, uploadId string, parts []Part) error {
+	dir, err := s.storage.UploadDir(uploadId)
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(dir); errors.Is(err, os.ErrNotExist) {
+		return ErrUploadNotFound
+	}
+
+	name, err := s.storage.ObjectPath(key)
+	if err != nil {
+		return err
+	}
+
+	readers := make([]io.Reader, 0, len(parts))
+	for _, part := range parts {
+		partPath, err := s.storage.PartPath(uploadId, part.PartNumber)
+		if err != nil {
+			return err
+		}
+		f, err := os.Open(partPath)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		readers = append(readers, f)
+	}
+
+	if _, err := localstorage.WriteFile(name, io.MultiReader(readers...)); err != nil {
+		return err
+	}
+
+	return os.RemoveAll(dir)
+}
+
+func (s *LocalStore) AbortMultipartUpload(ctx context.Context, key string, uploadId string) error {
+	dir, err := s.storage.UploadDir(uploadId)
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(dir)
+}
+
+func fileHash(name string) (string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,141 @@
+package storage
+
+import (
+	"context"
+	"fileservice/pkg/localstorage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+const signingKey = "test-key"
+
+func newTestStore(t *testing.T) *LocalStore {
+	root := t.TempDir()
+
+	mux := http.NewServeMux()
+	mux.Handle("/files/local/", localstorage.New(root, signingKey).Handler("/files/local"))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	store, err := NewLocalStore(root, signingKey, server.URL)
+	require.NoError(t, err)
+
+	return store
+}
+
+func do(t *testing.T, method string, url string, body string) *http.Response {
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { resp.Body.Close() })
+	return resp
+}
+
+func TestLocalStore_PresignedUploadAndDownload(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t)
+
+	put, err := store.PresignPut(ctx, "file.txt", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, do(t, put.Method, put.URL, "content").StatusCode)
+
+	size, err := store.Size(ctx, "file.txt")
+	require.NoError(t, err)
+	assert.Equal(t, int64(len("content")), size)
+
+	get, err := store.PresignGet(ctx, "file.txt", time.Minute)
+	require.NoError(t, err)
+	resp := do(t, get.Method, get.URL, "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	body, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, "content", string(body))
+}
+
+func TestLocalStore_RejectsInvalidURLs(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t)
+	require.NoError(t, store.Put(ctx, "file.txt", strings.NewReader("content"), "text/plain"))
+
+	get, err := store.PresignGet(ctx, "file.txt", time.Minute)
+	require.NoError(t, err)
+
+	t.Run("method", func(t *testing.T) {
+		assert.Equal(t, http.StatusForbidden, do(t, http.MethodPut, get.URL, "other").StatusCode)
+	})
+
+	t.Run("key", func(t *testing.T) {
+		url := strings.Replace(get.URL, "file.txt", "other.txt", 1)
+		assert.Equal(t, http.StatusForbidden, do(t, http.MethodGet, url, "").StatusCode)
+	})
+
+	t.Run("signature", func(t *testing.T) {
+		url := strings.Replace(get.URL, "X-Signature=", "X-Signature=00", 1)
+		assert.Equal(t, http.StatusForbidden, do(t, http.MethodGet, url, "").StatusCode)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		expired, err := store.PresignGet(ctx, "file.txt", -time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusForbidden, do(t, http.MethodGet, expired.URL, "").StatusCode)
+	})
+
+	t.Run("traversal", func(t *testing.T) {
+		_, err := store.PresignGet(ctx, "../file.txt", time.Minute)
+		assert.ErrorIs(t, err, localstorage.ErrInvalidKey)
+	})
+}
+
+func TestLocalStore_MultipartUpload(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t)
+
+	uploadId, err := store.CreateMultipartUpload(ctx, "video.mp4")
+	require.NoError(t, err)
+
+	for i, content := range []string{"first-", "second-", "third"} {
+		part, err := store.PresignUploadPart(ctx, "video.mp4", uploadId, int32(i+1), time.Minute)
+		require.NoError(t, err)
+		resp := do(t, part.Method, part.URL, content)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.NotEmpty(t, resp.Header.Get("ETag"))
+	}
+
+	parts, err := store.ListParts(ctx, "video.mp4", uploadId)
+	require.NoError(t, err)
+	require.Len(t, parts, 3)
+	assert.Equal(t, int32(1), parts[0].PartNumber)
+
+	require.NoError(t, store.CompleteMultipartUpload(ctx, "video.mp4", uploadId, parts))
+
+	object, err := store.Get(ctx, "video.mp4")
+	require.NoError(t, err)
+	defer object.Close()
+	body, _ := io.ReadAll(object)
+	assert.Equal(t, "first-second-third", string(body))
+
+	_, err = store.ListParts(ctx, "video.mp4", uploadId)
+	assert.ErrorIs(t, err, ErrUploadNotFound)
+}
+
+func TestLocalStore_AbortMultipartUpload(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t)
+
+	uploadId, err := store.CreateMultipartUpload(ctx, "video.mp4")
+	require.NoError(t, err)
+	require.NoError(t, store.AbortMultipartUpload(ctx, "video.mp4", uploadId))
+
+	part, err := store.PresignUploadPart(ctx, "video.mp4", uploadId, 1, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, do(t, part.Method, part.URL, "content").StatusCode)
+
+	_, err = store.Size(ctx, "video.mp4")
+	assert.ErrorIs(t, err, ErrObjectNotFound)
+}
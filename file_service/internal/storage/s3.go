@@ -0,0 +1,218 @@
+package storage
+
+import (
+	"common_library/logging"
+	"context"
+	"errors"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"go.uber.org/zap"
+	"io"
+	"slices"
+	"strings"
+	"time"
+)
+
+// S3Store keeps objects in an S3-compatible bucket. Presigned URLs are rewritten to go through the gateway,
+// which proxies them to the storage endpoint.
+type S3Store struct {
+	client           *s3.Client
+	presigner        *s3.PresignClient
+	bucket           *string
+	endpoint         string
+	gatewayPublicUrl string
+}
+
+func NewS3Store(ctx context.Context, client *s3.Client, bucketName string, endpoint string, gatewayPublicUrl string) (*S3Store, error) {
+	s := &S3Store{
+		client:           client,
+		presigner:        s3.NewPresignClient(client),
+		bucket:           aws.String(bucketName),
+		endpoint:         endpoint,
+		gatewayPublicUrl: gatewayPublicUrl,
+	}
+	err := s.createBucket(ctx, bucketName)
+	return s, err
+}
+
+func (s *S3Store) PresignPut(ctx context.Context, key string, expires time.Duration) (*PresignedRequest, error) {
+	req, err := s.presigner.PresignPutObject(ctx, &s3.PutObjectInput{
+		Bucket: s.bucket,
+		Key:    aws.String(key),
+	},
+		s3.WithPresignExpires(expires),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &PresignedRequest{URL: s.publicURL(req.URL, "/files/upload"), Method: req.Method}, nil
+}
+
+func (s *S3Store) PresignGet(ctx context.Context, key string, expires time.Duration) (*PresignedRequest, error) {
+	req, err := s.presigner.PresignGetObject(ctx, &s3.GetObjectInput{
+		Bucket: s.bucket,
+		Key:    aws.String(key),
+	},
+		s3.WithPresignExpires(expires),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &PresignedRequest{URL: s.publicURL(req.URL, "/files/download"), Method: req.Method}, nil
+}
+
+func (s *S3Store) Size(ctx context.Context, key string) (int64, error) {
+	head, err := s.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: s.bucket,
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		var notFound *types.NotFound
+		if errors.As(err, &notFound) {
+			return 0, ErrObjectNotFound
+		}
+		return 0, err
+	}
+	return aws.ToInt64(head.ContentLength), nil
+}
+
+func (s *S3Store) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	object, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: s.bucket,
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		var noSuchKey *types.NoSuchKey
+		if errors.As(err, &noSuchKey) {
+			return nil, ErrObjectNotFound
+		}
+		return nil, err
+	}
+	return object.Body, nil
+}
+
+func (s *S3Store) Put(ctx context.Context, key string, body io.Reader, contentType string) error {
+	_, err := s.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      s.bucket,
+		Key:         aws.String(key),
+		Body:        body,
+		ContentType: aws.String(contentType),
+	})
+	return err
+}
+
+func (s *S3Store) Delete(ctx context.Context, key string) error {
+	_, err := s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: s.bucket,
+		Key:    aws.String(key),
+	})
+	return err
+}
+
+func (s *S3Store) CreateMultipartUpload(ctx context.Context, key string) (string, error) {
+	upload, err := s.client.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
+		Bucket: s.bucket,
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return "", err
+	}
+	return aws.ToString(upload.UploadId), nil
+}
+
+func (s *S3Store) PresignUploadPart(ctx context.Context, key string, uploadId string, partNumber int32, expires time.Duration) (*PresignedRequest, error) {
+	req, err := s.presigner.PresignUploadPart(ctx, &s3.UploadPartInput{
+		Bucket:     s.bucket,
+		Key:        aws.String(key),
+		UploadId:   aws.String(uploadId),
+		PartNumber: aws.Int32(partNumber),
+	},
+		s3.WithPresignExpires(expires),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &PresignedRequest{URL: s.publicURL(req.URL, "/files/upload"), Method: req.Method}, nil
+}
+
+func (s *S3Store) ListParts(ctx context.Context, key string, uploadId string) ([]Part, error) {
+	var parts []Part
+
+	paginator := s3.NewListPartsPaginator(s.client, &s3.ListPartsInput{
+		Bucket:   s.bucket,
+		Key:      aws.String(key),
+		UploadId: aws.String(uploadId),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			var noSuchUpload *types.NoSuchUpload
+			if errors.As(err, &noSuchUpload) {
+				return nil, ErrUploadNotFound
+			}
+			return nil, err
+		}
+		for _, part := range page.Parts {
+			parts = append(parts, Part{PartNumber: aws.ToInt32(part.PartNumber), ETag: aws.ToString(part.ETag)})
+		}
+	}
+
+	slices.SortFunc(parts, func(a, b Part) int {
+		return int(a.PartNumber - b.PartNumber)
+	})
+
+	return parts, nil
+}
+
+func (s *S3Store) CompleteMultipartUpload(ctx context.Context, key string, uploadId string, parts []Part) error {
+	completed := make([]types.CompletedPart, len(parts))
+	for i, part := range parts {
+		completed[i] = types.CompletedPart{ETag: aws.String(part.ETag), PartNumber: aws.Int32(part.PartNumber)}
+	}
+
+	_, err := s.client.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          s.bucket,
+		Key:             aws.String(key),
+		UploadId:        aws.String(uploadId),
+		MultipartUpload: &types.CompletedMultipartUpload{Parts: completed},
+	})
+	var noSuchUpload *types.NoSuchUpload
+	if errors.As(err, &noSuchUpload) {
+		return ErrUploadNotFound
+	}
+	return err
+}
+
+func (s *S3Store) AbortMultipartUpload(ctx context.Context, key string, uploadId string) error {
+	_, err := s.client.AbortMultipartUpload(ctx, &s3.AbortMultipartUploadInput{
+		Bucket:   s.bucket,
+		Key:      aws.String(key),
+		UploadId: aws.String(uploadId),
+	})
+	var noSuchUpload *types.NoSuchUpload
+	if errors.As(err, &noSuchUpload) {
+		return nil
+	}
+	return err
+}
+
+// publicURL replaces the storage endpoint with the gateway route that proxies to it.
+func (s *S3Store) publicURL(url string, route string) string {
+	return strings.Replace(url, s.endpoint, s.gatewayPublicUrl+route, 1)
+}
+
+func (s *S3Store) createBucket(ctx context.Context, name string) error {
+	_, err := s.client.CreateBucket(ctx, &s3.CreateBucketInput{Bucket: aws.String(name)})
+	if err != nil {
+		var opErr *http.ResponseError
+		if errors.As(err, &opErr) && opErr.HTTPStatusCode() == 409 {
+			if logger, ok := logging.GetFromContext(ctx); ok {
+				logger.Info(ctx, "Bucket already exists", zap.String("bucket", name))
+			}
+			return nil
+		}
+	}
+	return err
+}
@@ -0,0 +1,46 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+)
+
+var (
+	ErrObjectNotFound = errors.New("object not found")
+	ErrUploadNotFound = errors.New("multipart upload not found")
+)
+
+// PresignedRequest is a URL the client uses to upload or download an object without credentials.
+type PresignedRequest struct {
+	URL    string
+	Method string
+}
+
+type Part struct {
+	PartNumber int32
+	ETag       string
+}
+
+// FileStore stores file objects by key and issues presigned URLs for them.
+// Returned URLs are public: they point to the gateway rather than to the storage itself.
+type FileStore interface {
+	PresignPut(ctx context.Context, key string, expires time.Duration) (*PresignedRequest, error)
+	PresignGet(ctx context.Context, key string, expires time.Duration) (*PresignedRequest, error)
+
+	// Size returns ErrObjectNotFound if the object does not exist.
+	Size(ctx context.Context, key string) (int64, error)
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Put(ctx context.Context, key string, body io.Reader, contentType string) error
+	// Delete does not fail if the object does not exist.
+	Delete(ctx context.Context, key string) error
+
+	CreateMultipartUpload(ctx context.Context, key string) (string, error)
+	PresignUploadPart(ctx context.Context, key string, uploadId string, partNumber int32, expires time.Duration) (*PresignedRequest, error)
+	// ListParts returns uploaded parts sorted by number or ErrUploadNotFound.
+	ListParts(ctx context.Context, key string, uploadId string) ([]Part, error)
+	CompleteMultipartUpload(ctx context.Context, key string, uploadId string, parts []Part) error
+	// AbortMultipartUpload does not fail if the upload does not exist.
+	AbortMultipartUpload(ctx context.Context, key string, uploadId string) error
+}
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS file_references;
+ALTER TABLE files DROP COLUMN IF EXISTS released_at;
@@ -0,0 +1,9 @@
+ALTER TABLE files ADD COLUMN released_at TIMESTAMP;
+
+CREATE TABLE file_references (
+    file_id UUID NOT NULL REFERENCES files(id) ON DELETE CASCADE,
+    owner_type VARCHAR(32) NOT NULL,
+    owner_id UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY (file_id, owner_type, owner_id)
+);
@@ -0,0 +1,7 @@
+CREATE TABLE file_grants (
+    file_id UUID NOT NULL REFERENCES files(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    granted_by UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY (file_id, user_id)
+);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS multipart_uploads;
\ No newline at end of file
@@ -0,0 +1,6 @@
+CREATE TABLE multipart_uploads (
+    file_id UUID PRIMARY KEY REFERENCES files(id) ON DELETE CASCADE,
+    upload_id TEXT NOT NULL,
+    part_count INTEGER NOT NULL CHECK (part_count > 0),
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
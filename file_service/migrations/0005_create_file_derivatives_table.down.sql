@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS file_derivatives;
+ALTER TABLE files DROP COLUMN IF EXISTS derivatives_processed_at;
\ No newline at end of file
@@ -0,0 +1,9 @@
+ALTER TABLE files ADD COLUMN derivatives_processed_at TIMESTAMP;
+
+CREATE TABLE file_derivatives (
+    file_id UUID NOT NULL REFERENCES files(id) ON DELETE CASCADE,
+    variant VARCHAR(32) NOT NULL,
+    extension VARCHAR(32) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY (file_id, variant)
+);
@@ -0,0 +1 @@
+ALTER TABLE files DROP COLUMN IF EXISTS usage_tracked;
\ No newline at end of file
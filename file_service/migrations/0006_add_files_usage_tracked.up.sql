@@ -0,0 +1,3 @@
+-- references of files uploaded before usage tracking are unknown, such files are collected only after a release
+ALTER TABLE files ADD COLUMN usage_tracked BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE files ALTER COLUMN usage_tracked SET DEFAULT true;
@@ -22,9 +22,45 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Empty struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	mi := &file_file_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_file_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_file_service_proto_rawDescGZIP(), []int{0}
+}
+
 type InitUploadRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UploadedBy    string                 `protobuf:"bytes,1,opt,name=uploaded_by,json=uploadedBy,proto3" json:"uploaded_by,omitempty"` // user_id
+	UploadedBy    string                 `protobuf:"bytes,1,opt,name=uploaded_by,json=uploadedBy,proto3" json:"uploaded_by,omitempty"` // user_id, только для сервисов; пользователь загружает файл от своего имени
 	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`                       // имя файла (например: homework.pdf)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -32,7 +68,7 @@ type InitUploadRequest struct {
 
 func (x *InitUploadRequest) Reset() {
 	*x = InitUploadRequest{}
-	mi := &file_file_service_proto_msgTypes[0]
+	mi := &file_file_service_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -44,7 +80,7 @@ func (x *InitUploadRequest) String() string {
 func (*InitUploadRequest) ProtoMessage() {}
 
 func (x *InitUploadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_file_service_proto_msgTypes[0]
+	mi := &file_file_service_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -57,7 +93,7 @@ func (x *InitUploadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InitUploadRequest.ProtoReflect.Descriptor instead.
 func (*InitUploadRequest) Descriptor() ([]byte, []int) {
-	return file_file_service_proto_rawDescGZIP(), []int{0}
+	return file_file_service_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *InitUploadRequest) GetUploadedBy() string {
@@ -85,7 +121,7 @@ type InitUploadResponse struct {
 
 func (x *InitUploadResponse) Reset() {
 	*x = InitUploadResponse{}
-	mi := &file_file_service_proto_msgTypes[1]
+	mi := &file_file_service_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -97,7 +133,7 @@ func (x *InitUploadResponse) String() string {
 func (*InitUploadResponse) ProtoMessage() {}
 
 func (x *InitUploadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_file_service_proto_msgTypes[1]
+	mi := &file_file_service_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -110,7 +146,7 @@ func (x *InitUploadResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InitUploadResponse.ProtoReflect.Descriptor instead.
 func (*InitUploadResponse) Descriptor() ([]byte, []int) {
-	return file_file_service_proto_rawDescGZIP(), []int{1}
+	return file_file_service_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *InitUploadResponse) GetFileId() string {
@@ -134,28 +170,31 @@ func (x *InitUploadResponse) GetMethod() string {
 	return ""
 }
 
-type GenerateDownloadURLRequest struct {
+type UploadFileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	FileId        string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	UploadedBy    string                 `protobuf:"bytes,1,opt,name=uploaded_by,json=uploadedBy,proto3" json:"uploaded_by,omitempty"` // user_id, только для сервисов; пользователь загружает файл от своего имени
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`                       // имя файла (например: invoice-12.pdf)
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`                         // не больше 3 МиБ
+	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GenerateDownloadURLRequest) Reset() {
-	*x = GenerateDownloadURLRequest{}
-	mi := &file_file_service_proto_msgTypes[2]
+func (x *UploadFileRequest) Reset() {
+	*x = UploadFileRequest{}
+	mi := &file_file_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GenerateDownloadURLRequest) String() string {
+func (x *UploadFileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateDownloadURLRequest) ProtoMessage() {}
+func (*UploadFileRequest) ProtoMessage() {}
 
-func (x *GenerateDownloadURLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_file_service_proto_msgTypes[2]
+func (x *UploadFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -166,40 +205,63 @@ func (x *GenerateDownloadURLRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateDownloadURLRequest.ProtoReflect.Descriptor instead.
-func (*GenerateDownloadURLRequest) Descriptor() ([]byte, []int) {
-	return file_file_service_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use UploadFileRequest.ProtoReflect.Descriptor instead.
+func (*UploadFileRequest) Descriptor() ([]byte, []int) {
+	return file_file_service_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GenerateDownloadURLRequest) GetFileId() string {
+func (x *UploadFileRequest) GetUploadedBy() string {
 	if x != nil {
-		return x.FileId
+		return x.UploadedBy
 	}
 	return ""
 }
 
-type DownloadURL struct {
+func (x *UploadFileRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *UploadFileRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *UploadFileRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+type InitMultipartUploadRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	UploadedBy    string                 `protobuf:"bytes,1,opt,name=uploaded_by,json=uploadedBy,proto3" json:"uploaded_by,omitempty"` // не используется, автор загрузки — пользователь из x-user-id
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`                       // имя файла (например: explanation.mp4)
+	PartCount     int32                  `protobuf:"varint,3,opt,name=part_count,json=partCount,proto3" json:"part_count,omitempty"`   // количество частей (1..10000), все части кроме последней не меньше 5 МиБ
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DownloadURL) Reset() {
-	*x = DownloadURL{}
-	mi := &file_file_service_proto_msgTypes[3]
+func (x *InitMultipartUploadRequest) Reset() {
+	*x = InitMultipartUploadRequest{}
+	mi := &file_file_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DownloadURL) String() string {
+func (x *InitMultipartUploadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadURL) ProtoMessage() {}
+func (*InitMultipartUploadRequest) ProtoMessage() {}
 
-func (x *DownloadURL) ProtoReflect() protoreflect.Message {
-	mi := &file_file_service_proto_msgTypes[3]
+func (x *InitMultipartUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -210,40 +272,55 @@ func (x *DownloadURL) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownloadURL.ProtoReflect.Descriptor instead.
-func (*DownloadURL) Descriptor() ([]byte, []int) {
-	return file_file_service_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use InitMultipartUploadRequest.ProtoReflect.Descriptor instead.
+func (*InitMultipartUploadRequest) Descriptor() ([]byte, []int) {
+	return file_file_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *DownloadURL) GetUrl() string {
+func (x *InitMultipartUploadRequest) GetUploadedBy() string {
 	if x != nil {
-		return x.Url
+		return x.UploadedBy
 	}
 	return ""
 }
 
-type GetFileMetaRequest struct {
+func (x *InitMultipartUploadRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *InitMultipartUploadRequest) GetPartCount() int32 {
+	if x != nil {
+		return x.PartCount
+	}
+	return 0
+}
+
+type InitMultipartUploadResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	FileId        string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	Parts         []*UploadPartURL       `protobuf:"bytes,2,rep,name=parts,proto3" json:"parts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFileMetaRequest) Reset() {
-	*x = GetFileMetaRequest{}
-	mi := &file_file_service_proto_msgTypes[4]
+func (x *InitMultipartUploadResponse) Reset() {
+	*x = InitMultipartUploadResponse{}
+	mi := &file_file_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFileMetaRequest) String() string {
+func (x *InitMultipartUploadResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFileMetaRequest) ProtoMessage() {}
+func (*InitMultipartUploadResponse) ProtoMessage() {}
 
-func (x *GetFileMetaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_file_service_proto_msgTypes[4]
+func (x *InitMultipartUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_file_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -254,44 +331,49 @@ func (x *GetFileMetaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFileMetaRequest.ProtoReflect.Descriptor instead.
-func (*GetFileMetaRequest) Descriptor() ([]byte, []int) {
-	return file_file_service_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use InitMultipartUploadResponse.ProtoReflect.Descriptor instead.
+func (*InitMultipartUploadResponse) Descriptor() ([]byte, []int) {
+	return file_file_service_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetFileMetaRequest) GetFileId() string {
+func (x *InitMultipartUploadResponse) GetFileId() string {
 	if x != nil {
 		return x.FileId
 	}
 	return ""
 }
 
-type File struct {
+func (x *InitMultipartUploadResponse) GetParts() []*UploadPartURL {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+type UploadPartURL struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Extension     string                 `protobuf:"bytes,2,opt,name=extension,proto3" json:"extension,omitempty"`
-	UploadedBy    string                 `protobuf:"bytes,3,opt,name=uploaded_by,json=uploadedBy,proto3" json:"uploaded_by,omitempty"`
-	Filename      *string                `protobuf:"bytes,4,opt,name=filename,proto3,oneof" json:"filename,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	PartNumber    int32                  `protobuf:"varint,1,opt,name=part_number,json=partNumber,proto3" json:"part_number,omitempty"`
+	UploadUrl     string                 `protobuf:"bytes,2,opt,name=upload_url,json=uploadUrl,proto3" json:"upload_url,omitempty"`
+	Method        string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *File) Reset() {
-	*x = File{}
-	mi := &file_file_service_proto_msgTypes[5]
+func (x *UploadPartURL) Reset() {
+	*x = UploadPartURL{}
+	mi := &file_file_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *File) String() string {
+func (x *UploadPartURL) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*File) ProtoMessage() {}
+func (*UploadPartURL) ProtoMessage() {}
 
-func (x *File) ProtoReflect() protoreflect.Message {
-	mi := &file_file_service_proto_msgTypes[5]
+func (x *UploadPartURL) ProtoReflect() protoreflect.Message {
+	mi := &file_file_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -302,80 +384,928 @@ func (x *File) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use File.ProtoReflect.Descriptor instead.
-func (*File) Descriptor() ([]byte, []int) {
-	return file_file_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use UploadPartURL.ProtoReflect.Descriptor instead.
+func (*UploadPartURL) Descriptor() ([]byte, []int) {
+	return file_file_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *File) GetId() string {
+func (x *UploadPartURL) GetPartNumber() int32 {
 	if x != nil {
-		return x.Id
+		return x.PartNumber
 	}
-	return ""
+	return 0
 }
 
-func (x *File) GetExtension() string {
+func (x *UploadPartURL) GetUploadUrl() string {
 	if x != nil {
-		return x.Extension
+		return x.UploadUrl
 	}
 	return ""
 }
 
-func (x *File) GetUploadedBy() string {
+func (x *UploadPartURL) GetMethod() string {
 	if x != nil {
-		return x.UploadedBy
+		return x.Method
 	}
 	return ""
 }
 
-func (x *File) GetFilename() string {
-	if x != nil && x.Filename != nil {
-		return *x.Filename
+type GenerateUploadPartURLsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileId        string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	PartNumbers   []int32                `protobuf:"varint,2,rep,packed,name=part_numbers,json=partNumbers,proto3" json:"part_numbers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateUploadPartURLsRequest) Reset() {
+	*x = GenerateUploadPartURLsRequest{}
+	mi := &file_file_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateUploadPartURLsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateUploadPartURLsRequest) ProtoMessage() {}
+
+func (x *GenerateUploadPartURLsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateUploadPartURLsRequest.ProtoReflect.Descriptor instead.
+func (*GenerateUploadPartURLsRequest) Descriptor() ([]byte, []int) {
+	return file_file_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GenerateUploadPartURLsRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
 	}
 	return ""
 }
 
-func (x *File) GetCreatedAt() *timestamppb.Timestamp {
+func (x *GenerateUploadPartURLsRequest) GetPartNumbers() []int32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.PartNumbers
 	}
 	return nil
 }
 
-var File_file_service_proto protoreflect.FileDescriptor
+type UploadPartURLs struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Parts               []*UploadPartURL       `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
+	UploadedPartNumbers []int32                `protobuf:"varint,2,rep,packed,name=uploaded_part_numbers,json=uploadedPartNumbers,proto3" json:"uploaded_part_numbers,omitempty"` // части, которые уже загружены
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
 
-const file_file_service_proto_rawDesc = "" +
-	"\n" +
-	"\x12file_service.proto\x12\afile.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"P\n" +
-	"\x11InitUploadRequest\x12\x1f\n" +
-	"\vuploaded_by\x18\x01 \x01(\tR\n" +
-	"uploadedBy\x12\x1a\n" +
-	"\bfilename\x18\x02 \x01(\tR\bfilename\"d\n" +
-	"\x12InitUploadResponse\x12\x17\n" +
-	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12\x1d\n" +
-	"\n" +
-	"upload_url\x18\x02 \x01(\tR\tuploadUrl\x12\x16\n" +
-	"\x06method\x18\x03 \x01(\tR\x06method\"5\n" +
-	"\x1aGenerateDownloadURLRequest\x12\x17\n" +
-	"\afile_id\x18\x01 \x01(\tR\x06fileId\"\x1f\n" +
-	"\vDownloadURL\x12\x10\n" +
-	"\x03url\x18\x01 \x01(\tR\x03url\"-\n" +
-	"\x12GetFileMetaRequest\x12\x17\n" +
-	"\afile_id\x18\x01 \x01(\tR\x06fileId\"\xbe\x01\n" +
-	"\x04File\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
-	"\textension\x18\x02 \x01(\tR\textension\x12\x1f\n" +
-	"\vuploaded_by\x18\x03 \x01(\tR\n" +
-	"uploadedBy\x12\x1f\n" +
-	"\bfilename\x18\x04 \x01(\tH\x00R\bfilename\x88\x01\x01\x129\n" +
-	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\v\n" +
-	"\t_filename2\xe1\x01\n" +
-	"\vFileService\x12E\n" +
-	"\n" +
-	"InitUpload\x12\x1a.file.v1.InitUploadRequest\x1a\x1b.file.v1.InitUploadResponse\x12P\n" +
-	"\x13GenerateDownloadURL\x12#.file.v1.GenerateDownloadURLRequest\x1a\x14.file.v1.DownloadURL\x129\n" +
-	"\vGetFileMeta\x12\x1b.file.v1.GetFileMetaRequest\x1a\r.file.v1.FileB\tZ\apkg/apib\x06proto3"
+func (x *UploadPartURLs) Reset() {
+	*x = UploadPartURLs{}
+	mi := &file_file_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadPartURLs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadPartURLs) ProtoMessage() {}
+
+func (x *UploadPartURLs) ProtoReflect() protoreflect.Message {
+	mi := &file_file_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadPartURLs.ProtoReflect.Descriptor instead.
+func (*UploadPartURLs) Descriptor() ([]byte, []int) {
+	return file_file_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UploadPartURLs) GetParts() []*UploadPartURL {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+func (x *UploadPartURLs) GetUploadedPartNumbers() []int32 {
+	if x != nil {
+		return x.UploadedPartNumbers
+	}
+	return nil
+}
+
+type MultipartUploadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileId        string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultipartUploadRequest) Reset() {
+	*x = MultipartUploadRequest{}
+	mi := &file_file_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultipartUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultipartUploadRequest) ProtoMessage() {}
+
+func (x *MultipartUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultipartUploadRequest.ProtoReflect.Descriptor instead.
+func (*MultipartUploadRequest) Descriptor() ([]byte, []int) {
+	return file_file_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MultipartUploadRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+type GenerateDownloadURLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileId        string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	Variant       *string                `protobuf:"bytes,2,opt,name=variant,proto3,oneof" json:"variant,omitempty"` // thumbnail, thumbnail_webp, preview, preview_webp; пусто — оригинал
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateDownloadURLRequest) Reset() {
+	*x = GenerateDownloadURLRequest{}
+	mi := &file_file_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateDownloadURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateDownloadURLRequest) ProtoMessage() {}
+
+func (x *GenerateDownloadURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateDownloadURLRequest.ProtoReflect.Descriptor instead.
+func (*GenerateDownloadURLRequest) Descriptor() ([]byte, []int) {
+	return file_file_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GenerateDownloadURLRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *GenerateDownloadURLRequest) GetVariant() string {
+	if x != nil && x.Variant != nil {
+		return *x.Variant
+	}
+	return ""
+}
+
+type DownloadURL struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadURL) Reset() {
+	*x = DownloadURL{}
+	mi := &file_file_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadURL) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadURL) ProtoMessage() {}
+
+func (x *DownloadURL) ProtoReflect() protoreflect.Message {
+	mi := &file_file_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadURL.ProtoReflect.Descriptor instead.
+func (*DownloadURL) Descriptor() ([]byte, []int) {
+	return file_file_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DownloadURL) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type GetFileMetaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileId        string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFileMetaRequest) Reset() {
+	*x = GetFileMetaRequest{}
+	mi := &file_file_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFileMetaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileMetaRequest) ProtoMessage() {}
+
+func (x *GetFileMetaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileMetaRequest.ProtoReflect.Descriptor instead.
+func (*GetFileMetaRequest) Descriptor() ([]byte, []int) {
+	return file_file_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetFileMetaRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+type File struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Extension     string                 `protobuf:"bytes,2,opt,name=extension,proto3" json:"extension,omitempty"`
+	UploadedBy    string                 `protobuf:"bytes,3,opt,name=uploaded_by,json=uploadedBy,proto3" json:"uploaded_by,omitempty"`
+	Filename      *string                `protobuf:"bytes,4,opt,name=filename,proto3,oneof" json:"filename,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *File) Reset() {
+	*x = File{}
+	mi := &file_file_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *File) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*File) ProtoMessage() {}
+
+func (x *File) ProtoReflect() protoreflect.Message {
+	mi := &file_file_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use File.ProtoReflect.Descriptor instead.
+func (*File) Descriptor() ([]byte, []int) {
+	return file_file_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *File) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *File) GetExtension() string {
+	if x != nil {
+		return x.Extension
+	}
+	return ""
+}
+
+func (x *File) GetUploadedBy() string {
+	if x != nil {
+		return x.UploadedBy
+	}
+	return ""
+}
+
+func (x *File) GetFilename() string {
+	if x != nil && x.Filename != nil {
+		return *x.Filename
+	}
+	return ""
+}
+
+func (x *File) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type FileUsage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileId        string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	OwnerType     string                 `protobuf:"bytes,2,opt,name=owner_type,json=ownerType,proto3" json:"owner_type,omitempty"` // тип сущности-владельца (например: assignment, receipt)
+	OwnerId       string                 `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`       // id сущности-владельца
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileUsage) Reset() {
+	*x = FileUsage{}
+	mi := &file_file_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileUsage) ProtoMessage() {}
+
+func (x *FileUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_file_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileUsage.ProtoReflect.Descriptor instead.
+func (*FileUsage) Descriptor() ([]byte, []int) {
+	return file_file_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *FileUsage) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *FileUsage) GetOwnerType() string {
+	if x != nil {
+		return x.OwnerType
+	}
+	return ""
+}
+
+func (x *FileUsage) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+type FileAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileId        string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	UserIds       []string               `protobuf:"bytes,2,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileAccessRequest) Reset() {
+	*x = FileAccessRequest{}
+	mi := &file_file_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileAccessRequest) ProtoMessage() {}
+
+func (x *FileAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileAccessRequest.ProtoReflect.Descriptor instead.
+func (*FileAccessRequest) Descriptor() ([]byte, []int) {
+	return file_file_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *FileAccessRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *FileAccessRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type CollectGarbageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DryRun        bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectGarbageRequest) Reset() {
+	*x = CollectGarbageRequest{}
+	mi := &file_file_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectGarbageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectGarbageRequest) ProtoMessage() {}
+
+func (x *CollectGarbageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectGarbageRequest.ProtoReflect.Descriptor instead.
+func (*CollectGarbageRequest) Descriptor() ([]byte, []int) {
+	return file_file_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CollectGarbageRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type CollectGarbageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Files         []*File                `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"` // удалённые файлы (или файлы, которые были бы удалены при dry_run)
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectGarbageResponse) Reset() {
+	*x = CollectGarbageResponse{}
+	mi := &file_file_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectGarbageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectGarbageResponse) ProtoMessage() {}
+
+func (x *CollectGarbageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_file_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectGarbageResponse.ProtoReflect.Descriptor instead.
+func (*CollectGarbageResponse) Descriptor() ([]byte, []int) {
+	return file_file_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CollectGarbageResponse) GetFiles() []*File {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *CollectGarbageResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type CreateArchiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       string                 `protobuf:"bytes,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"` // user_id, автор архива
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`              // имя архива, расширение .zip
+	Entries       []*ArchiveEntry        `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"`
+	Files         []*ArchiveFile         `protobuf:"bytes,4,rep,name=files,proto3" json:"files,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateArchiveRequest) Reset() {
+	*x = CreateArchiveRequest{}
+	mi := &file_file_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateArchiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateArchiveRequest) ProtoMessage() {}
+
+func (x *CreateArchiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateArchiveRequest.ProtoReflect.Descriptor instead.
+func (*CreateArchiveRequest) Descriptor() ([]byte, []int) {
+	return file_file_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateArchiveRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *CreateArchiveRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *CreateArchiveRequest) GetEntries() []*ArchiveEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *CreateArchiveRequest) GetFiles() []*ArchiveFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type ArchiveEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // путь внутри архива (например: lessons.json)
+	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveEntry) Reset() {
+	*x = ArchiveEntry{}
+	mi := &file_file_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveEntry) ProtoMessage() {}
+
+func (x *ArchiveEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_file_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveEntry.ProtoReflect.Descriptor instead.
+func (*ArchiveEntry) Descriptor() ([]byte, []int) {
+	return file_file_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ArchiveEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ArchiveEntry) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type ArchiveFile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileId        string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"` // файлы, к которым у владельца нет доступа, пропускаются
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`             // путь внутри архива; по умолчанию files/<file_id>/<filename>
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveFile) Reset() {
+	*x = ArchiveFile{}
+	mi := &file_file_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveFile) ProtoMessage() {}
+
+func (x *ArchiveFile) ProtoReflect() protoreflect.Message {
+	mi := &file_file_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveFile.ProtoReflect.Descriptor instead.
+func (*ArchiveFile) Descriptor() ([]byte, []int) {
+	return file_file_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ArchiveFile) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *ArchiveFile) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+type ForgetUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForgetUserRequest) Reset() {
+	*x = ForgetUserRequest{}
+	mi := &file_file_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForgetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForgetUserRequest) ProtoMessage() {}
+
+func (x *ForgetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForgetUserRequest.ProtoReflect.Descriptor instead.
+func (*ForgetUserRequest) Descriptor() ([]byte, []int) {
+	return file_file_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ForgetUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+var File_file_service_proto protoreflect.FileDescriptor
+
+const file_file_service_proto_rawDesc = "" +
+	"\n" +
+	"\x12file_service.proto\x12\afile.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\a\n" +
+	"\x05Empty\"P\n" +
+	"\x11InitUploadRequest\x12\x1f\n" +
+	"\vuploaded_by\x18\x01 \x01(\tR\n" +
+	"uploadedBy\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\"d\n" +
+	"\x12InitUploadResponse\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12\x1d\n" +
+	"\n" +
+	"upload_url\x18\x02 \x01(\tR\tuploadUrl\x12\x16\n" +
+	"\x06method\x18\x03 \x01(\tR\x06method\"\x8d\x01\n" +
+	"\x11UploadFileRequest\x12\x1f\n" +
+	"\vuploaded_by\x18\x01 \x01(\tR\n" +
+	"uploadedBy\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\"x\n" +
+	"\x1aInitMultipartUploadRequest\x12\x1f\n" +
+	"\vuploaded_by\x18\x01 \x01(\tR\n" +
+	"uploadedBy\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\x1d\n" +
+	"\n" +
+	"part_count\x18\x03 \x01(\x05R\tpartCount\"d\n" +
+	"\x1bInitMultipartUploadResponse\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12,\n" +
+	"\x05parts\x18\x02 \x03(\v2\x16.file.v1.UploadPartURLR\x05parts\"g\n" +
+	"\rUploadPartURL\x12\x1f\n" +
+	"\vpart_number\x18\x01 \x01(\x05R\n" +
+	"partNumber\x12\x1d\n" +
+	"\n" +
+	"upload_url\x18\x02 \x01(\tR\tuploadUrl\x12\x16\n" +
+	"\x06method\x18\x03 \x01(\tR\x06method\"[\n" +
+	"\x1dGenerateUploadPartURLsRequest\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12!\n" +
+	"\fpart_numbers\x18\x02 \x03(\x05R\vpartNumbers\"r\n" +
+	"\x0eUploadPartURLs\x12,\n" +
+	"\x05parts\x18\x01 \x03(\v2\x16.file.v1.UploadPartURLR\x05parts\x122\n" +
+	"\x15uploaded_part_numbers\x18\x02 \x03(\x05R\x13uploadedPartNumbers\"1\n" +
+	"\x16MultipartUploadRequest\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\tR\x06fileId\"`\n" +
+	"\x1aGenerateDownloadURLRequest\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12\x1d\n" +
+	"\avariant\x18\x02 \x01(\tH\x00R\avariant\x88\x01\x01B\n" +
+	"\n" +
+	"\b_variant\"\x1f\n" +
+	"\vDownloadURL\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"-\n" +
+	"\x12GetFileMetaRequest\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\tR\x06fileId\"\xbe\x01\n" +
+	"\x04File\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\textension\x18\x02 \x01(\tR\textension\x12\x1f\n" +
+	"\vuploaded_by\x18\x03 \x01(\tR\n" +
+	"uploadedBy\x12\x1f\n" +
+	"\bfilename\x18\x04 \x01(\tH\x00R\bfilename\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\v\n" +
+	"\t_filename\"^\n" +
+	"\tFileUsage\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12\x1d\n" +
+	"\n" +
+	"owner_type\x18\x02 \x01(\tR\townerType\x12\x19\n" +
+	"\bowner_id\x18\x03 \x01(\tR\aownerId\"G\n" +
+	"\x11FileAccessRequest\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12\x19\n" +
+	"\buser_ids\x18\x02 \x03(\tR\auserIds\"0\n" +
+	"\x15CollectGarbageRequest\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\"V\n" +
+	"\x16CollectGarbageResponse\x12#\n" +
+	"\x05files\x18\x01 \x03(\v2\r.file.v1.FileR\x05files\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"\xaa\x01\n" +
+	"\x14CreateArchiveRequest\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\tR\aownerId\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12/\n" +
+	"\aentries\x18\x03 \x03(\v2\x15.file.v1.ArchiveEntryR\aentries\x12*\n" +
+	"\x05files\x18\x04 \x03(\v2\x14.file.v1.ArchiveFileR\x05files\"<\n" +
+	"\fArchiveEntry\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\"H\n" +
+	"\vArchiveFile\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01B\a\n" +
+	"\x05_name\",\n" +
+	"\x11ForgetUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId2\xaa\b\n" +
+	"\vFileService\x12E\n" +
+	"\n" +
+	"InitUpload\x12\x1a.file.v1.InitUploadRequest\x1a\x1b.file.v1.InitUploadResponse\x127\n" +
+	"\n" +
+	"UploadFile\x12\x1a.file.v1.UploadFileRequest\x1a\r.file.v1.File\x12`\n" +
+	"\x13InitMultipartUpload\x12#.file.v1.InitMultipartUploadRequest\x1a$.file.v1.InitMultipartUploadResponse\x12Y\n" +
+	"\x16GenerateUploadPartURLs\x12&.file.v1.GenerateUploadPartURLsRequest\x1a\x17.file.v1.UploadPartURLs\x12I\n" +
+	"\x17CompleteMultipartUpload\x12\x1f.file.v1.MultipartUploadRequest\x1a\r.file.v1.File\x12G\n" +
+	"\x14AbortMultipartUpload\x12\x1f.file.v1.MultipartUploadRequest\x1a\x0e.file.v1.Empty\x12P\n" +
+	"\x13GenerateDownloadURL\x12#.file.v1.GenerateDownloadURLRequest\x1a\x14.file.v1.DownloadURL\x129\n" +
+	"\vGetFileMeta\x12\x1b.file.v1.GetFileMetaRequest\x1a\r.file.v1.File\x127\n" +
+	"\x11RegisterFileUsage\x12\x12.file.v1.FileUsage\x1a\x0e.file.v1.Empty\x129\n" +
+	"\x13UnregisterFileUsage\x12\x12.file.v1.FileUsage\x1a\x0e.file.v1.Empty\x12=\n" +
+	"\x0fGrantFileAccess\x12\x1a.file.v1.FileAccessRequest\x1a\x0e.file.v1.Empty\x12>\n" +
+	"\x10RevokeFileAccess\x12\x1a.file.v1.FileAccessRequest\x1a\x0e.file.v1.Empty\x12Q\n" +
+	"\x0eCollectGarbage\x12\x1e.file.v1.CollectGarbageRequest\x1a\x1f.file.v1.CollectGarbageResponse\x12=\n" +
+	"\rCreateArchive\x12\x1d.file.v1.CreateArchiveRequest\x1a\r.file.v1.File\x128\n" +
+	"\n" +
+	"ForgetUser\x12\x1a.file.v1.ForgetUserRequest\x1a\x0e.file.v1.EmptyB\tZ\apkg/apib\x06proto3"
 
 var (
 	file_file_service_proto_rawDescOnce sync.Once
@@ -389,29 +1319,74 @@ func file_file_service_proto_rawDescGZIP() []byte {
 	return file_file_service_proto_rawDescData
 }
 
-var file_file_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_file_service_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_file_service_proto_goTypes = []any{
-	(*InitUploadRequest)(nil),          // 0: file.v1.InitUploadRequest
-	(*InitUploadResponse)(nil),         // 1: file.v1.InitUploadResponse
-	(*GenerateDownloadURLRequest)(nil), // 2: file.v1.GenerateDownloadURLRequest
-	(*DownloadURL)(nil),                // 3: file.v1.DownloadURL
-	(*GetFileMetaRequest)(nil),         // 4: file.v1.GetFileMetaRequest
-	(*File)(nil),                       // 5: file.v1.File
-	(*timestamppb.Timestamp)(nil),      // 6: google.protobuf.Timestamp
+	(*Empty)(nil),                         // 0: file.v1.Empty
+	(*InitUploadRequest)(nil),             // 1: file.v1.InitUploadRequest
+	(*InitUploadResponse)(nil),            // 2: file.v1.InitUploadResponse
+	(*UploadFileRequest)(nil),             // 3: file.v1.UploadFileRequest
+	(*InitMultipartUploadRequest)(nil),    // 4: file.v1.InitMultipartUploadRequest
+	(*InitMultipartUploadResponse)(nil),   // 5: file.v1.InitMultipartUploadResponse
+	(*UploadPartURL)(nil),                 // 6: file.v1.UploadPartURL
+	(*GenerateUploadPartURLsRequest)(nil), // 7: file.v1.GenerateUploadPartURLsRequest
+	(*UploadPartURLs)(nil),                // 8: file.v1.UploadPartURLs
+	(*MultipartUploadRequest)(nil),        // 9: file.v1.MultipartUploadRequest
+	(*GenerateDownloadURLRequest)(nil),    // 10: file.v1.GenerateDownloadURLRequest
+	(*DownloadURL)(nil),                   // 11: file.v1.DownloadURL
+	(*GetFileMetaRequest)(nil),            // 12: file.v1.GetFileMetaRequest
+	(*File)(nil),                          // 13: file.v1.File
+	(*FileUsage)(nil),                     // 14: file.v1.FileUsage
+	(*FileAccessRequest)(nil),             // 15: file.v1.FileAccessRequest
+	(*CollectGarbageRequest)(nil),         // 16: file.v1.CollectGarbageRequest
+	(*CollectGarbageResponse)(nil),        // 17: file.v1.CollectGarbageResponse
+	(*CreateArchiveRequest)(nil),          // 18: file.v1.CreateArchiveRequest
+	(*ArchiveEntry)(nil),                  // 19: file.v1.ArchiveEntry
+	(*ArchiveFile)(nil),                   // 20: file.v1.ArchiveFile
+	(*ForgetUserRequest)(nil),             // 21: file.v1.ForgetUserRequest
+	(*timestamppb.Timestamp)(nil),         // 22: google.protobuf.Timestamp
 }
 var file_file_service_proto_depIdxs = []int32{
-	6, // 0: file.v1.File.created_at:type_name -> google.protobuf.Timestamp
-	0, // 1: file.v1.FileService.InitUpload:input_type -> file.v1.InitUploadRequest
-	2, // 2: file.v1.FileService.GenerateDownloadURL:input_type -> file.v1.GenerateDownloadURLRequest
-	4, // 3: file.v1.FileService.GetFileMeta:input_type -> file.v1.GetFileMetaRequest
-	1, // 4: file.v1.FileService.InitUpload:output_type -> file.v1.InitUploadResponse
-	3, // 5: file.v1.FileService.GenerateDownloadURL:output_type -> file.v1.DownloadURL
-	5, // 6: file.v1.FileService.GetFileMeta:output_type -> file.v1.File
-	4, // [4:7] is the sub-list for method output_type
-	1, // [1:4] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	6,  // 0: file.v1.InitMultipartUploadResponse.parts:type_name -> file.v1.UploadPartURL
+	6,  // 1: file.v1.UploadPartURLs.parts:type_name -> file.v1.UploadPartURL
+	22, // 2: file.v1.File.created_at:type_name -> google.protobuf.Timestamp
+	13, // 3: file.v1.CollectGarbageResponse.files:type_name -> file.v1.File
+	19, // 4: file.v1.CreateArchiveRequest.entries:type_name -> file.v1.ArchiveEntry
+	20, // 5: file.v1.CreateArchiveRequest.files:type_name -> file.v1.ArchiveFile
+	1,  // 6: file.v1.FileService.InitUpload:input_type -> file.v1.InitUploadRequest
+	3,  // 7: file.v1.FileService.UploadFile:input_type -> file.v1.UploadFileRequest
+	4,  // 8: file.v1.FileService.InitMultipartUpload:input_type -> file.v1.InitMultipartUploadRequest
+	7,  // 9: file.v1.FileService.GenerateUploadPartURLs:input_type -> file.v1.GenerateUploadPartURLsRequest
+	9,  // 10: file.v1.FileService.CompleteMultipartUpload:input_type -> file.v1.MultipartUploadRequest
+	9,  // 11: file.v1.FileService.AbortMultipartUpload:input_type -> file.v1.MultipartUploadRequest
+	10, // 12: file.v1.FileService.GenerateDownloadURL:input_type -> file.v1.GenerateDownloadURLRequest
+	12, // 13: file.v1.FileService.GetFileMeta:input_type -> file.v1.GetFileMetaRequest
+	14, // 14: file.v1.FileService.RegisterFileUsage:input_type -> file.v1.FileUsage
+	14, // 15: file.v1.FileService.UnregisterFileUsage:input_type -> file.v1.FileUsage
+	15, // 16: file.v1.FileService.GrantFileAccess:input_type -> file.v1.FileAccessRequest
+	15, // 17: file.v1.FileService.RevokeFileAccess:input_type -> file.v1.FileAccessRequest
+	16, // 18: file.v1.FileService.CollectGarbage:input_type -> file.v1.CollectGarbageRequest
+	18, // 19: file.v1.FileService.CreateArchive:input_type -> file.v1.CreateArchiveRequest
+	21, // 20: file.v1.FileService.ForgetUser:input_type -> file.v1.ForgetUserRequest
+	2,  // 21: file.v1.FileService.InitUpload:output_type -> file.v1.InitUploadResponse
+	13, // 22: file.v1.FileService.UploadFile:output_type -> file.v1.File
+	5,  // 23: file.v1.FileService.InitMultipartUpload:output_type -> file.v1.InitMultipartUploadResponse
+	8,  // 24: file.v1.FileService.GenerateUploadPartURLs:output_type -> file.v1.UploadPartURLs
+	13, // 25: file.v1.FileService.CompleteMultipartUpload:output_type -> file.v1.File
+	0,  // 26: file.v1.FileService.AbortMultipartUpload:output_type -> file.v1.Empty
+	11, // 27: file.v1.FileService.GenerateDownloadURL:output_type -> file.v1.DownloadURL
+	13, // 28: file.v1.FileService.GetFileMeta:output_type -> file.v1.File
+	0,  // 29: file.v1.FileService.RegisterFileUsage:output_type -> file.v1.Empty
+	0,  // 30: file.v1.FileService.UnregisterFileUsage:output_type -> file.v1.Empty
+	0,  // 31: file.v1.FileService.GrantFileAccess:output_type -> file.v1.Empty
+	0,  // 32: file.v1.FileService.RevokeFileAccess:output_type -> file.v1.Empty
+	17, // 33: file.v1.FileService.CollectGarbage:output_type -> file.v1.CollectGarbageResponse
+	13, // 34: file.v1.FileService.CreateArchive:output_type -> file.v1.File
+	0,  // 35: file.v1.FileService.ForgetUser:output_type -> file.v1.Empty
+	21, // [21:36] is the sub-list for method output_type
+	6,  // [6:21] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_file_service_proto_init() }
@@ -419,14 +1394,16 @@ func file_file_service_proto_init() {
 	if File_file_service_proto != nil {
 		return
 	}
-	file_file_service_proto_msgTypes[5].OneofWrappers = []any{}
+	file_file_service_proto_msgTypes[10].OneofWrappers = []any{}
+	file_file_service_proto_msgTypes[13].OneofWrappers = []any{}
+	file_file_service_proto_msgTypes[20].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_file_service_proto_rawDesc), len(file_file_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,9 +19,21 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	FileService_InitUpload_FullMethodName          = "/file.v1.FileService/InitUpload"
-	FileService_GenerateDownloadURL_FullMethodName = "/file.v1.FileService/GenerateDownloadURL"
-	FileService_GetFileMeta_FullMethodName         = "/file.v1.FileService/GetFileMeta"
+	FileService_InitUpload_FullMethodName              = "/file.v1.FileService/InitUpload"
+	FileService_UploadFile_FullMethodName              = "/file.v1.FileService/UploadFile"
+	FileService_InitMultipartUpload_FullMethodName     = "/file.v1.FileService/InitMultipartUpload"
+	FileService_GenerateUploadPartURLs_FullMethodName  = "/file.v1.FileService/GenerateUploadPartURLs"
+	FileService_CompleteMultipartUpload_FullMethodName = "/file.v1.FileService/CompleteMultipartUpload"
+	FileService_AbortMultipartUpload_FullMethodName    = "/file.v1.FileService/AbortMultipartUpload"
+	FileService_GenerateDownloadURL_FullMethodName     = "/file.v1.FileService/GenerateDownloadURL"
+	FileService_GetFileMeta_FullMethodName             = "/file.v1.FileService/GetFileMeta"
+	FileService_RegisterFileUsage_FullMethodName       = "/file.v1.FileService/RegisterFileUsage"
+	FileService_UnregisterFileUsage_FullMethodName     = "/file.v1.FileService/UnregisterFileUsage"
+	FileService_GrantFileAccess_FullMethodName         = "/file.v1.FileService/GrantFileAccess"
+	FileService_RevokeFileAccess_FullMethodName        = "/file.v1.FileService/RevokeFileAccess"
+	FileService_CollectGarbage_FullMethodName          = "/file.v1.FileService/CollectGarbage"
+	FileService_CreateArchive_FullMethodName           = "/file.v1.FileService/CreateArchive"
+	FileService_ForgetUser_FullMethodName              = "/file.v1.FileService/ForgetUser"
 )
 
 // FileServiceClient is the client API for FileService service.
@@ -30,10 +42,34 @@ const (
 type FileServiceClient interface {
 	// Инициализация загрузки файла: создаёт запись и возвращает временную ссылку
 	InitUpload(ctx context.Context, in *InitUploadRequest, opts ...grpc.CallOption) (*InitUploadResponse, error)
-	// Получение временной ссылки на скачивание файла
+	// Загрузка небольшого файла целиком через gRPC (для документов, которые генерируют другие сервисы)
+	UploadFile(ctx context.Context, in *UploadFileRequest, opts ...grpc.CallOption) (*File, error)
+	// Инициализация multipart-загрузки большого файла: создаёт запись и возвращает ссылки на загрузку частей
+	InitMultipartUpload(ctx context.Context, in *InitMultipartUploadRequest, opts ...grpc.CallOption) (*InitMultipartUploadResponse, error)
+	// Получение новых ссылок на загрузку частей (например, для продолжения прерванной загрузки)
+	GenerateUploadPartURLs(ctx context.Context, in *GenerateUploadPartURLsRequest, opts ...grpc.CallOption) (*UploadPartURLs, error)
+	// Завершение multipart-загрузки: собирает загруженные части в файл (только автор файла)
+	CompleteMultipartUpload(ctx context.Context, in *MultipartUploadRequest, opts ...grpc.CallOption) (*File, error)
+	// Отмена multipart-загрузки (только автор файла)
+	AbortMultipartUpload(ctx context.Context, in *MultipartUploadRequest, opts ...grpc.CallOption) (*Empty, error)
+	// Получение временной ссылки на скачивание файла или его миниатюры/превью (доступно автору файла и пользователям с выданным доступом)
 	GenerateDownloadURL(ctx context.Context, in *GenerateDownloadURLRequest, opts ...grpc.CallOption) (*DownloadURL, error)
 	// Получение метаданных файла
 	GetFileMeta(ctx context.Context, in *GetFileMetaRequest, opts ...grpc.CallOption) (*File, error)
+	// Регистрация использования файла сущностью другого сервиса (задание, чек и т.д.)
+	RegisterFileUsage(ctx context.Context, in *FileUsage, opts ...grpc.CallOption) (*Empty, error)
+	// Снятие использования файла; файл без использований удаляется сборщиком мусора
+	UnregisterFileUsage(ctx context.Context, in *FileUsage, opts ...grpc.CallOption) (*Empty, error)
+	// Выдача доступа к файлу пользователям (вызывается сервисами при привязке файла к своей сущности)
+	GrantFileAccess(ctx context.Context, in *FileAccessRequest, opts ...grpc.CallOption) (*Empty, error)
+	// Отзыв доступа к файлу (только автор файла)
+	RevokeFileAccess(ctx context.Context, in *FileAccessRequest, opts ...grpc.CallOption) (*Empty, error)
+	// Удаление файлов без использований старше grace period (dry_run — только отчёт)
+	CollectGarbage(ctx context.Context, in *CollectGarbageRequest, opts ...grpc.CallOption) (*CollectGarbageResponse, error)
+	// Сборка zip-архива из документов и файлов (только сам владелец архива); архив без использований удаляется сборщиком мусора
+	CreateArchive(ctx context.Context, in *CreateArchiveRequest, opts ...grpc.CallOption) (*File, error)
+	// Удаление данных пользователя при удалении аккаунта: отзывает выданный ему доступ и стирает имена загруженных им файлов (только сам пользователь)
+	ForgetUser(ctx context.Context, in *ForgetUserRequest, opts ...grpc.CallOption) (*Empty, error)
 }
 
 type fileServiceClient struct {
@@ -54,6 +90,56 @@ func (c *fileServiceClient) InitUpload(ctx context.Context, in *InitUploadReques
 	return out, nil
 }
 
+func (c *fileServiceClient) UploadFile(ctx context.Context, in *UploadFileRequest, opts ...grpc.CallOption) (*File, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(File)
+	err := c.cc.Invoke(ctx, FileService_UploadFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) InitMultipartUpload(ctx context.Context, in *InitMultipartUploadRequest, opts ...grpc.CallOption) (*InitMultipartUploadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InitMultipartUploadResponse)
+	err := c.cc.Invoke(ctx, FileService_InitMultipartUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) GenerateUploadPartURLs(ctx context.Context, in *GenerateUploadPartURLsRequest, opts ...grpc.CallOption) (*UploadPartURLs, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UploadPartURLs)
+	err := c.cc.Invoke(ctx, FileService_GenerateUploadPartURLs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) CompleteMultipartUpload(ctx context.Context, in *MultipartUploadRequest, opts ...grpc.CallOption) (*File, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(File)
+	err := c.cc.Invoke(ctx, FileService_CompleteMultipartUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) AbortMultipartUpload(ctx context.Context, in *MultipartUploadRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, FileService_AbortMultipartUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *fileServiceClient) GenerateDownloadURL(ctx context.Context, in *GenerateDownloadURLRequest, opts ...grpc.CallOption) (*DownloadURL, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DownloadURL)
@@ -74,16 +160,110 @@ func (c *fileServiceClient) GetFileMeta(ctx context.Context, in *GetFileMetaRequ
 	return out, nil
 }
 
+func (c *fileServiceClient) RegisterFileUsage(ctx context.Context, in *FileUsage, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, FileService_RegisterFileUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) UnregisterFileUsage(ctx context.Context, in *FileUsage, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, FileService_UnregisterFileUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) GrantFileAccess(ctx context.Context, in *FileAccessRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, FileService_GrantFileAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) RevokeFileAccess(ctx context.Context, in *FileAccessRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, FileService_RevokeFileAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) CollectGarbage(ctx context.Context, in *CollectGarbageRequest, opts ...grpc.CallOption) (*CollectGarbageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollectGarbageResponse)
+	err := c.cc.Invoke(ctx, FileService_CollectGarbage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) CreateArchive(ctx context.Context, in *CreateArchiveRequest, opts ...grpc.CallOption) (*File, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(File)
+	err := c.cc.Invoke(ctx, FileService_CreateArchive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) ForgetUser(ctx context.Context, in *ForgetUserRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, FileService_ForgetUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FileServiceServer is the server API for FileService service.
 // All implementations must embed UnimplementedFileServiceServer
 // for forward compatibility.
 type FileServiceServer interface {
 	// Инициализация загрузки файла: создаёт запись и возвращает временную ссылку
 	InitUpload(context.Context, *InitUploadRequest) (*InitUploadResponse, error)
-	// Получение временной ссылки на скачивание файла
+	// Загрузка небольшого файла целиком через gRPC (для документов, которые генерируют другие сервисы)
+	UploadFile(context.Context, *UploadFileRequest) (*File, error)
+	// Инициализация multipart-загрузки большого файла: создаёт запись и возвращает ссылки на загрузку частей
+	InitMultipartUpload(context.Context, *InitMultipartUploadRequest) (*InitMultipartUploadResponse, error)
+	// Получение новых ссылок на загрузку частей (например, для продолжения прерванной загрузки)
+	GenerateUploadPartURLs(context.Context, *GenerateUploadPartURLsRequest) (*UploadPartURLs, error)
+	// Завершение multipart-загрузки: собирает загруженные части в файл (только автор файла)
+	CompleteMultipartUpload(context.Context, *MultipartUploadRequest) (*File, error)
+	// Отмена multipart-загрузки (только автор файла)
+	AbortMultipartUpload(context.Context, *MultipartUploadRequest) (*Empty, error)
+	// Получение временной ссылки на скачивание файла или его миниатюры/превью (доступно автору файла и пользователям с выданным доступом)
 	GenerateDownloadURL(context.Context, *GenerateDownloadURLRequest) (*DownloadURL, error)
 	// Получение метаданных файла
 	GetFileMeta(context.Context, *GetFileMetaRequest) (*File, error)
+	// Регистрация использования файла сущностью другого сервиса (задание, чек и т.д.)
+	RegisterFileUsage(context.Context, *FileUsage) (*Empty, error)
+	// Снятие использования файла; файл без использований удаляется сборщиком мусора
+	UnregisterFileUsage(context.Context, *FileUsage) (*Empty, error)
+	// Выдача доступа к файлу пользователям (вызывается сервисами при привязке файла к своей сущности)
+	GrantFileAccess(context.Context, *FileAccessRequest) (*Empty, error)
+	// Отзыв доступа к файлу (только автор файла)
+	RevokeFileAccess(context.Context, *FileAccessRequest) (*Empty, error)
+	// Удаление файлов без использований старше grace period (dry_run — только отчёт)
+	CollectGarbage(context.Context, *CollectGarbageRequest) (*CollectGarbageResponse, error)
+	// Сборка zip-архива из документов и файлов (только сам владелец архива); архив без использований удаляется сборщиком мусора
+	CreateArchive(context.Context, *CreateArchiveRequest) (*File, error)
+	// Удаление данных пользователя при удалении аккаунта: отзывает выданный ему доступ и стирает имена загруженных им файлов (только сам пользователь)
+	ForgetUser(context.Context, *ForgetUserRequest) (*Empty, error)
 	mustEmbedUnimplementedFileServiceServer()
 }
 
@@ -97,12 +277,48 @@ type UnimplementedFileServiceServer struct{}
 func (UnimplementedFileServiceServer) InitUpload(context.Context, *InitUploadRequest) (*InitUploadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InitUpload not implemented")
 }
+func (UnimplementedFileServiceServer) UploadFile(context.Context, *UploadFileRequest) (*File, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadFile not implemented")
+}
+func (UnimplementedFileServiceServer) InitMultipartUpload(context.Context, *InitMultipartUploadRequest) (*InitMultipartUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitMultipartUpload not implemented")
+}
+func (UnimplementedFileServiceServer) GenerateUploadPartURLs(context.Context, *GenerateUploadPartURLsRequest) (*UploadPartURLs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateUploadPartURLs not implemented")
+}
+func (UnimplementedFileServiceServer) CompleteMultipartUpload(context.Context, *MultipartUploadRequest) (*File, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteMultipartUpload not implemented")
+}
+func (UnimplementedFileServiceServer) AbortMultipartUpload(context.Context, *MultipartUploadRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortMultipartUpload not implemented")
+}
 func (UnimplementedFileServiceServer) GenerateDownloadURL(context.Context, *GenerateDownloadURLRequest) (*DownloadURL, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateDownloadURL not implemented")
 }
 func (UnimplementedFileServiceServer) GetFileMeta(context.Context, *GetFileMetaRequest) (*File, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFileMeta not implemented")
 }
+func (UnimplementedFileServiceServer) RegisterFileUsage(context.Context, *FileUsage) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterFileUsage not implemented")
+}
+func (UnimplementedFileServiceServer) UnregisterFileUsage(context.Context, *FileUsage) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterFileUsage not implemented")
+}
+func (UnimplementedFileServiceServer) GrantFileAccess(context.Context, *FileAccessRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantFileAccess not implemented")
+}
+func (UnimplementedFileServiceServer) RevokeFileAccess(context.Context, *FileAccessRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeFileAccess not implemented")
+}
+func (UnimplementedFileServiceServer) CollectGarbage(context.Context, *CollectGarbageRequest) (*CollectGarbageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectGarbage not implemented")
+}
+func (UnimplementedFileServiceServer) CreateArchive(context.Context, *CreateArchiveRequest) (*File, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateArchive not implemented")
+}
+func (UnimplementedFileServiceServer) ForgetUser(context.Context, *ForgetUserRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForgetUser not implemented")
+}
 func (UnimplementedFileServiceServer) mustEmbedUnimplementedFileServiceServer() {}
 func (UnimplementedFileServiceServer) testEmbeddedByValue()                     {}
 
@@ -142,6 +358,96 @@ func _FileService_InitUpload_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FileService_UploadFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).UploadFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_UploadFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).UploadFile(ctx, req.(*UploadFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_InitMultipartUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InitMultipartUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).InitMultipartUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_InitMultipartUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).InitMultipartUpload(ctx, req.(*InitMultipartUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_GenerateUploadPartURLs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateUploadPartURLsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).GenerateUploadPartURLs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_GenerateUploadPartURLs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).GenerateUploadPartURLs(ctx, req.(*GenerateUploadPartURLsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_CompleteMultipartUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultipartUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).CompleteMultipartUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_CompleteMultipartUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).CompleteMultipartUpload(ctx, req.(*MultipartUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_AbortMultipartUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultipartUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).AbortMultipartUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_AbortMultipartUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).AbortMultipartUpload(ctx, req.(*MultipartUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FileService_GenerateDownloadURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GenerateDownloadURLRequest)
 	if err := dec(in); err != nil {
@@ -178,6 +484,132 @@ func _FileService_GetFileMeta_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FileService_RegisterFileUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FileUsage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).RegisterFileUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_RegisterFileUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).RegisterFileUsage(ctx, req.(*FileUsage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_UnregisterFileUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FileUsage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).UnregisterFileUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_UnregisterFileUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).UnregisterFileUsage(ctx, req.(*FileUsage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_GrantFileAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FileAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).GrantFileAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_GrantFileAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).GrantFileAccess(ctx, req.(*FileAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_RevokeFileAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FileAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).RevokeFileAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_RevokeFileAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).RevokeFileAccess(ctx, req.(*FileAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_CollectGarbage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectGarbageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).CollectGarbage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_CollectGarbage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).CollectGarbage(ctx, req.(*CollectGarbageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_CreateArchive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateArchiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).CreateArchive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_CreateArchive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).CreateArchive(ctx, req.(*CreateArchiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_ForgetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForgetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).ForgetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_ForgetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).ForgetUser(ctx, req.(*ForgetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FileService_ServiceDesc is the grpc.ServiceDesc for FileService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -189,6 +621,26 @@ var FileService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InitUpload",
 			Handler:    _FileService_InitUpload_Handler,
 		},
+		{
+			MethodName: "UploadFile",
+			Handler:    _FileService_UploadFile_Handler,
+		},
+		{
+			MethodName: "InitMultipartUpload",
+			Handler:    _FileService_InitMultipartUpload_Handler,
+		},
+		{
+			MethodName: "GenerateUploadPartURLs",
+			Handler:    _FileService_GenerateUploadPartURLs_Handler,
+		},
+		{
+			MethodName: "CompleteMultipartUpload",
+			Handler:    _FileService_CompleteMultipartUpload_Handler,
+		},
+		{
+			MethodName: "AbortMultipartUpload",
+			Handler:    _FileService_AbortMultipartUpload_Handler,
+		},
 		{
 			MethodName: "GenerateDownloadURL",
 			Handler:    _FileService_GenerateDownloadURL_Handler,
@@ -197,6 +649,34 @@ var FileService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetFileMeta",
 			Handler:    _FileService_GetFileMeta_Handler,
 		},
+		{
+			MethodName: "RegisterFileUsage",
+			Handler:    _FileService_RegisterFileUsage_Handler,
+		},
+		{
+			MethodName: "UnregisterFileUsage",
+			Handler:    _FileService_UnregisterFileUsage_Handler,
+		},
+		{
+			MethodName: "GrantFileAccess",
+			Handler:    _FileService_GrantFileAccess_Handler,
+		},
+		{
+			MethodName: "RevokeFileAccess",
+			Handler:    _FileService_RevokeFileAccess_Handler,
+		},
+		{
+			MethodName: "CollectGarbage",
+			Handler:    _FileService_CollectGarbage_Handler,
+		},
+		{
+			MethodName: "CreateArchive",
+			Handler:    _FileService_CreateArchive_Handler,
+		},
+		{
+			MethodName: "ForgetUser",
+			Handler:    _FileService_ForgetUser_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "file_service.proto",
@@ -0,0 +1,225 @@
+// Package localstorage stores files on the local filesystem and serves them over HMAC-signed URLs.
+// It is shared by file_service, which signs the URLs, and api_gateway, which serves them.
+package localstorage
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	expiresParam    = "X-Expires"
+	signatureParam  = "X-Signature"
+	uploadIdParam   = "uploadId"
+	partNumberParam = "partNumber"
+
+	multipartDir = ".multipart"
+)
+
+var (
+	ErrInvalidKey       = errors.New("invalid key")
+	ErrInvalidSignature = errors.New("invalid signature")
+	ErrExpired          = errors.New("url expired")
+)
+
+// Storage is a directory with the objects and pending multipart uploads.
+type Storage struct {
+	root       string
+	signingKey []byte
+}
+
+func New(root string, signingKey string) *Storage {
+	return &Storage{root: root, signingKey: []byte(signingKey)}
+}
+
+// ObjectPath returns the filesystem path of the object.
+func (s *Storage) ObjectPath(key string) (string, error) {
+	if key == "" || strings.HasPrefix(key, "/") || path.Clean(key) != key || strings.HasPrefix(key, "..") || strings.HasPrefix(key, multipartDir) {
+		return "", ErrInvalidKey
+	}
+	return filepath.Join(s.root, filepath.FromSlash(key)), nil
+}
+
+// PartPath returns the filesystem path of the part of the multipart upload.
+func (s *Storage) PartPath(uploadId string, partNumber int32) (string, error) {
+	dir, err := s.UploadDir(uploadId)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, strconv.Itoa(int(partNumber))), nil
+}
+
+// UploadDir returns the directory with the parts of the multipart upload.
+func (s *Storage) UploadDir(uploadId string) (string, error) {
+	if _, err := hex.DecodeString(uploadId); err != nil || uploadId == "" {
+		return "", ErrInvalidKey
+	}
+	return filepath.Join(s.root, multipartDir, uploadId), nil
+}
+
+// SignURL returns baseURL/key with the signature of the request.
+// uploadId and partNumber are set only for multipart part uploads.
+func (s *Storage) SignURL(baseURL string, method string, key string, uploadId string, partNumber int32, expires time.Duration) string {
+	query := url.Values{}
+	if uploadId != "" {
+		query.Set(uploadIdParam, uploadId)
+		query.Set(partNumberParam, strconv.Itoa(int(partNumber)))
+	}
+	expiresAt := strconv.FormatInt(time.Now().Add(expires).Unix(), 10)
+	query.Set(expiresParam, expiresAt)
+	query.Set(signatureParam, s.sign(method, key, uploadId, query.Get(partNumberParam), expiresAt))
+
+	return strings.TrimSuffix(baseURL, "/") + "/" + key + "?" + query.Encode()
+}
+
+func (s *Storage) verify(method string, key string, query url.Values) error {
+	expiresAt, err := strconv.ParseInt(query.Get(expiresParam), 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	signature, err := hex.DecodeString(query.Get(signatureParam))
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	expected, _ := hex.DecodeString(s.sign(method, key, query.Get(uploadIdParam), query.Get(partNumberParam), query.Get(expiresParam)))
+	if !hmac.Equal(signature, expected) {
+		return ErrInvalidSignature
+	}
+
+	if time.Now().Unix() > expiresAt {
+		return ErrExpired
+	}
+
+	return nil
+}
+
+func (s *Storage) sign(method string, key string, uploadId string, partNumber string, expiresAt string) string {
+	mac := hmac.New(sha256.New, s.signingKey)
+	mac.Write([]byte(strings.Join([]string{method, key, uploadId, partNumber, expiresAt}, "\n")))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Handler serves signed GET and PUT requests. prefix is the URL path the handler is mounted at.
+func (s *Storage) Handler(prefix string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, prefix), "/")
+
+		if err := s.verify(r.Method, key, r.URL.Query()); err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			s.serveObject(w, r, key)
+		case http.MethodPut:
+			s.receiveObject(w, r, key)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+func (s *Storage) serveObject(w http.ResponseWriter, r *http.Request, key string) {
+	name, err := s.ObjectPath(key)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	f, err := os.Open(name)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			http.NotFound(w, r)
+			return
+		}
+		http.Error(w, "cannot open file", http.StatusInternalServerError)
+		return
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		http.Error(w, "cannot open file", http.StatusInternalServerError)
+		return
+	}
+
+	http.ServeContent(w, r, path.Base(key), info.ModTime(), f)
+}
+
+func (s *Storage) receiveObject(w http.ResponseWriter, r *http.Request, key string) {
+	var (
+		name string
+		err  error
+	)
+	if uploadId := r.URL.Query().Get(uploadIdParam); uploadId != "" {
+		var partNumber int
+		partNumber, err = strconv.Atoi(r.URL.Query().Get(partNumberParam))
+		if err == nil {
+			name, err = s.PartPath(uploadId, int32(partNumber))
+		}
+		if err == nil {
+			if _, statErr := os.Stat(filepath.Dir(name)); statErr != nil {
+				http.Error(w, "upload not found", http.StatusNotFound)
+				return
+			}
+		}
+	} else {
+		name, err = s.ObjectPath(key)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	etag, err := WriteFile(name, r.Body)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("cannot write file: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("ETag", `"`+etag+`"`)
+	w.WriteHeader(http.StatusOK)
+}
+
+// WriteFile atomically writes src to name and returns the hex SHA-256 of the content.
+func WriteFile(name string, src io.Reader) (string, error) {
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return "", err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(name), ".tmp-*")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(tmp.Name())
+
+	hash := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(tmp, hash), src); err != nil {
+		tmp.Close()
+		return "", err
+	}
+	if err := tmp.Close(); err != nil {
+		return "", err
+	}
+
+	if err := os.Rename(tmp.Name(), name); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"common_library/logging"
 	"common_library/metadata"
+	"context"
 	"google.golang.org/grpc/credentials/insecure"
 	configs "homework_service/config"
 	"net"
@@ -77,6 +78,7 @@ func main() {
 	submissionService := service.NewSubmissionService(
 		submissionRepo,
 		assignmentRepo,
+		userClient,
 		fileClient,
 	)
 
@@ -84,6 +86,7 @@ func main() {
 		feedbackRepo,
 		submissionRepo,
 		assignmentRepo,
+		userClient,
 		fileClient,
 	)
 
@@ -127,6 +130,10 @@ func main() {
 		}
 	}()
 
+	if cfg.FileGrantBackfill {
+		go NewFileGrantBackfill(assignmentRepo, fileClient, log).Run(context.Background())
+	}
+
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
@@ -4,6 +4,8 @@ import (
 	"context"
 	"time"
 
+	"homework_service/internal/app"
+	"homework_service/internal/domain"
 	"homework_service/internal/repository"
 	"homework_service/pkg/kafka"
 	"homework_service/pkg/logger"
@@ -68,3 +70,49 @@ func (w *ReminderWorker) processReminders(ctx context.Context) {
 		w.logger.Infof("Sent reminder for assignment %s", assignment.ID)
 	}
 }
+
+const fileGrantBackfillBatchSize = 100
+
+// FileGrantBackfill grants both members of the pair access to files attached before file_service checked access.
+// Grants are idempotent, so an interrupted backfill can be started again.
+type FileGrantBackfill struct {
+	assignmentRepo *repository.AssignmentRepository
+	fileClient     *app.FileClient
+	logger         *logger.Logger
+}
+
+func NewFileGrantBackfill(assignmentRepo *repository.AssignmentRepository, fileClient *app.FileClient, logger *logger.Logger) *FileGrantBackfill {
+	return &FileGrantBackfill{
+		assignmentRepo: assignmentRepo,
+		fileClient:     fileClient,
+		logger:         logger,
+	}
+}
+
+func (b *FileGrantBackfill) Run(ctx context.Context) {
+	var after domain.FileAttachment
+	granted, failed := 0, 0
+	for {
+		attachments, err := b.assignmentRepo.ListFileAttachments(ctx, after, fileGrantBackfillBatchSize)
+		if err != nil {
+			b.logger.Errorf("File grant backfill stopped: %v", err)
+			return
+		}
+
+		for _, attachment := range attachments {
+			if err := b.fileClient.GrantFileAccess(ctx, attachment.FileID, attachment.TutorID, attachment.StudentID); err != nil {
+				b.logger.Errorf("Failed to grant access to file %s: %v", attachment.FileID, err)
+				failed++
+				continue
+			}
+			granted++
+		}
+
+		if len(attachments) < fileGrantBackfillBatchSize {
+			break
+		}
+		after = attachments[len(attachments)-1]
+	}
+
+	b.logger.Infof("File grant backfill finished: %d granted, %d failed", granted, failed)
+}
@@ -15,6 +15,8 @@ type Config struct {
 	DB       DBConfig    `yaml:"db"`
 	Kafka    KafkaConfig `yaml:"kafka"`
 	Services Services    `yaml:"services"`
+	// FileGrantBackfill grants pairs access to files attached before file_service checked access, run it once
+	FileGrantBackfill bool `yaml:"file_grant_backfill"`
 }
 
 type GRPCConfig struct {
@@ -174,6 +176,12 @@ func overrideFromEnv(cfg *Config) {
 			cfg.Services.FileService.Timeout = time.Duration(timeout) * time.Second
 		}
 	}
+
+	if val := os.Getenv("FILE_GRANT_BACKFILL"); val != "" {
+		if backfill, err := strconv.ParseBool(val); err == nil {
+			cfg.FileGrantBackfill = backfill
+		}
+	}
 }
 
 func validateConfig(cfg *Config) error {
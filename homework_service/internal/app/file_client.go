@@ -9,6 +9,10 @@ import (
 	"google.golang.org/grpc/metadata"
 )
 
+// assignmentOwnerType is the file_service owner type for all homework files:
+// assignment, submission and feedback files are owned by the assignment.
+const assignmentOwnerType = "assignment"
+
 type FileClient struct {
 	client filePb.FileServiceClient
 }
@@ -18,16 +22,56 @@ func NewFileClient(conn *grpc.ClientConn) *FileClient {
 }
 
 func (c *FileClient) GetFileURL(ctx context.Context, fileID uuid.UUID) (string, error) {
-	outCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs())
+	resp, err := c.client.GenerateDownloadURL(outgoingContext(ctx), &filePb.GenerateDownloadURLRequest{FileId: fileID.String()})
+	if err != nil {
+		return "", err
+	}
+	return resp.Url, nil
+}
+
+func (c *FileClient) RegisterFileUsage(ctx context.Context, fileID, assignmentID uuid.UUID) error {
+	_, err := c.client.RegisterFileUsage(outgoingContext(ctx), &filePb.FileUsage{
+		FileId:    fileID.String(),
+		OwnerType: assignmentOwnerType,
+		OwnerId:   assignmentID.String(),
+	})
+	return err
+}
+
+// UnregisterFileUsage releases the file used by the assignment.
+// If fileID is uuid.Nil all files of the assignment are released.
+func (c *FileClient) UnregisterFileUsage(ctx context.Context, fileID, assignmentID uuid.UUID) error {
+	req := &filePb.FileUsage{
+		OwnerType: assignmentOwnerType,
+		OwnerId:   assignmentID.String(),
+	}
+	if fileID != uuid.Nil {
+		req.FileId = fileID.String()
+	}
+	_, err := c.client.UnregisterFileUsage(outgoingContext(ctx), req)
+	return err
+}
+
+// GrantFileAccess allows the users to download the file.
+func (c *FileClient) GrantFileAccess(ctx context.Context, fileID uuid.UUID, userIDs ...uuid.UUID) error {
+	req := &filePb.FileAccessRequest{
+		FileId:  fileID.String(),
+		UserIds: make([]string, len(userIDs)),
+	}
+	for i, id := range userIDs {
+		req.UserIds[i] = id.String()
+	}
+	_, err := c.client.GrantFileAccess(outgoingContext(ctx), req)
+	return err
+}
+
+func outgoingContext(ctx context.Context) context.Context {
+	outCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("x-caller-service", "homework_service"))
 	if id, ok := ctxdata.GetUserID(ctx); ok {
 		outCtx = metadata.AppendToOutgoingContext(outCtx, "x-user-id", id)
 	}
 	if role, ok := ctxdata.GetUserRole(ctx); ok {
 		outCtx = metadata.AppendToOutgoingContext(outCtx, "x-user-role", role)
 	}
-	resp, err := c.client.GenerateDownloadURL(ctx, &filePb.GenerateDownloadURLRequest{FileId: fileID.String()})
-	if err != nil {
-		return "", err
-	}
-	return resp.Url, nil
+	return outCtx
 }
@@ -1,16 +1,16 @@
 package app
 
 import (
-	"common_library/ctxdata"
 	"context"
 	"github.com/google/uuid"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	userPb "userservice/pkg/api"
 )
 
+const pairStatusActive = "active"
+
 type UserClient struct {
 	client userPb.UserServiceClient
 }
@@ -19,24 +19,35 @@ func NewUserClient(conn *grpc.ClientConn) *UserClient {
 	return &UserClient{client: userPb.NewUserServiceClient(conn)}
 }
 
+// IsGuardian checks that the guardian has an active link to the student.
+func (c *UserClient) IsGuardian(ctx context.Context, guardianID, studentID uuid.UUID) (bool, error) {
+	req := &userPb.GetGuardianStudentRequest{
+		GuardianId: guardianID.String(),
+		StudentId:  studentID.String(),
+	}
+	resp, err := c.client.GetGuardianStudent(outgoingContext(ctx), req)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return resp.Status == "active", nil
+}
+
+// IsPair checks that the tutor may give the student new assignments.
+// Paused and archived pairs are read-only: their homework stays visible, but IsPair is false.
 func (c *UserClient) IsPair(ctx context.Context, tutorID, studentID uuid.UUID) (bool, error) {
 	req := &userPb.GetTutorStudentRequest{
 		TutorId:   tutorID.String(),
 		StudentId: studentID.String(),
 	}
-	outCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs())
-	if id, ok := ctxdata.GetUserID(ctx); ok {
-		outCtx = metadata.AppendToOutgoingContext(outCtx, "x-user-id", id)
-	}
-	if role, ok := ctxdata.GetUserRole(ctx); ok {
-		outCtx = metadata.AppendToOutgoingContext(outCtx, "x-user-role", role)
-	}
-	resp, err := c.client.GetTutorStudent(outCtx, req)
+	resp, err := c.client.GetTutorStudent(outgoingContext(ctx), req)
 	if err != nil {
 		if status.Code(err) == codes.NotFound {
 			return false, nil
 		}
 		return false, err
 	}
-	return resp.Status == "active", nil
+	return resp.Status == pairStatusActive, nil
 }
@@ -17,6 +17,13 @@ type Assignment struct {
 	EditedAt    time.Time
 }
 
+// FileAttachment is a file of an assignment, its submission or feedback and the pair that may download it.
+type FileAttachment struct {
+	FileID    uuid.UUID
+	TutorID   uuid.UUID
+	StudentID uuid.UUID
+}
+
 type AssignmentStatus string
 
 const (
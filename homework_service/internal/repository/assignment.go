@@ -280,3 +280,50 @@ func (r *AssignmentRepository) Delete(ctx context.Context, id uuid.UUID) error {
 
 	return nil
 }
+
+// ListFileAttachments returns files of assignments, submissions and feedbacks ordered by file and pair,
+// starting after the given attachment.
+func (r *AssignmentRepository) ListFileAttachments(ctx context.Context, after domain.FileAttachment, limit int) ([]domain.FileAttachment, error) {
+	query := `
+		SELECT file_id, tutor_id, student_id
+		FROM (
+			SELECT a.file_id, a.tutor_id, a.student_id
+			FROM assignments a
+			WHERE a.file_id IS NOT NULL
+			UNION
+			SELECT s.file_id, a.tutor_id, a.student_id
+			FROM submissions s
+			JOIN assignments a ON a.id = s.assignment_id
+			WHERE s.file_id IS NOT NULL
+			UNION
+			SELECT f.file_id, a.tutor_id, a.student_id
+			FROM feedbacks f
+			JOIN submissions s ON s.id = f.submission_id
+			JOIN assignments a ON a.id = s.assignment_id
+			WHERE f.file_id IS NOT NULL
+		) attachments
+		WHERE (file_id, tutor_id, student_id) > ($1, $2, $3)
+		ORDER BY file_id, tutor_id, student_id
+		LIMIT $4
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, after.FileID, after.TutorID, after.StudentID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list file attachments: %w", err)
+	}
+	defer rows.Close()
+
+	var attachments []domain.FileAttachment
+	for rows.Next() {
+		var attachment domain.FileAttachment
+		if err := rows.Scan(&attachment.FileID, &attachment.TutorID, &attachment.StudentID); err != nil {
+			return nil, fmt.Errorf("failed to scan file attachment: %w", err)
+		}
+		attachments = append(attachments, attachment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list file attachments: %w", err)
+	}
+
+	return attachments, nil
+}
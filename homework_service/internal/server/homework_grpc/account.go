@@ -0,0 +1,66 @@
+package homework_grpc
+
+import (
+	"context"
+	"github.com/google/uuid"
+
+	"homework_service/internal/domain"
+	v1 "homework_service/pkg/api"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ExportUserData returns the homework of the current user for the data export of user_service.
+func (h *HomeworkHandler) ExportUserData(ctx context.Context, req *v1.ExportUserDataRequest) (*v1.UserDataExport, error) {
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	asTutor, err := h.assignmentService.ListAssignmentsByTutor(ctx, userId, nil)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	asStudent, err := h.assignmentService.ListAssignmentsByStudent(ctx, userId, nil)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	assignments := append(asTutor, asStudent...)
+
+	var submissions []*domain.Submission
+	var feedbacks []*domain.Feedback
+	for _, assignment := range assignments {
+		assignmentSubmissions, err := h.submissionService.ListSubmissionsByAssignment(ctx, assignment.ID)
+		if err != nil {
+			return nil, toGRPCError(err)
+		}
+		submissions = append(submissions, assignmentSubmissions...)
+
+		assignmentFeedbacks, err := h.feedbackService.ListFeedbacksByAssignment(ctx, assignment.ID)
+		if err != nil {
+			return nil, toGRPCError(err)
+		}
+		feedbacks = append(feedbacks, assignmentFeedbacks...)
+	}
+
+	return &v1.UserDataExport{
+		Assignments: toProtoAssignments(assignments),
+		Submissions: toProtoSubmissions(submissions),
+		Feedbacks:   toProtoFeedbacks(feedbacks),
+	}, nil
+}
+
+// ForgetUser is called by user_service when the current user deletes the account.
+func (h *HomeworkHandler) ForgetUser(ctx context.Context, req *v1.ForgetUserRequest) (*v1.Empty, error) {
+	userId, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := h.assignmentService.ForgetUser(ctx, userId); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &v1.Empty{}, nil
+}
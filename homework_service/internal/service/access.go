@@ -0,0 +1,42 @@
+package service
+
+import (
+	"common_library/ctxdata"
+	"context"
+	"github.com/google/uuid"
+	"homework_service/internal/domain"
+)
+
+const roleGuardian = "guardian"
+
+// canReadAssignment checks that the caller is the tutor or the student of the assignment,
+// or an active guardian of the student. Guardians only read homework, files are not shared with them.
+func canReadAssignment(ctx context.Context, userClient UserClient, assignment *domain.Assignment) bool {
+	userID, ok := ctxdata.GetUserID(ctx)
+	if !ok {
+		return false
+	}
+	if assignment.TutorID.String() == userID || assignment.StudentID.String() == userID {
+		return true
+	}
+	return isGuardianOf(ctx, userClient, assignment.StudentID)
+}
+
+// isGuardianOf checks that the caller is an active guardian of the student.
+func isGuardianOf(ctx context.Context, userClient UserClient, studentID uuid.UUID) bool {
+	role, ok := ctxdata.GetUserRole(ctx)
+	if !ok || role != roleGuardian {
+		return false
+	}
+	userID, ok := ctxdata.GetUserID(ctx)
+	if !ok {
+		return false
+	}
+	guardianID, err := uuid.Parse(userID)
+	if err != nil {
+		return false
+	}
+
+	isGuardian, err := userClient.IsGuardian(ctx, guardianID, studentID)
+	return err == nil && isGuardian
+}
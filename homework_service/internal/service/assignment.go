@@ -61,6 +61,8 @@ func (s *AssignmentService) CreateAssignment(ctx context.Context, req *domain.As
 		return nil, err
 	}
 
+	attachFile(ctx, s.fileClient, assignment.FileID, assignment)
+
 	return assignment, nil
 }
 
@@ -73,12 +75,7 @@ func (s *AssignmentService) GetAssignment(ctx context.Context, id uuid.UUID) (*d
 		return nil, err
 	}
 
-	userID, ok := ctxdata.GetUserID(ctx)
-	if !ok {
-		return nil, ErrPermissionDenied
-	}
-
-	if assignment.TutorID.String() != userID && assignment.StudentID.String() != userID {
+	if !canReadAssignment(ctx, s.userClient, assignment) {
 		return nil, ErrPermissionDenied
 	}
 
@@ -91,7 +88,18 @@ func (s *AssignmentService) UpdateAssignment(ctx context.Context, assignment *do
 		return ErrPermissionDenied
 	}
 
-	return s.assignmentRepo.Update(ctx, assignment)
+	existing, err := s.assignmentRepo.GetByID(ctx, assignment.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.assignmentRepo.Update(ctx, assignment); err != nil {
+		return err
+	}
+
+	replaceFile(ctx, s.fileClient, existing.FileID, assignment.FileID, existing)
+
+	return nil
 }
 
 func (s *AssignmentService) DeleteAssignment(ctx context.Context, id uuid.UUID) error {
@@ -105,7 +113,14 @@ func (s *AssignmentService) DeleteAssignment(ctx context.Context, id uuid.UUID)
 		return ErrPermissionDenied
 	}
 
-	return s.assignmentRepo.Delete(ctx, id)
+	if err := s.assignmentRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	// submissions and feedbacks are deleted by cascade, release all files of the assignment
+	detachFile(ctx, s.fileClient, nil, id)
+
+	return nil
 }
 
 func (s *AssignmentService) ListAssignmentsByTutor(ctx context.Context, tutorID uuid.UUID, statuses []domain.AssignmentStatus) ([]*domain.Assignment, error) {
@@ -119,7 +134,7 @@ func (s *AssignmentService) ListAssignmentsByTutor(ctx context.Context, tutorID
 
 func (s *AssignmentService) ListAssignmentsByStudent(ctx context.Context, studentID uuid.UUID, statuses []domain.AssignmentStatus) ([]*domain.Assignment, error) {
 	userID, ok := ctxdata.GetUserID(ctx)
-	if !ok || studentID.String() != userID {
+	if !ok || (studentID.String() != userID && !isGuardianOf(ctx, s.userClient, studentID)) {
 		return nil, ErrPermissionDenied
 	}
 
@@ -128,7 +143,7 @@ func (s *AssignmentService) ListAssignmentsByStudent(ctx context.Context, studen
 
 func (s *AssignmentService) ListAssignmentsByPair(ctx context.Context, tutorID uuid.UUID, studentID uuid.UUID, statuses []domain.AssignmentStatus) ([]*domain.Assignment, error) {
 	userID, ok := ctxdata.GetUserID(ctx)
-	if !ok || (tutorID.String() != userID && studentID.String() != userID) {
+	if !ok || (tutorID.String() != userID && studentID.String() != userID && !isGuardianOf(ctx, s.userClient, studentID)) {
 		return nil, ErrPermissionDenied
 	}
 
@@ -149,3 +164,26 @@ func (s *AssignmentService) GetAssignmentFileURL(ctx context.Context, id uuid.UU
 	}
 	return url, nil
 }
+
+// ForgetUser deletes the assignments of a deleted student with their submissions and feedbacks
+// and releases their files. Assignments of a deleted tutor are kept for the students.
+func (s *AssignmentService) ForgetUser(ctx context.Context, userID uuid.UUID) error {
+	currentUserID, ok := ctxdata.GetUserID(ctx)
+	if !ok || userID.String() != currentUserID {
+		return ErrPermissionDenied
+	}
+
+	assignments, err := s.assignmentRepo.ListByFilter(ctx, domain.AssignmentFilter{StudentID: userID})
+	if err != nil {
+		return err
+	}
+
+	for _, assignment := range assignments {
+		if err := s.assignmentRepo.Delete(ctx, assignment.ID); err != nil && !errors.Is(err, repository.ErrNotFound) {
+			return err
+		}
+		detachFile(ctx, s.fileClient, nil, assignment.ID)
+	}
+
+	return nil
+}
@@ -33,6 +33,7 @@ type feedbackService struct {
 	feedbackRepo   *repository.FeedbackRepository
 	submissionRepo *repository.SubmissionRepository
 	assignmentRepo *repository.AssignmentRepository
+	userClient     UserClient
 	fileClient     FileClient
 }
 
@@ -40,12 +41,14 @@ func NewFeedbackService(
 	feedbackRepo *repository.FeedbackRepository,
 	submissionRepo *repository.SubmissionRepository,
 	assignmentRepo *repository.AssignmentRepository,
+	userClient UserClient,
 	fileClient FileClient,
 ) FeedbackServiceInterface {
 	return &feedbackService{
 		feedbackRepo:   feedbackRepo,
 		submissionRepo: submissionRepo,
 		assignmentRepo: assignmentRepo,
+		userClient:     userClient,
 		fileClient:     fileClient,
 	}
 }
@@ -90,6 +93,8 @@ func (s *feedbackService) CreateFeedback(ctx context.Context, feedback *domain.F
 		return nil, err
 	}
 
+	attachFile(ctx, s.fileClient, newFeedback.FileID, assignment)
+
 	return newFeedback, nil
 }
 
@@ -126,6 +131,7 @@ func (s *feedbackService) UpdateFeedback(ctx context.Context, feedback *domain.F
 		existingFeedback.Comment = feedback.Comment
 	}
 
+	oldFileID := existingFeedback.FileID
 	if feedback.FileID != nil {
 		existingFeedback.FileID = feedback.FileID
 	}
@@ -136,6 +142,8 @@ func (s *feedbackService) UpdateFeedback(ctx context.Context, feedback *domain.F
 		return nil, err
 	}
 
+	replaceFile(ctx, s.fileClient, oldFileID, existingFeedback.FileID, assignment)
+
 	return existingFeedback, nil
 }
 
@@ -145,11 +153,6 @@ func (s *feedbackService) GetFeedback(ctx context.Context, id uuid.UUID) (*domai
 		return nil, err
 	}
 
-	userID, ok := ctxdata.GetUserID(ctx)
-	if !ok {
-		return nil, ErrPermissionDenied
-	}
-
 	submission, err := s.submissionRepo.GetByID(ctx, feedback.SubmissionID)
 	if err != nil {
 		return nil, err
@@ -160,15 +163,7 @@ func (s *feedbackService) GetFeedback(ctx context.Context, id uuid.UUID) (*domai
 		return nil, err
 	}
 
-	userRole, ok := ctxdata.GetUserRole(ctx)
-	if !ok {
-		return nil, ErrPermissionDenied
-	}
-
-	if userRole == "tutor" && assignment.TutorID.String() != userID {
-		return nil, ErrPermissionDenied
-	}
-	if userRole == "student" && assignment.StudentID.String() != userID {
+	if !canReadAssignment(ctx, s.userClient, assignment) {
 		return nil, ErrPermissionDenied
 	}
 
@@ -176,25 +171,12 @@ func (s *feedbackService) GetFeedback(ctx context.Context, id uuid.UUID) (*domai
 }
 
 func (s *feedbackService) ListFeedbacksByAssignment(ctx context.Context, assignmentID uuid.UUID) ([]*domain.Feedback, error) {
-	userID, ok := ctxdata.GetUserID(ctx)
-	if !ok {
-		return nil, ErrPermissionDenied
-	}
-
-	userRole, ok := ctxdata.GetUserRole(ctx)
-	if !ok {
-		return nil, ErrPermissionDenied
-	}
-
 	assignment, err := s.assignmentRepo.GetByID(ctx, assignmentID)
 	if err != nil {
 		return nil, err
 	}
 
-	if userRole == "tutor" && assignment.TutorID.String() != userID {
-		return nil, ErrPermissionDenied
-	}
-	if userRole == "student" && assignment.StudentID.String() != userID {
+	if !canReadAssignment(ctx, s.userClient, assignment) {
 		return nil, ErrPermissionDenied
 	}
 
@@ -237,6 +219,10 @@ func (s *feedbackService) GetFeedbackFileURL(ctx context.Context, id uuid.UUID)
 	if userRole == "student" && assignment.StudentID.String() != userID {
 		return "", ErrPermissionDenied
 	}
+	// files are shared with the pair only
+	if userRole != "tutor" && userRole != "student" {
+		return "", ErrPermissionDenied
+	}
 
 	url, err := s.fileClient.GetFileURL(ctx, *feedback.FileID)
 	if err != nil {
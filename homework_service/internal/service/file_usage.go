@@ -0,0 +1,64 @@
+package service
+
+import (
+	"common_library/logging"
+	"context"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"homework_service/internal/domain"
+)
+
+// attachFile marks the file as used by the assignment so that file_service does not garbage collect it,
+// and grants both members of the tutor-student pair access to the file.
+// Failures are logged only: the homework entity is already stored at this point.
+func attachFile(ctx context.Context, fileClient FileClient, fileID *uuid.UUID, assignment *domain.Assignment) {
+	if fileID == nil {
+		return
+	}
+	if err := fileClient.RegisterFileUsage(ctx, *fileID, assignment.ID); err != nil {
+		if logger, ok := logging.GetFromContext(ctx); ok {
+			logger.Error(ctx, "failed to register file usage",
+				zap.String("file_id", fileID.String()),
+				zap.String("assignment_id", assignment.ID.String()),
+				zap.Error(err),
+			)
+		}
+	}
+	if err := fileClient.GrantFileAccess(ctx, *fileID, assignment.TutorID, assignment.StudentID); err != nil {
+		if logger, ok := logging.GetFromContext(ctx); ok {
+			logger.Error(ctx, "failed to grant file access",
+				zap.String("file_id", fileID.String()),
+				zap.String("assignment_id", assignment.ID.String()),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// detachFile releases the file used by the assignment. A nil fileID releases all files of the assignment.
+func detachFile(ctx context.Context, fileClient FileClient, fileID *uuid.UUID, assignmentID uuid.UUID) {
+	id := uuid.Nil
+	if fileID != nil {
+		id = *fileID
+	}
+	if err := fileClient.UnregisterFileUsage(ctx, id, assignmentID); err != nil {
+		if logger, ok := logging.GetFromContext(ctx); ok {
+			logger.Error(ctx, "failed to unregister file usage",
+				zap.String("file_id", id.String()),
+				zap.String("assignment_id", assignmentID.String()),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// replaceFile moves the usage of the assignment from oldFileID to newFileID.
+func replaceFile(ctx context.Context, fileClient FileClient, oldFileID, newFileID *uuid.UUID, assignment *domain.Assignment) {
+	if oldFileID != nil && newFileID != nil && *oldFileID == *newFileID {
+		return
+	}
+	attachFile(ctx, fileClient, newFileID, assignment)
+	if oldFileID != nil {
+		detachFile(ctx, fileClient, oldFileID, assignment.ID)
+	}
+}
@@ -7,8 +7,12 @@ import (
 
 type UserClient interface {
 	IsPair(ctx context.Context, tutorID, studentID uuid.UUID) (bool, error)
+	IsGuardian(ctx context.Context, guardianID, studentID uuid.UUID) (bool, error)
 }
 
 type FileClient interface {
 	GetFileURL(ctx context.Context, fileID uuid.UUID) (string, error)
+	RegisterFileUsage(ctx context.Context, fileID, assignmentID uuid.UUID) error
+	UnregisterFileUsage(ctx context.Context, fileID, assignmentID uuid.UUID) error
+	GrantFileAccess(ctx context.Context, fileID uuid.UUID, userIDs ...uuid.UUID) error
 }
@@ -19,17 +19,20 @@ type SubmissionServiceInterface interface {
 type submissionService struct {
 	submissionRepo *repository.SubmissionRepository
 	assignmentRepo *repository.AssignmentRepository
+	userClient     UserClient
 	fileClient     FileClient
 }
 
 func NewSubmissionService(
 	submissionRepo *repository.SubmissionRepository,
 	assignmentRepo *repository.AssignmentRepository,
+	userClient UserClient,
 	fileClient FileClient,
 ) SubmissionServiceInterface {
 	return &submissionService{
 		submissionRepo: submissionRepo,
 		assignmentRepo: assignmentRepo,
+		userClient:     userClient,
 		fileClient:     fileClient,
 	}
 }
@@ -49,6 +52,8 @@ func (s *submissionService) CreateSubmission(ctx context.Context, submission *do
 		return nil, err
 	}
 
+	attachFile(ctx, s.fileClient, submission.FileID, assignment)
+
 	return submission, nil
 }
 
@@ -63,8 +68,7 @@ func (s *submissionService) GetSubmission(ctx context.Context, id uuid.UUID) (*d
 		return nil, err
 	}
 
-	userId, ok := ctxdata.GetUserID(ctx)
-	if !ok || (userId != assignment.StudentID.String() && userId != assignment.TutorID.String()) {
+	if !canReadAssignment(ctx, s.userClient, assignment) {
 		return nil, ErrPermissionDenied
 	}
 
@@ -77,8 +81,7 @@ func (s *submissionService) ListSubmissionsByAssignment(ctx context.Context, ass
 		return nil, err
 	}
 
-	userId, ok := ctxdata.GetUserID(ctx)
-	if !ok || (userId != assignment.StudentID.String() && userId != assignment.TutorID.String()) {
+	if !canReadAssignment(ctx, s.userClient, assignment) {
 		return nil, ErrPermissionDenied
 	}
 
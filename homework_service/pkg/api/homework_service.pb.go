@@ -1057,6 +1057,155 @@ func (x *HomeworkFileURL) GetUrl() string {
 	return ""
 }
 
+type ExportUserDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserDataRequest) Reset() {
+	*x = ExportUserDataRequest{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataRequest) ProtoMessage() {}
+
+func (x *ExportUserDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportUserDataRequest) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ExportUserDataRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// assignments of the user as a tutor and as a student with their submissions and feedbacks
+type UserDataExport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assignments   []*Assignment          `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	Submissions   []*Submission          `protobuf:"bytes,2,rep,name=submissions,proto3" json:"submissions,omitempty"`
+	Feedbacks     []*Feedback            `protobuf:"bytes,3,rep,name=feedbacks,proto3" json:"feedbacks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserDataExport) Reset() {
+	*x = UserDataExport{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDataExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDataExport) ProtoMessage() {}
+
+func (x *UserDataExport) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDataExport.ProtoReflect.Descriptor instead.
+func (*UserDataExport) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UserDataExport) GetAssignments() []*Assignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+func (x *UserDataExport) GetSubmissions() []*Submission {
+	if x != nil {
+		return x.Submissions
+	}
+	return nil
+}
+
+func (x *UserDataExport) GetFeedbacks() []*Feedback {
+	if x != nil {
+		return x.Feedbacks
+	}
+	return nil
+}
+
+type ForgetUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForgetUserRequest) Reset() {
+	*x = ForgetUserRequest{}
+	mi := &file_my_proto_homework_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForgetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForgetUserRequest) ProtoMessage() {}
+
+func (x *ForgetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_my_proto_homework_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForgetUserRequest.ProtoReflect.Descriptor instead.
+func (*ForgetUserRequest) Descriptor() ([]byte, []int) {
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ForgetUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
 type Assignment struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1074,7 +1223,7 @@ type Assignment struct {
 
 func (x *Assignment) Reset() {
 	*x = Assignment{}
-	mi := &file_my_proto_homework_service_proto_msgTypes[19]
+	mi := &file_my_proto_homework_service_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1086,7 +1235,7 @@ func (x *Assignment) String() string {
 func (*Assignment) ProtoMessage() {}
 
 func (x *Assignment) ProtoReflect() protoreflect.Message {
-	mi := &file_my_proto_homework_service_proto_msgTypes[19]
+	mi := &file_my_proto_homework_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1099,7 +1248,7 @@ func (x *Assignment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Assignment.ProtoReflect.Descriptor instead.
 func (*Assignment) Descriptor() ([]byte, []int) {
-	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{19}
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *Assignment) GetId() string {
@@ -1179,7 +1328,7 @@ type Submission struct {
 
 func (x *Submission) Reset() {
 	*x = Submission{}
-	mi := &file_my_proto_homework_service_proto_msgTypes[20]
+	mi := &file_my_proto_homework_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1191,7 +1340,7 @@ func (x *Submission) String() string {
 func (*Submission) ProtoMessage() {}
 
 func (x *Submission) ProtoReflect() protoreflect.Message {
-	mi := &file_my_proto_homework_service_proto_msgTypes[20]
+	mi := &file_my_proto_homework_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1204,7 +1353,7 @@ func (x *Submission) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Submission.ProtoReflect.Descriptor instead.
 func (*Submission) Descriptor() ([]byte, []int) {
-	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{20}
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *Submission) GetId() string {
@@ -1263,7 +1412,7 @@ type Feedback struct {
 
 func (x *Feedback) Reset() {
 	*x = Feedback{}
-	mi := &file_my_proto_homework_service_proto_msgTypes[21]
+	mi := &file_my_proto_homework_service_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1275,7 +1424,7 @@ func (x *Feedback) String() string {
 func (*Feedback) ProtoMessage() {}
 
 func (x *Feedback) ProtoReflect() protoreflect.Message {
-	mi := &file_my_proto_homework_service_proto_msgTypes[21]
+	mi := &file_my_proto_homework_service_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1288,7 +1437,7 @@ func (x *Feedback) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Feedback.ProtoReflect.Descriptor instead.
 func (*Feedback) Descriptor() ([]byte, []int) {
-	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{21}
+	return file_my_proto_homework_service_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *Feedback) GetId() string {
@@ -1419,7 +1568,15 @@ const file_my_proto_homework_service_proto_rawDesc = "" +
 	"\vfeedback_id\x18\x01 \x01(\tR\n" +
 	"feedbackId\"#\n" +
 	"\x0fHomeworkFileURL\x12\x10\n" +
-	"\x03url\x18\x01 \x01(\tR\x03url\"\x99\x03\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"0\n" +
+	"\x15ExportUserDataRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xbb\x01\n" +
+	"\x0eUserDataExport\x129\n" +
+	"\vassignments\x18\x01 \x03(\v2\x17.homework.v1.AssignmentR\vassignments\x129\n" +
+	"\vsubmissions\x18\x02 \x03(\v2\x17.homework.v1.SubmissionR\vsubmissions\x123\n" +
+	"\tfeedbacks\x18\x03 \x03(\v2\x15.homework.v1.FeedbackR\tfeedbacks\",\n" +
+	"\x11ForgetUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x99\x03\n" +
 	"\n" +
 	"Assignment\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
@@ -1470,8 +1627,7 @@ const file_my_proto_homework_service_proto_rawDesc = "" +
 	"\n" +
 	"UNREVIEWED\x10\x02\x12\f\n" +
 	"\bREVIEWED\x10\x03\x12\v\n" +
-	"\aOVERDUE\x10\x042\xa8\n" +
-	"\n" +
+	"\aOVERDUE\x10\x042\xbd\v\n" +
 	"\x0fHomeworkService\x12Q\n" +
 	"\x10CreateAssignment\x12$.homework.v1.CreateAssignmentRequest\x1a\x17.homework.v1.Assignment\x12Q\n" +
 	"\x10UpdateAssignment\x12$.homework.v1.UpdateAssignmentRequest\x1a\x17.homework.v1.Assignment\x12L\n" +
@@ -1486,7 +1642,10 @@ const file_my_proto_homework_service_proto_rawDesc = "" +
 	"\x19ListFeedbacksByAssignment\x12-.homework.v1.ListFeedbacksByAssignmentRequest\x1a\".homework.v1.ListFeedbacksResponse\x12X\n" +
 	"\x11GetAssignmentFile\x12%.homework.v1.GetAssignmentFileRequest\x1a\x1c.homework.v1.HomeworkFileURL\x12X\n" +
 	"\x11GetSubmissionFile\x12%.homework.v1.GetSubmissionFileRequest\x1a\x1c.homework.v1.HomeworkFileURL\x12T\n" +
-	"\x0fGetFeedbackFile\x12#.homework.v1.GetFeedbackFileRequest\x1a\x1c.homework.v1.HomeworkFileURLB\vZ\t./pkg/apib\x06proto3"
+	"\x0fGetFeedbackFile\x12#.homework.v1.GetFeedbackFileRequest\x1a\x1c.homework.v1.HomeworkFileURL\x12Q\n" +
+	"\x0eExportUserData\x12\".homework.v1.ExportUserDataRequest\x1a\x1b.homework.v1.UserDataExport\x12@\n" +
+	"\n" +
+	"ForgetUser\x12\x1e.homework.v1.ForgetUserRequest\x1a\x12.homework.v1.EmptyB\vZ\t./pkg/apib\x06proto3"
 
 var (
 	file_my_proto_homework_service_proto_rawDescOnce sync.Once
@@ -1501,7 +1660,7 @@ func file_my_proto_homework_service_proto_rawDescGZIP() []byte {
 }
 
 var file_my_proto_homework_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_my_proto_homework_service_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_my_proto_homework_service_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_my_proto_homework_service_proto_goTypes = []any{
 	(AssignmentStatusFilter)(0),                // 0: homework.v1.AssignmentStatusFilter
 	(*Empty)(nil),                              // 1: homework.v1.Empty
@@ -1523,60 +1682,70 @@ var file_my_proto_homework_service_proto_goTypes = []any{
 	(*GetSubmissionFileRequest)(nil),           // 17: homework.v1.GetSubmissionFileRequest
 	(*GetFeedbackFileRequest)(nil),             // 18: homework.v1.GetFeedbackFileRequest
 	(*HomeworkFileURL)(nil),                    // 19: homework.v1.HomeworkFileURL
-	(*Assignment)(nil),                         // 20: homework.v1.Assignment
-	(*Submission)(nil),                         // 21: homework.v1.Submission
-	(*Feedback)(nil),                           // 22: homework.v1.Feedback
-	(*timestamppb.Timestamp)(nil),              // 23: google.protobuf.Timestamp
+	(*ExportUserDataRequest)(nil),              // 20: homework.v1.ExportUserDataRequest
+	(*UserDataExport)(nil),                     // 21: homework.v1.UserDataExport
+	(*ForgetUserRequest)(nil),                  // 22: homework.v1.ForgetUserRequest
+	(*Assignment)(nil),                         // 23: homework.v1.Assignment
+	(*Submission)(nil),                         // 24: homework.v1.Submission
+	(*Feedback)(nil),                           // 25: homework.v1.Feedback
+	(*timestamppb.Timestamp)(nil),              // 26: google.protobuf.Timestamp
 }
 var file_my_proto_homework_service_proto_depIdxs = []int32{
-	23, // 0: homework.v1.CreateAssignmentRequest.due_date:type_name -> google.protobuf.Timestamp
-	23, // 1: homework.v1.UpdateAssignmentRequest.due_date:type_name -> google.protobuf.Timestamp
+	26, // 0: homework.v1.CreateAssignmentRequest.due_date:type_name -> google.protobuf.Timestamp
+	26, // 1: homework.v1.UpdateAssignmentRequest.due_date:type_name -> google.protobuf.Timestamp
 	0,  // 2: homework.v1.ListAssignmentsByTutorRequest.status_filter:type_name -> homework.v1.AssignmentStatusFilter
 	0,  // 3: homework.v1.ListAssignmentsByStudentRequest.status_filter:type_name -> homework.v1.AssignmentStatusFilter
 	0,  // 4: homework.v1.ListAssignmentsByPairRequest.status_filter:type_name -> homework.v1.AssignmentStatusFilter
-	20, // 5: homework.v1.ListAssignmentsResponse.assignments:type_name -> homework.v1.Assignment
-	21, // 6: homework.v1.ListSubmissionsResponse.submissions:type_name -> homework.v1.Submission
-	22, // 7: homework.v1.ListFeedbacksResponse.feedbacks:type_name -> homework.v1.Feedback
-	23, // 8: homework.v1.Assignment.due_date:type_name -> google.protobuf.Timestamp
-	23, // 9: homework.v1.Assignment.created_at:type_name -> google.protobuf.Timestamp
-	23, // 10: homework.v1.Assignment.edited_at:type_name -> google.protobuf.Timestamp
-	23, // 11: homework.v1.Submission.created_at:type_name -> google.protobuf.Timestamp
-	23, // 12: homework.v1.Submission.edited_at:type_name -> google.protobuf.Timestamp
-	23, // 13: homework.v1.Feedback.created_at:type_name -> google.protobuf.Timestamp
-	23, // 14: homework.v1.Feedback.edited_at:type_name -> google.protobuf.Timestamp
-	3,  // 15: homework.v1.HomeworkService.CreateAssignment:input_type -> homework.v1.CreateAssignmentRequest
-	4,  // 16: homework.v1.HomeworkService.UpdateAssignment:input_type -> homework.v1.UpdateAssignmentRequest
-	2,  // 17: homework.v1.HomeworkService.DeleteAssignment:input_type -> homework.v1.DeleteAssignmentRequest
-	5,  // 18: homework.v1.HomeworkService.ListAssignmentsByTutor:input_type -> homework.v1.ListAssignmentsByTutorRequest
-	6,  // 19: homework.v1.HomeworkService.ListAssignmentsByStudent:input_type -> homework.v1.ListAssignmentsByStudentRequest
-	7,  // 20: homework.v1.HomeworkService.ListAssignmentsByPair:input_type -> homework.v1.ListAssignmentsByPairRequest
-	9,  // 21: homework.v1.HomeworkService.CreateSubmission:input_type -> homework.v1.CreateSubmissionRequest
-	10, // 22: homework.v1.HomeworkService.ListSubmissionsByAssignment:input_type -> homework.v1.ListSubmissionsByAssignmentRequest
-	12, // 23: homework.v1.HomeworkService.CreateFeedback:input_type -> homework.v1.CreateFeedbackRequest
-	13, // 24: homework.v1.HomeworkService.UpdateFeedback:input_type -> homework.v1.UpdateFeedbackRequest
-	14, // 25: homework.v1.HomeworkService.ListFeedbacksByAssignment:input_type -> homework.v1.ListFeedbacksByAssignmentRequest
-	16, // 26: homework.v1.HomeworkService.GetAssignmentFile:input_type -> homework.v1.GetAssignmentFileRequest
-	17, // 27: homework.v1.HomeworkService.GetSubmissionFile:input_type -> homework.v1.GetSubmissionFileRequest
-	18, // 28: homework.v1.HomeworkService.GetFeedbackFile:input_type -> homework.v1.GetFeedbackFileRequest
-	20, // 29: homework.v1.HomeworkService.CreateAssignment:output_type -> homework.v1.Assignment
-	20, // 30: homework.v1.HomeworkService.UpdateAssignment:output_type -> homework.v1.Assignment
-	1,  // 31: homework.v1.HomeworkService.DeleteAssignment:output_type -> homework.v1.Empty
-	8,  // 32: homework.v1.HomeworkService.ListAssignmentsByTutor:output_type -> homework.v1.ListAssignmentsResponse
-	8,  // 33: homework.v1.HomeworkService.ListAssignmentsByStudent:output_type -> homework.v1.ListAssignmentsResponse
-	8,  // 34: homework.v1.HomeworkService.ListAssignmentsByPair:output_type -> homework.v1.ListAssignmentsResponse
-	21, // 35: homework.v1.HomeworkService.CreateSubmission:output_type -> homework.v1.Submission
-	11, // 36: homework.v1.HomeworkService.ListSubmissionsByAssignment:output_type -> homework.v1.ListSubmissionsResponse
-	22, // 37: homework.v1.HomeworkService.CreateFeedback:output_type -> homework.v1.Feedback
-	22, // 38: homework.v1.HomeworkService.UpdateFeedback:output_type -> homework.v1.Feedback
-	15, // 39: homework.v1.HomeworkService.ListFeedbacksByAssignment:output_type -> homework.v1.ListFeedbacksResponse
-	19, // 40: homework.v1.HomeworkService.GetAssignmentFile:output_type -> homework.v1.HomeworkFileURL
-	19, // 41: homework.v1.HomeworkService.GetSubmissionFile:output_type -> homework.v1.HomeworkFileURL
-	19, // 42: homework.v1.HomeworkService.GetFeedbackFile:output_type -> homework.v1.HomeworkFileURL
-	29, // [29:43] is the sub-list for method output_type
-	15, // [15:29] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	23, // 5: homework.v1.ListAssignmentsResponse.assignments:type_name -> homework.v1.Assignment
+	24, // 6: homework.v1.ListSubmissionsResponse.submissions:type_name -> homework.v1.Submission
+	25, // 7: homework.v1.ListFeedbacksResponse.feedbacks:type_name -> homework.v1.Feedback
+	23, // 8: homework.v1.UserDataExport.assignments:type_name -> homework.v1.Assignment
+	24, // 9: homework.v1.UserDataExport.submissions:type_name -> homework.v1.Submission
+	25, // 10: homework.v1.UserDataExport.feedbacks:type_name -> homework.v1.Feedback
+	26, // 11: homework.v1.Assignment.due_date:type_name -> google.protobuf.Timestamp
+	26, // 12: homework.v1.Assignment.created_at:type_name -> google.protobuf.Timestamp
+	26, // 13: homework.v1.Assignment.edited_at:type_name -> google.protobuf.Timestamp
+	26, // 14: homework.v1.Submission.created_at:type_name -> google.protobuf.Timestamp
+	26, // 15: homework.v1.Submission.edited_at:type_name -> google.protobuf.Timestamp
+	26, // 16: homework.v1.Feedback.created_at:type_name -> google.protobuf.Timestamp
+	26, // 17: homework.v1.Feedback.edited_at:type_name -> google.protobuf.Timestamp
+	3,  // 18: homework.v1.HomeworkService.CreateAssignment:input_type -> homework.v1.CreateAssignmentRequest
+	4,  // 19: homework.v1.HomeworkService.UpdateAssignment:input_type -> homework.v1.UpdateAssignmentRequest
+	2,  // 20: homework.v1.HomeworkService.DeleteAssignment:input_type -> homework.v1.DeleteAssignmentRequest
+	5,  // 21: homework.v1.HomeworkService.ListAssignmentsByTutor:input_type -> homework.v1.ListAssignmentsByTutorRequest
+	6,  // 22: homework.v1.HomeworkService.ListAssignmentsByStudent:input_type -> homework.v1.ListAssignmentsByStudentRequest
+	7,  // 23: homework.v1.HomeworkService.ListAssignmentsByPair:input_type -> homework.v1.ListAssignmentsByPairRequest
+	9,  // 24: homework.v1.HomeworkService.CreateSubmission:input_type -> homework.v1.CreateSubmissionRequest
+	10, // 25: homework.v1.HomeworkService.ListSubmissionsByAssignment:input_type -> homework.v1.ListSubmissionsByAssignmentRequest
+	12, // 26: homework.v1.HomeworkService.CreateFeedback:input_type -> homework.v1.CreateFeedbackRequest
+	13, // 27: homework.v1.HomeworkService.UpdateFeedback:input_type -> homework.v1.UpdateFeedbackRequest
+	14, // 28: homework.v1.HomeworkService.ListFeedbacksByAssignment:input_type -> homework.v1.ListFeedbacksByAssignmentRequest
+	16, // 29: homework.v1.HomeworkService.GetAssignmentFile:input_type -> homework.v1.GetAssignmentFileRequest
+	17, // 30: homework.v1.HomeworkService.GetSubmissionFile:input_type -> homework.v1.GetSubmissionFileRequest
+	18, // 31: homework.v1.HomeworkService.GetFeedbackFile:input_type -> homework.v1.GetFeedbackFileRequest
+	20, // 32: homework.v1.HomeworkService.ExportUserData:input_type -> homework.v1.ExportUserDataRequest
+	22, // 33: homework.v1.HomeworkService.ForgetUser:input_type -> homework.v1.ForgetUserRequest
+	23, // 34: homework.v1.HomeworkService.CreateAssignment:output_type -> homework.v1.Assignment
+	23, // 35: homework.v1.HomeworkService.UpdateAssignment:output_type -> homework.v1.Assignment
+	1,  // 36: homework.v1.HomeworkService.DeleteAssignment:output_type -> homework.v1.Empty
+	8,  // 37: homework.v1.HomeworkService.ListAssignmentsByTutor:output_type -> homework.v1.ListAssignmentsResponse
+	8,  // 38: homework.v1.HomeworkService.ListAssignmentsByStudent:output_type -> homework.v1.ListAssignmentsResponse
+	8,  // 39: homework.v1.HomeworkService.ListAssignmentsByPair:output_type -> homework.v1.ListAssignmentsResponse
+	24, // 40: homework.v1.HomeworkService.CreateSubmission:output_type -> homework.v1.Submission
+	11, // 41: homework.v1.HomeworkService.ListSubmissionsByAssignment:output_type -> homework.v1.ListSubmissionsResponse
+	25, // 42: homework.v1.HomeworkService.CreateFeedback:output_type -> homework.v1.Feedback
+	25, // 43: homework.v1.HomeworkService.UpdateFeedback:output_type -> homework.v1.Feedback
+	15, // 44: homework.v1.HomeworkService.ListFeedbacksByAssignment:output_type -> homework.v1.ListFeedbacksResponse
+	19, // 45: homework.v1.HomeworkService.GetAssignmentFile:output_type -> homework.v1.HomeworkFileURL
+	19, // 46: homework.v1.HomeworkService.GetSubmissionFile:output_type -> homework.v1.HomeworkFileURL
+	19, // 47: homework.v1.HomeworkService.GetFeedbackFile:output_type -> homework.v1.HomeworkFileURL
+	21, // 48: homework.v1.HomeworkService.ExportUserData:output_type -> homework.v1.UserDataExport
+	1,  // 49: homework.v1.HomeworkService.ForgetUser:output_type -> homework.v1.Empty
+	34, // [34:50] is the sub-list for method output_type
+	18, // [18:34] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_my_proto_homework_service_proto_init() }
@@ -1589,16 +1758,16 @@ func file_my_proto_homework_service_proto_init() {
 	file_my_proto_homework_service_proto_msgTypes[8].OneofWrappers = []any{}
 	file_my_proto_homework_service_proto_msgTypes[11].OneofWrappers = []any{}
 	file_my_proto_homework_service_proto_msgTypes[12].OneofWrappers = []any{}
-	file_my_proto_homework_service_proto_msgTypes[19].OneofWrappers = []any{}
-	file_my_proto_homework_service_proto_msgTypes[20].OneofWrappers = []any{}
-	file_my_proto_homework_service_proto_msgTypes[21].OneofWrappers = []any{}
+	file_my_proto_homework_service_proto_msgTypes[22].OneofWrappers = []any{}
+	file_my_proto_homework_service_proto_msgTypes[23].OneofWrappers = []any{}
+	file_my_proto_homework_service_proto_msgTypes[24].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_my_proto_homework_service_proto_rawDesc), len(file_my_proto_homework_service_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   22,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -33,6 +33,8 @@ const (
 	HomeworkService_GetAssignmentFile_FullMethodName           = "/homework.v1.HomeworkService/GetAssignmentFile"
 	HomeworkService_GetSubmissionFile_FullMethodName           = "/homework.v1.HomeworkService/GetSubmissionFile"
 	HomeworkService_GetFeedbackFile_FullMethodName             = "/homework.v1.HomeworkService/GetFeedbackFile"
+	HomeworkService_ExportUserData_FullMethodName              = "/homework.v1.HomeworkService/ExportUserData"
+	HomeworkService_ForgetUser_FullMethodName                  = "/homework.v1.HomeworkService/ForgetUser"
 )
 
 // HomeworkServiceClient is the client API for HomeworkService service.
@@ -57,6 +59,9 @@ type HomeworkServiceClient interface {
 	GetAssignmentFile(ctx context.Context, in *GetAssignmentFileRequest, opts ...grpc.CallOption) (*HomeworkFileURL, error)
 	GetSubmissionFile(ctx context.Context, in *GetSubmissionFileRequest, opts ...grpc.CallOption) (*HomeworkFileURL, error)
 	GetFeedbackFile(ctx context.Context, in *GetFeedbackFileRequest, opts ...grpc.CallOption) (*HomeworkFileURL, error)
+	// --- ACCOUNT DATA (called by user_service on behalf of the user) ---
+	ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*UserDataExport, error)
+	ForgetUser(ctx context.Context, in *ForgetUserRequest, opts ...grpc.CallOption) (*Empty, error)
 }
 
 type homeworkServiceClient struct {
@@ -207,6 +212,26 @@ func (c *homeworkServiceClient) GetFeedbackFile(ctx context.Context, in *GetFeed
 	return out, nil
 }
 
+func (c *homeworkServiceClient) ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*UserDataExport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserDataExport)
+	err := c.cc.Invoke(ctx, HomeworkService_ExportUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *homeworkServiceClient) ForgetUser(ctx context.Context, in *ForgetUserRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, HomeworkService_ForgetUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HomeworkServiceServer is the server API for HomeworkService service.
 // All implementations must embed UnimplementedHomeworkServiceServer
 // for forward compatibility.
@@ -229,6 +254,9 @@ type HomeworkServiceServer interface {
 	GetAssignmentFile(context.Context, *GetAssignmentFileRequest) (*HomeworkFileURL, error)
 	GetSubmissionFile(context.Context, *GetSubmissionFileRequest) (*HomeworkFileURL, error)
 	GetFeedbackFile(context.Context, *GetFeedbackFileRequest) (*HomeworkFileURL, error)
+	// --- ACCOUNT DATA (called by user_service on behalf of the user) ---
+	ExportUserData(context.Context, *ExportUserDataRequest) (*UserDataExport, error)
+	ForgetUser(context.Context, *ForgetUserRequest) (*Empty, error)
 	mustEmbedUnimplementedHomeworkServiceServer()
 }
 
@@ -281,6 +309,12 @@ func (UnimplementedHomeworkServiceServer) GetSubmissionFile(context.Context, *Ge
 func (UnimplementedHomeworkServiceServer) GetFeedbackFile(context.Context, *GetFeedbackFileRequest) (*HomeworkFileURL, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFeedbackFile not implemented")
 }
+func (UnimplementedHomeworkServiceServer) ExportUserData(context.Context, *ExportUserDataRequest) (*UserDataExport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUserData not implemented")
+}
+func (UnimplementedHomeworkServiceServer) ForgetUser(context.Context, *ForgetUserRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForgetUser not implemented")
+}
 func (UnimplementedHomeworkServiceServer) mustEmbedUnimplementedHomeworkServiceServer() {}
 func (UnimplementedHomeworkServiceServer) testEmbeddedByValue()                         {}
 
@@ -554,6 +588,42 @@ func _HomeworkService_GetFeedbackFile_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HomeworkService_ExportUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HomeworkServiceServer).ExportUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HomeworkService_ExportUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HomeworkServiceServer).ExportUserData(ctx, req.(*ExportUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HomeworkService_ForgetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForgetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HomeworkServiceServer).ForgetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HomeworkService_ForgetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HomeworkServiceServer).ForgetUser(ctx, req.(*ForgetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // HomeworkService_ServiceDesc is the grpc.ServiceDesc for HomeworkService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -617,6 +687,14 @@ var HomeworkService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetFeedbackFile",
 			Handler:    _HomeworkService_GetFeedbackFile_Handler,
 		},
+		{
+			MethodName: "ExportUserData",
+			Handler:    _HomeworkService_ExportUserData_Handler,
+		},
+		{
+			MethodName: "ForgetUser",
+			Handler:    _HomeworkService_ForgetUser_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "my_proto/homework_service.proto",
@@ -20,10 +20,14 @@ import (
 	"paymentservice/internal/data"
 	"paymentservice/internal/db"
 	"paymentservice/internal/handler"
+	"paymentservice/internal/invoice"
+	"paymentservice/internal/notifier"
+	"paymentservice/internal/provider/yookassa"
 	"paymentservice/internal/service"
 	pb "paymentservice/pkg/api"
 	api3 "schedule_service/pkg/api"
 	"syscall"
+	"time"
 	"userservice/pkg/api"
 )
 
@@ -86,13 +90,84 @@ func main() {
 	defer closeFunc()
 
 	userClient := api.NewUserServiceClient(userGrpcClient)
+	adminClient := api.NewAdminServiceClient(userGrpcClient)
 	fileClient := api2.NewFileServiceClient(fileGrpcClient)
 	scheduleClient := api3.NewScheduleServiceClient(scheduleGrpcClient)
 
-	paymentService := service.NewPaymentService(paymentRepo, userClient, fileClient, scheduleClient)
+	invoiceRenderer, err := invoice.NewRenderer(cfg.InvoiceFontPath)
+	if err != nil {
+		logger.Warn(ctx, "invoice font is not available, invoices are rendered without Cyrillic", zap.Error(err))
+		invoiceRenderer, _ = invoice.NewRenderer("")
+	}
+
+	paymentService := service.NewPaymentService(paymentRepo, userClient, adminClient, fileClient, scheduleClient, invoiceRenderer)
+
+	switch cfg.PaymentProvider {
+	case "":
+		logger.Info(ctx, "payment provider is not configured, online payments are disabled")
+	case yookassa.ProviderName:
+		provider, err := yookassa.New(yookassa.Config{
+			ShopID:        cfg.YooKassaShopID,
+			SecretKey:     cfg.YooKassaSecretKey,
+			APIURL:        cfg.YooKassaAPIURL,
+			WebhookSecret: cfg.YooKassaWebhookSecret,
+		})
+		if err != nil {
+			logger.Fatal(ctx, "cannot create payment provider", zap.Error(err))
+		}
+		paymentService.WithPaymentProvider(provider)
+	default:
+		logger.Fatal(ctx, "unknown payment provider", zap.String("provider", cfg.PaymentProvider))
+	}
+
+	dunningEnabled := cfg.NotificationWebhookURL != ""
+	if dunningEnabled {
+		webhookNotifier, err := notifier.NewWebhook(cfg.NotificationWebhookURL, cfg.NotificationWebhookToken)
+		if err != nil {
+			logger.Fatal(ctx, "cannot create notifier", zap.Error(err))
+		}
+		paymentService.WithNotifier(webhookNotifier)
+	} else {
+		logger.Info(ctx, "notification webhook is not configured, payment reminders are disabled")
+	}
 
 	paymentHandler := handler.NewPaymentServiceServer(paymentService)
 
+	sagaRecoveryWorker := NewSagaRecoveryWorker(paymentService, logger, cfg.SagaRecoveryInterval, cfg.SagaStaleAfter)
+	go sagaRecoveryWorker.Start(ctx)
+
+	go func() {
+		// receipts get their tutors first, so that the tutors are granted access to the files
+		count, err := paymentService.BackfillReceiptPairs(ctx)
+		if err != nil {
+			logger.Error(ctx, "receipt pair backfill failed", zap.Error(err))
+		} else if count > 0 {
+			logger.Info(ctx, "receipt pair backfill finished", zap.Int("receipts", count))
+		}
+
+		if !cfg.FileGrantBackfill {
+			return
+		}
+		count, err = paymentService.BackfillReceiptFileGrants(ctx)
+		if err != nil {
+			logger.Error(ctx, "receipt file grant backfill failed", zap.Error(err))
+			return
+		}
+		logger.Info(ctx, "receipt file grant backfill finished", zap.Int("receipts", count))
+	}()
+
+	lessonChargeWorker := NewLessonChargeWorker(paymentService, logger, cfg.LessonChargeInterval, cfg.LessonChargeLookback)
+	go lessonChargeWorker.Start(ctx)
+
+	if dunningEnabled {
+		steps, err := dunningSteps(cfg.DunningStepDays)
+		if err != nil {
+			logger.Fatal(ctx, "invalid dunning steps", zap.Error(err))
+		}
+		dunningWorker := NewDunningWorker(paymentService, logger, cfg.DunningInterval, steps, cfg.DunningLookback)
+		go dunningWorker.Start(ctx)
+	}
+
 	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.GRPCPort))
 	if err != nil {
 		logger.Fatal(ctx, "cannot create listener", zap.Error(err))
@@ -119,3 +194,15 @@ func main() {
 		logger.Info(ctx, "Server Stopped")
 	}
 }
+
+// dunningSteps converts reminder days to delays, they must be positive and ascending.
+func dunningSteps(days []int) ([]time.Duration, error) {
+	steps := make([]time.Duration, len(days))
+	for i, d := range days {
+		if d <= 0 || (i > 0 && d <= days[i-1]) {
+			return nil, fmt.Errorf("reminder days must be positive and ascending: %v", days)
+		}
+		steps[i] = time.Duration(d) * 24 * time.Hour
+	}
+	return steps, nil
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"common_library/logging"
+	"context"
+	"go.uber.org/zap"
+	"paymentservice/internal/service"
+	"time"
+)
+
+// SagaRecoveryWorker resumes receipt approvals interrupted by failures or restarts.
+type SagaRecoveryWorker struct {
+	paymentService *service.PaymentService
+	logger         *logging.Logger
+	interval       time.Duration
+	staleAfter     time.Duration
+}
+
+func NewSagaRecoveryWorker(paymentService *service.PaymentService, logger *logging.Logger, interval time.Duration, staleAfter time.Duration) *SagaRecoveryWorker {
+	return &SagaRecoveryWorker{
+		paymentService: paymentService,
+		logger:         logger,
+		interval:       interval,
+		staleAfter:     staleAfter,
+	}
+}
+
+func (w *SagaRecoveryWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info(ctx, "Saga recovery worker stopped")
+			return
+		case <-ticker.C:
+			w.resume(ctx)
+		}
+	}
+}
+
+func (w *SagaRecoveryWorker) resume(ctx context.Context) {
+	finished, err := w.paymentService.ResumeApprovalSagas(ctx, w.staleAfter)
+	if err != nil {
+		w.logger.Error(ctx, "Saga recovery failed", zap.Error(err))
+		return
+	}
+
+	if finished > 0 {
+		w.logger.Info(ctx, "Resumed receipt approvals", zap.Int("sagas", finished))
+	}
+}
+
+// LessonChargeWorker charges completed lessons to student balances and consumes prepaid packages.
+type LessonChargeWorker struct {
+	paymentService *service.PaymentService
+	logger         *logging.Logger
+	interval       time.Duration
+	lookback       time.Duration
+}
+
+func NewLessonChargeWorker(paymentService *service.PaymentService, logger *logging.Logger, interval time.Duration, lookback time.Duration) *LessonChargeWorker {
+	return &LessonChargeWorker{
+		paymentService: paymentService,
+		logger:         logger,
+		interval:       interval,
+		lookback:       lookback,
+	}
+}
+
+func (w *LessonChargeWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info(ctx, "Lesson charge worker stopped")
+			return
+		case <-ticker.C:
+			w.charge(ctx)
+		}
+	}
+}
+
+func (w *LessonChargeWorker) charge(ctx context.Context) {
+	processed, err := w.paymentService.ChargeCompletedLessons(ctx, w.lookback)
+	if err != nil {
+		w.logger.Error(ctx, "Charging completed lessons failed", zap.Error(err))
+		return
+	}
+
+	if processed > 0 {
+		w.logger.Info(ctx, "Charged completed lessons", zap.Int("lessons", processed))
+	}
+}
+
+// DunningWorker reminds students about unpaid lessons and notifies tutors about ignored reminders.
+type DunningWorker struct {
+	paymentService *service.PaymentService
+	logger         *logging.Logger
+	interval       time.Duration
+	steps          []time.Duration
+	lookback       time.Duration
+}
+
+func NewDunningWorker(paymentService *service.PaymentService, logger *logging.Logger, interval time.Duration, steps []time.Duration, lookback time.Duration) *DunningWorker {
+	return &DunningWorker{
+		paymentService: paymentService,
+		logger:         logger,
+		interval:       interval,
+		steps:          steps,
+		lookback:       lookback,
+	}
+}
+
+func (w *DunningWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info(ctx, "Dunning worker stopped")
+			return
+		case <-ticker.C:
+			w.remind(ctx)
+		}
+	}
+}
+
+func (w *DunningWorker) remind(ctx context.Context) {
+	sent, err := w.paymentService.RunDunning(ctx, w.steps, w.lookback)
+	if err != nil {
+		w.logger.Error(ctx, "Dunning failed", zap.Error(err))
+		return
+	}
+
+	if sent > 0 {
+		w.logger.Info(ctx, "Sent payment reminders", zap.Int("notifications", sent))
+	}
+}
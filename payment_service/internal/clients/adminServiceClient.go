@@ -0,0 +1,14 @@
+//go:generate mockgen -source=adminServiceClient.go -destination=../mocks/admin_service_mock.go -package=mocks
+
+package clients
+
+import (
+	"context"
+	"google.golang.org/grpc"
+	api4 "userservice/pkg/api"
+)
+
+// AdminServiceClient records admin actions in the audit log of user service.
+type AdminServiceClient interface {
+	RecordAuditEvent(ctx context.Context, req *api4.RecordAuditEventRequest, opts ...grpc.CallOption) (*api4.Empty, error)
+}
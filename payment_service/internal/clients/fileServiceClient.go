@@ -10,4 +10,7 @@ import (
 
 type FileServiceClient interface {
 	GenerateDownloadURL(ctx context.Context, req *api2.GenerateDownloadURLRequest, opts ...grpc.CallOption) (*api2.DownloadURL, error)
+	RegisterFileUsage(ctx context.Context, req *api2.FileUsage, opts ...grpc.CallOption) (*api2.Empty, error)
+	UploadFile(ctx context.Context, req *api2.UploadFileRequest, opts ...grpc.CallOption) (*api2.File, error)
+	GrantFileAccess(ctx context.Context, req *api2.FileAccessRequest, opts ...grpc.CallOption) (*api2.Empty, error)
 }
@@ -9,8 +9,15 @@ import (
 )
 
 type ScheduleServiceClient interface {
+	GetSlot(ctx context.Context, req *api3.GetSlotRequest, opts ...grpc.CallOption) (*api3.Slot, error)
 	GetLesson(ctx context.Context, req *api3.GetLessonRequest, opts ...grpc.CallOption) (*api3.Lesson, error)
 	CreateLesson(ctx context.Context, req *api3.CreateLessonRequest, opts ...grpc.CallOption) (*api3.Lesson, error)
 	UpdateLesson(ctx context.Context, req *api3.UpdateLessonRequest, opts ...grpc.CallOption) (*api3.Lesson, error)
 	CancelLesson(ctx context.Context, req *api3.CancelLessonRequest, opts ...grpc.CallOption) (*api3.Lesson, error)
+	MarkAsPaid(ctx context.Context, req *api3.MarkAsPaidRequest, opts ...grpc.CallOption) (*api3.Lesson, error)
+	MarkAsUnpaid(ctx context.Context, req *api3.MarkAsUnpaidRequest, opts ...grpc.CallOption) (*api3.Lesson, error)
+	ListLessonsByTutor(ctx context.Context, req *api3.ListLessonsByTutorRequest, opts ...grpc.CallOption) (*api3.ListLessonsResponse, error)
+	ListLessonsByPair(ctx context.Context, req *api3.ListLessonsByPairRequest, opts ...grpc.CallOption) (*api3.ListLessonsResponse, error)
+	ListCompletedUnpaidLessons(ctx context.Context, req *api3.ListCompletedUnpaidLessonsRequest, opts ...grpc.CallOption) (*api3.ListLessonsResponse, error)
+	GetLessonInternal(ctx context.Context, req *api3.GetLessonRequest, opts ...grpc.CallOption) (*api3.Lesson, error)
 }
@@ -39,4 +39,6 @@ type UserServiceClient interface {
 	ResolveTutorStudentContext(ctx context.Context, req *api4.ResolveTutorStudentContextRequest, opts ...grpc.CallOption) (*api4.ResolvedTutorStudentContext, error)
 
 	AcceptInvitationFromTutor(ctx context.Context, req *api4.AcceptInvitationFromTutorRequest, opts ...grpc.CallOption) (*api4.Empty, error)
+
+	GetGuardianStudent(ctx context.Context, req *api4.GetGuardianStudentRequest, opts ...grpc.CallOption) (*api4.GuardianStudent, error)
 }
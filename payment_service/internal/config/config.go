@@ -4,6 +4,7 @@ import (
 	"errors"
 	"github.com/ilyakaznacheev/cleanenv"
 	"os"
+	"time"
 )
 
 type Config struct {
@@ -15,6 +16,37 @@ type Config struct {
 	UserServiceURL      string `env:"USER_CLIENT_URL"`
 	FileServiceURL      string `env:"FILE_SERVICE_URL"`
 	ScheduleServiceURL  string `env:"SCHEDULE_SERVICE_URL"`
+
+	SagaRecoveryInterval time.Duration `env:"SAGA_RECOVERY_INTERVAL" env-default:"30s"`
+	// sagas not updated for this period are considered interrupted
+	SagaStaleAfter time.Duration `env:"SAGA_STALE_AFTER" env-default:"1m"`
+
+	LessonChargeInterval time.Duration `env:"LESSON_CHARGE_INTERVAL" env-default:"5m"`
+	// only lessons completed within this period are charged
+	LessonChargeLookback time.Duration `env:"LESSON_CHARGE_LOOKBACK" env-default:"720h"`
+
+	DunningInterval time.Duration `env:"DUNNING_INTERVAL" env-default:"1h"`
+	// days after the end of an unpaid lesson when the student is reminded; the tutor is notified after the last one
+	DunningStepDays []int `env:"DUNNING_STEP_DAYS" env-default:"1,3,7" env-separator:","`
+	// only lessons completed within this period are reminded about
+	DunningLookback time.Duration `env:"DUNNING_LOOKBACK" env-default:"720h"`
+
+	// reminders are disabled unless the delivery service is set
+	NotificationWebhookURL   string `env:"NOTIFICATION_WEBHOOK_URL"`
+	NotificationWebhookToken string `env:"NOTIFICATION_WEBHOOK_TOKEN"`
+
+	// grants pairs access to receipt files submitted before file_service checked access, enable it once
+	FileGrantBackfill bool `env:"FILE_GRANT_BACKFILL" env-default:"false"`
+
+	// TrueType font with Cyrillic glyphs used in invoice PDFs
+	InvoiceFontPath string `env:"INVOICE_FONT_PATH" env-default:"/usr/share/fonts/dejavu/DejaVuSans.ttf"`
+
+	// online payments are disabled unless a provider is set; supported: yookassa
+	PaymentProvider       string `env:"PAYMENT_PROVIDER"`
+	YooKassaShopID        string `env:"YOOKASSA_SHOP_ID"`
+	YooKassaSecretKey     string `env:"YOOKASSA_SECRET_KEY"`
+	YooKassaAPIURL        string `env:"YOOKASSA_API_URL" env-default:"https://api.yookassa.ru/v3"`
+	YooKassaWebhookSecret string `env:"YOOKASSA_WEBHOOK_SECRET"`
 }
 
 func New() (*Config, error) {
@@ -0,0 +1,76 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+
+	"paymentservice/internal/models"
+)
+
+const dunningStepColumns = `id, lesson_id, tutor_id, student_id, step, recipient, sent_at`
+
+// ClaimDunningStep records the step before it is sent. Reports false if the step of the lesson is already recorded.
+func (r *PaymentRepo) ClaimDunningStep(ctx context.Context, input *models.DunningStepCreateInput) (bool, error) {
+	query := `
+		INSERT INTO dunning_steps (id, lesson_id, tutor_id, student_id, step, recipient, sent_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (lesson_id, step) DO NOTHING
+	`
+	tag, err := r.db.Exec(ctx, query,
+		input.ID,
+		input.LessonID,
+		input.TutorID,
+		input.StudentID,
+		input.Step,
+		input.Recipient,
+		time.Now(),
+	)
+	if err != nil {
+		return false, handleError(err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// ReleaseDunningStep deletes a claimed step that was not sent, so it is retried.
+func (r *PaymentRepo) ReleaseDunningStep(ctx context.Context, id uuid.UUID) error {
+	_, err := r.db.Exec(ctx, `DELETE FROM dunning_steps WHERE id = $1`, id)
+	if err != nil {
+		return handleError(err)
+	}
+	return nil
+}
+
+// GetDunningStartedAt returns when reminders were first run, now is recorded on the first call.
+func (r *PaymentRepo) GetDunningStartedAt(ctx context.Context, now time.Time) (time.Time, error) {
+	query := `
+		WITH inserted AS (
+			INSERT INTO dunning_state (started_at) VALUES ($1)
+			ON CONFLICT (id) DO NOTHING
+			RETURNING started_at
+		)
+		SELECT started_at FROM inserted
+		UNION ALL
+		SELECT started_at FROM dunning_state
+		LIMIT 1
+	`
+	var startedAt time.Time
+	err := r.db.QueryRow(ctx, query, now).Scan(&startedAt)
+	if err != nil {
+		return time.Time{}, handleError(err)
+	}
+	return startedAt, nil
+}
+
+// ListDunningSteps returns recorded steps of the given lessons.
+func (r *PaymentRepo) ListDunningSteps(ctx context.Context, lessonIDs []uuid.UUID) ([]*models.DunningStep, error) {
+	query := `SELECT ` + dunningStepColumns + ` FROM dunning_steps WHERE lesson_id = ANY($1) ORDER BY lesson_id, step`
+	var steps []*models.DunningStep
+	err := pgxscan.Select(ctx, r.db, &steps, query, lessonIDs)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return steps, nil
+}
@@ -0,0 +1,69 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	pgxmock "github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"paymentservice/internal/models"
+)
+
+func TestPaymentRepo_ClaimDunningStep(t *testing.T) {
+	input := &models.DunningStepCreateInput{
+		ID:        uuid.New(),
+		LessonID:  uuid.New(),
+		TutorID:   uuid.New(),
+		StudentID: uuid.New(),
+		Step:      2,
+		Recipient: models.DunningRecipientStudent,
+	}
+
+	for _, tt := range []struct {
+		name     string
+		affected int64
+	}{
+		{name: "Claimed", affected: 1},
+		{name: "AlreadySent", affected: 0},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPool, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockPool.Close()
+
+			repo := NewPaymentRepository(mockPool)
+
+			mockPool.ExpectExec("INSERT INTO dunning_steps").
+				WithArgs(input.ID, input.LessonID, input.TutorID, input.StudentID, int32(2), models.DunningRecipientStudent, AnyTime{}).
+				WillReturnResult(pgxmock.NewResult("INSERT", tt.affected))
+
+			claimed, err := repo.ClaimDunningStep(context.Background(), input)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.affected == 1, claimed)
+			assert.NoError(t, mockPool.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPaymentRepo_GetDunningStartedAt(t *testing.T) {
+	mockPool, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockPool.Close()
+
+	repo := NewPaymentRepository(mockPool)
+
+	now := time.Now()
+	startedAt := now.Add(-48 * time.Hour)
+	mockPool.ExpectQuery("INSERT INTO dunning_state").
+		WithArgs(now).
+		WillReturnRows(pgxmock.NewRows([]string{"started_at"}).AddRow(startedAt))
+
+	got, err := repo.GetDunningStartedAt(context.Background(), now)
+	assert.NoError(t, err)
+	assert.Equal(t, startedAt, got)
+	assert.NoError(t, mockPool.ExpectationsWereMet())
+}
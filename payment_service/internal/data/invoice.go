@@ -0,0 +1,132 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	errdefs "paymentservice/internal/errors"
+	"paymentservice/internal/models"
+)
+
+const invoiceColumns = `id, tutor_id, student_id, number, period_start, period_end, total_minor, paid_minor, currency, payment_details, file_id, created_at`
+
+// CreateInvoice inserts the invoice with its lines under the next number of the tutor and returns it.
+func (r *PaymentRepo) CreateInvoice(ctx context.Context, input *models.InvoiceCreateInput) (*models.Invoice, error) {
+	counterQuery := `
+		INSERT INTO invoice_counters (tutor_id, last_number) VALUES ($1, 1)
+		ON CONFLICT (tutor_id) DO UPDATE SET last_number = invoice_counters.last_number + 1
+		RETURNING last_number
+	`
+	invoiceQuery := `
+		INSERT INTO invoices (id, tutor_id, student_id, number, period_start, period_end, total_minor, paid_minor, currency, payment_details, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING ` + invoiceColumns
+	lineQuery := `
+		INSERT INTO invoice_lines (id, invoice_id, lesson_id, kind, starts_at, amount_minor, is_paid)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	var total, paid int64
+	for _, line := range input.Lines {
+		total += line.AmountMinor
+		if line.IsPaid {
+			paid += line.AmountMinor
+		}
+	}
+
+	invoice := &models.Invoice{}
+	err := r.inTx(ctx, func(tx pgx.Tx) error {
+		// the counter row stays locked until commit, so numbers of the tutor have no gaps
+		var number int32
+		if err := tx.QueryRow(ctx, counterQuery, input.TutorID).Scan(&number); err != nil {
+			return handleError(err)
+		}
+
+		err := pgxscan.Get(ctx, tx, invoice, invoiceQuery,
+			input.ID,
+			input.TutorID,
+			input.StudentID,
+			number,
+			input.PeriodStart,
+			input.PeriodEnd,
+			total,
+			paid,
+			input.Currency,
+			input.PaymentDetails,
+			time.Now(),
+		)
+		if err != nil {
+			return handleError(err)
+		}
+
+		for _, line := range input.Lines {
+			_, err := tx.Exec(ctx, lineQuery, uuid.New(), input.ID, line.LessonID, line.Kind, line.StartsAt, line.AmountMinor, line.IsPaid)
+			if err != nil {
+				return handleError(err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return invoice, nil
+}
+
+// GetInvoiceByID retrieves an invoice by ID.
+func (r *PaymentRepo) GetInvoiceByID(ctx context.Context, id uuid.UUID) (*models.Invoice, error) {
+	query := `SELECT ` + invoiceColumns + ` FROM invoices WHERE id = $1`
+	invoice := &models.Invoice{}
+	err := pgxscan.Get(ctx, r.db, invoice, query, id)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return invoice, nil
+}
+
+// ListInvoiceLines returns lines of the invoice ordered by lesson time.
+func (r *PaymentRepo) ListInvoiceLines(ctx context.Context, invoiceID uuid.UUID) ([]models.InvoiceLine, error) {
+	query := `
+		SELECT lesson_id, kind, starts_at, amount_minor, is_paid FROM invoice_lines
+		WHERE invoice_id = $1
+		ORDER BY starts_at
+	`
+	var lines []models.InvoiceLine
+	err := pgxscan.Select(ctx, r.db, &lines, query, invoiceID)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return lines, nil
+}
+
+// ListInvoices returns invoices matching the filter, newest first. Empty filter fields are ignored.
+func (r *PaymentRepo) ListInvoices(ctx context.Context, filter *models.InvoiceFilter) ([]*models.Invoice, error) {
+	query := `
+		SELECT ` + invoiceColumns + ` FROM invoices
+		WHERE ($1::uuid IS NULL OR tutor_id = $1)
+		  AND ($2::uuid IS NULL OR student_id = $2)
+		ORDER BY created_at DESC
+	`
+	var invoices []*models.Invoice
+	err := pgxscan.Select(ctx, r.db, &invoices, query, filter.TutorID, filter.StudentID)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return invoices, nil
+}
+
+// SetInvoiceFile saves the id of the stored invoice PDF.
+func (r *PaymentRepo) SetInvoiceFile(ctx context.Context, id uuid.UUID, fileID uuid.UUID) error {
+	tag, err := r.db.Exec(ctx, `UPDATE invoices SET file_id = $1 WHERE id = $2`, fileID, id)
+	if err != nil {
+		return handleError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return errdefs.ErrNotFound
+	}
+	return nil
+}
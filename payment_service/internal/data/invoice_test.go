@@ -0,0 +1,97 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	pgxmock "github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"common_library/money"
+	errdefs "paymentservice/internal/errors"
+	"paymentservice/internal/models"
+)
+
+var invoiceColumnNames = []string{"id", "tutor_id", "student_id", "number", "period_start", "period_end", "total_minor", "paid_minor", "currency", "payment_details", "file_id", "created_at"}
+
+func TestPaymentRepo_CreateInvoice(t *testing.T) {
+	periodStart := time.Date(2026, time.September, 1, 0, 0, 0, 0, time.UTC)
+	details := "card 1234"
+	input := &models.InvoiceCreateInput{
+		ID:             uuid.New(),
+		TutorID:        uuid.New(),
+		StudentID:      uuid.New(),
+		PeriodStart:    periodStart,
+		PeriodEnd:      periodStart.AddDate(0, 1, 0),
+		Currency:       money.RUB,
+		PaymentDetails: &details,
+		Lines: []models.InvoiceLine{
+			{LessonID: uuid.New(), Kind: models.InvoiceLineLesson, StartsAt: periodStart.Add(time.Hour), AmountMinor: 150000, IsPaid: true},
+			{LessonID: uuid.New(), Kind: models.InvoiceLineLateCancel, StartsAt: periodStart.Add(48 * time.Hour), AmountMinor: 200000},
+		},
+	}
+
+	mockPool, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockPool.Close()
+
+	repo := NewPaymentRepository(mockPool)
+
+	mockPool.ExpectBegin()
+	mockPool.ExpectQuery("INSERT INTO invoice_counters").
+		WithArgs(input.TutorID).
+		WillReturnRows(pgxmock.NewRows([]string{"last_number"}).AddRow(int32(5)))
+	mockPool.ExpectQuery("INSERT INTO invoices").
+		WithArgs(input.ID, input.TutorID, input.StudentID, int32(5), input.PeriodStart, input.PeriodEnd, int64(350000), int64(150000), money.RUB, &details, AnyTime{}).
+		WillReturnRows(pgxmock.NewRows(invoiceColumnNames).
+			AddRow(input.ID, input.TutorID, input.StudentID, int32(5), input.PeriodStart, input.PeriodEnd, int64(350000), int64(150000), "RUB", &details, (*uuid.UUID)(nil), time.Now()))
+	for _, line := range input.Lines {
+		mockPool.ExpectExec("INSERT INTO invoice_lines").
+			WithArgs(pgxmock.AnyArg(), input.ID, line.LessonID, line.Kind, line.StartsAt, line.AmountMinor, line.IsPaid).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	}
+	mockPool.ExpectCommit()
+
+	invoice, err := repo.CreateInvoice(context.Background(), input)
+	require.NoError(t, err)
+	assert.Equal(t, int32(5), invoice.Number)
+	assert.Equal(t, money.New(350000, money.RUB), invoice.Total())
+	assert.Equal(t, money.New(150000, money.RUB), invoice.Paid())
+	assert.Nil(t, invoice.FileID)
+	assert.NoError(t, mockPool.ExpectationsWereMet())
+}
+
+func TestPaymentRepo_SetInvoiceFile(t *testing.T) {
+	id := uuid.New()
+	fileID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		mockPool, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mockPool.Close()
+
+		mockPool.ExpectExec("UPDATE invoices SET file_id").
+			WithArgs(fileID, id).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+		err = NewPaymentRepository(mockPool).SetInvoiceFile(context.Background(), id, fileID)
+		assert.NoError(t, err)
+		assert.NoError(t, mockPool.ExpectationsWereMet())
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockPool, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mockPool.Close()
+
+		mockPool.ExpectExec("UPDATE invoices SET file_id").
+			WithArgs(fileID, id).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+		err = NewPaymentRepository(mockPool).SetInvoiceFile(context.Background(), id, fileID)
+		assert.ErrorIs(t, err, errdefs.ErrNotFound)
+	})
+}
@@ -0,0 +1,333 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	errdefs "paymentservice/internal/errors"
+	"paymentservice/internal/models"
+)
+
+const (
+	packageColumns  = `id, tutor_id, student_id, name, lesson_count, price_minor, currency, created_at`
+	purchaseColumns = `id, package_id, tutor_id, student_id, lessons_total, lessons_used, price_minor, currency, created_at`
+	ledgerTxColumns = `id, tutor_id, student_id, kind, reference_id, currency, package_purchase_id, created_at`
+)
+
+var errUnbalancedTransaction = errors.New("ledger transaction does not balance")
+
+// CreateLedgerTransaction posts the transaction unless a transaction of the same kind and reference exists.
+// Reports whether the transaction was created.
+func (r *PaymentRepo) CreateLedgerTransaction(ctx context.Context, input *models.LedgerTransactionCreateInput) (bool, error) {
+	var created bool
+	err := r.inTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		created, err = createLedgerTransaction(ctx, tx, input, nil)
+		return err
+	})
+	return created, err
+}
+
+// ApproveReceipt approves the pending receipt, credits its amount to the student and charges the lesson
+// unless it is already charged. Returns ErrAlreadyReviewed if the receipt is not pending anymore.
+func (r *PaymentRepo) ApproveReceipt(ctx context.Context, id uuid.UUID) (*models.PaymentReceipt, error) {
+	query := `
+		UPDATE receipts SET status = $1, edited_at = $2
+		WHERE id = $3 AND status = $4
+		RETURNING ` + receiptColumns
+	receipt := &models.PaymentReceipt{}
+	err := r.inTx(ctx, func(tx pgx.Tx) error {
+		err := pgxscan.Get(ctx, tx, receipt, query, models.ReceiptStatusApproved, time.Now(), id, models.ReceiptStatusPending)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errdefs.ErrAlreadyReviewed
+		}
+		if err != nil {
+			return handleError(err)
+		}
+
+		// receipts submitted before the ledger have no amount
+		amount := receipt.Amount()
+		if amount == nil || receipt.TutorID == nil || receipt.StudentID == nil {
+			return nil
+		}
+
+		_, err = createLedgerTransaction(ctx, tx, &models.LedgerTransactionCreateInput{
+			ID:          uuid.New(),
+			TutorID:     *receipt.TutorID,
+			StudentID:   *receipt.StudentID,
+			Kind:        models.LedgerKindReceiptPayment,
+			ReferenceID: receipt.ID,
+			Currency:    amount.Currency,
+			Postings: []models.LedgerPosting{
+				{Account: models.LedgerAccountExternal, AmountMinor: amount.Amount},
+				{Account: models.LedgerAccountStudent, AmountMinor: -amount.Amount},
+			},
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		_, err = createLedgerTransaction(ctx, tx, &models.LedgerTransactionCreateInput{
+			ID:          uuid.New(),
+			TutorID:     *receipt.TutorID,
+			StudentID:   *receipt.StudentID,
+			Kind:        models.LedgerKindLessonCharge,
+			ReferenceID: receipt.LessonID,
+			Currency:    amount.Currency,
+			Postings:    lessonChargePostings(amount.Amount),
+		}, nil)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return receipt, nil
+}
+
+// ListLessonCharges returns charge transactions of the given lessons.
+func (r *PaymentRepo) ListLessonCharges(ctx context.Context, lessonIDs []uuid.UUID) ([]*models.LedgerTransaction, error) {
+	query := `SELECT ` + ledgerTxColumns + ` FROM ledger_transactions WHERE kind = $1 AND reference_id = ANY($2)`
+	var charges []*models.LedgerTransaction
+	err := pgxscan.Select(ctx, r.db, &charges, query, models.LedgerKindLessonCharge, lessonIDs)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return charges, nil
+}
+
+// GetBalance returns the balance of the student with the tutor in every currency used by the pair
+// and the number of unused package lessons.
+func (r *PaymentRepo) GetBalance(ctx context.Context, tutorID uuid.UUID, studentID uuid.UUID) (*models.Balance, error) {
+	// the student account is credited by payments, so its balance is the opposite of the entries sum
+	balancesQuery := `
+		SELECT t.currency, -SUM(e.amount_minor)::bigint AS amount
+		FROM ledger_entries e
+		JOIN ledger_transactions t ON t.id = e.transaction_id
+		WHERE t.tutor_id = $1 AND t.student_id = $2 AND e.account = $3
+		GROUP BY t.currency
+		ORDER BY t.currency
+	`
+	lessonsQuery := `
+		SELECT COALESCE(SUM(lessons_total - lessons_used), 0)::integer FROM package_purchases
+		WHERE tutor_id = $1 AND student_id = $2
+	`
+
+	balance := &models.Balance{TutorID: tutorID, StudentID: studentID}
+	err := pgxscan.Select(ctx, r.db, &balance.Balances, balancesQuery, tutorID, studentID, models.LedgerAccountStudent)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	err = r.db.QueryRow(ctx, lessonsQuery, tutorID, studentID).Scan(&balance.PackageLessonsLeft)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return balance, nil
+}
+
+// ListLedgerEntries returns changes of the student balance with the tutor, newest first.
+func (r *PaymentRepo) ListLedgerEntries(ctx context.Context, tutorID uuid.UUID, studentID uuid.UUID, limit int) ([]*models.LedgerEntry, error) {
+	query := `
+		SELECT e.id, e.transaction_id, t.kind, t.reference_id, -e.amount_minor AS amount_minor, t.currency, t.created_at
+		FROM ledger_entries e
+		JOIN ledger_transactions t ON t.id = e.transaction_id
+		WHERE t.tutor_id = $1 AND t.student_id = $2 AND e.account = $3
+		ORDER BY t.created_at DESC
+		LIMIT $4
+	`
+	var entries []*models.LedgerEntry
+	err := pgxscan.Select(ctx, r.db, &entries, query, tutorID, studentID, models.LedgerAccountStudent, limit)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return entries, nil
+}
+
+// CreatePackage inserts a new package of the pair and returns it.
+func (r *PaymentRepo) CreatePackage(ctx context.Context, input *models.LessonPackageCreateInput) (*models.LessonPackage, error) {
+	query := `
+		INSERT INTO lesson_packages (id, tutor_id, student_id, name, lesson_count, price_minor, currency, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING ` + packageColumns
+	pkg := &models.LessonPackage{}
+	err := pgxscan.Get(ctx, r.db, pkg, query,
+		input.ID,
+		input.TutorID,
+		input.StudentID,
+		input.Name,
+		input.LessonCount,
+		input.PriceMinor,
+		input.Currency,
+		time.Now(),
+	)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return pkg, nil
+}
+
+// GetPackageByID retrieves a package by ID.
+func (r *PaymentRepo) GetPackageByID(ctx context.Context, id uuid.UUID) (*models.LessonPackage, error) {
+	query := `SELECT ` + packageColumns + ` FROM lesson_packages WHERE id = $1`
+	pkg := &models.LessonPackage{}
+	err := pgxscan.Get(ctx, r.db, pkg, query, id)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return pkg, nil
+}
+
+// ListPackages returns packages of the pair, newest first.
+func (r *PaymentRepo) ListPackages(ctx context.Context, tutorID uuid.UUID, studentID uuid.UUID) ([]*models.LessonPackage, error) {
+	query := `SELECT ` + packageColumns + ` FROM lesson_packages WHERE tutor_id = $1 AND student_id = $2 ORDER BY created_at DESC`
+	var packages []*models.LessonPackage
+	err := pgxscan.Select(ctx, r.db, &packages, query, tutorID, studentID)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return packages, nil
+}
+
+// CreatePackagePurchase records a paid package and credits its price to the student.
+func (r *PaymentRepo) CreatePackagePurchase(ctx context.Context, input *models.PackagePurchaseCreateInput) (*models.PackagePurchase, error) {
+	query := `
+		INSERT INTO package_purchases (id, package_id, tutor_id, student_id, lessons_total, lessons_used, price_minor, currency, created_at)
+		VALUES ($1, $2, $3, $4, $5, 0, $6, $7, $8)
+		RETURNING ` + purchaseColumns
+	purchase := &models.PackagePurchase{}
+	err := r.inTx(ctx, func(tx pgx.Tx) error {
+		err := pgxscan.Get(ctx, tx, purchase, query,
+			input.ID,
+			input.PackageID,
+			input.TutorID,
+			input.StudentID,
+			input.LessonsTotal,
+			input.PriceMinor,
+			input.Currency,
+			time.Now(),
+		)
+		if err != nil {
+			return handleError(err)
+		}
+
+		_, err = createLedgerTransaction(ctx, tx, &models.LedgerTransactionCreateInput{
+			ID:          uuid.New(),
+			TutorID:     input.TutorID,
+			StudentID:   input.StudentID,
+			Kind:        models.LedgerKindPackagePurchase,
+			ReferenceID: purchase.ID,
+			Currency:    input.Currency,
+			Postings: []models.LedgerPosting{
+				{Account: models.LedgerAccountExternal, AmountMinor: input.PriceMinor},
+				{Account: models.LedgerAccountStudent, AmountMinor: -input.PriceMinor},
+			},
+		}, nil)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return purchase, nil
+}
+
+// ConsumePackageLesson charges the lesson from the oldest package of the pair in the currency of the lesson price
+// that has lessons left and returns the package.
+// Returns ErrNotFound if there is no such package and ErrAlreadyExists if the lesson is already charged.
+func (r *PaymentRepo) ConsumePackageLesson(ctx context.Context, input *models.LessonChargeInput) (*models.PackagePurchase, error) {
+	selectQuery := `
+		SELECT ` + purchaseColumns + ` FROM package_purchases
+		WHERE tutor_id = $1 AND student_id = $2 AND currency = $3 AND lessons_used < lessons_total
+		ORDER BY created_at
+		LIMIT 1
+		FOR UPDATE
+	`
+	updateQuery := `
+		UPDATE package_purchases SET lessons_used = lessons_used + 1
+		WHERE id = $1
+		RETURNING ` + purchaseColumns
+
+	purchase := &models.PackagePurchase{}
+	err := r.inTx(ctx, func(tx pgx.Tx) error {
+		err := pgxscan.Get(ctx, tx, purchase, selectQuery, input.TutorID, input.StudentID, input.Price.Currency)
+		if err != nil {
+			return handleError(err)
+		}
+
+		amount := purchase.NextLessonPrice()
+		created, err := createLedgerTransaction(ctx, tx, &models.LedgerTransactionCreateInput{
+			ID:          input.ID,
+			TutorID:     input.TutorID,
+			StudentID:   input.StudentID,
+			Kind:        models.LedgerKindLessonCharge,
+			ReferenceID: input.LessonID,
+			Currency:    amount.Currency,
+			Postings:    lessonChargePostings(amount.Amount),
+		}, &purchase.ID)
+		if err != nil {
+			return err
+		}
+		if !created {
+			return errdefs.ErrAlreadyExists
+		}
+
+		if err := pgxscan.Get(ctx, tx, purchase, updateQuery, purchase.ID); err != nil {
+			return handleError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return purchase, nil
+}
+
+// lessonChargePostings moves the lesson price from the student to the tutor.
+func lessonChargePostings(amountMinor int64) []models.LedgerPosting {
+	return []models.LedgerPosting{
+		{Account: models.LedgerAccountStudent, AmountMinor: amountMinor},
+		{Account: models.LedgerAccountTutor, AmountMinor: -amountMinor},
+	}
+}
+
+func createLedgerTransaction(ctx context.Context, q Querier, input *models.LedgerTransactionCreateInput, packagePurchaseID *uuid.UUID) (bool, error) {
+	var sum int64
+	for _, posting := range input.Postings {
+		sum += posting.AmountMinor
+	}
+	if len(input.Postings) < 2 || sum != 0 {
+		return false, errUnbalancedTransaction
+	}
+
+	tag, err := q.Exec(ctx, `
+		INSERT INTO ledger_transactions (id, tutor_id, student_id, kind, reference_id, currency, package_purchase_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (kind, reference_id) DO NOTHING`,
+		input.ID,
+		input.TutorID,
+		input.StudentID,
+		input.Kind,
+		input.ReferenceID,
+		input.Currency,
+		packagePurchaseID,
+		time.Now(),
+	)
+	if err != nil {
+		return false, handleError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return false, nil
+	}
+
+	for _, posting := range input.Postings {
+		_, err := q.Exec(ctx, `INSERT INTO ledger_entries (id, transaction_id, account, amount_minor) VALUES ($1, $2, $3, $4)`,
+			uuid.New(), input.ID, posting.Account, posting.AmountMinor)
+		if err != nil {
+			return false, handleError(err)
+		}
+	}
+	return true, nil
+}
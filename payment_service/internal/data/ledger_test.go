@@ -0,0 +1,263 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	pgxmock "github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"common_library/money"
+	errdefs "paymentservice/internal/errors"
+	"paymentservice/internal/models"
+)
+
+var purchaseColumnNames = []string{"id", "package_id", "tutor_id", "student_id", "lessons_total", "lessons_used", "price_minor", "currency", "created_at"}
+
+func TestPaymentRepo_CreateLedgerTransaction(t *testing.T) {
+	input := &models.LedgerTransactionCreateInput{
+		ID:          uuid.New(),
+		TutorID:     uuid.New(),
+		StudentID:   uuid.New(),
+		Kind:        models.LedgerKindLessonCharge,
+		ReferenceID: uuid.New(),
+		Currency:    money.RUB,
+		Postings:    lessonChargePostings(150000),
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockPool, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mockPool.Close()
+
+		repo := NewPaymentRepository(mockPool)
+
+		mockPool.ExpectBegin()
+		mockPool.ExpectExec("INSERT INTO ledger_transactions").
+			WithArgs(input.ID, input.TutorID, input.StudentID, input.Kind, input.ReferenceID, money.RUB, (*uuid.UUID)(nil), AnyTime{}).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		mockPool.ExpectExec("INSERT INTO ledger_entries").
+			WithArgs(pgxmock.AnyArg(), input.ID, models.LedgerAccountStudent, int64(150000)).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		mockPool.ExpectExec("INSERT INTO ledger_entries").
+			WithArgs(pgxmock.AnyArg(), input.ID, models.LedgerAccountTutor, int64(-150000)).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		mockPool.ExpectCommit()
+
+		created, err := repo.CreateLedgerTransaction(context.Background(), input)
+		assert.NoError(t, err)
+		assert.True(t, created)
+		assert.NoError(t, mockPool.ExpectationsWereMet())
+	})
+
+	t.Run("AlreadyPosted", func(t *testing.T) {
+		mockPool, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mockPool.Close()
+
+		repo := NewPaymentRepository(mockPool)
+
+		mockPool.ExpectBegin()
+		mockPool.ExpectExec("INSERT INTO ledger_transactions").
+			WithArgs(input.ID, input.TutorID, input.StudentID, input.Kind, input.ReferenceID, money.RUB, (*uuid.UUID)(nil), AnyTime{}).
+			WillReturnResult(pgxmock.NewResult("INSERT", 0))
+		mockPool.ExpectCommit()
+
+		created, err := repo.CreateLedgerTransaction(context.Background(), input)
+		assert.NoError(t, err)
+		assert.False(t, created)
+		assert.NoError(t, mockPool.ExpectationsWereMet())
+	})
+
+	t.Run("Unbalanced", func(t *testing.T) {
+		mockPool, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mockPool.Close()
+
+		repo := NewPaymentRepository(mockPool)
+		unbalanced := *input
+		unbalanced.Postings = []models.LedgerPosting{
+			{Account: models.LedgerAccountStudent, AmountMinor: 150000},
+			{Account: models.LedgerAccountTutor, AmountMinor: -100000},
+		}
+
+		mockPool.ExpectBegin()
+		mockPool.ExpectRollback()
+
+		_, err = repo.CreateLedgerTransaction(context.Background(), &unbalanced)
+		assert.ErrorIs(t, err, errUnbalancedTransaction)
+		assert.NoError(t, mockPool.ExpectationsWereMet())
+	})
+}
+
+func TestPaymentRepo_ConsumePackageLesson(t *testing.T) {
+	input := &models.LessonChargeInput{
+		ID:        uuid.New(),
+		LessonID:  uuid.New(),
+		TutorID:   uuid.New(),
+		StudentID: uuid.New(),
+		Price:     money.New(150000, money.RUB),
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockPool, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mockPool.Close()
+
+		repo := NewPaymentRepository(mockPool)
+		purchaseID := uuid.New()
+		packageID := uuid.New()
+		now := time.Now()
+
+		mockPool.ExpectBegin()
+		mockPool.ExpectQuery("SELECT .* FROM package_purchases").
+			WithArgs(input.TutorID, input.StudentID, money.RUB).
+			WillReturnRows(pgxmock.NewRows(purchaseColumnNames).
+				AddRow(purchaseID, packageID, input.TutorID, input.StudentID, int32(3), int32(0), int64(10000), "RUB", now))
+		mockPool.ExpectExec("INSERT INTO ledger_transactions").
+			WithArgs(input.ID, input.TutorID, input.StudentID, models.LedgerKindLessonCharge, input.LessonID, money.RUB, &purchaseID, AnyTime{}).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		// 10000 / 3 is rounded so that the three lessons sum up to the package price
+		mockPool.ExpectExec("INSERT INTO ledger_entries").
+			WithArgs(pgxmock.AnyArg(), input.ID, models.LedgerAccountStudent, int64(3333)).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		mockPool.ExpectExec("INSERT INTO ledger_entries").
+			WithArgs(pgxmock.AnyArg(), input.ID, models.LedgerAccountTutor, int64(-3333)).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		mockPool.ExpectQuery("UPDATE package_purchases SET lessons_used").
+			WithArgs(purchaseID).
+			WillReturnRows(pgxmock.NewRows(purchaseColumnNames).
+				AddRow(purchaseID, packageID, input.TutorID, input.StudentID, int32(3), int32(1), int64(10000), "RUB", now))
+		mockPool.ExpectCommit()
+
+		purchase, err := repo.ConsumePackageLesson(context.Background(), input)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(1), purchase.LessonsUsed)
+		assert.NoError(t, mockPool.ExpectationsWereMet())
+	})
+
+	t.Run("NoPackage", func(t *testing.T) {
+		mockPool, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mockPool.Close()
+
+		repo := NewPaymentRepository(mockPool)
+
+		mockPool.ExpectBegin()
+		mockPool.ExpectQuery("SELECT .* FROM package_purchases").
+			WithArgs(input.TutorID, input.StudentID, money.RUB).
+			WillReturnRows(pgxmock.NewRows(purchaseColumnNames))
+		mockPool.ExpectRollback()
+
+		_, err = repo.ConsumePackageLesson(context.Background(), input)
+		assert.ErrorIs(t, err, errdefs.ErrNotFound)
+		assert.NoError(t, mockPool.ExpectationsWereMet())
+	})
+
+	t.Run("AlreadyCharged", func(t *testing.T) {
+		mockPool, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mockPool.Close()
+
+		repo := NewPaymentRepository(mockPool)
+
+		mockPool.ExpectBegin()
+		mockPool.ExpectQuery("SELECT .* FROM package_purchases").
+			WithArgs(input.TutorID, input.StudentID, money.RUB).
+			WillReturnRows(pgxmock.NewRows(purchaseColumnNames).
+				AddRow(uuid.New(), uuid.New(), input.TutorID, input.StudentID, int32(8), int32(2), int64(800000), "RUB", time.Now()))
+		mockPool.ExpectExec("INSERT INTO ledger_transactions").
+			WithArgs(input.ID, input.TutorID, input.StudentID, models.LedgerKindLessonCharge, input.LessonID, money.RUB, pgxmock.AnyArg(), AnyTime{}).
+			WillReturnResult(pgxmock.NewResult("INSERT", 0))
+		mockPool.ExpectRollback()
+
+		_, err = repo.ConsumePackageLesson(context.Background(), input)
+		assert.ErrorIs(t, err, errdefs.ErrAlreadyExists)
+		assert.NoError(t, mockPool.ExpectationsWereMet())
+	})
+}
+
+func TestPaymentRepo_ApproveReceipt(t *testing.T) {
+	mockPool, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockPool.Close()
+
+	repo := NewPaymentRepository(mockPool)
+	id := uuid.New()
+	lessonID := uuid.New()
+	tutorID := uuid.New()
+	studentID := uuid.New()
+	amount := int64(150000)
+	currency := money.RUB
+	now := time.Now()
+
+	mockPool.ExpectBegin()
+	mockPool.ExpectQuery("UPDATE receipts SET status").
+		WithArgs(models.ReceiptStatusApproved, AnyTime{}, id, models.ReceiptStatusPending).
+		WillReturnRows(pgxmock.NewRows(receiptColumnNames).
+			AddRow(id, lessonID, uuid.New(), "approved", nil, &tutorID, &studentID, &amount, &currency, now, now))
+	mockPool.ExpectExec("INSERT INTO ledger_transactions").
+		WithArgs(pgxmock.AnyArg(), tutorID, studentID, models.LedgerKindReceiptPayment, id, money.RUB, (*uuid.UUID)(nil), AnyTime{}).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mockPool.ExpectExec("INSERT INTO ledger_entries").
+		WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), models.LedgerAccountExternal, int64(150000)).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mockPool.ExpectExec("INSERT INTO ledger_entries").
+		WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), models.LedgerAccountStudent, int64(-150000)).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	// the lesson is already charged when it was completed
+	mockPool.ExpectExec("INSERT INTO ledger_transactions").
+		WithArgs(pgxmock.AnyArg(), tutorID, studentID, models.LedgerKindLessonCharge, lessonID, money.RUB, (*uuid.UUID)(nil), AnyTime{}).
+		WillReturnResult(pgxmock.NewResult("INSERT", 0))
+	mockPool.ExpectCommit()
+
+	receipt, err := repo.ApproveReceipt(context.Background(), id)
+	assert.NoError(t, err)
+	assert.Equal(t, models.ReceiptStatusApproved, receipt.Status)
+	assert.NoError(t, mockPool.ExpectationsWereMet())
+}
+
+func TestPaymentRepo_ApproveReceipt_AlreadyReviewed(t *testing.T) {
+	mockPool, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockPool.Close()
+
+	repo := NewPaymentRepository(mockPool)
+	id := uuid.New()
+
+	mockPool.ExpectBegin()
+	mockPool.ExpectQuery("UPDATE receipts SET status").
+		WithArgs(models.ReceiptStatusApproved, AnyTime{}, id, models.ReceiptStatusPending).
+		WillReturnRows(pgxmock.NewRows(receiptColumnNames))
+	mockPool.ExpectRollback()
+
+	_, err = repo.ApproveReceipt(context.Background(), id)
+	assert.ErrorIs(t, err, errdefs.ErrAlreadyReviewed)
+}
+
+func TestPaymentRepo_GetBalance(t *testing.T) {
+	mockPool, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockPool.Close()
+
+	repo := NewPaymentRepository(mockPool)
+	tutorID := uuid.New()
+	studentID := uuid.New()
+
+	mockPool.ExpectQuery("SELECT t.currency").
+		WithArgs(tutorID, studentID, models.LedgerAccountStudent).
+		WillReturnRows(pgxmock.NewRows([]string{"currency", "amount"}).
+			AddRow("EUR", int64(2000)).
+			AddRow("RUB", int64(-150000)))
+	mockPool.ExpectQuery("FROM package_purchases").
+		WithArgs(tutorID, studentID).
+		WillReturnRows(pgxmock.NewRows([]string{"coalesce"}).AddRow(int32(4)))
+
+	balance, err := repo.GetBalance(context.Background(), tutorID, studentID)
+	assert.NoError(t, err)
+	assert.Equal(t, []money.Money{money.New(2000, money.EUR), money.New(-150000, money.RUB)}, balance.Balances)
+	assert.Equal(t, int32(4), balance.PackageLessonsLeft)
+}
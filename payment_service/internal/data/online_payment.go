@@ -0,0 +1,140 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"paymentservice/internal/models"
+)
+
+const onlinePaymentColumns = `id, provider, provider_payment_id, lesson_id, tutor_id, student_id, amount_minor, currency, status, confirmation_url, created_at, edited_at`
+
+// CreateOnlinePayment inserts a new pending online payment and returns it.
+func (r *PaymentRepo) CreateOnlinePayment(ctx context.Context, input *models.OnlinePaymentCreateInput) (*models.OnlinePayment, error) {
+	query := `
+		INSERT INTO online_payments (id, provider, provider_payment_id, lesson_id, tutor_id, student_id, amount_minor, currency, status, confirmation_url, created_at, edited_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		RETURNING ` + onlinePaymentColumns
+	now := time.Now()
+	payment := &models.OnlinePayment{}
+	err := pgxscan.Get(ctx, r.db, payment, query,
+		input.ID,
+		input.Provider,
+		input.ProviderPaymentID,
+		input.LessonID,
+		input.TutorID,
+		input.StudentID,
+		input.AmountMinor,
+		input.Currency,
+		models.OnlinePaymentStatusPending,
+		input.ConfirmationURL,
+		now,
+		now,
+	)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return payment, nil
+}
+
+// GetPendingOnlinePayment returns the latest pending online payment of the lesson.
+func (r *PaymentRepo) GetPendingOnlinePayment(ctx context.Context, lessonID uuid.UUID) (*models.OnlinePayment, error) {
+	query := `
+		SELECT ` + onlinePaymentColumns + ` FROM online_payments
+		WHERE lesson_id = $1 AND status = $2
+		ORDER BY created_at DESC
+		LIMIT 1
+	`
+	payment := &models.OnlinePayment{}
+	err := pgxscan.Get(ctx, r.db, payment, query, lessonID, models.OnlinePaymentStatusPending)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return payment, nil
+}
+
+// GetOnlinePaymentByProviderID retrieves an online payment by the id the provider assigned to it.
+func (r *PaymentRepo) GetOnlinePaymentByProviderID(ctx context.Context, provider string, providerPaymentID string) (*models.OnlinePayment, error) {
+	query := `SELECT ` + onlinePaymentColumns + ` FROM online_payments WHERE provider = $1 AND provider_payment_id = $2`
+	payment := &models.OnlinePayment{}
+	err := pgxscan.Get(ctx, r.db, payment, query, provider, providerPaymentID)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return payment, nil
+}
+
+// CompleteOnlinePayment marks the pending payment as succeeded, credits its amount to the student and charges
+// the lesson unless it is already charged. Reports whether the payment was pending.
+func (r *PaymentRepo) CompleteOnlinePayment(ctx context.Context, id uuid.UUID) (bool, error) {
+	query := `
+		UPDATE online_payments SET status = $1, edited_at = $2
+		WHERE id = $3 AND status = $4
+		RETURNING ` + onlinePaymentColumns
+	var completed bool
+	err := r.inTx(ctx, func(tx pgx.Tx) error {
+		payment := &models.OnlinePayment{}
+		err := pgxscan.Get(ctx, tx, payment, query, models.OnlinePaymentStatusSucceeded, time.Now(), id, models.OnlinePaymentStatusPending)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return handleError(err)
+		}
+		completed = true
+		amount := payment.Amount()
+
+		_, err = createLedgerTransaction(ctx, tx, &models.LedgerTransactionCreateInput{
+			ID:          uuid.New(),
+			TutorID:     payment.TutorID,
+			StudentID:   payment.StudentID,
+			Kind:        models.LedgerKindOnlinePayment,
+			ReferenceID: payment.ID,
+			Currency:    amount.Currency,
+			Postings: []models.LedgerPosting{
+				{Account: models.LedgerAccountExternal, AmountMinor: amount.Amount},
+				{Account: models.LedgerAccountStudent, AmountMinor: -amount.Amount},
+			},
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		_, err = createLedgerTransaction(ctx, tx, &models.LedgerTransactionCreateInput{
+			ID:          uuid.New(),
+			TutorID:     payment.TutorID,
+			StudentID:   payment.StudentID,
+			Kind:        models.LedgerKindLessonCharge,
+			ReferenceID: payment.LessonID,
+			Currency:    amount.Currency,
+			Postings:    lessonChargePostings(amount.Amount),
+		}, nil)
+		return err
+	})
+	return completed, err
+}
+
+// RefundCanceledOnlinePayment marks the canceled payment as refunded after its money was returned at the provider.
+func (r *PaymentRepo) RefundCanceledOnlinePayment(ctx context.Context, id uuid.UUID) error {
+	query := `UPDATE online_payments SET status = $1, edited_at = $2 WHERE id = $3 AND status = $4`
+	_, err := r.db.Exec(ctx, query, models.OnlinePaymentStatusRefunded, time.Now(), id, models.OnlinePaymentStatusCanceled)
+	if err != nil {
+		return handleError(err)
+	}
+	return nil
+}
+
+// CancelOnlinePayment marks the pending payment as canceled. Payments that are not pending are left as is.
+func (r *PaymentRepo) CancelOnlinePayment(ctx context.Context, id uuid.UUID) error {
+	query := `UPDATE online_payments SET status = $1, edited_at = $2 WHERE id = $3 AND status = $4`
+	_, err := r.db.Exec(ctx, query, models.OnlinePaymentStatusCanceled, time.Now(), id, models.OnlinePaymentStatusPending)
+	if err != nil {
+		return handleError(err)
+	}
+	return nil
+}
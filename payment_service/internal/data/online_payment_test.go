@@ -0,0 +1,128 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	pgxmock "github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"common_library/money"
+	errdefs "paymentservice/internal/errors"
+	"paymentservice/internal/models"
+)
+
+var onlinePaymentColumnNames = []string{"id", "provider", "provider_payment_id", "lesson_id", "tutor_id", "student_id", "amount_minor", "currency", "status", "confirmation_url", "created_at", "edited_at"}
+
+func TestPaymentRepo_CreateOnlinePayment(t *testing.T) {
+	mockPool, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockPool.Close()
+
+	repo := NewPaymentRepository(mockPool)
+	input := &models.OnlinePaymentCreateInput{
+		ID:                uuid.New(),
+		Provider:          "yookassa",
+		ProviderPaymentID: "pay-1",
+		LessonID:          uuid.New(),
+		TutorID:           uuid.New(),
+		StudentID:         uuid.New(),
+		AmountMinor:       150000,
+		Currency:          money.RUB,
+		ConfirmationURL:   "https://pay/confirm",
+	}
+	now := time.Now()
+
+	mockPool.ExpectQuery("INSERT INTO online_payments").
+		WithArgs(input.ID, input.Provider, input.ProviderPaymentID, input.LessonID, input.TutorID, input.StudentID,
+			input.AmountMinor, input.Currency, models.OnlinePaymentStatusPending, input.ConfirmationURL, AnyTime{}, AnyTime{}).
+		WillReturnRows(pgxmock.NewRows(onlinePaymentColumnNames).
+			AddRow(input.ID, input.Provider, input.ProviderPaymentID, input.LessonID, input.TutorID, input.StudentID,
+				input.AmountMinor, "RUB", "pending", &input.ConfirmationURL, now, now))
+
+	payment, err := repo.CreateOnlinePayment(context.Background(), input)
+	assert.NoError(t, err)
+	assert.Equal(t, input.ID, payment.ID)
+	assert.Equal(t, models.OnlinePaymentStatusPending, payment.Status)
+	assert.Equal(t, &input.ConfirmationURL, payment.ConfirmationURL)
+	assert.NoError(t, mockPool.ExpectationsWereMet())
+}
+
+func TestPaymentRepo_GetOnlinePaymentByProviderID_NotFound(t *testing.T) {
+	mockPool, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockPool.Close()
+
+	repo := NewPaymentRepository(mockPool)
+
+	mockPool.ExpectQuery("SELECT .* FROM online_payments WHERE provider =").
+		WithArgs("yookassa", "pay-1").
+		WillReturnError(pgx.ErrNoRows)
+
+	_, err = repo.GetOnlinePaymentByProviderID(context.Background(), "yookassa", "pay-1")
+	assert.ErrorIs(t, err, errdefs.ErrNotFound)
+}
+
+func TestPaymentRepo_CompleteOnlinePayment(t *testing.T) {
+	id := uuid.New()
+	lessonID := uuid.New()
+	tutorID := uuid.New()
+	studentID := uuid.New()
+	now := time.Now()
+
+	t.Run("Pending", func(t *testing.T) {
+		mockPool, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mockPool.Close()
+
+		repo := NewPaymentRepository(mockPool)
+
+		mockPool.ExpectBegin()
+		mockPool.ExpectQuery("UPDATE online_payments SET status").
+			WithArgs(models.OnlinePaymentStatusSucceeded, AnyTime{}, id, models.OnlinePaymentStatusPending).
+			WillReturnRows(pgxmock.NewRows(onlinePaymentColumnNames).
+				AddRow(id, "yookassa", "pay-1", lessonID, tutorID, studentID, int64(150000), "RUB", "succeeded", (*string)(nil), now, now))
+		mockPool.ExpectExec("INSERT INTO ledger_transactions").
+			WithArgs(pgxmock.AnyArg(), tutorID, studentID, models.LedgerKindOnlinePayment, id, money.RUB, (*uuid.UUID)(nil), AnyTime{}).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		mockPool.ExpectExec("INSERT INTO ledger_entries").
+			WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), models.LedgerAccountExternal, int64(150000)).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		mockPool.ExpectExec("INSERT INTO ledger_entries").
+			WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), models.LedgerAccountStudent, int64(-150000)).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		// the lesson is already charged when it was completed
+		mockPool.ExpectExec("INSERT INTO ledger_transactions").
+			WithArgs(pgxmock.AnyArg(), tutorID, studentID, models.LedgerKindLessonCharge, lessonID, money.RUB, (*uuid.UUID)(nil), AnyTime{}).
+			WillReturnResult(pgxmock.NewResult("INSERT", 0))
+		mockPool.ExpectCommit()
+
+		completed, err := repo.CompleteOnlinePayment(context.Background(), id)
+		assert.NoError(t, err)
+		assert.True(t, completed)
+		assert.NoError(t, mockPool.ExpectationsWereMet())
+	})
+
+	t.Run("AlreadyCompleted", func(t *testing.T) {
+		mockPool, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mockPool.Close()
+
+		repo := NewPaymentRepository(mockPool)
+
+		mockPool.ExpectBegin()
+		mockPool.ExpectQuery("UPDATE online_payments SET status").
+			WithArgs(models.OnlinePaymentStatusSucceeded, AnyTime{}, id, models.OnlinePaymentStatusPending).
+			WillReturnRows(pgxmock.NewRows(onlinePaymentColumnNames))
+		mockPool.ExpectCommit()
+
+		completed, err := repo.CompleteOnlinePayment(context.Background(), id)
+		assert.NoError(t, err)
+		assert.False(t, completed)
+		assert.NoError(t, mockPool.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,161 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"paymentservice/internal/models"
+)
+
+const refundColumns = `id, lesson_id, tutor_id, student_id, kind, amount_minor, currency, reason, receipt_id, online_payment_id, package_purchase_id, provider_refund_id, created_at`
+
+// GetLessonCharge returns the charge transaction of the lesson with the amount charged from the student.
+func (r *PaymentRepo) GetLessonCharge(ctx context.Context, lessonID uuid.UUID) (*models.LessonCharge, error) {
+	query := `
+		SELECT ` + ledgerTxColumns + `,
+			(SELECT e.amount_minor FROM ledger_entries e WHERE e.transaction_id = t.id AND e.account = $3) AS amount_minor
+		FROM ledger_transactions t
+		WHERE kind = $1 AND reference_id = $2
+	`
+	charge := &models.LessonCharge{}
+	err := pgxscan.Get(ctx, r.db, charge, query, models.LedgerKindLessonCharge, lessonID, models.LedgerAccountStudent)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return charge, nil
+}
+
+// GetSucceededOnlinePayment returns the succeeded online payment of the lesson.
+func (r *PaymentRepo) GetSucceededOnlinePayment(ctx context.Context, lessonID uuid.UUID) (*models.OnlinePayment, error) {
+	query := `SELECT ` + onlinePaymentColumns + ` FROM online_payments WHERE lesson_id = $1 AND status = $2`
+	payment := &models.OnlinePayment{}
+	err := pgxscan.Get(ctx, r.db, payment, query, lessonID, models.OnlinePaymentStatusSucceeded)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return payment, nil
+}
+
+// CreateRefund records the refund of the lesson and reverses its charge: the amount is credited back to
+// the student and, for a refund, paid out of the system. A lesson paid from a package is returned to the package.
+// The receipt or online payment of the lesson is marked as refunded.
+// Returns ErrAlreadyExists if the lesson is already refunded.
+func (r *PaymentRepo) CreateRefund(ctx context.Context, input *models.RefundCreateInput) (*models.Refund, error) {
+	query := `
+		INSERT INTO refunds (id, lesson_id, tutor_id, student_id, kind, amount_minor, currency, reason, receipt_id, online_payment_id, package_purchase_id, provider_refund_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		RETURNING ` + refundColumns
+	refund := &models.Refund{}
+	err := r.inTx(ctx, func(tx pgx.Tx) error {
+		err := pgxscan.Get(ctx, tx, refund, query,
+			input.ID,
+			input.LessonID,
+			input.TutorID,
+			input.StudentID,
+			input.Kind,
+			input.Amount.Amount,
+			input.Amount.Currency,
+			input.Reason,
+			input.ReceiptID,
+			input.OnlinePaymentID,
+			input.PackagePurchaseID,
+			input.ProviderRefundID,
+			time.Now(),
+		)
+		if err != nil {
+			return handleError(err)
+		}
+
+		_, err = createLedgerTransaction(ctx, tx, &models.LedgerTransactionCreateInput{
+			ID:          uuid.New(),
+			TutorID:     input.TutorID,
+			StudentID:   input.StudentID,
+			Kind:        models.LedgerKindCreditNote,
+			ReferenceID: refund.ID,
+			Currency:    input.Amount.Currency,
+			Postings: []models.LedgerPosting{
+				{Account: models.LedgerAccountTutor, AmountMinor: input.Amount.Amount},
+				{Account: models.LedgerAccountStudent, AmountMinor: -input.Amount.Amount},
+			},
+		}, input.PackagePurchaseID)
+		if err != nil {
+			return err
+		}
+
+		if input.Kind == models.RefundKindRefund {
+			_, err = createLedgerTransaction(ctx, tx, &models.LedgerTransactionCreateInput{
+				ID:          uuid.New(),
+				TutorID:     input.TutorID,
+				StudentID:   input.StudentID,
+				Kind:        models.LedgerKindRefund,
+				ReferenceID: refund.ID,
+				Currency:    input.Amount.Currency,
+				Postings: []models.LedgerPosting{
+					{Account: models.LedgerAccountStudent, AmountMinor: input.Amount.Amount},
+					{Account: models.LedgerAccountExternal, AmountMinor: -input.Amount.Amount},
+				},
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+
+		if input.PackagePurchaseID != nil {
+			_, err := tx.Exec(ctx, `UPDATE package_purchases SET lessons_used = lessons_used - 1 WHERE id = $1 AND lessons_used > 0`,
+				*input.PackagePurchaseID)
+			if err != nil {
+				return handleError(err)
+			}
+		}
+		if input.ReceiptID != nil {
+			_, err := tx.Exec(ctx, `UPDATE receipts SET status = $1, edited_at = $2 WHERE id = $3 AND status = $4`,
+				models.ReceiptStatusRefunded, time.Now(), *input.ReceiptID, models.ReceiptStatusApproved)
+			if err != nil {
+				return handleError(err)
+			}
+		}
+		if input.OnlinePaymentID != nil {
+			_, err := tx.Exec(ctx, `UPDATE online_payments SET status = $1, edited_at = $2 WHERE id = $3 AND status = $4`,
+				models.OnlinePaymentStatusRefunded, time.Now(), *input.OnlinePaymentID, models.OnlinePaymentStatusSucceeded)
+			if err != nil {
+				return handleError(err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return refund, nil
+}
+
+// GetRefundByLessonID retrieves the refund of the lesson.
+func (r *PaymentRepo) GetRefundByLessonID(ctx context.Context, lessonID uuid.UUID) (*models.Refund, error) {
+	query := `SELECT ` + refundColumns + ` FROM refunds WHERE lesson_id = $1`
+	refund := &models.Refund{}
+	err := pgxscan.Get(ctx, r.db, refund, query, lessonID)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return refund, nil
+}
+
+// ListRefunds returns refunds matching the filter, newest first. Empty filter fields are ignored.
+func (r *PaymentRepo) ListRefunds(ctx context.Context, filter *models.RefundFilter) ([]*models.Refund, error) {
+	query := `
+		SELECT ` + refundColumns + ` FROM refunds
+		WHERE ($1::uuid IS NULL OR tutor_id = $1)
+		  AND ($2::uuid IS NULL OR student_id = $2)
+		ORDER BY created_at DESC
+	`
+	var refunds []*models.Refund
+	err := pgxscan.Select(ctx, r.db, &refunds, query, filter.TutorID, filter.StudentID)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return refunds, nil
+}
@@ -0,0 +1,174 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	pgxmock "github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"common_library/money"
+	errdefs "paymentservice/internal/errors"
+	"paymentservice/internal/models"
+)
+
+var refundColumnNames = []string{"id", "lesson_id", "tutor_id", "student_id", "kind", "amount_minor", "currency", "reason", "receipt_id", "online_payment_id", "package_purchase_id", "provider_refund_id", "created_at"}
+
+func TestPaymentRepo_GetLessonCharge(t *testing.T) {
+	mockPool, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockPool.Close()
+
+	repo := NewPaymentRepository(mockPool)
+	lessonID := uuid.New()
+	purchaseID := uuid.New()
+	txID := uuid.New()
+
+	mockPool.ExpectQuery("SELECT .* FROM ledger_transactions t").
+		WithArgs(models.LedgerKindLessonCharge, lessonID, models.LedgerAccountStudent).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "tutor_id", "student_id", "kind", "reference_id", "currency", "package_purchase_id", "created_at", "amount_minor"}).
+			AddRow(txID, uuid.New(), uuid.New(), "lesson_charge", lessonID, "RUB", &purchaseID, time.Now(), int64(125000)))
+
+	charge, err := repo.GetLessonCharge(context.Background(), lessonID)
+	require.NoError(t, err)
+	assert.Equal(t, txID, charge.ID)
+	assert.Equal(t, &purchaseID, charge.PackagePurchaseID)
+	assert.Equal(t, money.New(125000, money.RUB), charge.Amount())
+	assert.NoError(t, mockPool.ExpectationsWereMet())
+}
+
+func TestPaymentRepo_CreateRefund(t *testing.T) {
+	lessonID := uuid.New()
+	tutorID := uuid.New()
+	studentID := uuid.New()
+	now := time.Now()
+
+	t.Run("Refund", func(t *testing.T) {
+		mockPool, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mockPool.Close()
+
+		repo := NewPaymentRepository(mockPool)
+		paymentID := uuid.New()
+		providerRefundID := "refund-1"
+		input := &models.RefundCreateInput{
+			ID:               uuid.New(),
+			LessonID:         lessonID,
+			TutorID:          tutorID,
+			StudentID:        studentID,
+			Kind:             models.RefundKindRefund,
+			Amount:           money.New(150000, money.RUB),
+			OnlinePaymentID:  &paymentID,
+			ProviderRefundID: &providerRefundID,
+		}
+
+		mockPool.ExpectBegin()
+		mockPool.ExpectQuery("INSERT INTO refunds").
+			WithArgs(input.ID, lessonID, tutorID, studentID, models.RefundKindRefund, int64(150000), money.RUB, (*string)(nil),
+				(*uuid.UUID)(nil), &paymentID, (*uuid.UUID)(nil), &providerRefundID, AnyTime{}).
+			WillReturnRows(pgxmock.NewRows(refundColumnNames).
+				AddRow(input.ID, lessonID, tutorID, studentID, "refund", int64(150000), "RUB", (*string)(nil),
+					(*uuid.UUID)(nil), &paymentID, (*uuid.UUID)(nil), &providerRefundID, now))
+		mockPool.ExpectExec("INSERT INTO ledger_transactions").
+			WithArgs(pgxmock.AnyArg(), tutorID, studentID, models.LedgerKindCreditNote, input.ID, money.RUB, (*uuid.UUID)(nil), AnyTime{}).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		mockPool.ExpectExec("INSERT INTO ledger_entries").
+			WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), models.LedgerAccountTutor, int64(150000)).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		mockPool.ExpectExec("INSERT INTO ledger_entries").
+			WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), models.LedgerAccountStudent, int64(-150000)).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		mockPool.ExpectExec("INSERT INTO ledger_transactions").
+			WithArgs(pgxmock.AnyArg(), tutorID, studentID, models.LedgerKindRefund, input.ID, money.RUB, (*uuid.UUID)(nil), AnyTime{}).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		mockPool.ExpectExec("INSERT INTO ledger_entries").
+			WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), models.LedgerAccountStudent, int64(150000)).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		mockPool.ExpectExec("INSERT INTO ledger_entries").
+			WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), models.LedgerAccountExternal, int64(-150000)).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		mockPool.ExpectExec("UPDATE online_payments SET status").
+			WithArgs(models.OnlinePaymentStatusRefunded, AnyTime{}, paymentID, models.OnlinePaymentStatusSucceeded).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+		mockPool.ExpectCommit()
+
+		refund, err := repo.CreateRefund(context.Background(), input)
+		require.NoError(t, err)
+		assert.Equal(t, input.ID, refund.ID)
+		assert.Equal(t, money.New(150000, money.RUB), refund.Amount())
+		assert.NoError(t, mockPool.ExpectationsWereMet())
+	})
+
+	t.Run("CreditNoteFromPackage", func(t *testing.T) {
+		mockPool, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mockPool.Close()
+
+		repo := NewPaymentRepository(mockPool)
+		purchaseID := uuid.New()
+		input := &models.RefundCreateInput{
+			ID:                uuid.New(),
+			LessonID:          lessonID,
+			TutorID:           tutorID,
+			StudentID:         studentID,
+			Kind:              models.RefundKindCreditNote,
+			Amount:            money.New(100000, money.RUB),
+			PackagePurchaseID: &purchaseID,
+		}
+
+		mockPool.ExpectBegin()
+		mockPool.ExpectQuery("INSERT INTO refunds").
+			WithArgs(input.ID, lessonID, tutorID, studentID, models.RefundKindCreditNote, int64(100000), money.RUB, (*string)(nil),
+				(*uuid.UUID)(nil), (*uuid.UUID)(nil), &purchaseID, (*string)(nil), AnyTime{}).
+			WillReturnRows(pgxmock.NewRows(refundColumnNames).
+				AddRow(input.ID, lessonID, tutorID, studentID, "credit_note", int64(100000), "RUB", (*string)(nil),
+					(*uuid.UUID)(nil), (*uuid.UUID)(nil), &purchaseID, (*string)(nil), now))
+		mockPool.ExpectExec("INSERT INTO ledger_transactions").
+			WithArgs(pgxmock.AnyArg(), tutorID, studentID, models.LedgerKindCreditNote, input.ID, money.RUB, &purchaseID, AnyTime{}).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		mockPool.ExpectExec("INSERT INTO ledger_entries").
+			WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), models.LedgerAccountTutor, int64(100000)).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		mockPool.ExpectExec("INSERT INTO ledger_entries").
+			WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), models.LedgerAccountStudent, int64(-100000)).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		mockPool.ExpectExec("UPDATE package_purchases SET lessons_used = lessons_used - 1").
+			WithArgs(purchaseID).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+		mockPool.ExpectCommit()
+
+		_, err = repo.CreateRefund(context.Background(), input)
+		require.NoError(t, err)
+		assert.NoError(t, mockPool.ExpectationsWereMet())
+	})
+
+	t.Run("AlreadyRefunded", func(t *testing.T) {
+		mockPool, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mockPool.Close()
+
+		repo := NewPaymentRepository(mockPool)
+
+		mockPool.ExpectBegin()
+		mockPool.ExpectQuery("INSERT INTO refunds").
+			WithArgs(pgxmock.AnyArg(), lessonID, tutorID, studentID, models.RefundKindCreditNote, int64(100000), money.RUB, (*string)(nil),
+				(*uuid.UUID)(nil), (*uuid.UUID)(nil), (*uuid.UUID)(nil), (*string)(nil), AnyTime{}).
+			WillReturnError(&pgconn.PgError{Code: "23505"})
+		mockPool.ExpectRollback()
+
+		_, err = repo.CreateRefund(context.Background(), &models.RefundCreateInput{
+			ID:        uuid.New(),
+			LessonID:  lessonID,
+			TutorID:   tutorID,
+			StudentID: studentID,
+			Kind:      models.RefundKindCreditNote,
+			Amount:    money.New(100000, money.RUB),
+		})
+		assert.ErrorIs(t, err, errdefs.ErrAlreadyExists)
+		assert.NoError(t, mockPool.ExpectationsWereMet())
+	})
+}
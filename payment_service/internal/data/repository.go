@@ -20,9 +20,10 @@ type Querier interface {
 	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
 	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
 	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	Begin(ctx context.Context) (pgx.Tx, error)
 }
 
-// PaymentRepo stores receipts.
+// PaymentRepo stores receipts, approval sagas and the ledger.
 type PaymentRepo struct {
 	db Querier
 }
@@ -32,20 +33,25 @@ func NewPaymentRepository(db Querier) *PaymentRepo {
 	return &PaymentRepo{db: db}
 }
 
-// CreateReceipt inserts a new receipt and returns it.
+const receiptColumns = `id, lesson_id, file_id, status, rejection_reason, tutor_id, student_id, amount_minor, currency, created_at, edited_at`
+
+// CreateReceipt inserts a new pending receipt and returns it.
 func (r *PaymentRepo) CreateReceipt(ctx context.Context, input *models.PaymentReceiptCreateInput) (*models.PaymentReceipt, error) {
 	query := `
-		INSERT INTO receipts (id, lesson_id, file_id, is_verified, created_at, edited_at)
-		VALUES ($1, $2, $3, $4, $5, $6)
-		RETURNING id, lesson_id, file_id, is_verified, created_at, edited_at
-	`
+		INSERT INTO receipts (id, lesson_id, file_id, status, tutor_id, student_id, amount_minor, currency, created_at, edited_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING ` + receiptColumns
 	now := time.Now()
 	pr := &models.PaymentReceipt{}
 	err := pgxscan.Get(ctx, r.db, pr, query,
 		input.ID,
 		input.LessonID,
 		input.FileID,
-		input.IsVerified,
+		models.ReceiptStatusPending,
+		input.TutorID,
+		input.StudentID,
+		input.Amount.Amount,
+		input.Amount.Currency,
 		now,
 		now,
 	)
@@ -57,7 +63,7 @@ func (r *PaymentRepo) CreateReceipt(ctx context.Context, input *models.PaymentRe
 
 // GetReceiptByID retrieves a receipt by ID.
 func (r *PaymentRepo) GetReceiptByID(ctx context.Context, id uuid.UUID) (*models.PaymentReceipt, error) {
-	query := `SELECT id, lesson_id, file_id, is_verified, created_at, edited_at FROM receipts WHERE id = $1`
+	query := `SELECT ` + receiptColumns + ` FROM receipts WHERE id = $1`
 	pr := &models.PaymentReceipt{}
 	err := pgxscan.Get(ctx, r.db, pr, query, id)
 	if err != nil {
@@ -69,15 +75,22 @@ func (r *PaymentRepo) GetReceiptByID(ctx context.Context, id uuid.UUID) (*models
 	return pr, nil
 }
 
-// UpdateReceipt updates verification and returns receipt.
-func (r *PaymentRepo) UpdateReceipt(ctx context.Context, id uuid.UUID, isVerified bool) (*models.PaymentReceipt, error) {
-	query := `UPDATE receipts SET is_verified = $1, edited_at = $2 WHERE id = $3`
-	now := time.Now()
-	_, err := r.db.Exec(ctx, query, isVerified, now, id)
+// ReviewReceipt moves a pending receipt to the given status and returns it.
+// Returns ErrAlreadyReviewed if the receipt is not pending anymore.
+func (r *PaymentRepo) ReviewReceipt(ctx context.Context, id uuid.UUID, input *models.PaymentReceiptReviewInput) (*models.PaymentReceipt, error) {
+	query := `
+		UPDATE receipts SET status = $1, rejection_reason = $2, edited_at = $3
+		WHERE id = $4 AND status = $5
+		RETURNING ` + receiptColumns
+	pr := &models.PaymentReceipt{}
+	err := pgxscan.Get(ctx, r.db, pr, query, input.Status, input.RejectionReason, time.Now(), id, models.ReceiptStatusPending)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errdefs.ErrAlreadyReviewed
+		}
 		return nil, handleError(err)
 	}
-	return r.GetReceiptByID(ctx, id)
+	return pr, nil
 }
 
 // ExistsByID checks existence by ID.
@@ -91,9 +104,9 @@ func (r *PaymentRepo) ExistsByID(ctx context.Context, id uuid.UUID) (bool, error
 	return exists, nil
 }
 
-// GetReceiptByLessonID retrieves a receipt by lesson ID.
+// GetReceiptByLessonID retrieves the latest receipt of the lesson.
 func (r *PaymentRepo) GetReceiptByLessonID(ctx context.Context, lessonID uuid.UUID) (*models.PaymentReceipt, error) {
-	query := `SELECT id, lesson_id, file_id, is_verified, created_at, edited_at FROM receipts WHERE lesson_id = $1`
+	query := `SELECT ` + receiptColumns + ` FROM receipts WHERE lesson_id = $1 ORDER BY created_at DESC LIMIT 1`
 	pr := &models.PaymentReceipt{}
 	err := pgxscan.Get(ctx, r.db, pr, query, lessonID)
 	if err != nil {
@@ -104,3 +117,59 @@ func (r *PaymentRepo) GetReceiptByLessonID(ctx context.Context, lessonID uuid.UU
 	}
 	return pr, nil
 }
+
+// ListReceipts returns receipts matching the filter, newest first. Empty filter fields are ignored.
+func (r *PaymentRepo) ListReceipts(ctx context.Context, filter *models.ReceiptFilter) ([]*models.PaymentReceipt, error) {
+	query := `
+		SELECT ` + receiptColumns + ` FROM receipts
+		WHERE ($1::uuid IS NULL OR tutor_id = $1)
+		  AND ($2::uuid IS NULL OR student_id = $2)
+		  AND ($3::text IS NULL OR status = $3)
+		ORDER BY created_at DESC
+	`
+	var receipts []*models.PaymentReceipt
+	err := pgxscan.Select(ctx, r.db, &receipts, query, filter.TutorID, filter.StudentID, filter.Status)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return receipts, nil
+}
+
+// ListReceiptsWithoutPair returns receipts created before the review workflow, which have no tutor and student.
+func (r *PaymentRepo) ListReceiptsWithoutPair(ctx context.Context) ([]*models.PaymentReceipt, error) {
+	query := `SELECT ` + receiptColumns + ` FROM receipts WHERE tutor_id IS NULL ORDER BY created_at`
+	var receipts []*models.PaymentReceipt
+	err := pgxscan.Select(ctx, r.db, &receipts, query)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return receipts, nil
+}
+
+// SetReceiptPair sets the tutor and the student of a receipt that has none.
+func (r *PaymentRepo) SetReceiptPair(ctx context.Context, id uuid.UUID, tutorID uuid.UUID, studentID uuid.UUID) error {
+	query := `UPDATE receipts SET tutor_id = $1, student_id = $2 WHERE id = $3 AND tutor_id IS NULL`
+	_, err := r.db.Exec(ctx, query, tutorID, studentID, id)
+	if err != nil {
+		return handleError(err)
+	}
+	return nil
+}
+
+// inTx runs fn in a transaction that is committed if fn succeeds.
+func (r *PaymentRepo) inTx(ctx context.Context, fn func(tx pgx.Tx) error) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return handleError(err)
+	}
+	// no-op after commit
+	defer tx.Rollback(ctx)
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return handleError(err)
+	}
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"common_library/money"
 	"github.com/jackc/pgx/v5"
 	errdefs "paymentservice/internal/errors"
 	"paymentservice/internal/models"
@@ -22,6 +23,8 @@ func (a AnyTime) Match(v interface{}) bool {
 	return ok
 }
 
+var receiptColumnNames = []string{"id", "lesson_id", "file_id", "status", "rejection_reason", "tutor_id", "student_id", "amount_minor", "currency", "created_at", "edited_at"}
+
 func TestPaymentRepo_CreateReceipt(t *testing.T) {
 	// arrange
 	mockPool, err := pgxmock.NewPool() // Используем пул вместо Conn
@@ -34,17 +37,23 @@ func TestPaymentRepo_CreateReceipt(t *testing.T) {
 	id := uuid.New()
 	lessonID := uuid.New()
 	fileID := uuid.New()
+	tutorID := uuid.New()
+	studentID := uuid.New()
+	amount := int64(150000)
+	currency := money.RUB
 
 	mockPool.ExpectQuery("INSERT INTO receipts").
-		WithArgs(id, lessonID, fileID, true, AnyTime{}, AnyTime{}).
-		WillReturnRows(pgxmock.NewRows([]string{"id", "lesson_id", "file_id", "is_verified", "created_at", "edited_at"}).
-			AddRow(id, lessonID, fileID, true, now, now))
+		WithArgs(id, lessonID, fileID, models.ReceiptStatusPending, tutorID, studentID, int64(150000), money.RUB, AnyTime{}, AnyTime{}).
+		WillReturnRows(pgxmock.NewRows(receiptColumnNames).
+			AddRow(id, lessonID, fileID, "pending", nil, &tutorID, &studentID, &amount, &currency, now, now))
 
 	input := &models.PaymentReceiptCreateInput{
-		ID:         id,
-		LessonID:   lessonID,
-		FileID:     fileID,
-		IsVerified: true,
+		ID:        id,
+		LessonID:  lessonID,
+		FileID:    fileID,
+		TutorID:   tutorID,
+		StudentID: studentID,
+		Amount:    money.New(150000, money.RUB),
 	}
 
 	// act
@@ -53,6 +62,10 @@ func TestPaymentRepo_CreateReceipt(t *testing.T) {
 	// assert
 	assert.NoError(t, err)
 	assert.Equal(t, id, res.ID)
+	assert.Equal(t, models.ReceiptStatusPending, res.Status)
+	assert.Equal(t, &tutorID, res.TutorID)
+	assert.Equal(t, &amount, res.AmountMinor)
+	assert.Equal(t, &currency, res.Currency)
 }
 
 func TestPaymentRepo_GetReceiptByID_NotFound(t *testing.T) {
@@ -106,3 +119,103 @@ func TestPaymentRepo_GetReceiptByLessonID_NotFound(t *testing.T) {
 	_, err = repo.GetReceiptByLessonID(ctx, lessonID)
 	assert.ErrorIs(t, err, errdefs.ErrNotFound)
 }
+
+func TestPaymentRepo_ReviewReceipt(t *testing.T) {
+	mockPool, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockPool.Close()
+
+	repo := NewPaymentRepository(mockPool)
+	ctx := context.Background()
+	id := uuid.New()
+	reason := "wrong amount"
+	now := time.Now()
+
+	mockPool.ExpectQuery("UPDATE receipts SET status").
+		WithArgs(models.ReceiptStatusRejected, &reason, AnyTime{}, id, models.ReceiptStatusPending).
+		WillReturnRows(pgxmock.NewRows(receiptColumnNames).
+			AddRow(id, uuid.New(), uuid.New(), "rejected", &reason, nil, nil, nil, nil, now, now))
+
+	res, err := repo.ReviewReceipt(ctx, id, &models.PaymentReceiptReviewInput{
+		Status:          models.ReceiptStatusRejected,
+		RejectionReason: &reason,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, models.ReceiptStatusRejected, res.Status)
+	assert.Equal(t, reason, *res.RejectionReason)
+}
+
+func TestPaymentRepo_ReviewReceipt_AlreadyReviewed(t *testing.T) {
+	mockPool, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockPool.Close()
+
+	repo := NewPaymentRepository(mockPool)
+	ctx := context.Background()
+	id := uuid.New()
+
+	mockPool.ExpectQuery("UPDATE receipts SET status").
+		WithArgs(models.ReceiptStatusApproved, (*string)(nil), AnyTime{}, id, models.ReceiptStatusPending).
+		WillReturnError(pgx.ErrNoRows)
+
+	_, err = repo.ReviewReceipt(ctx, id, &models.PaymentReceiptReviewInput{Status: models.ReceiptStatusApproved})
+	assert.ErrorIs(t, err, errdefs.ErrAlreadyReviewed)
+}
+
+func TestPaymentRepo_ListReceipts(t *testing.T) {
+	mockPool, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockPool.Close()
+
+	repo := NewPaymentRepository(mockPool)
+	ctx := context.Background()
+	tutorID := uuid.New()
+	pending := models.ReceiptStatusPending
+	now := time.Now()
+
+	mockPool.ExpectQuery("SELECT .* FROM receipts").
+		WithArgs(&tutorID, (*uuid.UUID)(nil), &pending).
+		WillReturnRows(pgxmock.NewRows(receiptColumnNames).
+			AddRow(uuid.New(), uuid.New(), uuid.New(), "pending", nil, &tutorID, nil, nil, nil, now, now).
+			AddRow(uuid.New(), uuid.New(), uuid.New(), "pending", nil, &tutorID, nil, nil, nil, now, now))
+
+	res, err := repo.ListReceipts(ctx, &models.ReceiptFilter{TutorID: &tutorID, Status: &pending})
+	assert.NoError(t, err)
+	assert.Len(t, res, 2)
+	assert.NoError(t, mockPool.ExpectationsWereMet())
+}
+
+func TestPaymentRepo_ListReceiptsWithoutPair(t *testing.T) {
+	mockPool, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockPool.Close()
+
+	now := time.Now()
+	mockPool.ExpectQuery("SELECT .* FROM receipts WHERE tutor_id IS NULL").
+		WillReturnRows(pgxmock.NewRows(receiptColumnNames).
+			AddRow(uuid.New(), uuid.New(), uuid.New(), "approved", nil, nil, nil, nil, nil, now, now))
+
+	res, err := NewPaymentRepository(mockPool).ListReceiptsWithoutPair(context.Background())
+	assert.NoError(t, err)
+	require.Len(t, res, 1)
+	assert.Nil(t, res[0].TutorID)
+	assert.NoError(t, mockPool.ExpectationsWereMet())
+}
+
+func TestPaymentRepo_SetReceiptPair(t *testing.T) {
+	mockPool, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockPool.Close()
+
+	id := uuid.New()
+	tutorID := uuid.New()
+	studentID := uuid.New()
+	// a receipt that already has a pair is left as it is
+	mockPool.ExpectExec("UPDATE receipts SET tutor_id = \\$1, student_id = \\$2 WHERE id = \\$3 AND tutor_id IS NULL").
+		WithArgs(tutorID, studentID, id).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+	err = NewPaymentRepository(mockPool).SetReceiptPair(context.Background(), id, tutorID, studentID)
+	assert.NoError(t, err)
+	assert.NoError(t, mockPool.ExpectationsWereMet())
+}
@@ -0,0 +1,70 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+
+	"paymentservice/internal/models"
+)
+
+const sagaColumns = `id, receipt_id, lesson_id, state, attempts, last_error, created_at, updated_at`
+
+// CreateApprovalSaga inserts a new saga in the started state.
+// Returns ErrAlreadyExists if another approval of the receipt is in progress.
+func (r *PaymentRepo) CreateApprovalSaga(ctx context.Context, input *models.ApprovalSagaCreateInput) (*models.ApprovalSaga, error) {
+	query := `
+		INSERT INTO receipt_approval_sagas (id, receipt_id, lesson_id, state, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING ` + sagaColumns
+	now := time.Now()
+	saga := &models.ApprovalSaga{}
+	err := pgxscan.Get(ctx, r.db, saga, query, input.ID, input.ReceiptID, input.LessonID, models.SagaStateStarted, now, now)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return saga, nil
+}
+
+// UpdateApprovalSagaState moves the saga to the given state and returns it.
+func (r *PaymentRepo) UpdateApprovalSagaState(ctx context.Context, id uuid.UUID, state models.SagaState, lastError *string) (*models.ApprovalSaga, error) {
+	query := `
+		UPDATE receipt_approval_sagas SET state = $1, last_error = COALESCE($2, last_error), updated_at = $3
+		WHERE id = $4
+		RETURNING ` + sagaColumns
+	saga := &models.ApprovalSaga{}
+	err := pgxscan.Get(ctx, r.db, saga, query, state, lastError, time.Now(), id)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return saga, nil
+}
+
+// ClaimStaleApprovalSagas returns unfinished sagas that were not updated since staleBefore and increments their attempts.
+// Claimed sagas are touched, so concurrent callers do not get them until they become stale again.
+func (r *PaymentRepo) ClaimStaleApprovalSagas(ctx context.Context, staleBefore time.Time, limit int) ([]*models.ApprovalSaga, error) {
+	query := `
+		UPDATE receipt_approval_sagas SET attempts = attempts + 1, updated_at = $1
+		WHERE id IN (
+			SELECT id FROM receipt_approval_sagas
+			WHERE state NOT IN ($2, $3) AND updated_at < $4
+			ORDER BY updated_at
+			LIMIT $5
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + sagaColumns
+	var sagas []*models.ApprovalSaga
+	err := pgxscan.Select(ctx, r.db, &sagas, query,
+		time.Now(),
+		models.SagaStateCompleted,
+		models.SagaStateCompensated,
+		staleBefore,
+		limit,
+	)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return sagas, nil
+}
@@ -0,0 +1,76 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	pgxmock "github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errdefs "paymentservice/internal/errors"
+	"paymentservice/internal/models"
+)
+
+var sagaColumnNames = []string{"id", "receipt_id", "lesson_id", "state", "attempts", "last_error", "created_at", "updated_at"}
+
+func TestPaymentRepo_CreateApprovalSaga_AlreadyInProgress(t *testing.T) {
+	mockPool, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockPool.Close()
+
+	repo := NewPaymentRepository(mockPool)
+	input := &models.ApprovalSagaCreateInput{ID: uuid.New(), ReceiptID: uuid.New(), LessonID: uuid.New()}
+
+	mockPool.ExpectQuery("INSERT INTO receipt_approval_sagas").
+		WithArgs(input.ID, input.ReceiptID, input.LessonID, models.SagaStateStarted, AnyTime{}, AnyTime{}).
+		WillReturnError(&pgconn.PgError{Code: "23505"})
+
+	_, err = repo.CreateApprovalSaga(context.Background(), input)
+	assert.ErrorIs(t, err, errdefs.ErrAlreadyExists)
+}
+
+func TestPaymentRepo_UpdateApprovalSagaState(t *testing.T) {
+	mockPool, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockPool.Close()
+
+	repo := NewPaymentRepository(mockPool)
+	id := uuid.New()
+	lastError := "schedule is unavailable"
+	now := time.Now()
+
+	mockPool.ExpectQuery("UPDATE receipt_approval_sagas SET state").
+		WithArgs(models.SagaStateCompensating, &lastError, AnyTime{}, id).
+		WillReturnRows(pgxmock.NewRows(sagaColumnNames).
+			AddRow(id, uuid.New(), uuid.New(), "compensating", 0, &lastError, now, now))
+
+	saga, err := repo.UpdateApprovalSagaState(context.Background(), id, models.SagaStateCompensating, &lastError)
+	assert.NoError(t, err)
+	assert.Equal(t, models.SagaStateCompensating, saga.State)
+}
+
+func TestPaymentRepo_ClaimStaleApprovalSagas(t *testing.T) {
+	mockPool, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockPool.Close()
+
+	repo := NewPaymentRepository(mockPool)
+	staleBefore := time.Now().Add(-time.Minute)
+	now := time.Now()
+
+	mockPool.ExpectQuery("UPDATE receipt_approval_sagas SET attempts = attempts \\+ 1").
+		WithArgs(AnyTime{}, models.SagaStateCompleted, models.SagaStateCompensated, staleBefore, 10).
+		WillReturnRows(pgxmock.NewRows(sagaColumnNames).
+			AddRow(uuid.New(), uuid.New(), uuid.New(), "lesson_paid", 2, nil, now, now))
+
+	sagas, err := repo.ClaimStaleApprovalSagas(context.Background(), staleBefore, 10)
+	assert.NoError(t, err)
+	require.Len(t, sagas, 1)
+	assert.Equal(t, models.SagaStateLessonPaid, sagas[0].State)
+	assert.Equal(t, 2, sagas[0].Attempts)
+	assert.NoError(t, mockPool.ExpectationsWereMet())
+}
@@ -8,4 +8,11 @@ var (
 	ErrNotFound         = errors.New("not found")
 	ErrInvalidPayment   = errors.New("invalid payment")
 	ErrAlreadyExists    = errors.New("user already exists")
+	ErrAlreadyReviewed  = errors.New("receipt is already reviewed")
+	ErrReviewInProgress = errors.New("receipt review is in progress")
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	ErrNoProvider       = errors.New("payment provider is not configured")
+	ErrNotRefundable    = errors.New("lesson cannot be refunded")
+	ErrNoNotifier       = errors.New("notifier is not configured")
+	ErrNoPrice          = errors.New("lesson has no price")
 )
@@ -1,12 +1,14 @@
 package handler
 
 import (
+	"common_library/money"
 	"context"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	errdefs "paymentservice/internal/errors"
 	"paymentservice/internal/mocks"
 	"paymentservice/internal/models"
@@ -24,17 +26,19 @@ func TestGetPaymentInfo_Success(t *testing.T) {
 	h := &PaymentServiceServer{service: mockSvc}
 	ctx := context.Background()
 	input := &models.GetPaymentInfoInput{LessonId: lessonID}
+	price := money.New(100000, money.RUB)
 	response := &models.PaymentInfo{
 		LessonID:       lessonID,
-		PriceRUB:       1000,
+		Price:          &price,
 		PaymentDetails: "test payment details",
 	}
 	mockSvc.EXPECT().GetPaymentInfo(ctx, input).Return(response, nil)
 	lid := lessonID.String()
 	res, err := h.GetPaymentInfo(ctx, &pb.GetPaymentInfoRequest{LessonId: &lid})
 	assert.NoError(t, err)
-	assert.Equal(t, lessonID.String(), *res.LessonId)         // Разыменовываем указатель
-	assert.Equal(t, int32(1000), *res.PriceRub)               // Разыменовываем указатель
+	assert.Equal(t, lessonID.String(), *res.LessonId) // Разыменовываем указатель
+	assert.Equal(t, int64(100000), res.Price.AmountMinor)
+	assert.Equal(t, "RUB", res.Price.Currency)
 	assert.Equal(t, "test payment details", *res.PaymentInfo) // Разыменовываем указатель
 }
 
@@ -80,12 +84,12 @@ func TestSubmitPaymentReceipt_Success(t *testing.T) {
 	ctx := context.Background()
 	input := &models.SubmitPaymentReceiptInput{LessonId: lessonID, FileId: fileID}
 	response := &models.PaymentReceipt{
-		ID:         uuid.New(),
-		LessonID:   lessonID,
-		FileID:     fileID,
-		IsVerified: false,
-		CreatedAt:  createdAt,
-		EditedAt:   editedAt,
+		ID:        uuid.New(),
+		LessonID:  lessonID,
+		FileID:    fileID,
+		Status:    models.ReceiptStatusPending,
+		CreatedAt: createdAt,
+		EditedAt:  editedAt,
 	}
 	mockSvc.EXPECT().SubmitPaymentReceipt(ctx, input).Return(response, nil)
 	lID := lessonID.String()
@@ -178,12 +182,12 @@ func TestGetReceipt_Success(t *testing.T) {
 	ctx := context.Background()
 	input := &models.GetReceiptInput{ReceiptId: receiptID}
 	response := &models.PaymentReceipt{
-		ID:         receiptID,
-		LessonID:   lessonID,
-		FileID:     fileID,
-		IsVerified: true,
-		CreatedAt:  createdAt,
-		EditedAt:   editedAt,
+		ID:        receiptID,
+		LessonID:  lessonID,
+		FileID:    fileID,
+		Status:    models.ReceiptStatusApproved,
+		CreatedAt: createdAt,
+		EditedAt:  editedAt,
 	}
 	mockSvc.EXPECT().GetReceipt(ctx, input).Return(response, nil)
 	res, err := h.GetReceipt(ctx, &pb.GetReceiptRequest{ReceiptId: receiptID.String()})
@@ -192,6 +196,7 @@ func TestGetReceipt_Success(t *testing.T) {
 	assert.Equal(t, lessonID.String(), *res.LessonId)
 	assert.Equal(t, fileID.String(), *res.FileId)
 	assert.Equal(t, true, res.IsVerified)
+	assert.Equal(t, "approved", res.Status)
 	assert.Equal(t, createdAt, res.CreatedAt.AsTime().Truncate(time.Second))
 	assert.Equal(t, editedAt, res.EditedAt.AsTime().Truncate(time.Second))
 }
@@ -250,22 +255,23 @@ func TestVerifyReceipt_Success(t *testing.T) {
 	mockSvc := mocks.NewMockPaymentService(ctrl)
 	h := &PaymentServiceServer{service: mockSvc}
 	ctx := context.Background()
-	input := &models.VerifyReceiptInput{ReceiptId: receiptID}
+	input := &models.ReviewReceiptInput{ReceiptId: receiptID}
 	response := &models.PaymentReceipt{
-		ID:         receiptID,
-		LessonID:   lessonID,
-		FileID:     fileID,
-		IsVerified: true,
-		CreatedAt:  createdAt,
-		EditedAt:   editedAt,
+		ID:        receiptID,
+		LessonID:  lessonID,
+		FileID:    fileID,
+		Status:    models.ReceiptStatusApproved,
+		CreatedAt: createdAt,
+		EditedAt:  editedAt,
 	}
-	mockSvc.EXPECT().VerifyReceipt(ctx, input).Return(response, nil)
+	mockSvc.EXPECT().ApproveReceipt(ctx, input).Return(response, nil)
 	res, err := h.VerifyReceipt(ctx, &pb.VerifyReceiptRequest{ReceiptId: receiptID.String()})
 	assert.NoError(t, err)
 	assert.Equal(t, receiptID.String(), res.Id)
 	assert.Equal(t, lessonID.String(), *res.LessonId)
 	assert.Equal(t, fileID.String(), *res.FileId)
 	assert.Equal(t, true, res.IsVerified)
+	assert.Equal(t, "approved", res.Status)
 	assert.Equal(t, createdAt, res.CreatedAt.AsTime().Truncate(time.Second))
 	assert.Equal(t, editedAt, res.EditedAt.AsTime().Truncate(time.Second))
 }
@@ -278,8 +284,8 @@ func TestVerifyReceipt_NotFound(t *testing.T) {
 	mockSvc := mocks.NewMockPaymentService(ctrl)
 	h := &PaymentServiceServer{service: mockSvc}
 	ctx := context.Background()
-	input := &models.VerifyReceiptInput{ReceiptId: receiptID}
-	mockSvc.EXPECT().VerifyReceipt(ctx, input).Return(nil, errdefs.ErrNotFound)
+	input := &models.ReviewReceiptInput{ReceiptId: receiptID}
+	mockSvc.EXPECT().ApproveReceipt(ctx, input).Return(nil, errdefs.ErrNotFound)
 	_, err := h.VerifyReceipt(ctx, &pb.VerifyReceiptRequest{ReceiptId: receiptID.String()})
 	assert.Error(t, err)
 	assert.Equal(t, codes.NotFound, status.Code(err))
@@ -293,8 +299,8 @@ func TestVerifyReceipt_PermissionDenied(t *testing.T) {
 	mockSvc := mocks.NewMockPaymentService(ctrl)
 	h := &PaymentServiceServer{service: mockSvc}
 	ctx := context.Background()
-	input := &models.VerifyReceiptInput{ReceiptId: receiptID}
-	mockSvc.EXPECT().VerifyReceipt(ctx, input).Return(nil, errdefs.ErrPermissionDenied)
+	input := &models.ReviewReceiptInput{ReceiptId: receiptID}
+	mockSvc.EXPECT().ApproveReceipt(ctx, input).Return(nil, errdefs.ErrPermissionDenied)
 	_, err := h.VerifyReceipt(ctx, &pb.VerifyReceiptRequest{ReceiptId: receiptID.String()})
 	assert.Error(t, err)
 	assert.Equal(t, codes.PermissionDenied, status.Code(err))
@@ -312,6 +318,80 @@ func TestVerifyReceipt_InvalidReceiptID(t *testing.T) {
 	assert.Equal(t, codes.InvalidArgument, status.Code(err))
 }
 
+func TestRejectReceipt_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	receiptID := uuid.New()
+	reason := "wrong amount"
+	mockSvc := mocks.NewMockPaymentService(ctrl)
+	h := &PaymentServiceServer{service: mockSvc}
+	ctx := context.Background()
+	input := &models.RejectReceiptInput{ReceiptId: receiptID, Reason: reason}
+	response := &models.PaymentReceipt{
+		ID:              receiptID,
+		Status:          models.ReceiptStatusRejected,
+		RejectionReason: &reason,
+	}
+	mockSvc.EXPECT().RejectReceipt(ctx, input).Return(response, nil)
+	res, err := h.RejectReceipt(ctx, &pb.RejectReceiptRequest{ReceiptId: receiptID.String(), Reason: reason})
+	assert.NoError(t, err)
+	assert.Equal(t, "rejected", res.Status)
+	assert.Equal(t, reason, res.GetRejectionReason())
+	assert.Equal(t, false, res.IsVerified)
+}
+
+func TestRejectReceipt_AlreadyReviewed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	receiptID := uuid.New()
+	mockSvc := mocks.NewMockPaymentService(ctrl)
+	h := &PaymentServiceServer{service: mockSvc}
+	ctx := context.Background()
+	input := &models.RejectReceiptInput{ReceiptId: receiptID, Reason: "reason"}
+	mockSvc.EXPECT().RejectReceipt(ctx, input).Return(nil, errdefs.ErrAlreadyReviewed)
+	_, err := h.RejectReceipt(ctx, &pb.RejectReceiptRequest{ReceiptId: receiptID.String(), Reason: "reason"})
+	assert.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestListReceipts_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tutorID := uuid.New()
+	pending := models.ReceiptStatusPending
+	mockSvc := mocks.NewMockPaymentService(ctrl)
+	h := &PaymentServiceServer{service: mockSvc}
+	ctx := context.Background()
+	input := &models.ListReceiptsInput{TutorId: &tutorID, Status: &pending}
+	response := []*models.PaymentReceipt{
+		{ID: uuid.New(), TutorID: &tutorID, Status: pending},
+		{ID: uuid.New(), TutorID: &tutorID, Status: pending},
+	}
+	mockSvc.EXPECT().ListReceipts(ctx, input).Return(response, nil)
+	tID := tutorID.String()
+	receiptStatus := "pending"
+	res, err := h.ListReceipts(ctx, &pb.ListReceiptsRequest{TutorId: &tID, Status: &receiptStatus})
+	assert.NoError(t, err)
+	assert.Len(t, res.Receipts, 2)
+	assert.Equal(t, tID, res.Receipts[0].GetTutorId())
+}
+
+func TestListReceipts_InvalidTutorID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSvc := mocks.NewMockPaymentService(ctrl)
+	h := &PaymentServiceServer{service: mockSvc}
+	ctx := context.Background()
+	tID := "invalid-uuid"
+	_, err := h.ListReceipts(ctx, &pb.ListReceiptsRequest{TutorId: &tID})
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
 func TestGetReceiptFile_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -368,3 +448,286 @@ func TestGetReceiptFile_InvalidReceiptID(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, codes.InvalidArgument, status.Code(err))
 }
+
+func TestGetBalance_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tutorID := uuid.New()
+	studentID := uuid.New()
+	mockSvc := mocks.NewMockPaymentService(ctrl)
+	h := &PaymentServiceServer{service: mockSvc}
+	ctx := context.Background()
+	input := &models.GetBalanceInput{TutorId: tutorID, StudentId: studentID}
+	response := &models.Balance{TutorID: tutorID, StudentID: studentID, Balances: []money.Money{money.New(450000, money.RUB)}, PackageLessonsLeft: 3}
+	mockSvc.EXPECT().GetBalance(ctx, input).Return(response, nil)
+	res, err := h.GetBalance(ctx, &pb.GetBalanceRequest{TutorId: tutorID.String(), StudentId: studentID.String()})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(450000), res.Balances[0].AmountMinor)
+	assert.Equal(t, int32(3), res.PackageLessonsLeft)
+}
+
+func TestGetBalance_PermissionDenied(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSvc := mocks.NewMockPaymentService(ctrl)
+	h := &PaymentServiceServer{service: mockSvc}
+	ctx := context.Background()
+	mockSvc.EXPECT().GetBalance(ctx, gomock.Any()).Return(nil, errdefs.ErrPermissionDenied)
+	_, err := h.GetBalance(ctx, &pb.GetBalanceRequest{TutorId: uuid.New().String(), StudentId: uuid.New().String()})
+	assert.Error(t, err)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestListLedgerEntries_InvalidStudentID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSvc := mocks.NewMockPaymentService(ctrl)
+	h := &PaymentServiceServer{service: mockSvc}
+	ctx := context.Background()
+	_, err := h.ListLedgerEntries(ctx, &pb.ListLedgerEntriesRequest{TutorId: uuid.New().String(), StudentId: "invalid-uuid"})
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestCreatePackage_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	studentID := uuid.New()
+	mockSvc := mocks.NewMockPaymentService(ctrl)
+	h := &PaymentServiceServer{service: mockSvc}
+	ctx := context.Background()
+	input := &models.CreatePackageInput{StudentId: studentID, Name: "8 lessons", LessonCount: 8, Price: money.New(1200000, money.RUB)}
+	response := &models.LessonPackage{ID: uuid.New(), TutorID: uuid.New(), StudentID: studentID, Name: "8 lessons", LessonCount: 8, PriceMinor: 1200000, Currency: money.RUB, CreatedAt: time.Now()}
+	mockSvc.EXPECT().CreatePackage(ctx, input).Return(response, nil)
+	res, err := h.CreatePackage(ctx, &pb.CreatePackageRequest{
+		StudentId:   studentID.String(),
+		Name:        "8 lessons",
+		LessonCount: 8,
+		Price:       &pb.Money{AmountMinor: 1200000, Currency: "rub"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, response.ID.String(), res.Id)
+	assert.Equal(t, int32(8), res.LessonCount)
+	assert.Equal(t, "RUB", res.Price.Currency)
+}
+
+func TestCreatePackage_UnknownCurrency(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSvc := mocks.NewMockPaymentService(ctrl)
+	h := &PaymentServiceServer{service: mockSvc}
+	_, err := h.CreatePackage(context.Background(), &pb.CreatePackageRequest{
+		StudentId:   uuid.NewString(),
+		Name:        "8 lessons",
+		LessonCount: 8,
+		Price:       &pb.Money{AmountMinor: 1200000, Currency: "XXX"},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestPurchasePackage_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	packageID := uuid.New()
+	mockSvc := mocks.NewMockPaymentService(ctrl)
+	h := &PaymentServiceServer{service: mockSvc}
+	ctx := context.Background()
+	mockSvc.EXPECT().PurchasePackage(ctx, &models.PurchasePackageInput{PackageId: packageID}).Return(nil, errdefs.ErrNotFound)
+	_, err := h.PurchasePackage(ctx, &pb.PurchasePackageRequest{PackageId: packageID.String()})
+	assert.Error(t, err)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestCreateInvoice_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	studentID := uuid.New()
+	fileID := uuid.New()
+	periodStart := time.Date(2026, time.September, 1, 0, 0, 0, 0, time.UTC)
+	periodEnd := periodStart.AddDate(0, 1, 0)
+	mockSvc := mocks.NewMockPaymentService(ctrl)
+	h := &PaymentServiceServer{service: mockSvc}
+	ctx := context.Background()
+	input := &models.CreateInvoiceInput{StudentId: studentID, PeriodStart: periodStart, PeriodEnd: periodEnd}
+	response := &models.Invoice{ID: uuid.New(), StudentID: studentID, Number: 4, PeriodStart: periodStart, PeriodEnd: periodEnd, TotalMinor: 450000, Currency: money.RUB, FileID: &fileID}
+	mockSvc.EXPECT().CreateInvoice(ctx, input).Return(response, nil)
+	res, err := h.CreateInvoice(ctx, &pb.CreateInvoiceRequest{
+		StudentId:   studentID.String(),
+		PeriodStart: timestamppb.New(periodStart),
+		PeriodEnd:   timestamppb.New(periodEnd),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(4), res.Number)
+	assert.Equal(t, &pb.Money{AmountMinor: 450000, Currency: "RUB"}, res.Total)
+	assert.Equal(t, fileID.String(), res.GetFileId())
+}
+
+func TestCreateInvoice_MissingPeriod(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSvc := mocks.NewMockPaymentService(ctrl)
+	h := &PaymentServiceServer{service: mockSvc}
+	_, err := h.CreateInvoice(context.Background(), &pb.CreateInvoiceRequest{StudentId: uuid.NewString()})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetInvoiceFile_PermissionDenied(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	invoiceID := uuid.New()
+	mockSvc := mocks.NewMockPaymentService(ctrl)
+	h := &PaymentServiceServer{service: mockSvc}
+	ctx := context.Background()
+	mockSvc.EXPECT().GetInvoiceFile(ctx, &models.GetInvoiceFileInput{InvoiceId: invoiceID}).Return(nil, errdefs.ErrPermissionDenied)
+	_, err := h.GetInvoiceFile(ctx, &pb.GetInvoiceFileRequest{InvoiceId: invoiceID.String()})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestGetEarningsReport_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	periodStart := time.Date(2026, time.September, 1, 0, 0, 0, 0, time.UTC)
+	periodEnd := periodStart.AddDate(0, 1, 0)
+	avgDays := 1.5
+	mockSvc := mocks.NewMockPaymentService(ctrl)
+	h := &PaymentServiceServer{service: mockSvc}
+	ctx := context.Background()
+	summary := models.EarningsSummary{
+		LessonsCount: 2,
+		ByCurrency: []models.CurrencyEarnings{
+			{Currency: money.RUB, LessonsCount: 2, EarnedMinor: 300000, PaidMinor: 150000, OutstandingMinor: 150000},
+		},
+		AvgDaysToPay: &avgDays,
+	}
+	mockSvc.EXPECT().GetEarningsReport(ctx, &models.GetEarningsReportInput{PeriodStart: periodStart, PeriodEnd: periodEnd}).
+		Return(&models.EarningsReport{
+			PeriodStart: periodStart,
+			PeriodEnd:   periodEnd,
+			Total:       summary,
+			Months:      []models.MonthlyEarnings{{MonthStart: periodStart, EarningsSummary: summary}},
+			Students:    []models.StudentEarnings{{StudentID: uuid.New(), StudentName: "Student", EarningsSummary: summary}},
+		}, nil)
+	res, err := h.GetEarningsReport(ctx, &pb.GetEarningsReportRequest{
+		PeriodStart: timestamppb.New(periodStart),
+		PeriodEnd:   timestamppb.New(periodEnd),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "RUB", res.Total.ByCurrency[0].Currency)
+	assert.Equal(t, int64(300000), res.Total.ByCurrency[0].EarnedMinor)
+	assert.Equal(t, 1.5, res.Total.GetAvgDaysToPay())
+	assert.Len(t, res.Months, 1)
+	assert.Equal(t, "Student", res.Students[0].StudentName)
+}
+
+func TestGetOutstandingReport_PermissionDenied(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSvc := mocks.NewMockPaymentService(ctrl)
+	h := &PaymentServiceServer{service: mockSvc}
+	ctx := context.Background()
+	mockSvc.EXPECT().GetOutstandingReport(ctx).Return(nil, errdefs.ErrPermissionDenied)
+	_, err := h.GetOutstandingReport(ctx, &pb.GetOutstandingReportRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestCreateOnlinePayment_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	lessonID := uuid.New()
+	confirmationURL := "https://pay/confirm"
+	mockSvc := mocks.NewMockPaymentService(ctrl)
+	h := &PaymentServiceServer{service: mockSvc}
+	ctx := context.Background()
+	input := &models.CreateOnlinePaymentInput{LessonId: lessonID, ReturnUrl: "https://app/return"}
+	response := &models.OnlinePayment{ID: uuid.New(), LessonID: lessonID, Provider: "yookassa", Status: models.OnlinePaymentStatusPending, AmountMinor: 150000, Currency: money.RUB, ConfirmationURL: &confirmationURL}
+	mockSvc.EXPECT().CreateOnlinePayment(ctx, input).Return(response, nil)
+	res, err := h.CreateOnlinePayment(ctx, &pb.CreateOnlinePaymentRequest{LessonId: lessonID.String(), ReturnUrl: "https://app/return"})
+	assert.NoError(t, err)
+	assert.Equal(t, "pending", res.Status)
+	assert.Equal(t, confirmationURL, res.GetConfirmationUrl())
+}
+
+func TestCreateOnlinePayment_NoProvider(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSvc := mocks.NewMockPaymentService(ctrl)
+	h := &PaymentServiceServer{service: mockSvc}
+	mockSvc.EXPECT().CreateOnlinePayment(gomock.Any(), gomock.Any()).Return(nil, errdefs.ErrNoProvider)
+	_, err := h.CreateOnlinePayment(context.Background(), &pb.CreateOnlinePaymentRequest{LessonId: uuid.NewString()})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+func TestHandleProviderWebhook_InvalidSignature(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSvc := mocks.NewMockPaymentService(ctrl)
+	h := &PaymentServiceServer{service: mockSvc}
+	ctx := context.Background()
+	input := &models.ProviderWebhook{
+		Provider: "yookassa",
+		Body:     []byte("{}"),
+		Headers:  map[string]string{"x-webhook-signature": "sha256=00"},
+	}
+	mockSvc.EXPECT().HandleProviderWebhook(ctx, input).Return(errdefs.ErrInvalidSignature)
+	_, err := h.HandleProviderWebhook(ctx, &pb.ProviderWebhookRequest{
+		Provider: "yookassa",
+		Body:     []byte("{}"),
+		Headers:  map[string]string{"X-Webhook-Signature": "sha256=00"},
+	})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestHandleProviderWebhook_InvalidPayment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSvc := mocks.NewMockPaymentService(ctrl)
+	h := &PaymentServiceServer{service: mockSvc}
+	mockSvc.EXPECT().HandleProviderWebhook(gomock.Any(), gomock.Any()).Return(errdefs.ErrInvalidPayment)
+	_, err := h.HandleProviderWebhook(context.Background(), &pb.ProviderWebhookRequest{Provider: "yookassa", Body: []byte("{}")})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestRefundLesson_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	lessonID := uuid.New()
+	receiptID := uuid.New()
+	mockSvc := mocks.NewMockPaymentService(ctrl)
+	h := &PaymentServiceServer{service: mockSvc}
+	ctx := context.Background()
+	input := &models.RefundLessonInput{LessonId: lessonID, Kind: models.RefundKindCreditNote}
+	response := &models.Refund{ID: uuid.New(), LessonID: lessonID, Kind: models.RefundKindCreditNote, AmountMinor: 150000, Currency: money.RUB, ReceiptID: &receiptID}
+	mockSvc.EXPECT().RefundLesson(ctx, input).Return(response, nil)
+	res, err := h.RefundLesson(ctx, &pb.RefundLessonRequest{LessonId: lessonID.String(), Kind: "credit_note"})
+	assert.NoError(t, err)
+	assert.Equal(t, "credit_note", res.Kind)
+	assert.Equal(t, receiptID.String(), res.GetReceiptId())
+	assert.Equal(t, int64(150000), res.Amount.AmountMinor)
+}
+
+func TestRefundLesson_NotRefundable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSvc := mocks.NewMockPaymentService(ctrl)
+	h := &PaymentServiceServer{service: mockSvc}
+	mockSvc.EXPECT().RefundLesson(gomock.Any(), gomock.Any()).Return(nil, errdefs.ErrNotRefundable)
+	_, err := h.RefundLesson(context.Background(), &pb.RefundLessonRequest{LessonId: uuid.NewString(), Kind: "refund"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
@@ -4,6 +4,7 @@ package handler
 
 import (
 	"common_library/logging"
+	"common_library/money"
 	"context"
 	"errors"
 	"github.com/google/uuid"
@@ -21,8 +22,25 @@ type PaymentService interface {
 	GetPaymentInfo(ctx context.Context, input *models.GetPaymentInfoInput) (*models.PaymentInfo, error)
 	SubmitPaymentReceipt(ctx context.Context, input *models.SubmitPaymentReceiptInput) (*models.PaymentReceipt, error)
 	GetReceipt(ctx context.Context, input *models.GetReceiptInput) (*models.PaymentReceipt, error)
-	VerifyReceipt(ctx context.Context, input *models.VerifyReceiptInput) (*models.PaymentReceipt, error)
+	ApproveReceipt(ctx context.Context, input *models.ReviewReceiptInput) (*models.PaymentReceipt, error)
+	RejectReceipt(ctx context.Context, input *models.RejectReceiptInput) (*models.PaymentReceipt, error)
+	ListReceipts(ctx context.Context, input *models.ListReceiptsInput) ([]*models.PaymentReceipt, error)
 	GetReceiptFile(ctx context.Context, input *models.GetReceiptFileInput) (*models.ReceiptFileUrl, error)
+	GetBalance(ctx context.Context, input *models.GetBalanceInput) (*models.Balance, error)
+	ListLedgerEntries(ctx context.Context, input *models.ListLedgerEntriesInput) ([]*models.LedgerEntry, error)
+	CreatePackage(ctx context.Context, input *models.CreatePackageInput) (*models.LessonPackage, error)
+	ListPackages(ctx context.Context, input *models.ListPackagesInput) ([]*models.LessonPackage, error)
+	PurchasePackage(ctx context.Context, input *models.PurchasePackageInput) (*models.PackagePurchase, error)
+	CreateInvoice(ctx context.Context, input *models.CreateInvoiceInput) (*models.Invoice, error)
+	ListInvoices(ctx context.Context, input *models.ListInvoicesInput) ([]*models.Invoice, error)
+	GetInvoiceFile(ctx context.Context, input *models.GetInvoiceFileInput) (*models.InvoiceFileUrl, error)
+	GetEarningsReport(ctx context.Context, input *models.GetEarningsReportInput) (*models.EarningsReport, error)
+	GetOutstandingReport(ctx context.Context) (*models.OutstandingReport, error)
+	CreateOnlinePayment(ctx context.Context, input *models.CreateOnlinePaymentInput) (*models.OnlinePayment, error)
+	HandleProviderWebhook(ctx context.Context, input *models.ProviderWebhook) error
+	RefundLesson(ctx context.Context, input *models.RefundLessonInput) (*models.Refund, error)
+	ListRefunds(ctx context.Context, input *models.ListRefundsInput) ([]*models.Refund, error)
+	ExportUserData(ctx context.Context, userID uuid.UUID) (*models.UserDataExport, error)
 }
 
 type PaymentServiceServer struct {
@@ -34,7 +52,7 @@ func NewPaymentServiceServer(paymentService PaymentService) *PaymentServiceServe
 	return &PaymentServiceServer{service: paymentService}
 }
 func (h *PaymentServiceServer) GetPaymentInfo(ctx context.Context, req *pb.GetPaymentInfoRequest) (*pb.PaymentInfo, error) {
-	lessonID, err := uuid.Parse(*req.LessonId)
+	lessonID, err := uuid.Parse(req.GetLessonId())
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid LessonId: %v", err)
 	}
@@ -48,19 +66,22 @@ func (h *PaymentServiceServer) GetPaymentInfo(ctx context.Context, req *pb.GetPa
 		return nil, status.Errorf(codes.Internal, "internal server error: %v", err)
 	}
 
-	return &pb.PaymentInfo{
+	pbPaymentInfo := &pb.PaymentInfo{
 		LessonId:    req.LessonId,
-		PriceRub:    &paymentInfo.PriceRUB,
 		PaymentInfo: &paymentInfo.PaymentDetails,
-	}, nil
+	}
+	if paymentInfo.Price != nil {
+		pbPaymentInfo.Price = toPbMoney(*paymentInfo.Price)
+	}
+	return pbPaymentInfo, nil
 }
 
 func (h *PaymentServiceServer) SubmitPaymentReceipt(ctx context.Context, req *pb.SubmitPaymentReceiptRequest) (*pb.Receipt, error) {
-	lessonID, err := uuid.Parse(*req.LessonId)
+	lessonID, err := uuid.Parse(req.GetLessonId())
 	if err != nil {
 		return nil, status.New(codes.InvalidArgument, "invalid lesson ID: "+err.Error()).Err()
 	}
-	fileID, err := uuid.Parse(*req.FileId)
+	fileID, err := uuid.Parse(req.GetFileId())
 	if err != nil {
 		return nil, status.New(codes.InvalidArgument, "invalid file ID: "+err.Error()).Err()
 	}
@@ -74,7 +95,7 @@ func (h *PaymentServiceServer) SubmitPaymentReceipt(ctx context.Context, req *pb
 	}
 	paymentReceipt, err := h.service.SubmitPaymentReceipt(ctx, input)
 	if err != nil {
-		return nil, mapError(err, errdefs.ErrNotFound, errdefs.ErrPermissionDenied, errdefs.ErrInvalidArgument)
+		return nil, mapError(err, errdefs.ErrNotFound, errdefs.ErrPermissionDenied, errdefs.ErrInvalidArgument, errdefs.ErrAlreadyExists)
 	}
 	return toPbReceipt(paymentReceipt), nil
 }
@@ -98,25 +119,83 @@ func (h *PaymentServiceServer) GetReceipt(ctx context.Context, req *pb.GetReceip
 	return toPbReceipt(paymentReceipt), nil
 }
 
+// VerifyReceipt is kept for old clients and approves the receipt.
 func (h *PaymentServiceServer) VerifyReceipt(ctx context.Context, req *pb.VerifyReceiptRequest) (*pb.Receipt, error) {
+	return h.ApproveReceipt(ctx, &pb.ApproveReceiptRequest{ReceiptId: req.ReceiptId})
+}
+
+func (h *PaymentServiceServer) ApproveReceipt(ctx context.Context, req *pb.ApproveReceiptRequest) (*pb.Receipt, error) {
 	receiptID, err := uuid.Parse(req.ReceiptId)
 	if err != nil {
 		return nil, status.New(codes.InvalidArgument, "invalid receipt ID: "+err.Error()).Err()
 	}
 
-	input := &models.VerifyReceiptInput{
+	input := &models.ReviewReceiptInput{
 		ReceiptId: receiptID,
 	}
 	if logger, ok := logging.GetFromContext(ctx); ok {
-		logger.Info(ctx, "verifying receipt", zap.Any("input", input))
+		logger.Info(ctx, "approving receipt", zap.Any("input", input))
 	}
-	paymentReceipt, err := h.service.VerifyReceipt(ctx, input)
+	paymentReceipt, err := h.service.ApproveReceipt(ctx, input)
 	if err != nil {
-		return nil, mapError(err, errdefs.ErrNotFound, errdefs.ErrPermissionDenied)
+		return nil, mapError(err, errdefs.ErrNotFound, errdefs.ErrPermissionDenied, errdefs.ErrAlreadyReviewed, errdefs.ErrReviewInProgress)
 	}
 	return toPbReceipt(paymentReceipt), nil
 }
 
+func (h *PaymentServiceServer) RejectReceipt(ctx context.Context, req *pb.RejectReceiptRequest) (*pb.Receipt, error) {
+	receiptID, err := uuid.Parse(req.ReceiptId)
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "invalid receipt ID: "+err.Error()).Err()
+	}
+
+	input := &models.RejectReceiptInput{
+		ReceiptId: receiptID,
+		Reason:    req.Reason,
+	}
+	if logger, ok := logging.GetFromContext(ctx); ok {
+		logger.Info(ctx, "rejecting receipt", zap.Any("input", input))
+	}
+	paymentReceipt, err := h.service.RejectReceipt(ctx, input)
+	if err != nil {
+		return nil, mapError(err, errdefs.ErrNotFound, errdefs.ErrPermissionDenied, errdefs.ErrInvalidArgument, errdefs.ErrAlreadyReviewed)
+	}
+	return toPbReceipt(paymentReceipt), nil
+}
+
+func (h *PaymentServiceServer) ListReceipts(ctx context.Context, req *pb.ListReceiptsRequest) (*pb.ListReceiptsResponse, error) {
+	input := &models.ListReceiptsInput{}
+	if req.TutorId != nil {
+		tutorID, err := uuid.Parse(*req.TutorId)
+		if err != nil {
+			return nil, status.New(codes.InvalidArgument, "invalid tutor ID: "+err.Error()).Err()
+		}
+		input.TutorId = &tutorID
+	}
+	if req.StudentId != nil {
+		studentID, err := uuid.Parse(*req.StudentId)
+		if err != nil {
+			return nil, status.New(codes.InvalidArgument, "invalid student ID: "+err.Error()).Err()
+		}
+		input.StudentId = &studentID
+	}
+	if req.Status != nil {
+		receiptStatus := models.ReceiptStatus(*req.Status)
+		input.Status = &receiptStatus
+	}
+
+	receipts, err := h.service.ListReceipts(ctx, input)
+	if err != nil {
+		return nil, mapError(err, errdefs.ErrPermissionDenied, errdefs.ErrInvalidArgument)
+	}
+
+	resp := &pb.ListReceiptsResponse{Receipts: make([]*pb.Receipt, len(receipts))}
+	for i, receipt := range receipts {
+		resp.Receipts[i] = toPbReceipt(receipt)
+	}
+	return resp, nil
+}
+
 func (h *PaymentServiceServer) GetReceiptFile(ctx context.Context, req *pb.GetReceiptFileRequest) (*pb.ReceiptFileURL, error) {
 	receiptID, err := uuid.Parse(req.ReceiptId)
 	if err != nil {
@@ -146,20 +225,57 @@ func toPbReceipt(receipt *models.PaymentReceipt) *pb.Receipt {
 	lessonID := receipt.LessonID.String()
 	fileID := receipt.FileID.String()
 
-	return &pb.Receipt{
-		Id:         receipt.ID.String(),
-		LessonId:   &lessonID,
-		FileId:     &fileID,
-		IsVerified: receipt.IsVerified,
-		CreatedAt:  timestamppb.New(receipt.CreatedAt),
-		EditedAt:   timestamppb.New(receipt.EditedAt),
+	pbReceipt := &pb.Receipt{
+		Id:              receipt.ID.String(),
+		LessonId:        &lessonID,
+		FileId:          &fileID,
+		IsVerified:      receipt.Status == models.ReceiptStatusApproved,
+		Status:          receipt.Status.String(),
+		RejectionReason: receipt.RejectionReason,
+		CreatedAt:       timestamppb.New(receipt.CreatedAt),
+		EditedAt:        timestamppb.New(receipt.EditedAt),
+	}
+	if receipt.TutorID != nil {
+		tutorID := receipt.TutorID.String()
+		pbReceipt.TutorId = &tutorID
 	}
+	if receipt.StudentID != nil {
+		studentID := receipt.StudentID.String()
+		pbReceipt.StudentId = &studentID
+	}
+	if amount := receipt.Amount(); amount != nil {
+		pbReceipt.Amount = toPbMoney(*amount)
+	}
+	return pbReceipt
+}
+
+func toPbMoney(m money.Money) *pb.Money {
+	return &pb.Money{AmountMinor: m.Amount, Currency: m.Currency.String()}
+}
+
+func toPbMoneyList(list []money.Money) []*pb.Money {
+	result := make([]*pb.Money, len(list))
+	for i, m := range list {
+		result[i] = toPbMoney(m)
+	}
+	return result
+}
+
+func fromPbMoney(m *pb.Money) (money.Money, error) {
+	if m == nil {
+		return money.Money{}, errors.New("amount is required")
+	}
+	currency, err := money.ParseCurrency(m.Currency)
+	if err != nil {
+		return money.Money{}, err
+	}
+	return money.New(m.AmountMinor, currency), nil
 }
 
 //func toPbPaymentInfo(paymentInfo *models.PaymentInfo) *pb.PaymentInfo {
 //	return &pb.PaymentInfo{
 //		LessonId:    paymentInfo.LessonID.String(),
-//		PriceRub:    paymentInfo.PriceRUB,
+//		Price:       toPbMoney(paymentInfo.Price),
 //		PaymentInfo: paymentInfo.PaymentDetails,
 //	}
 //}
@@ -177,11 +293,29 @@ func mapError(err error, possibleErrors ...error) error {
 		return status.New(codes.PermissionDenied, "permission denied").Err()
 
 	case errors.Is(err, errdefs.ErrInvalidPayment) && slices.Contains(possibleErrors, errdefs.ErrInvalidPayment):
-		return status.New(codes.Unauthenticated, "invalid payment").Err()
+		return status.New(codes.FailedPrecondition, "payment does not match the stored one").Err()
 
 	case errors.Is(err, errdefs.ErrInvalidArgument) && slices.Contains(possibleErrors, errdefs.ErrInvalidArgument):
 		return status.New(codes.InvalidArgument, "invalid argument provided").Err()
 
+	case errors.Is(err, errdefs.ErrAlreadyExists) && slices.Contains(possibleErrors, errdefs.ErrAlreadyExists):
+		return status.New(codes.AlreadyExists, "receipt already exists").Err()
+
+	case errors.Is(err, errdefs.ErrAlreadyReviewed) && slices.Contains(possibleErrors, errdefs.ErrAlreadyReviewed):
+		return status.New(codes.FailedPrecondition, "receipt is already reviewed").Err()
+
+	case errors.Is(err, errdefs.ErrReviewInProgress) && slices.Contains(possibleErrors, errdefs.ErrReviewInProgress):
+		return status.New(codes.FailedPrecondition, "receipt approval is in progress").Err()
+
+	case errors.Is(err, errdefs.ErrInvalidSignature) && slices.Contains(possibleErrors, errdefs.ErrInvalidSignature):
+		return status.New(codes.Unauthenticated, "invalid webhook signature").Err()
+
+	case errors.Is(err, errdefs.ErrNoProvider) && slices.Contains(possibleErrors, errdefs.ErrNoProvider):
+		return status.New(codes.Unimplemented, "online payments are disabled").Err()
+
+	case errors.Is(err, errdefs.ErrNotRefundable) && slices.Contains(possibleErrors, errdefs.ErrNotRefundable):
+		return status.New(codes.FailedPrecondition, "only cancelled paid lessons can be refunded").Err()
+
 	default:
 		return status.New(codes.Internal, "internal server error").Err()
 	}
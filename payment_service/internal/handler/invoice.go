@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"common_library/money"
+	"context"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	errdefs "paymentservice/internal/errors"
+	"paymentservice/internal/models"
+	pb "paymentservice/pkg/api"
+)
+
+func (h *PaymentServiceServer) CreateInvoice(ctx context.Context, req *pb.CreateInvoiceRequest) (*pb.Invoice, error) {
+	studentID, err := uuid.Parse(req.StudentId)
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "invalid student ID: "+err.Error()).Err()
+	}
+	if req.PeriodStart == nil || req.PeriodEnd == nil {
+		return nil, status.New(codes.InvalidArgument, "period is required").Err()
+	}
+
+	input := &models.CreateInvoiceInput{
+		StudentId:   studentID,
+		PeriodStart: req.PeriodStart.AsTime(),
+		PeriodEnd:   req.PeriodEnd.AsTime(),
+	}
+	if req.Currency != nil {
+		currency, err := money.ParseCurrency(*req.Currency)
+		if err != nil {
+			return nil, status.New(codes.InvalidArgument, "invalid currency: "+err.Error()).Err()
+		}
+		input.Currency = &currency
+	}
+	invoice, err := h.service.CreateInvoice(ctx, input)
+	if err != nil {
+		return nil, mapError(err, errdefs.ErrNotFound, errdefs.ErrInvalidArgument, errdefs.ErrPermissionDenied)
+	}
+	return toPbInvoice(invoice), nil
+}
+
+func (h *PaymentServiceServer) ListInvoices(ctx context.Context, req *pb.ListInvoicesRequest) (*pb.ListInvoicesResponse, error) {
+	input := &models.ListInvoicesInput{}
+	if req.TutorId != nil {
+		tutorID, err := uuid.Parse(*req.TutorId)
+		if err != nil {
+			return nil, status.New(codes.InvalidArgument, "invalid tutor ID: "+err.Error()).Err()
+		}
+		input.TutorId = &tutorID
+	}
+	if req.StudentId != nil {
+		studentID, err := uuid.Parse(*req.StudentId)
+		if err != nil {
+			return nil, status.New(codes.InvalidArgument, "invalid student ID: "+err.Error()).Err()
+		}
+		input.StudentId = &studentID
+	}
+
+	invoices, err := h.service.ListInvoices(ctx, input)
+	if err != nil {
+		return nil, mapError(err, errdefs.ErrPermissionDenied)
+	}
+
+	resp := &pb.ListInvoicesResponse{Invoices: make([]*pb.Invoice, len(invoices))}
+	for i, invoice := range invoices {
+		resp.Invoices[i] = toPbInvoice(invoice)
+	}
+	return resp, nil
+}
+
+func (h *PaymentServiceServer) GetInvoiceFile(ctx context.Context, req *pb.GetInvoiceFileRequest) (*pb.InvoiceFileURL, error) {
+	invoiceID, err := uuid.Parse(req.InvoiceId)
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "invalid invoice ID: "+err.Error()).Err()
+	}
+
+	url, err := h.service.GetInvoiceFile(ctx, &models.GetInvoiceFileInput{InvoiceId: invoiceID})
+	if err != nil {
+		return nil, mapError(err, errdefs.ErrNotFound, errdefs.ErrInvalidArgument, errdefs.ErrPermissionDenied)
+	}
+	return &pb.InvoiceFileURL{Url: url.URL}, nil
+}
+
+func toPbInvoice(invoice *models.Invoice) *pb.Invoice {
+	result := &pb.Invoice{
+		Id:          invoice.ID.String(),
+		TutorId:     invoice.TutorID.String(),
+		StudentId:   invoice.StudentID.String(),
+		Number:      invoice.Number,
+		PeriodStart: timestamppb.New(invoice.PeriodStart),
+		PeriodEnd:   timestamppb.New(invoice.PeriodEnd),
+		Total:       toPbMoney(invoice.Total()),
+		Paid:        toPbMoney(invoice.Paid()),
+		CreatedAt:   timestamppb.New(invoice.CreatedAt),
+	}
+	if invoice.FileID != nil {
+		fileID := invoice.FileID.String()
+		result.FileId = &fileID
+	}
+	return result
+}
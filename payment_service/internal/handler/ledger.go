@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	errdefs "paymentservice/internal/errors"
+	"paymentservice/internal/models"
+	pb "paymentservice/pkg/api"
+)
+
+func (h *PaymentServiceServer) GetBalance(ctx context.Context, req *pb.GetBalanceRequest) (*pb.Balance, error) {
+	tutorID, studentID, err := parsePair(req.TutorId, req.StudentId)
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := h.service.GetBalance(ctx, &models.GetBalanceInput{TutorId: tutorID, StudentId: studentID})
+	if err != nil {
+		return nil, mapError(err, errdefs.ErrInvalidArgument, errdefs.ErrPermissionDenied)
+	}
+
+	return &pb.Balance{
+		TutorId:            balance.TutorID.String(),
+		StudentId:          balance.StudentID.String(),
+		Balances:           toPbMoneyList(balance.Balances),
+		PackageLessonsLeft: balance.PackageLessonsLeft,
+	}, nil
+}
+
+func (h *PaymentServiceServer) ListLedgerEntries(ctx context.Context, req *pb.ListLedgerEntriesRequest) (*pb.ListLedgerEntriesResponse, error) {
+	tutorID, studentID, err := parsePair(req.TutorId, req.StudentId)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &models.ListLedgerEntriesInput{
+		TutorId:   tutorID,
+		StudentId: studentID,
+		Limit:     req.GetLimit(),
+	}
+	entries, err := h.service.ListLedgerEntries(ctx, input)
+	if err != nil {
+		return nil, mapError(err, errdefs.ErrInvalidArgument, errdefs.ErrPermissionDenied)
+	}
+
+	resp := &pb.ListLedgerEntriesResponse{Entries: make([]*pb.LedgerEntry, len(entries))}
+	for i, entry := range entries {
+		resp.Entries[i] = &pb.LedgerEntry{
+			Id:            entry.ID.String(),
+			TransactionId: entry.TransactionID.String(),
+			Kind:          entry.Kind.String(),
+			ReferenceId:   entry.ReferenceID.String(),
+			Amount:        toPbMoney(entry.Amount()),
+			CreatedAt:     timestamppb.New(entry.CreatedAt),
+		}
+	}
+	return resp, nil
+}
+
+func (h *PaymentServiceServer) CreatePackage(ctx context.Context, req *pb.CreatePackageRequest) (*pb.LessonPackage, error) {
+	studentID, err := uuid.Parse(req.StudentId)
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "invalid student ID: "+err.Error()).Err()
+	}
+
+	price, err := fromPbMoney(req.Price)
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "invalid price: "+err.Error()).Err()
+	}
+
+	input := &models.CreatePackageInput{
+		StudentId:   studentID,
+		Name:        req.Name,
+		LessonCount: req.LessonCount,
+		Price:       price,
+	}
+	pkg, err := h.service.CreatePackage(ctx, input)
+	if err != nil {
+		return nil, mapError(err, errdefs.ErrNotFound, errdefs.ErrInvalidArgument, errdefs.ErrPermissionDenied)
+	}
+	return toPbPackage(pkg), nil
+}
+
+func (h *PaymentServiceServer) ListPackages(ctx context.Context, req *pb.ListPackagesRequest) (*pb.ListPackagesResponse, error) {
+	tutorID, studentID, err := parsePair(req.TutorId, req.StudentId)
+	if err != nil {
+		return nil, err
+	}
+
+	packages, err := h.service.ListPackages(ctx, &models.ListPackagesInput{TutorId: tutorID, StudentId: studentID})
+	if err != nil {
+		return nil, mapError(err, errdefs.ErrInvalidArgument, errdefs.ErrPermissionDenied)
+	}
+
+	resp := &pb.ListPackagesResponse{Packages: make([]*pb.LessonPackage, len(packages))}
+	for i, pkg := range packages {
+		resp.Packages[i] = toPbPackage(pkg)
+	}
+	return resp, nil
+}
+
+func (h *PaymentServiceServer) PurchasePackage(ctx context.Context, req *pb.PurchasePackageRequest) (*pb.PackagePurchase, error) {
+	packageID, err := uuid.Parse(req.PackageId)
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "invalid package ID: "+err.Error()).Err()
+	}
+
+	purchase, err := h.service.PurchasePackage(ctx, &models.PurchasePackageInput{PackageId: packageID})
+	if err != nil {
+		return nil, mapError(err, errdefs.ErrNotFound, errdefs.ErrInvalidArgument, errdefs.ErrPermissionDenied)
+	}
+
+	return &pb.PackagePurchase{
+		Id:           purchase.ID.String(),
+		PackageId:    purchase.PackageID.String(),
+		TutorId:      purchase.TutorID.String(),
+		StudentId:    purchase.StudentID.String(),
+		LessonsTotal: purchase.LessonsTotal,
+		LessonsUsed:  purchase.LessonsUsed,
+		Price:        toPbMoney(purchase.Price()),
+		CreatedAt:    timestamppb.New(purchase.CreatedAt),
+	}, nil
+}
+
+func toPbPackage(pkg *models.LessonPackage) *pb.LessonPackage {
+	return &pb.LessonPackage{
+		Id:          pkg.ID.String(),
+		TutorId:     pkg.TutorID.String(),
+		StudentId:   pkg.StudentID.String(),
+		Name:        pkg.Name,
+		LessonCount: pkg.LessonCount,
+		Price:       toPbMoney(pkg.Price()),
+		CreatedAt:   timestamppb.New(pkg.CreatedAt),
+	}
+}
+
+func parsePair(tutorId string, studentId string) (uuid.UUID, uuid.UUID, error) {
+	tutorID, err := uuid.Parse(tutorId)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.New(codes.InvalidArgument, "invalid tutor ID: "+err.Error()).Err()
+	}
+	studentID, err := uuid.Parse(studentId)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.New(codes.InvalidArgument, "invalid student ID: "+err.Error()).Err()
+	}
+	return tutorID, studentID, nil
+}
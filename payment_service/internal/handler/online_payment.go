@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	errdefs "paymentservice/internal/errors"
+	"paymentservice/internal/models"
+	pb "paymentservice/pkg/api"
+	"strings"
+)
+
+func (h *PaymentServiceServer) CreateOnlinePayment(ctx context.Context, req *pb.CreateOnlinePaymentRequest) (*pb.OnlinePayment, error) {
+	lessonID, err := uuid.Parse(req.GetLessonId())
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "invalid lesson ID: "+err.Error()).Err()
+	}
+
+	input := &models.CreateOnlinePaymentInput{
+		LessonId:  lessonID,
+		ReturnUrl: req.GetReturnUrl(),
+	}
+	payment, err := h.service.CreateOnlinePayment(ctx, input)
+	if err != nil {
+		return nil, mapError(err, errdefs.ErrNoProvider, errdefs.ErrNotFound, errdefs.ErrInvalidArgument,
+			errdefs.ErrPermissionDenied, errdefs.ErrAlreadyExists)
+	}
+
+	return &pb.OnlinePayment{
+		Id:              payment.ID.String(),
+		LessonId:        payment.LessonID.String(),
+		Provider:        payment.Provider,
+		Status:          payment.Status.String(),
+		Amount:          toPbMoney(payment.Amount()),
+		ConfirmationUrl: payment.ConfirmationURL,
+		CreatedAt:       timestamppb.New(payment.CreatedAt),
+	}, nil
+}
+
+func (h *PaymentServiceServer) HandleProviderWebhook(ctx context.Context, req *pb.ProviderWebhookRequest) (*pb.ProviderWebhookResponse, error) {
+	headers := make(map[string]string, len(req.GetHeaders()))
+	for name, value := range req.GetHeaders() {
+		headers[strings.ToLower(name)] = value
+	}
+
+	input := &models.ProviderWebhook{
+		Provider: req.GetProvider(),
+		Body:     req.GetBody(),
+		Headers:  headers,
+	}
+	if err := h.service.HandleProviderWebhook(ctx, input); err != nil {
+		return nil, mapError(err, errdefs.ErrNoProvider, errdefs.ErrNotFound, errdefs.ErrInvalidSignature, errdefs.ErrInvalidArgument,
+			errdefs.ErrInvalidPayment)
+	}
+	return &pb.ProviderWebhookResponse{}, nil
+}
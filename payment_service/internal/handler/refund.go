@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	errdefs "paymentservice/internal/errors"
+	"paymentservice/internal/models"
+	pb "paymentservice/pkg/api"
+)
+
+func (h *PaymentServiceServer) RefundLesson(ctx context.Context, req *pb.RefundLessonRequest) (*pb.Refund, error) {
+	lessonID, err := uuid.Parse(req.GetLessonId())
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "invalid lesson ID: "+err.Error()).Err()
+	}
+
+	input := &models.RefundLessonInput{
+		LessonId: lessonID,
+		Kind:     models.RefundKind(req.GetKind()),
+		Reason:   req.Reason,
+	}
+	refund, err := h.service.RefundLesson(ctx, input)
+	if err != nil {
+		return nil, mapError(err, errdefs.ErrNotFound, errdefs.ErrInvalidArgument, errdefs.ErrPermissionDenied,
+			errdefs.ErrAlreadyExists, errdefs.ErrNotRefundable, errdefs.ErrNoProvider)
+	}
+	return toPbRefund(refund), nil
+}
+
+func (h *PaymentServiceServer) ListRefunds(ctx context.Context, req *pb.ListRefundsRequest) (*pb.ListRefundsResponse, error) {
+	input := &models.ListRefundsInput{}
+	if req.TutorId != nil {
+		tutorID, err := uuid.Parse(*req.TutorId)
+		if err != nil {
+			return nil, status.New(codes.InvalidArgument, "invalid tutor ID: "+err.Error()).Err()
+		}
+		input.TutorId = &tutorID
+	}
+	if req.StudentId != nil {
+		studentID, err := uuid.Parse(*req.StudentId)
+		if err != nil {
+			return nil, status.New(codes.InvalidArgument, "invalid student ID: "+err.Error()).Err()
+		}
+		input.StudentId = &studentID
+	}
+
+	refunds, err := h.service.ListRefunds(ctx, input)
+	if err != nil {
+		return nil, mapError(err, errdefs.ErrPermissionDenied)
+	}
+
+	resp := &pb.ListRefundsResponse{Refunds: make([]*pb.Refund, len(refunds))}
+	for i, refund := range refunds {
+		resp.Refunds[i] = toPbRefund(refund)
+	}
+	return resp, nil
+}
+
+func toPbRefund(refund *models.Refund) *pb.Refund {
+	result := &pb.Refund{
+		Id:               refund.ID.String(),
+		LessonId:         refund.LessonID.String(),
+		TutorId:          refund.TutorID.String(),
+		StudentId:        refund.StudentID.String(),
+		Kind:             refund.Kind.String(),
+		Amount:           toPbMoney(refund.Amount()),
+		Reason:           refund.Reason,
+		ProviderRefundId: refund.ProviderRefundID,
+		CreatedAt:        timestamppb.New(refund.CreatedAt),
+	}
+	if refund.ReceiptID != nil {
+		receiptID := refund.ReceiptID.String()
+		result.ReceiptId = &receiptID
+	}
+	if refund.OnlinePaymentID != nil {
+		paymentID := refund.OnlinePaymentID.String()
+		result.OnlinePaymentId = &paymentID
+	}
+	if refund.PackagePurchaseID != nil {
+		purchaseID := refund.PackagePurchaseID.String()
+		result.PackagePurchaseId = &purchaseID
+	}
+	return result
+}
@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	errdefs "paymentservice/internal/errors"
+	"paymentservice/internal/models"
+	pb "paymentservice/pkg/api"
+)
+
+func (h *PaymentServiceServer) GetEarningsReport(ctx context.Context, req *pb.GetEarningsReportRequest) (*pb.EarningsReport, error) {
+	if req.PeriodStart == nil || req.PeriodEnd == nil {
+		return nil, status.New(codes.InvalidArgument, "period is required").Err()
+	}
+
+	input := &models.GetEarningsReportInput{
+		PeriodStart: req.PeriodStart.AsTime(),
+		PeriodEnd:   req.PeriodEnd.AsTime(),
+	}
+	report, err := h.service.GetEarningsReport(ctx, input)
+	if err != nil {
+		return nil, mapError(err, errdefs.ErrInvalidArgument, errdefs.ErrPermissionDenied)
+	}
+
+	resp := &pb.EarningsReport{
+		PeriodStart: timestamppb.New(report.PeriodStart),
+		PeriodEnd:   timestamppb.New(report.PeriodEnd),
+		Total:       toPbEarningsSummary(report.Total),
+		Months:      make([]*pb.MonthlyEarnings, len(report.Months)),
+		Students:    make([]*pb.StudentEarnings, len(report.Students)),
+	}
+	for i, month := range report.Months {
+		resp.Months[i] = &pb.MonthlyEarnings{
+			MonthStart: timestamppb.New(month.MonthStart),
+			Summary:    toPbEarningsSummary(month.EarningsSummary),
+		}
+	}
+	for i, student := range report.Students {
+		resp.Students[i] = &pb.StudentEarnings{
+			StudentId:   student.StudentID.String(),
+			StudentName: student.StudentName,
+			Summary:     toPbEarningsSummary(student.EarningsSummary),
+		}
+	}
+	return resp, nil
+}
+
+func (h *PaymentServiceServer) GetOutstandingReport(ctx context.Context, req *pb.GetOutstandingReportRequest) (*pb.OutstandingReport, error) {
+	report, err := h.service.GetOutstandingReport(ctx)
+	if err != nil {
+		return nil, mapError(err, errdefs.ErrPermissionDenied)
+	}
+
+	resp := &pb.OutstandingReport{
+		Outstanding: toPbMoneyList(report.Outstanding),
+		Students:    make([]*pb.StudentOutstanding, len(report.Students)),
+	}
+	for i, student := range report.Students {
+		resp.Students[i] = &pb.StudentOutstanding{
+			StudentId:      student.StudentID.String(),
+			StudentName:    student.StudentName,
+			UnpaidLessons:  student.UnpaidLessons,
+			Outstanding:    toPbMoneyList(student.Outstanding),
+			OldestUnpaidAt: timestamppb.New(student.OldestUnpaidAt),
+		}
+	}
+	return resp, nil
+}
+
+func toPbEarningsSummary(summary models.EarningsSummary) *pb.EarningsSummary {
+	result := &pb.EarningsSummary{
+		LessonsCount:         summary.LessonsCount,
+		UnpricedLessonsCount: summary.UnpricedLessonsCount,
+		AvgDaysToPay:         summary.AvgDaysToPay,
+		ByCurrency:           make([]*pb.CurrencyEarnings, len(summary.ByCurrency)),
+	}
+	for i, c := range summary.ByCurrency {
+		result.ByCurrency[i] = &pb.CurrencyEarnings{
+			Currency:         c.Currency.String(),
+			LessonsCount:     c.LessonsCount,
+			EarnedMinor:      c.EarnedMinor,
+			PaidMinor:        c.PaidMinor,
+			OutstandingMinor: c.OutstandingMinor,
+		}
+	}
+	return result
+}
@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	errdefs "paymentservice/internal/errors"
+	pb "paymentservice/pkg/api"
+)
+
+func (h *PaymentServiceServer) ExportUserData(ctx context.Context, req *pb.ExportUserDataRequest) (*pb.UserDataExport, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "invalid user ID: "+err.Error()).Err()
+	}
+
+	export, err := h.service.ExportUserData(ctx, userID)
+	if err != nil {
+		return nil, mapError(err, errdefs.ErrPermissionDenied)
+	}
+
+	resp := &pb.UserDataExport{
+		Receipts: make([]*pb.Receipt, len(export.Receipts)),
+		Invoices: make([]*pb.Invoice, len(export.Invoices)),
+		Refunds:  make([]*pb.Refund, len(export.Refunds)),
+	}
+	for i, receipt := range export.Receipts {
+		resp.Receipts[i] = toPbReceipt(receipt)
+	}
+	for i, invoice := range export.Invoices {
+		resp.Invoices[i] = toPbInvoice(invoice)
+	}
+	for i, refund := range export.Refunds {
+		resp.Refunds[i] = toPbRefund(refund)
+	}
+	return resp, nil
+}
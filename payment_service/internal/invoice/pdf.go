@@ -0,0 +1,128 @@
+package invoice
+
+import (
+	"bytes"
+	"common_library/money"
+	"fmt"
+	"os"
+	"paymentservice/internal/models"
+	"time"
+
+	"github.com/go-pdf/fpdf"
+)
+
+const (
+	fontFamily = "invoice"
+	dateLayout = "02.01.2006"
+	timeLayout = "02.01.2006 15:04"
+)
+
+// dates are printed in Moscow time, which most tutors use
+var location = time.FixedZone("MSK", 3*60*60)
+
+// Renderer renders invoices to PDF.
+type Renderer struct {
+	fontPath string
+}
+
+// NewRenderer creates a renderer that uses the TrueType font at fontPath. The font must contain Cyrillic glyphs.
+// Without a font the built-in Helvetica is used, which prints Latin text only.
+func NewRenderer(fontPath string) (*Renderer, error) {
+	if fontPath != "" {
+		if _, err := os.Stat(fontPath); err != nil {
+			return nil, fmt.Errorf("invoice font: %w", err)
+		}
+	}
+	return &Renderer{fontPath: fontPath}, nil
+}
+
+// Render returns the invoice PDF.
+func (r *Renderer) Render(doc *models.InvoiceDocument) ([]byte, error) {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	family := "Helvetica"
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+	if r.fontPath != "" {
+		pdf.AddUTF8Font(fontFamily, "", r.fontPath)
+		family = fontFamily
+		tr = func(s string) string { return s }
+	}
+	pdf.AddPage()
+
+	invoice := doc.Invoice
+
+	pdf.SetFont(family, "", 18)
+	pdf.CellFormat(0, 10, tr(fmt.Sprintf("Счёт № %d", invoice.Number)), "", 1, "L", false, 0, "")
+	pdf.SetFont(family, "", 11)
+	// the end of the period is exclusive
+	periodEnd := invoice.PeriodEnd.Add(-time.Nanosecond)
+	pdf.CellFormat(0, 7, tr(fmt.Sprintf("за период %s – %s", formatDate(invoice.PeriodStart), formatDate(periodEnd))), "", 1, "L", false, 0, "")
+	pdf.Ln(4)
+	pdf.CellFormat(0, 6, tr("Репетитор: "+doc.TutorName), "", 1, "L", false, 0, "")
+	pdf.CellFormat(0, 6, tr("Ученик: "+doc.StudentName), "", 1, "L", false, 0, "")
+	pdf.Ln(6)
+
+	widths := []float64{40, 80, 35, 25}
+	for i, header := range []string{"Дата", "Услуга", "Сумма", "Оплачено"} {
+		pdf.CellFormat(widths[i], 8, tr(header), "1", 0, "C", false, 0, "")
+	}
+	pdf.Ln(-1)
+	for _, line := range doc.Lines {
+		pdf.CellFormat(widths[0], 7, formatTime(line.StartsAt), "1", 0, "L", false, 0, "")
+		pdf.CellFormat(widths[1], 7, tr(lineTitle(line.Kind)), "1", 0, "L", false, 0, "")
+		pdf.CellFormat(widths[2], 7, tr(money.New(line.AmountMinor, invoice.Currency).String()), "1", 0, "R", false, 0, "")
+		pdf.CellFormat(widths[3], 7, tr(formatPaid(line.IsPaid)), "1", 0, "C", false, 0, "")
+		pdf.Ln(-1)
+	}
+	pdf.Ln(4)
+
+	totals := []struct {
+		title  string
+		amount money.Money
+	}{
+		{"Итого", invoice.Total()},
+		{"Оплачено", invoice.Paid()},
+		{"К оплате", money.New(invoice.TotalMinor-invoice.PaidMinor, invoice.Currency)},
+	}
+	for _, total := range totals {
+		pdf.CellFormat(120, 7, tr(total.title+":"), "", 0, "R", false, 0, "")
+		pdf.CellFormat(35, 7, tr(total.amount.String()), "", 1, "R", false, 0, "")
+	}
+
+	if invoice.PaymentDetails != nil && *invoice.PaymentDetails != "" {
+		pdf.Ln(6)
+		pdf.CellFormat(0, 7, tr("Реквизиты для оплаты:"), "", 1, "L", false, 0, "")
+		pdf.MultiCell(0, 6, tr(*invoice.PaymentDetails), "", "L", false)
+	}
+
+	pdf.Ln(6)
+	pdf.SetFont(family, "", 9)
+	pdf.CellFormat(0, 6, tr("Сформирован "+formatDate(invoice.CreatedAt)), "", 1, "L", false, 0, "")
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func lineTitle(kind models.InvoiceLineKind) string {
+	if kind == models.InvoiceLineLateCancel {
+		return "Поздняя отмена занятия"
+	}
+	return "Занятие"
+}
+
+func formatPaid(paid bool) string {
+	if paid {
+		return "да"
+	}
+	return "нет"
+}
+
+func formatDate(t time.Time) string {
+	return t.In(location).Format(dateLayout)
+}
+
+func formatTime(t time.Time) string {
+	return t.In(location).Format(timeLayout)
+}
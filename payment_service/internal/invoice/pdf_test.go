@@ -0,0 +1,50 @@
+package invoice
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"common_library/money"
+	"paymentservice/internal/models"
+)
+
+func TestRenderer_Render(t *testing.T) {
+	renderer, err := NewRenderer("")
+	require.NoError(t, err)
+
+	details := "Card 1234"
+	start := time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC)
+	doc := &models.InvoiceDocument{
+		Invoice: &models.Invoice{
+			ID:             uuid.New(),
+			Number:         7,
+			PeriodStart:    start,
+			PeriodEnd:      start.AddDate(0, 1, 0),
+			TotalMinor:     300000,
+			PaidMinor:      150000,
+			Currency:       money.RUB,
+			PaymentDetails: &details,
+			CreatedAt:      time.Now(),
+		},
+		Lines: []models.InvoiceLine{
+			{LessonID: uuid.New(), Kind: models.InvoiceLineLesson, StartsAt: start.Add(time.Hour), AmountMinor: 150000, IsPaid: true},
+			{LessonID: uuid.New(), Kind: models.InvoiceLineLateCancel, StartsAt: start.Add(48 * time.Hour), AmountMinor: 150000},
+		},
+		TutorName:   "Tutor",
+		StudentName: "Student",
+	}
+
+	pdf, err := renderer.Render(doc)
+	require.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(pdf, []byte("%PDF-")))
+}
+
+func TestNewRenderer_MissingFont(t *testing.T) {
+	_, err := NewRenderer("/nonexistent/font.ttf")
+	assert.Error(t, err)
+}
@@ -62,42 +62,62 @@ func (mr *MockFileServiceClientMockRecorder) GenerateDownloadURL(ctx, req any, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateDownloadURL", reflect.TypeOf((*MockFileServiceClient)(nil).GenerateDownloadURL), varargs...)
 }
 
-// GetFileMeta mocks base method.
-func (m *MockFileServiceClient) GetFileMeta(ctx context.Context, req *api.GetFileMetaRequest, opts ...grpc.CallOption) (*api.File, error) {
+// GrantFileAccess mocks base method.
+func (m *MockFileServiceClient) GrantFileAccess(ctx context.Context, req *api.FileAccessRequest, opts ...grpc.CallOption) (*api.Empty, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, req}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetFileMeta", varargs...)
-	ret0, _ := ret[0].(*api.File)
+	ret := m.ctrl.Call(m, "GrantFileAccess", varargs...)
+	ret0, _ := ret[0].(*api.Empty)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetFileMeta indicates an expected call of GetFileMeta.
-func (mr *MockFileServiceClientMockRecorder) GetFileMeta(ctx, req any, opts ...any) *gomock.Call {
+// GrantFileAccess indicates an expected call of GrantFileAccess.
+func (mr *MockFileServiceClientMockRecorder) GrantFileAccess(ctx, req any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, req}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileMeta", reflect.TypeOf((*MockFileServiceClient)(nil).GetFileMeta), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantFileAccess", reflect.TypeOf((*MockFileServiceClient)(nil).GrantFileAccess), varargs...)
 }
 
-// InitUpload mocks base method.
-func (m *MockFileServiceClient) InitUpload(ctx context.Context, req *api.InitUploadRequest, opts ...grpc.CallOption) (*api.InitUploadResponse, error) {
+// RegisterFileUsage mocks base method.
+func (m *MockFileServiceClient) RegisterFileUsage(ctx context.Context, req *api.FileUsage, opts ...grpc.CallOption) (*api.Empty, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, req}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "InitUpload", varargs...)
-	ret0, _ := ret[0].(*api.InitUploadResponse)
+	ret := m.ctrl.Call(m, "RegisterFileUsage", varargs...)
+	ret0, _ := ret[0].(*api.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterFileUsage indicates an expected call of RegisterFileUsage.
+func (mr *MockFileServiceClientMockRecorder) RegisterFileUsage(ctx, req any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, req}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterFileUsage", reflect.TypeOf((*MockFileServiceClient)(nil).RegisterFileUsage), varargs...)
+}
+
+// UploadFile mocks base method.
+func (m *MockFileServiceClient) UploadFile(ctx context.Context, req *api.UploadFileRequest, opts ...grpc.CallOption) (*api.File, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, req}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UploadFile", varargs...)
+	ret0, _ := ret[0].(*api.File)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// InitUpload indicates an expected call of InitUpload.
-func (mr *MockFileServiceClientMockRecorder) InitUpload(ctx, req any, opts ...any) *gomock.Call {
+// UploadFile indicates an expected call of UploadFile.
+func (mr *MockFileServiceClientMockRecorder) UploadFile(ctx, req any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, req}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitUpload", reflect.TypeOf((*MockFileServiceClient)(nil).InitUpload), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadFile", reflect.TypeOf((*MockFileServiceClient)(nil).UploadFile), varargs...)
 }
@@ -10,9 +10,11 @@
 package mocks
 
 import (
+	money "common_library/money"
 	context "context"
 	models "paymentservice/internal/models"
 	reflect "reflect"
+	time "time"
 
 	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
@@ -42,6 +44,185 @@ func (m *MockIPaymentRepo) EXPECT() *MockIPaymentRepoMockRecorder {
 	return m.recorder
 }
 
+// ApproveReceipt mocks base method.
+func (m *MockIPaymentRepo) ApproveReceipt(ctx context.Context, id uuid.UUID) (*models.PaymentReceipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveReceipt", ctx, id)
+	ret0, _ := ret[0].(*models.PaymentReceipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApproveReceipt indicates an expected call of ApproveReceipt.
+func (mr *MockIPaymentRepoMockRecorder) ApproveReceipt(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveReceipt", reflect.TypeOf((*MockIPaymentRepo)(nil).ApproveReceipt), ctx, id)
+}
+
+// CancelOnlinePayment mocks base method.
+func (m *MockIPaymentRepo) CancelOnlinePayment(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelOnlinePayment", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelOnlinePayment indicates an expected call of CancelOnlinePayment.
+func (mr *MockIPaymentRepoMockRecorder) CancelOnlinePayment(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOnlinePayment", reflect.TypeOf((*MockIPaymentRepo)(nil).CancelOnlinePayment), ctx, id)
+}
+
+// ClaimDunningStep mocks base method.
+func (m *MockIPaymentRepo) ClaimDunningStep(ctx context.Context, input *models.DunningStepCreateInput) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDunningStep", ctx, input)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDunningStep indicates an expected call of ClaimDunningStep.
+func (mr *MockIPaymentRepoMockRecorder) ClaimDunningStep(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDunningStep", reflect.TypeOf((*MockIPaymentRepo)(nil).ClaimDunningStep), ctx, input)
+}
+
+// ClaimStaleApprovalSagas mocks base method.
+func (m *MockIPaymentRepo) ClaimStaleApprovalSagas(ctx context.Context, staleBefore time.Time, limit int) ([]*models.ApprovalSaga, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimStaleApprovalSagas", ctx, staleBefore, limit)
+	ret0, _ := ret[0].([]*models.ApprovalSaga)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimStaleApprovalSagas indicates an expected call of ClaimStaleApprovalSagas.
+func (mr *MockIPaymentRepoMockRecorder) ClaimStaleApprovalSagas(ctx, staleBefore, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimStaleApprovalSagas", reflect.TypeOf((*MockIPaymentRepo)(nil).ClaimStaleApprovalSagas), ctx, staleBefore, limit)
+}
+
+// CompleteOnlinePayment mocks base method.
+func (m *MockIPaymentRepo) CompleteOnlinePayment(ctx context.Context, id uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteOnlinePayment", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteOnlinePayment indicates an expected call of CompleteOnlinePayment.
+func (mr *MockIPaymentRepoMockRecorder) CompleteOnlinePayment(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteOnlinePayment", reflect.TypeOf((*MockIPaymentRepo)(nil).CompleteOnlinePayment), ctx, id)
+}
+
+// ConsumePackageLesson mocks base method.
+func (m *MockIPaymentRepo) ConsumePackageLesson(ctx context.Context, input *models.LessonChargeInput) (*models.PackagePurchase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumePackageLesson", ctx, input)
+	ret0, _ := ret[0].(*models.PackagePurchase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumePackageLesson indicates an expected call of ConsumePackageLesson.
+func (mr *MockIPaymentRepoMockRecorder) ConsumePackageLesson(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumePackageLesson", reflect.TypeOf((*MockIPaymentRepo)(nil).ConsumePackageLesson), ctx, input)
+}
+
+// CreateApprovalSaga mocks base method.
+func (m *MockIPaymentRepo) CreateApprovalSaga(ctx context.Context, input *models.ApprovalSagaCreateInput) (*models.ApprovalSaga, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateApprovalSaga", ctx, input)
+	ret0, _ := ret[0].(*models.ApprovalSaga)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateApprovalSaga indicates an expected call of CreateApprovalSaga.
+func (mr *MockIPaymentRepoMockRecorder) CreateApprovalSaga(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateApprovalSaga", reflect.TypeOf((*MockIPaymentRepo)(nil).CreateApprovalSaga), ctx, input)
+}
+
+// CreateInvoice mocks base method.
+func (m *MockIPaymentRepo) CreateInvoice(ctx context.Context, input *models.InvoiceCreateInput) (*models.Invoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInvoice", ctx, input)
+	ret0, _ := ret[0].(*models.Invoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInvoice indicates an expected call of CreateInvoice.
+func (mr *MockIPaymentRepoMockRecorder) CreateInvoice(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInvoice", reflect.TypeOf((*MockIPaymentRepo)(nil).CreateInvoice), ctx, input)
+}
+
+// CreateLedgerTransaction mocks base method.
+func (m *MockIPaymentRepo) CreateLedgerTransaction(ctx context.Context, input *models.LedgerTransactionCreateInput) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLedgerTransaction", ctx, input)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLedgerTransaction indicates an expected call of CreateLedgerTransaction.
+func (mr *MockIPaymentRepoMockRecorder) CreateLedgerTransaction(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLedgerTransaction", reflect.TypeOf((*MockIPaymentRepo)(nil).CreateLedgerTransaction), ctx, input)
+}
+
+// CreateOnlinePayment mocks base method.
+func (m *MockIPaymentRepo) CreateOnlinePayment(ctx context.Context, input *models.OnlinePaymentCreateInput) (*models.OnlinePayment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOnlinePayment", ctx, input)
+	ret0, _ := ret[0].(*models.OnlinePayment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOnlinePayment indicates an expected call of CreateOnlinePayment.
+func (mr *MockIPaymentRepoMockRecorder) CreateOnlinePayment(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOnlinePayment", reflect.TypeOf((*MockIPaymentRepo)(nil).CreateOnlinePayment), ctx, input)
+}
+
+// CreatePackage mocks base method.
+func (m *MockIPaymentRepo) CreatePackage(ctx context.Context, input *models.LessonPackageCreateInput) (*models.LessonPackage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePackage", ctx, input)
+	ret0, _ := ret[0].(*models.LessonPackage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePackage indicates an expected call of CreatePackage.
+func (mr *MockIPaymentRepoMockRecorder) CreatePackage(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePackage", reflect.TypeOf((*MockIPaymentRepo)(nil).CreatePackage), ctx, input)
+}
+
+// CreatePackagePurchase mocks base method.
+func (m *MockIPaymentRepo) CreatePackagePurchase(ctx context.Context, input *models.PackagePurchaseCreateInput) (*models.PackagePurchase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePackagePurchase", ctx, input)
+	ret0, _ := ret[0].(*models.PackagePurchase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePackagePurchase indicates an expected call of CreatePackagePurchase.
+func (mr *MockIPaymentRepoMockRecorder) CreatePackagePurchase(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePackagePurchase", reflect.TypeOf((*MockIPaymentRepo)(nil).CreatePackagePurchase), ctx, input)
+}
+
 // CreateReceipt mocks base method.
 func (m *MockIPaymentRepo) CreateReceipt(ctx context.Context, receipt *models.PaymentReceiptCreateInput) (*models.PaymentReceipt, error) {
 	m.ctrl.T.Helper()
@@ -57,6 +238,21 @@ func (mr *MockIPaymentRepoMockRecorder) CreateReceipt(ctx, receipt any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReceipt", reflect.TypeOf((*MockIPaymentRepo)(nil).CreateReceipt), ctx, receipt)
 }
 
+// CreateRefund mocks base method.
+func (m *MockIPaymentRepo) CreateRefund(ctx context.Context, input *models.RefundCreateInput) (*models.Refund, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRefund", ctx, input)
+	ret0, _ := ret[0].(*models.Refund)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRefund indicates an expected call of CreateRefund.
+func (mr *MockIPaymentRepoMockRecorder) CreateRefund(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRefund", reflect.TypeOf((*MockIPaymentRepo)(nil).CreateRefund), ctx, input)
+}
+
 // ExistsByID mocks base method.
 func (m *MockIPaymentRepo) ExistsByID(ctx context.Context, id uuid.UUID) (bool, error) {
 	m.ctrl.T.Helper()
@@ -72,6 +268,111 @@ func (mr *MockIPaymentRepoMockRecorder) ExistsByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsByID", reflect.TypeOf((*MockIPaymentRepo)(nil).ExistsByID), ctx, id)
 }
 
+// GetBalance mocks base method.
+func (m *MockIPaymentRepo) GetBalance(ctx context.Context, tutorID, studentID uuid.UUID) (*models.Balance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalance", ctx, tutorID, studentID)
+	ret0, _ := ret[0].(*models.Balance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBalance indicates an expected call of GetBalance.
+func (mr *MockIPaymentRepoMockRecorder) GetBalance(ctx, tutorID, studentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockIPaymentRepo)(nil).GetBalance), ctx, tutorID, studentID)
+}
+
+// GetDunningStartedAt mocks base method.
+func (m *MockIPaymentRepo) GetDunningStartedAt(ctx context.Context, now time.Time) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDunningStartedAt", ctx, now)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDunningStartedAt indicates an expected call of GetDunningStartedAt.
+func (mr *MockIPaymentRepoMockRecorder) GetDunningStartedAt(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDunningStartedAt", reflect.TypeOf((*MockIPaymentRepo)(nil).GetDunningStartedAt), ctx, now)
+}
+
+// GetInvoiceByID mocks base method.
+func (m *MockIPaymentRepo) GetInvoiceByID(ctx context.Context, id uuid.UUID) (*models.Invoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInvoiceByID", ctx, id)
+	ret0, _ := ret[0].(*models.Invoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInvoiceByID indicates an expected call of GetInvoiceByID.
+func (mr *MockIPaymentRepoMockRecorder) GetInvoiceByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInvoiceByID", reflect.TypeOf((*MockIPaymentRepo)(nil).GetInvoiceByID), ctx, id)
+}
+
+// GetLessonCharge mocks base method.
+func (m *MockIPaymentRepo) GetLessonCharge(ctx context.Context, lessonID uuid.UUID) (*models.LessonCharge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLessonCharge", ctx, lessonID)
+	ret0, _ := ret[0].(*models.LessonCharge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLessonCharge indicates an expected call of GetLessonCharge.
+func (mr *MockIPaymentRepoMockRecorder) GetLessonCharge(ctx, lessonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLessonCharge", reflect.TypeOf((*MockIPaymentRepo)(nil).GetLessonCharge), ctx, lessonID)
+}
+
+// GetOnlinePaymentByProviderID mocks base method.
+func (m *MockIPaymentRepo) GetOnlinePaymentByProviderID(ctx context.Context, provider, providerPaymentID string) (*models.OnlinePayment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOnlinePaymentByProviderID", ctx, provider, providerPaymentID)
+	ret0, _ := ret[0].(*models.OnlinePayment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOnlinePaymentByProviderID indicates an expected call of GetOnlinePaymentByProviderID.
+func (mr *MockIPaymentRepoMockRecorder) GetOnlinePaymentByProviderID(ctx, provider, providerPaymentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOnlinePaymentByProviderID", reflect.TypeOf((*MockIPaymentRepo)(nil).GetOnlinePaymentByProviderID), ctx, provider, providerPaymentID)
+}
+
+// GetPackageByID mocks base method.
+func (m *MockIPaymentRepo) GetPackageByID(ctx context.Context, id uuid.UUID) (*models.LessonPackage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPackageByID", ctx, id)
+	ret0, _ := ret[0].(*models.LessonPackage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPackageByID indicates an expected call of GetPackageByID.
+func (mr *MockIPaymentRepoMockRecorder) GetPackageByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPackageByID", reflect.TypeOf((*MockIPaymentRepo)(nil).GetPackageByID), ctx, id)
+}
+
+// GetPendingOnlinePayment mocks base method.
+func (m *MockIPaymentRepo) GetPendingOnlinePayment(ctx context.Context, lessonID uuid.UUID) (*models.OnlinePayment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingOnlinePayment", ctx, lessonID)
+	ret0, _ := ret[0].(*models.OnlinePayment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingOnlinePayment indicates an expected call of GetPendingOnlinePayment.
+func (mr *MockIPaymentRepoMockRecorder) GetPendingOnlinePayment(ctx, lessonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingOnlinePayment", reflect.TypeOf((*MockIPaymentRepo)(nil).GetPendingOnlinePayment), ctx, lessonID)
+}
+
 // GetReceiptByID mocks base method.
 func (m *MockIPaymentRepo) GetReceiptByID(ctx context.Context, id uuid.UUID) (*models.PaymentReceipt, error) {
 	m.ctrl.T.Helper()
@@ -102,17 +403,413 @@ func (mr *MockIPaymentRepoMockRecorder) GetReceiptByLessonID(ctx, lessonID any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceiptByLessonID", reflect.TypeOf((*MockIPaymentRepo)(nil).GetReceiptByLessonID), ctx, lessonID)
 }
 
-// UpdateReceipt mocks base method.
-func (m *MockIPaymentRepo) UpdateReceipt(ctx context.Context, id uuid.UUID, isVerified bool) (*models.PaymentReceipt, error) {
+// GetRefundByLessonID mocks base method.
+func (m *MockIPaymentRepo) GetRefundByLessonID(ctx context.Context, lessonID uuid.UUID) (*models.Refund, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRefundByLessonID", ctx, lessonID)
+	ret0, _ := ret[0].(*models.Refund)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRefundByLessonID indicates an expected call of GetRefundByLessonID.
+func (mr *MockIPaymentRepoMockRecorder) GetRefundByLessonID(ctx, lessonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRefundByLessonID", reflect.TypeOf((*MockIPaymentRepo)(nil).GetRefundByLessonID), ctx, lessonID)
+}
+
+// GetSucceededOnlinePayment mocks base method.
+func (m *MockIPaymentRepo) GetSucceededOnlinePayment(ctx context.Context, lessonID uuid.UUID) (*models.OnlinePayment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSucceededOnlinePayment", ctx, lessonID)
+	ret0, _ := ret[0].(*models.OnlinePayment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSucceededOnlinePayment indicates an expected call of GetSucceededOnlinePayment.
+func (mr *MockIPaymentRepoMockRecorder) GetSucceededOnlinePayment(ctx, lessonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSucceededOnlinePayment", reflect.TypeOf((*MockIPaymentRepo)(nil).GetSucceededOnlinePayment), ctx, lessonID)
+}
+
+// ListDunningSteps mocks base method.
+func (m *MockIPaymentRepo) ListDunningSteps(ctx context.Context, lessonIDs []uuid.UUID) ([]*models.DunningStep, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateReceipt", ctx, id, isVerified)
+	ret := m.ctrl.Call(m, "ListDunningSteps", ctx, lessonIDs)
+	ret0, _ := ret[0].([]*models.DunningStep)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDunningSteps indicates an expected call of ListDunningSteps.
+func (mr *MockIPaymentRepoMockRecorder) ListDunningSteps(ctx, lessonIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDunningSteps", reflect.TypeOf((*MockIPaymentRepo)(nil).ListDunningSteps), ctx, lessonIDs)
+}
+
+// ListInvoiceLines mocks base method.
+func (m *MockIPaymentRepo) ListInvoiceLines(ctx context.Context, invoiceID uuid.UUID) ([]models.InvoiceLine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInvoiceLines", ctx, invoiceID)
+	ret0, _ := ret[0].([]models.InvoiceLine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInvoiceLines indicates an expected call of ListInvoiceLines.
+func (mr *MockIPaymentRepoMockRecorder) ListInvoiceLines(ctx, invoiceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInvoiceLines", reflect.TypeOf((*MockIPaymentRepo)(nil).ListInvoiceLines), ctx, invoiceID)
+}
+
+// ListInvoices mocks base method.
+func (m *MockIPaymentRepo) ListInvoices(ctx context.Context, filter *models.InvoiceFilter) ([]*models.Invoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInvoices", ctx, filter)
+	ret0, _ := ret[0].([]*models.Invoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInvoices indicates an expected call of ListInvoices.
+func (mr *MockIPaymentRepoMockRecorder) ListInvoices(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInvoices", reflect.TypeOf((*MockIPaymentRepo)(nil).ListInvoices), ctx, filter)
+}
+
+// ListLedgerEntries mocks base method.
+func (m *MockIPaymentRepo) ListLedgerEntries(ctx context.Context, tutorID, studentID uuid.UUID, limit int) ([]*models.LedgerEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLedgerEntries", ctx, tutorID, studentID, limit)
+	ret0, _ := ret[0].([]*models.LedgerEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLedgerEntries indicates an expected call of ListLedgerEntries.
+func (mr *MockIPaymentRepoMockRecorder) ListLedgerEntries(ctx, tutorID, studentID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLedgerEntries", reflect.TypeOf((*MockIPaymentRepo)(nil).ListLedgerEntries), ctx, tutorID, studentID, limit)
+}
+
+// ListLessonCharges mocks base method.
+func (m *MockIPaymentRepo) ListLessonCharges(ctx context.Context, lessonIDs []uuid.UUID) ([]*models.LedgerTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLessonCharges", ctx, lessonIDs)
+	ret0, _ := ret[0].([]*models.LedgerTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLessonCharges indicates an expected call of ListLessonCharges.
+func (mr *MockIPaymentRepoMockRecorder) ListLessonCharges(ctx, lessonIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLessonCharges", reflect.TypeOf((*MockIPaymentRepo)(nil).ListLessonCharges), ctx, lessonIDs)
+}
+
+// ListPackages mocks base method.
+func (m *MockIPaymentRepo) ListPackages(ctx context.Context, tutorID, studentID uuid.UUID) ([]*models.LessonPackage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPackages", ctx, tutorID, studentID)
+	ret0, _ := ret[0].([]*models.LessonPackage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPackages indicates an expected call of ListPackages.
+func (mr *MockIPaymentRepoMockRecorder) ListPackages(ctx, tutorID, studentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPackages", reflect.TypeOf((*MockIPaymentRepo)(nil).ListPackages), ctx, tutorID, studentID)
+}
+
+// ListReceipts mocks base method.
+func (m *MockIPaymentRepo) ListReceipts(ctx context.Context, filter *models.ReceiptFilter) ([]*models.PaymentReceipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReceipts", ctx, filter)
+	ret0, _ := ret[0].([]*models.PaymentReceipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReceipts indicates an expected call of ListReceipts.
+func (mr *MockIPaymentRepoMockRecorder) ListReceipts(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReceipts", reflect.TypeOf((*MockIPaymentRepo)(nil).ListReceipts), ctx, filter)
+}
+
+// ListReceiptsWithoutPair mocks base method.
+func (m *MockIPaymentRepo) ListReceiptsWithoutPair(ctx context.Context) ([]*models.PaymentReceipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReceiptsWithoutPair", ctx)
+	ret0, _ := ret[0].([]*models.PaymentReceipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReceiptsWithoutPair indicates an expected call of ListReceiptsWithoutPair.
+func (mr *MockIPaymentRepoMockRecorder) ListReceiptsWithoutPair(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReceiptsWithoutPair", reflect.TypeOf((*MockIPaymentRepo)(nil).ListReceiptsWithoutPair), ctx)
+}
+
+// ListRefunds mocks base method.
+func (m *MockIPaymentRepo) ListRefunds(ctx context.Context, filter *models.RefundFilter) ([]*models.Refund, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRefunds", ctx, filter)
+	ret0, _ := ret[0].([]*models.Refund)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRefunds indicates an expected call of ListRefunds.
+func (mr *MockIPaymentRepoMockRecorder) ListRefunds(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRefunds", reflect.TypeOf((*MockIPaymentRepo)(nil).ListRefunds), ctx, filter)
+}
+
+// RefundCanceledOnlinePayment mocks base method.
+func (m *MockIPaymentRepo) RefundCanceledOnlinePayment(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundCanceledOnlinePayment", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefundCanceledOnlinePayment indicates an expected call of RefundCanceledOnlinePayment.
+func (mr *MockIPaymentRepoMockRecorder) RefundCanceledOnlinePayment(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundCanceledOnlinePayment", reflect.TypeOf((*MockIPaymentRepo)(nil).RefundCanceledOnlinePayment), ctx, id)
+}
+
+// ReleaseDunningStep mocks base method.
+func (m *MockIPaymentRepo) ReleaseDunningStep(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseDunningStep", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseDunningStep indicates an expected call of ReleaseDunningStep.
+func (mr *MockIPaymentRepoMockRecorder) ReleaseDunningStep(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseDunningStep", reflect.TypeOf((*MockIPaymentRepo)(nil).ReleaseDunningStep), ctx, id)
+}
+
+// ReviewReceipt mocks base method.
+func (m *MockIPaymentRepo) ReviewReceipt(ctx context.Context, id uuid.UUID, input *models.PaymentReceiptReviewInput) (*models.PaymentReceipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewReceipt", ctx, id, input)
 	ret0, _ := ret[0].(*models.PaymentReceipt)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateReceipt indicates an expected call of UpdateReceipt.
-func (mr *MockIPaymentRepoMockRecorder) UpdateReceipt(ctx, id, isVerified any) *gomock.Call {
+// ReviewReceipt indicates an expected call of ReviewReceipt.
+func (mr *MockIPaymentRepoMockRecorder) ReviewReceipt(ctx, id, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewReceipt", reflect.TypeOf((*MockIPaymentRepo)(nil).ReviewReceipt), ctx, id, input)
+}
+
+// SetInvoiceFile mocks base method.
+func (m *MockIPaymentRepo) SetInvoiceFile(ctx context.Context, id, fileID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetInvoiceFile", ctx, id, fileID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetInvoiceFile indicates an expected call of SetInvoiceFile.
+func (mr *MockIPaymentRepoMockRecorder) SetInvoiceFile(ctx, id, fileID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetInvoiceFile", reflect.TypeOf((*MockIPaymentRepo)(nil).SetInvoiceFile), ctx, id, fileID)
+}
+
+// SetReceiptPair mocks base method.
+func (m *MockIPaymentRepo) SetReceiptPair(ctx context.Context, id, tutorID, studentID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReceiptPair", ctx, id, tutorID, studentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetReceiptPair indicates an expected call of SetReceiptPair.
+func (mr *MockIPaymentRepoMockRecorder) SetReceiptPair(ctx, id, tutorID, studentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReceiptPair", reflect.TypeOf((*MockIPaymentRepo)(nil).SetReceiptPair), ctx, id, tutorID, studentID)
+}
+
+// UpdateApprovalSagaState mocks base method.
+func (m *MockIPaymentRepo) UpdateApprovalSagaState(ctx context.Context, id uuid.UUID, state models.SagaState, lastError *string) (*models.ApprovalSaga, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateApprovalSagaState", ctx, id, state, lastError)
+	ret0, _ := ret[0].(*models.ApprovalSaga)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateApprovalSagaState indicates an expected call of UpdateApprovalSagaState.
+func (mr *MockIPaymentRepoMockRecorder) UpdateApprovalSagaState(ctx, id, state, lastError any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateApprovalSagaState", reflect.TypeOf((*MockIPaymentRepo)(nil).UpdateApprovalSagaState), ctx, id, state, lastError)
+}
+
+// MockInvoiceRenderer is a mock of InvoiceRenderer interface.
+type MockInvoiceRenderer struct {
+	ctrl     *gomock.Controller
+	recorder *MockInvoiceRendererMockRecorder
+	isgomock struct{}
+}
+
+// MockInvoiceRendererMockRecorder is the mock recorder for MockInvoiceRenderer.
+type MockInvoiceRendererMockRecorder struct {
+	mock *MockInvoiceRenderer
+}
+
+// NewMockInvoiceRenderer creates a new mock instance.
+func NewMockInvoiceRenderer(ctrl *gomock.Controller) *MockInvoiceRenderer {
+	mock := &MockInvoiceRenderer{ctrl: ctrl}
+	mock.recorder = &MockInvoiceRendererMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInvoiceRenderer) EXPECT() *MockInvoiceRendererMockRecorder {
+	return m.recorder
+}
+
+// Render mocks base method.
+func (m *MockInvoiceRenderer) Render(doc *models.InvoiceDocument) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Render", doc)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Render indicates an expected call of Render.
+func (mr *MockInvoiceRendererMockRecorder) Render(doc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Render", reflect.TypeOf((*MockInvoiceRenderer)(nil).Render), doc)
+}
+
+// MockPaymentProvider is a mock of PaymentProvider interface.
+type MockPaymentProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentProviderMockRecorder
+	isgomock struct{}
+}
+
+// MockPaymentProviderMockRecorder is the mock recorder for MockPaymentProvider.
+type MockPaymentProviderMockRecorder struct {
+	mock *MockPaymentProvider
+}
+
+// NewMockPaymentProvider creates a new mock instance.
+func NewMockPaymentProvider(ctrl *gomock.Controller) *MockPaymentProvider {
+	mock := &MockPaymentProvider{ctrl: ctrl}
+	mock.recorder = &MockPaymentProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentProvider) EXPECT() *MockPaymentProviderMockRecorder {
+	return m.recorder
+}
+
+// CreatePayment mocks base method.
+func (m *MockPaymentProvider) CreatePayment(ctx context.Context, input *models.ProviderPaymentInput) (*models.ProviderPayment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePayment", ctx, input)
+	ret0, _ := ret[0].(*models.ProviderPayment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePayment indicates an expected call of CreatePayment.
+func (mr *MockPaymentProviderMockRecorder) CreatePayment(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePayment", reflect.TypeOf((*MockPaymentProvider)(nil).CreatePayment), ctx, input)
+}
+
+// Name mocks base method.
+func (m *MockPaymentProvider) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name.
+func (mr *MockPaymentProviderMockRecorder) Name() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockPaymentProvider)(nil).Name))
+}
+
+// ParseWebhook mocks base method.
+func (m *MockPaymentProvider) ParseWebhook(body []byte, headers map[string]string) (*models.ProviderPayment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseWebhook", body, headers)
+	ret0, _ := ret[0].(*models.ProviderPayment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseWebhook indicates an expected call of ParseWebhook.
+func (mr *MockPaymentProviderMockRecorder) ParseWebhook(body, headers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseWebhook", reflect.TypeOf((*MockPaymentProvider)(nil).ParseWebhook), body, headers)
+}
+
+// Refund mocks base method.
+func (m *MockPaymentProvider) Refund(ctx context.Context, paymentID string, amount money.Money, idempotencyKey string) (*models.ProviderRefund, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refund", ctx, paymentID, amount, idempotencyKey)
+	ret0, _ := ret[0].(*models.ProviderRefund)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refund indicates an expected call of Refund.
+func (mr *MockPaymentProviderMockRecorder) Refund(ctx, paymentID, amount, idempotencyKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refund", reflect.TypeOf((*MockPaymentProvider)(nil).Refund), ctx, paymentID, amount, idempotencyKey)
+}
+
+// MockNotifier is a mock of Notifier interface.
+type MockNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotifierMockRecorder
+	isgomock struct{}
+}
+
+// MockNotifierMockRecorder is the mock recorder for MockNotifier.
+type MockNotifierMockRecorder struct {
+	mock *MockNotifier
+}
+
+// NewMockNotifier creates a new mock instance.
+func NewMockNotifier(ctrl *gomock.Controller) *MockNotifier {
+	mock := &MockNotifier{ctrl: ctrl}
+	mock.recorder = &MockNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotifier) EXPECT() *MockNotifierMockRecorder {
+	return m.recorder
+}
+
+// Notify mocks base method.
+func (m *MockNotifier) Notify(ctx context.Context, notification *models.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notify", ctx, notification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Notify indicates an expected call of Notify.
+func (mr *MockNotifierMockRecorder) Notify(ctx, notification any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReceipt", reflect.TypeOf((*MockIPaymentRepo)(nil).UpdateReceipt), ctx, id, isVerified)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockNotifier)(nil).Notify), ctx, notification)
 }
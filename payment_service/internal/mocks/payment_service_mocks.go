@@ -14,6 +14,7 @@ import (
 	models "paymentservice/internal/models"
 	reflect "reflect"
 
+	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -41,6 +42,141 @@ func (m *MockPaymentService) EXPECT() *MockPaymentServiceMockRecorder {
 	return m.recorder
 }
 
+// ApproveReceipt mocks base method.
+func (m *MockPaymentService) ApproveReceipt(ctx context.Context, input *models.ReviewReceiptInput) (*models.PaymentReceipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveReceipt", ctx, input)
+	ret0, _ := ret[0].(*models.PaymentReceipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApproveReceipt indicates an expected call of ApproveReceipt.
+func (mr *MockPaymentServiceMockRecorder) ApproveReceipt(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveReceipt", reflect.TypeOf((*MockPaymentService)(nil).ApproveReceipt), ctx, input)
+}
+
+// CreateInvoice mocks base method.
+func (m *MockPaymentService) CreateInvoice(ctx context.Context, input *models.CreateInvoiceInput) (*models.Invoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInvoice", ctx, input)
+	ret0, _ := ret[0].(*models.Invoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInvoice indicates an expected call of CreateInvoice.
+func (mr *MockPaymentServiceMockRecorder) CreateInvoice(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInvoice", reflect.TypeOf((*MockPaymentService)(nil).CreateInvoice), ctx, input)
+}
+
+// CreateOnlinePayment mocks base method.
+func (m *MockPaymentService) CreateOnlinePayment(ctx context.Context, input *models.CreateOnlinePaymentInput) (*models.OnlinePayment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOnlinePayment", ctx, input)
+	ret0, _ := ret[0].(*models.OnlinePayment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOnlinePayment indicates an expected call of CreateOnlinePayment.
+func (mr *MockPaymentServiceMockRecorder) CreateOnlinePayment(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOnlinePayment", reflect.TypeOf((*MockPaymentService)(nil).CreateOnlinePayment), ctx, input)
+}
+
+// CreatePackage mocks base method.
+func (m *MockPaymentService) CreatePackage(ctx context.Context, input *models.CreatePackageInput) (*models.LessonPackage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePackage", ctx, input)
+	ret0, _ := ret[0].(*models.LessonPackage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePackage indicates an expected call of CreatePackage.
+func (mr *MockPaymentServiceMockRecorder) CreatePackage(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePackage", reflect.TypeOf((*MockPaymentService)(nil).CreatePackage), ctx, input)
+}
+
+// ExportUserData mocks base method.
+func (m *MockPaymentService) ExportUserData(ctx context.Context, userID uuid.UUID) (*models.UserDataExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportUserData", ctx, userID)
+	ret0, _ := ret[0].(*models.UserDataExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportUserData indicates an expected call of ExportUserData.
+func (mr *MockPaymentServiceMockRecorder) ExportUserData(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportUserData", reflect.TypeOf((*MockPaymentService)(nil).ExportUserData), ctx, userID)
+}
+
+// GetBalance mocks base method.
+func (m *MockPaymentService) GetBalance(ctx context.Context, input *models.GetBalanceInput) (*models.Balance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalance", ctx, input)
+	ret0, _ := ret[0].(*models.Balance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBalance indicates an expected call of GetBalance.
+func (mr *MockPaymentServiceMockRecorder) GetBalance(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockPaymentService)(nil).GetBalance), ctx, input)
+}
+
+// GetEarningsReport mocks base method.
+func (m *MockPaymentService) GetEarningsReport(ctx context.Context, input *models.GetEarningsReportInput) (*models.EarningsReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEarningsReport", ctx, input)
+	ret0, _ := ret[0].(*models.EarningsReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEarningsReport indicates an expected call of GetEarningsReport.
+func (mr *MockPaymentServiceMockRecorder) GetEarningsReport(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEarningsReport", reflect.TypeOf((*MockPaymentService)(nil).GetEarningsReport), ctx, input)
+}
+
+// GetInvoiceFile mocks base method.
+func (m *MockPaymentService) GetInvoiceFile(ctx context.Context, input *models.GetInvoiceFileInput) (*models.InvoiceFileUrl, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInvoiceFile", ctx, input)
+	ret0, _ := ret[0].(*models.InvoiceFileUrl)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInvoiceFile indicates an expected call of GetInvoiceFile.
+func (mr *MockPaymentServiceMockRecorder) GetInvoiceFile(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInvoiceFile", reflect.TypeOf((*MockPaymentService)(nil).GetInvoiceFile), ctx, input)
+}
+
+// GetOutstandingReport mocks base method.
+func (m *MockPaymentService) GetOutstandingReport(ctx context.Context) (*models.OutstandingReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOutstandingReport", ctx)
+	ret0, _ := ret[0].(*models.OutstandingReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOutstandingReport indicates an expected call of GetOutstandingReport.
+func (mr *MockPaymentServiceMockRecorder) GetOutstandingReport(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutstandingReport", reflect.TypeOf((*MockPaymentService)(nil).GetOutstandingReport), ctx)
+}
+
 // GetPaymentInfo mocks base method.
 func (m *MockPaymentService) GetPaymentInfo(ctx context.Context, input *models.GetPaymentInfoInput) (*models.PaymentInfo, error) {
 	m.ctrl.T.Helper()
@@ -86,32 +222,151 @@ func (mr *MockPaymentServiceMockRecorder) GetReceiptFile(ctx, input any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceiptFile", reflect.TypeOf((*MockPaymentService)(nil).GetReceiptFile), ctx, input)
 }
 
-// SubmitPaymentReceipt mocks base method.
-func (m *MockPaymentService) SubmitPaymentReceipt(ctx context.Context, input *models.SubmitPaymentReceiptInput) (*models.PaymentReceipt, error) {
+// HandleProviderWebhook mocks base method.
+func (m *MockPaymentService) HandleProviderWebhook(ctx context.Context, input *models.ProviderWebhook) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SubmitPaymentReceipt", ctx, input)
+	ret := m.ctrl.Call(m, "HandleProviderWebhook", ctx, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HandleProviderWebhook indicates an expected call of HandleProviderWebhook.
+func (mr *MockPaymentServiceMockRecorder) HandleProviderWebhook(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleProviderWebhook", reflect.TypeOf((*MockPaymentService)(nil).HandleProviderWebhook), ctx, input)
+}
+
+// ListInvoices mocks base method.
+func (m *MockPaymentService) ListInvoices(ctx context.Context, input *models.ListInvoicesInput) ([]*models.Invoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInvoices", ctx, input)
+	ret0, _ := ret[0].([]*models.Invoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInvoices indicates an expected call of ListInvoices.
+func (mr *MockPaymentServiceMockRecorder) ListInvoices(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInvoices", reflect.TypeOf((*MockPaymentService)(nil).ListInvoices), ctx, input)
+}
+
+// ListLedgerEntries mocks base method.
+func (m *MockPaymentService) ListLedgerEntries(ctx context.Context, input *models.ListLedgerEntriesInput) ([]*models.LedgerEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLedgerEntries", ctx, input)
+	ret0, _ := ret[0].([]*models.LedgerEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLedgerEntries indicates an expected call of ListLedgerEntries.
+func (mr *MockPaymentServiceMockRecorder) ListLedgerEntries(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLedgerEntries", reflect.TypeOf((*MockPaymentService)(nil).ListLedgerEntries), ctx, input)
+}
+
+// ListPackages mocks base method.
+func (m *MockPaymentService) ListPackages(ctx context.Context, input *models.ListPackagesInput) ([]*models.LessonPackage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPackages", ctx, input)
+	ret0, _ := ret[0].([]*models.LessonPackage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPackages indicates an expected call of ListPackages.
+func (mr *MockPaymentServiceMockRecorder) ListPackages(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPackages", reflect.TypeOf((*MockPaymentService)(nil).ListPackages), ctx, input)
+}
+
+// ListReceipts mocks base method.
+func (m *MockPaymentService) ListReceipts(ctx context.Context, input *models.ListReceiptsInput) ([]*models.PaymentReceipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReceipts", ctx, input)
+	ret0, _ := ret[0].([]*models.PaymentReceipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReceipts indicates an expected call of ListReceipts.
+func (mr *MockPaymentServiceMockRecorder) ListReceipts(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReceipts", reflect.TypeOf((*MockPaymentService)(nil).ListReceipts), ctx, input)
+}
+
+// ListRefunds mocks base method.
+func (m *MockPaymentService) ListRefunds(ctx context.Context, input *models.ListRefundsInput) ([]*models.Refund, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRefunds", ctx, input)
+	ret0, _ := ret[0].([]*models.Refund)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRefunds indicates an expected call of ListRefunds.
+func (mr *MockPaymentServiceMockRecorder) ListRefunds(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRefunds", reflect.TypeOf((*MockPaymentService)(nil).ListRefunds), ctx, input)
+}
+
+// PurchasePackage mocks base method.
+func (m *MockPaymentService) PurchasePackage(ctx context.Context, input *models.PurchasePackageInput) (*models.PackagePurchase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurchasePackage", ctx, input)
+	ret0, _ := ret[0].(*models.PackagePurchase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurchasePackage indicates an expected call of PurchasePackage.
+func (mr *MockPaymentServiceMockRecorder) PurchasePackage(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurchasePackage", reflect.TypeOf((*MockPaymentService)(nil).PurchasePackage), ctx, input)
+}
+
+// RefundLesson mocks base method.
+func (m *MockPaymentService) RefundLesson(ctx context.Context, input *models.RefundLessonInput) (*models.Refund, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundLesson", ctx, input)
+	ret0, _ := ret[0].(*models.Refund)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefundLesson indicates an expected call of RefundLesson.
+func (mr *MockPaymentServiceMockRecorder) RefundLesson(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundLesson", reflect.TypeOf((*MockPaymentService)(nil).RefundLesson), ctx, input)
+}
+
+// RejectReceipt mocks base method.
+func (m *MockPaymentService) RejectReceipt(ctx context.Context, input *models.RejectReceiptInput) (*models.PaymentReceipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectReceipt", ctx, input)
 	ret0, _ := ret[0].(*models.PaymentReceipt)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SubmitPaymentReceipt indicates an expected call of SubmitPaymentReceipt.
-func (mr *MockPaymentServiceMockRecorder) SubmitPaymentReceipt(ctx, input any) *gomock.Call {
+// RejectReceipt indicates an expected call of RejectReceipt.
+func (mr *MockPaymentServiceMockRecorder) RejectReceipt(ctx, input any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitPaymentReceipt", reflect.TypeOf((*MockPaymentService)(nil).SubmitPaymentReceipt), ctx, input)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectReceipt", reflect.TypeOf((*MockPaymentService)(nil).RejectReceipt), ctx, input)
 }
 
-// VerifyReceipt mocks base method.
-func (m *MockPaymentService) VerifyReceipt(ctx context.Context, input *models.VerifyReceiptInput) (*models.PaymentReceipt, error) {
+// SubmitPaymentReceipt mocks base method.
+func (m *MockPaymentService) SubmitPaymentReceipt(ctx context.Context, input *models.SubmitPaymentReceiptInput) (*models.PaymentReceipt, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "VerifyReceipt", ctx, input)
+	ret := m.ctrl.Call(m, "SubmitPaymentReceipt", ctx, input)
 	ret0, _ := ret[0].(*models.PaymentReceipt)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// VerifyReceipt indicates an expected call of VerifyReceipt.
-func (mr *MockPaymentServiceMockRecorder) VerifyReceipt(ctx, input any) *gomock.Call {
+// SubmitPaymentReceipt indicates an expected call of SubmitPaymentReceipt.
+func (mr *MockPaymentServiceMockRecorder) SubmitPaymentReceipt(ctx, input any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyReceipt", reflect.TypeOf((*MockPaymentService)(nil).VerifyReceipt), ctx, input)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitPaymentReceipt", reflect.TypeOf((*MockPaymentService)(nil).SubmitPaymentReceipt), ctx, input)
 }
@@ -142,6 +142,26 @@ func (mr *MockScheduleServiceClientMockRecorder) GetLesson(ctx, req any, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLesson", reflect.TypeOf((*MockScheduleServiceClient)(nil).GetLesson), varargs...)
 }
 
+// GetLessonInternal mocks base method.
+func (m *MockScheduleServiceClient) GetLessonInternal(ctx context.Context, req *api.GetLessonRequest, opts ...grpc.CallOption) (*api.Lesson, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, req}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetLessonInternal", varargs...)
+	ret0, _ := ret[0].(*api.Lesson)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLessonInternal indicates an expected call of GetLessonInternal.
+func (mr *MockScheduleServiceClientMockRecorder) GetLessonInternal(ctx, req any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, req}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLessonInternal", reflect.TypeOf((*MockScheduleServiceClient)(nil).GetLessonInternal), varargs...)
+}
+
 // GetSlot mocks base method.
 func (m *MockScheduleServiceClient) GetSlot(ctx context.Context, req *api.GetSlotRequest, opts ...grpc.CallOption) (*api.Slot, error) {
 	m.ctrl.T.Helper()
@@ -262,6 +282,46 @@ func (mr *MockScheduleServiceClientMockRecorder) ListSlotsByTutor(ctx, req any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSlotsByTutor", reflect.TypeOf((*MockScheduleServiceClient)(nil).ListSlotsByTutor), varargs...)
 }
 
+// MarkAsPaid mocks base method.
+func (m *MockScheduleServiceClient) MarkAsPaid(ctx context.Context, req *api.MarkAsPaidRequest, opts ...grpc.CallOption) (*api.Lesson, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, req}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MarkAsPaid", varargs...)
+	ret0, _ := ret[0].(*api.Lesson)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkAsPaid indicates an expected call of MarkAsPaid.
+func (mr *MockScheduleServiceClientMockRecorder) MarkAsPaid(ctx, req any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, req}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAsPaid", reflect.TypeOf((*MockScheduleServiceClient)(nil).MarkAsPaid), varargs...)
+}
+
+// MarkAsUnpaid mocks base method.
+func (m *MockScheduleServiceClient) MarkAsUnpaid(ctx context.Context, req *api.MarkAsUnpaidRequest, opts ...grpc.CallOption) (*api.Lesson, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, req}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MarkAsUnpaid", varargs...)
+	ret0, _ := ret[0].(*api.Lesson)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkAsUnpaid indicates an expected call of MarkAsUnpaid.
+func (mr *MockScheduleServiceClientMockRecorder) MarkAsUnpaid(ctx, req any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, req}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAsUnpaid", reflect.TypeOf((*MockScheduleServiceClient)(nil).MarkAsUnpaid), varargs...)
+}
+
 // UpdateLesson mocks base method.
 func (m *MockScheduleServiceClient) UpdateLesson(ctx context.Context, req *api.UpdateLessonRequest, opts ...grpc.CallOption) (*api.Lesson, error) {
 	m.ctrl.T.Helper()
@@ -122,6 +122,26 @@ func (mr *MockUserServiceClientMockRecorder) DeleteTutorStudent(ctx, req any, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTutorStudent", reflect.TypeOf((*MockUserServiceClient)(nil).DeleteTutorStudent), varargs...)
 }
 
+// GetGuardianStudent mocks base method.
+func (m *MockUserServiceClient) GetGuardianStudent(ctx context.Context, req *api.GetGuardianStudentRequest, opts ...grpc.CallOption) (*api.GuardianStudent, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, req}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetGuardianStudent", varargs...)
+	ret0, _ := ret[0].(*api.GuardianStudent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGuardianStudent indicates an expected call of GetGuardianStudent.
+func (mr *MockUserServiceClientMockRecorder) GetGuardianStudent(ctx, req any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, req}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGuardianStudent", reflect.TypeOf((*MockUserServiceClient)(nil).GetGuardianStudent), varargs...)
+}
+
 // GetMe mocks base method.
 func (m *MockUserServiceClient) GetMe(ctx context.Context, req *api.Empty, opts ...grpc.CallOption) (*api.User, error) {
 	m.ctrl.T.Helper()
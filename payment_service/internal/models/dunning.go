@@ -0,0 +1,56 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type DunningRecipient string
+
+const (
+	DunningRecipientStudent DunningRecipient = "student"
+	DunningRecipientTutor   DunningRecipient = "tutor"
+)
+
+func (r DunningRecipient) String() string {
+	return string(r)
+}
+
+// DunningStep is a sent notification about an overdue lesson. Reminders to the student are numbered from 1,
+// the notice to the tutor follows the last reminder.
+type DunningStep struct {
+	ID        uuid.UUID
+	LessonID  uuid.UUID
+	TutorID   uuid.UUID
+	StudentID uuid.UUID
+	Step      int32
+	Recipient DunningRecipient
+	SentAt    time.Time
+}
+
+type DunningStepCreateInput struct {
+	ID        uuid.UUID
+	LessonID  uuid.UUID
+	TutorID   uuid.UUID
+	StudentID uuid.UUID
+	Step      int32
+	Recipient DunningRecipient
+}
+
+type NotificationKind string
+
+const (
+	// NotificationPaymentReminder asks the student to pay for a lesson
+	NotificationPaymentReminder NotificationKind = "payment_reminder"
+	// NotificationPaymentOverdue tells the tutor that the student ignored all reminders
+	NotificationPaymentOverdue NotificationKind = "payment_overdue"
+)
+
+// Notification is a message to a user delivered by a Notifier.
+type Notification struct {
+	UserID   uuid.UUID
+	Kind     NotificationKind
+	LessonID uuid.UUID
+	Text     string
+}
@@ -1,6 +1,11 @@
 package models
 
-import "github.com/google/uuid"
+import (
+	"common_library/money"
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type GetPaymentInfoInput struct {
 	LessonId uuid.UUID
@@ -15,10 +20,86 @@ type GetReceiptInput struct {
 	ReceiptId uuid.UUID
 }
 
-type VerifyReceiptInput struct {
+type GetReceiptFileInput struct {
 	ReceiptId uuid.UUID
 }
 
-type GetReceiptFileInput struct {
+type ReviewReceiptInput struct {
 	ReceiptId uuid.UUID
 }
+
+type RejectReceiptInput struct {
+	ReceiptId uuid.UUID
+	Reason    string
+}
+
+type ListReceiptsInput struct {
+	TutorId   *uuid.UUID
+	StudentId *uuid.UUID
+	Status    *ReceiptStatus
+}
+
+type GetBalanceInput struct {
+	TutorId   uuid.UUID
+	StudentId uuid.UUID
+}
+
+type ListLedgerEntriesInput struct {
+	TutorId   uuid.UUID
+	StudentId uuid.UUID
+	Limit     int32
+}
+
+type CreatePackageInput struct {
+	StudentId   uuid.UUID
+	Name        string
+	LessonCount int32
+	Price       money.Money
+}
+
+type ListPackagesInput struct {
+	TutorId   uuid.UUID
+	StudentId uuid.UUID
+}
+
+type PurchasePackageInput struct {
+	PackageId uuid.UUID
+}
+
+type CreateInvoiceInput struct {
+	StudentId   uuid.UUID
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+	// Currency defaults to the currency of the tutor profile
+	Currency *money.Currency
+}
+
+type ListInvoicesInput struct {
+	TutorId   *uuid.UUID
+	StudentId *uuid.UUID
+}
+
+type GetInvoiceFileInput struct {
+	InvoiceId uuid.UUID
+}
+
+type GetEarningsReportInput struct {
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+}
+
+type CreateOnlinePaymentInput struct {
+	LessonId  uuid.UUID
+	ReturnUrl string
+}
+
+type RefundLessonInput struct {
+	LessonId uuid.UUID
+	Kind     RefundKind
+	Reason   *string
+}
+
+type ListRefundsInput struct {
+	TutorId   *uuid.UUID
+	StudentId *uuid.UUID
+}
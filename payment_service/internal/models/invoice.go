@@ -0,0 +1,82 @@
+package models
+
+import (
+	"common_library/money"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type InvoiceLineKind string
+
+const (
+	// InvoiceLineLesson is a completed lesson
+	InvoiceLineLesson InvoiceLineKind = "lesson"
+	// InvoiceLineLateCancel is a lesson cancelled shortly before its start, which is charged as held
+	InvoiceLineLateCancel InvoiceLineKind = "late_cancel"
+)
+
+func (k InvoiceLineKind) String() string {
+	return string(k)
+}
+
+// Invoice is a statement of lessons of the pair over a period.
+type Invoice struct {
+	ID             uuid.UUID
+	TutorID        uuid.UUID
+	StudentID      uuid.UUID
+	Number         int32
+	PeriodStart    time.Time
+	PeriodEnd      time.Time
+	TotalMinor     int64
+	PaidMinor      int64
+	Currency       money.Currency
+	PaymentDetails *string
+	// FileID is empty until the PDF is stored in file_service
+	FileID    *uuid.UUID
+	CreatedAt time.Time
+}
+
+type InvoiceLine struct {
+	LessonID    uuid.UUID
+	Kind        InvoiceLineKind
+	StartsAt    time.Time
+	AmountMinor int64
+	IsPaid      bool
+}
+
+func (i *Invoice) Total() money.Money {
+	return money.New(i.TotalMinor, i.Currency)
+}
+
+func (i *Invoice) Paid() money.Money {
+	return money.New(i.PaidMinor, i.Currency)
+}
+
+type InvoiceCreateInput struct {
+	ID             uuid.UUID
+	TutorID        uuid.UUID
+	StudentID      uuid.UUID
+	PeriodStart    time.Time
+	PeriodEnd      time.Time
+	Currency       money.Currency
+	PaymentDetails *string
+	Lines          []InvoiceLine
+}
+
+type InvoiceFilter struct {
+	TutorID   *uuid.UUID
+	StudentID *uuid.UUID
+}
+
+// InvoiceDocument is everything printed on the invoice PDF.
+type InvoiceDocument struct {
+	Invoice     *Invoice
+	Lines       []InvoiceLine
+	TutorName   string
+	StudentName string
+}
+
+type InvoiceFileUrl struct {
+	URL string
+}
@@ -0,0 +1,115 @@
+package models
+
+import (
+	"common_library/money"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LedgerAccount is a side of a double-entry transaction. Each tutor–student pair has its own set of accounts.
+type LedgerAccount string
+
+const (
+	// LedgerAccountStudent is the balance of the student with the tutor
+	LedgerAccountStudent LedgerAccount = "student"
+	// LedgerAccountTutor is the revenue of the tutor from the student
+	LedgerAccountTutor LedgerAccount = "tutor"
+	// LedgerAccountExternal is money received outside of the system
+	LedgerAccountExternal LedgerAccount = "external"
+)
+
+func (a LedgerAccount) String() string {
+	return string(a)
+}
+
+type LedgerTransactionKind string
+
+const (
+	// LedgerKindReceiptPayment credits the student by an approved receipt
+	LedgerKindReceiptPayment LedgerTransactionKind = "receipt_payment"
+	// LedgerKindPackagePurchase credits the student by a purchased package
+	LedgerKindPackagePurchase LedgerTransactionKind = "package_purchase"
+	// LedgerKindLessonCharge charges the student for a lesson
+	LedgerKindLessonCharge LedgerTransactionKind = "lesson_charge"
+	// LedgerKindOnlinePayment credits the student by a payment through a payment provider
+	LedgerKindOnlinePayment LedgerTransactionKind = "online_payment"
+	// LedgerKindCreditNote reverses the charge of a refunded lesson
+	LedgerKindCreditNote LedgerTransactionKind = "credit_note"
+	// LedgerKindRefund returns the credit of a refunded lesson to the student
+	LedgerKindRefund LedgerTransactionKind = "refund"
+)
+
+func (k LedgerTransactionKind) String() string {
+	return string(k)
+}
+
+// LedgerPosting is an entry of a new transaction. Debit is positive, credit is negative.
+type LedgerPosting struct {
+	Account     LedgerAccount
+	AmountMinor int64
+}
+
+// LedgerTransactionCreateInput is a new transaction. Postings are in Currency and must sum to zero.
+type LedgerTransactionCreateInput struct {
+	ID          uuid.UUID
+	TutorID     uuid.UUID
+	StudentID   uuid.UUID
+	Kind        LedgerTransactionKind
+	ReferenceID uuid.UUID
+	Currency    money.Currency
+	Postings    []LedgerPosting
+}
+
+type LedgerTransaction struct {
+	ID                uuid.UUID
+	TutorID           uuid.UUID
+	StudentID         uuid.UUID
+	Kind              LedgerTransactionKind
+	ReferenceID       uuid.UUID
+	Currency          money.Currency
+	PackagePurchaseID *uuid.UUID
+	CreatedAt         time.Time
+}
+
+// LessonCharge is the charge transaction of a lesson with the charged amount.
+type LessonCharge struct {
+	LedgerTransaction
+	AmountMinor int64
+}
+
+func (c *LessonCharge) Amount() money.Money {
+	return money.New(c.AmountMinor, c.Currency)
+}
+
+// LedgerEntry is a change of the student balance. AmountMinor is positive for credits and negative for charges.
+type LedgerEntry struct {
+	ID            uuid.UUID
+	TransactionID uuid.UUID
+	Kind          LedgerTransactionKind
+	ReferenceID   uuid.UUID
+	AmountMinor   int64
+	Currency      money.Currency
+	CreatedAt     time.Time
+}
+
+func (e *LedgerEntry) Amount() money.Money {
+	return money.New(e.AmountMinor, e.Currency)
+}
+
+// Balance of the student with the tutor, one amount per currency. A negative amount is a debt of the student.
+type Balance struct {
+	TutorID            uuid.UUID
+	StudentID          uuid.UUID
+	Balances           []money.Money
+	PackageLessonsLeft int32
+}
+
+// LessonChargeInput charges the student for a completed lesson.
+type LessonChargeInput struct {
+	ID        uuid.UUID
+	LessonID  uuid.UUID
+	TutorID   uuid.UUID
+	StudentID uuid.UUID
+	Price     money.Money
+}
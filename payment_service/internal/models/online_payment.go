@@ -0,0 +1,90 @@
+package models
+
+import (
+	"common_library/money"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type OnlinePaymentStatus string
+
+const (
+	OnlinePaymentStatusPending   OnlinePaymentStatus = "pending"
+	OnlinePaymentStatusSucceeded OnlinePaymentStatus = "succeeded"
+	OnlinePaymentStatusCanceled  OnlinePaymentStatus = "canceled"
+	// OnlinePaymentStatusRefunded is a succeeded payment of a refunded lesson or a canceled payment the student paid anyway
+	OnlinePaymentStatusRefunded OnlinePaymentStatus = "refunded"
+)
+
+func (s OnlinePaymentStatus) String() string {
+	return string(s)
+}
+
+// OnlinePayment is a payment for a lesson through a payment provider.
+type OnlinePayment struct {
+	ID                uuid.UUID
+	Provider          string
+	ProviderPaymentID string
+	LessonID          uuid.UUID
+	TutorID           uuid.UUID
+	StudentID         uuid.UUID
+	AmountMinor       int64
+	Currency          money.Currency
+	Status            OnlinePaymentStatus
+	ConfirmationURL   *string
+	CreatedAt         time.Time
+	EditedAt          time.Time
+}
+
+func (p *OnlinePayment) Amount() money.Money {
+	return money.New(p.AmountMinor, p.Currency)
+}
+
+type OnlinePaymentCreateInput struct {
+	ID                uuid.UUID
+	Provider          string
+	ProviderPaymentID string
+	LessonID          uuid.UUID
+	TutorID           uuid.UUID
+	StudentID         uuid.UUID
+	AmountMinor       int64
+	Currency          money.Currency
+	ConfirmationURL   string
+}
+
+// ProviderPaymentInput is a payment to create in a payment provider.
+type ProviderPaymentInput struct {
+	// IdempotencyKey makes retries of the same request create a single payment
+	IdempotencyKey string
+	Amount         money.Money
+	Description    string
+	// ReturnURL is where the provider redirects the student after the payment
+	ReturnURL string
+	Metadata  map[string]string
+}
+
+// ProviderPayment is a payment as reported by a payment provider.
+type ProviderPayment struct {
+	ID              string
+	Status          OnlinePaymentStatus
+	Amount          money.Money
+	ConfirmationURL string
+	Metadata        map[string]string
+}
+
+// ProviderRefund is a refund as reported by a payment provider.
+type ProviderRefund struct {
+	ID        string
+	PaymentID string
+	Status    string
+	Amount    money.Money
+}
+
+// ProviderWebhook is a raw notification from a payment provider.
+type ProviderWebhook struct {
+	Provider string
+	Body     []byte
+	// Headers have lower case names
+	Headers map[string]string
+}
@@ -0,0 +1,70 @@
+package models
+
+import (
+	"common_library/money"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LessonPackage is an offer of several prepaid lessons made by the tutor to the student.
+type LessonPackage struct {
+	ID          uuid.UUID
+	TutorID     uuid.UUID
+	StudentID   uuid.UUID
+	Name        string
+	LessonCount int32
+	PriceMinor  int64
+	Currency    money.Currency
+	CreatedAt   time.Time
+}
+
+type LessonPackageCreateInput struct {
+	ID          uuid.UUID
+	TutorID     uuid.UUID
+	StudentID   uuid.UUID
+	Name        string
+	LessonCount int32
+	PriceMinor  int64
+	Currency    money.Currency
+}
+
+// PackagePurchase is a paid package. Its lessons are consumed one by one as lessons of the pair complete.
+type PackagePurchase struct {
+	ID           uuid.UUID
+	PackageID    uuid.UUID
+	TutorID      uuid.UUID
+	StudentID    uuid.UUID
+	LessonsTotal int32
+	LessonsUsed  int32
+	PriceMinor   int64
+	Currency     money.Currency
+	CreatedAt    time.Time
+}
+
+type PackagePurchaseCreateInput struct {
+	ID           uuid.UUID
+	PackageID    uuid.UUID
+	TutorID      uuid.UUID
+	StudentID    uuid.UUID
+	LessonsTotal int32
+	PriceMinor   int64
+	Currency     money.Currency
+}
+
+func (p *LessonPackage) Price() money.Money {
+	return money.New(p.PriceMinor, p.Currency)
+}
+
+func (p *PackagePurchase) Price() money.Money {
+	return money.New(p.PriceMinor, p.Currency)
+}
+
+// NextLessonPrice returns the share of the package price charged for the next lesson.
+// Shares are rounded so that all lessons of the package sum up to its price exactly.
+func (p *PackagePurchase) NextLessonPrice() money.Money {
+	price := p.PriceMinor
+	total := int64(p.LessonsTotal)
+	used := int64(p.LessonsUsed)
+	return money.New(price*(used+1)/total-price*used/total, p.Currency)
+}
@@ -1,9 +1,14 @@
 package models
 
-import "github.com/google/uuid"
+import (
+	"common_library/money"
+
+	"github.com/google/uuid"
+)
 
 type PaymentInfo struct {
-	LessonID       uuid.UUID
-	PriceRUB       int32
+	LessonID uuid.UUID
+	// Price is empty if the lesson has no price
+	Price          *money.Money
 	PaymentDetails string
 }
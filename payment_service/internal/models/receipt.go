@@ -1,30 +1,73 @@
 package models
 
 import (
+	"common_library/money"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+type ReceiptStatus string
+
+const (
+	ReceiptStatusPending  ReceiptStatus = "pending"
+	ReceiptStatusApproved ReceiptStatus = "approved"
+	ReceiptStatusRejected ReceiptStatus = "rejected"
+	// ReceiptStatusRefunded is an approved receipt of a refunded lesson
+	ReceiptStatusRefunded ReceiptStatus = "refunded"
+)
+
+func (s ReceiptStatus) String() string {
+	return string(s)
+}
+
+func (s ReceiptStatus) IsValid() bool {
+	return s == ReceiptStatusPending || s == ReceiptStatusApproved || s == ReceiptStatusRejected || s == ReceiptStatusRefunded
+}
+
 type PaymentReceipt struct {
-	ID         uuid.UUID
-	LessonID   uuid.UUID
-	FileID     uuid.UUID
-	IsVerified bool
-	CreatedAt  time.Time
-	EditedAt   time.Time
+	ID              uuid.UUID
+	LessonID        uuid.UUID
+	FileID          uuid.UUID
+	Status          ReceiptStatus
+	RejectionReason *string
+	// TutorID and StudentID are empty for receipts created before the review workflow
+	TutorID   *uuid.UUID
+	StudentID *uuid.UUID
+	// AmountMinor and Currency are the price of the lesson at submission, empty for receipts created before the ledger
+	AmountMinor *int64
+	Currency    *money.Currency
+	CreatedAt   time.Time
+	EditedAt    time.Time
+}
+
+// Amount returns the price of the lesson at submission or nil for old receipts.
+func (r *PaymentReceipt) Amount() *money.Money {
+	if r.AmountMinor == nil || r.Currency == nil {
+		return nil
+	}
+	m := money.New(*r.AmountMinor, *r.Currency)
+	return &m
 }
 
 type PaymentReceiptCreateInput struct {
-	ID         uuid.UUID
-	LessonID   uuid.UUID
-	FileID     uuid.UUID
-	IsVerified bool
+	ID        uuid.UUID
+	LessonID  uuid.UUID
+	FileID    uuid.UUID
+	TutorID   uuid.UUID
+	StudentID uuid.UUID
+	Amount    money.Money
+}
+
+type PaymentReceiptReviewInput struct {
+	Status          ReceiptStatus
+	RejectionReason *string
 }
 
-type PaymentReceiptUpdateInput struct {
-	ID         uuid.UUID
-	IsVerified bool
+type ReceiptFilter struct {
+	TutorID   *uuid.UUID
+	StudentID *uuid.UUID
+	Status    *ReceiptStatus
 }
 
 type ReceiptFileUrl struct {
@@ -0,0 +1,68 @@
+package models
+
+import (
+	"common_library/money"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RefundKind string
+
+const (
+	// RefundKindCreditNote leaves the lesson price on the student balance for future lessons
+	RefundKindCreditNote RefundKind = "credit_note"
+	// RefundKindRefund returns the lesson price to the student
+	RefundKindRefund RefundKind = "refund"
+)
+
+func (k RefundKind) String() string {
+	return string(k)
+}
+
+func (k RefundKind) IsValid() bool {
+	return k == RefundKindCreditNote || k == RefundKindRefund
+}
+
+// Refund reverses the payment of a cancelled lesson. At most one refund exists per lesson.
+type Refund struct {
+	ID          uuid.UUID
+	LessonID    uuid.UUID
+	TutorID     uuid.UUID
+	StudentID   uuid.UUID
+	Kind        RefundKind
+	AmountMinor int64
+	Currency    money.Currency
+	Reason      *string
+	// ReceiptID and OnlinePaymentID are the payment of the lesson, empty if it was paid from the balance
+	ReceiptID       *uuid.UUID
+	OnlinePaymentID *uuid.UUID
+	// PackagePurchaseID is the package the lesson is returned to
+	PackagePurchaseID *uuid.UUID
+	// ProviderRefundID is set when the money is returned through the payment provider
+	ProviderRefundID *string
+	CreatedAt        time.Time
+}
+
+func (r *Refund) Amount() money.Money {
+	return money.New(r.AmountMinor, r.Currency)
+}
+
+type RefundCreateInput struct {
+	ID                uuid.UUID
+	LessonID          uuid.UUID
+	TutorID           uuid.UUID
+	StudentID         uuid.UUID
+	Kind              RefundKind
+	Amount            money.Money
+	Reason            *string
+	ReceiptID         *uuid.UUID
+	OnlinePaymentID   *uuid.UUID
+	PackagePurchaseID *uuid.UUID
+	ProviderRefundID  *string
+}
+
+type RefundFilter struct {
+	TutorID   *uuid.UUID
+	StudentID *uuid.UUID
+}
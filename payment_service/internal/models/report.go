@@ -0,0 +1,66 @@
+package models
+
+import (
+	"common_library/money"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CurrencyEarnings aggregates completed lessons priced in one currency. Amounts are in minor units.
+type CurrencyEarnings struct {
+	Currency         money.Currency
+	LessonsCount     int32
+	EarnedMinor      int64
+	PaidMinor        int64
+	OutstandingMinor int64
+}
+
+// EarningsSummary aggregates completed lessons of a tutor.
+type EarningsSummary struct {
+	LessonsCount int32
+	// UnpricedLessonsCount is the number of lessons without a price, they add nothing to the amounts
+	UnpricedLessonsCount int32
+	// ByCurrency has an item per currency of the priced lessons, sorted by currency code
+	ByCurrency []CurrencyEarnings
+	// AvgDaysToPay is the average time from the end of a lesson to the approval of its receipt,
+	// empty if no lesson was paid by a receipt
+	AvgDaysToPay *float64
+}
+
+type MonthlyEarnings struct {
+	MonthStart time.Time
+	EarningsSummary
+}
+
+type StudentEarnings struct {
+	StudentID   uuid.UUID
+	StudentName string
+	EarningsSummary
+}
+
+// EarningsReport covers lessons that start within [PeriodStart, PeriodEnd).
+type EarningsReport struct {
+	TutorID     uuid.UUID
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+	Total       EarningsSummary
+	Months      []MonthlyEarnings
+	Students    []StudentEarnings
+}
+
+type StudentOutstanding struct {
+	StudentID     uuid.UUID
+	StudentName   string
+	UnpaidLessons int32
+	// Outstanding has an amount per currency of the unpaid lessons
+	Outstanding    []money.Money
+	OldestUnpaidAt time.Time
+}
+
+// OutstandingReport covers all completed unpaid lessons of the tutor.
+type OutstandingReport struct {
+	TutorID     uuid.UUID
+	Outstanding []money.Money
+	Students    []StudentOutstanding
+}
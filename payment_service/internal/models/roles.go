@@ -5,6 +5,10 @@ type Role string
 const (
 	RoleStudent Role = "student"
 	RoleTutor   Role = "tutor"
+	// RoleGuardian pays for the lessons of linked students
+	RoleGuardian Role = "guardian"
+	// RoleAdmin can view all receipts, the access is recorded in the audit log
+	RoleAdmin Role = "admin"
 )
 
 func (r Role) String() string {
@@ -12,5 +16,5 @@ func (r Role) String() string {
 }
 
 func (r Role) IsValid() bool {
-	return r == RoleStudent || r == RoleTutor
+	return r == RoleStudent || r == RoleTutor || r == RoleGuardian || r == RoleAdmin
 }
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SagaState is a step of the receipt approval saga:
+//
+//	started --MarkAsPaid--> lesson_paid --approve receipt--> completed
+//	   |                         |
+//	   `------> compensating <---'  --MarkAsUnpaid--> compensated
+type SagaState string
+
+const (
+	SagaStateStarted      SagaState = "started"
+	SagaStateLessonPaid   SagaState = "lesson_paid"
+	SagaStateCompleted    SagaState = "completed"
+	SagaStateCompensating SagaState = "compensating"
+	SagaStateCompensated  SagaState = "compensated"
+)
+
+func (s SagaState) String() string {
+	return string(s)
+}
+
+func (s SagaState) IsFinal() bool {
+	return s == SagaStateCompleted || s == SagaStateCompensated
+}
+
+// ApprovalSaga keeps the progress of a receipt approval, which changes both the receipt and the lesson in schedule_service.
+type ApprovalSaga struct {
+	ID        uuid.UUID
+	ReceiptID uuid.UUID
+	LessonID  uuid.UUID
+	State     SagaState
+	Attempts  int
+	LastError *string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type ApprovalSagaCreateInput struct {
+	ID        uuid.UUID
+	ReceiptID uuid.UUID
+	LessonID  uuid.UUID
+}
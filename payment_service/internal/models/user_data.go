@@ -0,0 +1,8 @@
+package models
+
+// UserDataExport is what the payment service stores about a user, for the data export of user_service.
+type UserDataExport struct {
+	Receipts []*PaymentReceipt
+	Invoices []*Invoice
+	Refunds  []*Refund
+}
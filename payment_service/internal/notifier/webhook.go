@@ -0,0 +1,74 @@
+// Package notifier delivers notifications to users through an external delivery service, e.g. a Telegram bot.
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"paymentservice/internal/models"
+)
+
+// Webhook posts notifications as JSON to the delivery service, which resolves the user and sends the text.
+type Webhook struct {
+	url        string
+	token      string
+	httpClient *http.Client
+}
+
+// NewWebhook creates a notifier posting to url. A non-empty token is sent as a bearer token.
+func NewWebhook(url string, token string) (*Webhook, error) {
+	if url == "" {
+		return nil, errors.New("notification webhook url is required")
+	}
+	return &Webhook{
+		url:        url,
+		token:      token,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+type message struct {
+	UserID   string `json:"user_id"`
+	Kind     string `json:"kind"`
+	LessonID string `json:"lesson_id"`
+	Text     string `json:"text"`
+}
+
+func (w *Webhook) Notify(ctx context.Context, notification *models.Notification) error {
+	body, err := json.Marshal(&message{
+		UserID:   notification.UserID.String(),
+		Kind:     string(notification.Kind),
+		LessonID: notification.LessonID.String(),
+		Text:     notification.Text,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if w.token != "" {
+		req.Header.Set("Authorization", "Bearer "+w.token)
+	}
+
+	resp, err := w.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("notification request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("notification webhook returned %d: %s", resp.StatusCode, respBody)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"paymentservice/internal/models"
+)
+
+func TestNewWebhook_RequiresURL(t *testing.T) {
+	_, err := NewWebhook("", "token")
+	assert.Error(t, err)
+}
+
+func TestWebhook_Notify(t *testing.T) {
+	userID := uuid.New()
+	lessonID := uuid.New()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+
+		var msg map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&msg))
+		assert.Equal(t, map[string]string{
+			"user_id":   userID.String(),
+			"kind":      "payment_reminder",
+			"lesson_id": lessonID.String(),
+			"text":      "Урок не оплачен",
+		}, msg)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	webhook, err := NewWebhook(server.URL, "token")
+	require.NoError(t, err)
+
+	err = webhook.Notify(context.Background(), &models.Notification{
+		UserID:   userID,
+		Kind:     models.NotificationPaymentReminder,
+		LessonID: lessonID,
+		Text:     "Урок не оплачен",
+	})
+	assert.NoError(t, err)
+}
+
+func TestWebhook_Notify_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	webhook, err := NewWebhook(server.URL, "")
+	require.NoError(t, err)
+
+	err = webhook.Notify(context.Background(), &models.Notification{UserID: uuid.New(), LessonID: uuid.New()})
+	assert.Error(t, err)
+}
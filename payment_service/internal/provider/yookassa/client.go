@@ -0,0 +1,256 @@
+// Package yookassa is a payment provider adapter for the YooKassa HTTP API.
+package yookassa
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"common_library/money"
+	errdefs "paymentservice/internal/errors"
+	"paymentservice/internal/models"
+)
+
+const (
+	ProviderName = "yookassa"
+
+	DefaultAPIURL = "https://api.yookassa.ru/v3"
+
+	// SignatureHeader carries "sha256=<hex HMAC-SHA256 of the body>" signed with the webhook secret
+	SignatureHeader = "x-webhook-signature"
+)
+
+type Config struct {
+	ShopID        string
+	SecretKey     string
+	APIURL        string
+	WebhookSecret string
+}
+
+// Client creates payments and refunds and verifies notifications.
+type Client struct {
+	cfg        Config
+	httpClient *http.Client
+}
+
+func New(cfg Config) (*Client, error) {
+	if cfg.ShopID == "" || cfg.SecretKey == "" || cfg.WebhookSecret == "" {
+		return nil, errors.New("yookassa shop id, secret key and webhook secret are required")
+	}
+	if cfg.APIURL == "" {
+		cfg.APIURL = DefaultAPIURL
+	}
+	cfg.APIURL = strings.TrimRight(cfg.APIURL, "/")
+
+	return &Client{
+		cfg:        cfg,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+func (c *Client) Name() string {
+	return ProviderName
+}
+
+type amount struct {
+	Value    string `json:"value"`
+	Currency string `json:"currency"`
+}
+
+type confirmation struct {
+	Type            string `json:"type"`
+	ReturnURL       string `json:"return_url,omitempty"`
+	ConfirmationURL string `json:"confirmation_url,omitempty"`
+}
+
+type payment struct {
+	ID           string            `json:"id"`
+	Status       string            `json:"status"`
+	Amount       amount            `json:"amount"`
+	Confirmation *confirmation     `json:"confirmation,omitempty"`
+	Metadata     map[string]string `json:"metadata,omitempty"`
+}
+
+type createPaymentRequest struct {
+	Amount       amount            `json:"amount"`
+	Capture      bool              `json:"capture"`
+	Confirmation confirmation      `json:"confirmation"`
+	Description  string            `json:"description,omitempty"`
+	Metadata     map[string]string `json:"metadata,omitempty"`
+}
+
+type refund struct {
+	ID        string `json:"id"`
+	PaymentID string `json:"payment_id"`
+	Status    string `json:"status"`
+	Amount    amount `json:"amount"`
+}
+
+type createRefundRequest struct {
+	PaymentID string `json:"payment_id"`
+	Amount    amount `json:"amount"`
+}
+
+type notification struct {
+	Type   string  `json:"type"`
+	Event  string  `json:"event"`
+	Object payment `json:"object"`
+}
+
+func (c *Client) CreatePayment(ctx context.Context, input *models.ProviderPaymentInput) (*models.ProviderPayment, error) {
+	req := &createPaymentRequest{
+		Amount:  toAmount(input.Amount),
+		Capture: true,
+		Confirmation: confirmation{
+			Type:      "redirect",
+			ReturnURL: input.ReturnURL,
+		},
+		Description: input.Description,
+		Metadata:    input.Metadata,
+	}
+
+	var resp payment
+	if err := c.post(ctx, "/payments", input.IdempotencyKey, req, &resp); err != nil {
+		return nil, err
+	}
+	return toProviderPayment(&resp)
+}
+
+func (c *Client) Refund(ctx context.Context, paymentID string, refundAmount money.Money, idempotencyKey string) (*models.ProviderRefund, error) {
+	req := &createRefundRequest{
+		PaymentID: paymentID,
+		Amount:    toAmount(refundAmount),
+	}
+
+	var resp refund
+	if err := c.post(ctx, "/refunds", idempotencyKey, req, &resp); err != nil {
+		return nil, err
+	}
+	refunded, err := parseAmount(resp.Amount)
+	if err != nil {
+		return nil, err
+	}
+	return &models.ProviderRefund{
+		ID:        resp.ID,
+		PaymentID: resp.PaymentID,
+		Status:    resp.Status,
+		Amount:    refunded,
+	}, nil
+}
+
+// ParseWebhook verifies the notification signature and returns the payment from the notification.
+// Header names are expected in lower case.
+func (c *Client) ParseWebhook(body []byte, headers map[string]string) (*models.ProviderPayment, error) {
+	if !c.validSignature(body, headers[SignatureHeader]) {
+		return nil, errdefs.ErrInvalidSignature
+	}
+
+	var n notification
+	if err := json.Unmarshal(body, &n); err != nil {
+		return nil, errdefs.ErrInvalidArgument
+	}
+	if n.Type != "notification" || n.Object.ID == "" {
+		return nil, errdefs.ErrInvalidArgument
+	}
+	return toProviderPayment(&n.Object)
+}
+
+func (c *Client) validSignature(body []byte, signature string) bool {
+	signature, ok := strings.CutPrefix(signature, "sha256=")
+	if !ok {
+		return false
+	}
+	got, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	return hmac.Equal(got, Sign(body, c.cfg.WebhookSecret))
+}
+
+// Sign returns HMAC-SHA256 of the body with the webhook secret.
+func Sign(body []byte, secret string) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return mac.Sum(nil)
+}
+
+func (c *Client) post(ctx context.Context, path string, idempotencyKey string, in any, out any) error {
+	body, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.cfg.APIURL+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.cfg.ShopID, c.cfg.SecretKey)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Idempotence-Key", idempotencyKey)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("yookassa request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("yookassa returned %d: %s", resp.StatusCode, respBody)
+	}
+	return json.Unmarshal(respBody, out)
+}
+
+func toProviderPayment(p *payment) (*models.ProviderPayment, error) {
+	paid, err := parseAmount(p.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &models.ProviderPayment{
+		ID:       p.ID,
+		Status:   toStatus(p.Status),
+		Amount:   paid,
+		Metadata: p.Metadata,
+	}
+	if p.Confirmation != nil {
+		result.ConfirmationURL = p.Confirmation.ConfirmationURL
+	}
+	return result, nil
+}
+
+// toStatus maps payment statuses; waiting_for_capture does not happen since payments are captured automatically
+func toStatus(status string) models.OnlinePaymentStatus {
+	switch status {
+	case "succeeded":
+		return models.OnlinePaymentStatusSucceeded
+	case "canceled":
+		return models.OnlinePaymentStatusCanceled
+	default:
+		return models.OnlinePaymentStatusPending
+	}
+}
+
+func toAmount(m money.Money) amount {
+	return amount{Value: m.Major(), Currency: m.Currency.String()}
+}
+
+func parseAmount(a amount) (money.Money, error) {
+	currency, err := money.ParseCurrency(a.Currency)
+	if err != nil {
+		return money.Money{}, err
+	}
+	return money.ParseMajor(a.Value, currency)
+}
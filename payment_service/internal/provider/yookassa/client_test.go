@@ -0,0 +1,174 @@
+package yookassa
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"common_library/money"
+	errdefs "paymentservice/internal/errors"
+	"paymentservice/internal/models"
+)
+
+const webhookSecret = "webhook-secret"
+
+// newTestClient returns a client talking to a local stand-in of the provider API
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := New(Config{
+		ShopID:        "shop",
+		SecretKey:     "secret",
+		APIURL:        server.URL,
+		WebhookSecret: webhookSecret,
+	})
+	require.NoError(t, err)
+	return client
+}
+
+func signed(body []byte) map[string]string {
+	return map[string]string{SignatureHeader: "sha256=" + hex.EncodeToString(Sign(body, webhookSecret))}
+}
+
+func TestNew_RequiresCredentials(t *testing.T) {
+	_, err := New(Config{ShopID: "shop"})
+	assert.Error(t, err)
+}
+
+func TestClient_CreatePayment(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/payments", r.URL.Path)
+		assert.Equal(t, "key-1", r.Header.Get("Idempotence-Key"))
+		shopID, secret, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "shop", shopID)
+		assert.Equal(t, "secret", secret)
+
+		var req map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, map[string]any{"value": "1500.00", "currency": "RUB"}, req["amount"])
+		assert.Equal(t, true, req["capture"])
+		assert.Equal(t, map[string]any{"type": "redirect", "return_url": "https://app/return"}, req["confirmation"])
+		assert.Equal(t, map[string]any{"lesson_id": "lesson-1"}, req["metadata"])
+
+		_, _ = io.WriteString(w, `{
+			"id": "pay-1",
+			"status": "pending",
+			"amount": {"value": "1500.00", "currency": "RUB"},
+			"confirmation": {"type": "redirect", "confirmation_url": "https://pay/confirm"},
+			"metadata": {"lesson_id": "lesson-1"}
+		}`)
+	})
+
+	payment, err := client.CreatePayment(context.Background(), &models.ProviderPaymentInput{
+		IdempotencyKey: "key-1",
+		Amount:         money.New(150000, money.RUB),
+		Description:    "lesson",
+		ReturnURL:      "https://app/return",
+		Metadata:       map[string]string{"lesson_id": "lesson-1"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, &models.ProviderPayment{
+		ID:              "pay-1",
+		Status:          models.OnlinePaymentStatusPending,
+		Amount:          money.New(150000, money.RUB),
+		ConfirmationURL: "https://pay/confirm",
+		Metadata:        map[string]string{"lesson_id": "lesson-1"},
+	}, payment)
+}
+
+func TestClient_CreatePayment_ProviderError(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = io.WriteString(w, `{"type": "error", "code": "invalid_request"}`)
+	})
+
+	_, err := client.CreatePayment(context.Background(), &models.ProviderPaymentInput{IdempotencyKey: "key-1", Amount: money.New(150000, money.RUB)})
+
+	assert.ErrorContains(t, err, "invalid_request")
+}
+
+func TestClient_Refund(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/refunds", r.URL.Path)
+		assert.Equal(t, "refund-key", r.Header.Get("Idempotence-Key"))
+
+		var req map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "pay-1", req["payment_id"])
+		assert.Equal(t, map[string]any{"value": "700.00", "currency": "RUB"}, req["amount"])
+
+		_, _ = io.WriteString(w, `{"id": "ref-1", "payment_id": "pay-1", "status": "succeeded", "amount": {"value": "700.00", "currency": "RUB"}}`)
+	})
+
+	refund, err := client.Refund(context.Background(), "pay-1", money.New(70000, money.RUB), "refund-key")
+
+	require.NoError(t, err)
+	assert.Equal(t, &models.ProviderRefund{ID: "ref-1", PaymentID: "pay-1", Status: "succeeded", Amount: money.New(70000, money.RUB)}, refund)
+}
+
+func TestClient_ParseWebhook(t *testing.T) {
+	client := newTestClient(t, nil)
+	body := []byte(`{
+		"type": "notification",
+		"event": "payment.succeeded",
+		"object": {"id": "pay-1", "status": "succeeded", "amount": {"value": "1500.00", "currency": "RUB"}}
+	}`)
+
+	t.Run("Valid", func(t *testing.T) {
+		payment, err := client.ParseWebhook(body, signed(body))
+		require.NoError(t, err)
+		assert.Equal(t, "pay-1", payment.ID)
+		assert.Equal(t, models.OnlinePaymentStatusSucceeded, payment.Status)
+		assert.Equal(t, money.New(150000, money.RUB), payment.Amount)
+	})
+
+	t.Run("MissingSignature", func(t *testing.T) {
+		_, err := client.ParseWebhook(body, map[string]string{})
+		assert.ErrorIs(t, err, errdefs.ErrInvalidSignature)
+	})
+
+	t.Run("TamperedBody", func(t *testing.T) {
+		tampered := []byte(`{"type": "notification", "event": "payment.succeeded", "object": {"id": "pay-1", "status": "succeeded", "amount": {"value": "1.00", "currency": "RUB"}}}`)
+		_, err := client.ParseWebhook(tampered, signed(body))
+		assert.ErrorIs(t, err, errdefs.ErrInvalidSignature)
+	})
+
+	t.Run("NotNotification", func(t *testing.T) {
+		other := []byte(`{"type": "other"}`)
+		_, err := client.ParseWebhook(other, signed(other))
+		assert.ErrorIs(t, err, errdefs.ErrInvalidArgument)
+	})
+}
+
+func TestParseAmount(t *testing.T) {
+	m, err := parseAmount(amount{Value: "1500.50", Currency: "RUB"})
+	require.NoError(t, err)
+	assert.Equal(t, money.New(150050, money.RUB), m)
+
+	m, err = parseAmount(amount{Value: "20.00", Currency: "USD"})
+	require.NoError(t, err)
+	assert.Equal(t, money.New(2000, money.USD), m)
+
+	_, err = parseAmount(amount{Value: "1500.505", Currency: "RUB"})
+	assert.Error(t, err)
+
+	_, err = parseAmount(amount{Value: "1500.00", Currency: "XXX"})
+	assert.Error(t, err)
+}
+
+func TestToAmount(t *testing.T) {
+	assert.Equal(t, amount{Value: "1500.50", Currency: "RUB"}, toAmount(money.New(150050, money.RUB)))
+	assert.Equal(t, amount{Value: "1500", Currency: "JPY"}, toAmount(money.New(1500, money.JPY)))
+}
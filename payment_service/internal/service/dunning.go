@@ -0,0 +1,215 @@
+package service
+
+import (
+	"common_library/logging"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	errdefs "paymentservice/internal/errors"
+	"paymentservice/internal/models"
+	api3 "schedule_service/pkg/api"
+	"time"
+	api4 "userservice/pkg/api"
+)
+
+// RunDunning reminds students about unpaid lessons completed within the lookback period and returns the number
+// of sent notifications. steps are delays after the end of the lesson, in ascending order; the student gets
+// a reminder at each of them and the tutor is notified one more interval after the last one. Reminders stop
+// once a receipt is submitted. Every step is recorded before it is sent, so it is never sent twice.
+// Lessons that ended before the first run are not reminded about.
+func (s *PaymentService) RunDunning(ctx context.Context, steps []time.Duration, lookback time.Duration) (int, error) {
+	if s.notifier == nil {
+		return 0, errdefs.ErrNoNotifier
+	}
+	if len(steps) == 0 {
+		return 0, nil
+	}
+
+	now := time.Now()
+	startedAt, err := s.repo.GetDunningStartedAt(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+	after := now.Add(-lookback)
+	if startedAt.After(after) {
+		after = startedAt
+	}
+
+	listRequest := &api3.ListCompletedUnpaidLessonsRequest{After: timestamppb.New(after)}
+	resp, err := retry(ctx, maxRetries, retryDelay, func() (*api3.ListLessonsResponse, error) {
+		return s.scheduleClient.ListCompletedUnpaidLessons(ctxWithMetadata(ctx), listRequest)
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(resp.Lessons) == 0 {
+		return 0, nil
+	}
+
+	lessonIDs := make([]uuid.UUID, 0, len(resp.Lessons))
+	for _, lesson := range resp.Lessons {
+		if id, err := uuid.Parse(lesson.Id); err == nil {
+			lessonIDs = append(lessonIDs, id)
+		}
+	}
+	sentSteps, err := s.repo.ListDunningSteps(ctx, lessonIDs)
+	if err != nil {
+		return 0, err
+	}
+	lastSteps := make(map[uuid.UUID]*models.DunningStep, len(sentSteps))
+	for _, step := range sentSteps {
+		if last, ok := lastSteps[step.LessonID]; !ok || step.Step > last.Step {
+			lastSteps[step.LessonID] = step
+		}
+	}
+
+	logger, hasLogger := logging.GetFromContext(ctx)
+
+	sent := 0
+	for _, lesson := range resp.Lessons {
+		n, err := s.dunLesson(ctx, lesson, steps, lastSteps, now)
+		if err != nil {
+			if hasLogger {
+				logger.Error(ctx, "failed to remind about unpaid lesson", zap.String("lesson_id", lesson.Id), zap.Error(err))
+			}
+			continue
+		}
+		sent += n
+	}
+
+	return sent, nil
+}
+
+// dunLesson sends the due step of the lesson and reports whether it was sent.
+func (s *PaymentService) dunLesson(ctx context.Context, lesson *api3.Lesson, steps []time.Duration, lastSteps map[uuid.UUID]*models.DunningStep, now time.Time) (int, error) {
+	lessonID, err := uuid.Parse(lesson.Id)
+	if err != nil {
+		return 0, err
+	}
+	price := lessonPrice(lesson)
+	if price == nil || price.Amount <= 0 {
+		return 0, nil
+	}
+
+	last := 0
+	var lastSentAt time.Time
+	if step, ok := lastSteps[lessonID]; ok {
+		last, lastSentAt = int(step.Step), step.SentAt
+	}
+	due := dueDunningStep(now.Sub(lesson.GetEndsAt().AsTime()), steps)
+	final := len(steps)
+
+	// reminders missed while the service was down are not sent, only the latest one;
+	// the tutor is notified once the student had time to pay after the final reminder
+	var next int
+	switch {
+	case due > last:
+		next = due
+	case last == final && now.Sub(lastSentAt) >= tutorNoticeDelay(steps):
+		next = final + 1
+	default:
+		return 0, nil
+	}
+
+	receipt, err := s.repo.GetReceiptByLessonID(ctx, lessonID)
+	if err != nil && !errors.Is(err, errdefs.ErrNotFound) {
+		return 0, err
+	}
+	if receipt != nil && receipt.Status != models.ReceiptStatusRejected {
+		return 0, nil
+	}
+
+	tutorID, err := uuid.Parse(lesson.TutorId)
+	if err != nil {
+		return 0, err
+	}
+	studentID, err := uuid.Parse(lesson.StudentId)
+	if err != nil {
+		return 0, err
+	}
+
+	input := &models.DunningStepCreateInput{
+		ID:        uuid.New(),
+		LessonID:  lessonID,
+		TutorID:   tutorID,
+		StudentID: studentID,
+		Step:      int32(next),
+		Recipient: models.DunningRecipientStudent,
+	}
+	notification := &models.Notification{
+		UserID:   studentID,
+		Kind:     models.NotificationPaymentReminder,
+		LessonID: lessonID,
+		Text:     fmt.Sprintf("Урок %s не оплачен, к оплате %s.", formatLessonTime(lesson), price),
+	}
+	if next > final {
+		input.Recipient = models.DunningRecipientTutor
+		notification.UserID = tutorID
+		notification.Kind = models.NotificationPaymentOverdue
+		notification.Text = fmt.Sprintf("Урок %s с учеником %s не оплачен после %d напоминаний, долг %s.",
+			formatLessonTime(lesson), s.serviceUserName(ctx, studentID), final, price)
+	}
+
+	ok, err := s.sendDunningStep(ctx, input, notification)
+	if err != nil || !ok {
+		return 0, err
+	}
+	return 1, nil
+}
+
+// serviceUserName returns the full name of the user, looked up on behalf of payment_service itself:
+// the dunning worker has no caller to forward. Falls back to the id, a failed lookup is logged.
+func (s *PaymentService) serviceUserName(ctx context.Context, userID uuid.UUID) string {
+	user, err := s.userClient.GetUser(serviceMetadata(ctx), &api4.GetUserRequest{Id: userID.String()})
+	if err != nil {
+		if logger, ok := logging.GetFromContext(ctx); ok {
+			logger.Warn(ctx, "failed to load user name", zap.String("user_id", userID.String()), zap.Error(err))
+		}
+		return userID.String()
+	}
+	return fullName(user, userID)
+}
+
+// sendDunningStep claims the step and sends the notification. The claim is released if sending fails,
+// so the step is retried by the next run. Reports whether the notification was sent.
+func (s *PaymentService) sendDunningStep(ctx context.Context, input *models.DunningStepCreateInput, notification *models.Notification) (bool, error) {
+	claimed, err := s.repo.ClaimDunningStep(ctx, input)
+	if err != nil || !claimed {
+		return false, err
+	}
+
+	if err := s.notifier.Notify(ctx, notification); err != nil {
+		if releaseErr := s.repo.ReleaseDunningStep(ctx, input.ID); releaseErr != nil {
+			return false, errors.Join(err, releaseErr)
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// dueDunningStep returns the number of the latest reminder due for a lesson that ended overdue ago, 0 if none is due.
+func dueDunningStep(overdue time.Duration, steps []time.Duration) int {
+	due := 0
+	for i, step := range steps {
+		if overdue >= step {
+			due = i + 1
+		}
+	}
+	return due
+}
+
+// tutorNoticeDelay returns how long after the final reminder the tutor is notified: the interval between
+// the last two reminders, or the delay of the only one.
+func tutorNoticeDelay(steps []time.Duration) time.Duration {
+	if len(steps) == 1 {
+		return steps[0]
+	}
+	return steps[len(steps)-1] - steps[len(steps)-2]
+}
+
+func formatLessonTime(lesson *api3.Lesson) string {
+	return lesson.GetStartsAt().AsTime().In(reportLocation).Format("02.01.2006 15:04")
+}
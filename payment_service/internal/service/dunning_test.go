@@ -0,0 +1,244 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	errdefs "paymentservice/internal/errors"
+	"paymentservice/internal/mocks"
+	"paymentservice/internal/models"
+	api "schedule_service/pkg/api"
+	"strings"
+	"testing"
+	"time"
+	userapi "userservice/pkg/api"
+)
+
+func TestRunDunning(t *testing.T) {
+	tutorID := uuid.New()
+	studentID := uuid.New()
+	steps := []time.Duration{24 * time.Hour, 72 * time.Hour, 168 * time.Hour}
+
+	newLesson := func(endedAgo time.Duration) *api.Lesson {
+		endsAt := time.Now().Add(-endedAgo)
+		return &api.Lesson{
+			Id:        uuid.New().String(),
+			TutorId:   tutorID.String(),
+			StudentId: studentID.String(),
+			Status:    "completed",
+			Price:     rub(1500),
+			StartsAt:  timestamppb.New(endsAt.Add(-time.Hour)),
+			EndsAt:    timestamppb.New(endsAt),
+		}
+	}
+
+	t.Run("FirstReminder", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, mockSchedule := setup(t)
+		defer ctrl.Finish()
+		notifier := mocks.NewMockNotifier(ctrl)
+		svc.WithNotifier(notifier)
+
+		lesson := newLesson(30 * time.Hour)
+		lessonID := uuid.MustParse(lesson.Id)
+
+		mockRepo.EXPECT().GetDunningStartedAt(gomock.Any(), gomock.Any()).Return(time.Time{}, nil)
+		mockSchedule.EXPECT().ListCompletedUnpaidLessons(gomock.Any(), gomock.Any()).
+			Return(&api.ListLessonsResponse{Lessons: []*api.Lesson{lesson}}, nil)
+		mockRepo.EXPECT().ListDunningSteps(gomock.Any(), []uuid.UUID{lessonID}).Return(nil, nil)
+		mockRepo.EXPECT().GetReceiptByLessonID(gomock.Any(), lessonID).Return(nil, errdefs.ErrNotFound)
+		mockRepo.EXPECT().ClaimDunningStep(gomock.Any(), gomock.Cond(func(input *models.DunningStepCreateInput) bool {
+			return input.LessonID == lessonID && input.Step == 1 && input.Recipient == models.DunningRecipientStudent
+		})).Return(true, nil)
+		notifier.EXPECT().Notify(gomock.Any(), gomock.Cond(func(n *models.Notification) bool {
+			return n.UserID == studentID && n.Kind == models.NotificationPaymentReminder && n.LessonID == lessonID
+		})).Return(nil)
+
+		sent, err := svc.RunDunning(context.Background(), steps, 30*24*time.Hour)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, sent)
+	})
+
+	t.Run("FinalReminder", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, mockSchedule := setup(t)
+		defer ctrl.Finish()
+		notifier := mocks.NewMockNotifier(ctrl)
+		svc.WithNotifier(notifier)
+
+		lesson := newLesson(8 * 24 * time.Hour)
+		lessonID := uuid.MustParse(lesson.Id)
+
+		mockRepo.EXPECT().GetDunningStartedAt(gomock.Any(), gomock.Any()).Return(time.Time{}, nil)
+		mockSchedule.EXPECT().ListCompletedUnpaidLessons(gomock.Any(), gomock.Any()).
+			Return(&api.ListLessonsResponse{Lessons: []*api.Lesson{lesson}}, nil)
+		mockRepo.EXPECT().ListDunningSteps(gomock.Any(), gomock.Any()).
+			Return([]*models.DunningStep{{LessonID: lessonID, Step: 1}, {LessonID: lessonID, Step: 2}}, nil)
+		mockRepo.EXPECT().GetReceiptByLessonID(gomock.Any(), lessonID).
+			Return(&models.PaymentReceipt{Status: models.ReceiptStatusRejected}, nil)
+		mockRepo.EXPECT().ClaimDunningStep(gomock.Any(), gomock.Cond(func(input *models.DunningStepCreateInput) bool {
+			return input.Step == 3 && input.Recipient == models.DunningRecipientStudent
+		})).Return(true, nil)
+		notifier.EXPECT().Notify(gomock.Any(), gomock.Cond(func(n *models.Notification) bool {
+			return n.UserID == studentID
+		})).Return(nil)
+
+		sent, err := svc.RunDunning(context.Background(), steps, 30*24*time.Hour)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, sent)
+	})
+
+	t.Run("TutorNoticeAfterFinalReminder", func(t *testing.T) {
+		ctrl, svc, mockRepo, mockUser, _, mockSchedule := setup(t)
+		defer ctrl.Finish()
+		notifier := mocks.NewMockNotifier(ctrl)
+		svc.WithNotifier(notifier)
+
+		lesson := newLesson(12 * 24 * time.Hour)
+		lessonID := uuid.MustParse(lesson.Id)
+
+		mockRepo.EXPECT().GetDunningStartedAt(gomock.Any(), gomock.Any()).Return(time.Time{}, nil)
+		mockSchedule.EXPECT().ListCompletedUnpaidLessons(gomock.Any(), gomock.Any()).
+			Return(&api.ListLessonsResponse{Lessons: []*api.Lesson{lesson}}, nil)
+		mockRepo.EXPECT().ListDunningSteps(gomock.Any(), gomock.Any()).
+			Return([]*models.DunningStep{
+				{LessonID: lessonID, Step: 3, SentAt: time.Now().Add(-4 * 24 * time.Hour)},
+				{LessonID: lessonID, Step: 2, SentAt: time.Now().Add(-8 * 24 * time.Hour)},
+			}, nil)
+		mockRepo.EXPECT().GetReceiptByLessonID(gomock.Any(), lessonID).Return(nil, errdefs.ErrNotFound)
+		mockUser.EXPECT().GetUser(gomock.Any(), &userapi.GetUserRequest{Id: studentID.String()}).
+			DoAndReturn(func(ctx context.Context, _ *userapi.GetUserRequest, _ ...grpc.CallOption) (*userapi.UserPublic, error) {
+				// the worker has no user, the name is looked up by the service itself
+				md, _ := metadata.FromOutgoingContext(ctx)
+				assert.Equal(t, []string{"payment_service"}, md.Get("x-caller-service"))
+				return &userapi.UserPublic{FirstName: proto.String("Anna")}, nil
+			})
+		mockRepo.EXPECT().ClaimDunningStep(gomock.Any(), gomock.Cond(func(input *models.DunningStepCreateInput) bool {
+			return input.Step == 4 && input.Recipient == models.DunningRecipientTutor
+		})).Return(true, nil)
+		notifier.EXPECT().Notify(gomock.Any(), gomock.Cond(func(n *models.Notification) bool {
+			return n.UserID == tutorID && n.Kind == models.NotificationPaymentOverdue && strings.Contains(n.Text, "Anna")
+		})).Return(nil)
+
+		sent, err := svc.RunDunning(context.Background(), steps, 30*24*time.Hour)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, sent)
+	})
+
+	t.Run("TutorNoticeNotDueYet", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, mockSchedule := setup(t)
+		defer ctrl.Finish()
+		svc.WithNotifier(mocks.NewMockNotifier(ctrl))
+
+		lesson := newLesson(10 * 24 * time.Hour)
+		lessonID := uuid.MustParse(lesson.Id)
+
+		mockRepo.EXPECT().GetDunningStartedAt(gomock.Any(), gomock.Any()).Return(time.Time{}, nil)
+		mockSchedule.EXPECT().ListCompletedUnpaidLessons(gomock.Any(), gomock.Any()).
+			Return(&api.ListLessonsResponse{Lessons: []*api.Lesson{lesson}}, nil)
+		mockRepo.EXPECT().ListDunningSteps(gomock.Any(), gomock.Any()).
+			Return([]*models.DunningStep{{LessonID: lessonID, Step: 3, SentAt: time.Now().Add(-2 * 24 * time.Hour)}}, nil)
+
+		sent, err := svc.RunDunning(context.Background(), steps, 30*24*time.Hour)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, sent)
+	})
+
+	t.Run("SkipsLessonsBeforeFirstRun", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, mockSchedule := setup(t)
+		defer ctrl.Finish()
+		svc.WithNotifier(mocks.NewMockNotifier(ctrl))
+
+		startedAt := time.Now().Add(-time.Hour)
+
+		mockRepo.EXPECT().GetDunningStartedAt(gomock.Any(), gomock.Any()).Return(startedAt, nil)
+		mockSchedule.EXPECT().ListCompletedUnpaidLessons(gomock.Any(), gomock.Cond(func(req *api.ListCompletedUnpaidLessonsRequest) bool {
+			return req.GetAfter().AsTime().Equal(startedAt)
+		})).Return(&api.ListLessonsResponse{}, nil)
+
+		sent, err := svc.RunDunning(context.Background(), steps, 30*24*time.Hour)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, sent)
+	})
+
+	t.Run("StopsAfterReceipt", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, mockSchedule := setup(t)
+		defer ctrl.Finish()
+		svc.WithNotifier(mocks.NewMockNotifier(ctrl))
+
+		lesson := newLesson(4 * 24 * time.Hour)
+		lessonID := uuid.MustParse(lesson.Id)
+
+		mockRepo.EXPECT().GetDunningStartedAt(gomock.Any(), gomock.Any()).Return(time.Time{}, nil)
+		mockSchedule.EXPECT().ListCompletedUnpaidLessons(gomock.Any(), gomock.Any()).
+			Return(&api.ListLessonsResponse{Lessons: []*api.Lesson{lesson}}, nil)
+		mockRepo.EXPECT().ListDunningSteps(gomock.Any(), gomock.Any()).Return([]*models.DunningStep{{LessonID: lessonID, Step: 1}}, nil)
+		mockRepo.EXPECT().GetReceiptByLessonID(gomock.Any(), lessonID).
+			Return(&models.PaymentReceipt{Status: models.ReceiptStatusPending}, nil)
+
+		sent, err := svc.RunDunning(context.Background(), steps, 30*24*time.Hour)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, sent)
+	})
+
+	t.Run("AlreadySent", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, mockSchedule := setup(t)
+		defer ctrl.Finish()
+		svc.WithNotifier(mocks.NewMockNotifier(ctrl))
+
+		lesson := newLesson(2 * 24 * time.Hour)
+		lessonID := uuid.MustParse(lesson.Id)
+
+		mockRepo.EXPECT().GetDunningStartedAt(gomock.Any(), gomock.Any()).Return(time.Time{}, nil)
+		mockSchedule.EXPECT().ListCompletedUnpaidLessons(gomock.Any(), gomock.Any()).
+			Return(&api.ListLessonsResponse{Lessons: []*api.Lesson{lesson}}, nil)
+		mockRepo.EXPECT().ListDunningSteps(gomock.Any(), gomock.Any()).Return([]*models.DunningStep{{LessonID: lessonID, Step: 1}}, nil)
+
+		sent, err := svc.RunDunning(context.Background(), steps, 30*24*time.Hour)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, sent)
+	})
+
+	t.Run("NotifyFailedReleasesStep", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, mockSchedule := setup(t)
+		defer ctrl.Finish()
+		notifier := mocks.NewMockNotifier(ctrl)
+		svc.WithNotifier(notifier)
+
+		lesson := newLesson(30 * time.Hour)
+		var claimedID uuid.UUID
+
+		mockRepo.EXPECT().GetDunningStartedAt(gomock.Any(), gomock.Any()).Return(time.Time{}, nil)
+		mockSchedule.EXPECT().ListCompletedUnpaidLessons(gomock.Any(), gomock.Any()).
+			Return(&api.ListLessonsResponse{Lessons: []*api.Lesson{lesson}}, nil)
+		mockRepo.EXPECT().ListDunningSteps(gomock.Any(), gomock.Any()).Return(nil, nil)
+		mockRepo.EXPECT().GetReceiptByLessonID(gomock.Any(), gomock.Any()).Return(nil, errdefs.ErrNotFound)
+		mockRepo.EXPECT().ClaimDunningStep(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, input *models.DunningStepCreateInput) (bool, error) {
+				claimedID = input.ID
+				return true, nil
+			})
+		notifier.EXPECT().Notify(gomock.Any(), gomock.Any()).Return(errors.New("bot is down"))
+		mockRepo.EXPECT().ReleaseDunningStep(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, id uuid.UUID) error {
+				assert.Equal(t, claimedID, id)
+				return nil
+			})
+
+		sent, err := svc.RunDunning(context.Background(), steps, 30*24*time.Hour)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, sent)
+	})
+
+	t.Run("NoNotifier", func(t *testing.T) {
+		ctrl, svc, _, _, _, _ := setup(t)
+		defer ctrl.Finish()
+
+		_, err := svc.RunDunning(context.Background(), steps, time.Hour)
+		assert.ErrorIs(t, err, errdefs.ErrNoNotifier)
+	})
+}
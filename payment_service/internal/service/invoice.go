@@ -0,0 +1,321 @@
+package service
+
+import (
+	"common_library/logging"
+	"common_library/money"
+	"context"
+	api2 "fileservice/pkg/api"
+	"fmt"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	errdefs "paymentservice/internal/errors"
+	"paymentservice/internal/models"
+	api3 "schedule_service/pkg/api"
+	"slices"
+	"strings"
+	"time"
+	api4 "userservice/pkg/api"
+)
+
+const (
+	invoiceFileOwnerType   = "invoice" // тип владельца файла счёта в file_service
+	invoiceFileContentType = "application/pdf"
+
+	// a lesson cancelled later than this before its start is charged as held
+	lateCancelWindow = 24 * time.Hour
+	maxInvoicePeriod = 366 * 24 * time.Hour
+)
+
+// CreateInvoice creates an invoice for the student of the calling tutor with completed lessons and late cancellations
+// that start within [PeriodStart, PeriodEnd). An invoice is in a single currency, the one of the tutor profile
+// unless requested otherwise; lessons priced in other currencies are left for another invoice. The PDF is rendered and stored in file_service right away;
+// if that fails, the invoice is returned without a file, which is created on the first download.
+func (s *PaymentService) CreateInvoice(ctx context.Context, input *models.CreateInvoiceInput) (*models.Invoice, error) {
+	if input.StudentId == uuid.Nil || !input.PeriodEnd.After(input.PeriodStart) || input.PeriodEnd.Sub(input.PeriodStart) > maxInvoicePeriod {
+		return nil, errdefs.ErrInvalidArgument
+	}
+
+	userID, role, ok := caller(ctx)
+	if !ok || role != models.RoleTutor {
+		return nil, errdefs.ErrPermissionDenied
+	}
+
+	getTutorStudentRequest := &api4.GetTutorStudentRequest{
+		TutorId:   userID.String(),
+		StudentId: input.StudentId.String(),
+	}
+	_, err := s.userClient.GetTutorStudent(ctxWithMetadata(ctx), getTutorStudentRequest)
+	if status.Code(err) == codes.NotFound {
+		return nil, errdefs.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	listLessonsRequest := &api3.ListLessonsByPairRequest{
+		TutorId:      userID.String(),
+		StudentId:    input.StudentId.String(),
+		StatusFilter: []api3.LessonStatusFilter{api3.LessonStatusFilter_COMPLETED, api3.LessonStatusFilter_CANCELLED},
+	}
+	lessons, err := retry(ctx, maxRetries, retryDelay, func() (*api3.ListLessonsResponse, error) {
+		return s.scheduleClient.ListLessonsByPair(ctxWithMetadata(ctx), listLessonsRequest)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	getTutorProfileRequest := &api4.GetTutorProfileByUserIdRequest{UserId: userID.String()}
+	profile, err := retry(ctx, maxRetries, retryDelay, func() (*api4.TutorProfile, error) {
+		return s.userClient.GetTutorProfileByUserId(ctxWithMetadata(ctx), getTutorProfileRequest)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	currency := money.Currency(profile.GetCurrency())
+	if input.Currency != nil {
+		currency = *input.Currency
+	}
+	if currency == "" {
+		currency = money.Default
+	}
+
+	invoice, err := s.repo.CreateInvoice(ctx, &models.InvoiceCreateInput{
+		ID:             uuid.New(),
+		TutorID:        userID,
+		StudentID:      input.StudentId,
+		PeriodStart:    input.PeriodStart,
+		PeriodEnd:      input.PeriodEnd,
+		Currency:       currency,
+		PaymentDetails: profile.PaymentInfo,
+		Lines:          invoiceLines(lessons.Lessons, input.PeriodStart, input.PeriodEnd, currency),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ensureInvoiceFile(ctx, invoice); err != nil {
+		if logger, ok := logging.GetFromContext(ctx); ok {
+			logger.Error(ctx, "failed to store invoice file",
+				zap.String("invoice_id", invoice.ID.String()),
+				zap.Error(err),
+			)
+		}
+	}
+
+	return invoice, nil
+}
+
+// ListInvoices returns invoices of the caller: tutors see invoices they issued, students see invoices issued to them,
+// guardians see invoices of the linked student set in the filter.
+func (s *PaymentService) ListInvoices(ctx context.Context, input *models.ListInvoicesInput) ([]*models.Invoice, error) {
+	userID, role, ok := caller(ctx)
+	if !ok {
+		return nil, errdefs.ErrPermissionDenied
+	}
+
+	filter := &models.InvoiceFilter{
+		TutorID:   input.TutorId,
+		StudentID: input.StudentId,
+	}
+	switch role {
+	case models.RoleTutor:
+		if filter.TutorID != nil && *filter.TutorID != userID {
+			return nil, errdefs.ErrPermissionDenied
+		}
+		filter.TutorID = &userID
+	case models.RoleStudent:
+		if filter.StudentID != nil && *filter.StudentID != userID {
+			return nil, errdefs.ErrPermissionDenied
+		}
+		filter.StudentID = &userID
+	case models.RoleGuardian:
+		if filter.StudentID == nil {
+			return nil, errdefs.ErrInvalidArgument
+		}
+		if err := s.checkGuardian(ctx, *filter.StudentID); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errdefs.ErrPermissionDenied
+	}
+
+	return s.repo.ListInvoices(ctx, filter)
+}
+
+// GetInvoiceFile returns a download link to the invoice PDF. Both the tutor and the student can download it,
+// guardians are not granted access to the file.
+func (s *PaymentService) GetInvoiceFile(ctx context.Context, input *models.GetInvoiceFileInput) (*models.InvoiceFileUrl, error) {
+	if input.InvoiceId == uuid.Nil {
+		return nil, errdefs.ErrInvalidArgument
+	}
+
+	invoice, err := s.repo.GetInvoiceByID(ctx, input.InvoiceId)
+	if err != nil {
+		return nil, err
+	}
+	if userID, _, ok := caller(ctx); !ok || (userID != invoice.TutorID && userID != invoice.StudentID) {
+		return nil, errdefs.ErrPermissionDenied
+	}
+
+	if invoice.FileID == nil {
+		if err := s.ensureInvoiceFile(ctx, invoice); err != nil {
+			return nil, err
+		}
+	}
+
+	generateDownloadURLRequest := &api2.GenerateDownloadURLRequest{FileId: invoice.FileID.String()}
+	url, err := s.fileClient.GenerateDownloadURL(ctxWithMetadata(ctx), generateDownloadURLRequest)
+	if err != nil {
+		return nil, err
+	}
+	return &models.InvoiceFileUrl{URL: url.GetUrl()}, nil
+}
+
+// ensureInvoiceFile renders the invoice PDF, stores it in file_service on behalf of the caller
+// and sets the file of the invoice. Both members of the pair are granted access to the file.
+func (s *PaymentService) ensureInvoiceFile(ctx context.Context, invoice *models.Invoice) error {
+	userID, _, ok := caller(ctx)
+	if !ok {
+		return errdefs.ErrPermissionDenied
+	}
+
+	lines, err := s.repo.ListInvoiceLines(ctx, invoice.ID)
+	if err != nil {
+		return err
+	}
+
+	content, err := s.invoiceRenderer.Render(&models.InvoiceDocument{
+		Invoice:     invoice,
+		Lines:       lines,
+		TutorName:   s.userName(ctx, invoice.TutorID),
+		StudentName: s.userName(ctx, invoice.StudentID),
+	})
+	if err != nil {
+		return fmt.Errorf("render invoice: %w", err)
+	}
+
+	uploadFileRequest := &api2.UploadFileRequest{
+		UploadedBy:  userID.String(),
+		Filename:    fmt.Sprintf("invoice-%d.pdf", invoice.Number),
+		Content:     content,
+		ContentType: invoiceFileContentType,
+	}
+	file, err := retry(ctx, maxRetries, retryDelay, func() (*api2.File, error) {
+		return s.fileClient.UploadFile(ctxWithMetadata(ctx), uploadFileRequest)
+	})
+	if err != nil {
+		return err
+	}
+	fileID, err := uuid.Parse(file.GetId())
+	if err != nil {
+		return err
+	}
+
+	registerFileUsageRequest := &api2.FileUsage{
+		FileId:    file.GetId(),
+		OwnerType: invoiceFileOwnerType,
+		OwnerId:   invoice.ID.String(),
+	}
+	_, err = retry(ctx, maxRetries, retryDelay, func() (*api2.Empty, error) {
+		return s.fileClient.RegisterFileUsage(ctxWithMetadata(ctx), registerFileUsageRequest)
+	})
+	if err != nil {
+		return err
+	}
+
+	grantFileAccessRequest := &api2.FileAccessRequest{
+		FileId:  file.GetId(),
+		UserIds: []string{invoice.TutorID.String(), invoice.StudentID.String()},
+	}
+	_, err = retry(ctx, maxRetries, retryDelay, func() (*api2.Empty, error) {
+		return s.fileClient.GrantFileAccess(ctxWithMetadata(ctx), grantFileAccessRequest)
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.SetInvoiceFile(ctx, invoice.ID, fileID); err != nil {
+		return err
+	}
+	invoice.FileID = &fileID
+	return nil
+}
+
+// userName returns the full name of the user or the id if the user cannot be loaded.
+func (s *PaymentService) userName(ctx context.Context, userID uuid.UUID) string {
+	user, err := s.userClient.GetUser(ctxWithMetadata(ctx), &api4.GetUserRequest{Id: userID.String()})
+	if err != nil {
+		return userID.String()
+	}
+	return fullName(user, userID)
+}
+
+func fullName(user *api4.UserPublic, userID uuid.UUID) string {
+	name := strings.TrimSpace(user.GetFirstName() + " " + user.GetLastName())
+	if name == "" {
+		return userID.String()
+	}
+	return name
+}
+
+// invoiceLines returns completed lessons and late cancellations that start within [start, end), ordered by time.
+// Lessons priced in a currency other than the given one are skipped, lessons without a price cost nothing.
+func invoiceLines(lessons []*api3.Lesson, start time.Time, end time.Time, currency money.Currency) []models.InvoiceLine {
+	lines := make([]models.InvoiceLine, 0, len(lessons))
+	for _, lesson := range lessons {
+		startsAt := lesson.GetStartsAt().AsTime()
+		if startsAt.Before(start) || !startsAt.Before(end) {
+			continue
+		}
+
+		var kind models.InvoiceLineKind
+		switch lesson.GetStatus() {
+		case "completed":
+			kind = models.InvoiceLineLesson
+		case "cancelled":
+			if !isLateCancel(lesson) {
+				continue
+			}
+			kind = models.InvoiceLineLateCancel
+		default:
+			continue
+		}
+
+		var amount int64
+		if price := lessonPrice(lesson); price != nil {
+			if price.Currency != currency {
+				continue
+			}
+			amount = price.Amount
+		}
+
+		lessonID, err := uuid.Parse(lesson.GetId())
+		if err != nil {
+			continue
+		}
+		lines = append(lines, models.InvoiceLine{
+			LessonID:    lessonID,
+			Kind:        kind,
+			StartsAt:    startsAt,
+			AmountMinor: amount,
+			IsPaid:      lesson.GetIsPaid(),
+		})
+	}
+
+	slices.SortFunc(lines, func(a, b models.InvoiceLine) int {
+		return a.StartsAt.Compare(b.StartsAt)
+	})
+	return lines
+}
+
+func isLateCancel(lesson *api3.Lesson) bool {
+	// edited_at changes on every edit of the lesson, only the time of the cancellation counts;
+	// lessons cancelled before schedule_service kept that time are never late
+	if lesson.GetCancelledAt() == nil {
+		return false
+	}
+	return lesson.GetCancelledAt().AsTime().After(lesson.GetStartsAt().AsTime().Add(-lateCancelWindow))
+}
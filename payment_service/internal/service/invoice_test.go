@@ -0,0 +1,242 @@
+package service_test
+
+import (
+	"common_library/money"
+	"errors"
+	fileapi "fileservice/pkg/api"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	errdefs "paymentservice/internal/errors"
+	"paymentservice/internal/models"
+	api "schedule_service/pkg/api"
+	"testing"
+	"time"
+	userapi "userservice/pkg/api"
+)
+
+func TestCreateInvoice(t *testing.T) {
+	tutorID := uuid.New()
+	studentID := uuid.New()
+	periodStart := time.Date(2026, time.September, 1, 0, 0, 0, 0, time.UTC)
+	periodEnd := periodStart.AddDate(0, 1, 0)
+	input := &models.CreateInvoiceInput{StudentId: studentID, PeriodStart: periodStart, PeriodEnd: periodEnd}
+
+	lesson := func(status string, startsAt time.Time, editedAt time.Time, isPaid bool) *api.Lesson {
+		l := &api.Lesson{
+			Id:        uuid.NewString(),
+			TutorId:   tutorID.String(),
+			StudentId: studentID.String(),
+			Status:    status,
+			IsPaid:    isPaid,
+			Price:     rub(1500),
+			StartsAt:  timestamppb.New(startsAt),
+			EditedAt:  timestamppb.New(editedAt),
+		}
+		if status == "cancelled" {
+			l.CancelledAt = l.EditedAt
+		}
+		return l
+	}
+	day := func(d int) time.Time { return periodStart.AddDate(0, 0, d).Add(15 * time.Hour) }
+
+	completed := lesson("completed", day(10), day(10), false)
+	paid := lesson("completed", day(3), day(3), true)
+	lateCancel := lesson("cancelled", day(5), day(5).Add(-2*time.Hour), false)
+	// edits after an early cancellation do not make it late
+	editedAfterCancel := lesson("cancelled", day(8), day(6), false)
+	editedAfterCancel.EditedAt = timestamppb.New(day(8).Add(-time.Hour))
+	// lessons in other currencies go to another invoice
+	inEuro := lesson("completed", day(12), day(12), false)
+	inEuro.Price = &api.Money{AmountMinor: 4000, Currency: "EUR"}
+	lessons := []*api.Lesson{
+		inEuro,
+		completed,
+		paid,
+		lateCancel,
+		lesson("cancelled", day(7), day(5), false),
+		editedAfterCancel,
+		lesson("completed", day(-1), day(-1), false),
+		lesson("completed", day(30), day(30), false),
+		lesson("booked", day(20), day(1), false),
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl, svc, mockRepo, mockUserClient, mockFileClient, mockSchedule, mockRenderer := setupWithRenderer(t)
+		defer ctrl.Finish()
+
+		invoiceID := uuid.New()
+		fileID := uuid.New()
+		details := "card 1234"
+
+		mockUserClient.EXPECT().GetTutorStudent(gomock.Any(), &userapi.GetTutorStudentRequest{
+			TutorId:   tutorID.String(),
+			StudentId: studentID.String(),
+		}).Return(&userapi.TutorStudent{}, nil)
+		mockSchedule.EXPECT().ListLessonsByPair(gomock.Any(), &api.ListLessonsByPairRequest{
+			TutorId:      tutorID.String(),
+			StudentId:    studentID.String(),
+			StatusFilter: []api.LessonStatusFilter{api.LessonStatusFilter_COMPLETED, api.LessonStatusFilter_CANCELLED},
+		}).Return(&api.ListLessonsResponse{Lessons: lessons}, nil)
+		mockUserClient.EXPECT().GetTutorProfileByUserId(gomock.Any(), &userapi.GetTutorProfileByUserIdRequest{UserId: tutorID.String()}).
+			Return(&userapi.TutorProfile{PaymentInfo: &details, Currency: "RUB"}, nil)
+
+		var lines []models.InvoiceLine
+		mockRepo.EXPECT().CreateInvoice(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, input *models.InvoiceCreateInput) (*models.Invoice, error) {
+				assert.Equal(t, tutorID, input.TutorID)
+				assert.Equal(t, studentID, input.StudentID)
+				assert.Equal(t, &details, input.PaymentDetails)
+				assert.Equal(t, money.RUB, input.Currency)
+				lines = input.Lines
+				return &models.Invoice{ID: invoiceID, TutorID: tutorID, StudentID: studentID, Number: 3}, nil
+			})
+		mockRepo.EXPECT().ListInvoiceLines(gomock.Any(), invoiceID).DoAndReturn(func(_ any, _ uuid.UUID) ([]models.InvoiceLine, error) {
+			return lines, nil
+		})
+		mockUserClient.EXPECT().GetUser(gomock.Any(), &userapi.GetUserRequest{Id: tutorID.String()}).
+			Return(&userapi.UserPublic{FirstName: proto.String("Anna"), LastName: proto.String("Petrova")}, nil)
+		mockUserClient.EXPECT().GetUser(gomock.Any(), &userapi.GetUserRequest{Id: studentID.String()}).
+			Return(nil, status.Error(codes.Unimplemented, "unavailable"))
+		mockRenderer.EXPECT().Render(gomock.Cond(func(doc *models.InvoiceDocument) bool {
+			return doc.TutorName == "Anna Petrova" && doc.StudentName == studentID.String() && len(doc.Lines) == 3
+		})).Return([]byte("%PDF-"), nil)
+		mockFileClient.EXPECT().UploadFile(gomock.Any(), &fileapi.UploadFileRequest{
+			UploadedBy:  tutorID.String(),
+			Filename:    "invoice-3.pdf",
+			Content:     []byte("%PDF-"),
+			ContentType: "application/pdf",
+		}).Return(&fileapi.File{Id: fileID.String()}, nil)
+		mockFileClient.EXPECT().RegisterFileUsage(gomock.Any(), &fileapi.FileUsage{
+			FileId:    fileID.String(),
+			OwnerType: "invoice",
+			OwnerId:   invoiceID.String(),
+		}).Return(&fileapi.Empty{}, nil)
+		mockFileClient.EXPECT().GrantFileAccess(gomock.Any(), &fileapi.FileAccessRequest{
+			FileId:  fileID.String(),
+			UserIds: []string{tutorID.String(), studentID.String()},
+		}).Return(&fileapi.Empty{}, nil)
+		mockRepo.EXPECT().SetInvoiceFile(gomock.Any(), invoiceID, fileID).Return(nil)
+
+		invoice, err := svc.CreateInvoice(tutorCtx(tutorID), input)
+		require.NoError(t, err)
+		assert.Equal(t, &fileID, invoice.FileID)
+
+		require.Len(t, lines, 3)
+		assert.Equal(t, paid.Id, lines[0].LessonID.String())
+		assert.True(t, lines[0].IsPaid)
+		assert.Equal(t, lateCancel.Id, lines[1].LessonID.String())
+		assert.Equal(t, models.InvoiceLineLateCancel, lines[1].Kind)
+		assert.Equal(t, completed.Id, lines[2].LessonID.String())
+		assert.Equal(t, models.InvoiceLineLesson, lines[2].Kind)
+		assert.Equal(t, int64(150000), lines[2].AmountMinor)
+	})
+
+	t.Run("FileNotStored", func(t *testing.T) {
+		ctrl, svc, mockRepo, mockUserClient, mockFileClient, mockSchedule, mockRenderer := setupWithRenderer(t)
+		defer ctrl.Finish()
+
+		invoice := &models.Invoice{ID: uuid.New(), TutorID: tutorID, StudentID: studentID, Number: 1}
+		mockUserClient.EXPECT().GetTutorStudent(gomock.Any(), gomock.Any()).Return(&userapi.TutorStudent{}, nil)
+		mockSchedule.EXPECT().ListLessonsByPair(gomock.Any(), gomock.Any()).Return(&api.ListLessonsResponse{}, nil)
+		mockUserClient.EXPECT().GetTutorProfileByUserId(gomock.Any(), gomock.Any()).Return(&userapi.TutorProfile{}, nil)
+		mockRepo.EXPECT().CreateInvoice(gomock.Any(), gomock.Any()).Return(invoice, nil)
+		mockRepo.EXPECT().ListInvoiceLines(gomock.Any(), invoice.ID).Return(nil, nil)
+		mockUserClient.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(&userapi.UserPublic{}, nil).Times(2)
+		mockRenderer.EXPECT().Render(gomock.Any()).Return([]byte("%PDF-"), nil)
+		mockFileClient.EXPECT().UploadFile(gomock.Any(), gomock.Any()).Return(nil, errors.New("file service is down"))
+
+		result, err := svc.CreateInvoice(tutorCtx(tutorID), input)
+		require.NoError(t, err)
+		assert.Nil(t, result.FileID)
+	})
+
+	t.Run("Error_NotTutor", func(t *testing.T) {
+		_, svc, _, _, _, _ := setup(t)
+
+		_, err := svc.CreateInvoice(studentCtx(studentID), input)
+		assert.ErrorIs(t, err, errdefs.ErrPermissionDenied)
+	})
+
+	t.Run("Error_NotStudentOfTutor", func(t *testing.T) {
+		ctrl, svc, _, mockUserClient, _, _ := setup(t)
+		defer ctrl.Finish()
+
+		mockUserClient.EXPECT().GetTutorStudent(gomock.Any(), gomock.Any()).
+			Return(nil, status.Error(codes.NotFound, "not found"))
+
+		_, err := svc.CreateInvoice(tutorCtx(tutorID), input)
+		assert.ErrorIs(t, err, errdefs.ErrNotFound)
+	})
+
+	t.Run("Error_InvalidPeriod", func(t *testing.T) {
+		_, svc, _, _, _, _ := setup(t)
+
+		for _, invalid := range []*models.CreateInvoiceInput{
+			{StudentId: studentID, PeriodStart: periodEnd, PeriodEnd: periodStart},
+			{StudentId: studentID, PeriodStart: periodStart, PeriodEnd: periodStart.AddDate(2, 0, 0)},
+			{PeriodStart: periodStart, PeriodEnd: periodEnd},
+		} {
+			_, err := svc.CreateInvoice(tutorCtx(tutorID), invalid)
+			assert.ErrorIs(t, err, errdefs.ErrInvalidArgument)
+		}
+	})
+}
+
+func TestListInvoices(t *testing.T) {
+	tutorID := uuid.New()
+	studentID := uuid.New()
+
+	t.Run("Student", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, _ := setup(t)
+		defer ctrl.Finish()
+
+		mockRepo.EXPECT().ListInvoices(gomock.Any(), &models.InvoiceFilter{TutorID: &tutorID, StudentID: &studentID}).Return(nil, nil)
+
+		_, err := svc.ListInvoices(studentCtx(studentID), &models.ListInvoicesInput{TutorId: &tutorID})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Error_OtherTutor", func(t *testing.T) {
+		_, svc, _, _, _, _ := setup(t)
+
+		other := uuid.New()
+		_, err := svc.ListInvoices(tutorCtx(tutorID), &models.ListInvoicesInput{TutorId: &other})
+		assert.ErrorIs(t, err, errdefs.ErrPermissionDenied)
+	})
+}
+
+func TestGetInvoiceFile(t *testing.T) {
+	tutorID := uuid.New()
+	studentID := uuid.New()
+	fileID := uuid.New()
+	invoice := &models.Invoice{ID: uuid.New(), TutorID: tutorID, StudentID: studentID, FileID: &fileID}
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, mockFileClient, _ := setup(t)
+		defer ctrl.Finish()
+
+		mockRepo.EXPECT().GetInvoiceByID(gomock.Any(), invoice.ID).Return(invoice, nil)
+		mockFileClient.EXPECT().GenerateDownloadURL(gomock.Any(), &fileapi.GenerateDownloadURLRequest{FileId: fileID.String()}).
+			Return(&fileapi.DownloadURL{Url: "https://example.com/invoice.pdf"}, nil)
+
+		url, err := svc.GetInvoiceFile(studentCtx(studentID), &models.GetInvoiceFileInput{InvoiceId: invoice.ID})
+		require.NoError(t, err)
+		assert.Equal(t, "https://example.com/invoice.pdf", url.URL)
+	})
+
+	t.Run("Error_OtherUser", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, _ := setup(t)
+		defer ctrl.Finish()
+
+		mockRepo.EXPECT().GetInvoiceByID(gomock.Any(), invoice.ID).Return(invoice, nil)
+
+		_, err := svc.GetInvoiceFile(studentCtx(uuid.New()), &models.GetInvoiceFileInput{InvoiceId: invoice.ID})
+		assert.ErrorIs(t, err, errdefs.ErrPermissionDenied)
+	})
+}
@@ -0,0 +1,287 @@
+package service
+
+import (
+	"common_library/logging"
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	errdefs "paymentservice/internal/errors"
+	"paymentservice/internal/models"
+	api3 "schedule_service/pkg/api"
+	"strings"
+	"time"
+	api4 "userservice/pkg/api"
+)
+
+const (
+	defaultLedgerEntriesLimit = 50
+	maxLedgerEntriesLimit     = 200
+)
+
+// GetBalance returns the balance of the student with the tutor. Both of them and guardians of the student can see it.
+func (s *PaymentService) GetBalance(ctx context.Context, input *models.GetBalanceInput) (*models.Balance, error) {
+	if err := s.checkPairMember(ctx, input.TutorId, input.StudentId); err != nil {
+		return nil, err
+	}
+	return s.repo.GetBalance(ctx, input.TutorId, input.StudentId)
+}
+
+// ListLedgerEntries returns changes of the student balance with the tutor, newest first. Both of them can see them.
+func (s *PaymentService) ListLedgerEntries(ctx context.Context, input *models.ListLedgerEntriesInput) ([]*models.LedgerEntry, error) {
+	if input.Limit < 0 || input.Limit > maxLedgerEntriesLimit {
+		return nil, errdefs.ErrInvalidArgument
+	}
+	if err := s.checkPairMember(ctx, input.TutorId, input.StudentId); err != nil {
+		return nil, err
+	}
+
+	limit := int(input.Limit)
+	if limit == 0 {
+		limit = defaultLedgerEntriesLimit
+	}
+	return s.repo.ListLedgerEntries(ctx, input.TutorId, input.StudentId, limit)
+}
+
+// CreatePackage creates a package of prepaid lessons for a student of the calling tutor.
+func (s *PaymentService) CreatePackage(ctx context.Context, input *models.CreatePackageInput) (*models.LessonPackage, error) {
+	name := strings.TrimSpace(input.Name)
+	if input.StudentId == uuid.Nil || name == "" || input.LessonCount <= 0 || input.Price.Amount < 0 {
+		return nil, errdefs.ErrInvalidArgument
+	}
+
+	userID, role, ok := caller(ctx)
+	if !ok || role != models.RoleTutor {
+		return nil, errdefs.ErrPermissionDenied
+	}
+
+	getTutorStudentRequest := &api4.GetTutorStudentRequest{
+		TutorId:   userID.String(),
+		StudentId: input.StudentId.String(),
+	}
+	_, err := s.userClient.GetTutorStudent(ctxWithMetadata(ctx), getTutorStudentRequest)
+	if status.Code(err) == codes.NotFound {
+		return nil, errdefs.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.CreatePackage(ctx, &models.LessonPackageCreateInput{
+		ID:          uuid.New(),
+		TutorID:     userID,
+		StudentID:   input.StudentId,
+		Name:        name,
+		LessonCount: input.LessonCount,
+		PriceMinor:  input.Price.Amount,
+		Currency:    input.Price.Currency,
+	})
+}
+
+// ListPackages returns packages of the pair. Both the tutor and the student can see them.
+func (s *PaymentService) ListPackages(ctx context.Context, input *models.ListPackagesInput) ([]*models.LessonPackage, error) {
+	if err := s.checkPairMember(ctx, input.TutorId, input.StudentId); err != nil {
+		return nil, err
+	}
+	return s.repo.ListPackages(ctx, input.TutorId, input.StudentId)
+}
+
+// PurchasePackage records that the student paid for the package and credits its price to the student balance.
+// Only the tutor of the package confirms purchases, since the money is received outside of the system.
+func (s *PaymentService) PurchasePackage(ctx context.Context, input *models.PurchasePackageInput) (*models.PackagePurchase, error) {
+	if input.PackageId == uuid.Nil {
+		return nil, errdefs.ErrInvalidArgument
+	}
+
+	pkg, err := s.repo.GetPackageByID(ctx, input.PackageId)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, role, ok := caller(ctx)
+	if !ok || role != models.RoleTutor || pkg.TutorID != userID {
+		return nil, errdefs.ErrPermissionDenied
+	}
+
+	return s.repo.CreatePackagePurchase(ctx, &models.PackagePurchaseCreateInput{
+		ID:           uuid.New(),
+		PackageID:    pkg.ID,
+		TutorID:      pkg.TutorID,
+		StudentID:    pkg.StudentID,
+		LessonsTotal: pkg.LessonCount,
+		PriceMinor:   pkg.PriceMinor,
+		Currency:     pkg.Currency,
+	})
+}
+
+// ChargeCompletedLessons charges unpaid lessons completed within the lookback period and returns the number of processed ones.
+// A lesson is paid from a package of the pair if there is one with lessons left, otherwise its price is charged to the student balance.
+// Lessons without a price are not charged and are logged until the tutor sets it.
+func (s *PaymentService) ChargeCompletedLessons(ctx context.Context, lookback time.Duration) (int, error) {
+	listRequest := &api3.ListCompletedUnpaidLessonsRequest{After: timestamppb.New(time.Now().Add(-lookback))}
+	resp, err := retry(ctx, maxRetries, retryDelay, func() (*api3.ListLessonsResponse, error) {
+		return s.scheduleClient.ListCompletedUnpaidLessons(ctxWithMetadata(ctx), listRequest)
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(resp.Lessons) == 0 {
+		return 0, nil
+	}
+
+	lessonIDs := make([]uuid.UUID, 0, len(resp.Lessons))
+	for _, lesson := range resp.Lessons {
+		if id, err := uuid.Parse(lesson.Id); err == nil {
+			lessonIDs = append(lessonIDs, id)
+		}
+	}
+	charges, err := s.repo.ListLessonCharges(ctx, lessonIDs)
+	if err != nil {
+		return 0, err
+	}
+	charged := make(map[uuid.UUID]*models.LedgerTransaction, len(charges))
+	for _, charge := range charges {
+		charged[charge.ReferenceID] = charge
+	}
+
+	logger, hasLogger := logging.GetFromContext(ctx)
+
+	processed := 0
+	for _, lesson := range resp.Lessons {
+		done, err := s.chargeCompletedLesson(ctx, lesson, charged)
+		if errors.Is(err, errdefs.ErrNoPrice) {
+			if hasLogger {
+				logger.Warn(ctx, "completed lesson has no price, it is not charged",
+					zap.String("lesson_id", lesson.Id), zap.String("tutor_id", lesson.TutorId))
+			}
+			continue
+		}
+		if err != nil {
+			if hasLogger {
+				logger.Error(ctx, "failed to charge completed lesson", zap.String("lesson_id", lesson.Id), zap.Error(err))
+			}
+			continue
+		}
+		if done {
+			processed++
+		}
+	}
+
+	return processed, nil
+}
+
+// chargeCompletedLesson reports whether the lesson was charged or marked as paid.
+func (s *PaymentService) chargeCompletedLesson(ctx context.Context, lesson *api3.Lesson, charged map[uuid.UUID]*models.LedgerTransaction) (bool, error) {
+	lessonID, err := uuid.Parse(lesson.Id)
+	if err != nil {
+		return false, err
+	}
+
+	if charge, ok := charged[lessonID]; ok {
+		// a lesson charged to the balance stays unpaid until the student pays for it
+		if charge.PackagePurchaseID == nil {
+			return false, nil
+		}
+		// the lesson was paid from a package, but marking it failed last time
+		return true, s.markLessonPaid(ctx, lessonID, charge.ID)
+	}
+
+	// the currency of the package and the amount are unknown, a zero charge would hide the debt
+	price := lessonPrice(lesson)
+	if price == nil {
+		return false, errdefs.ErrNoPrice
+	}
+
+	tutorID, err := uuid.Parse(lesson.TutorId)
+	if err != nil {
+		return false, err
+	}
+	studentID, err := uuid.Parse(lesson.StudentId)
+	if err != nil {
+		return false, err
+	}
+
+	charge := &models.LessonChargeInput{
+		ID:        uuid.New(),
+		LessonID:  lessonID,
+		TutorID:   tutorID,
+		StudentID: studentID,
+		Price:     *price,
+	}
+
+	_, err = s.repo.ConsumePackageLesson(ctx, charge)
+	switch {
+	case err == nil:
+		return true, s.markLessonPaid(ctx, lessonID, charge.ID)
+	case errors.Is(err, errdefs.ErrAlreadyExists):
+		// charged concurrently
+		return false, nil
+	case !errors.Is(err, errdefs.ErrNotFound):
+		return false, err
+	}
+
+	created, err := s.repo.CreateLedgerTransaction(ctx, &models.LedgerTransactionCreateInput{
+		ID:          charge.ID,
+		TutorID:     tutorID,
+		StudentID:   studentID,
+		Kind:        models.LedgerKindLessonCharge,
+		ReferenceID: lessonID,
+		Currency:    charge.Price.Currency,
+		Postings: []models.LedgerPosting{
+			{Account: models.LedgerAccountStudent, AmountMinor: charge.Price.Amount},
+			{Account: models.LedgerAccountTutor, AmountMinor: -charge.Price.Amount},
+		},
+	})
+	return created, err
+}
+
+// markLessonPaid marks the lesson as paid by the payment: a receipt, an online payment or a package charge.
+func (s *PaymentService) markLessonPaid(ctx context.Context, lessonID uuid.UUID, paymentID uuid.UUID) error {
+	markAsPaidRequest := &api3.MarkAsPaidRequest{Id: lessonID.String(), PaymentId: paymentID.String()}
+	_, err := retry(ctx, maxRetries, retryDelay, func() (*api3.Lesson, error) {
+		return s.scheduleClient.MarkAsPaid(ctxWithMetadata(ctx), markAsPaidRequest)
+	})
+	return err
+}
+
+// checkPairMember checks that the caller is the tutor or the student of the pair, or a guardian of the student.
+func (s *PaymentService) checkPairMember(ctx context.Context, tutorID uuid.UUID, studentID uuid.UUID) error {
+	if tutorID == uuid.Nil || studentID == uuid.Nil {
+		return errdefs.ErrInvalidArgument
+	}
+	userID, _, ok := caller(ctx)
+	if !ok {
+		return errdefs.ErrPermissionDenied
+	}
+	if userID == tutorID || userID == studentID {
+		return nil
+	}
+	return s.checkGuardian(ctx, studentID)
+}
+
+// checkGuardian checks that the caller is an active guardian of the student.
+func (s *PaymentService) checkGuardian(ctx context.Context, studentID uuid.UUID) error {
+	userID, role, ok := caller(ctx)
+	if !ok || role != models.RoleGuardian {
+		return errdefs.ErrPermissionDenied
+	}
+
+	getGuardianStudentRequest := &api4.GetGuardianStudentRequest{
+		GuardianId: userID.String(),
+		StudentId:  studentID.String(),
+	}
+	guardianStudent, err := s.userClient.GetGuardianStudent(ctxWithMetadata(ctx), getGuardianStudentRequest)
+	if status.Code(err) == codes.NotFound {
+		return errdefs.ErrPermissionDenied
+	}
+	if err != nil {
+		return err
+	}
+	if guardianStudent.GetStatus() != "active" {
+		return errdefs.ErrPermissionDenied
+	}
+	return nil
+}
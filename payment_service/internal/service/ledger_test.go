@@ -0,0 +1,317 @@
+package service_test
+
+import (
+	"common_library/money"
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	errdefs "paymentservice/internal/errors"
+	"paymentservice/internal/models"
+	api "schedule_service/pkg/api"
+	"testing"
+	"time"
+	userapi "userservice/pkg/api"
+)
+
+func TestGetBalance(t *testing.T) {
+	tutorID := uuid.New()
+	studentID := uuid.New()
+	input := &models.GetBalanceInput{TutorId: tutorID, StudentId: studentID}
+
+	for name, ctx := range map[string]context.Context{
+		"Tutor":   tutorCtx(tutorID),
+		"Student": studentCtx(studentID),
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctrl, svc, mockRepo, _, _, _ := setup(t)
+			defer ctrl.Finish()
+
+			mockRepo.EXPECT().GetBalance(gomock.Any(), tutorID, studentID).
+				Return(&models.Balance{TutorID: tutorID, StudentID: studentID, Balances: []money.Money{money.New(-150000, money.RUB)}}, nil)
+
+			balance, err := svc.GetBalance(ctx, input)
+			assert.NoError(t, err)
+			assert.Equal(t, []money.Money{money.New(-150000, money.RUB)}, balance.Balances)
+		})
+	}
+
+	t.Run("Guardian", func(t *testing.T) {
+		ctrl, svc, mockRepo, mockUserClient, _, _ := setup(t)
+		defer ctrl.Finish()
+
+		guardianID := uuid.New()
+		mockUserClient.EXPECT().GetGuardianStudent(gomock.Any(), &userapi.GetGuardianStudentRequest{
+			GuardianId: guardianID.String(),
+			StudentId:  studentID.String(),
+		}).Return(&userapi.GuardianStudent{Status: "active"}, nil)
+		mockRepo.EXPECT().GetBalance(gomock.Any(), tutorID, studentID).
+			Return(&models.Balance{TutorID: tutorID, StudentID: studentID}, nil)
+
+		_, err := svc.GetBalance(guardianCtx(guardianID), input)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Error_PendingGuardian", func(t *testing.T) {
+		ctrl, svc, _, mockUserClient, _, _ := setup(t)
+		defer ctrl.Finish()
+
+		mockUserClient.EXPECT().GetGuardianStudent(gomock.Any(), gomock.Any()).
+			Return(&userapi.GuardianStudent{Status: "pending"}, nil)
+
+		_, err := svc.GetBalance(guardianCtx(uuid.New()), input)
+		assert.ErrorIs(t, err, errdefs.ErrPermissionDenied)
+	})
+
+	t.Run("Error_OtherUser", func(t *testing.T) {
+		_, svc, _, _, _, _ := setup(t)
+
+		_, err := svc.GetBalance(studentCtx(uuid.New()), input)
+		assert.ErrorIs(t, err, errdefs.ErrPermissionDenied)
+	})
+
+	t.Run("Error_EmptyPair", func(t *testing.T) {
+		_, svc, _, _, _, _ := setup(t)
+
+		_, err := svc.GetBalance(tutorCtx(tutorID), &models.GetBalanceInput{TutorId: tutorID})
+		assert.ErrorIs(t, err, errdefs.ErrInvalidArgument)
+	})
+}
+
+func TestListLedgerEntries(t *testing.T) {
+	tutorID := uuid.New()
+	studentID := uuid.New()
+
+	t.Run("DefaultLimit", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, _ := setup(t)
+		defer ctrl.Finish()
+
+		mockRepo.EXPECT().ListLedgerEntries(gomock.Any(), tutorID, studentID, 50).Return(nil, nil)
+
+		_, err := svc.ListLedgerEntries(studentCtx(studentID), &models.ListLedgerEntriesInput{TutorId: tutorID, StudentId: studentID})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Error_LimitTooLarge", func(t *testing.T) {
+		_, svc, _, _, _, _ := setup(t)
+
+		_, err := svc.ListLedgerEntries(studentCtx(studentID), &models.ListLedgerEntriesInput{TutorId: tutorID, StudentId: studentID, Limit: 1000})
+		assert.ErrorIs(t, err, errdefs.ErrInvalidArgument)
+	})
+}
+
+func TestCreatePackage(t *testing.T) {
+	tutorID := uuid.New()
+	studentID := uuid.New()
+	input := &models.CreatePackageInput{StudentId: studentID, Name: " 8 lessons ", LessonCount: 8, Price: money.New(1200000, money.RUB)}
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl, svc, mockRepo, mockUserClient, _, _ := setup(t)
+		defer ctrl.Finish()
+
+		mockUserClient.EXPECT().GetTutorStudent(gomock.Any(), &userapi.GetTutorStudentRequest{
+			TutorId:   tutorID.String(),
+			StudentId: studentID.String(),
+		}).Return(&userapi.TutorStudent{}, nil)
+		mockRepo.EXPECT().CreatePackage(gomock.Any(), gomock.Cond(func(input *models.LessonPackageCreateInput) bool {
+			return input.ID != uuid.Nil && input.TutorID == tutorID && input.StudentID == studentID &&
+				input.Name == "8 lessons" && input.LessonCount == 8 && input.PriceMinor == 1200000 && input.Currency == money.RUB
+		})).Return(&models.LessonPackage{ID: uuid.New()}, nil)
+
+		_, err := svc.CreatePackage(tutorCtx(tutorID), input)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Error_NotTutor", func(t *testing.T) {
+		_, svc, _, _, _, _ := setup(t)
+
+		_, err := svc.CreatePackage(studentCtx(studentID), input)
+		assert.ErrorIs(t, err, errdefs.ErrPermissionDenied)
+	})
+
+	t.Run("Error_NotStudentOfTutor", func(t *testing.T) {
+		ctrl, svc, _, mockUserClient, _, _ := setup(t)
+		defer ctrl.Finish()
+
+		mockUserClient.EXPECT().GetTutorStudent(gomock.Any(), gomock.Any()).
+			Return(nil, status.Error(codes.NotFound, "not found"))
+
+		_, err := svc.CreatePackage(tutorCtx(tutorID), input)
+		assert.ErrorIs(t, err, errdefs.ErrNotFound)
+	})
+
+	t.Run("Error_InvalidInput", func(t *testing.T) {
+		_, svc, _, _, _, _ := setup(t)
+
+		for _, invalid := range []*models.CreatePackageInput{
+			{StudentId: studentID, Name: " ", LessonCount: 8},
+			{StudentId: studentID, Name: "pack", LessonCount: 0},
+			{StudentId: studentID, Name: "pack", LessonCount: 8, Price: money.New(-1, money.RUB)},
+			{Name: "pack", LessonCount: 8},
+		} {
+			_, err := svc.CreatePackage(tutorCtx(tutorID), invalid)
+			assert.ErrorIs(t, err, errdefs.ErrInvalidArgument)
+		}
+	})
+}
+
+func TestPurchasePackage(t *testing.T) {
+	tutorID := uuid.New()
+	pkg := &models.LessonPackage{ID: uuid.New(), TutorID: tutorID, StudentID: uuid.New(), LessonCount: 8, PriceMinor: 1200000, Currency: money.EUR}
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, _ := setup(t)
+		defer ctrl.Finish()
+
+		mockRepo.EXPECT().GetPackageByID(gomock.Any(), pkg.ID).Return(pkg, nil)
+		mockRepo.EXPECT().CreatePackagePurchase(gomock.Any(), gomock.Cond(func(input *models.PackagePurchaseCreateInput) bool {
+			return input.PackageID == pkg.ID && input.TutorID == tutorID && input.StudentID == pkg.StudentID &&
+				input.LessonsTotal == 8 && input.PriceMinor == 1200000 && input.Currency == money.EUR
+		})).Return(&models.PackagePurchase{ID: uuid.New()}, nil)
+
+		_, err := svc.PurchasePackage(tutorCtx(tutorID), &models.PurchasePackageInput{PackageId: pkg.ID})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Error_Student", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, _ := setup(t)
+		defer ctrl.Finish()
+
+		mockRepo.EXPECT().GetPackageByID(gomock.Any(), pkg.ID).Return(pkg, nil)
+
+		_, err := svc.PurchasePackage(studentCtx(pkg.StudentID), &models.PurchasePackageInput{PackageId: pkg.ID})
+		assert.ErrorIs(t, err, errdefs.ErrPermissionDenied)
+	})
+}
+
+func TestChargeCompletedLessons(t *testing.T) {
+	tutorID := uuid.New()
+	studentID := uuid.New()
+
+	newLesson := func() *api.Lesson {
+		return &api.Lesson{
+			Id:        uuid.New().String(),
+			TutorId:   tutorID.String(),
+			StudentId: studentID.String(),
+			Status:    "completed",
+			Price:     rub(1500),
+		}
+	}
+
+	t.Run("PaidFromPackage", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, mockSchedule := setup(t)
+		defer ctrl.Finish()
+
+		lesson := newLesson()
+		lessonID := uuid.MustParse(lesson.Id)
+
+		mockSchedule.EXPECT().ListCompletedUnpaidLessons(gomock.Any(), gomock.Any()).
+			Return(&api.ListLessonsResponse{Lessons: []*api.Lesson{lesson}}, nil)
+		mockRepo.EXPECT().ListLessonCharges(gomock.Any(), []uuid.UUID{lessonID}).Return(nil, nil)
+		var chargeID uuid.UUID
+		mockRepo.EXPECT().ConsumePackageLesson(gomock.Any(), gomock.Cond(func(input *models.LessonChargeInput) bool {
+			return input.LessonID == lessonID && input.TutorID == tutorID && input.StudentID == studentID && input.Price == money.New(150000, money.RUB)
+		})).DoAndReturn(func(_ context.Context, input *models.LessonChargeInput) (*models.PackagePurchase, error) {
+			chargeID = input.ID
+			return &models.PackagePurchase{LessonsUsed: 1}, nil
+		})
+		mockSchedule.EXPECT().MarkAsPaid(gomock.Any(), gomock.Cond(func(req *api.MarkAsPaidRequest) bool {
+			return req.Id == lesson.Id && req.PaymentId == chargeID.String()
+		})).Return(lesson, nil)
+
+		processed, err := svc.ChargeCompletedLessons(context.Background(), time.Hour)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, processed)
+	})
+
+	t.Run("ChargedToBalance", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, mockSchedule := setup(t)
+		defer ctrl.Finish()
+
+		lesson := newLesson()
+		lessonID := uuid.MustParse(lesson.Id)
+
+		mockSchedule.EXPECT().ListCompletedUnpaidLessons(gomock.Any(), gomock.Any()).
+			Return(&api.ListLessonsResponse{Lessons: []*api.Lesson{lesson}}, nil)
+		mockRepo.EXPECT().ListLessonCharges(gomock.Any(), gomock.Any()).Return(nil, nil)
+		mockRepo.EXPECT().ConsumePackageLesson(gomock.Any(), gomock.Any()).Return(nil, errdefs.ErrNotFound)
+		mockRepo.EXPECT().CreateLedgerTransaction(gomock.Any(), gomock.Cond(func(input *models.LedgerTransactionCreateInput) bool {
+			return input.Kind == models.LedgerKindLessonCharge && input.ReferenceID == lessonID &&
+				input.Postings[0].Account == models.LedgerAccountStudent && input.Postings[0].AmountMinor == 150000 && input.Currency == money.RUB
+		})).Return(true, nil)
+
+		processed, err := svc.ChargeCompletedLessons(context.Background(), time.Hour)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, processed)
+	})
+
+	t.Run("AlreadyCharged", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, mockSchedule := setup(t)
+		defer ctrl.Finish()
+
+		toBalance := newLesson()
+		fromPackage := newLesson()
+		purchaseID := uuid.New()
+		chargeID := uuid.New()
+
+		mockSchedule.EXPECT().ListCompletedUnpaidLessons(gomock.Any(), gomock.Any()).
+			Return(&api.ListLessonsResponse{Lessons: []*api.Lesson{toBalance, fromPackage}}, nil)
+		mockRepo.EXPECT().ListLessonCharges(gomock.Any(), gomock.Any()).Return([]*models.LedgerTransaction{
+			{ReferenceID: uuid.MustParse(toBalance.Id)},
+			{ID: chargeID, ReferenceID: uuid.MustParse(fromPackage.Id), PackagePurchaseID: &purchaseID},
+		}, nil)
+		// marking the lesson paid from the package failed last time
+		mockSchedule.EXPECT().MarkAsPaid(gomock.Any(), &api.MarkAsPaidRequest{Id: fromPackage.Id, PaymentId: chargeID.String()}).Return(fromPackage, nil)
+
+		processed, err := svc.ChargeCompletedLessons(context.Background(), time.Hour)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, processed)
+	})
+
+	t.Run("SkipsLessonWithoutPrice", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, mockSchedule := setup(t)
+		defer ctrl.Finish()
+
+		unpriced := newLesson()
+		unpriced.Price = nil
+		lesson := newLesson()
+
+		mockSchedule.EXPECT().ListCompletedUnpaidLessons(gomock.Any(), gomock.Any()).
+			Return(&api.ListLessonsResponse{Lessons: []*api.Lesson{unpriced, lesson}}, nil)
+		mockRepo.EXPECT().ListLessonCharges(gomock.Any(), gomock.Any()).Return(nil, nil)
+		mockRepo.EXPECT().ConsumePackageLesson(gomock.Any(), gomock.Cond(func(input *models.LessonChargeInput) bool {
+			return input.LessonID.String() == lesson.Id
+		})).Return(nil, errdefs.ErrNotFound)
+		mockRepo.EXPECT().CreateLedgerTransaction(gomock.Any(), gomock.Cond(func(input *models.LedgerTransactionCreateInput) bool {
+			return input.ReferenceID.String() == lesson.Id
+		})).Return(true, nil)
+
+		processed, err := svc.ChargeCompletedLessons(context.Background(), time.Hour)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, processed)
+	})
+
+	t.Run("ContinuesAfterFailure", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, mockSchedule := setup(t)
+		defer ctrl.Finish()
+
+		failing := newLesson()
+		lesson := newLesson()
+
+		mockSchedule.EXPECT().ListCompletedUnpaidLessons(gomock.Any(), gomock.Any()).
+			Return(&api.ListLessonsResponse{Lessons: []*api.Lesson{failing, lesson}}, nil)
+		mockRepo.EXPECT().ListLessonCharges(gomock.Any(), gomock.Any()).Return(nil, nil)
+		gomock.InOrder(
+			mockRepo.EXPECT().ConsumePackageLesson(gomock.Any(), gomock.Any()).Return(nil, errors.New("db is down")),
+			mockRepo.EXPECT().ConsumePackageLesson(gomock.Any(), gomock.Any()).Return(nil, errdefs.ErrNotFound),
+		)
+		mockRepo.EXPECT().CreateLedgerTransaction(gomock.Any(), gomock.Any()).Return(true, nil)
+
+		processed, err := svc.ChargeCompletedLessons(context.Background(), time.Hour)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, processed)
+	})
+}
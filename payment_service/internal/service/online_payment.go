@@ -0,0 +1,180 @@
+package service
+
+import (
+	"common_library/logging"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	errdefs "paymentservice/internal/errors"
+	"paymentservice/internal/models"
+	api3 "schedule_service/pkg/api"
+)
+
+// CreateOnlinePayment starts a card payment for the lesson through the payment provider. Only the student
+// of the lesson can pay for it. A lesson has at most one pending payment, it is returned instead of creating
+// a new one; a pending payment with another price or provider is canceled and replaced. The provider captures
+// payments at once and cannot cancel them, so a replaced payment the student still pays is refunded.
+func (s *PaymentService) CreateOnlinePayment(ctx context.Context, input *models.CreateOnlinePaymentInput) (*models.OnlinePayment, error) {
+	if s.paymentProvider == nil {
+		return nil, errdefs.ErrNoProvider
+	}
+	if input.LessonId == uuid.Nil || input.ReturnUrl == "" {
+		return nil, errdefs.ErrInvalidArgument
+	}
+
+	getLessonRequest := &api3.GetLessonRequest{Id: input.LessonId.String()}
+	lesson, err := retry(ctx, maxRetries, retryDelay, func() (*api3.Lesson, error) {
+		return s.scheduleClient.GetLesson(ctxWithMetadata(ctx), getLessonRequest)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	studentID, err := uuid.Parse(lesson.StudentId)
+	if err != nil {
+		return nil, err
+	}
+	if userID, _, ok := caller(ctx); !ok || userID != studentID {
+		return nil, errdefs.ErrPermissionDenied
+	}
+	if lesson.IsPaid {
+		return nil, errdefs.ErrAlreadyExists
+	}
+	price := lessonPrice(lesson)
+	if price == nil || price.Amount <= 0 {
+		return nil, errdefs.ErrInvalidArgument
+	}
+	tutorID, err := uuid.Parse(lesson.TutorId)
+	if err != nil {
+		return nil, err
+	}
+
+	// a receipt under review or approved already pays for the lesson
+	lastReceipt, err := s.repo.GetReceiptByLessonID(ctx, input.LessonId)
+	if err != nil && !errors.Is(err, errdefs.ErrNotFound) {
+		return nil, err
+	}
+	if lastReceipt != nil && lastReceipt.Status != models.ReceiptStatusRejected {
+		return nil, errdefs.ErrAlreadyExists
+	}
+
+	pending, err := s.repo.GetPendingOnlinePayment(ctx, input.LessonId)
+	if err != nil && !errors.Is(err, errdefs.ErrNotFound) {
+		return nil, err
+	}
+	if pending != nil {
+		if pending.Provider == s.paymentProvider.Name() && pending.Amount() == *price {
+			return pending, nil
+		}
+		if err := s.repo.CancelOnlinePayment(ctx, pending.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	paymentID := uuid.New()
+	providerPayment, err := s.paymentProvider.CreatePayment(ctx, &models.ProviderPaymentInput{
+		IdempotencyKey: paymentID.String(),
+		Amount:         *price,
+		Description:    fmt.Sprintf("Оплата занятия %s", lesson.Id),
+		ReturnURL:      input.ReturnUrl,
+		Metadata: map[string]string{
+			"payment_id": paymentID.String(),
+			"lesson_id":  lesson.Id,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	payment, err := s.repo.CreateOnlinePayment(ctx, &models.OnlinePaymentCreateInput{
+		ID:                paymentID,
+		Provider:          s.paymentProvider.Name(),
+		ProviderPaymentID: providerPayment.ID,
+		LessonID:          input.LessonId,
+		TutorID:           tutorID,
+		StudentID:         studentID,
+		AmountMinor:       price.Amount,
+		Currency:          price.Currency,
+		ConfirmationURL:   providerPayment.ConfirmationURL,
+	})
+	if errors.Is(err, errdefs.ErrAlreadyExists) {
+		// a concurrent request created the pending payment first, the provider payment of this one expires unused
+		return s.repo.GetPendingOnlinePayment(ctx, input.LessonId)
+	}
+	return payment, err
+}
+
+// HandleProviderWebhook applies a payment notification of the provider. Notifications are keyed by the provider
+// payment id, so redelivered ones are applied once. A succeeded payment is credited to the student's balance
+// and marks the lesson as paid; marking is repeated on every delivery until it succeeds. A succeeded payment
+// that was canceled here is refunded to the student.
+func (s *PaymentService) HandleProviderWebhook(ctx context.Context, input *models.ProviderWebhook) error {
+	if s.paymentProvider == nil {
+		return errdefs.ErrNoProvider
+	}
+	if input.Provider != s.paymentProvider.Name() {
+		return errdefs.ErrNotFound
+	}
+
+	providerPayment, err := s.paymentProvider.ParseWebhook(input.Body, input.Headers)
+	if err != nil {
+		return err
+	}
+
+	payment, err := s.repo.GetOnlinePaymentByProviderID(ctx, input.Provider, providerPayment.ID)
+	if errors.Is(err, errdefs.ErrNotFound) {
+		// the payment was not created by this service, nothing to apply
+		if logger, ok := logging.GetFromContext(ctx); ok {
+			logger.Warn(ctx, "webhook for unknown payment",
+				zap.String("provider", input.Provider),
+				zap.String("provider_payment_id", providerPayment.ID),
+			)
+		}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	switch providerPayment.Status {
+	case models.OnlinePaymentStatusSucceeded:
+		if providerPayment.Amount != payment.Amount() {
+			return errdefs.ErrInvalidPayment
+		}
+		switch payment.Status {
+		case models.OnlinePaymentStatusCanceled:
+			return s.refundCanceledOnlinePayment(ctx, payment)
+		case models.OnlinePaymentStatusRefunded:
+			// the money is already returned, the lesson must not be marked as paid again
+			return nil
+		}
+		if _, err := s.repo.CompleteOnlinePayment(ctx, payment.ID); err != nil {
+			return err
+		}
+		return s.markLessonPaid(ctx, payment.LessonID, payment.ID)
+
+	case models.OnlinePaymentStatusCanceled:
+		return s.repo.CancelOnlinePayment(ctx, payment.ID)
+
+	default:
+		return nil
+	}
+}
+
+// refundCanceledOnlinePayment returns the money of a payment the student paid after it was replaced.
+// The refund is keyed by the payment, so redelivered notifications do not refund it twice.
+func (s *PaymentService) refundCanceledOnlinePayment(ctx context.Context, payment *models.OnlinePayment) error {
+	if logger, ok := logging.GetFromContext(ctx); ok {
+		logger.Warn(ctx, "canceled online payment succeeded, refunding it",
+			zap.String("payment_id", payment.ID.String()),
+			zap.String("lesson_id", payment.LessonID.String()),
+		)
+	}
+
+	if _, err := s.paymentProvider.Refund(ctx, payment.ProviderPaymentID, payment.Amount(), "canceled-"+payment.ID.String()); err != nil {
+		return err
+	}
+	return s.repo.RefundCanceledOnlinePayment(ctx, payment.ID)
+}
@@ -0,0 +1,312 @@
+package service_test
+
+import (
+	"common_library/money"
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	errdefs "paymentservice/internal/errors"
+	"paymentservice/internal/mocks"
+	"paymentservice/internal/models"
+	api "schedule_service/pkg/api"
+	"testing"
+	"time"
+)
+
+func TestCreateOnlinePayment(t *testing.T) {
+	lessonID := uuid.New()
+	tutorID := uuid.New()
+	studentID := uuid.New()
+	input := &models.CreateOnlinePaymentInput{LessonId: lessonID, ReturnUrl: "https://app/return"}
+	lesson := &api.Lesson{
+		Id:        lessonID.String(),
+		StudentId: studentID.String(),
+		TutorId:   tutorID.String(),
+		Price:     rub(1500),
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, mockScheduleClient := setup(t)
+		defer ctrl.Finish()
+		provider := mocks.NewMockPaymentProvider(ctrl)
+		svc.WithPaymentProvider(provider)
+		provider.EXPECT().Name().Return("yookassa").AnyTimes()
+
+		mockScheduleClient.EXPECT().GetLesson(gomock.Any(), &api.GetLessonRequest{Id: lessonID.String()}).Return(lesson, nil)
+		mockRepo.EXPECT().GetReceiptByLessonID(gomock.Any(), lessonID).Return(nil, errdefs.ErrNotFound)
+		mockRepo.EXPECT().GetPendingOnlinePayment(gomock.Any(), lessonID).Return(nil, errdefs.ErrNotFound)
+		provider.EXPECT().CreatePayment(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, in *models.ProviderPaymentInput) (*models.ProviderPayment, error) {
+				assert.Equal(t, money.New(150000, money.RUB), in.Amount)
+				assert.Equal(t, "https://app/return", in.ReturnURL)
+				assert.Equal(t, in.IdempotencyKey, in.Metadata["payment_id"])
+				return &models.ProviderPayment{ID: "pay-1", Status: models.OnlinePaymentStatusPending, Amount: in.Amount, ConfirmationURL: "https://pay/confirm"}, nil
+			})
+		mockRepo.EXPECT().CreateOnlinePayment(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, in *models.OnlinePaymentCreateInput) (*models.OnlinePayment, error) {
+				assert.Equal(t, "yookassa", in.Provider)
+				assert.Equal(t, "pay-1", in.ProviderPaymentID)
+				assert.Equal(t, tutorID, in.TutorID)
+				assert.Equal(t, studentID, in.StudentID)
+				assert.Equal(t, int64(150000), in.AmountMinor)
+				assert.Equal(t, money.RUB, in.Currency)
+				return &models.OnlinePayment{ID: in.ID, ProviderPaymentID: in.ProviderPaymentID, Status: models.OnlinePaymentStatusPending, ConfirmationURL: &in.ConfirmationURL}, nil
+			})
+
+		payment, err := svc.CreateOnlinePayment(studentCtx(studentID), input)
+		assert.NoError(t, err)
+		assert.Equal(t, "https://pay/confirm", *payment.ConfirmationURL)
+	})
+
+	t.Run("ReusesPending", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, mockScheduleClient := setup(t)
+		defer ctrl.Finish()
+		provider := mocks.NewMockPaymentProvider(ctrl)
+		svc.WithPaymentProvider(provider)
+		provider.EXPECT().Name().Return("yookassa").AnyTimes()
+
+		pending := &models.OnlinePayment{ID: uuid.New(), Provider: "yookassa", AmountMinor: 150000, Currency: money.RUB, Status: models.OnlinePaymentStatusPending}
+		mockScheduleClient.EXPECT().GetLesson(gomock.Any(), gomock.Any()).Return(lesson, nil)
+		mockRepo.EXPECT().GetReceiptByLessonID(gomock.Any(), lessonID).Return(nil, errdefs.ErrNotFound)
+		mockRepo.EXPECT().GetPendingOnlinePayment(gomock.Any(), lessonID).Return(pending, nil)
+
+		payment, err := svc.CreateOnlinePayment(studentCtx(studentID), input)
+		assert.NoError(t, err)
+		assert.Equal(t, pending, payment)
+	})
+
+	t.Run("ReplacesPendingWithOtherPrice", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, mockScheduleClient := setup(t)
+		defer ctrl.Finish()
+		provider := mocks.NewMockPaymentProvider(ctrl)
+		svc.WithPaymentProvider(provider)
+		provider.EXPECT().Name().Return("yookassa").AnyTimes()
+
+		stale := &models.OnlinePayment{ID: uuid.New(), Provider: "yookassa", AmountMinor: 100000, Currency: money.RUB, Status: models.OnlinePaymentStatusPending}
+		mockScheduleClient.EXPECT().GetLesson(gomock.Any(), gomock.Any()).Return(lesson, nil)
+		mockRepo.EXPECT().GetReceiptByLessonID(gomock.Any(), lessonID).Return(nil, errdefs.ErrNotFound)
+		gomock.InOrder(
+			mockRepo.EXPECT().GetPendingOnlinePayment(gomock.Any(), lessonID).Return(stale, nil),
+			mockRepo.EXPECT().CancelOnlinePayment(gomock.Any(), stale.ID).Return(nil),
+			provider.EXPECT().CreatePayment(gomock.Any(), gomock.Any()).
+				Return(&models.ProviderPayment{ID: "pay-2", Status: models.OnlinePaymentStatusPending}, nil),
+			mockRepo.EXPECT().CreateOnlinePayment(gomock.Any(), gomock.Any()).
+				Return(&models.OnlinePayment{ID: uuid.New(), ProviderPaymentID: "pay-2"}, nil),
+		)
+
+		payment, err := svc.CreateOnlinePayment(studentCtx(studentID), input)
+		assert.NoError(t, err)
+		assert.Equal(t, "pay-2", payment.ProviderPaymentID)
+	})
+
+	t.Run("CreatedConcurrently", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, mockScheduleClient := setup(t)
+		defer ctrl.Finish()
+		provider := mocks.NewMockPaymentProvider(ctrl)
+		svc.WithPaymentProvider(provider)
+		provider.EXPECT().Name().Return("yookassa").AnyTimes()
+
+		other := &models.OnlinePayment{ID: uuid.New(), Provider: "yookassa", ProviderPaymentID: "pay-1", AmountMinor: 150000, Currency: money.RUB, Status: models.OnlinePaymentStatusPending}
+		mockScheduleClient.EXPECT().GetLesson(gomock.Any(), gomock.Any()).Return(lesson, nil)
+		mockRepo.EXPECT().GetReceiptByLessonID(gomock.Any(), lessonID).Return(nil, errdefs.ErrNotFound)
+		gomock.InOrder(
+			mockRepo.EXPECT().GetPendingOnlinePayment(gomock.Any(), lessonID).Return(nil, errdefs.ErrNotFound),
+			provider.EXPECT().CreatePayment(gomock.Any(), gomock.Any()).
+				Return(&models.ProviderPayment{ID: "pay-2", Status: models.OnlinePaymentStatusPending}, nil),
+			// the unique pending payment of the lesson was inserted by the other request
+			mockRepo.EXPECT().CreateOnlinePayment(gomock.Any(), gomock.Any()).Return(nil, errdefs.ErrAlreadyExists),
+			mockRepo.EXPECT().GetPendingOnlinePayment(gomock.Any(), lessonID).Return(other, nil),
+		)
+
+		payment, err := svc.CreateOnlinePayment(studentCtx(studentID), input)
+		assert.NoError(t, err)
+		assert.Equal(t, other, payment)
+	})
+
+	t.Run("ReceiptPending", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, mockScheduleClient := setup(t)
+		defer ctrl.Finish()
+		svc.WithPaymentProvider(mocks.NewMockPaymentProvider(ctrl))
+
+		mockScheduleClient.EXPECT().GetLesson(gomock.Any(), gomock.Any()).Return(lesson, nil)
+		mockRepo.EXPECT().GetReceiptByLessonID(gomock.Any(), lessonID).
+			Return(&models.PaymentReceipt{Status: models.ReceiptStatusPending}, nil)
+
+		_, err := svc.CreateOnlinePayment(studentCtx(studentID), input)
+		assert.ErrorIs(t, err, errdefs.ErrAlreadyExists)
+	})
+
+	t.Run("NotStudent", func(t *testing.T) {
+		ctrl, svc, _, _, _, mockScheduleClient := setup(t)
+		defer ctrl.Finish()
+		svc.WithPaymentProvider(mocks.NewMockPaymentProvider(ctrl))
+
+		mockScheduleClient.EXPECT().GetLesson(gomock.Any(), gomock.Any()).Return(lesson, nil)
+
+		_, err := svc.CreateOnlinePayment(tutorCtx(tutorID), input)
+		assert.ErrorIs(t, err, errdefs.ErrPermissionDenied)
+	})
+
+	t.Run("NoProvider", func(t *testing.T) {
+		ctrl, svc, _, _, _, _ := setup(t)
+		defer ctrl.Finish()
+
+		_, err := svc.CreateOnlinePayment(studentCtx(studentID), input)
+		assert.ErrorIs(t, err, errdefs.ErrNoProvider)
+	})
+}
+
+func TestHandleProviderWebhook(t *testing.T) {
+	lessonID := uuid.New()
+	payment := &models.OnlinePayment{
+		ID:                uuid.New(),
+		Provider:          "yookassa",
+		ProviderPaymentID: "pay-1",
+		LessonID:          lessonID,
+		AmountMinor:       150000,
+		Currency:          money.RUB,
+		Status:            models.OnlinePaymentStatusPending,
+		CreatedAt:         time.Now(),
+	}
+	webhook := &models.ProviderWebhook{Provider: "yookassa", Body: []byte("{}"), Headers: map[string]string{}}
+
+	setupProvider := func(t *testing.T) (*gomock.Controller, *mocks.MockIPaymentRepo, *mocks.MockScheduleServiceClient, *mocks.MockPaymentProvider, func(*models.ProviderWebhook) error) {
+		ctrl, svc, mockRepo, _, _, mockScheduleClient := setup(t)
+		provider := mocks.NewMockPaymentProvider(ctrl)
+		provider.EXPECT().Name().Return("yookassa").AnyTimes()
+		svc.WithPaymentProvider(provider)
+		return ctrl, mockRepo, mockScheduleClient, provider, func(in *models.ProviderWebhook) error {
+			return svc.HandleProviderWebhook(context.Background(), in)
+		}
+	}
+
+	t.Run("Succeeded", func(t *testing.T) {
+		ctrl, mockRepo, mockScheduleClient, provider, handle := setupProvider(t)
+		defer ctrl.Finish()
+
+		provider.EXPECT().ParseWebhook(webhook.Body, webhook.Headers).
+			Return(&models.ProviderPayment{ID: "pay-1", Status: models.OnlinePaymentStatusSucceeded, Amount: money.New(150000, money.RUB)}, nil)
+		mockRepo.EXPECT().GetOnlinePaymentByProviderID(gomock.Any(), "yookassa", "pay-1").Return(payment, nil)
+		mockRepo.EXPECT().CompleteOnlinePayment(gomock.Any(), payment.ID).Return(true, nil)
+		mockScheduleClient.EXPECT().MarkAsPaid(gomock.Any(), &api.MarkAsPaidRequest{Id: lessonID.String(), PaymentId: payment.ID.String()}).
+			Return(&api.Lesson{Id: lessonID.String(), IsPaid: true}, nil)
+
+		assert.NoError(t, handle(webhook))
+	})
+
+	t.Run("Redelivered", func(t *testing.T) {
+		ctrl, mockRepo, mockScheduleClient, provider, handle := setupProvider(t)
+		defer ctrl.Finish()
+
+		provider.EXPECT().ParseWebhook(gomock.Any(), gomock.Any()).
+			Return(&models.ProviderPayment{ID: "pay-1", Status: models.OnlinePaymentStatusSucceeded, Amount: money.New(150000, money.RUB)}, nil)
+		mockRepo.EXPECT().GetOnlinePaymentByProviderID(gomock.Any(), "yookassa", "pay-1").Return(payment, nil)
+		// nothing is credited twice, marking the lesson is idempotent
+		mockRepo.EXPECT().CompleteOnlinePayment(gomock.Any(), payment.ID).Return(false, nil)
+		mockScheduleClient.EXPECT().MarkAsPaid(gomock.Any(), gomock.Any()).Return(&api.Lesson{IsPaid: true}, nil)
+
+		assert.NoError(t, handle(webhook))
+	})
+
+	t.Run("AmountMismatch", func(t *testing.T) {
+		ctrl, mockRepo, _, provider, handle := setupProvider(t)
+		defer ctrl.Finish()
+
+		provider.EXPECT().ParseWebhook(gomock.Any(), gomock.Any()).
+			Return(&models.ProviderPayment{ID: "pay-1", Status: models.OnlinePaymentStatusSucceeded, Amount: money.New(1000, money.RUB)}, nil)
+		mockRepo.EXPECT().GetOnlinePaymentByProviderID(gomock.Any(), "yookassa", "pay-1").Return(payment, nil)
+
+		assert.ErrorIs(t, handle(webhook), errdefs.ErrInvalidPayment)
+	})
+
+	t.Run("CurrencyMismatch", func(t *testing.T) {
+		ctrl, mockRepo, _, provider, handle := setupProvider(t)
+		defer ctrl.Finish()
+
+		provider.EXPECT().ParseWebhook(gomock.Any(), gomock.Any()).
+			Return(&models.ProviderPayment{ID: "pay-1", Status: models.OnlinePaymentStatusSucceeded, Amount: money.New(150000, money.KZT)}, nil)
+		mockRepo.EXPECT().GetOnlinePaymentByProviderID(gomock.Any(), "yookassa", "pay-1").Return(payment, nil)
+
+		assert.ErrorIs(t, handle(webhook), errdefs.ErrInvalidPayment)
+	})
+
+	t.Run("SucceededAfterReplacement", func(t *testing.T) {
+		ctrl, mockRepo, _, provider, handle := setupProvider(t)
+		defer ctrl.Finish()
+
+		replaced := *payment
+		replaced.Status = models.OnlinePaymentStatusCanceled
+
+		provider.EXPECT().ParseWebhook(gomock.Any(), gomock.Any()).
+			Return(&models.ProviderPayment{ID: "pay-1", Status: models.OnlinePaymentStatusSucceeded, Amount: money.New(150000, money.RUB)}, nil)
+		mockRepo.EXPECT().GetOnlinePaymentByProviderID(gomock.Any(), "yookassa", "pay-1").Return(&replaced, nil)
+		// the money is returned instead of being lost, the lesson is not marked as paid
+		gomock.InOrder(
+			provider.EXPECT().Refund(gomock.Any(), "pay-1", money.New(150000, money.RUB), "canceled-"+payment.ID.String()).
+				Return(&models.ProviderRefund{ID: "refund-1", PaymentID: "pay-1", Status: "succeeded"}, nil),
+			mockRepo.EXPECT().RefundCanceledOnlinePayment(gomock.Any(), payment.ID).Return(nil),
+		)
+
+		assert.NoError(t, handle(webhook))
+	})
+
+	t.Run("SucceededAfterReplacementRefundFailed", func(t *testing.T) {
+		ctrl, mockRepo, _, provider, handle := setupProvider(t)
+		defer ctrl.Finish()
+
+		replaced := *payment
+		replaced.Status = models.OnlinePaymentStatusCanceled
+
+		provider.EXPECT().ParseWebhook(gomock.Any(), gomock.Any()).
+			Return(&models.ProviderPayment{ID: "pay-1", Status: models.OnlinePaymentStatusSucceeded, Amount: money.New(150000, money.RUB)}, nil)
+		mockRepo.EXPECT().GetOnlinePaymentByProviderID(gomock.Any(), "yookassa", "pay-1").Return(&replaced, nil)
+		provider.EXPECT().Refund(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("provider is down"))
+
+		// the provider redelivers the notification until the refund succeeds
+		assert.Error(t, handle(webhook))
+	})
+
+	t.Run("SucceededAfterRefund", func(t *testing.T) {
+		ctrl, mockRepo, _, provider, handle := setupProvider(t)
+		defer ctrl.Finish()
+
+		refunded := *payment
+		refunded.Status = models.OnlinePaymentStatusRefunded
+
+		provider.EXPECT().ParseWebhook(gomock.Any(), gomock.Any()).
+			Return(&models.ProviderPayment{ID: "pay-1", Status: models.OnlinePaymentStatusSucceeded, Amount: money.New(150000, money.RUB)}, nil)
+		mockRepo.EXPECT().GetOnlinePaymentByProviderID(gomock.Any(), "yookassa", "pay-1").Return(&refunded, nil)
+
+		assert.NoError(t, handle(webhook))
+	})
+
+	t.Run("Canceled", func(t *testing.T) {
+		ctrl, mockRepo, _, provider, handle := setupProvider(t)
+		defer ctrl.Finish()
+
+		provider.EXPECT().ParseWebhook(gomock.Any(), gomock.Any()).
+			Return(&models.ProviderPayment{ID: "pay-1", Status: models.OnlinePaymentStatusCanceled, Amount: money.New(150000, money.RUB)}, nil)
+		mockRepo.EXPECT().GetOnlinePaymentByProviderID(gomock.Any(), "yookassa", "pay-1").Return(payment, nil)
+		mockRepo.EXPECT().CancelOnlinePayment(gomock.Any(), payment.ID).Return(nil)
+
+		assert.NoError(t, handle(webhook))
+	})
+
+	t.Run("InvalidSignature", func(t *testing.T) {
+		ctrl, _, _, provider, handle := setupProvider(t)
+		defer ctrl.Finish()
+
+		provider.EXPECT().ParseWebhook(gomock.Any(), gomock.Any()).Return(nil, errdefs.ErrInvalidSignature)
+
+		assert.ErrorIs(t, handle(webhook), errdefs.ErrInvalidSignature)
+	})
+
+	t.Run("UnknownProvider", func(t *testing.T) {
+		ctrl, _, _, _, handle := setupProvider(t)
+		defer ctrl.Finish()
+
+		assert.ErrorIs(t, handle(&models.ProviderWebhook{Provider: "other"}), errdefs.ErrNotFound)
+	})
+}
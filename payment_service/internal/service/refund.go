@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	errdefs "paymentservice/internal/errors"
+	"paymentservice/internal/models"
+	api3 "schedule_service/pkg/api"
+)
+
+// RefundLesson reverses the payment of a cancelled paid lesson. Only the tutor of the lesson can refund it.
+// A credit note leaves the price on the student balance, a refund returns it to the student: through the
+// payment provider if the lesson was paid online, otherwise the tutor returns the money and the refund records it.
+// A lesson paid from a package is returned to the package and can only get a credit note.
+// The lesson is marked as unpaid; marking is repeated on every call until it succeeds.
+func (s *PaymentService) RefundLesson(ctx context.Context, input *models.RefundLessonInput) (*models.Refund, error) {
+	if input.LessonId == uuid.Nil || !input.Kind.IsValid() {
+		return nil, errdefs.ErrInvalidArgument
+	}
+
+	getLessonRequest := &api3.GetLessonRequest{Id: input.LessonId.String()}
+	lesson, err := retry(ctx, maxRetries, retryDelay, func() (*api3.Lesson, error) {
+		return s.scheduleClient.GetLesson(ctxWithMetadata(ctx), getLessonRequest)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tutorID, err := uuid.Parse(lesson.TutorId)
+	if err != nil {
+		return nil, err
+	}
+	studentID, err := uuid.Parse(lesson.StudentId)
+	if err != nil {
+		return nil, err
+	}
+	if userID, role, ok := caller(ctx); !ok || role != models.RoleTutor || userID != tutorID {
+		return nil, errdefs.ErrPermissionDenied
+	}
+
+	existing, err := s.repo.GetRefundByLessonID(ctx, input.LessonId)
+	if err == nil {
+		if existing.Kind != input.Kind {
+			return nil, errdefs.ErrAlreadyExists
+		}
+		// the lesson is refunded, but marking it failed last time
+		return existing, s.markLessonUnpaid(ctx, lesson)
+	}
+	if !errors.Is(err, errdefs.ErrNotFound) {
+		return nil, err
+	}
+
+	if lesson.Status != "cancelled" || !lesson.IsPaid {
+		return nil, errdefs.ErrNotRefundable
+	}
+
+	// lessons paid before the ledger have no charge to reverse
+	charge, err := s.repo.GetLessonCharge(ctx, input.LessonId)
+	if errors.Is(err, errdefs.ErrNotFound) {
+		return nil, errdefs.ErrNotRefundable
+	}
+	if err != nil {
+		return nil, err
+	}
+	if charge.PackagePurchaseID != nil && input.Kind == models.RefundKindRefund {
+		return nil, errdefs.ErrInvalidArgument
+	}
+
+	refund := &models.RefundCreateInput{
+		ID:                uuid.New(),
+		LessonID:          input.LessonId,
+		TutorID:           tutorID,
+		StudentID:         studentID,
+		Kind:              input.Kind,
+		Amount:            charge.Amount(),
+		Reason:            input.Reason,
+		PackagePurchaseID: charge.PackagePurchaseID,
+	}
+
+	receipt, err := s.repo.GetReceiptByLessonID(ctx, input.LessonId)
+	if err != nil && !errors.Is(err, errdefs.ErrNotFound) {
+		return nil, err
+	}
+	if receipt != nil && receipt.Status == models.ReceiptStatusApproved {
+		refund.ReceiptID = &receipt.ID
+	}
+
+	payment, err := s.repo.GetSucceededOnlinePayment(ctx, input.LessonId)
+	if err != nil && !errors.Is(err, errdefs.ErrNotFound) {
+		return nil, err
+	}
+	if payment != nil {
+		refund.OnlinePaymentID = &payment.ID
+		if input.Kind == models.RefundKindRefund {
+			if s.paymentProvider == nil || payment.Provider != s.paymentProvider.Name() {
+				return nil, errdefs.ErrNoProvider
+			}
+			// keyed by the lesson, so a retried refund does not return the money twice
+			providerRefund, err := s.paymentProvider.Refund(ctx, payment.ProviderPaymentID, refund.Amount, "refund-"+input.LessonId.String())
+			if err != nil {
+				return nil, err
+			}
+			refund.ProviderRefundID = &providerRefund.ID
+		}
+	}
+
+	created, err := s.repo.CreateRefund(ctx, refund)
+	if err != nil {
+		return nil, err
+	}
+	return created, s.markLessonUnpaid(ctx, lesson)
+}
+
+// ListRefunds returns refunds of the caller, newest first. The filter narrows them down to a pair.
+func (s *PaymentService) ListRefunds(ctx context.Context, input *models.ListRefundsInput) ([]*models.Refund, error) {
+	userID, role, ok := caller(ctx)
+	if !ok {
+		return nil, errdefs.ErrPermissionDenied
+	}
+
+	filter := &models.RefundFilter{
+		TutorID:   input.TutorId,
+		StudentID: input.StudentId,
+	}
+	switch role {
+	case models.RoleTutor:
+		if filter.TutorID != nil && *filter.TutorID != userID {
+			return nil, errdefs.ErrPermissionDenied
+		}
+		filter.TutorID = &userID
+	case models.RoleStudent:
+		if filter.StudentID != nil && *filter.StudentID != userID {
+			return nil, errdefs.ErrPermissionDenied
+		}
+		filter.StudentID = &userID
+	case models.RoleGuardian:
+		// refunds are per pair, a guardian sees them like the pair ledger
+		if filter.TutorID == nil || filter.StudentID == nil {
+			return nil, errdefs.ErrInvalidArgument
+		}
+		if err := s.checkPairMember(ctx, *filter.TutorID, *filter.StudentID); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errdefs.ErrPermissionDenied
+	}
+
+	return s.repo.ListRefunds(ctx, filter)
+}
+
+// markLessonUnpaid reverts the payment the lesson is marked as paid by.
+// Lessons paid before the payments were recorded on them stay paid.
+func (s *PaymentService) markLessonUnpaid(ctx context.Context, lesson *api3.Lesson) error {
+	if !lesson.IsPaid || lesson.PaymentId == nil {
+		return nil
+	}
+	markAsUnpaidRequest := &api3.MarkAsUnpaidRequest{Id: lesson.Id, PaymentId: lesson.GetPaymentId()}
+	_, err := retry(ctx, maxRetries, retryDelay, func() (*api3.Lesson, error) {
+		return s.scheduleClient.MarkAsUnpaid(ctxWithMetadata(ctx), markAsUnpaidRequest)
+	})
+	return err
+}
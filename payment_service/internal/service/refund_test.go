@@ -0,0 +1,219 @@
+package service_test
+
+import (
+	"common_library/money"
+	"context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	errdefs "paymentservice/internal/errors"
+	"paymentservice/internal/mocks"
+	"paymentservice/internal/models"
+	api "schedule_service/pkg/api"
+	"testing"
+	userapi "userservice/pkg/api"
+)
+
+func TestRefundLesson(t *testing.T) {
+	lessonID := uuid.New()
+	tutorID := uuid.New()
+	studentID := uuid.New()
+	paymentID := uuid.New().String()
+	lesson := &api.Lesson{
+		Id:        lessonID.String(),
+		StudentId: studentID.String(),
+		TutorId:   tutorID.String(),
+		Status:    "cancelled",
+		IsPaid:    true,
+		Price:     rub(1500),
+		PaymentId: &paymentID,
+	}
+	charge := &models.LessonCharge{
+		LedgerTransaction: models.LedgerTransaction{ID: uuid.New(), Kind: models.LedgerKindLessonCharge, ReferenceID: lessonID, Currency: money.RUB},
+		AmountMinor:       150000,
+	}
+
+	t.Run("CreditNoteForReceipt", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, mockScheduleClient := setup(t)
+		defer ctrl.Finish()
+
+		reason := "Заболел"
+		receipt := &models.PaymentReceipt{ID: uuid.New(), LessonID: lessonID, Status: models.ReceiptStatusApproved}
+		mockScheduleClient.EXPECT().GetLesson(gomock.Any(), &api.GetLessonRequest{Id: lessonID.String()}).Return(lesson, nil)
+		mockRepo.EXPECT().GetRefundByLessonID(gomock.Any(), lessonID).Return(nil, errdefs.ErrNotFound)
+		mockRepo.EXPECT().GetLessonCharge(gomock.Any(), lessonID).Return(charge, nil)
+		mockRepo.EXPECT().GetReceiptByLessonID(gomock.Any(), lessonID).Return(receipt, nil)
+		mockRepo.EXPECT().GetSucceededOnlinePayment(gomock.Any(), lessonID).Return(nil, errdefs.ErrNotFound)
+		mockRepo.EXPECT().CreateRefund(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, in *models.RefundCreateInput) (*models.Refund, error) {
+				assert.Equal(t, models.RefundKindCreditNote, in.Kind)
+				assert.Equal(t, money.New(150000, money.RUB), in.Amount)
+				assert.Equal(t, &receipt.ID, in.ReceiptID)
+				assert.Equal(t, &reason, in.Reason)
+				assert.Nil(t, in.OnlinePaymentID)
+				return &models.Refund{ID: in.ID, LessonID: in.LessonID, Kind: in.Kind}, nil
+			})
+		mockScheduleClient.EXPECT().MarkAsUnpaid(gomock.Any(), &api.MarkAsUnpaidRequest{Id: lessonID.String(), PaymentId: paymentID}).
+			Return(&api.Lesson{}, nil)
+
+		refund, err := svc.RefundLesson(tutorCtx(tutorID), &models.RefundLessonInput{LessonId: lessonID, Kind: models.RefundKindCreditNote, Reason: &reason})
+		require.NoError(t, err)
+		assert.Equal(t, lessonID, refund.LessonID)
+	})
+
+	t.Run("RefundThroughProvider", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, mockScheduleClient := setup(t)
+		defer ctrl.Finish()
+		provider := mocks.NewMockPaymentProvider(ctrl)
+		svc.WithPaymentProvider(provider)
+		provider.EXPECT().Name().Return("yookassa").AnyTimes()
+
+		payment := &models.OnlinePayment{ID: uuid.New(), Provider: "yookassa", ProviderPaymentID: "pay-1", LessonID: lessonID, Status: models.OnlinePaymentStatusSucceeded}
+		mockScheduleClient.EXPECT().GetLesson(gomock.Any(), gomock.Any()).Return(lesson, nil)
+		mockRepo.EXPECT().GetRefundByLessonID(gomock.Any(), lessonID).Return(nil, errdefs.ErrNotFound)
+		mockRepo.EXPECT().GetLessonCharge(gomock.Any(), lessonID).Return(charge, nil)
+		mockRepo.EXPECT().GetReceiptByLessonID(gomock.Any(), lessonID).Return(nil, errdefs.ErrNotFound)
+		mockRepo.EXPECT().GetSucceededOnlinePayment(gomock.Any(), lessonID).Return(payment, nil)
+		provider.EXPECT().Refund(gomock.Any(), "pay-1", money.New(150000, money.RUB), "refund-"+lessonID.String()).
+			Return(&models.ProviderRefund{ID: "refund-1", PaymentID: "pay-1", Status: "succeeded"}, nil)
+		mockRepo.EXPECT().CreateRefund(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, in *models.RefundCreateInput) (*models.Refund, error) {
+				assert.Equal(t, models.RefundKindRefund, in.Kind)
+				assert.Equal(t, &payment.ID, in.OnlinePaymentID)
+				assert.Equal(t, "refund-1", *in.ProviderRefundID)
+				return &models.Refund{ID: in.ID, LessonID: in.LessonID, Kind: in.Kind, ProviderRefundID: in.ProviderRefundID}, nil
+			})
+		mockScheduleClient.EXPECT().MarkAsUnpaid(gomock.Any(), gomock.Any()).Return(&api.Lesson{}, nil)
+
+		refund, err := svc.RefundLesson(tutorCtx(tutorID), &models.RefundLessonInput{LessonId: lessonID, Kind: models.RefundKindRefund})
+		require.NoError(t, err)
+		assert.Equal(t, "refund-1", *refund.ProviderRefundID)
+	})
+
+	t.Run("AlreadyRefunded", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, mockScheduleClient := setup(t)
+		defer ctrl.Finish()
+
+		existing := &models.Refund{ID: uuid.New(), LessonID: lessonID, Kind: models.RefundKindCreditNote}
+		unpaid := &api.Lesson{Id: lesson.Id, StudentId: lesson.StudentId, TutorId: lesson.TutorId, Status: "cancelled"}
+		mockScheduleClient.EXPECT().GetLesson(gomock.Any(), gomock.Any()).Return(unpaid, nil)
+		mockRepo.EXPECT().GetRefundByLessonID(gomock.Any(), lessonID).Return(existing, nil)
+		// the lesson is already unpaid, there is nothing to mark
+
+		refund, err := svc.RefundLesson(tutorCtx(tutorID), &models.RefundLessonInput{LessonId: lessonID, Kind: models.RefundKindCreditNote})
+		require.NoError(t, err)
+		assert.Equal(t, existing, refund)
+	})
+
+	t.Run("AlreadyRefunded_MarkingFailed", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, mockScheduleClient := setup(t)
+		defer ctrl.Finish()
+
+		existing := &models.Refund{ID: uuid.New(), LessonID: lessonID, Kind: models.RefundKindCreditNote}
+		mockScheduleClient.EXPECT().GetLesson(gomock.Any(), gomock.Any()).Return(lesson, nil)
+		mockRepo.EXPECT().GetRefundByLessonID(gomock.Any(), lessonID).Return(existing, nil)
+		mockScheduleClient.EXPECT().MarkAsUnpaid(gomock.Any(), &api.MarkAsUnpaidRequest{Id: lessonID.String(), PaymentId: paymentID}).
+			Return(&api.Lesson{}, nil)
+
+		refund, err := svc.RefundLesson(tutorCtx(tutorID), &models.RefundLessonInput{LessonId: lessonID, Kind: models.RefundKindCreditNote})
+		require.NoError(t, err)
+		assert.Equal(t, existing, refund)
+	})
+
+	t.Run("NotCancelled", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, mockScheduleClient := setup(t)
+		defer ctrl.Finish()
+
+		booked := &api.Lesson{Id: lesson.Id, StudentId: lesson.StudentId, TutorId: lesson.TutorId, Status: "booked", IsPaid: true}
+		mockScheduleClient.EXPECT().GetLesson(gomock.Any(), gomock.Any()).Return(booked, nil)
+		mockRepo.EXPECT().GetRefundByLessonID(gomock.Any(), lessonID).Return(nil, errdefs.ErrNotFound)
+
+		_, err := svc.RefundLesson(tutorCtx(tutorID), &models.RefundLessonInput{LessonId: lessonID, Kind: models.RefundKindCreditNote})
+		assert.ErrorIs(t, err, errdefs.ErrNotRefundable)
+	})
+
+	t.Run("PackageLessonRefund", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, mockScheduleClient := setup(t)
+		defer ctrl.Finish()
+
+		purchaseID := uuid.New()
+		packageCharge := &models.LessonCharge{
+			LedgerTransaction: models.LedgerTransaction{ID: uuid.New(), Currency: money.RUB, PackagePurchaseID: &purchaseID},
+			AmountMinor:       100000,
+		}
+		mockScheduleClient.EXPECT().GetLesson(gomock.Any(), gomock.Any()).Return(lesson, nil)
+		mockRepo.EXPECT().GetRefundByLessonID(gomock.Any(), lessonID).Return(nil, errdefs.ErrNotFound)
+		mockRepo.EXPECT().GetLessonCharge(gomock.Any(), lessonID).Return(packageCharge, nil)
+
+		_, err := svc.RefundLesson(tutorCtx(tutorID), &models.RefundLessonInput{LessonId: lessonID, Kind: models.RefundKindRefund})
+		assert.ErrorIs(t, err, errdefs.ErrInvalidArgument)
+	})
+
+	t.Run("NotTutor", func(t *testing.T) {
+		ctrl, svc, _, _, _, mockScheduleClient := setup(t)
+		defer ctrl.Finish()
+
+		mockScheduleClient.EXPECT().GetLesson(gomock.Any(), gomock.Any()).Return(lesson, nil)
+
+		_, err := svc.RefundLesson(studentCtx(studentID), &models.RefundLessonInput{LessonId: lessonID, Kind: models.RefundKindCreditNote})
+		assert.ErrorIs(t, err, errdefs.ErrPermissionDenied)
+	})
+
+	t.Run("InvalidKind", func(t *testing.T) {
+		ctrl, svc, _, _, _, _ := setup(t)
+		defer ctrl.Finish()
+
+		_, err := svc.RefundLesson(tutorCtx(tutorID), &models.RefundLessonInput{LessonId: lessonID, Kind: "cash"})
+		assert.ErrorIs(t, err, errdefs.ErrInvalidArgument)
+	})
+}
+
+func TestListRefunds(t *testing.T) {
+	ctrl, svc, mockRepo, _, _, _ := setup(t)
+	defer ctrl.Finish()
+
+	studentID := uuid.New()
+	mockRepo.EXPECT().ListRefunds(gomock.Any(), &models.RefundFilter{StudentID: &studentID}).Return(nil, nil)
+
+	_, err := svc.ListRefunds(studentCtx(studentID), &models.ListRefundsInput{})
+	assert.NoError(t, err)
+
+	_, err = svc.ListRefunds(studentCtx(studentID), &models.ListRefundsInput{StudentId: new(uuid.UUID)})
+	assert.ErrorIs(t, err, errdefs.ErrPermissionDenied)
+
+	t.Run("Guardian", func(t *testing.T) {
+		ctrl, svc, mockRepo, mockUserClient, _, _ := setup(t)
+		defer ctrl.Finish()
+
+		guardianID := uuid.New()
+		tutorID := uuid.New()
+		mockUserClient.EXPECT().GetGuardianStudent(gomock.Any(), &userapi.GetGuardianStudentRequest{
+			GuardianId: guardianID.String(),
+			StudentId:  studentID.String(),
+		}).Return(&userapi.GuardianStudent{Status: "active"}, nil)
+		mockRepo.EXPECT().ListRefunds(gomock.Any(), &models.RefundFilter{TutorID: &tutorID, StudentID: &studentID}).Return(nil, nil)
+
+		_, err := svc.ListRefunds(guardianCtx(guardianID), &models.ListRefundsInput{TutorId: &tutorID, StudentId: &studentID})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Error_GuardianWithoutPair", func(t *testing.T) {
+		_, svc, _, _, _, _ := setup(t)
+
+		_, err := svc.ListRefunds(guardianCtx(uuid.New()), &models.ListRefundsInput{StudentId: &studentID})
+		assert.ErrorIs(t, err, errdefs.ErrInvalidArgument)
+	})
+
+	t.Run("Error_PendingGuardian", func(t *testing.T) {
+		ctrl, svc, _, mockUserClient, _, _ := setup(t)
+		defer ctrl.Finish()
+
+		tutorID := uuid.New()
+		mockUserClient.EXPECT().GetGuardianStudent(gomock.Any(), gomock.Any()).
+			Return(&userapi.GuardianStudent{Status: "pending"}, nil)
+
+		_, err := svc.ListRefunds(guardianCtx(uuid.New()), &models.ListRefundsInput{TutorId: &tutorID, StudentId: &studentID})
+		assert.ErrorIs(t, err, errdefs.ErrPermissionDenied)
+	})
+}
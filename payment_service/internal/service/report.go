@@ -0,0 +1,248 @@
+package service
+
+import (
+	"cmp"
+	"common_library/money"
+	"context"
+	"github.com/google/uuid"
+	errdefs "paymentservice/internal/errors"
+	"paymentservice/internal/models"
+	api3 "schedule_service/pkg/api"
+	"slices"
+	"time"
+)
+
+const maxReportPeriod = 366 * 24 * time.Hour
+
+// months of reports are split in Moscow time, which most tutors use
+var reportLocation = time.FixedZone("MSK", 3*60*60)
+
+// GetEarningsReport returns earnings of the calling tutor from completed lessons that start within
+// [PeriodStart, PeriodEnd), in total, by month and by student. Amounts are split by currency, since
+// lessons of different students can be priced in different currencies.
+func (s *PaymentService) GetEarningsReport(ctx context.Context, input *models.GetEarningsReportInput) (*models.EarningsReport, error) {
+	if !input.PeriodEnd.After(input.PeriodStart) || input.PeriodEnd.Sub(input.PeriodStart) > maxReportPeriod {
+		return nil, errdefs.ErrInvalidArgument
+	}
+
+	userID, role, ok := caller(ctx)
+	if !ok || role != models.RoleTutor {
+		return nil, errdefs.ErrPermissionDenied
+	}
+
+	lessons, err := s.listCompletedLessons(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	paidAt, err := s.receiptApprovalTimes(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	total := &earnings{}
+	months := make(map[time.Time]*earnings)
+	for month := monthStart(input.PeriodStart); month.Before(input.PeriodEnd); month = month.AddDate(0, 1, 0) {
+		months[month] = &earnings{}
+	}
+	students := make(map[uuid.UUID]*earnings)
+
+	for _, lesson := range lessons {
+		startsAt := lesson.GetStartsAt().AsTime()
+		if startsAt.Before(input.PeriodStart) || !startsAt.Before(input.PeriodEnd) {
+			continue
+		}
+		studentID, err := uuid.Parse(lesson.GetStudentId())
+		if err != nil {
+			continue
+		}
+		if students[studentID] == nil {
+			students[studentID] = &earnings{}
+		}
+
+		total.add(lesson, paidAt)
+		months[monthStart(startsAt)].add(lesson, paidAt)
+		students[studentID].add(lesson, paidAt)
+	}
+
+	report := &models.EarningsReport{
+		TutorID:     userID,
+		PeriodStart: input.PeriodStart,
+		PeriodEnd:   input.PeriodEnd,
+		Total:       total.summary(),
+		Months:      make([]models.MonthlyEarnings, 0, len(months)),
+		Students:    make([]models.StudentEarnings, 0, len(students)),
+	}
+	for month, e := range months {
+		report.Months = append(report.Months, models.MonthlyEarnings{MonthStart: month, EarningsSummary: e.summary()})
+	}
+	slices.SortFunc(report.Months, func(a, b models.MonthlyEarnings) int {
+		return a.MonthStart.Compare(b.MonthStart)
+	})
+	for studentID, e := range students {
+		report.Students = append(report.Students, models.StudentEarnings{
+			StudentID:       studentID,
+			StudentName:     s.userName(ctx, studentID),
+			EarningsSummary: e.summary(),
+		})
+	}
+	slices.SortFunc(report.Students, func(a, b models.StudentEarnings) int {
+		return cmp.Or(cmp.Compare(b.LessonsCount, a.LessonsCount), cmp.Compare(a.StudentName, b.StudentName))
+	})
+
+	return report, nil
+}
+
+// GetOutstandingReport returns completed unpaid lessons of the calling tutor by student, most unpaid lessons first.
+// Debts are split by currency.
+func (s *PaymentService) GetOutstandingReport(ctx context.Context) (*models.OutstandingReport, error) {
+	userID, role, ok := caller(ctx)
+	if !ok || role != models.RoleTutor {
+		return nil, errdefs.ErrPermissionDenied
+	}
+
+	lessons, err := s.listCompletedLessons(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	total := money.Totals{}
+	students := make(map[uuid.UUID]*models.StudentOutstanding)
+	studentTotals := make(map[uuid.UUID]money.Totals)
+	for _, lesson := range lessons {
+		if lesson.GetIsPaid() {
+			continue
+		}
+		studentID, err := uuid.Parse(lesson.GetStudentId())
+		if err != nil {
+			continue
+		}
+
+		student, ok := students[studentID]
+		if !ok {
+			student = &models.StudentOutstanding{StudentID: studentID}
+			students[studentID] = student
+			studentTotals[studentID] = money.Totals{}
+		}
+		student.UnpaidLessons++
+		if startsAt := lesson.GetStartsAt().AsTime(); student.OldestUnpaidAt.IsZero() || startsAt.Before(student.OldestUnpaidAt) {
+			student.OldestUnpaidAt = startsAt
+		}
+		if price := lessonPrice(lesson); price != nil {
+			studentTotals[studentID].Add(*price)
+			total.Add(*price)
+		}
+	}
+
+	report := &models.OutstandingReport{
+		TutorID:     userID,
+		Outstanding: total.List(),
+		Students:    make([]models.StudentOutstanding, 0, len(students)),
+	}
+	for studentID, student := range students {
+		student.StudentName = s.userName(ctx, studentID)
+		student.Outstanding = studentTotals[studentID].List()
+		report.Students = append(report.Students, *student)
+	}
+	slices.SortFunc(report.Students, func(a, b models.StudentOutstanding) int {
+		return cmp.Or(cmp.Compare(b.UnpaidLessons, a.UnpaidLessons), a.OldestUnpaidAt.Compare(b.OldestUnpaidAt))
+	})
+
+	return report, nil
+}
+
+func (s *PaymentService) listCompletedLessons(ctx context.Context, tutorID uuid.UUID) ([]*api3.Lesson, error) {
+	listLessonsRequest := &api3.ListLessonsByTutorRequest{
+		TutorId:      tutorID.String(),
+		StatusFilter: []api3.LessonStatusFilter{api3.LessonStatusFilter_COMPLETED},
+	}
+	resp, err := retry(ctx, maxRetries, retryDelay, func() (*api3.ListLessonsResponse, error) {
+		return s.scheduleClient.ListLessonsByTutor(ctxWithMetadata(ctx), listLessonsRequest)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Lessons, nil
+}
+
+// receiptApprovalTimes returns approval times of approved receipts of the tutor by lesson id.
+func (s *PaymentService) receiptApprovalTimes(ctx context.Context, tutorID uuid.UUID) (map[string]time.Time, error) {
+	approved := models.ReceiptStatusApproved
+	receipts, err := s.repo.ListReceipts(ctx, &models.ReceiptFilter{TutorID: &tutorID, Status: &approved})
+	if err != nil {
+		return nil, err
+	}
+
+	paidAt := make(map[string]time.Time, len(receipts))
+	for _, receipt := range receipts {
+		// receipts are not edited after the review
+		paidAt[receipt.LessonID.String()] = receipt.EditedAt
+	}
+	return paidAt, nil
+}
+
+type earnings struct {
+	lessonsCount         int32
+	unpricedLessonsCount int32
+	byCurrency           map[money.Currency]*models.CurrencyEarnings
+	daysToPaySum         float64
+	paidByReceipts       int
+}
+
+func (e *earnings) add(lesson *api3.Lesson, paidAt map[string]time.Time) {
+	e.lessonsCount++
+
+	// lessons booked before the tutor set a price have none
+	if price := lessonPrice(lesson); price == nil {
+		e.unpricedLessonsCount++
+	} else {
+		if e.byCurrency == nil {
+			e.byCurrency = make(map[money.Currency]*models.CurrencyEarnings)
+		}
+		c, ok := e.byCurrency[price.Currency]
+		if !ok {
+			c = &models.CurrencyEarnings{Currency: price.Currency}
+			e.byCurrency[price.Currency] = c
+		}
+		c.LessonsCount++
+		c.EarnedMinor += price.Amount
+		if lesson.GetIsPaid() {
+			c.PaidMinor += price.Amount
+		} else {
+			c.OutstandingMinor += price.Amount
+		}
+	}
+
+	if !lesson.GetIsPaid() {
+		return
+	}
+	if at, ok := paidAt[lesson.GetId()]; ok {
+		days := at.Sub(lesson.GetEndsAt().AsTime()).Hours() / 24
+		// receipts can be submitted before the lesson ends
+		e.daysToPaySum += max(days, 0)
+		e.paidByReceipts++
+	}
+}
+
+func (e *earnings) summary() models.EarningsSummary {
+	summary := models.EarningsSummary{
+		LessonsCount:         e.lessonsCount,
+		UnpricedLessonsCount: e.unpricedLessonsCount,
+		ByCurrency:           make([]models.CurrencyEarnings, 0, len(e.byCurrency)),
+	}
+	for _, c := range e.byCurrency {
+		summary.ByCurrency = append(summary.ByCurrency, *c)
+	}
+	slices.SortFunc(summary.ByCurrency, func(a, b models.CurrencyEarnings) int {
+		return cmp.Compare(a.Currency, b.Currency)
+	})
+	if e.paidByReceipts > 0 {
+		avg := e.daysToPaySum / float64(e.paidByReceipts)
+		summary.AvgDaysToPay = &avg
+	}
+	return summary
+}
+
+func monthStart(t time.Time) time.Time {
+	t = t.In(reportLocation)
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, reportLocation)
+}
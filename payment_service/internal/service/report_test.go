@@ -0,0 +1,151 @@
+package service_test
+
+import (
+	"common_library/money"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	errdefs "paymentservice/internal/errors"
+	"paymentservice/internal/mocks"
+	"paymentservice/internal/models"
+	api "schedule_service/pkg/api"
+	"testing"
+	"time"
+	userapi "userservice/pkg/api"
+)
+
+var msk = time.FixedZone("MSK", 3*60*60)
+
+func completedLesson(studentID uuid.UUID, startsAt time.Time, price *api.Money, isPaid bool) *api.Lesson {
+	return &api.Lesson{
+		Id:        uuid.NewString(),
+		StudentId: studentID.String(),
+		Status:    "completed",
+		IsPaid:    isPaid,
+		Price:     price,
+		StartsAt:  timestamppb.New(startsAt),
+		EndsAt:    timestamppb.New(startsAt.Add(time.Hour)),
+	}
+}
+
+func expectUserNames(mockUserClient *mocks.MockUserServiceClient, names map[uuid.UUID]string) {
+	mockUserClient.EXPECT().GetUser(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, req *userapi.GetUserRequest, _ ...any) (*userapi.UserPublic, error) {
+			return &userapi.UserPublic{FirstName: proto.String(names[uuid.MustParse(req.Id)])}, nil
+		}).AnyTimes()
+}
+
+func TestGetEarningsReport(t *testing.T) {
+	tutorID := uuid.New()
+	firstStudent := uuid.New()
+	secondStudent := uuid.New()
+	periodStart := time.Date(2026, time.September, 1, 0, 0, 0, 0, msk)
+	periodEnd := periodStart.AddDate(0, 2, 0)
+	input := &models.GetEarningsReportInput{PeriodStart: periodStart, PeriodEnd: periodEnd}
+
+	paidByReceipt := completedLesson(firstStudent, periodStart.AddDate(0, 0, 4), rub(1000), true)
+	lessons := []*api.Lesson{
+		paidByReceipt,
+		completedLesson(firstStudent, periodStart.AddDate(0, 0, 19), rub(1000), false),
+		completedLesson(secondStudent, periodStart.AddDate(0, 1, 2), nil, false),
+		completedLesson(secondStudent, periodStart.AddDate(0, 1, 9), &api.Money{AmountMinor: 3000, Currency: "EUR"}, true),
+		completedLesson(firstStudent, periodStart.AddDate(0, 0, -2), rub(1000), false),
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl, svc, mockRepo, mockUserClient, _, mockSchedule := setup(t)
+		defer ctrl.Finish()
+
+		mockSchedule.EXPECT().ListLessonsByTutor(gomock.Any(), &api.ListLessonsByTutorRequest{
+			TutorId:      tutorID.String(),
+			StatusFilter: []api.LessonStatusFilter{api.LessonStatusFilter_COMPLETED},
+		}).Return(&api.ListLessonsResponse{Lessons: lessons}, nil)
+		approved := models.ReceiptStatusApproved
+		mockRepo.EXPECT().ListReceipts(gomock.Any(), &models.ReceiptFilter{TutorID: &tutorID, Status: &approved}).
+			Return([]*models.PaymentReceipt{{
+				LessonID: uuid.MustParse(paidByReceipt.Id),
+				Status:   models.ReceiptStatusApproved,
+				EditedAt: paidByReceipt.EndsAt.AsTime().Add(48 * time.Hour),
+			}}, nil)
+		expectUserNames(mockUserClient, map[uuid.UUID]string{firstStudent: "First", secondStudent: "Second"})
+
+		report, err := svc.GetEarningsReport(tutorCtx(tutorID), input)
+		require.NoError(t, err)
+
+		assert.Equal(t, int32(4), report.Total.LessonsCount)
+		assert.Equal(t, int32(1), report.Total.UnpricedLessonsCount)
+		// amounts in different currencies are never summed up
+		assert.Equal(t, []models.CurrencyEarnings{
+			{Currency: money.EUR, LessonsCount: 1, EarnedMinor: 3000, PaidMinor: 3000},
+			{Currency: money.RUB, LessonsCount: 2, EarnedMinor: 200000, PaidMinor: 100000, OutstandingMinor: 100000},
+		}, report.Total.ByCurrency)
+		require.NotNil(t, report.Total.AvgDaysToPay)
+		assert.InDelta(t, 2.0, *report.Total.AvgDaysToPay, 0.001)
+
+		require.Len(t, report.Months, 2)
+		assert.True(t, report.Months[0].MonthStart.Equal(periodStart))
+		require.Len(t, report.Months[0].ByCurrency, 1)
+		assert.Equal(t, int64(200000), report.Months[0].ByCurrency[0].EarnedMinor)
+		require.Len(t, report.Months[1].ByCurrency, 1)
+		assert.Equal(t, money.EUR, report.Months[1].ByCurrency[0].Currency)
+		assert.Nil(t, report.Months[1].AvgDaysToPay)
+
+		// both students have two lessons, so they are ordered by name
+		require.Len(t, report.Students, 2)
+		assert.Equal(t, firstStudent, report.Students[0].StudentID)
+		assert.Equal(t, int64(100000), report.Students[0].ByCurrency[0].OutstandingMinor)
+		assert.Equal(t, secondStudent, report.Students[1].StudentID)
+		assert.Equal(t, "Second", report.Students[1].StudentName)
+		assert.Equal(t, int32(1), report.Students[1].UnpricedLessonsCount)
+	})
+
+	t.Run("Error_NotTutor", func(t *testing.T) {
+		_, svc, _, _, _, _ := setup(t)
+
+		_, err := svc.GetEarningsReport(studentCtx(firstStudent), input)
+		assert.ErrorIs(t, err, errdefs.ErrPermissionDenied)
+	})
+
+	t.Run("Error_InvalidPeriod", func(t *testing.T) {
+		_, svc, _, _, _, _ := setup(t)
+
+		for _, invalid := range []*models.GetEarningsReportInput{
+			{PeriodStart: periodEnd, PeriodEnd: periodStart},
+			{PeriodStart: periodStart, PeriodEnd: periodStart.AddDate(2, 0, 0)},
+		} {
+			_, err := svc.GetEarningsReport(tutorCtx(tutorID), invalid)
+			assert.ErrorIs(t, err, errdefs.ErrInvalidArgument)
+		}
+	})
+}
+
+func TestGetOutstandingReport(t *testing.T) {
+	tutorID := uuid.New()
+	firstStudent := uuid.New()
+	secondStudent := uuid.New()
+	start := time.Date(2026, time.September, 1, 12, 0, 0, 0, time.UTC)
+
+	ctrl, svc, _, mockUserClient, _, mockSchedule := setup(t)
+	defer ctrl.Finish()
+
+	mockSchedule.EXPECT().ListLessonsByTutor(gomock.Any(), gomock.Any()).Return(&api.ListLessonsResponse{Lessons: []*api.Lesson{
+		completedLesson(firstStudent, start.AddDate(0, 0, 10), rub(1000), false),
+		completedLesson(firstStudent, start, rub(500), false),
+		completedLesson(firstStudent, start.AddDate(0, 0, 5), rub(700), true),
+		completedLesson(secondStudent, start.AddDate(0, 0, 3), nil, false),
+		completedLesson(secondStudent, start.AddDate(0, 0, 4), &api.Money{AmountMinor: 2000, Currency: "EUR"}, false),
+	}}, nil)
+	expectUserNames(mockUserClient, map[uuid.UUID]string{firstStudent: "First", secondStudent: "Second"})
+
+	report, err := svc.GetOutstandingReport(tutorCtx(tutorID))
+	require.NoError(t, err)
+	assert.Equal(t, []money.Money{money.New(2000, money.EUR), money.New(150000, money.RUB)}, report.Outstanding)
+	require.Len(t, report.Students, 2)
+	assert.Equal(t, firstStudent, report.Students[0].StudentID)
+	assert.Equal(t, int32(2), report.Students[0].UnpaidLessons)
+	assert.True(t, report.Students[0].OldestUnpaidAt.Equal(start))
+	assert.Equal(t, []money.Money{money.New(2000, money.EUR)}, report.Students[1].Outstanding)
+}
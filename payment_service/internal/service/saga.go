@@ -0,0 +1,189 @@
+package service
+
+import (
+	"common_library/logging"
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	errdefs "paymentservice/internal/errors"
+	"paymentservice/internal/models"
+	api3 "schedule_service/pkg/api"
+	"time"
+)
+
+const (
+	// after this number of recovery attempts an unfinished approval is rolled back
+	maxApprovalSagaAttempts = 10
+	approvalSagaBatchSize   = 50
+)
+
+var errApprovalRolledBack = errors.New("receipt approval was rolled back")
+
+// ApproveReceipt confirms the pending receipt and marks its lesson as paid. Only the tutor of the lesson can approve it.
+// Both changes are made by a saga, so the lesson is never left paid without an approved receipt.
+// The approved amount is credited to the student balance and charged for the lesson.
+func (s *PaymentService) ApproveReceipt(ctx context.Context, input *models.ReviewReceiptInput) (*models.PaymentReceipt, error) {
+	receipt, err := s.getReceiptForReview(ctx, input.ReceiptId)
+	if err != nil {
+		return nil, err
+	}
+
+	getLessonRequest := &api3.GetLessonRequest{Id: receipt.LessonID.String()}
+	lesson, err := retry(ctx, maxRetries, retryDelay, func() (*api3.Lesson, error) {
+		return s.scheduleClient.GetLesson(ctxWithMetadata(ctx), getLessonRequest)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the lesson was paid in another way, nothing to roll back if the approval fails
+	if lesson.IsPaid {
+		return s.repo.ApproveReceipt(ctx, receipt.ID)
+	}
+
+	saga, err := s.repo.CreateApprovalSaga(ctx, &models.ApprovalSagaCreateInput{
+		ID:        uuid.New(),
+		ReceiptID: receipt.ID,
+		LessonID:  receipt.LessonID,
+	})
+	if errors.Is(err, errdefs.ErrAlreadyExists) {
+		return nil, errdefs.ErrReviewInProgress
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.runApprovalSaga(ctx, saga)
+}
+
+// ResumeApprovalSagas continues approvals interrupted by errors or restarts and returns the number of finished ones.
+// Sagas updated after staleBefore are considered running and are skipped.
+func (s *PaymentService) ResumeApprovalSagas(ctx context.Context, staleAfter time.Duration) (int, error) {
+	sagas, err := s.repo.ClaimStaleApprovalSagas(ctx, time.Now().Add(-staleAfter), approvalSagaBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	logger, hasLogger := logging.GetFromContext(ctx)
+
+	finished := 0
+	for _, saga := range sagas {
+		if saga.Attempts > maxApprovalSagaAttempts && (saga.State == models.SagaStateStarted || saga.State == models.SagaStateLessonPaid) {
+			saga, err = s.repo.UpdateApprovalSagaState(ctx, saga.ID, models.SagaStateCompensating, nil)
+			if err != nil {
+				return finished, err
+			}
+		}
+
+		_, err := s.runApprovalSaga(ctx, saga)
+		if err != nil && !errors.Is(err, errApprovalRolledBack) {
+			if hasLogger {
+				logger.Error(ctx, "failed to resume receipt approval",
+					zap.String("saga_id", saga.ID.String()),
+					zap.String("state", saga.State.String()),
+					zap.Error(err),
+				)
+			}
+			continue
+		}
+		finished++
+	}
+
+	return finished, nil
+}
+
+// runApprovalSaga executes the saga from its current state until it is finished or a step fails.
+// A failed forward step starts compensation, a failed compensation is left to the recovery loop.
+func (s *PaymentService) runApprovalSaga(ctx context.Context, saga *models.ApprovalSaga) (*models.PaymentReceipt, error) {
+	var receipt *models.PaymentReceipt
+	// error of the forward step that caused the compensation
+	var cause error
+
+	for {
+		var err error
+		switch saga.State {
+		case models.SagaStateStarted:
+			markAsPaidRequest := &api3.MarkAsPaidRequest{Id: saga.LessonID.String(), PaymentId: saga.ReceiptID.String()}
+			_, stepErr := retry(ctx, maxRetries, retryDelay, func() (*api3.Lesson, error) {
+				return s.scheduleClient.MarkAsPaid(ctxWithMetadata(ctx), markAsPaidRequest)
+			})
+			if stepErr != nil {
+				// the lesson could be marked even if the call failed, so it is rolled back anyway
+				cause = stepErr
+				saga, err = s.setApprovalSagaState(ctx, saga, models.SagaStateCompensating, stepErr)
+			} else {
+				saga, err = s.setApprovalSagaState(ctx, saga, models.SagaStateLessonPaid, nil)
+			}
+
+		case models.SagaStateLessonPaid:
+			var stepErr error
+			receipt, stepErr = s.approveSagaReceipt(ctx, saga)
+			switch {
+			case errors.Is(stepErr, errdefs.ErrAlreadyReviewed):
+				// the receipt was rejected while the lesson was being marked
+				cause = stepErr
+				saga, err = s.setApprovalSagaState(ctx, saga, models.SagaStateCompensating, stepErr)
+			case stepErr != nil:
+				return nil, stepErr
+			default:
+				saga, err = s.setApprovalSagaState(ctx, saga, models.SagaStateCompleted, nil)
+			}
+
+		case models.SagaStateCompensating:
+			markAsUnpaidRequest := &api3.MarkAsUnpaidRequest{Id: saga.LessonID.String(), PaymentId: saga.ReceiptID.String()}
+			_, stepErr := retry(ctx, maxRetries, retryDelay, func() (*api3.Lesson, error) {
+				return s.scheduleClient.MarkAsUnpaid(ctxWithMetadata(ctx), markAsUnpaidRequest)
+			})
+			if stepErr != nil {
+				return nil, stepErr
+			}
+			saga, err = s.setApprovalSagaState(ctx, saga, models.SagaStateCompensated, nil)
+
+		case models.SagaStateCompleted:
+			if receipt != nil {
+				return receipt, nil
+			}
+			return s.repo.GetReceiptByID(ctx, saga.ReceiptID)
+
+		case models.SagaStateCompensated:
+			if cause != nil {
+				return nil, cause
+			}
+			return nil, errApprovalRolledBack
+
+		default:
+			return nil, errors.New("unknown saga state: " + saga.State.String())
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// approveSagaReceipt approves the receipt of the saga. It can be repeated: an already approved receipt is returned as is.
+func (s *PaymentService) approveSagaReceipt(ctx context.Context, saga *models.ApprovalSaga) (*models.PaymentReceipt, error) {
+	receipt, err := s.repo.ApproveReceipt(ctx, saga.ReceiptID)
+	if !errors.Is(err, errdefs.ErrAlreadyReviewed) {
+		return receipt, err
+	}
+
+	receipt, getErr := s.repo.GetReceiptByID(ctx, saga.ReceiptID)
+	if getErr != nil {
+		return nil, getErr
+	}
+	if receipt.Status == models.ReceiptStatusApproved {
+		return receipt, nil
+	}
+	return nil, err
+}
+
+func (s *PaymentService) setApprovalSagaState(ctx context.Context, saga *models.ApprovalSaga, state models.SagaState, cause error) (*models.ApprovalSaga, error) {
+	var lastError *string
+	if cause != nil {
+		msg := cause.Error()
+		lastError = &msg
+	}
+	return s.repo.UpdateApprovalSagaState(ctx, saga.ID, state, lastError)
+}
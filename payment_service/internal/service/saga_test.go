@@ -0,0 +1,313 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	errdefs "paymentservice/internal/errors"
+	"paymentservice/internal/mocks"
+	"paymentservice/internal/models"
+	api "schedule_service/pkg/api"
+	"testing"
+	"time"
+)
+
+type approvalFixture struct {
+	sagaID    uuid.UUID
+	receiptID uuid.UUID
+	lessonID  uuid.UUID
+	tutorID   uuid.UUID
+}
+
+func newApprovalFixture() approvalFixture {
+	return approvalFixture{
+		sagaID:    uuid.New(),
+		receiptID: uuid.New(),
+		lessonID:  uuid.New(),
+		tutorID:   uuid.New(),
+	}
+}
+
+func (f approvalFixture) saga(state models.SagaState, attempts int) *models.ApprovalSaga {
+	return &models.ApprovalSaga{
+		ID:        f.sagaID,
+		ReceiptID: f.receiptID,
+		LessonID:  f.lessonID,
+		State:     state,
+		Attempts:  attempts,
+	}
+}
+
+func (f approvalFixture) receipt(status models.ReceiptStatus) *models.PaymentReceipt {
+	return &models.PaymentReceipt{
+		ID:       f.receiptID,
+		LessonID: f.lessonID,
+		Status:   status,
+		TutorID:  &f.tutorID,
+	}
+}
+
+// expectApprovalStart expects the checks made before the saga is created.
+func (f approvalFixture) expectApprovalStart(mockRepo *mocks.MockIPaymentRepo, mockSchedule *mocks.MockScheduleServiceClient) {
+	mockRepo.EXPECT().GetReceiptByID(gomock.Any(), f.receiptID).Return(f.receipt(models.ReceiptStatusPending), nil)
+	mockSchedule.EXPECT().GetLesson(gomock.Any(), &api.GetLessonRequest{Id: f.lessonID.String()}).
+		Return(&api.Lesson{Id: f.lessonID.String()}, nil)
+	mockRepo.EXPECT().CreateApprovalSaga(gomock.Any(), gomock.Cond(func(input *models.ApprovalSagaCreateInput) bool {
+		return input.ReceiptID == f.receiptID && input.LessonID == f.lessonID
+	})).Return(f.saga(models.SagaStateStarted, 0), nil)
+}
+
+func (f approvalFixture) expectState(mockRepo *mocks.MockIPaymentRepo, state models.SagaState) *gomock.Call {
+	lastError := gomock.Nil()
+	if state == models.SagaStateCompensating {
+		lastError = gomock.Not(gomock.Nil())
+	}
+	return mockRepo.EXPECT().UpdateApprovalSagaState(gomock.Any(), f.sagaID, state, lastError).
+		Return(f.saga(state, 0), nil)
+}
+
+func (f approvalFixture) expectMarkAsPaid(mockSchedule *mocks.MockScheduleServiceClient, err error) *gomock.Call {
+	var lesson *api.Lesson
+	if err == nil {
+		lesson = &api.Lesson{Id: f.lessonID.String(), IsPaid: true}
+	}
+	return mockSchedule.EXPECT().MarkAsPaid(gomock.Any(), &api.MarkAsPaidRequest{Id: f.lessonID.String(), PaymentId: f.receiptID.String()}).Return(lesson, err)
+}
+
+func (f approvalFixture) expectMarkAsUnpaid(mockSchedule *mocks.MockScheduleServiceClient, err error) *gomock.Call {
+	var lesson *api.Lesson
+	if err == nil {
+		lesson = &api.Lesson{Id: f.lessonID.String()}
+	}
+	return mockSchedule.EXPECT().MarkAsUnpaid(gomock.Any(), &api.MarkAsUnpaidRequest{Id: f.lessonID.String(), PaymentId: f.receiptID.String()}).Return(lesson, err)
+}
+
+func (f approvalFixture) expectReview(mockRepo *mocks.MockIPaymentRepo, err error) *gomock.Call {
+	var receipt *models.PaymentReceipt
+	if err == nil {
+		receipt = f.receipt(models.ReceiptStatusApproved)
+	}
+	return mockRepo.EXPECT().ApproveReceipt(gomock.Any(), f.receiptID).Return(receipt, err)
+}
+
+func TestApproveReceiptSaga(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, mockSchedule := setup(t)
+		defer ctrl.Finish()
+
+		f := newApprovalFixture()
+		f.expectApprovalStart(mockRepo, mockSchedule)
+		gomock.InOrder(
+			f.expectMarkAsPaid(mockSchedule, nil),
+			f.expectState(mockRepo, models.SagaStateLessonPaid),
+			f.expectReview(mockRepo, nil),
+			f.expectState(mockRepo, models.SagaStateCompleted),
+		)
+
+		receipt, err := svc.ApproveReceipt(tutorCtx(f.tutorID), &models.ReviewReceiptInput{ReceiptId: f.receiptID})
+		require.NoError(t, err)
+		assert.Equal(t, models.ReceiptStatusApproved, receipt.Status)
+	})
+
+	t.Run("LessonAlreadyPaid", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, mockSchedule := setup(t)
+		defer ctrl.Finish()
+
+		f := newApprovalFixture()
+		mockRepo.EXPECT().GetReceiptByID(gomock.Any(), f.receiptID).Return(f.receipt(models.ReceiptStatusPending), nil)
+		mockSchedule.EXPECT().GetLesson(gomock.Any(), gomock.Any()).Return(&api.Lesson{IsPaid: true}, nil)
+		f.expectReview(mockRepo, nil)
+
+		receipt, err := svc.ApproveReceipt(tutorCtx(f.tutorID), &models.ReviewReceiptInput{ReceiptId: f.receiptID})
+		require.NoError(t, err)
+		assert.Equal(t, models.ReceiptStatusApproved, receipt.Status)
+	})
+
+	t.Run("ApprovalInProgress", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, mockSchedule := setup(t)
+		defer ctrl.Finish()
+
+		f := newApprovalFixture()
+		mockRepo.EXPECT().GetReceiptByID(gomock.Any(), f.receiptID).Return(f.receipt(models.ReceiptStatusPending), nil)
+		mockSchedule.EXPECT().GetLesson(gomock.Any(), gomock.Any()).Return(&api.Lesson{}, nil)
+		mockRepo.EXPECT().CreateApprovalSaga(gomock.Any(), gomock.Any()).Return(nil, errdefs.ErrAlreadyExists)
+
+		_, err := svc.ApproveReceipt(tutorCtx(f.tutorID), &models.ReviewReceiptInput{ReceiptId: f.receiptID})
+		assert.ErrorIs(t, err, errdefs.ErrReviewInProgress)
+	})
+
+	t.Run("Failure_MarkAsPaid", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, mockSchedule := setup(t)
+		defer ctrl.Finish()
+
+		f := newApprovalFixture()
+		markErr := errors.New("schedule error")
+		f.expectApprovalStart(mockRepo, mockSchedule)
+		gomock.InOrder(
+			f.expectMarkAsPaid(mockSchedule, markErr),
+			f.expectState(mockRepo, models.SagaStateCompensating),
+			f.expectMarkAsUnpaid(mockSchedule, nil),
+			f.expectState(mockRepo, models.SagaStateCompensated),
+		)
+
+		_, err := svc.ApproveReceipt(tutorCtx(f.tutorID), &models.ReviewReceiptInput{ReceiptId: f.receiptID})
+		assert.ErrorIs(t, err, markErr)
+	})
+
+	t.Run("Failure_SaveLessonPaidState", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, mockSchedule := setup(t)
+		defer ctrl.Finish()
+
+		f := newApprovalFixture()
+		dbErr := errors.New("db error")
+		f.expectApprovalStart(mockRepo, mockSchedule)
+		gomock.InOrder(
+			f.expectMarkAsPaid(mockSchedule, nil),
+			f.expectState(mockRepo, models.SagaStateLessonPaid).Return(nil, dbErr),
+		)
+
+		// the saga stays started and is resumed by the recovery loop, MarkAsPaid can be repeated
+		_, err := svc.ApproveReceipt(tutorCtx(f.tutorID), &models.ReviewReceiptInput{ReceiptId: f.receiptID})
+		assert.ErrorIs(t, err, dbErr)
+	})
+
+	t.Run("Failure_ApproveReceipt", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, mockSchedule := setup(t)
+		defer ctrl.Finish()
+
+		f := newApprovalFixture()
+		dbErr := errors.New("db error")
+		f.expectApprovalStart(mockRepo, mockSchedule)
+		gomock.InOrder(
+			f.expectMarkAsPaid(mockSchedule, nil),
+			f.expectState(mockRepo, models.SagaStateLessonPaid),
+			f.expectReview(mockRepo, dbErr),
+		)
+
+		// the lesson stays paid until the recovery loop approves the receipt or rolls the saga back
+		_, err := svc.ApproveReceipt(tutorCtx(f.tutorID), &models.ReviewReceiptInput{ReceiptId: f.receiptID})
+		assert.ErrorIs(t, err, dbErr)
+	})
+
+	t.Run("Failure_ReceiptRejectedMeanwhile", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, mockSchedule := setup(t)
+		defer ctrl.Finish()
+
+		f := newApprovalFixture()
+		f.expectApprovalStart(mockRepo, mockSchedule)
+		gomock.InOrder(
+			f.expectMarkAsPaid(mockSchedule, nil),
+			f.expectState(mockRepo, models.SagaStateLessonPaid),
+			f.expectReview(mockRepo, errdefs.ErrAlreadyReviewed),
+			mockRepo.EXPECT().GetReceiptByID(gomock.Any(), f.receiptID).Return(f.receipt(models.ReceiptStatusRejected), nil),
+			f.expectState(mockRepo, models.SagaStateCompensating),
+			f.expectMarkAsUnpaid(mockSchedule, nil),
+			f.expectState(mockRepo, models.SagaStateCompensated),
+		)
+
+		_, err := svc.ApproveReceipt(tutorCtx(f.tutorID), &models.ReviewReceiptInput{ReceiptId: f.receiptID})
+		assert.ErrorIs(t, err, errdefs.ErrAlreadyReviewed)
+	})
+
+	t.Run("Failure_Compensation", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, mockSchedule := setup(t)
+		defer ctrl.Finish()
+
+		f := newApprovalFixture()
+		unmarkErr := errors.New("schedule is still down")
+		f.expectApprovalStart(mockRepo, mockSchedule)
+		gomock.InOrder(
+			f.expectMarkAsPaid(mockSchedule, errors.New("schedule is down")),
+			f.expectState(mockRepo, models.SagaStateCompensating),
+			f.expectMarkAsUnpaid(mockSchedule, unmarkErr),
+		)
+
+		// the saga stays compensating and is rolled back by the recovery loop
+		_, err := svc.ApproveReceipt(tutorCtx(f.tutorID), &models.ReviewReceiptInput{ReceiptId: f.receiptID})
+		assert.ErrorIs(t, err, unmarkErr)
+	})
+}
+
+func TestResumeApprovalSagas(t *testing.T) {
+	t.Run("ResumesEachState", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, mockSchedule := setup(t)
+		defer ctrl.Finish()
+
+		started := newApprovalFixture()
+		lessonPaid := newApprovalFixture()
+		compensating := newApprovalFixture()
+
+		mockRepo.EXPECT().ClaimStaleApprovalSagas(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*models.ApprovalSaga{
+			started.saga(models.SagaStateStarted, 1),
+			lessonPaid.saga(models.SagaStateLessonPaid, 1),
+			compensating.saga(models.SagaStateCompensating, 1),
+		}, nil)
+
+		gomock.InOrder(
+			started.expectMarkAsPaid(mockSchedule, nil),
+			started.expectState(mockRepo, models.SagaStateLessonPaid),
+			started.expectReview(mockRepo, nil),
+			started.expectState(mockRepo, models.SagaStateCompleted),
+		)
+		// the receipt was approved before the crash, but the saga state was not saved
+		gomock.InOrder(
+			lessonPaid.expectReview(mockRepo, errdefs.ErrAlreadyReviewed),
+			mockRepo.EXPECT().GetReceiptByID(gomock.Any(), lessonPaid.receiptID).Return(lessonPaid.receipt(models.ReceiptStatusApproved), nil),
+			lessonPaid.expectState(mockRepo, models.SagaStateCompleted),
+		)
+		gomock.InOrder(
+			compensating.expectMarkAsUnpaid(mockSchedule, nil),
+			compensating.expectState(mockRepo, models.SagaStateCompensated),
+		)
+
+		finished, err := svc.ResumeApprovalSagas(context.Background(), time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, 3, finished)
+	})
+
+	t.Run("RollsBackAfterMaxAttempts", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, mockSchedule := setup(t)
+		defer ctrl.Finish()
+
+		f := newApprovalFixture()
+		mockRepo.EXPECT().ClaimStaleApprovalSagas(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]*models.ApprovalSaga{f.saga(models.SagaStateLessonPaid, 11)}, nil)
+		gomock.InOrder(
+			mockRepo.EXPECT().UpdateApprovalSagaState(gomock.Any(), f.sagaID, models.SagaStateCompensating, gomock.Nil()).
+				Return(f.saga(models.SagaStateCompensating, 11), nil),
+			f.expectMarkAsUnpaid(mockSchedule, nil),
+			f.expectState(mockRepo, models.SagaStateCompensated),
+		)
+
+		finished, err := svc.ResumeApprovalSagas(context.Background(), time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, 1, finished)
+	})
+
+	t.Run("KeepsFailedSagas", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, mockSchedule := setup(t)
+		defer ctrl.Finish()
+
+		f := newApprovalFixture()
+		mockRepo.EXPECT().ClaimStaleApprovalSagas(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]*models.ApprovalSaga{f.saga(models.SagaStateCompensating, 3)}, nil)
+		f.expectMarkAsUnpaid(mockSchedule, errors.New("schedule is down"))
+
+		finished, err := svc.ResumeApprovalSagas(context.Background(), time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, 0, finished)
+	})
+
+	t.Run("Error_Claim", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, _ := setup(t)
+		defer ctrl.Finish()
+
+		mockRepo.EXPECT().ClaimStaleApprovalSagas(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+
+		_, err := svc.ResumeApprovalSagas(context.Background(), time.Minute)
+		assert.Error(t, err)
+	})
+}
@@ -4,10 +4,14 @@ package service
 
 import (
 	"common_library/ctxdata"
+	"common_library/logging"
+	"common_library/money"
 	"context"
+	"errors"
 	api2 "fileservice/pkg/api"
 	"fmt"
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
@@ -17,46 +21,169 @@ import (
 	errdefs "paymentservice/internal/errors"
 	"paymentservice/internal/models"
 	api3 "schedule_service/pkg/api"
+	"strings"
 	"time"
+	api4 "userservice/pkg/api"
 )
 
 const maxRetries = 6                      // Максимальное количество попыток
 const retryDelay = 100 * time.Millisecond // Задержка между попытками
 
+const receiptFileOwnerType = "receipt" // тип владельца файла чека в file_service
+
 type IPaymentRepo interface {
 	CreateReceipt(ctx context.Context, receipt *models.PaymentReceiptCreateInput) (*models.PaymentReceipt, error)
 
 	GetReceiptByID(ctx context.Context, id uuid.UUID) (*models.PaymentReceipt, error)
 
-	UpdateReceipt(ctx context.Context, id uuid.UUID, isVerified bool) (*models.PaymentReceipt, error)
+	ReviewReceipt(ctx context.Context, id uuid.UUID, input *models.PaymentReceiptReviewInput) (*models.PaymentReceipt, error)
 
 	ExistsByID(ctx context.Context, id uuid.UUID) (bool, error)
 
 	GetReceiptByLessonID(ctx context.Context, lessonID uuid.UUID) (*models.PaymentReceipt, error)
+
+	ListReceipts(ctx context.Context, filter *models.ReceiptFilter) ([]*models.PaymentReceipt, error)
+
+	ListReceiptsWithoutPair(ctx context.Context) ([]*models.PaymentReceipt, error)
+
+	SetReceiptPair(ctx context.Context, id uuid.UUID, tutorID uuid.UUID, studentID uuid.UUID) error
+
+	CreateApprovalSaga(ctx context.Context, input *models.ApprovalSagaCreateInput) (*models.ApprovalSaga, error)
+
+	UpdateApprovalSagaState(ctx context.Context, id uuid.UUID, state models.SagaState, lastError *string) (*models.ApprovalSaga, error)
+
+	ClaimStaleApprovalSagas(ctx context.Context, staleBefore time.Time, limit int) ([]*models.ApprovalSaga, error)
+
+	ApproveReceipt(ctx context.Context, id uuid.UUID) (*models.PaymentReceipt, error)
+
+	CreateLedgerTransaction(ctx context.Context, input *models.LedgerTransactionCreateInput) (bool, error)
+
+	ListLessonCharges(ctx context.Context, lessonIDs []uuid.UUID) ([]*models.LedgerTransaction, error)
+
+	GetBalance(ctx context.Context, tutorID uuid.UUID, studentID uuid.UUID) (*models.Balance, error)
+
+	ListLedgerEntries(ctx context.Context, tutorID uuid.UUID, studentID uuid.UUID, limit int) ([]*models.LedgerEntry, error)
+
+	CreatePackage(ctx context.Context, input *models.LessonPackageCreateInput) (*models.LessonPackage, error)
+
+	GetPackageByID(ctx context.Context, id uuid.UUID) (*models.LessonPackage, error)
+
+	ListPackages(ctx context.Context, tutorID uuid.UUID, studentID uuid.UUID) ([]*models.LessonPackage, error)
+
+	CreatePackagePurchase(ctx context.Context, input *models.PackagePurchaseCreateInput) (*models.PackagePurchase, error)
+
+	ConsumePackageLesson(ctx context.Context, input *models.LessonChargeInput) (*models.PackagePurchase, error)
+
+	CreateInvoice(ctx context.Context, input *models.InvoiceCreateInput) (*models.Invoice, error)
+
+	GetInvoiceByID(ctx context.Context, id uuid.UUID) (*models.Invoice, error)
+
+	ListInvoiceLines(ctx context.Context, invoiceID uuid.UUID) ([]models.InvoiceLine, error)
+
+	ListInvoices(ctx context.Context, filter *models.InvoiceFilter) ([]*models.Invoice, error)
+
+	SetInvoiceFile(ctx context.Context, id uuid.UUID, fileID uuid.UUID) error
+
+	CreateOnlinePayment(ctx context.Context, input *models.OnlinePaymentCreateInput) (*models.OnlinePayment, error)
+
+	GetPendingOnlinePayment(ctx context.Context, lessonID uuid.UUID) (*models.OnlinePayment, error)
+
+	GetOnlinePaymentByProviderID(ctx context.Context, provider string, providerPaymentID string) (*models.OnlinePayment, error)
+
+	CompleteOnlinePayment(ctx context.Context, id uuid.UUID) (bool, error)
+
+	CancelOnlinePayment(ctx context.Context, id uuid.UUID) error
+
+	RefundCanceledOnlinePayment(ctx context.Context, id uuid.UUID) error
+
+	GetSucceededOnlinePayment(ctx context.Context, lessonID uuid.UUID) (*models.OnlinePayment, error)
+
+	GetLessonCharge(ctx context.Context, lessonID uuid.UUID) (*models.LessonCharge, error)
+
+	CreateRefund(ctx context.Context, input *models.RefundCreateInput) (*models.Refund, error)
+
+	GetRefundByLessonID(ctx context.Context, lessonID uuid.UUID) (*models.Refund, error)
+
+	ListRefunds(ctx context.Context, filter *models.RefundFilter) ([]*models.Refund, error)
+
+	ClaimDunningStep(ctx context.Context, input *models.DunningStepCreateInput) (bool, error)
+
+	ReleaseDunningStep(ctx context.Context, id uuid.UUID) error
+
+	ListDunningSteps(ctx context.Context, lessonIDs []uuid.UUID) ([]*models.DunningStep, error)
+
+	GetDunningStartedAt(ctx context.Context, now time.Time) (time.Time, error)
+}
+
+// InvoiceRenderer renders invoices to PDF.
+type InvoiceRenderer interface {
+	Render(doc *models.InvoiceDocument) ([]byte, error)
+}
+
+// PaymentProvider is an acquiring service that accepts card payments on behalf of the tutors.
+type PaymentProvider interface {
+	// Name identifies the provider in webhook routes and stored payments.
+	Name() string
+
+	// CreatePayment creates a payment the student confirms at the returned confirmation URL.
+	CreatePayment(ctx context.Context, input *models.ProviderPaymentInput) (*models.ProviderPayment, error)
+
+	// ParseWebhook verifies the signature of the notification and returns the payment it reports.
+	// Returns errdefs.ErrInvalidSignature if the notification is not signed by the provider.
+	ParseWebhook(body []byte, headers map[string]string) (*models.ProviderPayment, error)
+
+	Refund(ctx context.Context, paymentID string, amount money.Money, idempotencyKey string) (*models.ProviderRefund, error)
+}
+
+// Notifier delivers messages to users.
+type Notifier interface {
+	Notify(ctx context.Context, notification *models.Notification) error
 }
 
 type PaymentService struct {
-	repo           IPaymentRepo
-	userClient     clients.UserServiceClient
-	fileClient     clients.FileServiceClient
-	scheduleClient clients.ScheduleServiceClient
+	repo            IPaymentRepo
+	userClient      clients.UserServiceClient
+	adminClient     clients.AdminServiceClient
+	fileClient      clients.FileServiceClient
+	scheduleClient  clients.ScheduleServiceClient
+	invoiceRenderer InvoiceRenderer
+	paymentProvider PaymentProvider
+	notifier        Notifier
 }
 
 func NewPaymentService(
 	repo IPaymentRepo,
 	userClient clients.UserServiceClient,
+	adminClient clients.AdminServiceClient,
 	fileClient clients.FileServiceClient,
 	scheduleClient clients.ScheduleServiceClient,
+	invoiceRenderer InvoiceRenderer,
 ) *PaymentService {
 
 	return &PaymentService{
-		repo:           repo,
-		userClient:     userClient,
-		fileClient:     fileClient,
-		scheduleClient: scheduleClient,
+		repo:            repo,
+		userClient:      userClient,
+		adminClient:     adminClient,
+		fileClient:      fileClient,
+		scheduleClient:  scheduleClient,
+		invoiceRenderer: invoiceRenderer,
 	}
 }
 
+// WithPaymentProvider enables online payments through the provider.
+func (s *PaymentService) WithPaymentProvider(provider PaymentProvider) *PaymentService {
+	s.paymentProvider = provider
+	return s
+}
+
+// WithNotifier enables reminders about overdue payments.
+func (s *PaymentService) WithNotifier(notifier Notifier) *PaymentService {
+	s.notifier = notifier
+	return s
+}
+
+// SubmitPaymentReceipt creates a pending receipt for the lesson. The lesson is marked as paid only
+// when the tutor approves the receipt. A new receipt can be submitted after the previous one is rejected.
 func (s *PaymentService) SubmitPaymentReceipt(ctx context.Context, input *models.SubmitPaymentReceiptInput) (*models.PaymentReceipt, error) {
 	if input.FileId == uuid.Nil || input.LessonId == uuid.Nil {
 		return nil, errdefs.ErrInvalidArgument
@@ -77,19 +204,37 @@ func (s *PaymentService) SubmitPaymentReceipt(ctx context.Context, input *models
 		return nil, errdefs.ErrAlreadyExists
 	}
 
-	lesson.IsPaid = true
-	updateLessonRequest := &api3.UpdateLessonRequest{
-		Id:             lesson.Id,
-		ConnectionLink: lesson.ConnectionLink,
-		PriceRub:       lesson.PriceRub,
-		PaymentInfo:    lesson.PaymentInfo,
+	studentID, err := uuid.Parse(lesson.StudentId)
+	if err != nil {
+		return nil, err
+	}
+	// guardians submit receipts on behalf of the student
+	if userID, _, ok := caller(ctx); !ok || userID != studentID {
+		if err := s.checkGuardian(ctx, studentID); err != nil {
+			return nil, err
+		}
+	}
+
+	lastReceipt, err := s.repo.GetReceiptByLessonID(ctx, input.LessonId)
+	if err != nil && !errors.Is(err, errdefs.ErrNotFound) {
+		return nil, err
+	}
+	if lastReceipt != nil && lastReceipt.Status != models.ReceiptStatusRejected {
+		return nil, errdefs.ErrAlreadyExists
 	}
-	lesson, err = retry[*api3.Lesson](ctx, maxRetries, retryDelay, func() (*api3.Lesson, error) {
-		return s.scheduleClient.UpdateLesson(ctxWithMetadata(ctx), updateLessonRequest)
+
+	getSlotRequest := &api3.GetSlotRequest{Id: lesson.SlotId}
+	slot, err := retry(ctx, maxRetries, retryDelay, func() (*api3.Slot, error) {
+		return s.scheduleClient.GetSlot(ctxWithMetadata(ctx), getSlotRequest)
 	})
 	if err != nil {
 		return nil, err
 	}
+	tutorID, err := uuid.Parse(slot.TutorId)
+	if err != nil {
+		return nil, err
+	}
+
 	newReceiptID := uuid.New()
 	exists, err := s.repo.ExistsByID(ctx, newReceiptID)
 	if err != nil {
@@ -100,17 +245,23 @@ func (s *PaymentService) SubmitPaymentReceipt(ctx context.Context, input *models
 	}
 
 	createReceiptInput := &models.PaymentReceiptCreateInput{
-		LessonID:   input.LessonId,
-		FileID:     input.FileId,
-		IsVerified: true,
+		ID:        newReceiptID,
+		LessonID:  input.LessonId,
+		FileID:    input.FileId,
+		TutorID:   tutorID,
+		StudentID: studentID,
+		Amount:    lessonPriceOrZero(lesson),
 	}
 	receipt, err := retry(ctx, maxRetries, retryDelay, func() (*models.PaymentReceipt, error) {
 		return s.repo.CreateReceipt(ctxWithMetadata(ctx), createReceiptInput)
 	})
 	if err != nil {
-		return nil, errdefs.ErrNotFound
+		return nil, err
 	}
 
+	s.registerReceiptFile(ctx, receipt)
+	s.grantReceiptFileAccess(ctx, receipt)
+
 	// отправить ивент уведомление
 
 	return receipt, nil
@@ -131,13 +282,11 @@ func (s *PaymentService) GetPaymentInfo(ctx context.Context, input *models.GetPa
 	if err != nil {
 		return nil, err
 	}
-	if *lesson.PriceRub == 0 {
-		*lesson.PriceRub = 0
-	}
+	// price and payment details are empty for lessons booked before the tutor set them
 	paymentInfo := &models.PaymentInfo{
 		LessonID:       input.LessonId,
-		PriceRUB:       *lesson.PriceRub,
-		PaymentDetails: *lesson.PaymentInfo,
+		Price:          lessonPrice(lesson),
+		PaymentDetails: lesson.GetPaymentInfo(),
 	}
 	return paymentInfo, nil
 }
@@ -155,16 +304,92 @@ func (s *PaymentService) GetReceipt(ctx context.Context, input *models.GetReceip
 	return receipt, nil
 }
 
-func (s *PaymentService) VerifyReceipt(ctx context.Context, input *models.VerifyReceiptInput) (*models.PaymentReceipt, error) {
-	if input.ReceiptId == uuid.Nil {
+// RejectReceipt rejects the pending receipt with a reason shown to the student, who can then submit a new one.
+// Only the tutor of the lesson can reject it.
+func (s *PaymentService) RejectReceipt(ctx context.Context, input *models.RejectReceiptInput) (*models.PaymentReceipt, error) {
+	reason := strings.TrimSpace(input.Reason)
+	if reason == "" {
+		return nil, errdefs.ErrInvalidArgument
+	}
+
+	receipt, err := s.getReceiptForReview(ctx, input.ReceiptId)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.ReviewReceipt(ctx, receipt.ID, &models.PaymentReceiptReviewInput{
+		Status:          models.ReceiptStatusRejected,
+		RejectionReason: &reason,
+	})
+}
+
+// ListReceipts returns receipts of the caller: tutors see receipts of their lessons, students see their own ones,
+// guardians see receipts of the linked student set in the filter, admins see all of them.
+func (s *PaymentService) ListReceipts(ctx context.Context, input *models.ListReceiptsInput) ([]*models.PaymentReceipt, error) {
+	if input.Status != nil && !input.Status.IsValid() {
+		return nil, errdefs.ErrInvalidArgument
+	}
+
+	userID, role, ok := caller(ctx)
+	if !ok {
+		return nil, errdefs.ErrPermissionDenied
+	}
+
+	filter := &models.ReceiptFilter{
+		TutorID:   input.TutorId,
+		StudentID: input.StudentId,
+		Status:    input.Status,
+	}
+	switch role {
+	case models.RoleTutor:
+		if filter.TutorID != nil && *filter.TutorID != userID {
+			return nil, errdefs.ErrPermissionDenied
+		}
+		filter.TutorID = &userID
+	case models.RoleStudent:
+		if filter.StudentID != nil && *filter.StudentID != userID {
+			return nil, errdefs.ErrPermissionDenied
+		}
+		filter.StudentID = &userID
+	case models.RoleGuardian:
+		if filter.StudentID == nil {
+			return nil, errdefs.ErrInvalidArgument
+		}
+		if err := s.checkGuardian(ctx, *filter.StudentID); err != nil {
+			return nil, err
+		}
+	case models.RoleAdmin:
+		if err := s.recordAdminAction(ctx, "list_receipts", "receipt", "", receiptFilterDetails(filter)); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errdefs.ErrPermissionDenied
+	}
+
+	return s.repo.ListReceipts(ctx, filter)
+}
+
+// getReceiptForReview returns the pending receipt if the caller is its tutor.
+func (s *PaymentService) getReceiptForReview(ctx context.Context, receiptID uuid.UUID) (*models.PaymentReceipt, error) {
+	if receiptID == uuid.Nil {
 		return nil, errdefs.ErrInvalidArgument
 	}
+
 	receipt, err := retry(ctx, maxRetries, retryDelay, func() (*models.PaymentReceipt, error) {
-		return s.repo.UpdateReceipt(ctx, input.ReceiptId, true)
+		return s.repo.GetReceiptByID(ctx, receiptID)
 	})
 	if err != nil {
 		return nil, err
 	}
+
+	userID, role, ok := caller(ctx)
+	if !ok || role != models.RoleTutor || receipt.TutorID == nil || *receipt.TutorID != userID {
+		return nil, errdefs.ErrPermissionDenied
+	}
+	if receipt.Status != models.ReceiptStatusPending {
+		return nil, errdefs.ErrAlreadyReviewed
+	}
+
 	return receipt, nil
 }
 
@@ -189,6 +414,139 @@ func (s *PaymentService) GetReceiptFile(ctx context.Context, input *models.GetRe
 	return receiptFileURL, nil
 }
 
+// registerReceiptFile marks the receipt file as used so that file_service does not garbage collect it.
+func (s *PaymentService) registerReceiptFile(ctx context.Context, receipt *models.PaymentReceipt) {
+	registerFileUsageRequest := &api2.FileUsage{
+		FileId:    receipt.FileID.String(),
+		OwnerType: receiptFileOwnerType,
+		OwnerId:   receipt.ID.String(),
+	}
+	_, err := retry(ctx, maxRetries, retryDelay, func() (*api2.Empty, error) {
+		return s.fileClient.RegisterFileUsage(ctxWithMetadata(ctx), registerFileUsageRequest)
+	})
+	if err != nil {
+		if logger, ok := logging.GetFromContext(ctx); ok {
+			logger.Error(ctx, "failed to register receipt file usage",
+				zap.String("receipt_id", receipt.ID.String()),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// grantReceiptFileAccess allows the tutor of the lesson to download the receipt file uploaded by the student.
+func (s *PaymentService) grantReceiptFileAccess(ctx context.Context, receipt *models.PaymentReceipt) {
+	userIDs := make([]string, 0, 2)
+	for _, id := range []*uuid.UUID{receipt.TutorID, receipt.StudentID} {
+		if id != nil {
+			userIDs = append(userIDs, id.String())
+		}
+	}
+	grantFileAccessRequest := &api2.FileAccessRequest{
+		FileId:  receipt.FileID.String(),
+		UserIds: userIDs,
+	}
+	_, err := retry(ctx, maxRetries, retryDelay, func() (*api2.Empty, error) {
+		return s.fileClient.GrantFileAccess(ctxWithMetadata(ctx), grantFileAccessRequest)
+	})
+	if err != nil {
+		if logger, ok := logging.GetFromContext(ctx); ok {
+			logger.Error(ctx, "failed to grant receipt file access",
+				zap.String("receipt_id", receipt.ID.String()),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// BackfillReceiptFileGrants grants access to files of receipts submitted before file_service checked access.
+// It is run once after the migration of file_service, repeated grants are not an error.
+func (s *PaymentService) BackfillReceiptFileGrants(ctx context.Context) (int, error) {
+	receipts, err := s.repo.ListReceipts(ctx, &models.ReceiptFilter{})
+	if err != nil {
+		return 0, err
+	}
+	for _, receipt := range receipts {
+		s.grantReceiptFileAccess(ctx, receipt)
+	}
+	return len(receipts), nil
+}
+
+// BackfillReceiptPairs sets the tutor and the student of receipts created before the review workflow
+// from their lessons, so that tutors can review them. Returns the number of updated receipts.
+func (s *PaymentService) BackfillReceiptPairs(ctx context.Context) (int, error) {
+	receipts, err := s.repo.ListReceiptsWithoutPair(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	logger, hasLogger := logging.GetFromContext(ctx)
+
+	updated := 0
+	for _, receipt := range receipts {
+		if err := s.setReceiptPair(ctx, receipt); err != nil {
+			if hasLogger {
+				logger.Error(ctx, "failed to backfill receipt pair", zap.String("receipt_id", receipt.ID.String()), zap.Error(err))
+			}
+			continue
+		}
+		updated++
+	}
+	return updated, nil
+}
+
+func (s *PaymentService) setReceiptPair(ctx context.Context, receipt *models.PaymentReceipt) error {
+	// the backfill runs without a user, GetLesson would reject it
+	getLessonRequest := &api3.GetLessonRequest{Id: receipt.LessonID.String()}
+	lesson, err := retry(ctx, maxRetries, retryDelay, func() (*api3.Lesson, error) {
+		return s.scheduleClient.GetLessonInternal(ctxWithMetadata(ctx), getLessonRequest)
+	})
+	if err != nil {
+		return err
+	}
+
+	tutorID, err := uuid.Parse(lesson.TutorId)
+	if err != nil {
+		return err
+	}
+	studentID, err := uuid.Parse(lesson.StudentId)
+	if err != nil {
+		return err
+	}
+	return s.repo.SetReceiptPair(ctx, receipt.ID, tutorID, studentID)
+}
+
+// caller returns the id and the role of the user making the request.
+func caller(ctx context.Context) (uuid.UUID, models.Role, bool) {
+	userID, ok := ctxdata.GetUserID(ctx)
+	if !ok {
+		return uuid.Nil, "", false
+	}
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return uuid.Nil, "", false
+	}
+	role, _ := ctxdata.GetUserRole(ctx)
+	return id, models.Role(role), true
+}
+
+// lessonPrice returns the price of the lesson or nil if the tutor has not set it.
+func lessonPrice(lesson *api3.Lesson) *money.Money {
+	if lesson.GetPrice() == nil {
+		return nil
+	}
+	price := money.New(lesson.GetPrice().GetAmountMinor(), money.Currency(lesson.GetPrice().GetCurrency()))
+	return &price
+}
+
+// lessonPriceOrZero returns the price of the lesson, lessons without a price cost nothing in the default currency.
+func lessonPriceOrZero(lesson *api3.Lesson) money.Money {
+	if price := lessonPrice(lesson); price != nil {
+		return *price
+	}
+	return money.New(0, money.Default)
+}
+
 func retry[T any](
 	ctx context.Context,
 	attempts int,
@@ -229,8 +587,37 @@ func isRetriable(err error) bool {
 	return false
 }
 
+// recordAdminAction writes the action of the calling admin to the audit log before it is done.
+func (s *PaymentService) recordAdminAction(ctx context.Context, action string, targetType string, targetID string, details string) error {
+	recordAuditEventRequest := &api4.RecordAuditEventRequest{
+		Action:     action,
+		TargetType: targetType,
+		TargetId:   targetID,
+		Details:    &details,
+	}
+	_, err := s.adminClient.RecordAuditEvent(ctxWithMetadata(ctx), recordAuditEventRequest)
+	if err != nil {
+		return fmt.Errorf("record admin action: %w", err)
+	}
+	return nil
+}
+
+func receiptFilterDetails(filter *models.ReceiptFilter) string {
+	var parts []string
+	if filter.TutorID != nil {
+		parts = append(parts, "tutor_id="+filter.TutorID.String())
+	}
+	if filter.StudentID != nil {
+		parts = append(parts, "student_id="+filter.StudentID.String())
+	}
+	if filter.Status != nil {
+		parts = append(parts, "status="+string(*filter.Status))
+	}
+	return strings.Join(parts, " ")
+}
+
 func ctxWithMetadata(ctx context.Context) context.Context {
-	reqCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs())
+	reqCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("x-caller-service", "payment_service"))
 	if userId, ok := ctxdata.GetUserID(ctx); ok {
 		reqCtx = metadata.AppendToOutgoingContext(reqCtx, "x-user-id", userId)
 	}
@@ -240,3 +627,8 @@ func ctxWithMetadata(ctx context.Context) context.Context {
 
 	return reqCtx
 }
+
+// serviceMetadata authenticates the request as payment_service alone, for work done on no user's behalf.
+func serviceMetadata(ctx context.Context) context.Context {
+	return metadata.NewOutgoingContext(ctx, metadata.Pairs("x-caller-service", "payment_service"))
+}
@@ -1,74 +1,135 @@
 package service_test
 
 import (
+	"common_library/ctxdata"
+	"common_library/money"
 	"context"
 	"errors"
 	api2 "fileservice/pkg/api"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	errdefs "paymentservice/internal/errors"
 	"paymentservice/internal/mocks"
 	"paymentservice/internal/models"
 	"paymentservice/internal/service"
-	"schedule_service/pkg/api"
+	api "schedule_service/pkg/api"
 	"testing"
 	"time"
+	userapi "userservice/pkg/api"
 )
 
 func setup(t *testing.T) (*gomock.Controller, *service.PaymentService, *mocks.MockIPaymentRepo, *mocks.MockUserServiceClient, *mocks.MockFileServiceClient, *mocks.MockScheduleServiceClient) {
+	ctrl, svc, mockRepo, mockUserClient, mockFileClient, mockScheduleClient, _ := setupWithRenderer(t)
+	return ctrl, svc, mockRepo, mockUserClient, mockFileClient, mockScheduleClient
+}
+
+func setupWithRenderer(t *testing.T) (*gomock.Controller, *service.PaymentService, *mocks.MockIPaymentRepo, *mocks.MockUserServiceClient, *mocks.MockFileServiceClient, *mocks.MockScheduleServiceClient, *mocks.MockInvoiceRenderer) {
 	ctrl := gomock.NewController(t)
 
 	mockRepo := mocks.NewMockIPaymentRepo(ctrl)
 	mockUserClient := mocks.NewMockUserServiceClient(ctrl)
 	mockFileClient := mocks.NewMockFileServiceClient(ctrl)
 	mockScheduleClient := mocks.NewMockScheduleServiceClient(ctrl)
+	mockRenderer := mocks.NewMockInvoiceRenderer(ctrl)
 
-	svc := service.NewPaymentService(mockRepo, mockUserClient, mockFileClient, mockScheduleClient)
-	return ctrl, svc, mockRepo, mockUserClient, mockFileClient, mockScheduleClient
+	svc := service.NewPaymentService(mockRepo, mockUserClient, mocks.NewMockAdminServiceClient(ctrl), mockFileClient, mockScheduleClient, mockRenderer)
+	return ctrl, svc, mockRepo, mockUserClient, mockFileClient, mockScheduleClient, mockRenderer
+}
+
+func setupWithAdminClient(t *testing.T) (*gomock.Controller, *service.PaymentService, *mocks.MockIPaymentRepo, *mocks.MockAdminServiceClient) {
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mocks.NewMockIPaymentRepo(ctrl)
+	mockAdminClient := mocks.NewMockAdminServiceClient(ctrl)
+
+	svc := service.NewPaymentService(
+		mockRepo,
+		mocks.NewMockUserServiceClient(ctrl),
+		mockAdminClient,
+		mocks.NewMockFileServiceClient(ctrl),
+		mocks.NewMockScheduleServiceClient(ctrl),
+		mocks.NewMockInvoiceRenderer(ctrl),
+	)
+	return ctrl, svc, mockRepo, mockAdminClient
+}
+func studentCtx(studentID uuid.UUID) context.Context {
+	ctx := ctxdata.WithUserID(context.Background(), studentID.String())
+	return ctxdata.WithUserRole(ctx, "student")
 }
+
+func tutorCtx(tutorID uuid.UUID) context.Context {
+	ctx := ctxdata.WithUserID(context.Background(), tutorID.String())
+	return ctxdata.WithUserRole(ctx, "tutor")
+}
+
+func guardianCtx(guardianID uuid.UUID) context.Context {
+	ctx := ctxdata.WithUserID(context.Background(), guardianID.String())
+	return ctxdata.WithUserRole(ctx, "guardian")
+}
+
+// rub returns a lesson price of whole rubles
+func rub(amount int64) *api.Money {
+	return &api.Money{AmountMinor: amount * 100, Currency: "RUB"}
+}
+
 func TestSubmitPaymentReceipt(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
-		ctrl, svc, mockRepo, _, _, mockScheduleClient := setup(t)
+		ctrl, svc, mockRepo, _, mockFileClient, mockScheduleClient := setup(t)
 		defer ctrl.Finish()
 
 		lessonID := uuid.New()
 		fileID := uuid.New()
 		receiptID := uuid.New()
+		slotID := uuid.New()
+		tutorID := uuid.New()
+		studentID := uuid.New()
 
 		mockScheduleClient.EXPECT().GetLesson(gomock.Any(), &api.GetLessonRequest{
 			Id: lessonID.String(),
 		}).Return(&api.Lesson{
-			Id:             lessonID.String(),
-			ConnectionLink: proto.String("nigger"),    // вместо &variable
-			PriceRub:       proto.Int32(100),          // вместо &priceRub
-			PaymentInfo:    proto.String("some info"), // вместо &paymentInfo
+			Id:        lessonID.String(),
+			SlotId:    slotID.String(),
+			StudentId: studentID.String(),
+			Price:     rub(100),
 		}, nil)
 
-		mockScheduleClient.EXPECT().UpdateLesson(gomock.Any(), &api.UpdateLessonRequest{
-			Id:             lessonID.String(),
-			ConnectionLink: proto.String("nigger"),
-			PriceRub:       proto.Int32(100),
-			PaymentInfo:    proto.String("some info"),
-		}).Return(&api.Lesson{}, nil)
+		mockRepo.EXPECT().GetReceiptByLessonID(gomock.Any(), lessonID).Return(nil, errdefs.ErrNotFound)
+
+		mockScheduleClient.EXPECT().GetSlot(gomock.Any(), &api.GetSlotRequest{Id: slotID.String()}).
+			Return(&api.Slot{Id: slotID.String(), TutorId: tutorID.String()}, nil)
 
 		mockRepo.EXPECT().ExistsByID(gomock.Any(), gomock.Any()).Return(false, nil)
 
-		mockRepo.EXPECT().CreateReceipt(gomock.Any(), &models.PaymentReceiptCreateInput{
-			LessonID:   lessonID,
-			FileID:     fileID,
-			IsVerified: true,
-		}).Return(&models.PaymentReceipt{
-			ID:         receiptID,
-			LessonID:   lessonID,
-			FileID:     fileID,
-			IsVerified: true,
+		mockRepo.EXPECT().CreateReceipt(gomock.Any(), gomock.Cond(func(input *models.PaymentReceiptCreateInput) bool {
+			return input.ID != uuid.Nil && input.LessonID == lessonID && input.FileID == fileID &&
+				input.TutorID == tutorID && input.StudentID == studentID && input.Amount == money.New(10000, money.RUB)
+		})).Return(&models.PaymentReceipt{
+			ID:        receiptID,
+			LessonID:  lessonID,
+			FileID:    fileID,
+			Status:    models.ReceiptStatusPending,
+			TutorID:   &tutorID,
+			StudentID: &studentID,
 		}, nil)
 
-		result, err := svc.SubmitPaymentReceipt(context.Background(), &models.SubmitPaymentReceiptInput{
+		mockFileClient.EXPECT().RegisterFileUsage(gomock.Any(), &api2.FileUsage{
+			FileId:    fileID.String(),
+			OwnerType: "receipt",
+			OwnerId:   receiptID.String(),
+		}).Return(&api2.Empty{}, nil)
+
+		mockFileClient.EXPECT().GrantFileAccess(gomock.Any(), &api2.FileAccessRequest{
+			FileId:  fileID.String(),
+			UserIds: []string{tutorID.String(), studentID.String()},
+		}).Return(&api2.Empty{}, nil)
+
+		result, err := svc.SubmitPaymentReceipt(studentCtx(studentID), &models.SubmitPaymentReceiptInput{
 			LessonId: lessonID,
 			FileId:   fileID,
 		})
@@ -78,6 +139,9 @@ func TestSubmitPaymentReceipt(t *testing.T) {
 		if result.ID != receiptID {
 			t.Fatalf("expected receipt ID %v, got %v", receiptID, result.ID)
 		}
+		if result.Status != models.ReceiptStatusPending {
+			t.Fatalf("expected pending receipt, got %v", result.Status)
+		}
 	})
 
 	t.Run("Error_InvalidInput", func(t *testing.T) {
@@ -120,53 +184,120 @@ func TestSubmitPaymentReceipt(t *testing.T) {
 		}
 	})
 
-	t.Run("Error_UpdateLesson", func(t *testing.T) {
-		ctrl, svc, _, _, _, mockSchedule := setup(t)
+	t.Run("Error_NotStudentOfLesson", func(t *testing.T) {
+		ctrl, svc, _, _, _, mockScheduleClient := setup(t)
+		defer ctrl.Finish()
+
+		mockScheduleClient.EXPECT().GetLesson(gomock.Any(), gomock.Any()).
+			Return(&api.Lesson{StudentId: uuid.New().String()}, nil)
+
+		_, err := svc.SubmitPaymentReceipt(studentCtx(uuid.New()), &models.SubmitPaymentReceiptInput{
+			LessonId: uuid.New(), FileId: uuid.New(),
+		})
+		if !errors.Is(err, errdefs.ErrPermissionDenied) {
+			t.Fatalf("expected ErrPermissionDenied, got %v", err)
+		}
+	})
+
+	t.Run("Error_ReceiptAlreadySubmitted", func(t *testing.T) {
+		for _, receiptStatus := range []models.ReceiptStatus{models.ReceiptStatusPending, models.ReceiptStatusApproved} {
+			t.Run(receiptStatus.String(), func(t *testing.T) {
+				ctrl, svc, mockRepo, _, _, mockScheduleClient := setup(t)
+				defer ctrl.Finish()
+
+				studentID := uuid.New()
+				mockScheduleClient.EXPECT().GetLesson(gomock.Any(), gomock.Any()).
+					Return(&api.Lesson{StudentId: studentID.String()}, nil)
+				mockRepo.EXPECT().GetReceiptByLessonID(gomock.Any(), gomock.Any()).
+					Return(&models.PaymentReceipt{Status: receiptStatus}, nil)
+
+				_, err := svc.SubmitPaymentReceipt(studentCtx(studentID), &models.SubmitPaymentReceiptInput{
+					LessonId: uuid.New(), FileId: uuid.New(),
+				})
+				if !errors.Is(err, errdefs.ErrAlreadyExists) {
+					t.Fatalf("expected ErrAlreadyExists, got %v", err)
+				}
+			})
+		}
+	})
+
+	t.Run("ResubmitAfterRejection", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, mockFileClient, mockScheduleClient := setup(t)
+		defer ctrl.Finish()
+
+		studentID := uuid.New()
+		mockScheduleClient.EXPECT().GetLesson(gomock.Any(), gomock.Any()).
+			Return(&api.Lesson{StudentId: studentID.String()}, nil)
+		mockRepo.EXPECT().GetReceiptByLessonID(gomock.Any(), gomock.Any()).
+			Return(&models.PaymentReceipt{Status: models.ReceiptStatusRejected}, nil)
+		mockScheduleClient.EXPECT().GetSlot(gomock.Any(), gomock.Any()).
+			Return(&api.Slot{TutorId: uuid.New().String()}, nil)
+		mockRepo.EXPECT().ExistsByID(gomock.Any(), gomock.Any()).Return(false, nil)
+		mockRepo.EXPECT().CreateReceipt(gomock.Any(), gomock.Any()).
+			Return(&models.PaymentReceipt{ID: uuid.New(), Status: models.ReceiptStatusPending}, nil)
+		mockFileClient.EXPECT().RegisterFileUsage(gomock.Any(), gomock.Any()).Return(&api2.Empty{}, nil)
+		mockFileClient.EXPECT().GrantFileAccess(gomock.Any(), gomock.Any()).Return(&api2.Empty{}, nil)
+
+		_, err := svc.SubmitPaymentReceipt(studentCtx(studentID), &models.SubmitPaymentReceiptInput{
+			LessonId: uuid.New(), FileId: uuid.New(),
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Error_GetSlot", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, mockSchedule := setup(t)
 		defer ctrl.Finish()
 
+		studentID := uuid.New()
 		mockSchedule.EXPECT().
 			GetLesson(gomock.Any(), gomock.Any()).
-			Return(&api.Lesson{IsPaid: false}, nil)
+			Return(&api.Lesson{StudentId: studentID.String()}, nil)
+		mockRepo.EXPECT().GetReceiptByLessonID(gomock.Any(), gomock.Any()).Return(nil, errdefs.ErrNotFound)
 		mockSchedule.EXPECT().
-			UpdateLesson(gomock.Any(), gomock.Any()).
-			Return(nil, errors.New("upd error"))
+			GetSlot(gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("slot error"))
 
-		_, err := svc.SubmitPaymentReceipt(context.Background(), &models.SubmitPaymentReceiptInput{
+		_, err := svc.SubmitPaymentReceipt(studentCtx(studentID), &models.SubmitPaymentReceiptInput{
 			LessonId: uuid.New(), FileId: uuid.New(),
 		})
-		if err == nil || err.Error() != "upd error" {
-			t.Fatalf("want upd error, got %v", err)
+		if err == nil || err.Error() != "slot error" {
+			t.Fatalf("want slot error, got %v", err)
 		}
 	})
 	t.Run("Error_CreateReceipt", func(t *testing.T) {
 		ctrl, svc, mockRepo, _, _, mockSchedule := setup(t)
 		defer ctrl.Finish()
 
-		mockSchedule.EXPECT().GetLesson(gomock.Any(), gomock.Any()).Return(&api.Lesson{PriceRub: proto.Int32(1)}, nil)
-		mockSchedule.EXPECT().UpdateLesson(gomock.Any(), gomock.Any()).Return(&api.Lesson{}, nil)
+		studentID := uuid.New()
+		mockSchedule.EXPECT().GetLesson(gomock.Any(), gomock.Any()).
+			Return(&api.Lesson{Price: rub(1), StudentId: studentID.String()}, nil)
+		mockRepo.EXPECT().GetReceiptByLessonID(gomock.Any(), gomock.Any()).Return(nil, errdefs.ErrNotFound)
+		mockSchedule.EXPECT().GetSlot(gomock.Any(), gomock.Any()).Return(&api.Slot{TutorId: uuid.New().String()}, nil)
 		mockRepo.EXPECT().ExistsByID(gomock.Any(), gomock.Any()).Return(false, nil)
 		mockRepo.EXPECT().
 			CreateReceipt(gomock.Any(), gomock.Any()).
 			Return(nil, errors.New("db error"))
 
-		_, err := svc.SubmitPaymentReceipt(context.Background(), &models.SubmitPaymentReceiptInput{
+		_, err := svc.SubmitPaymentReceipt(studentCtx(studentID), &models.SubmitPaymentReceiptInput{
 			LessonId: uuid.New(), FileId: uuid.New(),
 		})
-		if err == nil || err.Error() != errdefs.ErrNotFound.Error() {
-			t.Fatalf("expected ErrNotFound, got %v", err)
+		if err == nil || err.Error() != "db error" {
+			t.Fatalf("want db error, got %v", err)
 		}
 	})
 	t.Run("RetryLogic_SucceedsAfterRetries", func(t *testing.T) {
-		ctrl, svc, mockRepo, _, _, mockScheduleClient := setup(t)
+		ctrl, svc, mockRepo, _, mockFileClient, mockScheduleClient := setup(t)
 		defer ctrl.Finish()
 
 		lessonID := uuid.New()
 		fileID := uuid.New()
+		studentID := uuid.New()
 
 		mockScheduleClient.EXPECT().GetLesson(gomock.Any(), gomock.Any()).
-			Return(&api.Lesson{Id: lessonID.String(), IsPaid: false}, nil)
-		mockScheduleClient.EXPECT().UpdateLesson(gomock.Any(), gomock.Any()).
-			Return(&api.Lesson{}, nil)
+			Return(&api.Lesson{Id: lessonID.String(), IsPaid: false, StudentId: studentID.String()}, nil)
+		mockRepo.EXPECT().GetReceiptByLessonID(gomock.Any(), gomock.Any()).Return(nil, errdefs.ErrNotFound)
+		mockScheduleClient.EXPECT().GetSlot(gomock.Any(), gomock.Any()).
+			Return(&api.Slot{TutorId: uuid.New().String()}, nil)
 
 		mockRepo.EXPECT().ExistsByID(gomock.Any(), gomock.Any()).
 			Return(false, nil)
@@ -176,8 +307,12 @@ func TestSubmitPaymentReceipt(t *testing.T) {
 			Return(nil, retriableError).Times(4)
 		mockRepo.EXPECT().CreateReceipt(gomock.Any(), gomock.Any()).
 			Return(&models.PaymentReceipt{}, nil).Times(1)
+		mockFileClient.EXPECT().RegisterFileUsage(gomock.Any(), gomock.Any()).
+			Return(&api2.Empty{}, nil)
+		mockFileClient.EXPECT().GrantFileAccess(gomock.Any(), gomock.Any()).
+			Return(&api2.Empty{}, nil)
 
-		_, err := svc.SubmitPaymentReceipt(context.Background(), &models.SubmitPaymentReceiptInput{
+		_, err := svc.SubmitPaymentReceipt(studentCtx(studentID), &models.SubmitPaymentReceiptInput{
 			LessonId: lessonID,
 			FileId:   fileID,
 		})
@@ -199,7 +334,7 @@ func TestGetPaymentInfo(t *testing.T) {
 			Id: lessonID.String(),
 		}).Return(&api.Lesson{
 			Id:          lessonID.String(),
-			PriceRub:    proto.Int32(1500),
+			Price:       rub(1500),
 			PaymentInfo: proto.String("Payment instructions"),
 		}, nil)
 
@@ -207,11 +342,24 @@ func TestGetPaymentInfo(t *testing.T) {
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
-		if info == nil || info.LessonID != lessonID || info.PriceRUB != 1500 {
+		if info == nil || info.LessonID != lessonID || *info.Price != money.New(150000, money.RUB) {
 			t.Fatal("invalid payment info returned")
 		}
 	})
 
+	t.Run("NoPrice", func(t *testing.T) {
+		ctrl, svc, _, _, _, mockScheduleClient := setup(t)
+		defer ctrl.Finish()
+
+		lessonID := uuid.New()
+		mockScheduleClient.EXPECT().GetLesson(gomock.Any(), gomock.Any()).Return(&api.Lesson{Id: lessonID.String()}, nil)
+
+		info, err := svc.GetPaymentInfo(context.Background(), &models.GetPaymentInfoInput{LessonId: lessonID})
+		assert.NoError(t, err)
+		assert.Nil(t, info.Price)
+		assert.Empty(t, info.PaymentDetails)
+	})
+
 	t.Run("Error_InvalidInput", func(t *testing.T) {
 		_, svc, _, _, _, _ := setup(t)
 
@@ -258,12 +406,12 @@ func TestGetReceipt(t *testing.T) {
 		input := &models.GetReceiptInput{ReceiptId: receiptID}
 
 		receipt := &models.PaymentReceipt{
-			ID:         receiptID,
-			LessonID:   uuid.New(),
-			FileID:     uuid.New(),
-			IsVerified: true,
-			CreatedAt:  time.Now(),
-			EditedAt:   time.Now(),
+			ID:        receiptID,
+			LessonID:  uuid.New(),
+			FileID:    uuid.New(),
+			Status:    models.ReceiptStatusApproved,
+			CreatedAt: time.Now(),
+			EditedAt:  time.Now(),
 		}
 
 		mockRepo.EXPECT().GetReceiptByID(gomock.Any(), receiptID).Return(receipt, nil)
@@ -300,53 +448,252 @@ func TestGetReceipt(t *testing.T) {
 	})
 }
 
-func TestVerifyReceipt(t *testing.T) {
-	t.Run("Success", func(t *testing.T) {
+func TestApproveReceipt(t *testing.T) {
+	t.Run("Error_InvalidInput", func(t *testing.T) {
+		_, svc, _, _, _, _ := setup(t)
+
+		_, err := svc.ApproveReceipt(context.Background(), &models.ReviewReceiptInput{})
+		if !errors.Is(err, errdefs.ErrInvalidArgument) {
+			t.Fatalf("expected ErrInvalidArgument, got %v", err)
+		}
+	})
+
+	t.Run("Error_ReceiptNotFound", func(t *testing.T) {
 		ctrl, svc, mockRepo, _, _, _ := setup(t)
 		defer ctrl.Finish()
 
 		receiptID := uuid.New()
-		input := &models.VerifyReceiptInput{ReceiptId: receiptID}
+		mockRepo.EXPECT().GetReceiptByID(gomock.Any(), receiptID).Return(nil, errdefs.ErrNotFound)
+
+		_, err := svc.ApproveReceipt(tutorCtx(uuid.New()), &models.ReviewReceiptInput{ReceiptId: receiptID})
+		if !errors.Is(err, errdefs.ErrNotFound) {
+			t.Fatalf("expected ErrNotFound, got %v", err)
+		}
+	})
 
-		updatedReceipt := &models.PaymentReceipt{
-			ID:         receiptID,
-			IsVerified: true,
+	t.Run("Error_NotTutorOfLesson", func(t *testing.T) {
+		tutorID := uuid.New()
+		testCases := []struct {
+			name string
+			ctx  context.Context
+		}{
+			{"OtherTutor", tutorCtx(uuid.New())},
+			{"Student", studentCtx(tutorID)},
+			{"Anonymous", context.Background()},
 		}
 
-		mockRepo.EXPECT().UpdateReceipt(gomock.Any(), receiptID, true).Return(updatedReceipt, nil)
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				ctrl, svc, mockRepo, _, _, _ := setup(t)
+				defer ctrl.Finish()
+
+				mockRepo.EXPECT().GetReceiptByID(gomock.Any(), gomock.Any()).Return(&models.PaymentReceipt{
+					Status:  models.ReceiptStatusPending,
+					TutorID: &tutorID,
+				}, nil)
 
-		result, err := svc.VerifyReceipt(context.Background(), input)
+				_, err := svc.ApproveReceipt(tc.ctx, &models.ReviewReceiptInput{ReceiptId: uuid.New()})
+				if !errors.Is(err, errdefs.ErrPermissionDenied) {
+					t.Fatalf("expected ErrPermissionDenied, got %v", err)
+				}
+			})
+		}
+	})
+
+	t.Run("Error_AlreadyReviewed", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, _ := setup(t)
+		defer ctrl.Finish()
+
+		tutorID := uuid.New()
+		mockRepo.EXPECT().GetReceiptByID(gomock.Any(), gomock.Any()).Return(&models.PaymentReceipt{
+			Status:  models.ReceiptStatusRejected,
+			TutorID: &tutorID,
+		}, nil)
+
+		_, err := svc.ApproveReceipt(tutorCtx(tutorID), &models.ReviewReceiptInput{ReceiptId: uuid.New()})
+		if !errors.Is(err, errdefs.ErrAlreadyReviewed) {
+			t.Fatalf("expected ErrAlreadyReviewed, got %v", err)
+		}
+	})
+}
+
+func TestRejectReceipt(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, _ := setup(t)
+		defer ctrl.Finish()
+
+		receiptID := uuid.New()
+		tutorID := uuid.New()
+		reason := "wrong amount"
+
+		mockRepo.EXPECT().GetReceiptByID(gomock.Any(), receiptID).Return(&models.PaymentReceipt{
+			ID:      receiptID,
+			Status:  models.ReceiptStatusPending,
+			TutorID: &tutorID,
+		}, nil)
+		mockRepo.EXPECT().ReviewReceipt(gomock.Any(), receiptID, &models.PaymentReceiptReviewInput{
+			Status:          models.ReceiptStatusRejected,
+			RejectionReason: &reason,
+		}).Return(&models.PaymentReceipt{ID: receiptID, Status: models.ReceiptStatusRejected, RejectionReason: &reason}, nil)
+
+		result, err := svc.RejectReceipt(tutorCtx(tutorID), &models.RejectReceiptInput{ReceiptId: receiptID, Reason: " " + reason + " "})
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
-		if result == nil || !result.IsVerified {
-			t.Fatal("receipt not verified")
+		if result.Status != models.ReceiptStatusRejected {
+			t.Fatal("receipt not rejected")
 		}
 	})
 
-	t.Run("Error_InvalidInput", func(t *testing.T) {
+	t.Run("Error_EmptyReason", func(t *testing.T) {
 		_, svc, _, _, _, _ := setup(t)
 
-		_, err := svc.VerifyReceipt(context.Background(), &models.VerifyReceiptInput{})
-		if err == nil {
-			t.Fatal("expected error for empty receipt ID")
+		_, err := svc.RejectReceipt(context.Background(), &models.RejectReceiptInput{ReceiptId: uuid.New(), Reason: "  "})
+		if !errors.Is(err, errdefs.ErrInvalidArgument) {
+			t.Fatalf("expected ErrInvalidArgument, got %v", err)
 		}
 	})
 
-	t.Run("Error_ReceiptNotFound", func(t *testing.T) {
+	t.Run("Error_NotTutorOfLesson", func(t *testing.T) {
 		ctrl, svc, mockRepo, _, _, _ := setup(t)
 		defer ctrl.Finish()
 
-		receiptID := uuid.New()
-		mockRepo.EXPECT().UpdateReceipt(gomock.Any(), receiptID, true).Return(nil, errors.New("not found"))
+		tutorID := uuid.New()
+		mockRepo.EXPECT().GetReceiptByID(gomock.Any(), gomock.Any()).Return(&models.PaymentReceipt{
+			Status:  models.ReceiptStatusPending,
+			TutorID: &tutorID,
+		}, nil)
 
-		_, err := svc.VerifyReceipt(context.Background(), &models.VerifyReceiptInput{ReceiptId: receiptID})
-		if err == nil {
-			t.Fatal("expected error when receipt not found")
+		_, err := svc.RejectReceipt(tutorCtx(uuid.New()), &models.RejectReceiptInput{ReceiptId: uuid.New(), Reason: "reason"})
+		if !errors.Is(err, errdefs.ErrPermissionDenied) {
+			t.Fatalf("expected ErrPermissionDenied, got %v", err)
 		}
 	})
 }
 
+func TestListReceipts(t *testing.T) {
+	t.Run("TutorSeesOwnReceipts", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, _ := setup(t)
+		defer ctrl.Finish()
+
+		tutorID := uuid.New()
+		studentID := uuid.New()
+		pending := models.ReceiptStatusPending
+
+		mockRepo.EXPECT().ListReceipts(gomock.Any(), &models.ReceiptFilter{
+			TutorID:   &tutorID,
+			StudentID: &studentID,
+			Status:    &pending,
+		}).Return([]*models.PaymentReceipt{{ID: uuid.New()}}, nil)
+
+		result, err := svc.ListReceipts(tutorCtx(tutorID), &models.ListReceiptsInput{StudentId: &studentID, Status: &pending})
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+
+	t.Run("StudentSeesOwnReceipts", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, _ := setup(t)
+		defer ctrl.Finish()
+
+		studentID := uuid.New()
+		mockRepo.EXPECT().ListReceipts(gomock.Any(), &models.ReceiptFilter{StudentID: &studentID}).
+			Return([]*models.PaymentReceipt{}, nil)
+
+		_, err := svc.ListReceipts(studentCtx(studentID), &models.ListReceiptsInput{})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Error_OtherUsersReceipts", func(t *testing.T) {
+		_, svc, _, _, _, _ := setup(t)
+
+		otherID := uuid.New()
+		_, err := svc.ListReceipts(tutorCtx(uuid.New()), &models.ListReceiptsInput{TutorId: &otherID})
+		assert.ErrorIs(t, err, errdefs.ErrPermissionDenied)
+
+		_, err = svc.ListReceipts(studentCtx(uuid.New()), &models.ListReceiptsInput{StudentId: &otherID})
+		assert.ErrorIs(t, err, errdefs.ErrPermissionDenied)
+
+		_, err = svc.ListReceipts(context.Background(), &models.ListReceiptsInput{})
+		assert.ErrorIs(t, err, errdefs.ErrPermissionDenied)
+	})
+
+	t.Run("GuardianSeesReceiptsOfLinkedStudent", func(t *testing.T) {
+		ctrl, svc, mockRepo, mockUserClient, _, _ := setup(t)
+		defer ctrl.Finish()
+
+		guardianID := uuid.New()
+		studentID := uuid.New()
+		mockUserClient.EXPECT().GetGuardianStudent(gomock.Any(), &userapi.GetGuardianStudentRequest{
+			GuardianId: guardianID.String(),
+			StudentId:  studentID.String(),
+		}).Return(&userapi.GuardianStudent{Status: "active"}, nil)
+		mockRepo.EXPECT().ListReceipts(gomock.Any(), &models.ReceiptFilter{StudentID: &studentID}).
+			Return([]*models.PaymentReceipt{}, nil)
+
+		_, err := svc.ListReceipts(guardianCtx(guardianID), &models.ListReceiptsInput{StudentId: &studentID})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Error_GuardianNotLinked", func(t *testing.T) {
+		ctrl, svc, _, mockUserClient, _, _ := setup(t)
+		defer ctrl.Finish()
+
+		studentID := uuid.New()
+		mockUserClient.EXPECT().GetGuardianStudent(gomock.Any(), gomock.Any()).
+			Return(nil, status.Error(codes.NotFound, "not found"))
+
+		_, err := svc.ListReceipts(guardianCtx(uuid.New()), &models.ListReceiptsInput{StudentId: &studentID})
+		assert.ErrorIs(t, err, errdefs.ErrPermissionDenied)
+
+		_, err = svc.ListReceipts(guardianCtx(uuid.New()), &models.ListReceiptsInput{})
+		assert.ErrorIs(t, err, errdefs.ErrInvalidArgument)
+	})
+
+	t.Run("AdminSeesAllReceipts", func(t *testing.T) {
+		ctrl, svc, mockRepo, mockAdminClient := setupWithAdminClient(t)
+		defer ctrl.Finish()
+
+		adminID := uuid.New()
+		studentID := uuid.New()
+		ctx := ctxdata.WithUserRole(ctxdata.WithUserID(context.Background(), adminID.String()), "admin")
+		details := "student_id=" + studentID.String()
+
+		gomock.InOrder(
+			mockAdminClient.EXPECT().RecordAuditEvent(gomock.Any(), &userapi.RecordAuditEventRequest{
+				Action:     "list_receipts",
+				TargetType: "receipt",
+				Details:    &details,
+			}).Return(&userapi.Empty{}, nil),
+			mockRepo.EXPECT().ListReceipts(gomock.Any(), &models.ReceiptFilter{StudentID: &studentID}).
+				Return([]*models.PaymentReceipt{{ID: uuid.New()}}, nil),
+		)
+
+		result, err := svc.ListReceipts(ctx, &models.ListReceiptsInput{StudentId: &studentID})
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+
+	t.Run("Error_AdminAuditFailed", func(t *testing.T) {
+		ctrl, svc, _, mockAdminClient := setupWithAdminClient(t)
+		defer ctrl.Finish()
+
+		ctx := ctxdata.WithUserRole(ctxdata.WithUserID(context.Background(), uuid.NewString()), "admin")
+		mockAdminClient.EXPECT().RecordAuditEvent(gomock.Any(), gomock.Any()).
+			Return(nil, status.Error(codes.Unavailable, "unavailable"))
+
+		_, err := svc.ListReceipts(ctx, &models.ListReceiptsInput{})
+		assert.Error(t, err)
+	})
+
+	t.Run("Error_InvalidStatus", func(t *testing.T) {
+		_, svc, _, _, _, _ := setup(t)
+
+		unknown := models.ReceiptStatus("paid")
+		_, err := svc.ListReceipts(tutorCtx(uuid.New()), &models.ListReceiptsInput{Status: &unknown})
+		assert.ErrorIs(t, err, errdefs.ErrInvalidArgument)
+	})
+}
+
 func TestGetReceiptFile(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		ctrl, svc, mockRepo, _, mockFileClient, _ := setup(t)
@@ -357,9 +704,9 @@ func TestGetReceiptFile(t *testing.T) {
 		input := &models.GetReceiptFileInput{ReceiptId: receiptID}
 
 		receipt := &models.PaymentReceipt{
-			ID:         receiptID,
-			FileID:     fileID,
-			IsVerified: true,
+			ID:     receiptID,
+			FileID: fileID,
+			Status: models.ReceiptStatusApproved,
 		}
 
 		mockRepo.EXPECT().GetReceiptByID(gomock.Any(), receiptID).Return(receipt, nil)
@@ -394,9 +741,9 @@ func TestGetReceiptFile(t *testing.T) {
 		receiptID := uuid.New()
 		fileID := uuid.New()
 		receipt := &models.PaymentReceipt{
-			ID:         receiptID,
-			FileID:     fileID,
-			IsVerified: true,
+			ID:     receiptID,
+			FileID: fileID,
+			Status: models.ReceiptStatusApproved,
 		}
 
 		mockRepo.EXPECT().GetReceiptByID(gomock.Any(), receiptID).Return(receipt, nil)
@@ -408,3 +755,71 @@ func TestGetReceiptFile(t *testing.T) {
 		}
 	})
 }
+
+func TestBackfillReceiptFileGrants(t *testing.T) {
+	ctrl, svc, mockRepo, _, mockFileClient, _ := setup(t)
+	defer ctrl.Finish()
+
+	tutorID := uuid.New()
+	studentID := uuid.New()
+	receipts := []*models.PaymentReceipt{
+		{ID: uuid.New(), FileID: uuid.New(), TutorID: &tutorID, StudentID: &studentID},
+		{ID: uuid.New(), FileID: uuid.New(), StudentID: &studentID},
+	}
+
+	mockRepo.EXPECT().ListReceipts(gomock.Any(), &models.ReceiptFilter{}).Return(receipts, nil)
+	mockFileClient.EXPECT().GrantFileAccess(gomock.Any(), &api2.FileAccessRequest{
+		FileId:  receipts[0].FileID.String(),
+		UserIds: []string{tutorID.String(), studentID.String()},
+	}).Return(&api2.Empty{}, nil)
+	mockFileClient.EXPECT().GrantFileAccess(gomock.Any(), &api2.FileAccessRequest{
+		FileId:  receipts[1].FileID.String(),
+		UserIds: []string{studentID.String()},
+	}).Return(&api2.Empty{}, nil)
+
+	count, err := svc.BackfillReceiptFileGrants(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestBackfillReceiptPairs(t *testing.T) {
+	ctrl, svc, mockRepo, _, _, mockScheduleClient := setup(t)
+	defer ctrl.Finish()
+
+	tutorID := uuid.New()
+	studentID := uuid.New()
+	receipts := []*models.PaymentReceipt{
+		{ID: uuid.New(), LessonID: uuid.New()},
+		{ID: uuid.New(), LessonID: uuid.New()},
+	}
+
+	lessons := map[string]*api.Lesson{
+		receipts[0].LessonID.String(): {Id: receipts[0].LessonID.String(), TutorId: tutorID.String(), StudentId: studentID.String()},
+	}
+	// schedule_service serves lessons to users only, and to services acting on their own only through GetLessonInternal
+	mockScheduleClient.EXPECT().GetLesson(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, _ *api.GetLessonRequest, _ ...grpc.CallOption) (*api.Lesson, error) {
+			if md, _ := metadata.FromOutgoingContext(ctx); len(md.Get("x-user-id")) == 0 {
+				return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+			}
+			return nil, status.Error(codes.PermissionDenied, "permission denied")
+		}).AnyTimes()
+	mockScheduleClient.EXPECT().GetLessonInternal(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, req *api.GetLessonRequest, _ ...grpc.CallOption) (*api.Lesson, error) {
+			if md, _ := metadata.FromOutgoingContext(ctx); len(md.Get("x-caller-service")) == 0 {
+				return nil, status.Error(codes.PermissionDenied, "permission denied")
+			}
+			if lesson, ok := lessons[req.Id]; ok {
+				return lesson, nil
+			}
+			// the other receipt is skipped and tried again on the next start
+			return nil, errors.New("lesson is gone")
+		}).Times(2)
+
+	mockRepo.EXPECT().ListReceiptsWithoutPair(gomock.Any()).Return(receipts, nil)
+	mockRepo.EXPECT().SetReceiptPair(gomock.Any(), receipts[0].ID, tutorID, studentID).Return(nil)
+
+	count, err := svc.BackfillReceiptPairs(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+	"github.com/google/uuid"
+	errdefs "paymentservice/internal/errors"
+	"paymentservice/internal/models"
+)
+
+// ExportUserData returns receipts, invoices and refunds of the pairs where the current user is the tutor
+// or the student. Guardians and admins have no records of their own.
+func (s *PaymentService) ExportUserData(ctx context.Context, userID uuid.UUID) (*models.UserDataExport, error) {
+	callerID, role, ok := caller(ctx)
+	if !ok || callerID != userID {
+		return nil, errdefs.ErrPermissionDenied
+	}
+
+	var tutorID, studentID *uuid.UUID
+	switch role {
+	case models.RoleTutor:
+		tutorID = &userID
+	case models.RoleStudent:
+		studentID = &userID
+	default:
+		return &models.UserDataExport{}, nil
+	}
+
+	receipts, err := s.repo.ListReceipts(ctx, &models.ReceiptFilter{TutorID: tutorID, StudentID: studentID})
+	if err != nil {
+		return nil, err
+	}
+	invoices, err := s.repo.ListInvoices(ctx, &models.InvoiceFilter{TutorID: tutorID, StudentID: studentID})
+	if err != nil {
+		return nil, err
+	}
+	refunds, err := s.repo.ListRefunds(ctx, &models.RefundFilter{TutorID: tutorID, StudentID: studentID})
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.UserDataExport{Receipts: receipts, Invoices: invoices, Refunds: refunds}, nil
+}
@@ -0,0 +1,50 @@
+package service_test
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	errdefs "paymentservice/internal/errors"
+	"paymentservice/internal/models"
+	"testing"
+)
+
+func TestExportUserData(t *testing.T) {
+	studentID := uuid.New()
+
+	t.Run("Student", func(t *testing.T) {
+		ctrl, svc, mockRepo, _, _, _ := setup(t)
+		defer ctrl.Finish()
+
+		receipt := &models.PaymentReceipt{ID: uuid.New()}
+		invoice := &models.Invoice{ID: uuid.New()}
+		mockRepo.EXPECT().ListReceipts(gomock.Any(), &models.ReceiptFilter{StudentID: &studentID}).Return([]*models.PaymentReceipt{receipt}, nil)
+		mockRepo.EXPECT().ListInvoices(gomock.Any(), &models.InvoiceFilter{StudentID: &studentID}).Return([]*models.Invoice{invoice}, nil)
+		mockRepo.EXPECT().ListRefunds(gomock.Any(), &models.RefundFilter{StudentID: &studentID}).Return(nil, nil)
+
+		export, err := svc.ExportUserData(studentCtx(studentID), studentID)
+		require.NoError(t, err)
+		assert.Equal(t, []*models.PaymentReceipt{receipt}, export.Receipts)
+		assert.Equal(t, []*models.Invoice{invoice}, export.Invoices)
+		assert.Empty(t, export.Refunds)
+	})
+
+	t.Run("Guardian", func(t *testing.T) {
+		ctrl, svc, _, _, _, _ := setup(t)
+		defer ctrl.Finish()
+
+		guardianID := uuid.New()
+		export, err := svc.ExportUserData(guardianCtx(guardianID), guardianID)
+		require.NoError(t, err)
+		assert.Empty(t, export.Receipts)
+	})
+
+	t.Run("OtherUser", func(t *testing.T) {
+		ctrl, svc, _, _, _, _ := setup(t)
+		defer ctrl.Finish()
+
+		_, err := svc.ExportUserData(tutorCtx(uuid.New()), studentID)
+		assert.ErrorIs(t, err, errdefs.ErrPermissionDenied)
+	})
+}
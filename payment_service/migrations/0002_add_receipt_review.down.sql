@@ -0,0 +1,13 @@
+DROP INDEX IF EXISTS "receipts_student_id_idx";
+DROP INDEX IF EXISTS "receipts_tutor_id_idx";
+DROP INDEX IF EXISTS "receipts_lesson_id_active_idx";
+
+ALTER TABLE "receipts" ADD COLUMN "is_verified" boolean NOT NULL DEFAULT false;
+
+UPDATE "receipts" SET "is_verified" = true WHERE "status" = 'approved';
+
+ALTER TABLE "receipts"
+  DROP COLUMN "student_id",
+  DROP COLUMN "tutor_id",
+  DROP COLUMN "rejection_reason",
+  DROP COLUMN "status";
\ No newline at end of file
@@ -0,0 +1,31 @@
+ALTER TABLE "receipts"
+  ADD COLUMN "status" text NOT NULL DEFAULT 'pending' CHECK ("status" IN ('pending', 'approved', 'rejected')),
+  ADD COLUMN "rejection_reason" text,
+  ADD COLUMN "tutor_id" uuid,
+  ADD COLUMN "student_id" uuid;
+
+UPDATE "receipts" SET "status" = 'approved' WHERE "is_verified";
+
+ALTER TABLE "receipts" DROP COLUMN "is_verified";
+
+-- receipts could be submitted repeatedly before, keep the approved or the latest one of a lesson active
+UPDATE "receipts" r
+SET "status" = 'rejected', "rejection_reason" = 'Duplicate receipt'
+FROM (
+  SELECT "id", row_number() OVER (PARTITION BY "lesson_id" ORDER BY ("status" = 'approved') DESC, "created_at" DESC, "id" DESC) AS "rank"
+  FROM "receipts"
+) d
+WHERE r."id" = d."id" AND d."rank" > 1;
+
+-- a lesson has at most one receipt that is not rejected, rejected ones are kept for history
+CREATE UNIQUE INDEX "receipts_lesson_id_active_idx" ON "receipts" ("lesson_id") WHERE "status" <> 'rejected';
+
+CREATE INDEX "receipts_tutor_id_idx" ON "receipts" ("tutor_id", "status");
+
+CREATE INDEX "receipts_student_id_idx" ON "receipts" ("student_id", "status");
+
+COMMENT ON COLUMN "receipts"."status" IS 'pending / approved / rejected';
+
+COMMENT ON COLUMN "receipts"."tutor_id" IS 'Refers to user_service.users.id';
+
+COMMENT ON COLUMN "receipts"."student_id" IS 'Refers to user_service.users.id';
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS "receipt_approval_sagas";
\ No newline at end of file
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS "receipt_approval_sagas" (
+  "id" uuid PRIMARY KEY,
+  "receipt_id" uuid NOT NULL REFERENCES "receipts" ("id") ON DELETE CASCADE,
+  "lesson_id" uuid NOT NULL,
+  "state" text NOT NULL DEFAULT 'started' CHECK ("state" IN ('started', 'lesson_paid', 'completed', 'compensating', 'compensated')),
+  "attempts" integer NOT NULL DEFAULT 0,
+  "last_error" text,
+  "created_at" timestamp NOT NULL DEFAULT now(),
+  "updated_at" timestamp NOT NULL DEFAULT now()
+);
+
+-- only one approval of a receipt can be in progress
+CREATE UNIQUE INDEX "receipt_approval_sagas_receipt_id_active_idx" ON "receipt_approval_sagas" ("receipt_id")
+  WHERE "state" NOT IN ('completed', 'compensated');
+
+CREATE INDEX "receipt_approval_sagas_updated_at_active_idx" ON "receipt_approval_sagas" ("updated_at")
+  WHERE "state" NOT IN ('completed', 'compensated');
+
+COMMENT ON COLUMN "receipt_approval_sagas"."lesson_id" IS 'Refers to schedule.lessons.id';
+
+COMMENT ON COLUMN "receipt_approval_sagas"."attempts" IS 'Number of runs by the recovery loop';
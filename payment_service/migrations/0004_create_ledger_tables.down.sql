@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS "ledger_entries";
+DROP TABLE IF EXISTS "ledger_transactions";
+DROP TABLE IF EXISTS "package_purchases";
+DROP TABLE IF EXISTS "lesson_packages";
+
+ALTER TABLE "receipts" DROP COLUMN "amount_rub";
\ No newline at end of file
@@ -0,0 +1,58 @@
+ALTER TABLE "receipts" ADD COLUMN "amount_rub" integer;
+
+COMMENT ON COLUMN "receipts"."amount_rub" IS 'Price of the lesson when the receipt was submitted';
+
+CREATE TABLE IF NOT EXISTS "lesson_packages" (
+  "id" uuid PRIMARY KEY,
+  "tutor_id" uuid NOT NULL,
+  "student_id" uuid NOT NULL,
+  "name" text NOT NULL,
+  "lesson_count" integer NOT NULL CHECK ("lesson_count" > 0),
+  "price_rub" integer NOT NULL CHECK ("price_rub" >= 0),
+  "created_at" timestamp NOT NULL DEFAULT now()
+);
+
+CREATE INDEX "lesson_packages_pair_idx" ON "lesson_packages" ("tutor_id", "student_id");
+
+CREATE TABLE IF NOT EXISTS "package_purchases" (
+  "id" uuid PRIMARY KEY,
+  "package_id" uuid NOT NULL REFERENCES "lesson_packages" ("id"),
+  "tutor_id" uuid NOT NULL,
+  "student_id" uuid NOT NULL,
+  "lessons_total" integer NOT NULL CHECK ("lessons_total" > 0),
+  "lessons_used" integer NOT NULL DEFAULT 0 CHECK ("lessons_used" BETWEEN 0 AND "lessons_total"),
+  "price_rub" integer NOT NULL CHECK ("price_rub" >= 0),
+  "created_at" timestamp NOT NULL DEFAULT now()
+);
+
+CREATE INDEX "package_purchases_pair_idx" ON "package_purchases" ("tutor_id", "student_id", "created_at")
+  WHERE "lessons_used" < "lessons_total";
+
+-- every transaction has at least two entries with zero sum
+CREATE TABLE IF NOT EXISTS "ledger_transactions" (
+  "id" uuid PRIMARY KEY,
+  "tutor_id" uuid NOT NULL,
+  "student_id" uuid NOT NULL,
+  "kind" text NOT NULL CHECK ("kind" IN ('receipt_payment', 'package_purchase', 'lesson_charge')),
+  "reference_id" uuid NOT NULL,
+  "package_purchase_id" uuid REFERENCES "package_purchases" ("id"),
+  "created_at" timestamp NOT NULL DEFAULT now(),
+  UNIQUE ("kind", "reference_id")
+);
+
+CREATE INDEX "ledger_transactions_pair_idx" ON "ledger_transactions" ("tutor_id", "student_id", "created_at");
+
+CREATE TABLE IF NOT EXISTS "ledger_entries" (
+  "id" uuid PRIMARY KEY,
+  "transaction_id" uuid NOT NULL REFERENCES "ledger_transactions" ("id") ON DELETE CASCADE,
+  "account" text NOT NULL CHECK ("account" IN ('student', 'tutor', 'external')),
+  "amount_rub" bigint NOT NULL
+);
+
+CREATE INDEX "ledger_entries_transaction_id_idx" ON "ledger_entries" ("transaction_id");
+
+COMMENT ON COLUMN "ledger_transactions"."reference_id" IS 'receipts.id, package_purchases.id or schedule.lessons.id depending on kind';
+
+COMMENT ON COLUMN "ledger_transactions"."package_purchase_id" IS 'Package the lesson was paid from';
+
+COMMENT ON COLUMN "ledger_entries"."amount_rub" IS 'Debit is positive, credit is negative';
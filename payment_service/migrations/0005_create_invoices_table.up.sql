@@ -0,0 +1,41 @@
+CREATE TABLE IF NOT EXISTS "invoice_counters" (
+  "tutor_id" uuid PRIMARY KEY,
+  "last_number" integer NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS "invoices" (
+  "id" uuid PRIMARY KEY,
+  "tutor_id" uuid NOT NULL,
+  "student_id" uuid NOT NULL,
+  "number" integer NOT NULL,
+  "period_start" timestamp NOT NULL,
+  "period_end" timestamp NOT NULL CHECK ("period_end" > "period_start"),
+  "total_rub" bigint NOT NULL,
+  "paid_rub" bigint NOT NULL,
+  "payment_details" text,
+  "file_id" uuid,
+  "created_at" timestamp NOT NULL DEFAULT now(),
+  UNIQUE ("tutor_id", "number")
+);
+
+CREATE INDEX "invoices_student_id_idx" ON "invoices" ("student_id");
+
+CREATE TABLE IF NOT EXISTS "invoice_lines" (
+  "id" uuid PRIMARY KEY,
+  "invoice_id" uuid NOT NULL REFERENCES "invoices" ("id") ON DELETE CASCADE,
+  "lesson_id" uuid NOT NULL,
+  "kind" text NOT NULL CHECK ("kind" IN ('lesson', 'late_cancel')),
+  "starts_at" timestamp NOT NULL,
+  "amount_rub" integer NOT NULL,
+  "is_paid" boolean NOT NULL
+);
+
+CREATE INDEX "invoice_lines_invoice_id_idx" ON "invoice_lines" ("invoice_id");
+
+COMMENT ON COLUMN "invoices"."number" IS 'Sequential number among invoices of the tutor';
+
+COMMENT ON COLUMN "invoices"."payment_details" IS 'Payment info of the tutor when the invoice was created';
+
+COMMENT ON COLUMN "invoices"."file_id" IS 'Refers to file_service.files.id, empty until the PDF is stored';
+
+COMMENT ON COLUMN "invoice_lines"."lesson_id" IS 'Refers to schedule.lessons.id';
@@ -0,0 +1,10 @@
+DELETE FROM "ledger_transactions" WHERE "kind" = 'online_payment';
+
+ALTER TABLE "ledger_transactions" DROP CONSTRAINT "ledger_transactions_kind_check";
+
+ALTER TABLE "ledger_transactions" ADD CONSTRAINT "ledger_transactions_kind_check"
+  CHECK ("kind" IN ('receipt_payment', 'package_purchase', 'lesson_charge'));
+
+COMMENT ON COLUMN "ledger_transactions"."reference_id" IS 'receipts.id, package_purchases.id or schedule.lessons.id depending on kind';
+
+DROP TABLE IF EXISTS "online_payments";
\ No newline at end of file
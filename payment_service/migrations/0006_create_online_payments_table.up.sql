@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS "online_payments" (
+  "id" uuid PRIMARY KEY,
+  "provider" text NOT NULL,
+  "provider_payment_id" text NOT NULL,
+  "lesson_id" uuid NOT NULL,
+  "tutor_id" uuid NOT NULL,
+  "student_id" uuid NOT NULL,
+  "amount_rub" integer NOT NULL CHECK ("amount_rub" > 0),
+  "status" text NOT NULL DEFAULT 'pending' CHECK ("status" IN ('pending', 'succeeded', 'canceled')),
+  "confirmation_url" text,
+  "created_at" timestamp NOT NULL DEFAULT now(),
+  "edited_at" timestamp NOT NULL DEFAULT now(),
+  UNIQUE ("provider", "provider_payment_id")
+);
+
+CREATE INDEX "online_payments_lesson_id_idx" ON "online_payments" ("lesson_id");
+
+ALTER TABLE "ledger_transactions" DROP CONSTRAINT "ledger_transactions_kind_check";
+
+ALTER TABLE "ledger_transactions" ADD CONSTRAINT "ledger_transactions_kind_check"
+  CHECK ("kind" IN ('receipt_payment', 'package_purchase', 'lesson_charge', 'online_payment'));
+
+COMMENT ON COLUMN "online_payments"."confirmation_url" IS 'Page of the provider where the student pays';
+
+COMMENT ON COLUMN "ledger_transactions"."reference_id" IS 'receipts.id, package_purchases.id, schedule.lessons.id or online_payments.id depending on kind';
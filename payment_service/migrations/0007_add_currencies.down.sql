@@ -0,0 +1,30 @@
+ALTER TABLE "online_payments" DROP COLUMN "currency";
+ALTER TABLE "online_payments" ALTER COLUMN "amount_minor" TYPE integer USING "amount_minor" / 100;
+ALTER TABLE "online_payments" RENAME COLUMN "amount_minor" TO "amount_rub";
+
+ALTER TABLE "invoice_lines" ALTER COLUMN "amount_minor" TYPE integer USING "amount_minor" / 100;
+ALTER TABLE "invoice_lines" RENAME COLUMN "amount_minor" TO "amount_rub";
+ALTER TABLE "invoices" DROP COLUMN "currency";
+UPDATE "invoices" SET "total_minor" = "total_minor" / 100, "paid_minor" = "paid_minor" / 100;
+ALTER TABLE "invoices" RENAME COLUMN "paid_minor" TO "paid_rub";
+ALTER TABLE "invoices" RENAME COLUMN "total_minor" TO "total_rub";
+
+UPDATE "ledger_entries" SET "amount_minor" = "amount_minor" / 100;
+ALTER TABLE "ledger_entries" RENAME COLUMN "amount_minor" TO "amount_rub";
+ALTER TABLE "ledger_transactions" DROP COLUMN "currency";
+
+ALTER TABLE "package_purchases" DROP COLUMN "currency";
+ALTER TABLE "package_purchases" ALTER COLUMN "price_minor" TYPE integer USING "price_minor" / 100;
+ALTER TABLE "package_purchases" RENAME COLUMN "price_minor" TO "price_rub";
+
+ALTER TABLE "lesson_packages" DROP COLUMN "currency";
+ALTER TABLE "lesson_packages" ALTER COLUMN "price_minor" TYPE integer USING "price_minor" / 100;
+ALTER TABLE "lesson_packages" RENAME COLUMN "price_minor" TO "price_rub";
+
+ALTER TABLE "receipts" DROP COLUMN "currency";
+ALTER TABLE "receipts" ALTER COLUMN "amount_minor" TYPE integer USING "amount_minor" / 100;
+ALTER TABLE "receipts" RENAME COLUMN "amount_minor" TO "amount_rub";
+
+COMMENT ON COLUMN "receipts"."amount_rub" IS 'Price of the lesson when the receipt was submitted';
+
+COMMENT ON COLUMN "ledger_entries"."amount_rub" IS 'Debit is positive, credit is negative';
\ No newline at end of file
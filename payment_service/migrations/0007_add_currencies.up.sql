@@ -0,0 +1,36 @@
+-- amounts are stored in minor units (kopecks, cents) of the currency in the row
+
+ALTER TABLE "receipts" RENAME COLUMN "amount_rub" TO "amount_minor";
+ALTER TABLE "receipts" ALTER COLUMN "amount_minor" TYPE bigint USING "amount_minor" * 100;
+ALTER TABLE "receipts" ADD COLUMN "currency" char(3);
+UPDATE "receipts" SET "currency" = 'RUB' WHERE "amount_minor" IS NOT NULL;
+
+ALTER TABLE "lesson_packages" RENAME COLUMN "price_rub" TO "price_minor";
+ALTER TABLE "lesson_packages" ALTER COLUMN "price_minor" TYPE bigint USING "price_minor" * 100;
+ALTER TABLE "lesson_packages" ADD COLUMN "currency" char(3) NOT NULL DEFAULT 'RUB';
+
+ALTER TABLE "package_purchases" RENAME COLUMN "price_rub" TO "price_minor";
+ALTER TABLE "package_purchases" ALTER COLUMN "price_minor" TYPE bigint USING "price_minor" * 100;
+ALTER TABLE "package_purchases" ADD COLUMN "currency" char(3) NOT NULL DEFAULT 'RUB';
+
+-- all entries of a transaction are in its currency
+ALTER TABLE "ledger_transactions" ADD COLUMN "currency" char(3) NOT NULL DEFAULT 'RUB';
+ALTER TABLE "ledger_entries" RENAME COLUMN "amount_rub" TO "amount_minor";
+UPDATE "ledger_entries" SET "amount_minor" = "amount_minor" * 100;
+
+ALTER TABLE "invoices" RENAME COLUMN "total_rub" TO "total_minor";
+ALTER TABLE "invoices" RENAME COLUMN "paid_rub" TO "paid_minor";
+UPDATE "invoices" SET "total_minor" = "total_minor" * 100, "paid_minor" = "paid_minor" * 100;
+ALTER TABLE "invoices" ADD COLUMN "currency" char(3) NOT NULL DEFAULT 'RUB';
+ALTER TABLE "invoice_lines" RENAME COLUMN "amount_rub" TO "amount_minor";
+ALTER TABLE "invoice_lines" ALTER COLUMN "amount_minor" TYPE bigint USING "amount_minor" * 100;
+
+ALTER TABLE "online_payments" RENAME COLUMN "amount_rub" TO "amount_minor";
+ALTER TABLE "online_payments" ALTER COLUMN "amount_minor" TYPE bigint USING "amount_minor" * 100;
+ALTER TABLE "online_payments" ADD COLUMN "currency" char(3) NOT NULL DEFAULT 'RUB';
+
+COMMENT ON COLUMN "receipts"."amount_minor" IS 'Price of the lesson when the receipt was submitted';
+
+COMMENT ON COLUMN "ledger_entries"."amount_minor" IS 'Debit is positive, credit is negative';
+
+COMMENT ON COLUMN "invoices"."currency" IS 'Only lessons in this currency are included';
@@ -0,0 +1,26 @@
+DELETE FROM "ledger_transactions" WHERE "kind" IN ('credit_note', 'refund');
+
+ALTER TABLE "ledger_transactions" DROP CONSTRAINT "ledger_transactions_kind_check";
+
+ALTER TABLE "ledger_transactions" ADD CONSTRAINT "ledger_transactions_kind_check"
+  CHECK ("kind" IN ('receipt_payment', 'package_purchase', 'lesson_charge', 'online_payment'));
+
+UPDATE "online_payments" SET "status" = 'succeeded' WHERE "status" = 'refunded';
+
+ALTER TABLE "online_payments" DROP CONSTRAINT "online_payments_status_check";
+
+ALTER TABLE "online_payments" ADD CONSTRAINT "online_payments_status_check"
+  CHECK ("status" IN ('pending', 'succeeded', 'canceled'));
+
+UPDATE "receipts" SET "status" = 'approved' WHERE "status" = 'refunded';
+
+ALTER TABLE "receipts" DROP CONSTRAINT "receipts_status_check";
+
+ALTER TABLE "receipts" ADD CONSTRAINT "receipts_status_check"
+  CHECK ("status" IN ('pending', 'approved', 'rejected'));
+
+COMMENT ON COLUMN "receipts"."status" IS 'pending / approved / rejected';
+
+COMMENT ON COLUMN "ledger_transactions"."reference_id" IS 'receipts.id, package_purchases.id, schedule.lessons.id or online_payments.id depending on kind';
+
+DROP TABLE IF EXISTS "refunds";
\ No newline at end of file
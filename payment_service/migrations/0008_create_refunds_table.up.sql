@@ -0,0 +1,42 @@
+CREATE TABLE IF NOT EXISTS "refunds" (
+  "id" uuid PRIMARY KEY,
+  "lesson_id" uuid NOT NULL UNIQUE,
+  "tutor_id" uuid NOT NULL,
+  "student_id" uuid NOT NULL,
+  "kind" text NOT NULL CHECK ("kind" IN ('credit_note', 'refund')),
+  "amount_minor" bigint NOT NULL CHECK ("amount_minor" >= 0),
+  "currency" char(3) NOT NULL,
+  "reason" text,
+  "receipt_id" uuid REFERENCES "receipts" ("id"),
+  "online_payment_id" uuid REFERENCES "online_payments" ("id"),
+  "package_purchase_id" uuid REFERENCES "package_purchases" ("id"),
+  "provider_refund_id" text,
+  "created_at" timestamp NOT NULL DEFAULT now()
+);
+
+CREATE INDEX "refunds_pair_idx" ON "refunds" ("tutor_id", "student_id", "created_at");
+
+ALTER TABLE "receipts" DROP CONSTRAINT "receipts_status_check";
+
+ALTER TABLE "receipts" ADD CONSTRAINT "receipts_status_check"
+  CHECK ("status" IN ('pending', 'approved', 'rejected', 'refunded'));
+
+ALTER TABLE "online_payments" DROP CONSTRAINT "online_payments_status_check";
+
+ALTER TABLE "online_payments" ADD CONSTRAINT "online_payments_status_check"
+  CHECK ("status" IN ('pending', 'succeeded', 'canceled', 'refunded'));
+
+ALTER TABLE "ledger_transactions" DROP CONSTRAINT "ledger_transactions_kind_check";
+
+ALTER TABLE "ledger_transactions" ADD CONSTRAINT "ledger_transactions_kind_check"
+  CHECK ("kind" IN ('receipt_payment', 'package_purchase', 'lesson_charge', 'online_payment', 'credit_note', 'refund'));
+
+COMMENT ON COLUMN "refunds"."kind" IS 'credit_note leaves the price on the student balance, refund returns it to the student';
+
+COMMENT ON COLUMN "refunds"."receipt_id" IS 'Approved receipt that paid the lesson';
+
+COMMENT ON COLUMN "refunds"."package_purchase_id" IS 'Package the lesson is returned to';
+
+COMMENT ON COLUMN "receipts"."status" IS 'pending / approved / rejected / refunded';
+
+COMMENT ON COLUMN "ledger_transactions"."reference_id" IS 'receipts.id, package_purchases.id, schedule.lessons.id, online_payments.id or refunds.id depending on kind';
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS "dunning_steps";
\ No newline at end of file
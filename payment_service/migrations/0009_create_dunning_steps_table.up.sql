@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS "dunning_steps" (
+  "id" uuid PRIMARY KEY,
+  "lesson_id" uuid NOT NULL,
+  "tutor_id" uuid NOT NULL,
+  "student_id" uuid NOT NULL,
+  "step" integer NOT NULL CHECK ("step" > 0),
+  "recipient" text NOT NULL CHECK ("recipient" IN ('student', 'tutor')),
+  "sent_at" timestamp NOT NULL DEFAULT now(),
+  UNIQUE ("lesson_id", "step")
+);
+
+COMMENT ON COLUMN "dunning_steps"."lesson_id" IS 'Refers to schedule.lessons.id';
+
+COMMENT ON COLUMN "dunning_steps"."step" IS 'Reminders to the student are numbered from 1, the notice to the tutor follows the last one';
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS "online_payments_lesson_id_pending_idx";
\ No newline at end of file
@@ -0,0 +1,11 @@
+-- a lesson has at most one pending online payment, older duplicates are canceled; if the student still pays one, the webhook refunds it
+UPDATE "online_payments" p
+SET "status" = 'canceled', "edited_at" = now()
+FROM (
+  SELECT "id", row_number() OVER (PARTITION BY "lesson_id" ORDER BY "created_at" DESC, "id" DESC) AS "rank"
+  FROM "online_payments"
+  WHERE "status" = 'pending'
+) d
+WHERE p."id" = d."id" AND d."rank" > 1;
+
+CREATE UNIQUE INDEX "online_payments_lesson_id_pending_idx" ON "online_payments" ("lesson_id") WHERE "status" = 'pending';
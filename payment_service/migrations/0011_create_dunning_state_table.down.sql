@@ -0,0 +1 @@
+DROP TABLE IF EXISTS "dunning_state";
\ No newline at end of file
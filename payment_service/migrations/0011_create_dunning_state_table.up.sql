@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS "dunning_state" (
+  "id" boolean PRIMARY KEY DEFAULT true CHECK ("id"),
+  "started_at" timestamp NOT NULL
+);
+
+COMMENT ON COLUMN "dunning_state"."started_at" IS 'First run of reminders, lessons that ended earlier are not reminded about';
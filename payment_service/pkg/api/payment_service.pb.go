@@ -250,30 +250,2363 @@ func (x *GetReceiptFileRequest) GetReceiptId() string {
 	return ""
 }
 
+type ApproveReceiptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReceiptId     string                 `protobuf:"bytes,1,opt,name=receipt_id,json=receiptId,proto3" json:"receipt_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveReceiptRequest) Reset() {
+	*x = ApproveReceiptRequest{}
+	mi := &file_payment_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveReceiptRequest) ProtoMessage() {}
+
+func (x *ApproveReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveReceiptRequest.ProtoReflect.Descriptor instead.
+func (*ApproveReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ApproveReceiptRequest) GetReceiptId() string {
+	if x != nil {
+		return x.ReceiptId
+	}
+	return ""
+}
+
+type RejectReceiptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReceiptId     string                 `protobuf:"bytes,1,opt,name=receipt_id,json=receiptId,proto3" json:"receipt_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // показывается ученику
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectReceiptRequest) Reset() {
+	*x = RejectReceiptRequest{}
+	mi := &file_payment_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectReceiptRequest) ProtoMessage() {}
+
+func (x *RejectReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectReceiptRequest.ProtoReflect.Descriptor instead.
+func (*RejectReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RejectReceiptRequest) GetReceiptId() string {
+	if x != nil {
+		return x.ReceiptId
+	}
+	return ""
+}
+
+func (x *RejectReceiptRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ListReceiptsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TutorId       *string                `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3,oneof" json:"tutor_id,omitempty"`
+	StudentId     *string                `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3,oneof" json:"student_id,omitempty"`
+	Status        *string                `protobuf:"bytes,3,opt,name=status,proto3,oneof" json:"status,omitempty"` // pending / approved / rejected / refunded
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReceiptsRequest) Reset() {
+	*x = ListReceiptsRequest{}
+	mi := &file_payment_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReceiptsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReceiptsRequest) ProtoMessage() {}
+
+func (x *ListReceiptsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReceiptsRequest.ProtoReflect.Descriptor instead.
+func (*ListReceiptsRequest) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListReceiptsRequest) GetTutorId() string {
+	if x != nil && x.TutorId != nil {
+		return *x.TutorId
+	}
+	return ""
+}
+
+func (x *ListReceiptsRequest) GetStudentId() string {
+	if x != nil && x.StudentId != nil {
+		return *x.StudentId
+	}
+	return ""
+}
+
+func (x *ListReceiptsRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+type GetBalanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TutorId       string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBalanceRequest) Reset() {
+	*x = GetBalanceRequest{}
+	mi := &file_payment_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceRequest) ProtoMessage() {}
+
+func (x *GetBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceRequest.ProtoReflect.Descriptor instead.
+func (*GetBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetBalanceRequest) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+func (x *GetBalanceRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+type ListLedgerEntriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TutorId       string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Limit         *int32                 `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"` // по умолчанию 50, не больше 200
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLedgerEntriesRequest) Reset() {
+	*x = ListLedgerEntriesRequest{}
+	mi := &file_payment_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLedgerEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLedgerEntriesRequest) ProtoMessage() {}
+
+func (x *ListLedgerEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLedgerEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListLedgerEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListLedgerEntriesRequest) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+func (x *ListLedgerEntriesRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *ListLedgerEntriesRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type CreatePackageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	LessonCount   int32                  `protobuf:"varint,3,opt,name=lesson_count,json=lessonCount,proto3" json:"lesson_count,omitempty"`
+	Price         *Money                 `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePackageRequest) Reset() {
+	*x = CreatePackageRequest{}
+	mi := &file_payment_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePackageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePackageRequest) ProtoMessage() {}
+
+func (x *CreatePackageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePackageRequest.ProtoReflect.Descriptor instead.
+func (*CreatePackageRequest) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreatePackageRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *CreatePackageRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreatePackageRequest) GetLessonCount() int32 {
+	if x != nil {
+		return x.LessonCount
+	}
+	return 0
+}
+
+func (x *CreatePackageRequest) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+type ListPackagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TutorId       string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPackagesRequest) Reset() {
+	*x = ListPackagesRequest{}
+	mi := &file_payment_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPackagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPackagesRequest) ProtoMessage() {}
+
+func (x *ListPackagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPackagesRequest.ProtoReflect.Descriptor instead.
+func (*ListPackagesRequest) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListPackagesRequest) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+func (x *ListPackagesRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+type PurchasePackageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PackageId     string                 `protobuf:"bytes,1,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurchasePackageRequest) Reset() {
+	*x = PurchasePackageRequest{}
+	mi := &file_payment_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchasePackageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchasePackageRequest) ProtoMessage() {}
+
+func (x *PurchasePackageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchasePackageRequest.ProtoReflect.Descriptor instead.
+func (*PurchasePackageRequest) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PurchasePackageRequest) GetPackageId() string {
+	if x != nil {
+		return x.PackageId
+	}
+	return ""
+}
+
+type CreateInvoiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	PeriodStart   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"` // включительно
+	PeriodEnd     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`       // не включительно
+	Currency      *string                `protobuf:"bytes,4,opt,name=currency,proto3,oneof" json:"currency,omitempty"`                    // по умолчанию валюта профиля репетитора
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInvoiceRequest) Reset() {
+	*x = CreateInvoiceRequest{}
+	mi := &file_payment_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInvoiceRequest) ProtoMessage() {}
+
+func (x *CreateInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*CreateInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreateInvoiceRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *CreateInvoiceRequest) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *CreateInvoiceRequest) GetPeriodEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return nil
+}
+
+func (x *CreateInvoiceRequest) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
+type ListInvoicesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TutorId       *string                `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3,oneof" json:"tutor_id,omitempty"`
+	StudentId     *string                `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3,oneof" json:"student_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvoicesRequest) Reset() {
+	*x = ListInvoicesRequest{}
+	mi := &file_payment_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvoicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvoicesRequest) ProtoMessage() {}
+
+func (x *ListInvoicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvoicesRequest.ProtoReflect.Descriptor instead.
+func (*ListInvoicesRequest) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListInvoicesRequest) GetTutorId() string {
+	if x != nil && x.TutorId != nil {
+		return *x.TutorId
+	}
+	return ""
+}
+
+func (x *ListInvoicesRequest) GetStudentId() string {
+	if x != nil && x.StudentId != nil {
+		return *x.StudentId
+	}
+	return ""
+}
+
+type GetInvoiceFileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvoiceId     string                 `protobuf:"bytes,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvoiceFileRequest) Reset() {
+	*x = GetInvoiceFileRequest{}
+	mi := &file_payment_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvoiceFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvoiceFileRequest) ProtoMessage() {}
+
+func (x *GetInvoiceFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvoiceFileRequest.ProtoReflect.Descriptor instead.
+func (*GetInvoiceFileRequest) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetInvoiceFileRequest) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+type GetEarningsReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PeriodStart   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"` // включительно
+	PeriodEnd     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`       // не включительно, не больше года от начала
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEarningsReportRequest) Reset() {
+	*x = GetEarningsReportRequest{}
+	mi := &file_payment_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEarningsReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEarningsReportRequest) ProtoMessage() {}
+
+func (x *GetEarningsReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEarningsReportRequest.ProtoReflect.Descriptor instead.
+func (*GetEarningsReportRequest) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetEarningsReportRequest) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *GetEarningsReportRequest) GetPeriodEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return nil
+}
+
+type GetOutstandingReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOutstandingReportRequest) Reset() {
+	*x = GetOutstandingReportRequest{}
+	mi := &file_payment_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOutstandingReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOutstandingReportRequest) ProtoMessage() {}
+
+func (x *GetOutstandingReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOutstandingReportRequest.ProtoReflect.Descriptor instead.
+func (*GetOutstandingReportRequest) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{17}
+}
+
+type CreateOnlinePaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LessonId      string                 `protobuf:"bytes,1,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`
+	ReturnUrl     string                 `protobuf:"bytes,2,opt,name=return_url,json=returnUrl,proto3" json:"return_url,omitempty"` // куда провайдер вернет ученика после оплаты
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOnlinePaymentRequest) Reset() {
+	*x = CreateOnlinePaymentRequest{}
+	mi := &file_payment_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOnlinePaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOnlinePaymentRequest) ProtoMessage() {}
+
+func (x *CreateOnlinePaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOnlinePaymentRequest.ProtoReflect.Descriptor instead.
+func (*CreateOnlinePaymentRequest) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateOnlinePaymentRequest) GetLessonId() string {
+	if x != nil {
+		return x.LessonId
+	}
+	return ""
+}
+
+func (x *CreateOnlinePaymentRequest) GetReturnUrl() string {
+	if x != nil {
+		return x.ReturnUrl
+	}
+	return ""
+}
+
+type ProviderWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`                                                                         // имя провайдера из адреса вебхука
+	Body          []byte                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`                                                                                 // тело уведомления без изменений, по нему проверяется подпись
+	Headers       map[string]string      `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // имена в нижнем регистре
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProviderWebhookRequest) Reset() {
+	*x = ProviderWebhookRequest{}
+	mi := &file_payment_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderWebhookRequest) ProtoMessage() {}
+
+func (x *ProviderWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderWebhookRequest.ProtoReflect.Descriptor instead.
+func (*ProviderWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ProviderWebhookRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ProviderWebhookRequest) GetBody() []byte {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *ProviderWebhookRequest) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+type RefundLessonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LessonId      string                 `protobuf:"bytes,1,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // credit_note - цена остается на балансе ученика, refund - возвращается ученику
+	Reason        *string                `protobuf:"bytes,3,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundLessonRequest) Reset() {
+	*x = RefundLessonRequest{}
+	mi := &file_payment_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundLessonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundLessonRequest) ProtoMessage() {}
+
+func (x *RefundLessonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundLessonRequest.ProtoReflect.Descriptor instead.
+func (*RefundLessonRequest) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RefundLessonRequest) GetLessonId() string {
+	if x != nil {
+		return x.LessonId
+	}
+	return ""
+}
+
+func (x *RefundLessonRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *RefundLessonRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+type ListRefundsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TutorId       *string                `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3,oneof" json:"tutor_id,omitempty"`
+	StudentId     *string                `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3,oneof" json:"student_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRefundsRequest) Reset() {
+	*x = ListRefundsRequest{}
+	mi := &file_payment_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRefundsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRefundsRequest) ProtoMessage() {}
+
+func (x *ListRefundsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRefundsRequest.ProtoReflect.Descriptor instead.
+func (*ListRefundsRequest) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListRefundsRequest) GetTutorId() string {
+	if x != nil && x.TutorId != nil {
+		return *x.TutorId
+	}
+	return ""
+}
+
+func (x *ListRefundsRequest) GetStudentId() string {
+	if x != nil && x.StudentId != nil {
+		return *x.StudentId
+	}
+	return ""
+}
+
+// Money is an amount in minor units of an ISO 4217 currency, e.g. 150000 RUB is 1500 rubles
+type Money struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AmountMinor   int64                  `protobuf:"varint,1,opt,name=amount_minor,json=amountMinor,proto3" json:"amount_minor,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_payment_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Money) GetAmountMinor() int64 {
+	if x != nil {
+		return x.AmountMinor
+	}
+	return 0
+}
+
+func (x *Money) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 type PaymentInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	LessonId      *string                `protobuf:"bytes,1,opt,name=lesson_id,json=lessonId,proto3,oneof" json:"lesson_id,omitempty"`
-	PriceRub      *int32                 `protobuf:"varint,2,opt,name=price_rub,json=priceRub,proto3,oneof" json:"price_rub,omitempty"`
-	PaymentInfo   *string                `protobuf:"bytes,3,opt,name=payment_info,json=paymentInfo,proto3,oneof" json:"payment_info,omitempty"`
+	LessonId      *string                `protobuf:"bytes,1,opt,name=lesson_id,json=lessonId,proto3,oneof" json:"lesson_id,omitempty"`
+	PaymentInfo   *string                `protobuf:"bytes,3,opt,name=payment_info,json=paymentInfo,proto3,oneof" json:"payment_info,omitempty"`
+	Price         *Money                 `protobuf:"bytes,4,opt,name=price,proto3,oneof" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentInfo) Reset() {
+	*x = PaymentInfo{}
+	mi := &file_payment_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentInfo) ProtoMessage() {}
+
+func (x *PaymentInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentInfo.ProtoReflect.Descriptor instead.
+func (*PaymentInfo) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PaymentInfo) GetLessonId() string {
+	if x != nil && x.LessonId != nil {
+		return *x.LessonId
+	}
+	return ""
+}
+
+func (x *PaymentInfo) GetPaymentInfo() string {
+	if x != nil && x.PaymentInfo != nil {
+		return *x.PaymentInfo
+	}
+	return ""
+}
+
+func (x *PaymentInfo) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+type Receipt struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                    // UUIDv7
+	LessonId        *string                `protobuf:"bytes,2,opt,name=lesson_id,json=lessonId,proto3,oneof" json:"lesson_id,omitempty"`  // Refers to schedule.lessons.id
+	FileId          *string                `protobuf:"bytes,3,opt,name=file_id,json=fileId,proto3,oneof" json:"file_id,omitempty"`        // Refers to file_service.files.id
+	IsVerified      bool                   `protobuf:"varint,4,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"` // true if status is approved
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	EditedAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+	Status          string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"` // pending / approved / rejected / refunded
+	RejectionReason *string                `protobuf:"bytes,8,opt,name=rejection_reason,json=rejectionReason,proto3,oneof" json:"rejection_reason,omitempty"`
+	TutorId         *string                `protobuf:"bytes,9,opt,name=tutor_id,json=tutorId,proto3,oneof" json:"tutor_id,omitempty"`
+	StudentId       *string                `protobuf:"bytes,10,opt,name=student_id,json=studentId,proto3,oneof" json:"student_id,omitempty"`
+	Amount          *Money                 `protobuf:"bytes,12,opt,name=amount,proto3,oneof" json:"amount,omitempty"` // цена занятия на момент отправки чека
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Receipt) Reset() {
+	*x = Receipt{}
+	mi := &file_payment_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Receipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Receipt) ProtoMessage() {}
+
+func (x *Receipt) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Receipt.ProtoReflect.Descriptor instead.
+func (*Receipt) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Receipt) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Receipt) GetLessonId() string {
+	if x != nil && x.LessonId != nil {
+		return *x.LessonId
+	}
+	return ""
+}
+
+func (x *Receipt) GetFileId() string {
+	if x != nil && x.FileId != nil {
+		return *x.FileId
+	}
+	return ""
+}
+
+func (x *Receipt) GetIsVerified() bool {
+	if x != nil {
+		return x.IsVerified
+	}
+	return false
+}
+
+func (x *Receipt) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Receipt) GetEditedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EditedAt
+	}
+	return nil
+}
+
+func (x *Receipt) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Receipt) GetRejectionReason() string {
+	if x != nil && x.RejectionReason != nil {
+		return *x.RejectionReason
+	}
+	return ""
+}
+
+func (x *Receipt) GetTutorId() string {
+	if x != nil && x.TutorId != nil {
+		return *x.TutorId
+	}
+	return ""
+}
+
+func (x *Receipt) GetStudentId() string {
+	if x != nil && x.StudentId != nil {
+		return *x.StudentId
+	}
+	return ""
+}
+
+func (x *Receipt) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type ListReceiptsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Receipts      []*Receipt             `protobuf:"bytes,1,rep,name=receipts,proto3" json:"receipts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReceiptsResponse) Reset() {
+	*x = ListReceiptsResponse{}
+	mi := &file_payment_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReceiptsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReceiptsResponse) ProtoMessage() {}
+
+func (x *ListReceiptsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReceiptsResponse.ProtoReflect.Descriptor instead.
+func (*ListReceiptsResponse) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListReceiptsResponse) GetReceipts() []*Receipt {
+	if x != nil {
+		return x.Receipts
+	}
+	return nil
+}
+
+type ReceiptFileURL struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           *string                `protobuf:"bytes,1,opt,name=url,proto3,oneof" json:"url,omitempty"` // временная ссылка на файл из file-service
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiptFileURL) Reset() {
+	*x = ReceiptFileURL{}
+	mi := &file_payment_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiptFileURL) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptFileURL) ProtoMessage() {}
+
+func (x *ReceiptFileURL) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptFileURL.ProtoReflect.Descriptor instead.
+func (*ReceiptFileURL) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ReceiptFileURL) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
+	}
+	return ""
+}
+
+type Balance struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	TutorId            string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	StudentId          string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	PackageLessonsLeft int32                  `protobuf:"varint,4,opt,name=package_lessons_left,json=packageLessonsLeft,proto3" json:"package_lessons_left,omitempty"` // неиспользованные занятия из пакетов
+	Balances           []*Money               `protobuf:"bytes,5,rep,name=balances,proto3" json:"balances,omitempty"`                                                  // по валютам, отрицательный баланс - долг ученика
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Balance) Reset() {
+	*x = Balance{}
+	mi := &file_payment_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Balance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Balance) ProtoMessage() {}
+
+func (x *Balance) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Balance.ProtoReflect.Descriptor instead.
+func (*Balance) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Balance) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+func (x *Balance) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *Balance) GetPackageLessonsLeft() int32 {
+	if x != nil {
+		return x.PackageLessonsLeft
+	}
+	return 0
+}
+
+func (x *Balance) GetBalances() []*Money {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+type LedgerEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TransactionId string                 `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`                                  // receipt_payment / package_purchase / lesson_charge / online_payment / credit_note / refund
+	ReferenceId   string                 `protobuf:"bytes,4,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"` // id чека, покупки пакета, занятия, онлайн-платежа или возврата
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"` // изменение баланса ученика
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LedgerEntry) Reset() {
+	*x = LedgerEntry{}
+	mi := &file_payment_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LedgerEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerEntry) ProtoMessage() {}
+
+func (x *LedgerEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerEntry.ProtoReflect.Descriptor instead.
+func (*LedgerEntry) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *LedgerEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *LedgerEntry) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type ListLedgerEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*LedgerEntry         `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLedgerEntriesResponse) Reset() {
+	*x = ListLedgerEntriesResponse{}
+	mi := &file_payment_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLedgerEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLedgerEntriesResponse) ProtoMessage() {}
+
+func (x *ListLedgerEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLedgerEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListLedgerEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListLedgerEntriesResponse) GetEntries() []*LedgerEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type LessonPackage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TutorId       string                 `protobuf:"bytes,2,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	StudentId     string                 `protobuf:"bytes,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	LessonCount   int32                  `protobuf:"varint,5,opt,name=lesson_count,json=lessonCount,proto3" json:"lesson_count,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Price         *Money                 `protobuf:"bytes,8,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LessonPackage) Reset() {
+	*x = LessonPackage{}
+	mi := &file_payment_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LessonPackage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LessonPackage) ProtoMessage() {}
+
+func (x *LessonPackage) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LessonPackage.ProtoReflect.Descriptor instead.
+func (*LessonPackage) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *LessonPackage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LessonPackage) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+func (x *LessonPackage) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *LessonPackage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LessonPackage) GetLessonCount() int32 {
+	if x != nil {
+		return x.LessonCount
+	}
+	return 0
+}
+
+func (x *LessonPackage) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *LessonPackage) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+type ListPackagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Packages      []*LessonPackage       `protobuf:"bytes,1,rep,name=packages,proto3" json:"packages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPackagesResponse) Reset() {
+	*x = ListPackagesResponse{}
+	mi := &file_payment_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPackagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPackagesResponse) ProtoMessage() {}
+
+func (x *ListPackagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPackagesResponse.ProtoReflect.Descriptor instead.
+func (*ListPackagesResponse) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListPackagesResponse) GetPackages() []*LessonPackage {
+	if x != nil {
+		return x.Packages
+	}
+	return nil
+}
+
+type PackagePurchase struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PackageId     string                 `protobuf:"bytes,2,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	TutorId       string                 `protobuf:"bytes,3,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	StudentId     string                 `protobuf:"bytes,4,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	LessonsTotal  int32                  `protobuf:"varint,5,opt,name=lessons_total,json=lessonsTotal,proto3" json:"lessons_total,omitempty"`
+	LessonsUsed   int32                  `protobuf:"varint,6,opt,name=lessons_used,json=lessonsUsed,proto3" json:"lessons_used,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Price         *Money                 `protobuf:"bytes,9,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PackagePurchase) Reset() {
+	*x = PackagePurchase{}
+	mi := &file_payment_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PackagePurchase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackagePurchase) ProtoMessage() {}
+
+func (x *PackagePurchase) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackagePurchase.ProtoReflect.Descriptor instead.
+func (*PackagePurchase) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PackagePurchase) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PackagePurchase) GetPackageId() string {
+	if x != nil {
+		return x.PackageId
+	}
+	return ""
+}
+
+func (x *PackagePurchase) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+func (x *PackagePurchase) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *PackagePurchase) GetLessonsTotal() int32 {
+	if x != nil {
+		return x.LessonsTotal
+	}
+	return 0
+}
+
+func (x *PackagePurchase) GetLessonsUsed() int32 {
+	if x != nil {
+		return x.LessonsUsed
+	}
+	return 0
+}
+
+func (x *PackagePurchase) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PackagePurchase) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+type Invoice struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TutorId       string                 `protobuf:"bytes,2,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	StudentId     string                 `protobuf:"bytes,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Number        int32                  `protobuf:"varint,4,opt,name=number,proto3" json:"number,omitempty"` // порядковый номер счёта у репетитора
+	PeriodStart   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	PeriodEnd     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	FileId        *string                `protobuf:"bytes,9,opt,name=file_id,json=fileId,proto3,oneof" json:"file_id,omitempty"` // PDF в file_service
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Total         *Money                 `protobuf:"bytes,11,opt,name=total,proto3" json:"total,omitempty"` // занятия и поздние отмены за период в валюте счёта
+	Paid          *Money                 `protobuf:"bytes,12,opt,name=paid,proto3" json:"paid,omitempty"`   // из них уже оплачено
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Invoice) Reset() {
+	*x = Invoice{}
+	mi := &file_payment_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invoice) ProtoMessage() {}
+
+func (x *Invoice) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invoice.ProtoReflect.Descriptor instead.
+func (*Invoice) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Invoice) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Invoice) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+func (x *Invoice) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *Invoice) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *Invoice) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *Invoice) GetPeriodEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return nil
+}
+
+func (x *Invoice) GetFileId() string {
+	if x != nil && x.FileId != nil {
+		return *x.FileId
+	}
+	return ""
+}
+
+func (x *Invoice) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Invoice) GetTotal() *Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *Invoice) GetPaid() *Money {
+	if x != nil {
+		return x.Paid
+	}
+	return nil
+}
+
+type ListInvoicesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invoices      []*Invoice             `protobuf:"bytes,1,rep,name=invoices,proto3" json:"invoices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvoicesResponse) Reset() {
+	*x = ListInvoicesResponse{}
+	mi := &file_payment_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvoicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvoicesResponse) ProtoMessage() {}
+
+func (x *ListInvoicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvoicesResponse.ProtoReflect.Descriptor instead.
+func (*ListInvoicesResponse) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListInvoicesResponse) GetInvoices() []*Invoice {
+	if x != nil {
+		return x.Invoices
+	}
+	return nil
+}
+
+type InvoiceFileURL struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"` // временная ссылка на PDF из file-service
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvoiceFileURL) Reset() {
+	*x = InvoiceFileURL{}
+	mi := &file_payment_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvoiceFileURL) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoiceFileURL) ProtoMessage() {}
+
+func (x *InvoiceFileURL) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoiceFileURL.ProtoReflect.Descriptor instead.
+func (*InvoiceFileURL) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *InvoiceFileURL) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type CurrencyEarnings struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Currency         string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	LessonsCount     int32                  `protobuf:"varint,2,opt,name=lessons_count,json=lessonsCount,proto3" json:"lessons_count,omitempty"` // занятия с ценой в этой валюте
+	EarnedMinor      int64                  `protobuf:"varint,3,opt,name=earned_minor,json=earnedMinor,proto3" json:"earned_minor,omitempty"`
+	PaidMinor        int64                  `protobuf:"varint,4,opt,name=paid_minor,json=paidMinor,proto3" json:"paid_minor,omitempty"`
+	OutstandingMinor int64                  `protobuf:"varint,5,opt,name=outstanding_minor,json=outstandingMinor,proto3" json:"outstanding_minor,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CurrencyEarnings) Reset() {
+	*x = CurrencyEarnings{}
+	mi := &file_payment_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyEarnings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyEarnings) ProtoMessage() {}
+
+func (x *CurrencyEarnings) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyEarnings.ProtoReflect.Descriptor instead.
+func (*CurrencyEarnings) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CurrencyEarnings) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CurrencyEarnings) GetLessonsCount() int32 {
+	if x != nil {
+		return x.LessonsCount
+	}
+	return 0
+}
+
+func (x *CurrencyEarnings) GetEarnedMinor() int64 {
+	if x != nil {
+		return x.EarnedMinor
+	}
+	return 0
+}
+
+func (x *CurrencyEarnings) GetPaidMinor() int64 {
+	if x != nil {
+		return x.PaidMinor
+	}
+	return 0
+}
+
+func (x *CurrencyEarnings) GetOutstandingMinor() int64 {
+	if x != nil {
+		return x.OutstandingMinor
+	}
+	return 0
+}
+
+type EarningsSummary struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	LessonsCount         int32                  `protobuf:"varint,1,opt,name=lessons_count,json=lessonsCount,proto3" json:"lessons_count,omitempty"`                           // завершенные занятия
+	UnpricedLessonsCount int32                  `protobuf:"varint,2,opt,name=unpriced_lessons_count,json=unpricedLessonsCount,proto3" json:"unpriced_lessons_count,omitempty"` // из них без цены, в суммы не входят
+	AvgDaysToPay         *float64               `protobuf:"fixed64,6,opt,name=avg_days_to_pay,json=avgDaysToPay,proto3,oneof" json:"avg_days_to_pay,omitempty"`                // от конца занятия до подтверждения чека
+	ByCurrency           []*CurrencyEarnings    `protobuf:"bytes,7,rep,name=by_currency,json=byCurrency,proto3" json:"by_currency,omitempty"`                                  // по коду валюты
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *EarningsSummary) Reset() {
+	*x = EarningsSummary{}
+	mi := &file_payment_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EarningsSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EarningsSummary) ProtoMessage() {}
+
+func (x *EarningsSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EarningsSummary.ProtoReflect.Descriptor instead.
+func (*EarningsSummary) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *EarningsSummary) GetLessonsCount() int32 {
+	if x != nil {
+		return x.LessonsCount
+	}
+	return 0
+}
+
+func (x *EarningsSummary) GetUnpricedLessonsCount() int32 {
+	if x != nil {
+		return x.UnpricedLessonsCount
+	}
+	return 0
+}
+
+func (x *EarningsSummary) GetAvgDaysToPay() float64 {
+	if x != nil && x.AvgDaysToPay != nil {
+		return *x.AvgDaysToPay
+	}
+	return 0
+}
+
+func (x *EarningsSummary) GetByCurrency() []*CurrencyEarnings {
+	if x != nil {
+		return x.ByCurrency
+	}
+	return nil
+}
+
+type MonthlyEarnings struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MonthStart    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=month_start,json=monthStart,proto3" json:"month_start,omitempty"` // начало месяца по Москве
+	Summary       *EarningsSummary       `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MonthlyEarnings) Reset() {
+	*x = MonthlyEarnings{}
+	mi := &file_payment_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonthlyEarnings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonthlyEarnings) ProtoMessage() {}
+
+func (x *MonthlyEarnings) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonthlyEarnings.ProtoReflect.Descriptor instead.
+func (*MonthlyEarnings) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *MonthlyEarnings) GetMonthStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MonthStart
+	}
+	return nil
+}
+
+func (x *MonthlyEarnings) GetSummary() *EarningsSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+type StudentEarnings struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	StudentName   string                 `protobuf:"bytes,2,opt,name=student_name,json=studentName,proto3" json:"student_name,omitempty"`
+	Summary       *EarningsSummary       `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StudentEarnings) Reset() {
+	*x = StudentEarnings{}
+	mi := &file_payment_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentEarnings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentEarnings) ProtoMessage() {}
+
+func (x *StudentEarnings) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentEarnings.ProtoReflect.Descriptor instead.
+func (*StudentEarnings) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *StudentEarnings) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *StudentEarnings) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
+}
+
+func (x *StudentEarnings) GetSummary() *EarningsSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+type EarningsReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PeriodStart   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	PeriodEnd     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	Total         *EarningsSummary       `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
+	Months        []*MonthlyEarnings     `protobuf:"bytes,4,rep,name=months,proto3" json:"months,omitempty"`
+	Students      []*StudentEarnings     `protobuf:"bytes,5,rep,name=students,proto3" json:"students,omitempty"` // по убыванию числа занятий
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EarningsReport) Reset() {
+	*x = EarningsReport{}
+	mi := &file_payment_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EarningsReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EarningsReport) ProtoMessage() {}
+
+func (x *EarningsReport) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EarningsReport.ProtoReflect.Descriptor instead.
+func (*EarningsReport) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *EarningsReport) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *EarningsReport) GetPeriodEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return nil
+}
+
+func (x *EarningsReport) GetTotal() *EarningsSummary {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *EarningsReport) GetMonths() []*MonthlyEarnings {
+	if x != nil {
+		return x.Months
+	}
+	return nil
+}
+
+func (x *EarningsReport) GetStudents() []*StudentEarnings {
+	if x != nil {
+		return x.Students
+	}
+	return nil
+}
+
+type StudentOutstanding struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	StudentId      string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	StudentName    string                 `protobuf:"bytes,2,opt,name=student_name,json=studentName,proto3" json:"student_name,omitempty"`
+	UnpaidLessons  int32                  `protobuf:"varint,3,opt,name=unpaid_lessons,json=unpaidLessons,proto3" json:"unpaid_lessons,omitempty"`
+	OldestUnpaidAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=oldest_unpaid_at,json=oldestUnpaidAt,proto3" json:"oldest_unpaid_at,omitempty"`
+	Outstanding    []*Money               `protobuf:"bytes,6,rep,name=outstanding,proto3" json:"outstanding,omitempty"` // по валютам
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StudentOutstanding) Reset() {
+	*x = StudentOutstanding{}
+	mi := &file_payment_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentOutstanding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentOutstanding) ProtoMessage() {}
+
+func (x *StudentOutstanding) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentOutstanding.ProtoReflect.Descriptor instead.
+func (*StudentOutstanding) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *StudentOutstanding) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *StudentOutstanding) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
+}
+
+func (x *StudentOutstanding) GetUnpaidLessons() int32 {
+	if x != nil {
+		return x.UnpaidLessons
+	}
+	return 0
+}
+
+func (x *StudentOutstanding) GetOldestUnpaidAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OldestUnpaidAt
+	}
+	return nil
+}
+
+func (x *StudentOutstanding) GetOutstanding() []*Money {
+	if x != nil {
+		return x.Outstanding
+	}
+	return nil
+}
+
+type OutstandingReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Students      []*StudentOutstanding  `protobuf:"bytes,2,rep,name=students,proto3" json:"students,omitempty"`       // по убыванию числа неоплаченных занятий
+	Outstanding   []*Money               `protobuf:"bytes,3,rep,name=outstanding,proto3" json:"outstanding,omitempty"` // по валютам
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PaymentInfo) Reset() {
-	*x = PaymentInfo{}
-	mi := &file_payment_service_proto_msgTypes[5]
+func (x *OutstandingReport) Reset() {
+	*x = OutstandingReport{}
+	mi := &file_payment_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OutstandingReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutstandingReport) ProtoMessage() {}
+
+func (x *OutstandingReport) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutstandingReport.ProtoReflect.Descriptor instead.
+func (*OutstandingReport) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *OutstandingReport) GetStudents() []*StudentOutstanding {
+	if x != nil {
+		return x.Students
+	}
+	return nil
+}
+
+func (x *OutstandingReport) GetOutstanding() []*Money {
+	if x != nil {
+		return x.Outstanding
+	}
+	return nil
+}
+
+type OnlinePayment struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	LessonId        string                 `protobuf:"bytes,2,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`
+	Provider        string                 `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	Status          string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`                                                // pending / succeeded / canceled / refunded
+	ConfirmationUrl *string                `protobuf:"bytes,6,opt,name=confirmation_url,json=confirmationUrl,proto3,oneof" json:"confirmation_url,omitempty"` // страница оплаты провайдера
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount          *Money                 `protobuf:"bytes,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *OnlinePayment) Reset() {
+	*x = OnlinePayment{}
+	mi := &file_payment_service_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PaymentInfo) String() string {
+func (x *OnlinePayment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaymentInfo) ProtoMessage() {}
+func (*OnlinePayment) ProtoMessage() {}
 
-func (x *PaymentInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_service_proto_msgTypes[5]
+func (x *OnlinePayment) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -284,59 +2617,81 @@ func (x *PaymentInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaymentInfo.ProtoReflect.Descriptor instead.
-func (*PaymentInfo) Descriptor() ([]byte, []int) {
-	return file_payment_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use OnlinePayment.ProtoReflect.Descriptor instead.
+func (*OnlinePayment) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *PaymentInfo) GetLessonId() string {
-	if x != nil && x.LessonId != nil {
-		return *x.LessonId
+func (x *OnlinePayment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OnlinePayment) GetLessonId() string {
+	if x != nil {
+		return x.LessonId
 	}
 	return ""
 }
 
-func (x *PaymentInfo) GetPriceRub() int32 {
-	if x != nil && x.PriceRub != nil {
-		return *x.PriceRub
+func (x *OnlinePayment) GetProvider() string {
+	if x != nil {
+		return x.Provider
 	}
-	return 0
+	return ""
 }
 
-func (x *PaymentInfo) GetPaymentInfo() string {
-	if x != nil && x.PaymentInfo != nil {
-		return *x.PaymentInfo
+func (x *OnlinePayment) GetStatus() string {
+	if x != nil {
+		return x.Status
 	}
 	return ""
 }
 
-type Receipt struct {
+func (x *OnlinePayment) GetConfirmationUrl() string {
+	if x != nil && x.ConfirmationUrl != nil {
+		return *x.ConfirmationUrl
+	}
+	return ""
+}
+
+func (x *OnlinePayment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *OnlinePayment) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type ProviderWebhookResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                   // UUIDv7
-	LessonId      *string                `protobuf:"bytes,2,opt,name=lesson_id,json=lessonId,proto3,oneof" json:"lesson_id,omitempty"` // Refers to schedule.lessons.id
-	FileId        *string                `protobuf:"bytes,3,opt,name=file_id,json=fileId,proto3,oneof" json:"file_id,omitempty"`       // Refers to file_service.files.id
-	IsVerified    bool                   `protobuf:"varint,4,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	EditedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Receipt) Reset() {
-	*x = Receipt{}
-	mi := &file_payment_service_proto_msgTypes[6]
+func (x *ProviderWebhookResponse) Reset() {
+	*x = ProviderWebhookResponse{}
+	mi := &file_payment_service_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Receipt) String() string {
+func (x *ProviderWebhookResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Receipt) ProtoMessage() {}
+func (*ProviderWebhookResponse) ProtoMessage() {}
 
-func (x *Receipt) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_service_proto_msgTypes[6]
+func (x *ProviderWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -347,75 +2702,209 @@ func (x *Receipt) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Receipt.ProtoReflect.Descriptor instead.
-func (*Receipt) Descriptor() ([]byte, []int) {
-	return file_payment_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ProviderWebhookResponse.ProtoReflect.Descriptor instead.
+func (*ProviderWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *Receipt) GetId() string {
+type Refund struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	LessonId          string                 `protobuf:"bytes,2,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`
+	TutorId           string                 `protobuf:"bytes,3,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	StudentId         string                 `protobuf:"bytes,4,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Kind              string                 `protobuf:"bytes,5,opt,name=kind,proto3" json:"kind,omitempty"` // credit_note / refund
+	Amount            *Money                 `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason            *string                `protobuf:"bytes,7,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	ReceiptId         *string                `protobuf:"bytes,8,opt,name=receipt_id,json=receiptId,proto3,oneof" json:"receipt_id,omitempty"` // чек, которым было оплачено занятие
+	OnlinePaymentId   *string                `protobuf:"bytes,9,opt,name=online_payment_id,json=onlinePaymentId,proto3,oneof" json:"online_payment_id,omitempty"`
+	PackagePurchaseId *string                `protobuf:"bytes,10,opt,name=package_purchase_id,json=packagePurchaseId,proto3,oneof" json:"package_purchase_id,omitempty"` // пакет, в который вернулось занятие
+	ProviderRefundId  *string                `protobuf:"bytes,11,opt,name=provider_refund_id,json=providerRefundId,proto3,oneof" json:"provider_refund_id,omitempty"`    // возврат у платежного провайдера
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Refund) Reset() {
+	*x = Refund{}
+	mi := &file_payment_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Refund) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Refund) ProtoMessage() {}
+
+func (x *Refund) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Refund.ProtoReflect.Descriptor instead.
+func (*Refund) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *Refund) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Receipt) GetLessonId() string {
-	if x != nil && x.LessonId != nil {
-		return *x.LessonId
+func (x *Refund) GetLessonId() string {
+	if x != nil {
+		return x.LessonId
 	}
 	return ""
 }
 
-func (x *Receipt) GetFileId() string {
-	if x != nil && x.FileId != nil {
-		return *x.FileId
+func (x *Refund) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
 	}
 	return ""
 }
 
-func (x *Receipt) GetIsVerified() bool {
+func (x *Refund) GetStudentId() string {
 	if x != nil {
-		return x.IsVerified
+		return x.StudentId
 	}
-	return false
+	return ""
 }
 
-func (x *Receipt) GetCreatedAt() *timestamppb.Timestamp {
+func (x *Refund) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Refund) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Refund) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *Refund) GetReceiptId() string {
+	if x != nil && x.ReceiptId != nil {
+		return *x.ReceiptId
+	}
+	return ""
+}
+
+func (x *Refund) GetOnlinePaymentId() string {
+	if x != nil && x.OnlinePaymentId != nil {
+		return *x.OnlinePaymentId
+	}
+	return ""
+}
+
+func (x *Refund) GetPackagePurchaseId() string {
+	if x != nil && x.PackagePurchaseId != nil {
+		return *x.PackagePurchaseId
+	}
+	return ""
+}
+
+func (x *Refund) GetProviderRefundId() string {
+	if x != nil && x.ProviderRefundId != nil {
+		return *x.ProviderRefundId
+	}
+	return ""
+}
+
+func (x *Refund) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *Receipt) GetEditedAt() *timestamppb.Timestamp {
+type ListRefundsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Refunds       []*Refund              `protobuf:"bytes,1,rep,name=refunds,proto3" json:"refunds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRefundsResponse) Reset() {
+	*x = ListRefundsResponse{}
+	mi := &file_payment_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRefundsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRefundsResponse) ProtoMessage() {}
+
+func (x *ListRefundsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[46]
 	if x != nil {
-		return x.EditedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRefundsResponse.ProtoReflect.Descriptor instead.
+func (*ListRefundsResponse) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListRefundsResponse) GetRefunds() []*Refund {
+	if x != nil {
+		return x.Refunds
 	}
 	return nil
 }
 
-type ReceiptFileURL struct {
+type ExportUserDataRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Url           *string                `protobuf:"bytes,1,opt,name=url,proto3,oneof" json:"url,omitempty"` // временная ссылка на файл из file-service
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReceiptFileURL) Reset() {
-	*x = ReceiptFileURL{}
-	mi := &file_payment_service_proto_msgTypes[7]
+func (x *ExportUserDataRequest) Reset() {
+	*x = ExportUserDataRequest{}
+	mi := &file_payment_service_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReceiptFileURL) String() string {
+func (x *ExportUserDataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReceiptFileURL) ProtoMessage() {}
+func (*ExportUserDataRequest) ProtoMessage() {}
 
-func (x *ReceiptFileURL) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_service_proto_msgTypes[7]
+func (x *ExportUserDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -426,18 +2915,79 @@ func (x *ReceiptFileURL) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReceiptFileURL.ProtoReflect.Descriptor instead.
-func (*ReceiptFileURL) Descriptor() ([]byte, []int) {
-	return file_payment_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ExportUserDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportUserDataRequest) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *ReceiptFileURL) GetUrl() string {
-	if x != nil && x.Url != nil {
-		return *x.Url
+func (x *ExportUserDataRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
 	}
 	return ""
 }
 
+// Financial records of the user, they are kept when the account is deleted
+type UserDataExport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Receipts      []*Receipt             `protobuf:"bytes,1,rep,name=receipts,proto3" json:"receipts,omitempty"`
+	Invoices      []*Invoice             `protobuf:"bytes,2,rep,name=invoices,proto3" json:"invoices,omitempty"`
+	Refunds       []*Refund              `protobuf:"bytes,3,rep,name=refunds,proto3" json:"refunds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserDataExport) Reset() {
+	*x = UserDataExport{}
+	mi := &file_payment_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDataExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDataExport) ProtoMessage() {}
+
+func (x *UserDataExport) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDataExport.ProtoReflect.Descriptor instead.
+func (*UserDataExport) Descriptor() ([]byte, []int) {
+	return file_payment_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *UserDataExport) GetReceipts() []*Receipt {
+	if x != nil {
+		return x.Receipts
+	}
+	return nil
+}
+
+func (x *UserDataExport) GetInvoices() []*Invoice {
+	if x != nil {
+		return x.Invoices
+	}
+	return nil
+}
+
+func (x *UserDataExport) GetRefunds() []*Refund {
+	if x != nil {
+		return x.Refunds
+	}
+	return nil
+}
+
 var File_payment_service_proto protoreflect.FileDescriptor
 
 const file_payment_service_proto_rawDesc = "" +
@@ -463,16 +3013,100 @@ const file_payment_service_proto_rawDesc = "" +
 	"receipt_id\x18\x01 \x01(\tR\treceiptId\"6\n" +
 	"\x15GetReceiptFileRequest\x12\x1d\n" +
 	"\n" +
-	"receipt_id\x18\x01 \x01(\tR\treceiptId\"\xa6\x01\n" +
-	"\vPaymentInfo\x12 \n" +
-	"\tlesson_id\x18\x01 \x01(\tH\x00R\blessonId\x88\x01\x01\x12 \n" +
-	"\tprice_rub\x18\x02 \x01(\x05H\x01R\bpriceRub\x88\x01\x01\x12&\n" +
-	"\fpayment_info\x18\x03 \x01(\tH\x02R\vpaymentInfo\x88\x01\x01B\f\n" +
+	"receipt_id\x18\x01 \x01(\tR\treceiptId\"6\n" +
+	"\x15ApproveReceiptRequest\x12\x1d\n" +
+	"\n" +
+	"receipt_id\x18\x01 \x01(\tR\treceiptId\"M\n" +
+	"\x14RejectReceiptRequest\x12\x1d\n" +
+	"\n" +
+	"receipt_id\x18\x01 \x01(\tR\treceiptId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x9d\x01\n" +
+	"\x13ListReceiptsRequest\x12\x1e\n" +
+	"\btutor_id\x18\x01 \x01(\tH\x00R\atutorId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tH\x01R\tstudentId\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x03 \x01(\tH\x02R\x06status\x88\x01\x01B\v\n" +
+	"\t_tutor_idB\r\n" +
+	"\v_student_idB\t\n" +
+	"\a_status\"M\n" +
+	"\x11GetBalanceRequest\x12\x19\n" +
+	"\btutor_id\x18\x01 \x01(\tR\atutorId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\"y\n" +
+	"\x18ListLedgerEntriesRequest\x12\x19\n" +
+	"\btutor_id\x18\x01 \x01(\tR\atutorId\x12\x1d\n" +
 	"\n" +
-	"_lesson_idB\f\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\x12\x19\n" +
+	"\x05limit\x18\x03 \x01(\x05H\x00R\x05limit\x88\x01\x01B\b\n" +
+	"\x06_limit\"\xa6\x01\n" +
+	"\x14CreatePackageRequest\x12\x1d\n" +
 	"\n" +
-	"_price_rubB\x0f\n" +
-	"\r_payment_info\"\x88\x02\n" +
+	"student_id\x18\x01 \x01(\tR\tstudentId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12!\n" +
+	"\flesson_count\x18\x03 \x01(\x05R\vlessonCount\x12'\n" +
+	"\x05price\x18\x05 \x01(\v2\x11.payment.v1.MoneyR\x05priceJ\x04\b\x04\x10\x05R\tprice_rub\"O\n" +
+	"\x13ListPackagesRequest\x12\x19\n" +
+	"\btutor_id\x18\x01 \x01(\tR\atutorId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\"7\n" +
+	"\x16PurchasePackageRequest\x12\x1d\n" +
+	"\n" +
+	"package_id\x18\x01 \x01(\tR\tpackageId\"\xdd\x01\n" +
+	"\x14CreateInvoiceRequest\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tR\tstudentId\x12=\n" +
+	"\fperiod_start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x129\n" +
+	"\n" +
+	"period_end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tperiodEnd\x12\x1f\n" +
+	"\bcurrency\x18\x04 \x01(\tH\x00R\bcurrency\x88\x01\x01B\v\n" +
+	"\t_currency\"u\n" +
+	"\x13ListInvoicesRequest\x12\x1e\n" +
+	"\btutor_id\x18\x01 \x01(\tH\x00R\atutorId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tH\x01R\tstudentId\x88\x01\x01B\v\n" +
+	"\t_tutor_idB\r\n" +
+	"\v_student_id\"6\n" +
+	"\x15GetInvoiceFileRequest\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\x01 \x01(\tR\tinvoiceId\"\x94\x01\n" +
+	"\x18GetEarningsReportRequest\x12=\n" +
+	"\fperiod_start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x129\n" +
+	"\n" +
+	"period_end\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tperiodEnd\"\x1d\n" +
+	"\x1bGetOutstandingReportRequest\"X\n" +
+	"\x1aCreateOnlinePaymentRequest\x12\x1b\n" +
+	"\tlesson_id\x18\x01 \x01(\tR\blessonId\x12\x1d\n" +
+	"\n" +
+	"return_url\x18\x02 \x01(\tR\treturnUrl\"\xcf\x01\n" +
+	"\x16ProviderWebhookRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\fR\x04body\x12I\n" +
+	"\aheaders\x18\x03 \x03(\v2/.payment.v1.ProviderWebhookRequest.HeadersEntryR\aheaders\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"n\n" +
+	"\x13RefundLessonRequest\x12\x1b\n" +
+	"\tlesson_id\x18\x01 \x01(\tR\blessonId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x1b\n" +
+	"\x06reason\x18\x03 \x01(\tH\x00R\x06reason\x88\x01\x01B\t\n" +
+	"\a_reason\"t\n" +
+	"\x12ListRefundsRequest\x12\x1e\n" +
+	"\btutor_id\x18\x01 \x01(\tH\x00R\atutorId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tH\x01R\tstudentId\x88\x01\x01B\v\n" +
+	"\t_tutor_idB\r\n" +
+	"\v_student_id\"F\n" +
+	"\x05Money\x12!\n" +
+	"\famount_minor\x18\x01 \x01(\x03R\vamountMinor\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\"\xbf\x01\n" +
+	"\vPaymentInfo\x12 \n" +
+	"\tlesson_id\x18\x01 \x01(\tH\x00R\blessonId\x88\x01\x01\x12&\n" +
+	"\fpayment_info\x18\x03 \x01(\tH\x01R\vpaymentInfo\x88\x01\x01\x12,\n" +
+	"\x05price\x18\x04 \x01(\v2\x11.payment.v1.MoneyH\x02R\x05price\x88\x01\x01B\f\n" +
+	"\n" +
+	"_lesson_idB\x0f\n" +
+	"\r_payment_infoB\b\n" +
+	"\x06_priceJ\x04\b\x02\x10\x03R\tprice_rub\"\x92\x04\n" +
 	"\aReceipt\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
 	"\tlesson_id\x18\x02 \x01(\tH\x00R\blessonId\x88\x01\x01\x12\x1c\n" +
@@ -481,21 +3115,199 @@ const file_payment_service_proto_rawDesc = "" +
 	"isVerified\x129\n" +
 	"\n" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x127\n" +
-	"\tedited_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\beditedAtB\f\n" +
+	"\tedited_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\beditedAt\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12.\n" +
+	"\x10rejection_reason\x18\b \x01(\tH\x02R\x0frejectionReason\x88\x01\x01\x12\x1e\n" +
+	"\btutor_id\x18\t \x01(\tH\x03R\atutorId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"student_id\x18\n" +
+	" \x01(\tH\x04R\tstudentId\x88\x01\x01\x12.\n" +
+	"\x06amount\x18\f \x01(\v2\x11.payment.v1.MoneyH\x05R\x06amount\x88\x01\x01B\f\n" +
 	"\n" +
 	"_lesson_idB\n" +
 	"\n" +
-	"\b_file_id\"/\n" +
+	"\b_file_idB\x13\n" +
+	"\x11_rejection_reasonB\v\n" +
+	"\t_tutor_idB\r\n" +
+	"\v_student_idB\t\n" +
+	"\a_amountJ\x04\b\v\x10\fR\n" +
+	"amount_rub\"G\n" +
+	"\x14ListReceiptsResponse\x12/\n" +
+	"\breceipts\x18\x01 \x03(\v2\x13.payment.v1.ReceiptR\breceipts\"/\n" +
 	"\x0eReceiptFileURL\x12\x15\n" +
 	"\x03url\x18\x01 \x01(\tH\x00R\x03url\x88\x01\x01B\x06\n" +
-	"\x04_url2\x8f\x03\n" +
+	"\x04_url\"\xb7\x01\n" +
+	"\aBalance\x12\x19\n" +
+	"\btutor_id\x18\x01 \x01(\tR\atutorId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\x120\n" +
+	"\x14package_lessons_left\x18\x04 \x01(\x05R\x12packageLessonsLeft\x12-\n" +
+	"\bbalances\x18\x05 \x03(\v2\x11.payment.v1.MoneyR\bbalancesJ\x04\b\x03\x10\x04R\vbalance_rub\"\xf3\x01\n" +
+	"\vLedgerEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
+	"\x0etransaction_id\x18\x02 \x01(\tR\rtransactionId\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12!\n" +
+	"\freference_id\x18\x04 \x01(\tR\vreferenceId\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12)\n" +
+	"\x06amount\x18\a \x01(\v2\x11.payment.v1.MoneyR\x06amountJ\x04\b\x05\x10\x06R\n" +
+	"amount_rub\"N\n" +
+	"\x19ListLedgerEntriesResponse\x121\n" +
+	"\aentries\x18\x01 \x03(\v2\x17.payment.v1.LedgerEntryR\aentries\"\x85\x02\n" +
+	"\rLessonPackage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\btutor_id\x18\x02 \x01(\tR\atutorId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x03 \x01(\tR\tstudentId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12!\n" +
+	"\flesson_count\x18\x05 \x01(\x05R\vlessonCount\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12'\n" +
+	"\x05price\x18\b \x01(\v2\x11.payment.v1.MoneyR\x05priceJ\x04\b\x06\x10\aR\tprice_rub\"M\n" +
+	"\x14ListPackagesResponse\x125\n" +
+	"\bpackages\x18\x01 \x03(\v2\x19.payment.v1.LessonPackageR\bpackages\"\xb7\x02\n" +
+	"\x0fPackagePurchase\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"package_id\x18\x02 \x01(\tR\tpackageId\x12\x19\n" +
+	"\btutor_id\x18\x03 \x01(\tR\atutorId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x04 \x01(\tR\tstudentId\x12#\n" +
+	"\rlessons_total\x18\x05 \x01(\x05R\flessonsTotal\x12!\n" +
+	"\flessons_used\x18\x06 \x01(\x05R\vlessonsUsed\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12'\n" +
+	"\x05price\x18\t \x01(\v2\x11.payment.v1.MoneyR\x05priceJ\x04\b\a\x10\bR\tprice_rub\"\xbb\x03\n" +
+	"\aInvoice\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\btutor_id\x18\x02 \x01(\tR\atutorId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x03 \x01(\tR\tstudentId\x12\x16\n" +
+	"\x06number\x18\x04 \x01(\x05R\x06number\x12=\n" +
+	"\fperiod_start\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x129\n" +
+	"\n" +
+	"period_end\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tperiodEnd\x12\x1c\n" +
+	"\afile_id\x18\t \x01(\tH\x00R\x06fileId\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12'\n" +
+	"\x05total\x18\v \x01(\v2\x11.payment.v1.MoneyR\x05total\x12%\n" +
+	"\x04paid\x18\f \x01(\v2\x11.payment.v1.MoneyR\x04paidB\n" +
+	"\n" +
+	"\b_file_idJ\x04\b\a\x10\bJ\x04\b\b\x10\tR\ttotal_rubR\bpaid_rub\"G\n" +
+	"\x14ListInvoicesResponse\x12/\n" +
+	"\binvoices\x18\x01 \x03(\v2\x13.payment.v1.InvoiceR\binvoices\"\"\n" +
+	"\x0eInvoiceFileURL\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"\xc2\x01\n" +
+	"\x10CurrencyEarnings\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12#\n" +
+	"\rlessons_count\x18\x02 \x01(\x05R\flessonsCount\x12!\n" +
+	"\fearned_minor\x18\x03 \x01(\x03R\vearnedMinor\x12\x1d\n" +
+	"\n" +
+	"paid_minor\x18\x04 \x01(\x03R\tpaidMinor\x12+\n" +
+	"\x11outstanding_minor\x18\x05 \x01(\x03R\x10outstandingMinor\"\xa4\x02\n" +
+	"\x0fEarningsSummary\x12#\n" +
+	"\rlessons_count\x18\x01 \x01(\x05R\flessonsCount\x124\n" +
+	"\x16unpriced_lessons_count\x18\x02 \x01(\x05R\x14unpricedLessonsCount\x12*\n" +
+	"\x0favg_days_to_pay\x18\x06 \x01(\x01H\x00R\favgDaysToPay\x88\x01\x01\x12=\n" +
+	"\vby_currency\x18\a \x03(\v2\x1c.payment.v1.CurrencyEarningsR\n" +
+	"byCurrencyB\x12\n" +
+	"\x10_avg_days_to_payJ\x04\b\x03\x10\x04J\x04\b\x04\x10\x05J\x04\b\x05\x10\x06R\n" +
+	"earned_rubR\bpaid_rubR\x0foutstanding_rub\"\x85\x01\n" +
+	"\x0fMonthlyEarnings\x12;\n" +
+	"\vmonth_start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"monthStart\x125\n" +
+	"\asummary\x18\x02 \x01(\v2\x1b.payment.v1.EarningsSummaryR\asummary\"\x8a\x01\n" +
+	"\x0fStudentEarnings\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tR\tstudentId\x12!\n" +
+	"\fstudent_name\x18\x02 \x01(\tR\vstudentName\x125\n" +
+	"\asummary\x18\x03 \x01(\v2\x1b.payment.v1.EarningsSummaryR\asummary\"\xab\x02\n" +
+	"\x0eEarningsReport\x12=\n" +
+	"\fperiod_start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x129\n" +
+	"\n" +
+	"period_end\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tperiodEnd\x121\n" +
+	"\x05total\x18\x03 \x01(\v2\x1b.payment.v1.EarningsSummaryR\x05total\x123\n" +
+	"\x06months\x18\x04 \x03(\v2\x1b.payment.v1.MonthlyEarningsR\x06months\x127\n" +
+	"\bstudents\x18\x05 \x03(\v2\x1b.payment.v1.StudentEarningsR\bstudents\"\x8f\x02\n" +
+	"\x12StudentOutstanding\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tR\tstudentId\x12!\n" +
+	"\fstudent_name\x18\x02 \x01(\tR\vstudentName\x12%\n" +
+	"\x0eunpaid_lessons\x18\x03 \x01(\x05R\runpaidLessons\x12D\n" +
+	"\x10oldest_unpaid_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x0eoldestUnpaidAt\x123\n" +
+	"\voutstanding\x18\x06 \x03(\v2\x11.payment.v1.MoneyR\voutstandingJ\x04\b\x04\x10\x05R\x0foutstanding_rub\"\x9b\x01\n" +
+	"\x11OutstandingReport\x12:\n" +
+	"\bstudents\x18\x02 \x03(\v2\x1e.payment.v1.StudentOutstandingR\bstudents\x123\n" +
+	"\voutstanding\x18\x03 \x03(\v2\x11.payment.v1.MoneyR\voutstandingJ\x04\b\x01\x10\x02R\x0foutstanding_rub\"\xad\x02\n" +
+	"\rOnlinePayment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tlesson_id\x18\x02 \x01(\tR\blessonId\x12\x1a\n" +
+	"\bprovider\x18\x03 \x01(\tR\bprovider\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12.\n" +
+	"\x10confirmation_url\x18\x06 \x01(\tH\x00R\x0fconfirmationUrl\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12)\n" +
+	"\x06amount\x18\b \x01(\v2\x11.payment.v1.MoneyR\x06amountB\x13\n" +
+	"\x11_confirmation_urlJ\x04\b\x05\x10\x06R\n" +
+	"amount_rub\"\x19\n" +
+	"\x17ProviderWebhookResponse\"\xa2\x04\n" +
+	"\x06Refund\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tlesson_id\x18\x02 \x01(\tR\blessonId\x12\x19\n" +
+	"\btutor_id\x18\x03 \x01(\tR\atutorId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x04 \x01(\tR\tstudentId\x12\x12\n" +
+	"\x04kind\x18\x05 \x01(\tR\x04kind\x12)\n" +
+	"\x06amount\x18\x06 \x01(\v2\x11.payment.v1.MoneyR\x06amount\x12\x1b\n" +
+	"\x06reason\x18\a \x01(\tH\x00R\x06reason\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"receipt_id\x18\b \x01(\tH\x01R\treceiptId\x88\x01\x01\x12/\n" +
+	"\x11online_payment_id\x18\t \x01(\tH\x02R\x0fonlinePaymentId\x88\x01\x01\x123\n" +
+	"\x13package_purchase_id\x18\n" +
+	" \x01(\tH\x03R\x11packagePurchaseId\x88\x01\x01\x121\n" +
+	"\x12provider_refund_id\x18\v \x01(\tH\x04R\x10providerRefundId\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\t\n" +
+	"\a_reasonB\r\n" +
+	"\v_receipt_idB\x14\n" +
+	"\x12_online_payment_idB\x16\n" +
+	"\x14_package_purchase_idB\x15\n" +
+	"\x13_provider_refund_id\"C\n" +
+	"\x13ListRefundsResponse\x12,\n" +
+	"\arefunds\x18\x01 \x03(\v2\x12.payment.v1.RefundR\arefunds\"0\n" +
+	"\x15ExportUserDataRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xa0\x01\n" +
+	"\x0eUserDataExport\x12/\n" +
+	"\breceipts\x18\x01 \x03(\v2\x13.payment.v1.ReceiptR\breceipts\x12/\n" +
+	"\binvoices\x18\x02 \x03(\v2\x13.payment.v1.InvoiceR\binvoices\x12,\n" +
+	"\arefunds\x18\x03 \x03(\v2\x12.payment.v1.RefundR\arefunds2\xd2\x0e\n" +
 	"\x0ePaymentService\x12L\n" +
 	"\x0eGetPaymentInfo\x12!.payment.v1.GetPaymentInfoRequest\x1a\x17.payment.v1.PaymentInfo\x12T\n" +
 	"\x14SubmitPaymentReceipt\x12'.payment.v1.SubmitPaymentReceiptRequest\x1a\x13.payment.v1.Receipt\x12@\n" +
 	"\n" +
 	"GetReceipt\x12\x1d.payment.v1.GetReceiptRequest\x1a\x13.payment.v1.Receipt\x12F\n" +
-	"\rVerifyReceipt\x12 .payment.v1.VerifyReceiptRequest\x1a\x13.payment.v1.Receipt\x12O\n" +
-	"\x0eGetReceiptFile\x12!.payment.v1.GetReceiptFileRequest\x1a\x1a.payment.v1.ReceiptFileURLB\x17Z\x15payment_service/protob\x06proto3"
+	"\rVerifyReceipt\x12 .payment.v1.VerifyReceiptRequest\x1a\x13.payment.v1.Receipt\x12H\n" +
+	"\x0eApproveReceipt\x12!.payment.v1.ApproveReceiptRequest\x1a\x13.payment.v1.Receipt\x12F\n" +
+	"\rRejectReceipt\x12 .payment.v1.RejectReceiptRequest\x1a\x13.payment.v1.Receipt\x12Q\n" +
+	"\fListReceipts\x12\x1f.payment.v1.ListReceiptsRequest\x1a .payment.v1.ListReceiptsResponse\x12O\n" +
+	"\x0eGetReceiptFile\x12!.payment.v1.GetReceiptFileRequest\x1a\x1a.payment.v1.ReceiptFileURL\x12@\n" +
+	"\n" +
+	"GetBalance\x12\x1d.payment.v1.GetBalanceRequest\x1a\x13.payment.v1.Balance\x12`\n" +
+	"\x11ListLedgerEntries\x12$.payment.v1.ListLedgerEntriesRequest\x1a%.payment.v1.ListLedgerEntriesResponse\x12L\n" +
+	"\rCreatePackage\x12 .payment.v1.CreatePackageRequest\x1a\x19.payment.v1.LessonPackage\x12Q\n" +
+	"\fListPackages\x12\x1f.payment.v1.ListPackagesRequest\x1a .payment.v1.ListPackagesResponse\x12R\n" +
+	"\x0fPurchasePackage\x12\".payment.v1.PurchasePackageRequest\x1a\x1b.payment.v1.PackagePurchase\x12F\n" +
+	"\rCreateInvoice\x12 .payment.v1.CreateInvoiceRequest\x1a\x13.payment.v1.Invoice\x12Q\n" +
+	"\fListInvoices\x12\x1f.payment.v1.ListInvoicesRequest\x1a .payment.v1.ListInvoicesResponse\x12O\n" +
+	"\x0eGetInvoiceFile\x12!.payment.v1.GetInvoiceFileRequest\x1a\x1a.payment.v1.InvoiceFileURL\x12U\n" +
+	"\x11GetEarningsReport\x12$.payment.v1.GetEarningsReportRequest\x1a\x1a.payment.v1.EarningsReport\x12^\n" +
+	"\x14GetOutstandingReport\x12'.payment.v1.GetOutstandingReportRequest\x1a\x1d.payment.v1.OutstandingReport\x12X\n" +
+	"\x13CreateOnlinePayment\x12&.payment.v1.CreateOnlinePaymentRequest\x1a\x19.payment.v1.OnlinePayment\x12`\n" +
+	"\x15HandleProviderWebhook\x12\".payment.v1.ProviderWebhookRequest\x1a#.payment.v1.ProviderWebhookResponse\x12C\n" +
+	"\fRefundLesson\x12\x1f.payment.v1.RefundLessonRequest\x1a\x12.payment.v1.Refund\x12N\n" +
+	"\vListRefunds\x12\x1e.payment.v1.ListRefundsRequest\x1a\x1f.payment.v1.ListRefundsResponse\x12O\n" +
+	"\x0eExportUserData\x12!.payment.v1.ExportUserDataRequest\x1a\x1a.payment.v1.UserDataExportB\x17Z\x15payment_service/protob\x06proto3"
 
 var (
 	file_payment_service_proto_rawDescOnce sync.Once
@@ -509,36 +3321,159 @@ func file_payment_service_proto_rawDescGZIP() []byte {
 	return file_payment_service_proto_rawDescData
 }
 
-var file_payment_service_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_payment_service_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
 var file_payment_service_proto_goTypes = []any{
 	(*GetPaymentInfoRequest)(nil),       // 0: payment.v1.GetPaymentInfoRequest
 	(*SubmitPaymentReceiptRequest)(nil), // 1: payment.v1.SubmitPaymentReceiptRequest
 	(*GetReceiptRequest)(nil),           // 2: payment.v1.GetReceiptRequest
 	(*VerifyReceiptRequest)(nil),        // 3: payment.v1.VerifyReceiptRequest
 	(*GetReceiptFileRequest)(nil),       // 4: payment.v1.GetReceiptFileRequest
-	(*PaymentInfo)(nil),                 // 5: payment.v1.PaymentInfo
-	(*Receipt)(nil),                     // 6: payment.v1.Receipt
-	(*ReceiptFileURL)(nil),              // 7: payment.v1.ReceiptFileURL
-	(*timestamppb.Timestamp)(nil),       // 8: google.protobuf.Timestamp
+	(*ApproveReceiptRequest)(nil),       // 5: payment.v1.ApproveReceiptRequest
+	(*RejectReceiptRequest)(nil),        // 6: payment.v1.RejectReceiptRequest
+	(*ListReceiptsRequest)(nil),         // 7: payment.v1.ListReceiptsRequest
+	(*GetBalanceRequest)(nil),           // 8: payment.v1.GetBalanceRequest
+	(*ListLedgerEntriesRequest)(nil),    // 9: payment.v1.ListLedgerEntriesRequest
+	(*CreatePackageRequest)(nil),        // 10: payment.v1.CreatePackageRequest
+	(*ListPackagesRequest)(nil),         // 11: payment.v1.ListPackagesRequest
+	(*PurchasePackageRequest)(nil),      // 12: payment.v1.PurchasePackageRequest
+	(*CreateInvoiceRequest)(nil),        // 13: payment.v1.CreateInvoiceRequest
+	(*ListInvoicesRequest)(nil),         // 14: payment.v1.ListInvoicesRequest
+	(*GetInvoiceFileRequest)(nil),       // 15: payment.v1.GetInvoiceFileRequest
+	(*GetEarningsReportRequest)(nil),    // 16: payment.v1.GetEarningsReportRequest
+	(*GetOutstandingReportRequest)(nil), // 17: payment.v1.GetOutstandingReportRequest
+	(*CreateOnlinePaymentRequest)(nil),  // 18: payment.v1.CreateOnlinePaymentRequest
+	(*ProviderWebhookRequest)(nil),      // 19: payment.v1.ProviderWebhookRequest
+	(*RefundLessonRequest)(nil),         // 20: payment.v1.RefundLessonRequest
+	(*ListRefundsRequest)(nil),          // 21: payment.v1.ListRefundsRequest
+	(*Money)(nil),                       // 22: payment.v1.Money
+	(*PaymentInfo)(nil),                 // 23: payment.v1.PaymentInfo
+	(*Receipt)(nil),                     // 24: payment.v1.Receipt
+	(*ListReceiptsResponse)(nil),        // 25: payment.v1.ListReceiptsResponse
+	(*ReceiptFileURL)(nil),              // 26: payment.v1.ReceiptFileURL
+	(*Balance)(nil),                     // 27: payment.v1.Balance
+	(*LedgerEntry)(nil),                 // 28: payment.v1.LedgerEntry
+	(*ListLedgerEntriesResponse)(nil),   // 29: payment.v1.ListLedgerEntriesResponse
+	(*LessonPackage)(nil),               // 30: payment.v1.LessonPackage
+	(*ListPackagesResponse)(nil),        // 31: payment.v1.ListPackagesResponse
+	(*PackagePurchase)(nil),             // 32: payment.v1.PackagePurchase
+	(*Invoice)(nil),                     // 33: payment.v1.Invoice
+	(*ListInvoicesResponse)(nil),        // 34: payment.v1.ListInvoicesResponse
+	(*InvoiceFileURL)(nil),              // 35: payment.v1.InvoiceFileURL
+	(*CurrencyEarnings)(nil),            // 36: payment.v1.CurrencyEarnings
+	(*EarningsSummary)(nil),             // 37: payment.v1.EarningsSummary
+	(*MonthlyEarnings)(nil),             // 38: payment.v1.MonthlyEarnings
+	(*StudentEarnings)(nil),             // 39: payment.v1.StudentEarnings
+	(*EarningsReport)(nil),              // 40: payment.v1.EarningsReport
+	(*StudentOutstanding)(nil),          // 41: payment.v1.StudentOutstanding
+	(*OutstandingReport)(nil),           // 42: payment.v1.OutstandingReport
+	(*OnlinePayment)(nil),               // 43: payment.v1.OnlinePayment
+	(*ProviderWebhookResponse)(nil),     // 44: payment.v1.ProviderWebhookResponse
+	(*Refund)(nil),                      // 45: payment.v1.Refund
+	(*ListRefundsResponse)(nil),         // 46: payment.v1.ListRefundsResponse
+	(*ExportUserDataRequest)(nil),       // 47: payment.v1.ExportUserDataRequest
+	(*UserDataExport)(nil),              // 48: payment.v1.UserDataExport
+	nil,                                 // 49: payment.v1.ProviderWebhookRequest.HeadersEntry
+	(*timestamppb.Timestamp)(nil),       // 50: google.protobuf.Timestamp
 }
 var file_payment_service_proto_depIdxs = []int32{
-	8, // 0: payment.v1.Receipt.created_at:type_name -> google.protobuf.Timestamp
-	8, // 1: payment.v1.Receipt.edited_at:type_name -> google.protobuf.Timestamp
-	0, // 2: payment.v1.PaymentService.GetPaymentInfo:input_type -> payment.v1.GetPaymentInfoRequest
-	1, // 3: payment.v1.PaymentService.SubmitPaymentReceipt:input_type -> payment.v1.SubmitPaymentReceiptRequest
-	2, // 4: payment.v1.PaymentService.GetReceipt:input_type -> payment.v1.GetReceiptRequest
-	3, // 5: payment.v1.PaymentService.VerifyReceipt:input_type -> payment.v1.VerifyReceiptRequest
-	4, // 6: payment.v1.PaymentService.GetReceiptFile:input_type -> payment.v1.GetReceiptFileRequest
-	5, // 7: payment.v1.PaymentService.GetPaymentInfo:output_type -> payment.v1.PaymentInfo
-	6, // 8: payment.v1.PaymentService.SubmitPaymentReceipt:output_type -> payment.v1.Receipt
-	6, // 9: payment.v1.PaymentService.GetReceipt:output_type -> payment.v1.Receipt
-	6, // 10: payment.v1.PaymentService.VerifyReceipt:output_type -> payment.v1.Receipt
-	7, // 11: payment.v1.PaymentService.GetReceiptFile:output_type -> payment.v1.ReceiptFileURL
-	7, // [7:12] is the sub-list for method output_type
-	2, // [2:7] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	22, // 0: payment.v1.CreatePackageRequest.price:type_name -> payment.v1.Money
+	50, // 1: payment.v1.CreateInvoiceRequest.period_start:type_name -> google.protobuf.Timestamp
+	50, // 2: payment.v1.CreateInvoiceRequest.period_end:type_name -> google.protobuf.Timestamp
+	50, // 3: payment.v1.GetEarningsReportRequest.period_start:type_name -> google.protobuf.Timestamp
+	50, // 4: payment.v1.GetEarningsReportRequest.period_end:type_name -> google.protobuf.Timestamp
+	49, // 5: payment.v1.ProviderWebhookRequest.headers:type_name -> payment.v1.ProviderWebhookRequest.HeadersEntry
+	22, // 6: payment.v1.PaymentInfo.price:type_name -> payment.v1.Money
+	50, // 7: payment.v1.Receipt.created_at:type_name -> google.protobuf.Timestamp
+	50, // 8: payment.v1.Receipt.edited_at:type_name -> google.protobuf.Timestamp
+	22, // 9: payment.v1.Receipt.amount:type_name -> payment.v1.Money
+	24, // 10: payment.v1.ListReceiptsResponse.receipts:type_name -> payment.v1.Receipt
+	22, // 11: payment.v1.Balance.balances:type_name -> payment.v1.Money
+	50, // 12: payment.v1.LedgerEntry.created_at:type_name -> google.protobuf.Timestamp
+	22, // 13: payment.v1.LedgerEntry.amount:type_name -> payment.v1.Money
+	28, // 14: payment.v1.ListLedgerEntriesResponse.entries:type_name -> payment.v1.LedgerEntry
+	50, // 15: payment.v1.LessonPackage.created_at:type_name -> google.protobuf.Timestamp
+	22, // 16: payment.v1.LessonPackage.price:type_name -> payment.v1.Money
+	30, // 17: payment.v1.ListPackagesResponse.packages:type_name -> payment.v1.LessonPackage
+	50, // 18: payment.v1.PackagePurchase.created_at:type_name -> google.protobuf.Timestamp
+	22, // 19: payment.v1.PackagePurchase.price:type_name -> payment.v1.Money
+	50, // 20: payment.v1.Invoice.period_start:type_name -> google.protobuf.Timestamp
+	50, // 21: payment.v1.Invoice.period_end:type_name -> google.protobuf.Timestamp
+	50, // 22: payment.v1.Invoice.created_at:type_name -> google.protobuf.Timestamp
+	22, // 23: payment.v1.Invoice.total:type_name -> payment.v1.Money
+	22, // 24: payment.v1.Invoice.paid:type_name -> payment.v1.Money
+	33, // 25: payment.v1.ListInvoicesResponse.invoices:type_name -> payment.v1.Invoice
+	36, // 26: payment.v1.EarningsSummary.by_currency:type_name -> payment.v1.CurrencyEarnings
+	50, // 27: payment.v1.MonthlyEarnings.month_start:type_name -> google.protobuf.Timestamp
+	37, // 28: payment.v1.MonthlyEarnings.summary:type_name -> payment.v1.EarningsSummary
+	37, // 29: payment.v1.StudentEarnings.summary:type_name -> payment.v1.EarningsSummary
+	50, // 30: payment.v1.EarningsReport.period_start:type_name -> google.protobuf.Timestamp
+	50, // 31: payment.v1.EarningsReport.period_end:type_name -> google.protobuf.Timestamp
+	37, // 32: payment.v1.EarningsReport.total:type_name -> payment.v1.EarningsSummary
+	38, // 33: payment.v1.EarningsReport.months:type_name -> payment.v1.MonthlyEarnings
+	39, // 34: payment.v1.EarningsReport.students:type_name -> payment.v1.StudentEarnings
+	50, // 35: payment.v1.StudentOutstanding.oldest_unpaid_at:type_name -> google.protobuf.Timestamp
+	22, // 36: payment.v1.StudentOutstanding.outstanding:type_name -> payment.v1.Money
+	41, // 37: payment.v1.OutstandingReport.students:type_name -> payment.v1.StudentOutstanding
+	22, // 38: payment.v1.OutstandingReport.outstanding:type_name -> payment.v1.Money
+	50, // 39: payment.v1.OnlinePayment.created_at:type_name -> google.protobuf.Timestamp
+	22, // 40: payment.v1.OnlinePayment.amount:type_name -> payment.v1.Money
+	22, // 41: payment.v1.Refund.amount:type_name -> payment.v1.Money
+	50, // 42: payment.v1.Refund.created_at:type_name -> google.protobuf.Timestamp
+	45, // 43: payment.v1.ListRefundsResponse.refunds:type_name -> payment.v1.Refund
+	24, // 44: payment.v1.UserDataExport.receipts:type_name -> payment.v1.Receipt
+	33, // 45: payment.v1.UserDataExport.invoices:type_name -> payment.v1.Invoice
+	45, // 46: payment.v1.UserDataExport.refunds:type_name -> payment.v1.Refund
+	0,  // 47: payment.v1.PaymentService.GetPaymentInfo:input_type -> payment.v1.GetPaymentInfoRequest
+	1,  // 48: payment.v1.PaymentService.SubmitPaymentReceipt:input_type -> payment.v1.SubmitPaymentReceiptRequest
+	2,  // 49: payment.v1.PaymentService.GetReceipt:input_type -> payment.v1.GetReceiptRequest
+	3,  // 50: payment.v1.PaymentService.VerifyReceipt:input_type -> payment.v1.VerifyReceiptRequest
+	5,  // 51: payment.v1.PaymentService.ApproveReceipt:input_type -> payment.v1.ApproveReceiptRequest
+	6,  // 52: payment.v1.PaymentService.RejectReceipt:input_type -> payment.v1.RejectReceiptRequest
+	7,  // 53: payment.v1.PaymentService.ListReceipts:input_type -> payment.v1.ListReceiptsRequest
+	4,  // 54: payment.v1.PaymentService.GetReceiptFile:input_type -> payment.v1.GetReceiptFileRequest
+	8,  // 55: payment.v1.PaymentService.GetBalance:input_type -> payment.v1.GetBalanceRequest
+	9,  // 56: payment.v1.PaymentService.ListLedgerEntries:input_type -> payment.v1.ListLedgerEntriesRequest
+	10, // 57: payment.v1.PaymentService.CreatePackage:input_type -> payment.v1.CreatePackageRequest
+	11, // 58: payment.v1.PaymentService.ListPackages:input_type -> payment.v1.ListPackagesRequest
+	12, // 59: payment.v1.PaymentService.PurchasePackage:input_type -> payment.v1.PurchasePackageRequest
+	13, // 60: payment.v1.PaymentService.CreateInvoice:input_type -> payment.v1.CreateInvoiceRequest
+	14, // 61: payment.v1.PaymentService.ListInvoices:input_type -> payment.v1.ListInvoicesRequest
+	15, // 62: payment.v1.PaymentService.GetInvoiceFile:input_type -> payment.v1.GetInvoiceFileRequest
+	16, // 63: payment.v1.PaymentService.GetEarningsReport:input_type -> payment.v1.GetEarningsReportRequest
+	17, // 64: payment.v1.PaymentService.GetOutstandingReport:input_type -> payment.v1.GetOutstandingReportRequest
+	18, // 65: payment.v1.PaymentService.CreateOnlinePayment:input_type -> payment.v1.CreateOnlinePaymentRequest
+	19, // 66: payment.v1.PaymentService.HandleProviderWebhook:input_type -> payment.v1.ProviderWebhookRequest
+	20, // 67: payment.v1.PaymentService.RefundLesson:input_type -> payment.v1.RefundLessonRequest
+	21, // 68: payment.v1.PaymentService.ListRefunds:input_type -> payment.v1.ListRefundsRequest
+	47, // 69: payment.v1.PaymentService.ExportUserData:input_type -> payment.v1.ExportUserDataRequest
+	23, // 70: payment.v1.PaymentService.GetPaymentInfo:output_type -> payment.v1.PaymentInfo
+	24, // 71: payment.v1.PaymentService.SubmitPaymentReceipt:output_type -> payment.v1.Receipt
+	24, // 72: payment.v1.PaymentService.GetReceipt:output_type -> payment.v1.Receipt
+	24, // 73: payment.v1.PaymentService.VerifyReceipt:output_type -> payment.v1.Receipt
+	24, // 74: payment.v1.PaymentService.ApproveReceipt:output_type -> payment.v1.Receipt
+	24, // 75: payment.v1.PaymentService.RejectReceipt:output_type -> payment.v1.Receipt
+	25, // 76: payment.v1.PaymentService.ListReceipts:output_type -> payment.v1.ListReceiptsResponse
+	26, // 77: payment.v1.PaymentService.GetReceiptFile:output_type -> payment.v1.ReceiptFileURL
+	27, // 78: payment.v1.PaymentService.GetBalance:output_type -> payment.v1.Balance
+	29, // 79: payment.v1.PaymentService.ListLedgerEntries:output_type -> payment.v1.ListLedgerEntriesResponse
+	30, // 80: payment.v1.PaymentService.CreatePackage:output_type -> payment.v1.LessonPackage
+	31, // 81: payment.v1.PaymentService.ListPackages:output_type -> payment.v1.ListPackagesResponse
+	32, // 82: payment.v1.PaymentService.PurchasePackage:output_type -> payment.v1.PackagePurchase
+	33, // 83: payment.v1.PaymentService.CreateInvoice:output_type -> payment.v1.Invoice
+	34, // 84: payment.v1.PaymentService.ListInvoices:output_type -> payment.v1.ListInvoicesResponse
+	35, // 85: payment.v1.PaymentService.GetInvoiceFile:output_type -> payment.v1.InvoiceFileURL
+	40, // 86: payment.v1.PaymentService.GetEarningsReport:output_type -> payment.v1.EarningsReport
+	42, // 87: payment.v1.PaymentService.GetOutstandingReport:output_type -> payment.v1.OutstandingReport
+	43, // 88: payment.v1.PaymentService.CreateOnlinePayment:output_type -> payment.v1.OnlinePayment
+	44, // 89: payment.v1.PaymentService.HandleProviderWebhook:output_type -> payment.v1.ProviderWebhookResponse
+	45, // 90: payment.v1.PaymentService.RefundLesson:output_type -> payment.v1.Refund
+	46, // 91: payment.v1.PaymentService.ListRefunds:output_type -> payment.v1.ListRefundsResponse
+	48, // 92: payment.v1.PaymentService.ExportUserData:output_type -> payment.v1.UserDataExport
+	70, // [70:93] is the sub-list for method output_type
+	47, // [47:70] is the sub-list for method input_type
+	47, // [47:47] is the sub-list for extension type_name
+	47, // [47:47] is the sub-list for extension extendee
+	0,  // [0:47] is the sub-list for field type_name
 }
 
 func init() { file_payment_service_proto_init() }
@@ -548,16 +3483,26 @@ func file_payment_service_proto_init() {
 	}
 	file_payment_service_proto_msgTypes[0].OneofWrappers = []any{}
 	file_payment_service_proto_msgTypes[1].OneofWrappers = []any{}
-	file_payment_service_proto_msgTypes[5].OneofWrappers = []any{}
-	file_payment_service_proto_msgTypes[6].OneofWrappers = []any{}
 	file_payment_service_proto_msgTypes[7].OneofWrappers = []any{}
+	file_payment_service_proto_msgTypes[9].OneofWrappers = []any{}
+	file_payment_service_proto_msgTypes[13].OneofWrappers = []any{}
+	file_payment_service_proto_msgTypes[14].OneofWrappers = []any{}
+	file_payment_service_proto_msgTypes[20].OneofWrappers = []any{}
+	file_payment_service_proto_msgTypes[21].OneofWrappers = []any{}
+	file_payment_service_proto_msgTypes[23].OneofWrappers = []any{}
+	file_payment_service_proto_msgTypes[24].OneofWrappers = []any{}
+	file_payment_service_proto_msgTypes[26].OneofWrappers = []any{}
+	file_payment_service_proto_msgTypes[33].OneofWrappers = []any{}
+	file_payment_service_proto_msgTypes[37].OneofWrappers = []any{}
+	file_payment_service_proto_msgTypes[43].OneofWrappers = []any{}
+	file_payment_service_proto_msgTypes[45].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_payment_service_proto_rawDesc), len(file_payment_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   50,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
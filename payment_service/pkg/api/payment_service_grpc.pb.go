@@ -19,11 +19,29 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PaymentService_GetPaymentInfo_FullMethodName       = "/payment.v1.PaymentService/GetPaymentInfo"
-	PaymentService_SubmitPaymentReceipt_FullMethodName = "/payment.v1.PaymentService/SubmitPaymentReceipt"
-	PaymentService_GetReceipt_FullMethodName           = "/payment.v1.PaymentService/GetReceipt"
-	PaymentService_VerifyReceipt_FullMethodName        = "/payment.v1.PaymentService/VerifyReceipt"
-	PaymentService_GetReceiptFile_FullMethodName       = "/payment.v1.PaymentService/GetReceiptFile"
+	PaymentService_GetPaymentInfo_FullMethodName        = "/payment.v1.PaymentService/GetPaymentInfo"
+	PaymentService_SubmitPaymentReceipt_FullMethodName  = "/payment.v1.PaymentService/SubmitPaymentReceipt"
+	PaymentService_GetReceipt_FullMethodName            = "/payment.v1.PaymentService/GetReceipt"
+	PaymentService_VerifyReceipt_FullMethodName         = "/payment.v1.PaymentService/VerifyReceipt"
+	PaymentService_ApproveReceipt_FullMethodName        = "/payment.v1.PaymentService/ApproveReceipt"
+	PaymentService_RejectReceipt_FullMethodName         = "/payment.v1.PaymentService/RejectReceipt"
+	PaymentService_ListReceipts_FullMethodName          = "/payment.v1.PaymentService/ListReceipts"
+	PaymentService_GetReceiptFile_FullMethodName        = "/payment.v1.PaymentService/GetReceiptFile"
+	PaymentService_GetBalance_FullMethodName            = "/payment.v1.PaymentService/GetBalance"
+	PaymentService_ListLedgerEntries_FullMethodName     = "/payment.v1.PaymentService/ListLedgerEntries"
+	PaymentService_CreatePackage_FullMethodName         = "/payment.v1.PaymentService/CreatePackage"
+	PaymentService_ListPackages_FullMethodName          = "/payment.v1.PaymentService/ListPackages"
+	PaymentService_PurchasePackage_FullMethodName       = "/payment.v1.PaymentService/PurchasePackage"
+	PaymentService_CreateInvoice_FullMethodName         = "/payment.v1.PaymentService/CreateInvoice"
+	PaymentService_ListInvoices_FullMethodName          = "/payment.v1.PaymentService/ListInvoices"
+	PaymentService_GetInvoiceFile_FullMethodName        = "/payment.v1.PaymentService/GetInvoiceFile"
+	PaymentService_GetEarningsReport_FullMethodName     = "/payment.v1.PaymentService/GetEarningsReport"
+	PaymentService_GetOutstandingReport_FullMethodName  = "/payment.v1.PaymentService/GetOutstandingReport"
+	PaymentService_CreateOnlinePayment_FullMethodName   = "/payment.v1.PaymentService/CreateOnlinePayment"
+	PaymentService_HandleProviderWebhook_FullMethodName = "/payment.v1.PaymentService/HandleProviderWebhook"
+	PaymentService_RefundLesson_FullMethodName          = "/payment.v1.PaymentService/RefundLesson"
+	PaymentService_ListRefunds_FullMethodName           = "/payment.v1.PaymentService/ListRefunds"
+	PaymentService_ExportUserData_FullMethodName        = "/payment.v1.PaymentService/ExportUserData"
 )
 
 // PaymentServiceClient is the client API for PaymentService service.
@@ -33,8 +51,28 @@ type PaymentServiceClient interface {
 	GetPaymentInfo(ctx context.Context, in *GetPaymentInfoRequest, opts ...grpc.CallOption) (*PaymentInfo, error)
 	SubmitPaymentReceipt(ctx context.Context, in *SubmitPaymentReceiptRequest, opts ...grpc.CallOption) (*Receipt, error)
 	GetReceipt(ctx context.Context, in *GetReceiptRequest, opts ...grpc.CallOption) (*Receipt, error)
+	// Deprecated: use ApproveReceipt.
 	VerifyReceipt(ctx context.Context, in *VerifyReceiptRequest, opts ...grpc.CallOption) (*Receipt, error)
+	ApproveReceipt(ctx context.Context, in *ApproveReceiptRequest, opts ...grpc.CallOption) (*Receipt, error)
+	RejectReceipt(ctx context.Context, in *RejectReceiptRequest, opts ...grpc.CallOption) (*Receipt, error)
+	ListReceipts(ctx context.Context, in *ListReceiptsRequest, opts ...grpc.CallOption) (*ListReceiptsResponse, error)
 	GetReceiptFile(ctx context.Context, in *GetReceiptFileRequest, opts ...grpc.CallOption) (*ReceiptFileURL, error)
+	GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*Balance, error)
+	ListLedgerEntries(ctx context.Context, in *ListLedgerEntriesRequest, opts ...grpc.CallOption) (*ListLedgerEntriesResponse, error)
+	CreatePackage(ctx context.Context, in *CreatePackageRequest, opts ...grpc.CallOption) (*LessonPackage, error)
+	ListPackages(ctx context.Context, in *ListPackagesRequest, opts ...grpc.CallOption) (*ListPackagesResponse, error)
+	PurchasePackage(ctx context.Context, in *PurchasePackageRequest, opts ...grpc.CallOption) (*PackagePurchase, error)
+	CreateInvoice(ctx context.Context, in *CreateInvoiceRequest, opts ...grpc.CallOption) (*Invoice, error)
+	ListInvoices(ctx context.Context, in *ListInvoicesRequest, opts ...grpc.CallOption) (*ListInvoicesResponse, error)
+	GetInvoiceFile(ctx context.Context, in *GetInvoiceFileRequest, opts ...grpc.CallOption) (*InvoiceFileURL, error)
+	GetEarningsReport(ctx context.Context, in *GetEarningsReportRequest, opts ...grpc.CallOption) (*EarningsReport, error)
+	GetOutstandingReport(ctx context.Context, in *GetOutstandingReportRequest, opts ...grpc.CallOption) (*OutstandingReport, error)
+	CreateOnlinePayment(ctx context.Context, in *CreateOnlinePaymentRequest, opts ...grpc.CallOption) (*OnlinePayment, error)
+	HandleProviderWebhook(ctx context.Context, in *ProviderWebhookRequest, opts ...grpc.CallOption) (*ProviderWebhookResponse, error)
+	RefundLesson(ctx context.Context, in *RefundLessonRequest, opts ...grpc.CallOption) (*Refund, error)
+	ListRefunds(ctx context.Context, in *ListRefundsRequest, opts ...grpc.CallOption) (*ListRefundsResponse, error)
+	// Called by user_service on behalf of the user for the data export
+	ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*UserDataExport, error)
 }
 
 type paymentServiceClient struct {
@@ -85,6 +123,36 @@ func (c *paymentServiceClient) VerifyReceipt(ctx context.Context, in *VerifyRece
 	return out, nil
 }
 
+func (c *paymentServiceClient) ApproveReceipt(ctx context.Context, in *ApproveReceiptRequest, opts ...grpc.CallOption) (*Receipt, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Receipt)
+	err := c.cc.Invoke(ctx, PaymentService_ApproveReceipt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) RejectReceipt(ctx context.Context, in *RejectReceiptRequest, opts ...grpc.CallOption) (*Receipt, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Receipt)
+	err := c.cc.Invoke(ctx, PaymentService_RejectReceipt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ListReceipts(ctx context.Context, in *ListReceiptsRequest, opts ...grpc.CallOption) (*ListReceiptsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReceiptsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ListReceipts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *paymentServiceClient) GetReceiptFile(ctx context.Context, in *GetReceiptFileRequest, opts ...grpc.CallOption) (*ReceiptFileURL, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ReceiptFileURL)
@@ -95,6 +163,156 @@ func (c *paymentServiceClient) GetReceiptFile(ctx context.Context, in *GetReceip
 	return out, nil
 }
 
+func (c *paymentServiceClient) GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*Balance, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Balance)
+	err := c.cc.Invoke(ctx, PaymentService_GetBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ListLedgerEntries(ctx context.Context, in *ListLedgerEntriesRequest, opts ...grpc.CallOption) (*ListLedgerEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLedgerEntriesResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ListLedgerEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) CreatePackage(ctx context.Context, in *CreatePackageRequest, opts ...grpc.CallOption) (*LessonPackage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LessonPackage)
+	err := c.cc.Invoke(ctx, PaymentService_CreatePackage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ListPackages(ctx context.Context, in *ListPackagesRequest, opts ...grpc.CallOption) (*ListPackagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPackagesResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ListPackages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) PurchasePackage(ctx context.Context, in *PurchasePackageRequest, opts ...grpc.CallOption) (*PackagePurchase, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PackagePurchase)
+	err := c.cc.Invoke(ctx, PaymentService_PurchasePackage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) CreateInvoice(ctx context.Context, in *CreateInvoiceRequest, opts ...grpc.CallOption) (*Invoice, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Invoice)
+	err := c.cc.Invoke(ctx, PaymentService_CreateInvoice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ListInvoices(ctx context.Context, in *ListInvoicesRequest, opts ...grpc.CallOption) (*ListInvoicesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInvoicesResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ListInvoices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetInvoiceFile(ctx context.Context, in *GetInvoiceFileRequest, opts ...grpc.CallOption) (*InvoiceFileURL, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvoiceFileURL)
+	err := c.cc.Invoke(ctx, PaymentService_GetInvoiceFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetEarningsReport(ctx context.Context, in *GetEarningsReportRequest, opts ...grpc.CallOption) (*EarningsReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EarningsReport)
+	err := c.cc.Invoke(ctx, PaymentService_GetEarningsReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetOutstandingReport(ctx context.Context, in *GetOutstandingReportRequest, opts ...grpc.CallOption) (*OutstandingReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OutstandingReport)
+	err := c.cc.Invoke(ctx, PaymentService_GetOutstandingReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) CreateOnlinePayment(ctx context.Context, in *CreateOnlinePaymentRequest, opts ...grpc.CallOption) (*OnlinePayment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OnlinePayment)
+	err := c.cc.Invoke(ctx, PaymentService_CreateOnlinePayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) HandleProviderWebhook(ctx context.Context, in *ProviderWebhookRequest, opts ...grpc.CallOption) (*ProviderWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderWebhookResponse)
+	err := c.cc.Invoke(ctx, PaymentService_HandleProviderWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) RefundLesson(ctx context.Context, in *RefundLessonRequest, opts ...grpc.CallOption) (*Refund, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Refund)
+	err := c.cc.Invoke(ctx, PaymentService_RefundLesson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ListRefunds(ctx context.Context, in *ListRefundsRequest, opts ...grpc.CallOption) (*ListRefundsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRefundsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ListRefunds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*UserDataExport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserDataExport)
+	err := c.cc.Invoke(ctx, PaymentService_ExportUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PaymentServiceServer is the server API for PaymentService service.
 // All implementations must embed UnimplementedPaymentServiceServer
 // for forward compatibility.
@@ -102,8 +320,28 @@ type PaymentServiceServer interface {
 	GetPaymentInfo(context.Context, *GetPaymentInfoRequest) (*PaymentInfo, error)
 	SubmitPaymentReceipt(context.Context, *SubmitPaymentReceiptRequest) (*Receipt, error)
 	GetReceipt(context.Context, *GetReceiptRequest) (*Receipt, error)
+	// Deprecated: use ApproveReceipt.
 	VerifyReceipt(context.Context, *VerifyReceiptRequest) (*Receipt, error)
+	ApproveReceipt(context.Context, *ApproveReceiptRequest) (*Receipt, error)
+	RejectReceipt(context.Context, *RejectReceiptRequest) (*Receipt, error)
+	ListReceipts(context.Context, *ListReceiptsRequest) (*ListReceiptsResponse, error)
 	GetReceiptFile(context.Context, *GetReceiptFileRequest) (*ReceiptFileURL, error)
+	GetBalance(context.Context, *GetBalanceRequest) (*Balance, error)
+	ListLedgerEntries(context.Context, *ListLedgerEntriesRequest) (*ListLedgerEntriesResponse, error)
+	CreatePackage(context.Context, *CreatePackageRequest) (*LessonPackage, error)
+	ListPackages(context.Context, *ListPackagesRequest) (*ListPackagesResponse, error)
+	PurchasePackage(context.Context, *PurchasePackageRequest) (*PackagePurchase, error)
+	CreateInvoice(context.Context, *CreateInvoiceRequest) (*Invoice, error)
+	ListInvoices(context.Context, *ListInvoicesRequest) (*ListInvoicesResponse, error)
+	GetInvoiceFile(context.Context, *GetInvoiceFileRequest) (*InvoiceFileURL, error)
+	GetEarningsReport(context.Context, *GetEarningsReportRequest) (*EarningsReport, error)
+	GetOutstandingReport(context.Context, *GetOutstandingReportRequest) (*OutstandingReport, error)
+	CreateOnlinePayment(context.Context, *CreateOnlinePaymentRequest) (*OnlinePayment, error)
+	HandleProviderWebhook(context.Context, *ProviderWebhookRequest) (*ProviderWebhookResponse, error)
+	RefundLesson(context.Context, *RefundLessonRequest) (*Refund, error)
+	ListRefunds(context.Context, *ListRefundsRequest) (*ListRefundsResponse, error)
+	// Called by user_service on behalf of the user for the data export
+	ExportUserData(context.Context, *ExportUserDataRequest) (*UserDataExport, error)
 	mustEmbedUnimplementedPaymentServiceServer()
 }
 
@@ -126,9 +364,63 @@ func (UnimplementedPaymentServiceServer) GetReceipt(context.Context, *GetReceipt
 func (UnimplementedPaymentServiceServer) VerifyReceipt(context.Context, *VerifyReceiptRequest) (*Receipt, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerifyReceipt not implemented")
 }
+func (UnimplementedPaymentServiceServer) ApproveReceipt(context.Context, *ApproveReceiptRequest) (*Receipt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveReceipt not implemented")
+}
+func (UnimplementedPaymentServiceServer) RejectReceipt(context.Context, *RejectReceiptRequest) (*Receipt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectReceipt not implemented")
+}
+func (UnimplementedPaymentServiceServer) ListReceipts(context.Context, *ListReceiptsRequest) (*ListReceiptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReceipts not implemented")
+}
 func (UnimplementedPaymentServiceServer) GetReceiptFile(context.Context, *GetReceiptFileRequest) (*ReceiptFileURL, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetReceiptFile not implemented")
 }
+func (UnimplementedPaymentServiceServer) GetBalance(context.Context, *GetBalanceRequest) (*Balance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalance not implemented")
+}
+func (UnimplementedPaymentServiceServer) ListLedgerEntries(context.Context, *ListLedgerEntriesRequest) (*ListLedgerEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLedgerEntries not implemented")
+}
+func (UnimplementedPaymentServiceServer) CreatePackage(context.Context, *CreatePackageRequest) (*LessonPackage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePackage not implemented")
+}
+func (UnimplementedPaymentServiceServer) ListPackages(context.Context, *ListPackagesRequest) (*ListPackagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPackages not implemented")
+}
+func (UnimplementedPaymentServiceServer) PurchasePackage(context.Context, *PurchasePackageRequest) (*PackagePurchase, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurchasePackage not implemented")
+}
+func (UnimplementedPaymentServiceServer) CreateInvoice(context.Context, *CreateInvoiceRequest) (*Invoice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInvoice not implemented")
+}
+func (UnimplementedPaymentServiceServer) ListInvoices(context.Context, *ListInvoicesRequest) (*ListInvoicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInvoices not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetInvoiceFile(context.Context, *GetInvoiceFileRequest) (*InvoiceFileURL, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvoiceFile not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetEarningsReport(context.Context, *GetEarningsReportRequest) (*EarningsReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEarningsReport not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetOutstandingReport(context.Context, *GetOutstandingReportRequest) (*OutstandingReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOutstandingReport not implemented")
+}
+func (UnimplementedPaymentServiceServer) CreateOnlinePayment(context.Context, *CreateOnlinePaymentRequest) (*OnlinePayment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOnlinePayment not implemented")
+}
+func (UnimplementedPaymentServiceServer) HandleProviderWebhook(context.Context, *ProviderWebhookRequest) (*ProviderWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleProviderWebhook not implemented")
+}
+func (UnimplementedPaymentServiceServer) RefundLesson(context.Context, *RefundLessonRequest) (*Refund, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundLesson not implemented")
+}
+func (UnimplementedPaymentServiceServer) ListRefunds(context.Context, *ListRefundsRequest) (*ListRefundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRefunds not implemented")
+}
+func (UnimplementedPaymentServiceServer) ExportUserData(context.Context, *ExportUserDataRequest) (*UserDataExport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUserData not implemented")
+}
 func (UnimplementedPaymentServiceServer) mustEmbedUnimplementedPaymentServiceServer() {}
 func (UnimplementedPaymentServiceServer) testEmbeddedByValue()                        {}
 
@@ -222,6 +514,60 @@ func _PaymentService_VerifyReceipt_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaymentService_ApproveReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ApproveReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ApproveReceipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ApproveReceipt(ctx, req.(*ApproveReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_RejectReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).RejectReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_RejectReceipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).RejectReceipt(ctx, req.(*RejectReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ListReceipts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReceiptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ListReceipts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ListReceipts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ListReceipts(ctx, req.(*ListReceiptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PaymentService_GetReceiptFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetReceiptFileRequest)
 	if err := dec(in); err != nil {
@@ -240,6 +586,276 @@ func _PaymentService_GetReceiptFile_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaymentService_GetBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetBalance(ctx, req.(*GetBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ListLedgerEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLedgerEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ListLedgerEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ListLedgerEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ListLedgerEntries(ctx, req.(*ListLedgerEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_CreatePackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).CreatePackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_CreatePackage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).CreatePackage(ctx, req.(*CreatePackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ListPackages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPackagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ListPackages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ListPackages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ListPackages(ctx, req.(*ListPackagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_PurchasePackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchasePackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).PurchasePackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_PurchasePackage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).PurchasePackage(ctx, req.(*PurchasePackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_CreateInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).CreateInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_CreateInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).CreateInvoice(ctx, req.(*CreateInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ListInvoices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvoicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ListInvoices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ListInvoices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ListInvoices(ctx, req.(*ListInvoicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetInvoiceFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvoiceFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetInvoiceFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetInvoiceFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetInvoiceFile(ctx, req.(*GetInvoiceFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetEarningsReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEarningsReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetEarningsReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetEarningsReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetEarningsReport(ctx, req.(*GetEarningsReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetOutstandingReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOutstandingReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetOutstandingReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetOutstandingReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetOutstandingReport(ctx, req.(*GetOutstandingReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_CreateOnlinePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOnlinePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).CreateOnlinePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_CreateOnlinePayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).CreateOnlinePayment(ctx, req.(*CreateOnlinePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_HandleProviderWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).HandleProviderWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_HandleProviderWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).HandleProviderWebhook(ctx, req.(*ProviderWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_RefundLesson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundLessonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).RefundLesson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_RefundLesson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).RefundLesson(ctx, req.(*RefundLessonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ListRefunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRefundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ListRefunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ListRefunds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ListRefunds(ctx, req.(*ListRefundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ExportUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ExportUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ExportUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ExportUserData(ctx, req.(*ExportUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PaymentService_ServiceDesc is the grpc.ServiceDesc for PaymentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -263,10 +879,82 @@ var PaymentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "VerifyReceipt",
 			Handler:    _PaymentService_VerifyReceipt_Handler,
 		},
+		{
+			MethodName: "ApproveReceipt",
+			Handler:    _PaymentService_ApproveReceipt_Handler,
+		},
+		{
+			MethodName: "RejectReceipt",
+			Handler:    _PaymentService_RejectReceipt_Handler,
+		},
+		{
+			MethodName: "ListReceipts",
+			Handler:    _PaymentService_ListReceipts_Handler,
+		},
 		{
 			MethodName: "GetReceiptFile",
 			Handler:    _PaymentService_GetReceiptFile_Handler,
 		},
+		{
+			MethodName: "GetBalance",
+			Handler:    _PaymentService_GetBalance_Handler,
+		},
+		{
+			MethodName: "ListLedgerEntries",
+			Handler:    _PaymentService_ListLedgerEntries_Handler,
+		},
+		{
+			MethodName: "CreatePackage",
+			Handler:    _PaymentService_CreatePackage_Handler,
+		},
+		{
+			MethodName: "ListPackages",
+			Handler:    _PaymentService_ListPackages_Handler,
+		},
+		{
+			MethodName: "PurchasePackage",
+			Handler:    _PaymentService_PurchasePackage_Handler,
+		},
+		{
+			MethodName: "CreateInvoice",
+			Handler:    _PaymentService_CreateInvoice_Handler,
+		},
+		{
+			MethodName: "ListInvoices",
+			Handler:    _PaymentService_ListInvoices_Handler,
+		},
+		{
+			MethodName: "GetInvoiceFile",
+			Handler:    _PaymentService_GetInvoiceFile_Handler,
+		},
+		{
+			MethodName: "GetEarningsReport",
+			Handler:    _PaymentService_GetEarningsReport_Handler,
+		},
+		{
+			MethodName: "GetOutstandingReport",
+			Handler:    _PaymentService_GetOutstandingReport_Handler,
+		},
+		{
+			MethodName: "CreateOnlinePayment",
+			Handler:    _PaymentService_CreateOnlinePayment_Handler,
+		},
+		{
+			MethodName: "HandleProviderWebhook",
+			Handler:    _PaymentService_HandleProviderWebhook_Handler,
+		},
+		{
+			MethodName: "RefundLesson",
+			Handler:    _PaymentService_RefundLesson_Handler,
+		},
+		{
+			MethodName: "ListRefunds",
+			Handler:    _PaymentService_ListRefunds_Handler,
+		},
+		{
+			MethodName: "ExportUserData",
+			Handler:    _PaymentService_ExportUserData_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "payment_service.proto",
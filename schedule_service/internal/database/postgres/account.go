@@ -0,0 +1,65 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+)
+
+// ForgetUser removes data of a deleted user. Future booked lessons of the user are cancelled and their slots
+// are freed, free future slots of the user as a tutor are deleted and the connection link and payment info
+// the user gave as a tutor are cleared. Past lessons are kept for the other side of the pair.
+func (r *PostgresRepository) ForgetUser(ctx context.Context, userID string) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `
+		WITH cancelled AS (
+			UPDATE lessons l SET status = 'cancelled', edited_at = now(), cancelled_at = now()
+			FROM slots s
+			WHERE l.slot_id = s.id AND l.status = 'booked' AND s.starts_at > now()
+			  AND (l.student_id = $1 OR s.tutor_id = $1)
+			RETURNING l.slot_id
+		)
+		UPDATE slots SET is_booked = false, edited_at = now()
+		WHERE id IN (SELECT slot_id FROM cancelled)
+	`, userID)
+	if err != nil {
+		return fmt.Errorf("failed to cancel lessons: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		DELETE FROM slots s
+		WHERE s.tutor_id = $1 AND s.starts_at > now()
+		  AND NOT EXISTS (SELECT 1 FROM lessons l WHERE l.slot_id = s.id)
+	`, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete slots: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE lessons SET connection_link = NULL, payment_info = NULL
+		WHERE slot_id IN (SELECT id FROM slots WHERE tutor_id = $1)
+	`, userID)
+	if err != nil {
+		return fmt.Errorf("failed to clear lesson details: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, "DELETE FROM pricing_rules WHERE tutor_id = $1 OR student_id = $1", userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete pricing rules: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, "DELETE FROM discount_codes WHERE tutor_id = $1", userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete discount codes: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
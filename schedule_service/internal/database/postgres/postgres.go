@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"common_library/money"
 	"context"
 	"errors"
 	"fmt"
@@ -179,26 +180,36 @@ func (r *PostgresRepository) ListSlotsByTutor(ctx context.Context, tutorID strin
 
 func (r *PostgresRepository) GetLesson(ctx context.Context, id string) (*repo.Lesson, error) {
 	query := `
-		SELECT id, slot_id, student_id, status, is_paid, connection_link, price_rub, payment_info, created_at, edited_at
-		FROM lessons
-		WHERE id = $1
+		SELECT l.id, l.slot_id, s.tutor_id, s.starts_at, s.ends_at, l.student_id, l.status, l.is_paid, l.connection_link, l.price_minor, l.price_currency, l.price_breakdown, l.payment_info, l.payment_id, l.created_at, l.edited_at, l.cancelled_at
+		FROM lessons l
+		JOIN slots s ON l.slot_id = s.id
+		WHERE l.id = $1
 	`
 
 	var lesson repo.Lesson
-	var connectionLink, paymentInfo pgtype.Text
-	var priceRub pgtype.Int4
+	var connectionLink, paymentInfo, paymentID pgtype.Text
+	var priceMinor pgtype.Int8
+	var priceCurrency pgtype.Text
+	var priceBreakdown []priceComponentRow
 
 	err := r.pool.QueryRow(ctx, query, id).Scan(
 		&lesson.ID,
 		&lesson.SlotID,
+		&lesson.TutorID,
+		&lesson.StartsAt,
+		&lesson.EndsAt,
 		&lesson.StudentID,
 		&lesson.Status,
 		&lesson.IsPaid,
 		&connectionLink,
-		&priceRub,
+		&priceMinor,
+		&priceCurrency,
+		&priceBreakdown,
 		&paymentInfo,
+		&paymentID,
 		&lesson.CreatedAt,
 		&lesson.EditedAt,
+		&lesson.CancelledAt,
 	)
 
 	if err != nil {
@@ -212,19 +223,20 @@ func (r *PostgresRepository) GetLesson(ctx context.Context, id string) (*repo.Le
 		lesson.ConnectionLink = &connectionLink.String
 	}
 
-	if priceRub.Valid {
-		val := int32(priceRub.Int32)
-		lesson.PriceRub = &val
-	}
+	lesson.Price = lessonPrice(priceMinor, priceCurrency)
+	lesson.PriceBreakdown = toPriceComponents(priceBreakdown)
 
 	if paymentInfo.Valid {
 		lesson.PaymentInfo = &paymentInfo.String
 	}
+	if paymentID.Valid {
+		lesson.PaymentID = &paymentID.String
+	}
 
 	return &lesson, nil
 }
 
-func (r *PostgresRepository) CreateLessonAndBookSlot(ctx context.Context, lesson repo.Lesson, slotID string) error {
+func (r *PostgresRepository) CreateLessonAndBookSlot(ctx context.Context, lesson repo.Lesson, slotID string, discountCodeID *string) error {
 	tx, err := r.pool.Begin(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
@@ -249,17 +261,35 @@ func (r *PostgresRepository) CreateLessonAndBookSlot(ctx context.Context, lesson
 		return fmt.Errorf("failed to mark slot as booked: %w", err)
 	}
 
+	if discountCodeID != nil {
+		// the limit is checked here again so concurrent bookings can't use the code more times than allowed
+		res, err := tx.Exec(ctx, `
+			UPDATE discount_codes SET used_count = used_count + 1
+			WHERE id = $1 AND (max_uses IS NULL OR used_count < max_uses)
+		`, *discountCodeID)
+		if err != nil {
+			return fmt.Errorf("failed to use discount code: %w", err)
+		}
+		if res.RowsAffected() == 0 {
+			return service.ErrDiscountCodeUsedUp
+		}
+	}
+
 	query := `
-		INSERT INTO lessons (id, slot_id, student_id, status, is_paid, created_at, edited_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		INSERT INTO lessons (id, slot_id, student_id, status, is_paid, price_minor, price_currency, price_breakdown, created_at, edited_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 	`
 
+	priceMinor, priceCurrency := priceColumns(lesson.Price)
 	_, err = tx.Exec(ctx, query,
 		lesson.ID,
 		lesson.SlotID,
 		lesson.StudentID,
 		lesson.Status,
 		lesson.IsPaid,
+		priceMinor,
+		priceCurrency,
+		fromPriceComponents(lesson.PriceBreakdown),
 		lesson.CreatedAt,
 		lesson.EditedAt,
 	)
@@ -277,15 +307,18 @@ func (r *PostgresRepository) CreateLessonAndBookSlot(ctx context.Context, lesson
 func (r *PostgresRepository) UpdateLesson(ctx context.Context, lesson repo.Lesson) error {
 	query := `
 		UPDATE lessons
-		SET status = $1, is_paid = $2, connection_link = $3, price_rub = $4, payment_info = $5, edited_at = $6
-		WHERE id = $7
+		SET status = $1, is_paid = $2, connection_link = $3, price_minor = $4, price_currency = $5, price_breakdown = $6, payment_info = $7, edited_at = $8
+		WHERE id = $9
 	`
 
+	priceMinor, priceCurrency := priceColumns(lesson.Price)
 	res, err := r.pool.Exec(ctx, query,
 		lesson.Status,
 		lesson.IsPaid,
 		lesson.ConnectionLink,
-		lesson.PriceRub,
+		priceMinor,
+		priceCurrency,
+		fromPriceComponents(lesson.PriceBreakdown),
 		lesson.PaymentInfo,
 		lesson.EditedAt,
 		lesson.ID,
@@ -310,9 +343,10 @@ func (r *PostgresRepository) CancelLessonAndFreeSlot(ctx context.Context, lesson
 	defer tx.Rollback(ctx)
 
 	_, err = tx.Exec(ctx,
-		"UPDATE lessons SET status = $1, edited_at = $2 WHERE id = $3",
+		"UPDATE lessons SET status = $1, edited_at = $2, cancelled_at = $3 WHERE id = $4",
 		lesson.Status,
 		lesson.EditedAt,
+		lesson.CancelledAt,
 		lesson.ID,
 	)
 	if err != nil {
@@ -333,7 +367,7 @@ func (r *PostgresRepository) CancelLessonAndFreeSlot(ctx context.Context, lesson
 
 func (r *PostgresRepository) ListLessonsByTutor(ctx context.Context, tutorID string, statusFilter []string) ([]repo.Lesson, error) {
 	query := `
-		SELECT l.id, l.slot_id, l.student_id, l.status, l.is_paid, l.connection_link, l.price_rub, l.payment_info, l.created_at, l.edited_at
+		SELECT l.id, l.slot_id, s.tutor_id, s.starts_at, s.ends_at, l.student_id, l.status, l.is_paid, l.connection_link, l.price_minor, l.price_currency, l.price_breakdown, l.payment_info, l.payment_id, l.created_at, l.edited_at, l.cancelled_at
 		FROM lessons l
 		JOIN slots s ON l.slot_id = s.id
 		WHERE s.tutor_id = $1
@@ -356,7 +390,7 @@ func (r *PostgresRepository) ListLessonsByTutor(ctx context.Context, tutorID str
 
 func (r *PostgresRepository) ListLessonsByStudent(ctx context.Context, studentID string, statusFilter []string) ([]repo.Lesson, error) {
 	query := `
-		SELECT l.id, l.slot_id, l.student_id, l.status, l.is_paid, l.connection_link, l.price_rub, l.payment_info, l.created_at, l.edited_at
+		SELECT l.id, l.slot_id, s.tutor_id, s.starts_at, s.ends_at, l.student_id, l.status, l.is_paid, l.connection_link, l.price_minor, l.price_currency, l.price_breakdown, l.payment_info, l.payment_id, l.created_at, l.edited_at, l.cancelled_at
 		FROM lessons l
 		JOIN slots s ON l.slot_id = s.id
 		WHERE l.student_id = $1
@@ -379,7 +413,7 @@ func (r *PostgresRepository) ListLessonsByStudent(ctx context.Context, studentID
 
 func (r *PostgresRepository) ListLessonsByPair(ctx context.Context, tutorID, studentID string, statusFilter []string) ([]repo.Lesson, error) {
 	query := `
-		SELECT l.id, l.slot_id, l.student_id, l.status, l.is_paid, l.connection_link, l.price_rub, l.payment_info, l.created_at, l.edited_at
+		SELECT l.id, l.slot_id, s.tutor_id, s.starts_at, s.ends_at, l.student_id, l.status, l.is_paid, l.connection_link, l.price_minor, l.price_currency, l.price_breakdown, l.payment_info, l.payment_id, l.created_at, l.edited_at, l.cancelled_at
 		FROM lessons l
 		JOIN slots s ON l.slot_id = s.id
 		WHERE s.tutor_id = $1 AND l.student_id = $2
@@ -406,7 +440,7 @@ func (r *PostgresRepository) ListCompletedUnpaidLessons(ctx context.Context, aft
 
 	if after != nil {
 		query = `
-			SELECT l.id, l.slot_id, l.student_id, l.status, l.is_paid, l.connection_link, l.price_rub, l.payment_info, l.created_at, l.edited_at
+			SELECT l.id, l.slot_id, s.tutor_id, s.starts_at, s.ends_at, l.student_id, l.status, l.is_paid, l.connection_link, l.price_minor, l.price_currency, l.price_breakdown, l.payment_info, l.payment_id, l.created_at, l.edited_at, l.cancelled_at
 			FROM lessons l
 			JOIN slots s ON l.slot_id = s.id
 			WHERE l.status = 'completed' AND l.is_paid = false AND s.ends_at > $1
@@ -415,7 +449,7 @@ func (r *PostgresRepository) ListCompletedUnpaidLessons(ctx context.Context, aft
 		args = []interface{}{after}
 	} else {
 		query = `
-			SELECT l.id, l.slot_id, l.student_id, l.status, l.is_paid, l.connection_link, l.price_rub, l.payment_info, l.created_at, l.edited_at
+			SELECT l.id, l.slot_id, s.tutor_id, s.starts_at, s.ends_at, l.student_id, l.status, l.is_paid, l.connection_link, l.price_minor, l.price_currency, l.price_breakdown, l.payment_info, l.payment_id, l.created_at, l.edited_at, l.cancelled_at
 			FROM lessons l
 			JOIN slots s ON l.slot_id = s.id
 			WHERE l.status = 'completed' AND l.is_paid = false
@@ -455,20 +489,29 @@ func (r *PostgresRepository) queryLessons(ctx context.Context, query string, arg
 	var lessons []repo.Lesson
 	for rows.Next() {
 		var lesson repo.Lesson
-		var connectionLink, paymentInfo pgtype.Text
-		var priceRub pgtype.Int4
+		var connectionLink, paymentInfo, paymentID pgtype.Text
+		var priceMinor pgtype.Int8
+		var priceCurrency pgtype.Text
+		var priceBreakdown []priceComponentRow
 
 		err := rows.Scan(
 			&lesson.ID,
 			&lesson.SlotID,
+			&lesson.TutorID,
+			&lesson.StartsAt,
+			&lesson.EndsAt,
 			&lesson.StudentID,
 			&lesson.Status,
 			&lesson.IsPaid,
 			&connectionLink,
-			&priceRub,
+			&priceMinor,
+			&priceCurrency,
+			&priceBreakdown,
 			&paymentInfo,
+			&paymentID,
 			&lesson.CreatedAt,
 			&lesson.EditedAt,
+			&lesson.CancelledAt,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan lesson row: %w", err)
@@ -478,14 +521,16 @@ func (r *PostgresRepository) queryLessons(ctx context.Context, query string, arg
 			lesson.ConnectionLink = &connectionLink.String
 		}
 
-		if priceRub.Valid {
-			val := int32(priceRub.Int32)
-			lesson.PriceRub = &val
-		}
+		lesson.Price = lessonPrice(priceMinor, priceCurrency)
+		lesson.PriceBreakdown = toPriceComponents(priceBreakdown)
+		lesson.PriceBreakdown = toPriceComponents(priceBreakdown)
 
 		if paymentInfo.Valid {
 			lesson.PaymentInfo = &paymentInfo.String
 		}
+		if paymentID.Valid {
+			lesson.PaymentID = &paymentID.String
+		}
 
 		lessons = append(lessons, lesson)
 	}
@@ -497,18 +542,78 @@ func (r *PostgresRepository) queryLessons(ctx context.Context, query string, arg
 	return lessons, nil
 }
 
-func (r *PostgresRepository) MarkAsPaid(ctx context.Context, lessonID string) error {
-	query := `UPDATE lessons SET is_paid = TRUE WHERE id = $1`
+func (r *PostgresRepository) MarkAsUnpaid(ctx context.Context, lessonID string, paymentID string) error {
+	// a lesson paid by another payment stays paid
+	query := `UPDATE lessons SET is_paid = FALSE, payment_id = NULL WHERE id = $1 AND payment_id = $2`
 
-	res, err := r.pool.Exec(ctx, query, lessonID)
+	if _, err := r.pool.Exec(ctx, query, lessonID, paymentID); err != nil {
+		return fmt.Errorf("failed to mark as unpaid: %w", err)
+	}
+	return nil
+}
 
-	if err != nil {
+func (r *PostgresRepository) MarkAsPaid(ctx context.Context, lessonID string, paymentID string) error {
+	// the payment of an already paid lesson is kept
+	query := `UPDATE lessons SET is_paid = TRUE, payment_id = $2 WHERE id = $1 AND is_paid = FALSE`
+
+	if _, err := r.pool.Exec(ctx, query, lessonID, paymentID); err != nil {
 		return fmt.Errorf("failed to mark as paid: %w", err)
 	}
+	return nil
+}
 
-	if res.RowsAffected() == 0 {
-		return service.ErrLessonNotFound
+func lessonPrice(minor pgtype.Int8, currency pgtype.Text) *money.Money {
+	if !minor.Valid || !currency.Valid {
+		return nil
 	}
-	return nil
+	price := money.New(minor.Int64, money.Currency(currency.String))
+	return &price
+}
 
+func priceColumns(price *money.Money) (*int64, *string) {
+	if price == nil {
+		return nil, nil
+	}
+	currency := price.Currency.String()
+	return &price.Amount, &currency
+}
+
+// priceComponentRow is an element of lessons.price_breakdown
+type priceComponentRow struct {
+	Kind        string `json:"kind"`
+	Description string `json:"description"`
+	AmountMinor int64  `json:"amount_minor"`
+	Currency    string `json:"currency"`
+}
+
+func toPriceComponents(rows []priceComponentRow) []repo.PriceComponent {
+	if len(rows) == 0 {
+		return nil
+	}
+	components := make([]repo.PriceComponent, len(rows))
+	for i, row := range rows {
+		components[i] = repo.PriceComponent{
+			Kind:        row.Kind,
+			Description: row.Description,
+			Amount:      money.New(row.AmountMinor, money.Currency(row.Currency)),
+		}
+	}
+	return components
+}
+
+// fromPriceComponents returns nil for an empty breakdown so the column stays NULL
+func fromPriceComponents(components []repo.PriceComponent) any {
+	if len(components) == 0 {
+		return nil
+	}
+	rows := make([]priceComponentRow, len(components))
+	for i, component := range components {
+		rows[i] = priceComponentRow{
+			Kind:        component.Kind,
+			Description: component.Description,
+			AmountMinor: component.Amount.Amount,
+			Currency:    component.Amount.Currency.String(),
+		}
+	}
+	return rows
 }
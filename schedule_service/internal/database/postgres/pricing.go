@@ -0,0 +1,251 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	repo "schedule_service/internal/database/repo"
+	service "schedule_service/internal/service/service"
+)
+
+const pricingRuleColumns = "id, tutor_id, student_id, kind, name, duration_minutes, price_minor, price_currency, percent, created_at"
+
+const discountCodeColumns = "id, tutor_id, code, percent, expires_at, max_uses, used_count, created_at"
+
+func (r *PostgresRepository) CreatePricingRule(ctx context.Context, rule repo.PricingRule) error {
+	query := `
+		INSERT INTO pricing_rules (` + pricingRuleColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+
+	priceMinor, priceCurrency := priceColumns(rule.Price)
+	_, err := r.pool.Exec(ctx, query,
+		rule.ID,
+		rule.TutorID,
+		rule.StudentID,
+		rule.Kind,
+		rule.Name,
+		rule.DurationMinutes,
+		priceMinor,
+		priceCurrency,
+		rule.Percent,
+		rule.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create pricing rule: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresRepository) GetPricingRule(ctx context.Context, id string) (*repo.PricingRule, error) {
+	query := `SELECT ` + pricingRuleColumns + ` FROM pricing_rules WHERE id = $1`
+
+	rule, err := scanPricingRule(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, service.ErrPricingRuleNotFound
+		}
+		return nil, fmt.Errorf("failed to get pricing rule: %w", err)
+	}
+
+	return rule, nil
+}
+
+func (r *PostgresRepository) ListPricingRules(ctx context.Context, tutorID string) ([]repo.PricingRule, error) {
+	query := `SELECT ` + pricingRuleColumns + ` FROM pricing_rules WHERE tutor_id = $1 ORDER BY created_at ASC`
+
+	rows, err := r.pool.Query(ctx, query, tutorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query pricing rules: %w", err)
+	}
+	defer rows.Close()
+
+	var rules []repo.PricingRule
+	for rows.Next() {
+		rule, err := scanPricingRule(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan pricing rule row: %w", err)
+		}
+		rules = append(rules, *rule)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating pricing rule rows: %w", err)
+	}
+
+	return rules, nil
+}
+
+func (r *PostgresRepository) DeletePricingRule(ctx context.Context, id string) error {
+	res, err := r.pool.Exec(ctx, "DELETE FROM pricing_rules WHERE id = $1", id)
+	if err != nil {
+		return fmt.Errorf("failed to delete pricing rule: %w", err)
+	}
+
+	if res.RowsAffected() == 0 {
+		return service.ErrPricingRuleNotFound
+	}
+
+	return nil
+}
+
+func (r *PostgresRepository) CreateDiscountCode(ctx context.Context, code repo.DiscountCode) error {
+	query := `
+		INSERT INTO discount_codes (` + discountCodeColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	_, err := r.pool.Exec(ctx, query,
+		code.ID,
+		code.TutorID,
+		code.Code,
+		code.Percent,
+		code.ExpiresAt,
+		code.MaxUses,
+		code.UsedCount,
+		code.CreatedAt,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return service.ErrDiscountCodeExists
+		}
+		return fmt.Errorf("failed to create discount code: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresRepository) GetDiscountCode(ctx context.Context, id string) (*repo.DiscountCode, error) {
+	query := `SELECT ` + discountCodeColumns + ` FROM discount_codes WHERE id = $1`
+
+	return r.getDiscountCode(ctx, query, id)
+}
+
+func (r *PostgresRepository) GetDiscountCodeByCode(ctx context.Context, tutorID, code string) (*repo.DiscountCode, error) {
+	query := `SELECT ` + discountCodeColumns + ` FROM discount_codes WHERE tutor_id = $1 AND code = $2`
+
+	return r.getDiscountCode(ctx, query, tutorID, code)
+}
+
+func (r *PostgresRepository) ListDiscountCodes(ctx context.Context, tutorID string) ([]repo.DiscountCode, error) {
+	query := `SELECT ` + discountCodeColumns + ` FROM discount_codes WHERE tutor_id = $1 ORDER BY created_at DESC`
+
+	rows, err := r.pool.Query(ctx, query, tutorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query discount codes: %w", err)
+	}
+	defer rows.Close()
+
+	var codes []repo.DiscountCode
+	for rows.Next() {
+		code, err := scanDiscountCode(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan discount code row: %w", err)
+		}
+		codes = append(codes, *code)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating discount code rows: %w", err)
+	}
+
+	return codes, nil
+}
+
+func (r *PostgresRepository) DeleteDiscountCode(ctx context.Context, id string) error {
+	res, err := r.pool.Exec(ctx, "DELETE FROM discount_codes WHERE id = $1", id)
+	if err != nil {
+		return fmt.Errorf("failed to delete discount code: %w", err)
+	}
+
+	if res.RowsAffected() == 0 {
+		return service.ErrDiscountCodeNotFound
+	}
+
+	return nil
+}
+
+func (r *PostgresRepository) getDiscountCode(ctx context.Context, query string, args ...interface{}) (*repo.DiscountCode, error) {
+	code, err := scanDiscountCode(r.pool.QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, service.ErrDiscountCodeNotFound
+		}
+		return nil, fmt.Errorf("failed to get discount code: %w", err)
+	}
+
+	return code, nil
+}
+
+func scanPricingRule(row pgx.Row) (*repo.PricingRule, error) {
+	var rule repo.PricingRule
+	var studentID pgtype.Text
+	var durationMinutes, percent pgtype.Int4
+	var priceMinor pgtype.Int8
+	var priceCurrency pgtype.Text
+
+	err := row.Scan(
+		&rule.ID,
+		&rule.TutorID,
+		&studentID,
+		&rule.Kind,
+		&rule.Name,
+		&durationMinutes,
+		&priceMinor,
+		&priceCurrency,
+		&percent,
+		&rule.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if studentID.Valid {
+		rule.StudentID = &studentID.String
+	}
+	if durationMinutes.Valid {
+		rule.DurationMinutes = &durationMinutes.Int32
+	}
+	rule.Price = lessonPrice(priceMinor, priceCurrency)
+	if percent.Valid {
+		rule.Percent = &percent.Int32
+	}
+
+	return &rule, nil
+}
+
+func scanDiscountCode(row pgx.Row) (*repo.DiscountCode, error) {
+	var code repo.DiscountCode
+	var expiresAt pgtype.Timestamptz
+	var maxUses pgtype.Int4
+
+	err := row.Scan(
+		&code.ID,
+		&code.TutorID,
+		&code.Code,
+		&code.Percent,
+		&expiresAt,
+		&maxUses,
+		&code.UsedCount,
+		&code.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if expiresAt.Valid {
+		code.ExpiresAt = &expiresAt.Time
+	}
+	if maxUses.Valid {
+		code.MaxUses = &maxUses.Int32
+	}
+
+	return &code, nil
+}
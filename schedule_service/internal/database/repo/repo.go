@@ -1,6 +1,7 @@
 package repo
 
 import (
+	"common_library/money"
 	"context"
 	"time"
 )
@@ -18,14 +19,57 @@ type Slot struct {
 type Lesson struct {
 	ID             string
 	SlotID         string
+	TutorID        string    // tutor of the slot
+	StartsAt       time.Time // time of the slot
+	EndsAt         time.Time
 	StudentID      string
 	Status         string // "booked", "cancelled", "completeбd"
 	IsPaid         bool
 	ConnectionLink *string
-	PriceRub       *int32
+	Price          *money.Money
+	PriceBreakdown []PriceComponent // how the price was computed at booking
 	PaymentInfo    *string
+	PaymentID      *string // payment of payment_service that paid the lesson
 	CreatedAt      time.Time
 	EditedAt       time.Time
+	CancelledAt    *time.Time // when the lesson was cancelled, edits after that do not change it
+}
+
+type PricingRuleKind string
+
+const (
+	PricingRuleDuration        PricingRuleKind = "duration"          // price of a lesson of the given length
+	PricingRuleDiscount        PricingRuleKind = "discount"          // percent off every lesson
+	PricingRuleFirstLessonFree PricingRuleKind = "first_lesson_free" // the first lesson of a pair costs nothing
+)
+
+type PricingRule struct {
+	ID              string
+	TutorID         string
+	StudentID       *string // nil applies to all students of the tutor
+	Kind            PricingRuleKind
+	Name            string
+	DurationMinutes *int32
+	Price           *money.Money
+	Percent         *int32
+	CreatedAt       time.Time
+}
+
+type DiscountCode struct {
+	ID        string
+	TutorID   string
+	Code      string // stored in upper case
+	Percent   int32
+	ExpiresAt *time.Time
+	MaxUses   *int32
+	UsedCount int32
+	CreatedAt time.Time
+}
+
+type PriceComponent struct {
+	Kind        string // base price or a discount, see pricing.Component*
+	Description string
+	Amount      money.Money // negative for discounts
 }
 
 type Repository interface {
@@ -38,7 +82,8 @@ type Repository interface {
 
 	// Lesson operations
 	GetLesson(ctx context.Context, id string) (*Lesson, error)
-	CreateLessonAndBookSlot(ctx context.Context, lesson Lesson, slotID string) error
+	// CreateLessonAndBookSlot also uses the discount code if it is not nil
+	CreateLessonAndBookSlot(ctx context.Context, lesson Lesson, slotID string, discountCodeID *string) error
 	UpdateLesson(ctx context.Context, lesson Lesson) error
 	CancelLessonAndFreeSlot(ctx context.Context, lesson Lesson, slotID string) error
 	ListLessonsByTutor(ctx context.Context, tutorID string, statusFilter []string) ([]Lesson, error)
@@ -48,5 +93,23 @@ type Repository interface {
 
 	UpdateCompletedLessons(ctx context.Context) (int, error)
 
-	MarkAsPaid(ctx context.Context, lessonID string) error
+	// MarkAsPaid keeps the payment of an already paid lesson
+	MarkAsPaid(ctx context.Context, lessonID string, paymentID string) error
+	// MarkAsUnpaid changes only a lesson paid by the payment
+	MarkAsUnpaid(ctx context.Context, lessonID string, paymentID string) error
+
+	// Pricing operations
+	CreatePricingRule(ctx context.Context, rule PricingRule) error
+	GetPricingRule(ctx context.Context, id string) (*PricingRule, error)
+	ListPricingRules(ctx context.Context, tutorID string) ([]PricingRule, error)
+	DeletePricingRule(ctx context.Context, id string) error
+
+	CreateDiscountCode(ctx context.Context, code DiscountCode) error
+	GetDiscountCode(ctx context.Context, id string) (*DiscountCode, error)
+	GetDiscountCodeByCode(ctx context.Context, tutorID, code string) (*DiscountCode, error)
+	ListDiscountCodes(ctx context.Context, tutorID string) ([]DiscountCode, error)
+	DeleteDiscountCode(ctx context.Context, id string) error
+
+	// ForgetUser cancels future lessons of a deleted user and clears what the user entered as a tutor
+	ForgetUser(ctx context.Context, userID string) error
 }
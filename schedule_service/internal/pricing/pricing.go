@@ -0,0 +1,163 @@
+// Package pricing computes the price of a lesson from the tutor rules.
+package pricing
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"common_library/money"
+	"schedule_service/internal/database/repo"
+)
+
+const (
+	ComponentTutorPrice      = "tutor_price"       // default price of the tutor
+	ComponentPairPrice       = "pair_price"        // price set for the student
+	ComponentDurationPrice   = "duration_price"    // price rule for the lesson length
+	ComponentDiscount        = "discount"          // discount rule
+	ComponentDiscountCode    = "discount_code"     // discount code entered at booking
+	ComponentFirstLessonFree = "first_lesson_free" // first lesson rule
+)
+
+type Input struct {
+	StudentID   string
+	Duration    time.Duration
+	TutorPrice  *money.Money // tutor default, used when the pair has no price
+	PairPrice   *money.Money
+	Rules       []repo.PricingRule
+	FirstLesson bool               // the pair has no booked or completed lessons yet
+	Code        *repo.DiscountCode // already checked with CheckDiscountCode
+}
+
+type Quote struct {
+	Price     *money.Money // nil if neither the tutor nor the pair has a price
+	Breakdown []repo.PriceComponent
+}
+
+// Compute picks the base price and applies discounts to it.
+//
+// The base price is the first of: a duration rule of the student, the pair price,
+// a duration rule of the tutor, the tutor price. A first lesson rule makes the lesson free,
+// otherwise percents of discount rules and the code are added up, capped at 100.
+func Compute(input Input) Quote {
+	base, ok := basePrice(input)
+	if !ok {
+		return Quote{}
+	}
+
+	quote := Quote{Breakdown: []repo.PriceComponent{base}}
+	price := base.Amount
+
+	if input.FirstLesson {
+		if rule := findRule(input, repo.PricingRuleFirstLessonFree); rule != nil && price.Amount > 0 {
+			quote.Breakdown = append(quote.Breakdown, repo.PriceComponent{
+				Kind:        ComponentFirstLessonFree,
+				Description: rule.Name,
+				Amount:      price.Neg(),
+			})
+			free := money.New(0, price.Currency)
+			quote.Price = &free
+			return quote
+		}
+	}
+
+	var discounts []repo.PriceComponent
+	var percentLeft int64 = 100
+	addDiscount := func(kind string, description string, percent int32) {
+		p := min(int64(percent), percentLeft)
+		if p <= 0 {
+			return
+		}
+		percentLeft -= p
+		discounts = append(discounts, repo.PriceComponent{
+			Kind:        kind,
+			Description: fmt.Sprintf("%s (%d%%)", description, p),
+			Amount:      base.Amount.Percent(p).Neg(),
+		})
+	}
+
+	for _, rule := range sortedRules(input) {
+		if rule.Kind == repo.PricingRuleDiscount && rule.Percent != nil {
+			addDiscount(ComponentDiscount, rule.Name, *rule.Percent)
+		}
+	}
+	if input.Code != nil {
+		addDiscount(ComponentDiscountCode, input.Code.Code, input.Code.Percent)
+	}
+
+	for _, discount := range discounts {
+		price.Amount += discount.Amount.Amount
+	}
+	if price.Amount < 0 {
+		price.Amount = 0
+	}
+
+	quote.Breakdown = append(quote.Breakdown, discounts...)
+	quote.Price = &price
+	return quote
+}
+
+// CheckDiscountCode returns an error if the code can't be used at the moment.
+func CheckDiscountCode(code *repo.DiscountCode, now time.Time) error {
+	if code.ExpiresAt != nil && !now.Before(*code.ExpiresAt) {
+		return fmt.Errorf("discount code %s has expired", code.Code)
+	}
+	if code.MaxUses != nil && code.UsedCount >= *code.MaxUses {
+		return fmt.Errorf("discount code %s is used up", code.Code)
+	}
+	return nil
+}
+
+// NormalizeCode makes codes case-insensitive.
+func NormalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func basePrice(input Input) (repo.PriceComponent, bool) {
+	minutes := int32(input.Duration / time.Minute)
+
+	var tutorRule *repo.PricingRule
+	for _, rule := range sortedRules(input) {
+		if rule.Kind != repo.PricingRuleDuration || rule.DurationMinutes == nil || *rule.DurationMinutes != minutes || rule.Price == nil {
+			continue
+		}
+		if rule.StudentID != nil {
+			return repo.PriceComponent{Kind: ComponentDurationPrice, Description: rule.Name, Amount: *rule.Price}, true
+		}
+		if tutorRule == nil {
+			tutorRule = &rule
+		}
+	}
+
+	switch {
+	case input.PairPrice != nil:
+		return repo.PriceComponent{Kind: ComponentPairPrice, Description: "Цена для ученика", Amount: *input.PairPrice}, true
+	case tutorRule != nil:
+		return repo.PriceComponent{Kind: ComponentDurationPrice, Description: tutorRule.Name, Amount: *tutorRule.Price}, true
+	case input.TutorPrice != nil:
+		return repo.PriceComponent{Kind: ComponentTutorPrice, Description: "Цена репетитора", Amount: *input.TutorPrice}, true
+	}
+	return repo.PriceComponent{}, false
+}
+
+func findRule(input Input, kind repo.PricingRuleKind) *repo.PricingRule {
+	for _, rule := range sortedRules(input) {
+		if rule.Kind == kind {
+			return &rule
+		}
+	}
+	return nil
+}
+
+// sortedRules returns the rules that apply to the student, oldest first.
+func sortedRules(input Input) []repo.PricingRule {
+	rules := make([]repo.PricingRule, 0, len(input.Rules))
+	for _, rule := range input.Rules {
+		if rule.StudentID == nil || *rule.StudentID == input.StudentID {
+			rules = append(rules, rule)
+		}
+	}
+	sort.SliceStable(rules, func(i, j int) bool { return rules[i].CreatedAt.Before(rules[j].CreatedAt) })
+	return rules
+}
@@ -0,0 +1,146 @@
+package pricing_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"common_library/money"
+	"schedule_service/internal/database/repo"
+	"schedule_service/internal/pricing"
+)
+
+const studentID = "student-1"
+
+func rub(major int64) *money.Money {
+	m := money.FromMajor(major, money.RUB)
+	return &m
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestCompute_NoPrice(t *testing.T) {
+	quote := pricing.Compute(pricing.Input{StudentID: studentID, Duration: time.Hour})
+
+	assert.Nil(t, quote.Price)
+	assert.Empty(t, quote.Breakdown)
+}
+
+func TestCompute_BasePrice(t *testing.T) {
+	created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	tutorRule := repo.PricingRule{Kind: repo.PricingRuleDuration, Name: "90 минут", DurationMinutes: ptr(int32(90)), Price: rub(2500), CreatedAt: created}
+	pairRule := repo.PricingRule{Kind: repo.PricingRuleDuration, StudentID: ptr(studentID), Name: "90 минут для ученика", DurationMinutes: ptr(int32(90)), Price: rub(2000), CreatedAt: created}
+	otherRule := repo.PricingRule{Kind: repo.PricingRuleDuration, StudentID: ptr("student-2"), Name: "чужое", DurationMinutes: ptr(int32(90)), Price: rub(100), CreatedAt: created}
+
+	tests := []struct {
+		name     string
+		input    pricing.Input
+		kind     string
+		expected *money.Money
+	}{
+		{
+			name:     "tutor price",
+			input:    pricing.Input{Duration: time.Hour, TutorPrice: rub(1500), Rules: []repo.PricingRule{tutorRule}},
+			kind:     pricing.ComponentTutorPrice,
+			expected: rub(1500),
+		},
+		{
+			name:     "tutor duration rule over tutor price",
+			input:    pricing.Input{Duration: 90 * time.Minute, TutorPrice: rub(1500), Rules: []repo.PricingRule{tutorRule, otherRule}},
+			kind:     pricing.ComponentDurationPrice,
+			expected: rub(2500),
+		},
+		{
+			name:     "pair price over tutor duration rule",
+			input:    pricing.Input{Duration: 90 * time.Minute, PairPrice: rub(1800), Rules: []repo.PricingRule{tutorRule}},
+			kind:     pricing.ComponentPairPrice,
+			expected: rub(1800),
+		},
+		{
+			name:     "pair duration rule over pair price",
+			input:    pricing.Input{Duration: 90 * time.Minute, PairPrice: rub(1800), Rules: []repo.PricingRule{tutorRule, pairRule}},
+			kind:     pricing.ComponentDurationPrice,
+			expected: rub(2000),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.input.StudentID = studentID
+			quote := pricing.Compute(tt.input)
+
+			require.Len(t, quote.Breakdown, 1)
+			assert.Equal(t, tt.kind, quote.Breakdown[0].Kind)
+			assert.Equal(t, tt.expected, quote.Price)
+		})
+	}
+}
+
+func TestCompute_Discounts(t *testing.T) {
+	created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	sibling := repo.PricingRule{Kind: repo.PricingRuleDiscount, StudentID: ptr(studentID), Name: "Скидка для брата", Percent: ptr(int32(10)), CreatedAt: created}
+	code := &repo.DiscountCode{Code: "SPRING", Percent: 25}
+
+	quote := pricing.Compute(pricing.Input{
+		StudentID:  studentID,
+		Duration:   time.Hour,
+		TutorPrice: rub(1500),
+		Rules:      []repo.PricingRule{sibling},
+		Code:       code,
+	})
+
+	assert.Equal(t, rub(975), quote.Price)
+	assert.Equal(t, []repo.PriceComponent{
+		{Kind: pricing.ComponentTutorPrice, Description: "Цена репетитора", Amount: *rub(1500)},
+		{Kind: pricing.ComponentDiscount, Description: "Скидка для брата (10%)", Amount: rub(150).Neg()},
+		{Kind: pricing.ComponentDiscountCode, Description: "SPRING (25%)", Amount: rub(375).Neg()},
+	}, quote.Breakdown)
+}
+
+func TestCompute_DiscountsCapped(t *testing.T) {
+	created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	rules := []repo.PricingRule{
+		{Kind: repo.PricingRuleDiscount, Name: "Первая", Percent: ptr(int32(70)), CreatedAt: created},
+		{Kind: repo.PricingRuleDiscount, Name: "Вторая", Percent: ptr(int32(50)), CreatedAt: created.Add(time.Hour)},
+	}
+
+	quote := pricing.Compute(pricing.Input{StudentID: studentID, Duration: time.Hour, TutorPrice: rub(1000), Rules: rules})
+
+	assert.Equal(t, rub(0), quote.Price)
+	require.Len(t, quote.Breakdown, 3)
+	assert.Equal(t, "Вторая (30%)", quote.Breakdown[2].Description)
+}
+
+func TestCompute_FirstLessonFree(t *testing.T) {
+	rules := []repo.PricingRule{
+		{Kind: repo.PricingRuleFirstLessonFree, Name: "Пробное занятие"},
+		{Kind: repo.PricingRuleDiscount, Name: "Скидка", Percent: ptr(int32(10))},
+	}
+
+	t.Run("first lesson", func(t *testing.T) {
+		quote := pricing.Compute(pricing.Input{StudentID: studentID, Duration: time.Hour, TutorPrice: rub(1500), Rules: rules, FirstLesson: true})
+
+		assert.Equal(t, rub(0), quote.Price)
+		require.Len(t, quote.Breakdown, 2)
+		assert.Equal(t, pricing.ComponentFirstLessonFree, quote.Breakdown[1].Kind)
+	})
+
+	t.Run("later lesson", func(t *testing.T) {
+		quote := pricing.Compute(pricing.Input{StudentID: studentID, Duration: time.Hour, TutorPrice: rub(1500), Rules: rules})
+
+		assert.Equal(t, rub(1350), quote.Price)
+	})
+}
+
+func TestCheckDiscountCode(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	assert.NoError(t, pricing.CheckDiscountCode(&repo.DiscountCode{Code: "A", Percent: 10}, now))
+	assert.Error(t, pricing.CheckDiscountCode(&repo.DiscountCode{Code: "A", Percent: 10, ExpiresAt: ptr(now)}, now))
+	assert.Error(t, pricing.CheckDiscountCode(&repo.DiscountCode{Code: "A", Percent: 10, MaxUses: ptr(int32(2)), UsedCount: 2}, now))
+	assert.NoError(t, pricing.CheckDiscountCode(&repo.DiscountCode{Code: "A", Percent: 10, MaxUses: ptr(int32(2)), UsedCount: 1}, now))
+}
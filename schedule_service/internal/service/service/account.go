@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+
+	"common_library/ctxdata"
+	pb "schedule_service/pkg/api"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ExportUserData returns everything the service stores about the current user, for the data export of user_service.
+func (s *ScheduleServer) ExportUserData(ctx context.Context, req *pb.ExportUserDataRequest) (*pb.UserDataExport, error) {
+	if err := ensureCurrentUser(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	asTutor, err := s.db.ListLessonsByTutor(ctx, req.UserId, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list lessons")
+	}
+	asStudent, err := s.db.ListLessonsByStudent(ctx, req.UserId, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list lessons")
+	}
+	slots, err := s.db.ListSlotsByTutor(ctx, req.UserId, false)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list slots")
+	}
+	rules, err := s.db.ListPricingRules(ctx, req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list pricing rules")
+	}
+	codesList, err := s.db.ListDiscountCodes(ctx, req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list discount codes")
+	}
+
+	resp := &pb.UserDataExport{
+		Lessons:       createListLessonsResponse(append(asTutor, asStudent...)).Lessons,
+		Slots:         make([]*pb.Slot, len(slots)),
+		PricingRules:  make([]*pb.PricingRule, len(rules)),
+		DiscountCodes: make([]*pb.DiscountCode, len(codesList)),
+	}
+	for i := range slots {
+		resp.Slots[i] = &pb.Slot{
+			Id:        slots[i].ID,
+			TutorId:   slots[i].TutorID,
+			StartsAt:  timestamppb.New(slots[i].StartsAt),
+			EndsAt:    timestamppb.New(slots[i].EndsAt),
+			IsBooked:  slots[i].IsBooked,
+			CreatedAt: timestamppb.New(slots[i].CreatedAt),
+		}
+		if slots[i].EditedAt != nil {
+			resp.Slots[i].EditedAt = timestamppb.New(*slots[i].EditedAt)
+		}
+	}
+	for i := range rules {
+		resp.PricingRules[i] = toPbPricingRule(&rules[i])
+	}
+	for i := range codesList {
+		resp.DiscountCodes[i] = toPbDiscountCode(&codesList[i])
+	}
+
+	return resp, nil
+}
+
+// ForgetUser is called by user_service when the current user deletes the account.
+func (s *ScheduleServer) ForgetUser(ctx context.Context, req *pb.ForgetUserRequest) (*pb.Empty, error) {
+	if err := ensureCurrentUser(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	if err := s.db.ForgetUser(ctx, req.UserId); err != nil {
+		return nil, status.Error(codes.Internal, "failed to forget user")
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func ensureCurrentUser(ctx context.Context, userID string) error {
+	currentUserID, ok := ctxdata.GetUserID(ctx)
+	if !ok {
+		return StatusUnauthenticated
+	}
+	if err := uuid.Validate(userID); err != nil {
+		return status.Error(codes.InvalidArgument, "invalid ID")
+	}
+	if userID != currentUserID {
+		return StatusPermissionDenied
+	}
+	return nil
+}
@@ -17,6 +17,11 @@ var (
 	ErrInvalidPair      = errors.New("tutor and student are not connected")
 	ErrNotTutor         = errors.New("user is not a tutor")
 
+	ErrPricingRuleNotFound  = errors.New("pricing rule not found")
+	ErrDiscountCodeNotFound = errors.New("discount code not found")
+	ErrDiscountCodeExists   = errors.New("discount code already exists")
+	ErrDiscountCodeUsedUp   = errors.New("discount code is used up")
+
 	StatusUnauthenticated  = status.Error(codes.Unauthenticated, "user not authenticated")
 	StatusPermissionDenied = status.Error(codes.PermissionDenied, "permission denied")
 	StatusNotFound         = status.Error(codes.NotFound, "lesson not found")
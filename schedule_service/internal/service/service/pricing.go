@@ -0,0 +1,419 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"time"
+
+	"common_library/ctxdata"
+	"common_library/money"
+	"schedule_service/internal/database/repo"
+	"schedule_service/internal/pricing"
+	pb "schedule_service/pkg/api"
+	userpb "userservice/pkg/api"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var discountCodePattern = regexp.MustCompile(`^[A-Z0-9_-]{3,32}$`)
+
+func (s *ScheduleServer) QuotePrice(ctx context.Context, req *pb.QuotePriceRequest) (*pb.PriceQuote, error) {
+	userID, ok := ctxdata.GetUserID(ctx)
+	if !ok {
+		return nil, StatusUnauthenticated
+	}
+	if err := uuid.Validate(req.SlotId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid ID")
+	}
+	if err := uuid.Validate(req.StudentId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid ID")
+	}
+
+	slot, err := s.db.GetSlot(ctx, req.SlotId)
+	if err != nil {
+		if errors.Is(err, ErrSlotNotFound) {
+			return nil, status.Error(codes.NotFound, "slot not found")
+		}
+		return nil, StatusInternalError
+	}
+
+	if userID != slot.TutorID && userID != req.StudentId {
+		return nil, StatusPermissionDenied
+	}
+
+	isValidPair, err := s.ValidateTutorStudentPair(ctx, slot.TutorID, req.StudentId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to validate tutor-student relationship: "+err.Error())
+	}
+	if !isValidPair {
+		return nil, status.Error(codes.FailedPrecondition, "tutor and student are not connected")
+	}
+
+	quote, _, err := s.quotePrice(ctx, slot, req.StudentId, req.DiscountCode)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.PriceQuote{Breakdown: toPbPriceComponents(quote.Breakdown)}
+	if quote.Price != nil {
+		resp.Price = toPbMoney(*quote.Price)
+	}
+	return resp, nil
+}
+
+// quotePrice computes the price of booking the slot by the student. The discount code is returned
+// so the booking can use it.
+func (s *ScheduleServer) quotePrice(ctx context.Context, slot *repo.Slot, studentID string, discountCode *string) (pricing.Quote, *repo.DiscountCode, error) {
+	input := pricing.Input{
+		StudentID: studentID,
+		Duration:  slot.EndsAt.Sub(slot.StartsAt),
+	}
+
+	if discountCode != nil {
+		code, err := s.db.GetDiscountCodeByCode(ctx, slot.TutorID, pricing.NormalizeCode(*discountCode))
+		if err != nil {
+			if errors.Is(err, ErrDiscountCodeNotFound) {
+				return pricing.Quote{}, nil, status.Error(codes.InvalidArgument, "invalid discount code")
+			}
+			return pricing.Quote{}, nil, StatusInternalError
+		}
+		if err := pricing.CheckDiscountCode(code, time.Now()); err != nil {
+			return pricing.Quote{}, nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		input.Code = code
+	}
+
+	rules, err := s.db.ListPricingRules(ctx, slot.TutorID)
+	if err != nil {
+		return pricing.Quote{}, nil, StatusInternalError
+	}
+	input.Rules = rules
+
+	lessons, err := s.db.ListLessonsByPair(ctx, slot.TutorID, studentID, []string{"booked", "completed"})
+	if err != nil {
+		return pricing.Quote{}, nil, StatusInternalError
+	}
+	input.FirstLesson = len(lessons) == 0
+
+	reqCtx, err := outgoingUserContext(ctx)
+	if err != nil {
+		return pricing.Quote{}, nil, StatusUnauthenticated
+	}
+	tutorStudent, err := s.UserClient.GetTutorStudent(reqCtx, slot.TutorID, studentID)
+	if err != nil {
+		return pricing.Quote{}, nil, status.Error(codes.Internal, "failed to get tutor-student relationship")
+	}
+	if price := tutorStudent.GetLessonPrice(); price != nil {
+		input.PairPrice, err = parseMoney(price.GetAmountMinor(), price.GetCurrency())
+	} else {
+		// the context falls back to the tutor default when the pair has no price
+		var resolved *userpb.ResolvedTutorStudentContext
+		resolved, err = s.UserClient.ResolveTutorStudentContext(reqCtx, slot.TutorID, studentID)
+		if err != nil {
+			return pricing.Quote{}, nil, status.Error(codes.Internal, "failed to get tutor price")
+		}
+		if price := resolved.GetLessonPrice(); price != nil {
+			input.TutorPrice, err = parseMoney(price.GetAmountMinor(), price.GetCurrency())
+		}
+	}
+	if err != nil {
+		return pricing.Quote{}, nil, status.Error(codes.Internal, "invalid lesson price: "+err.Error())
+	}
+
+	return pricing.Compute(input), input.Code, nil
+}
+
+func (s *ScheduleServer) CreatePricingRule(ctx context.Context, req *pb.CreatePricingRuleRequest) (*pb.PricingRule, error) {
+	userID, ok := ctxdata.GetUserID(ctx)
+	if !ok {
+		return nil, StatusUnauthenticated
+	}
+
+	isTutor, err := IsTutor(ctx, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to verify tutor status")
+	}
+	if !isTutor {
+		return nil, status.Error(codes.PermissionDenied, "only tutors can create pricing rules")
+	}
+
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	rule := repo.PricingRule{
+		ID:        uuid.New().String(),
+		TutorID:   userID,
+		Kind:      repo.PricingRuleKind(req.Kind),
+		Name:      req.Name,
+		CreatedAt: time.Now(),
+	}
+
+	switch rule.Kind {
+	case repo.PricingRuleDuration:
+		if req.GetDurationMinutes() <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "duration_minutes must be positive")
+		}
+		if req.Price == nil {
+			return nil, status.Error(codes.InvalidArgument, "price is required")
+		}
+		price, err := parseMoney(req.Price.GetAmountMinor(), req.Price.GetCurrency())
+		if err != nil || price.Amount < 0 {
+			return nil, status.Error(codes.InvalidArgument, "invalid price")
+		}
+		rule.DurationMinutes = req.DurationMinutes
+		rule.Price = price
+	case repo.PricingRuleDiscount:
+		if req.GetPercent() < 1 || req.GetPercent() > 100 {
+			return nil, status.Error(codes.InvalidArgument, "percent must be between 1 and 100")
+		}
+		rule.Percent = req.Percent
+	case repo.PricingRuleFirstLessonFree:
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid kind")
+	}
+
+	if req.StudentId != nil {
+		if err := uuid.Validate(*req.StudentId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid ID")
+		}
+		isValidPair, err := s.ValidateTutorStudentPair(ctx, userID, *req.StudentId)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to validate tutor-student relationship: "+err.Error())
+		}
+		if !isValidPair {
+			return nil, status.Error(codes.FailedPrecondition, "tutor and student are not connected")
+		}
+		rule.StudentID = req.StudentId
+	}
+
+	if err := s.db.CreatePricingRule(ctx, rule); err != nil {
+		return nil, status.Error(codes.Internal, "failed to create pricing rule")
+	}
+
+	return toPbPricingRule(&rule), nil
+}
+
+func (s *ScheduleServer) ListPricingRules(ctx context.Context, req *pb.ListPricingRulesRequest) (*pb.ListPricingRulesResponse, error) {
+	userID, ok := ctxdata.GetUserID(ctx)
+	if !ok {
+		return nil, StatusUnauthenticated
+	}
+	if req.TutorId != userID {
+		return nil, StatusPermissionDenied
+	}
+
+	rules, err := s.db.ListPricingRules(ctx, req.TutorId)
+	if err != nil {
+		return nil, StatusInternalError
+	}
+
+	resp := &pb.ListPricingRulesResponse{Rules: make([]*pb.PricingRule, 0, len(rules))}
+	for i := range rules {
+		resp.Rules = append(resp.Rules, toPbPricingRule(&rules[i]))
+	}
+	return resp, nil
+}
+
+func (s *ScheduleServer) DeletePricingRule(ctx context.Context, req *pb.DeletePricingRuleRequest) (*pb.Empty, error) {
+	userID, ok := ctxdata.GetUserID(ctx)
+	if !ok {
+		return nil, StatusUnauthenticated
+	}
+	if err := uuid.Validate(req.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid ID")
+	}
+
+	rule, err := s.db.GetPricingRule(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, ErrPricingRuleNotFound) {
+			return nil, status.Error(codes.NotFound, "pricing rule not found")
+		}
+		return nil, StatusInternalError
+	}
+	if rule.TutorID != userID {
+		return nil, StatusPermissionDenied
+	}
+
+	if err := s.db.DeletePricingRule(ctx, req.Id); err != nil && !errors.Is(err, ErrPricingRuleNotFound) {
+		return nil, status.Error(codes.Internal, "failed to delete pricing rule")
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (s *ScheduleServer) CreateDiscountCode(ctx context.Context, req *pb.CreateDiscountCodeRequest) (*pb.DiscountCode, error) {
+	userID, ok := ctxdata.GetUserID(ctx)
+	if !ok {
+		return nil, StatusUnauthenticated
+	}
+
+	isTutor, err := IsTutor(ctx, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to verify tutor status")
+	}
+	if !isTutor {
+		return nil, status.Error(codes.PermissionDenied, "only tutors can create discount codes")
+	}
+
+	code := repo.DiscountCode{
+		ID:        uuid.New().String(),
+		TutorID:   userID,
+		Code:      pricing.NormalizeCode(req.Code),
+		Percent:   req.Percent,
+		MaxUses:   req.MaxUses,
+		CreatedAt: time.Now(),
+	}
+	if !discountCodePattern.MatchString(code.Code) {
+		return nil, status.Error(codes.InvalidArgument, "code must be 3 to 32 letters, digits, '-' or '_'")
+	}
+	if code.Percent < 1 || code.Percent > 100 {
+		return nil, status.Error(codes.InvalidArgument, "percent must be between 1 and 100")
+	}
+	if code.MaxUses != nil && *code.MaxUses <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "max_uses must be positive")
+	}
+	if req.ExpiresAt != nil {
+		expiresAt := req.ExpiresAt.AsTime()
+		if !expiresAt.After(code.CreatedAt) {
+			return nil, status.Error(codes.InvalidArgument, "expires_at must be in the future")
+		}
+		code.ExpiresAt = &expiresAt
+	}
+
+	if err := s.db.CreateDiscountCode(ctx, code); err != nil {
+		if errors.Is(err, ErrDiscountCodeExists) {
+			return nil, status.Error(codes.AlreadyExists, "discount code already exists")
+		}
+		return nil, status.Error(codes.Internal, "failed to create discount code")
+	}
+
+	return toPbDiscountCode(&code), nil
+}
+
+func (s *ScheduleServer) ListDiscountCodes(ctx context.Context, req *pb.ListDiscountCodesRequest) (*pb.ListDiscountCodesResponse, error) {
+	userID, ok := ctxdata.GetUserID(ctx)
+	if !ok {
+		return nil, StatusUnauthenticated
+	}
+	if req.TutorId != userID {
+		return nil, StatusPermissionDenied
+	}
+
+	discountCodes, err := s.db.ListDiscountCodes(ctx, req.TutorId)
+	if err != nil {
+		return nil, StatusInternalError
+	}
+
+	resp := &pb.ListDiscountCodesResponse{Codes: make([]*pb.DiscountCode, 0, len(discountCodes))}
+	for i := range discountCodes {
+		resp.Codes = append(resp.Codes, toPbDiscountCode(&discountCodes[i]))
+	}
+	return resp, nil
+}
+
+func (s *ScheduleServer) DeleteDiscountCode(ctx context.Context, req *pb.DeleteDiscountCodeRequest) (*pb.Empty, error) {
+	userID, ok := ctxdata.GetUserID(ctx)
+	if !ok {
+		return nil, StatusUnauthenticated
+	}
+	if err := uuid.Validate(req.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid ID")
+	}
+
+	code, err := s.db.GetDiscountCode(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, ErrDiscountCodeNotFound) {
+			return nil, status.Error(codes.NotFound, "discount code not found")
+		}
+		return nil, StatusInternalError
+	}
+	if code.TutorID != userID {
+		return nil, StatusPermissionDenied
+	}
+
+	if err := s.db.DeleteDiscountCode(ctx, req.Id); err != nil && !errors.Is(err, ErrDiscountCodeNotFound) {
+		return nil, status.Error(codes.Internal, "failed to delete discount code")
+	}
+
+	return &pb.Empty{}, nil
+}
+
+// outgoingUserContext passes the caller to user_service.
+func outgoingUserContext(ctx context.Context) (context.Context, error) {
+	userID, ok := ctxdata.GetUserID(ctx)
+	if !ok {
+		return nil, errors.New("user ID not found in context")
+	}
+	role, ok := ctxdata.GetUserRole(ctx)
+	if !ok {
+		return nil, errors.New("user role not found in context")
+	}
+	return metadata.NewOutgoingContext(ctx, metadata.Pairs("x-user-id", userID, "x-user-role", role)), nil
+}
+
+func toPbMoney(m money.Money) *pb.Money {
+	return &pb.Money{AmountMinor: m.Amount, Currency: m.Currency.String()}
+}
+
+func parseMoney(amountMinor int64, currencyCode string) (*money.Money, error) {
+	currency, err := money.ParseCurrency(currencyCode)
+	if err != nil {
+		return nil, err
+	}
+	price := money.New(amountMinor, currency)
+	return &price, nil
+}
+
+func toPbPriceComponents(components []repo.PriceComponent) []*pb.PriceComponent {
+	if len(components) == 0 {
+		return nil
+	}
+	result := make([]*pb.PriceComponent, len(components))
+	for i, component := range components {
+		result[i] = &pb.PriceComponent{
+			Kind:        component.Kind,
+			Description: component.Description,
+			Amount:      toPbMoney(component.Amount),
+		}
+	}
+	return result
+}
+
+func toPbPricingRule(rule *repo.PricingRule) *pb.PricingRule {
+	pbRule := &pb.PricingRule{
+		Id:              rule.ID,
+		TutorId:         rule.TutorID,
+		StudentId:       rule.StudentID,
+		Kind:            string(rule.Kind),
+		Name:            rule.Name,
+		DurationMinutes: rule.DurationMinutes,
+		Percent:         rule.Percent,
+		CreatedAt:       timestamppb.New(rule.CreatedAt),
+	}
+	if rule.Price != nil {
+		pbRule.Price = toPbMoney(*rule.Price)
+	}
+	return pbRule
+}
+
+func toPbDiscountCode(code *repo.DiscountCode) *pb.DiscountCode {
+	pbCode := &pb.DiscountCode{
+		Id:        code.ID,
+		TutorId:   code.TutorID,
+		Code:      code.Code,
+		Percent:   code.Percent,
+		MaxUses:   code.MaxUses,
+		UsedCount: code.UsedCount,
+		CreatedAt: timestamppb.New(code.CreatedAt),
+	}
+	if code.ExpiresAt != nil {
+		pbCode.ExpiresAt = timestamppb.New(*code.ExpiresAt)
+	}
+	return pbCode
+}
@@ -293,7 +293,10 @@ func (s *ScheduleServer) GetLesson(ctx context.Context, req *pb.GetLessonRequest
 	}
 
 	if userID != slot.TutorID && userID != lesson.StudentID {
-		return nil, StatusPermissionDenied
+		isGuardian, err := s.IsGuardianOf(ctx, lesson.StudentID)
+		if err != nil || !isGuardian {
+			return nil, StatusPermissionDenied
+		}
 	}
 
 	return convertrepoLessonToProto(lesson), nil
@@ -343,32 +346,41 @@ func (s *ScheduleServer) CreateLesson(ctx context.Context, req *pb.CreateLessonR
 		return nil, status.Error(codes.FailedPrecondition, "tutor and student are not connected")
 	}
 
+	quote, discountCode, err := s.quotePrice(ctx, slot, studentID, req.DiscountCode)
+	if err != nil {
+		return nil, err
+	}
+	var discountCodeID *string
+	if discountCode != nil {
+		discountCodeID = &discountCode.ID
+	}
+
 	lessonID := uuid.New().String()
 	now := time.Now()
 
 	lesson := repo.Lesson{
-		ID:        lessonID,
-		SlotID:    req.SlotId,
-		StudentID: studentID,
-		Status:    "booked",
-		IsPaid:    false,
-		CreatedAt: now,
-		EditedAt:  now,
-	}
-
-	if err := s.db.CreateLessonAndBookSlot(ctx, lesson, req.SlotId); err != nil {
+		ID:             lessonID,
+		SlotID:         req.SlotId,
+		TutorID:        tutorID,
+		StartsAt:       slot.StartsAt,
+		EndsAt:         slot.EndsAt,
+		StudentID:      studentID,
+		Status:         "booked",
+		IsPaid:         false,
+		Price:          quote.Price,
+		PriceBreakdown: quote.Breakdown,
+		CreatedAt:      now,
+		EditedAt:       now,
+	}
+
+	if err := s.db.CreateLessonAndBookSlot(ctx, lesson, req.SlotId, discountCodeID); err != nil {
+		if errors.Is(err, ErrDiscountCodeUsedUp) {
+			return nil, status.Error(codes.InvalidArgument, "discount code is used up")
+		}
 		return nil, status.Error(codes.Internal, "failed to create lesson")
 	}
 
-	return &pb.Lesson{
-		Id:        lessonID,
-		SlotId:    req.SlotId,
-		StudentId: studentID,
-		Status:    "booked",
-		IsPaid:    false,
-		CreatedAt: timestamppb.New(now),
-		EditedAt:  timestamppb.New(now),
-	}, nil
+	return convertrepoLessonToProto(&lesson), nil
 }
 
 func (s *ScheduleServer) UpdateLesson(ctx context.Context, req *pb.UpdateLessonRequest) (*pb.Lesson, error) {
@@ -405,8 +417,14 @@ func (s *ScheduleServer) UpdateLesson(ctx context.Context, req *pb.UpdateLessonR
 		isUpdated = true
 	}
 
-	if req.PriceRub != nil {
-		lesson.PriceRub = req.PriceRub
+	if req.Price != nil {
+		price, err := parseMoney(req.Price.GetAmountMinor(), req.Price.GetCurrency())
+		if err != nil || price.Amount < 0 {
+			return nil, status.Error(codes.InvalidArgument, "invalid price")
+		}
+		lesson.Price = price
+		// the price set by the tutor replaces the computed one
+		lesson.PriceBreakdown = nil
 		isUpdated = true
 	}
 
@@ -448,12 +466,19 @@ func (s *ScheduleServer) CancelLesson(ctx context.Context, req *pb.CancelLessonR
 	}
 
 	if userID != slot.TutorID && userID != lesson.StudentID {
-		return nil, StatusPermissionDenied
+		if !IsAdmin(ctx) {
+			return nil, StatusPermissionDenied
+		}
+		// admins force-cancel lessons, the action is recorded before it is done
+		if err := s.RecordAdminAction(ctx, "cancel_lesson", "lesson", lesson.ID, req.Reason); err != nil {
+			return nil, status.Error(codes.Internal, "failed to record admin action")
+		}
 	}
 
 	now := time.Now()
 	lesson.Status = "cancelled"
 	lesson.EditedAt = now
+	lesson.CancelledAt = &now
 
 	if err := s.db.CancelLessonAndFreeSlot(ctx, *lesson, lesson.SlotID); err != nil {
 		return nil, status.Error(codes.Internal, "failed to cancel lesson")
@@ -502,7 +527,10 @@ func (s *ScheduleServer) ListLessonsByStudent(ctx context.Context, req *pb.ListL
 	}
 
 	if req.StudentId != userID {
-		return nil, StatusPermissionDenied
+		isGuardian, err := s.IsGuardianOf(ctx, req.StudentId)
+		if err != nil || !isGuardian {
+			return nil, StatusPermissionDenied
+		}
 	}
 
 	statusFilters := make([]string, 0, len(req.StatusFilter))
@@ -535,10 +563,13 @@ func (s *ScheduleServer) ListLessonsByPair(ctx context.Context, req *pb.ListLess
 	}
 
 	if req.TutorId != userID && req.StudentId != userID {
-		return nil, StatusPermissionDenied
+		isGuardian, err := s.IsGuardianOf(ctx, req.StudentId)
+		if err != nil || !isGuardian {
+			return nil, StatusPermissionDenied
+		}
 	}
 
-	isValidPair, err := s.ValidateTutorStudentPair(ctx, req.TutorId, req.StudentId)
+	isValidPair, err := s.ValidateTutorStudentHistory(ctx, req.TutorId, req.StudentId)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to validate tutor-student relationship")
 	}
@@ -587,7 +618,11 @@ func (s *ScheduleServer) ListCompletedUnpaidLessons(ctx context.Context, req *pb
 	return createListLessonsResponse(lessons), nil
 }
 
-func (s *ScheduleServer) MarkAsPaid(ctx context.Context, req *pb.MarkAsPaidRequest) (*pb.Lesson, error) {
+// GetLessonInternal returns any lesson to a service acting on its own, e.g. a background job without a user.
+func (s *ScheduleServer) GetLessonInternal(ctx context.Context, req *pb.GetLessonRequest) (*pb.Lesson, error) {
+	if _, ok := ctxdata.GetCallerService(ctx); !ok {
+		return nil, StatusPermissionDenied
+	}
 	if err := uuid.Validate(req.Id); err != nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid ID")
 	}
@@ -600,11 +635,66 @@ func (s *ScheduleServer) MarkAsPaid(ctx context.Context, req *pb.MarkAsPaidReque
 		return nil, StatusInternalError
 	}
 
-	if err := s.db.MarkAsPaid(ctx, lesson.ID); err != nil {
-		return nil, err
+	return convertrepoLessonToProto(lesson), nil
+}
+
+// MarkAsPaid marks the lesson as paid by the payment. Only payment_service calls it.
+func (s *ScheduleServer) MarkAsPaid(ctx context.Context, req *pb.MarkAsPaidRequest) (*pb.Lesson, error) {
+	if _, ok := ctxdata.GetCallerService(ctx); !ok {
+		return nil, StatusPermissionDenied
+	}
+	if err := uuid.Validate(req.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid ID")
+	}
+	if err := uuid.Validate(req.PaymentId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid payment ID")
 	}
-	lesson.IsPaid = true
 
+	if _, err := s.db.GetLesson(ctx, req.Id); err != nil {
+		if errors.Is(err, ErrLessonNotFound) {
+			return nil, StatusNotFound
+		}
+		return nil, StatusInternalError
+	}
+
+	if err := s.db.MarkAsPaid(ctx, req.Id, req.PaymentId); err != nil {
+		return nil, StatusInternalError
+	}
+
+	lesson, err := s.db.GetLesson(ctx, req.Id)
+	if err != nil {
+		return nil, StatusInternalError
+	}
 	return convertrepoLessonToProto(lesson), nil
+}
+
+// MarkAsUnpaid reverts MarkAsPaid of the same payment, a lesson paid by another payment stays paid.
+// Only payment_service calls it.
+func (s *ScheduleServer) MarkAsUnpaid(ctx context.Context, req *pb.MarkAsUnpaidRequest) (*pb.Lesson, error) {
+	if _, ok := ctxdata.GetCallerService(ctx); !ok {
+		return nil, StatusPermissionDenied
+	}
+	if err := uuid.Validate(req.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid ID")
+	}
+	if err := uuid.Validate(req.PaymentId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid payment ID")
+	}
+
+	if _, err := s.db.GetLesson(ctx, req.Id); err != nil {
+		if errors.Is(err, ErrLessonNotFound) {
+			return nil, StatusNotFound
+		}
+		return nil, StatusInternalError
+	}
 
+	if err := s.db.MarkAsUnpaid(ctx, req.Id, req.PaymentId); err != nil {
+		return nil, StatusInternalError
+	}
+
+	lesson, err := s.db.GetLesson(ctx, req.Id)
+	if err != nil {
+		return nil, StatusInternalError
+	}
+	return convertrepoLessonToProto(lesson), nil
 }
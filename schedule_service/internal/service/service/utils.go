@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"schedule_service/internal/database/repo"
 	pb "schedule_service/pkg/api"
+	"slices"
 	"time"
 
 	"google.golang.org/grpc/metadata"
@@ -23,6 +24,7 @@ import (
 type UserClient struct {
 	conn   *grpc.ClientConn
 	client userpb.UserServiceClient
+	admin  userpb.AdminServiceClient
 }
 
 func NewUserClient(adress string) (*UserClient, error) {
@@ -33,6 +35,7 @@ func NewUserClient(adress string) (*UserClient, error) {
 	return &UserClient{
 		conn:   conn,
 		client: userpb.NewUserServiceClient(conn),
+		admin:  userpb.NewAdminServiceClient(conn),
 	}, nil
 
 }
@@ -47,28 +50,60 @@ func (c *UserClient) GetTutorStudent(ctx context.Context, tutorID, studentID str
 	})
 }
 
+func (c *UserClient) ResolveTutorStudentContext(ctx context.Context, tutorID, studentID string) (*userpb.ResolvedTutorStudentContext, error) {
+	return c.client.ResolveTutorStudentContext(ctx, &userpb.ResolveTutorStudentContextRequest{
+		TutorId:   tutorID,
+		StudentId: studentID,
+	})
+}
+
+func (c *UserClient) GetGuardianStudent(ctx context.Context, guardianID, studentID string) (*userpb.GuardianStudent, error) {
+	return c.client.GetGuardianStudent(ctx, &userpb.GetGuardianStudentRequest{
+		GuardianId: guardianID,
+		StudentId:  studentID,
+	})
+}
+
+func (c *UserClient) RecordAuditEvent(ctx context.Context, action, targetType, targetID string, details *string) error {
+	_, err := c.admin.RecordAuditEvent(ctx, &userpb.RecordAuditEventRequest{
+		Action:     action,
+		TargetType: targetType,
+		TargetId:   targetID,
+		Details:    details,
+	})
+	return err
+}
+
 func convertrepoLessonToProto(lesson *repo.Lesson) *pb.Lesson {
 	protoLesson := &pb.Lesson{
 		Id:        lesson.ID,
 		SlotId:    lesson.SlotID,
+		TutorId:   lesson.TutorID,
 		StudentId: lesson.StudentID,
 		Status:    lesson.Status,
 		IsPaid:    lesson.IsPaid,
 		CreatedAt: timestamppb.New(lesson.CreatedAt),
 		EditedAt:  timestamppb.New(lesson.EditedAt),
+		StartsAt:  timestamppb.New(lesson.StartsAt),
+		EndsAt:    timestamppb.New(lesson.EndsAt),
 	}
 
 	if lesson.ConnectionLink != nil {
 		protoLesson.ConnectionLink = lesson.ConnectionLink
 	}
 
-	if lesson.PriceRub != nil {
-		protoLesson.PriceRub = lesson.PriceRub
+	if lesson.Price != nil {
+		protoLesson.Price = toPbMoney(*lesson.Price)
 	}
+	protoLesson.PriceBreakdown = toPbPriceComponents(lesson.PriceBreakdown)
 
 	if lesson.PaymentInfo != nil {
 		protoLesson.PaymentInfo = lesson.PaymentInfo
 	}
+	protoLesson.PaymentId = lesson.PaymentID
+	if lesson.CancelledAt != nil {
+		protoLesson.CancelledAt = timestamppb.New(*lesson.CancelledAt)
+	}
 
 	return protoLesson
 }
@@ -91,7 +126,18 @@ func validateTimeRange(start, end time.Time) bool {
 	return start.Before(end)
 }
 
+// ValidateTutorStudentPair checks that the current user may act for the active pair.
+// Paused and archived pairs get no new lessons.
 func (s *ScheduleServer) ValidateTutorStudentPair(ctx context.Context, tutorID, studentID string) (bool, error) {
+	return s.validateTutorStudentPair(ctx, tutorID, studentID, "active")
+}
+
+// ValidateTutorStudentHistory also passes paused and archived pairs, their lessons stay readable.
+func (s *ScheduleServer) ValidateTutorStudentHistory(ctx context.Context, tutorID, studentID string) (bool, error) {
+	return s.validateTutorStudentPair(ctx, tutorID, studentID, "active", "paused", "archived")
+}
+
+func (s *ScheduleServer) validateTutorStudentPair(ctx context.Context, tutorID, studentID string, statuses ...string) (bool, error) {
 	currentUserID, ok := ctxdata.GetUserID(ctx)
 	if !ok {
 		return false, errors.New("user ID not found in context")
@@ -110,7 +156,7 @@ func (s *ScheduleServer) ValidateTutorStudentPair(ctx context.Context, tutorID,
 		}
 		return false, fmt.Errorf("failed to verify tutor-student pair: %w", err)
 	}
-	if tutorStudent.GetStatus() != "active" {
+	if !slices.Contains(statuses, tutorStudent.GetStatus()) {
 		return false, nil
 	}
 
@@ -119,11 +165,68 @@ func (s *ScheduleServer) ValidateTutorStudentPair(ctx context.Context, tutorID,
 		return currentUserID == tutorID, nil
 	case "student":
 		return currentUserID == studentID, nil
+	case "guardian":
+		// user service returns the pair only to active guardians of the student
+		return true, nil
 	default:
 		return false, nil
 	}
 
 }
+
+// IsGuardianOf checks that the current user is an active guardian of the student.
+// Guardians have read access to the lessons of the student.
+func (s *ScheduleServer) IsGuardianOf(ctx context.Context, studentID string) (bool, error) {
+	currentUserID, ok := ctxdata.GetUserID(ctx)
+	if !ok {
+		return false, errors.New("user ID not found in context")
+	}
+
+	currentUserRole, ok := ctxdata.GetUserRole(ctx)
+	if !ok {
+		return false, errors.New("user role not found in context")
+	}
+	if currentUserRole != "guardian" {
+		return false, nil
+	}
+
+	reqCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("x-user-id", currentUserID, "x-user-role", currentUserRole))
+	guardianStudent, err := s.UserClient.GetGuardianStudent(reqCtx, currentUserID, studentID)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to verify guardian of student: %w", err)
+	}
+
+	return guardianStudent.GetStatus() == "active", nil
+}
+
+// IsAdmin checks that the current user is an admin.
+func IsAdmin(ctx context.Context) bool {
+	role, ok := ctxdata.GetUserRole(ctx)
+	return ok && role == "admin"
+}
+
+// RecordAdminAction writes the action of the current admin to the audit log of user service.
+// The caller's own role is forwarded, user service rejects the event unless it is admin.
+func (s *ScheduleServer) RecordAdminAction(ctx context.Context, action, targetType, targetID string, details *string) error {
+	currentUserID, ok := ctxdata.GetUserID(ctx)
+	if !ok {
+		return errors.New("user ID not found in context")
+	}
+	currentUserRole, ok := ctxdata.GetUserRole(ctx)
+	if !ok {
+		return errors.New("user role not found in context")
+	}
+
+	reqCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("x-user-id", currentUserID, "x-user-role", currentUserRole))
+	if err := s.UserClient.RecordAuditEvent(reqCtx, action, targetType, targetID, details); err != nil {
+		return fmt.Errorf("failed to record admin action: %w", err)
+	}
+	return nil
+}
+
 func IsTutor(ctx context.Context, userID string) (bool, error) {
 	currentUserID, ok := ctxdata.GetUserID(ctx)
 	if !ok {
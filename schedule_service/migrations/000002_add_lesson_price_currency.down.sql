@@ -0,0 +1,8 @@
+ALTER TABLE lessons DROP CONSTRAINT lessons_price_currency_check;
+ALTER TABLE lessons ADD COLUMN price_rub INTEGER;
+
+UPDATE lessons SET price_rub = price_minor / 100 WHERE price_minor IS NOT NULL;
+
+ALTER TABLE lessons DROP COLUMN price_currency;
+ALTER TABLE lessons DROP COLUMN price_minor;
+CREATE INDEX idx_lessons_price ON lessons(price_rub) WHERE price_rub IS NOT NULL;
\ No newline at end of file
@@ -0,0 +1,12 @@
+-- Цена урока в минимальных единицах валюты (копейках, центах)
+ALTER TABLE lessons ADD COLUMN price_minor BIGINT CHECK (price_minor >= 0);
+ALTER TABLE lessons ADD COLUMN price_currency CHAR(3);
+
+UPDATE lessons SET price_minor = price_rub::BIGINT * 100, price_currency = 'RUB'
+WHERE price_rub IS NOT NULL;
+
+DROP INDEX IF EXISTS idx_lessons_price;
+ALTER TABLE lessons DROP COLUMN price_rub;
+
+ALTER TABLE lessons ADD CONSTRAINT lessons_price_currency_check
+    CHECK ((price_minor IS NULL) = (price_currency IS NULL));
@@ -0,0 +1,4 @@
+ALTER TABLE lessons DROP COLUMN price_breakdown;
+
+DROP TABLE IF EXISTS discount_codes;
+DROP TABLE IF EXISTS pricing_rules;
\ No newline at end of file
@@ -0,0 +1,38 @@
+-- Правила цены репетитора: цена по длительности, скидка в процентах, первое занятие бесплатно.
+-- student_id IS NULL - правило для всех учеников репетитора
+CREATE TABLE IF NOT EXISTS pricing_rules (
+    id UUID PRIMARY KEY,
+    tutor_id UUID NOT NULL,
+    student_id UUID,
+    kind TEXT NOT NULL CHECK (kind IN ('duration', 'discount', 'first_lesson_free')),
+    name TEXT NOT NULL,
+    duration_minutes INTEGER CHECK (duration_minutes > 0),
+    price_minor BIGINT CHECK (price_minor >= 0),
+    price_currency CHAR(3),
+    percent INTEGER CHECK (percent BETWEEN 1 AND 100),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    CONSTRAINT pricing_rules_duration_check
+        CHECK (kind <> 'duration' OR (duration_minutes IS NOT NULL AND price_minor IS NOT NULL AND price_currency IS NOT NULL)),
+    CONSTRAINT pricing_rules_discount_check
+        CHECK (kind <> 'discount' OR percent IS NOT NULL)
+);
+
+CREATE INDEX idx_pricing_rules_tutor ON pricing_rules(tutor_id);
+
+-- Промокоды репетитора на скидку в процентах
+CREATE TABLE IF NOT EXISTS discount_codes (
+    id UUID PRIMARY KEY,
+    tutor_id UUID NOT NULL,
+    code TEXT NOT NULL,
+    percent INTEGER NOT NULL CHECK (percent BETWEEN 1 AND 100),
+    expires_at TIMESTAMP WITH TIME ZONE,
+    max_uses INTEGER CHECK (max_uses > 0),
+    used_count INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    CONSTRAINT unique_tutor_discount_code UNIQUE (tutor_id, code)
+);
+
+-- Из чего сложилась цена урока при записи
+ALTER TABLE lessons ADD COLUMN price_breakdown JSONB;
@@ -0,0 +1 @@
+ALTER TABLE lessons DROP COLUMN payment_id;
\ No newline at end of file
@@ -0,0 +1,2 @@
+-- Платеж payment_service (чек, онлайн-платеж или списание), которым оплачен урок
+ALTER TABLE lessons ADD COLUMN payment_id UUID;
@@ -0,0 +1 @@
+ALTER TABLE lessons DROP COLUMN cancelled_at;
\ No newline at end of file
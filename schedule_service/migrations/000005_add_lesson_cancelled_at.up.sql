@@ -0,0 +1,2 @@
+-- Время отмены урока; edited_at меняется при любой правке, поэтому для поздней отмены не подходит
+ALTER TABLE lessons ADD COLUMN cancelled_at TIMESTAMP WITH TIME ZONE;
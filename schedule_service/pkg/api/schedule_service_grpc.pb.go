@@ -29,10 +29,21 @@ const (
 	ScheduleService_UpdateLesson_FullMethodName               = "/schedule.v1.ScheduleService/UpdateLesson"
 	ScheduleService_CancelLesson_FullMethodName               = "/schedule.v1.ScheduleService/CancelLesson"
 	ScheduleService_MarkAsPaid_FullMethodName                 = "/schedule.v1.ScheduleService/MarkAsPaid"
+	ScheduleService_MarkAsUnpaid_FullMethodName               = "/schedule.v1.ScheduleService/MarkAsUnpaid"
 	ScheduleService_ListLessonsByTutor_FullMethodName         = "/schedule.v1.ScheduleService/ListLessonsByTutor"
 	ScheduleService_ListLessonsByStudent_FullMethodName       = "/schedule.v1.ScheduleService/ListLessonsByStudent"
 	ScheduleService_ListLessonsByPair_FullMethodName          = "/schedule.v1.ScheduleService/ListLessonsByPair"
+	ScheduleService_QuotePrice_FullMethodName                 = "/schedule.v1.ScheduleService/QuotePrice"
+	ScheduleService_CreatePricingRule_FullMethodName          = "/schedule.v1.ScheduleService/CreatePricingRule"
+	ScheduleService_ListPricingRules_FullMethodName           = "/schedule.v1.ScheduleService/ListPricingRules"
+	ScheduleService_DeletePricingRule_FullMethodName          = "/schedule.v1.ScheduleService/DeletePricingRule"
+	ScheduleService_CreateDiscountCode_FullMethodName         = "/schedule.v1.ScheduleService/CreateDiscountCode"
+	ScheduleService_ListDiscountCodes_FullMethodName          = "/schedule.v1.ScheduleService/ListDiscountCodes"
+	ScheduleService_DeleteDiscountCode_FullMethodName         = "/schedule.v1.ScheduleService/DeleteDiscountCode"
 	ScheduleService_ListCompletedUnpaidLessons_FullMethodName = "/schedule.v1.ScheduleService/ListCompletedUnpaidLessons"
+	ScheduleService_GetLessonInternal_FullMethodName          = "/schedule.v1.ScheduleService/GetLessonInternal"
+	ScheduleService_ExportUserData_FullMethodName             = "/schedule.v1.ScheduleService/ExportUserData"
+	ScheduleService_ForgetUser_FullMethodName                 = "/schedule.v1.ScheduleService/ForgetUser"
 )
 
 // ScheduleServiceClient is the client API for ScheduleService service.
@@ -51,11 +62,24 @@ type ScheduleServiceClient interface {
 	UpdateLesson(ctx context.Context, in *UpdateLessonRequest, opts ...grpc.CallOption) (*Lesson, error)
 	CancelLesson(ctx context.Context, in *CancelLessonRequest, opts ...grpc.CallOption) (*Lesson, error)
 	MarkAsPaid(ctx context.Context, in *MarkAsPaidRequest, opts ...grpc.CallOption) (*Lesson, error)
+	MarkAsUnpaid(ctx context.Context, in *MarkAsUnpaidRequest, opts ...grpc.CallOption) (*Lesson, error)
 	ListLessonsByTutor(ctx context.Context, in *ListLessonsByTutorRequest, opts ...grpc.CallOption) (*ListLessonsResponse, error)
 	ListLessonsByStudent(ctx context.Context, in *ListLessonsByStudentRequest, opts ...grpc.CallOption) (*ListLessonsResponse, error)
 	ListLessonsByPair(ctx context.Context, in *ListLessonsByPairRequest, opts ...grpc.CallOption) (*ListLessonsResponse, error)
+	// --- PRICING ---
+	QuotePrice(ctx context.Context, in *QuotePriceRequest, opts ...grpc.CallOption) (*PriceQuote, error)
+	CreatePricingRule(ctx context.Context, in *CreatePricingRuleRequest, opts ...grpc.CallOption) (*PricingRule, error)
+	ListPricingRules(ctx context.Context, in *ListPricingRulesRequest, opts ...grpc.CallOption) (*ListPricingRulesResponse, error)
+	DeletePricingRule(ctx context.Context, in *DeletePricingRuleRequest, opts ...grpc.CallOption) (*Empty, error)
+	CreateDiscountCode(ctx context.Context, in *CreateDiscountCodeRequest, opts ...grpc.CallOption) (*DiscountCode, error)
+	ListDiscountCodes(ctx context.Context, in *ListDiscountCodesRequest, opts ...grpc.CallOption) (*ListDiscountCodesResponse, error)
+	DeleteDiscountCode(ctx context.Context, in *DeleteDiscountCodeRequest, opts ...grpc.CallOption) (*Empty, error)
 	// --- INTERNAL ---
 	ListCompletedUnpaidLessons(ctx context.Context, in *ListCompletedUnpaidLessonsRequest, opts ...grpc.CallOption) (*ListLessonsResponse, error)
+	GetLessonInternal(ctx context.Context, in *GetLessonRequest, opts ...grpc.CallOption) (*Lesson, error)
+	// --- ACCOUNT DATA (called by user_service on behalf of the user) ---
+	ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*UserDataExport, error)
+	ForgetUser(ctx context.Context, in *ForgetUserRequest, opts ...grpc.CallOption) (*Empty, error)
 }
 
 type scheduleServiceClient struct {
@@ -166,6 +190,16 @@ func (c *scheduleServiceClient) MarkAsPaid(ctx context.Context, in *MarkAsPaidRe
 	return out, nil
 }
 
+func (c *scheduleServiceClient) MarkAsUnpaid(ctx context.Context, in *MarkAsUnpaidRequest, opts ...grpc.CallOption) (*Lesson, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Lesson)
+	err := c.cc.Invoke(ctx, ScheduleService_MarkAsUnpaid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *scheduleServiceClient) ListLessonsByTutor(ctx context.Context, in *ListLessonsByTutorRequest, opts ...grpc.CallOption) (*ListLessonsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListLessonsResponse)
@@ -196,6 +230,76 @@ func (c *scheduleServiceClient) ListLessonsByPair(ctx context.Context, in *ListL
 	return out, nil
 }
 
+func (c *scheduleServiceClient) QuotePrice(ctx context.Context, in *QuotePriceRequest, opts ...grpc.CallOption) (*PriceQuote, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PriceQuote)
+	err := c.cc.Invoke(ctx, ScheduleService_QuotePrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) CreatePricingRule(ctx context.Context, in *CreatePricingRuleRequest, opts ...grpc.CallOption) (*PricingRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PricingRule)
+	err := c.cc.Invoke(ctx, ScheduleService_CreatePricingRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) ListPricingRules(ctx context.Context, in *ListPricingRulesRequest, opts ...grpc.CallOption) (*ListPricingRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPricingRulesResponse)
+	err := c.cc.Invoke(ctx, ScheduleService_ListPricingRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) DeletePricingRule(ctx context.Context, in *DeletePricingRuleRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, ScheduleService_DeletePricingRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) CreateDiscountCode(ctx context.Context, in *CreateDiscountCodeRequest, opts ...grpc.CallOption) (*DiscountCode, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiscountCode)
+	err := c.cc.Invoke(ctx, ScheduleService_CreateDiscountCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) ListDiscountCodes(ctx context.Context, in *ListDiscountCodesRequest, opts ...grpc.CallOption) (*ListDiscountCodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDiscountCodesResponse)
+	err := c.cc.Invoke(ctx, ScheduleService_ListDiscountCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) DeleteDiscountCode(ctx context.Context, in *DeleteDiscountCodeRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, ScheduleService_DeleteDiscountCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *scheduleServiceClient) ListCompletedUnpaidLessons(ctx context.Context, in *ListCompletedUnpaidLessonsRequest, opts ...grpc.CallOption) (*ListLessonsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListLessonsResponse)
@@ -206,6 +310,36 @@ func (c *scheduleServiceClient) ListCompletedUnpaidLessons(ctx context.Context,
 	return out, nil
 }
 
+func (c *scheduleServiceClient) GetLessonInternal(ctx context.Context, in *GetLessonRequest, opts ...grpc.CallOption) (*Lesson, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Lesson)
+	err := c.cc.Invoke(ctx, ScheduleService_GetLessonInternal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*UserDataExport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserDataExport)
+	err := c.cc.Invoke(ctx, ScheduleService_ExportUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) ForgetUser(ctx context.Context, in *ForgetUserRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, ScheduleService_ForgetUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ScheduleServiceServer is the server API for ScheduleService service.
 // All implementations must embed UnimplementedScheduleServiceServer
 // for forward compatibility.
@@ -222,11 +356,24 @@ type ScheduleServiceServer interface {
 	UpdateLesson(context.Context, *UpdateLessonRequest) (*Lesson, error)
 	CancelLesson(context.Context, *CancelLessonRequest) (*Lesson, error)
 	MarkAsPaid(context.Context, *MarkAsPaidRequest) (*Lesson, error)
+	MarkAsUnpaid(context.Context, *MarkAsUnpaidRequest) (*Lesson, error)
 	ListLessonsByTutor(context.Context, *ListLessonsByTutorRequest) (*ListLessonsResponse, error)
 	ListLessonsByStudent(context.Context, *ListLessonsByStudentRequest) (*ListLessonsResponse, error)
 	ListLessonsByPair(context.Context, *ListLessonsByPairRequest) (*ListLessonsResponse, error)
+	// --- PRICING ---
+	QuotePrice(context.Context, *QuotePriceRequest) (*PriceQuote, error)
+	CreatePricingRule(context.Context, *CreatePricingRuleRequest) (*PricingRule, error)
+	ListPricingRules(context.Context, *ListPricingRulesRequest) (*ListPricingRulesResponse, error)
+	DeletePricingRule(context.Context, *DeletePricingRuleRequest) (*Empty, error)
+	CreateDiscountCode(context.Context, *CreateDiscountCodeRequest) (*DiscountCode, error)
+	ListDiscountCodes(context.Context, *ListDiscountCodesRequest) (*ListDiscountCodesResponse, error)
+	DeleteDiscountCode(context.Context, *DeleteDiscountCodeRequest) (*Empty, error)
 	// --- INTERNAL ---
 	ListCompletedUnpaidLessons(context.Context, *ListCompletedUnpaidLessonsRequest) (*ListLessonsResponse, error)
+	GetLessonInternal(context.Context, *GetLessonRequest) (*Lesson, error)
+	// --- ACCOUNT DATA (called by user_service on behalf of the user) ---
+	ExportUserData(context.Context, *ExportUserDataRequest) (*UserDataExport, error)
+	ForgetUser(context.Context, *ForgetUserRequest) (*Empty, error)
 	mustEmbedUnimplementedScheduleServiceServer()
 }
 
@@ -267,6 +414,9 @@ func (UnimplementedScheduleServiceServer) CancelLesson(context.Context, *CancelL
 func (UnimplementedScheduleServiceServer) MarkAsPaid(context.Context, *MarkAsPaidRequest) (*Lesson, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MarkAsPaid not implemented")
 }
+func (UnimplementedScheduleServiceServer) MarkAsUnpaid(context.Context, *MarkAsUnpaidRequest) (*Lesson, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkAsUnpaid not implemented")
+}
 func (UnimplementedScheduleServiceServer) ListLessonsByTutor(context.Context, *ListLessonsByTutorRequest) (*ListLessonsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListLessonsByTutor not implemented")
 }
@@ -276,9 +426,39 @@ func (UnimplementedScheduleServiceServer) ListLessonsByStudent(context.Context,
 func (UnimplementedScheduleServiceServer) ListLessonsByPair(context.Context, *ListLessonsByPairRequest) (*ListLessonsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListLessonsByPair not implemented")
 }
+func (UnimplementedScheduleServiceServer) QuotePrice(context.Context, *QuotePriceRequest) (*PriceQuote, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuotePrice not implemented")
+}
+func (UnimplementedScheduleServiceServer) CreatePricingRule(context.Context, *CreatePricingRuleRequest) (*PricingRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePricingRule not implemented")
+}
+func (UnimplementedScheduleServiceServer) ListPricingRules(context.Context, *ListPricingRulesRequest) (*ListPricingRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPricingRules not implemented")
+}
+func (UnimplementedScheduleServiceServer) DeletePricingRule(context.Context, *DeletePricingRuleRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePricingRule not implemented")
+}
+func (UnimplementedScheduleServiceServer) CreateDiscountCode(context.Context, *CreateDiscountCodeRequest) (*DiscountCode, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDiscountCode not implemented")
+}
+func (UnimplementedScheduleServiceServer) ListDiscountCodes(context.Context, *ListDiscountCodesRequest) (*ListDiscountCodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDiscountCodes not implemented")
+}
+func (UnimplementedScheduleServiceServer) DeleteDiscountCode(context.Context, *DeleteDiscountCodeRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDiscountCode not implemented")
+}
 func (UnimplementedScheduleServiceServer) ListCompletedUnpaidLessons(context.Context, *ListCompletedUnpaidLessonsRequest) (*ListLessonsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListCompletedUnpaidLessons not implemented")
 }
+func (UnimplementedScheduleServiceServer) GetLessonInternal(context.Context, *GetLessonRequest) (*Lesson, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLessonInternal not implemented")
+}
+func (UnimplementedScheduleServiceServer) ExportUserData(context.Context, *ExportUserDataRequest) (*UserDataExport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUserData not implemented")
+}
+func (UnimplementedScheduleServiceServer) ForgetUser(context.Context, *ForgetUserRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForgetUser not implemented")
+}
 func (UnimplementedScheduleServiceServer) mustEmbedUnimplementedScheduleServiceServer() {}
 func (UnimplementedScheduleServiceServer) testEmbeddedByValue()                         {}
 
@@ -480,6 +660,24 @@ func _ScheduleService_MarkAsPaid_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ScheduleService_MarkAsUnpaid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkAsUnpaidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).MarkAsUnpaid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_MarkAsUnpaid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).MarkAsUnpaid(ctx, req.(*MarkAsUnpaidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ScheduleService_ListLessonsByTutor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListLessonsByTutorRequest)
 	if err := dec(in); err != nil {
@@ -534,6 +732,132 @@ func _ScheduleService_ListLessonsByPair_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ScheduleService_QuotePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuotePriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).QuotePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_QuotePrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).QuotePrice(ctx, req.(*QuotePriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_CreatePricingRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePricingRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).CreatePricingRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_CreatePricingRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).CreatePricingRule(ctx, req.(*CreatePricingRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_ListPricingRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPricingRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).ListPricingRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_ListPricingRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).ListPricingRules(ctx, req.(*ListPricingRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_DeletePricingRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePricingRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).DeletePricingRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_DeletePricingRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).DeletePricingRule(ctx, req.(*DeletePricingRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_CreateDiscountCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDiscountCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).CreateDiscountCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_CreateDiscountCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).CreateDiscountCode(ctx, req.(*CreateDiscountCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_ListDiscountCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDiscountCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).ListDiscountCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_ListDiscountCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).ListDiscountCodes(ctx, req.(*ListDiscountCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_DeleteDiscountCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDiscountCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).DeleteDiscountCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_DeleteDiscountCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).DeleteDiscountCode(ctx, req.(*DeleteDiscountCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ScheduleService_ListCompletedUnpaidLessons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListCompletedUnpaidLessonsRequest)
 	if err := dec(in); err != nil {
@@ -552,6 +876,60 @@ func _ScheduleService_ListCompletedUnpaidLessons_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ScheduleService_GetLessonInternal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLessonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).GetLessonInternal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_GetLessonInternal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).GetLessonInternal(ctx, req.(*GetLessonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_ExportUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).ExportUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_ExportUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).ExportUserData(ctx, req.(*ExportUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_ForgetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForgetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).ForgetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_ForgetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).ForgetUser(ctx, req.(*ForgetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ScheduleService_ServiceDesc is the grpc.ServiceDesc for ScheduleService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -599,6 +977,10 @@ var ScheduleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MarkAsPaid",
 			Handler:    _ScheduleService_MarkAsPaid_Handler,
 		},
+		{
+			MethodName: "MarkAsUnpaid",
+			Handler:    _ScheduleService_MarkAsUnpaid_Handler,
+		},
 		{
 			MethodName: "ListLessonsByTutor",
 			Handler:    _ScheduleService_ListLessonsByTutor_Handler,
@@ -611,10 +993,50 @@ var ScheduleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListLessonsByPair",
 			Handler:    _ScheduleService_ListLessonsByPair_Handler,
 		},
+		{
+			MethodName: "QuotePrice",
+			Handler:    _ScheduleService_QuotePrice_Handler,
+		},
+		{
+			MethodName: "CreatePricingRule",
+			Handler:    _ScheduleService_CreatePricingRule_Handler,
+		},
+		{
+			MethodName: "ListPricingRules",
+			Handler:    _ScheduleService_ListPricingRules_Handler,
+		},
+		{
+			MethodName: "DeletePricingRule",
+			Handler:    _ScheduleService_DeletePricingRule_Handler,
+		},
+		{
+			MethodName: "CreateDiscountCode",
+			Handler:    _ScheduleService_CreateDiscountCode_Handler,
+		},
+		{
+			MethodName: "ListDiscountCodes",
+			Handler:    _ScheduleService_ListDiscountCodes_Handler,
+		},
+		{
+			MethodName: "DeleteDiscountCode",
+			Handler:    _ScheduleService_DeleteDiscountCode_Handler,
+		},
 		{
 			MethodName: "ListCompletedUnpaidLessons",
 			Handler:    _ScheduleService_ListCompletedUnpaidLessons_Handler,
 		},
+		{
+			MethodName: "GetLessonInternal",
+			Handler:    _ScheduleService_GetLessonInternal_Handler,
+		},
+		{
+			MethodName: "ExportUserData",
+			Handler:    _ScheduleService_ExportUserData_Handler,
+		},
+		{
+			MethodName: "ForgetUser",
+			Handler:    _ScheduleService_ForgetUser_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "schedule_service.proto",
@@ -12,7 +12,7 @@ package mock_schedule
 import (
 	context "context"
 	reflect "reflect"
-	api "schedule_service/pkg/api"
+	pkg "schedule_service/pkg/api"
 
 	gomock "go.uber.org/mock/gomock"
 	grpc "google.golang.org/grpc"
@@ -43,14 +43,14 @@ func (m *MockScheduleServiceClient) EXPECT() *MockScheduleServiceClientMockRecor
 }
 
 // CancelLesson mocks base method.
-func (m *MockScheduleServiceClient) CancelLesson(ctx context.Context, in *api.CancelLessonRequest, opts ...grpc.CallOption) (*api.Lesson, error) {
+func (m *MockScheduleServiceClient) CancelLesson(ctx context.Context, in *pkg.CancelLessonRequest, opts ...grpc.CallOption) (*pkg.Lesson, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "CancelLesson", varargs...)
-	ret0, _ := ret[0].(*api.Lesson)
+	ret0, _ := ret[0].(*pkg.Lesson)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -62,15 +62,35 @@ func (mr *MockScheduleServiceClientMockRecorder) CancelLesson(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelLesson", reflect.TypeOf((*MockScheduleServiceClient)(nil).CancelLesson), varargs...)
 }
 
+// CreateDiscountCode mocks base method.
+func (m *MockScheduleServiceClient) CreateDiscountCode(ctx context.Context, in *pkg.CreateDiscountCodeRequest, opts ...grpc.CallOption) (*pkg.DiscountCode, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateDiscountCode", varargs...)
+	ret0, _ := ret[0].(*pkg.DiscountCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDiscountCode indicates an expected call of CreateDiscountCode.
+func (mr *MockScheduleServiceClientMockRecorder) CreateDiscountCode(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDiscountCode", reflect.TypeOf((*MockScheduleServiceClient)(nil).CreateDiscountCode), varargs...)
+}
+
 // CreateLesson mocks base method.
-func (m *MockScheduleServiceClient) CreateLesson(ctx context.Context, in *api.CreateLessonRequest, opts ...grpc.CallOption) (*api.Lesson, error) {
+func (m *MockScheduleServiceClient) CreateLesson(ctx context.Context, in *pkg.CreateLessonRequest, opts ...grpc.CallOption) (*pkg.Lesson, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "CreateLesson", varargs...)
-	ret0, _ := ret[0].(*api.Lesson)
+	ret0, _ := ret[0].(*pkg.Lesson)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -82,15 +102,35 @@ func (mr *MockScheduleServiceClientMockRecorder) CreateLesson(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLesson", reflect.TypeOf((*MockScheduleServiceClient)(nil).CreateLesson), varargs...)
 }
 
+// CreatePricingRule mocks base method.
+func (m *MockScheduleServiceClient) CreatePricingRule(ctx context.Context, in *pkg.CreatePricingRuleRequest, opts ...grpc.CallOption) (*pkg.PricingRule, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreatePricingRule", varargs...)
+	ret0, _ := ret[0].(*pkg.PricingRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePricingRule indicates an expected call of CreatePricingRule.
+func (mr *MockScheduleServiceClientMockRecorder) CreatePricingRule(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePricingRule", reflect.TypeOf((*MockScheduleServiceClient)(nil).CreatePricingRule), varargs...)
+}
+
 // CreateSlot mocks base method.
-func (m *MockScheduleServiceClient) CreateSlot(ctx context.Context, in *api.CreateSlotRequest, opts ...grpc.CallOption) (*api.Slot, error) {
+func (m *MockScheduleServiceClient) CreateSlot(ctx context.Context, in *pkg.CreateSlotRequest, opts ...grpc.CallOption) (*pkg.Slot, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "CreateSlot", varargs...)
-	ret0, _ := ret[0].(*api.Slot)
+	ret0, _ := ret[0].(*pkg.Slot)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -102,15 +142,55 @@ func (mr *MockScheduleServiceClientMockRecorder) CreateSlot(ctx, in any, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSlot", reflect.TypeOf((*MockScheduleServiceClient)(nil).CreateSlot), varargs...)
 }
 
+// DeleteDiscountCode mocks base method.
+func (m *MockScheduleServiceClient) DeleteDiscountCode(ctx context.Context, in *pkg.DeleteDiscountCodeRequest, opts ...grpc.CallOption) (*pkg.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteDiscountCode", varargs...)
+	ret0, _ := ret[0].(*pkg.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDiscountCode indicates an expected call of DeleteDiscountCode.
+func (mr *MockScheduleServiceClientMockRecorder) DeleteDiscountCode(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDiscountCode", reflect.TypeOf((*MockScheduleServiceClient)(nil).DeleteDiscountCode), varargs...)
+}
+
+// DeletePricingRule mocks base method.
+func (m *MockScheduleServiceClient) DeletePricingRule(ctx context.Context, in *pkg.DeletePricingRuleRequest, opts ...grpc.CallOption) (*pkg.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeletePricingRule", varargs...)
+	ret0, _ := ret[0].(*pkg.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePricingRule indicates an expected call of DeletePricingRule.
+func (mr *MockScheduleServiceClientMockRecorder) DeletePricingRule(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePricingRule", reflect.TypeOf((*MockScheduleServiceClient)(nil).DeletePricingRule), varargs...)
+}
+
 // DeleteSlot mocks base method.
-func (m *MockScheduleServiceClient) DeleteSlot(ctx context.Context, in *api.DeleteSlotRequest, opts ...grpc.CallOption) (*api.Empty, error) {
+func (m *MockScheduleServiceClient) DeleteSlot(ctx context.Context, in *pkg.DeleteSlotRequest, opts ...grpc.CallOption) (*pkg.Empty, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "DeleteSlot", varargs...)
-	ret0, _ := ret[0].(*api.Empty)
+	ret0, _ := ret[0].(*pkg.Empty)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -122,15 +202,55 @@ func (mr *MockScheduleServiceClientMockRecorder) DeleteSlot(ctx, in any, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSlot", reflect.TypeOf((*MockScheduleServiceClient)(nil).DeleteSlot), varargs...)
 }
 
+// ExportUserData mocks base method.
+func (m *MockScheduleServiceClient) ExportUserData(ctx context.Context, in *pkg.ExportUserDataRequest, opts ...grpc.CallOption) (*pkg.UserDataExport, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExportUserData", varargs...)
+	ret0, _ := ret[0].(*pkg.UserDataExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportUserData indicates an expected call of ExportUserData.
+func (mr *MockScheduleServiceClientMockRecorder) ExportUserData(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportUserData", reflect.TypeOf((*MockScheduleServiceClient)(nil).ExportUserData), varargs...)
+}
+
+// ForgetUser mocks base method.
+func (m *MockScheduleServiceClient) ForgetUser(ctx context.Context, in *pkg.ForgetUserRequest, opts ...grpc.CallOption) (*pkg.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ForgetUser", varargs...)
+	ret0, _ := ret[0].(*pkg.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForgetUser indicates an expected call of ForgetUser.
+func (mr *MockScheduleServiceClientMockRecorder) ForgetUser(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForgetUser", reflect.TypeOf((*MockScheduleServiceClient)(nil).ForgetUser), varargs...)
+}
+
 // GetLesson mocks base method.
-func (m *MockScheduleServiceClient) GetLesson(ctx context.Context, in *api.GetLessonRequest, opts ...grpc.CallOption) (*api.Lesson, error) {
+func (m *MockScheduleServiceClient) GetLesson(ctx context.Context, in *pkg.GetLessonRequest, opts ...grpc.CallOption) (*pkg.Lesson, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "GetLesson", varargs...)
-	ret0, _ := ret[0].(*api.Lesson)
+	ret0, _ := ret[0].(*pkg.Lesson)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -142,15 +262,35 @@ func (mr *MockScheduleServiceClientMockRecorder) GetLesson(ctx, in any, opts ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLesson", reflect.TypeOf((*MockScheduleServiceClient)(nil).GetLesson), varargs...)
 }
 
+// GetLessonInternal mocks base method.
+func (m *MockScheduleServiceClient) GetLessonInternal(ctx context.Context, in *pkg.GetLessonRequest, opts ...grpc.CallOption) (*pkg.Lesson, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetLessonInternal", varargs...)
+	ret0, _ := ret[0].(*pkg.Lesson)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLessonInternal indicates an expected call of GetLessonInternal.
+func (mr *MockScheduleServiceClientMockRecorder) GetLessonInternal(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLessonInternal", reflect.TypeOf((*MockScheduleServiceClient)(nil).GetLessonInternal), varargs...)
+}
+
 // GetSlot mocks base method.
-func (m *MockScheduleServiceClient) GetSlot(ctx context.Context, in *api.GetSlotRequest, opts ...grpc.CallOption) (*api.Slot, error) {
+func (m *MockScheduleServiceClient) GetSlot(ctx context.Context, in *pkg.GetSlotRequest, opts ...grpc.CallOption) (*pkg.Slot, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "GetSlot", varargs...)
-	ret0, _ := ret[0].(*api.Slot)
+	ret0, _ := ret[0].(*pkg.Slot)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -163,14 +303,14 @@ func (mr *MockScheduleServiceClientMockRecorder) GetSlot(ctx, in any, opts ...an
 }
 
 // ListCompletedUnpaidLessons mocks base method.
-func (m *MockScheduleServiceClient) ListCompletedUnpaidLessons(ctx context.Context, in *api.ListCompletedUnpaidLessonsRequest, opts ...grpc.CallOption) (*api.ListLessonsResponse, error) {
+func (m *MockScheduleServiceClient) ListCompletedUnpaidLessons(ctx context.Context, in *pkg.ListCompletedUnpaidLessonsRequest, opts ...grpc.CallOption) (*pkg.ListLessonsResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "ListCompletedUnpaidLessons", varargs...)
-	ret0, _ := ret[0].(*api.ListLessonsResponse)
+	ret0, _ := ret[0].(*pkg.ListLessonsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -182,15 +322,35 @@ func (mr *MockScheduleServiceClientMockRecorder) ListCompletedUnpaidLessons(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCompletedUnpaidLessons", reflect.TypeOf((*MockScheduleServiceClient)(nil).ListCompletedUnpaidLessons), varargs...)
 }
 
+// ListDiscountCodes mocks base method.
+func (m *MockScheduleServiceClient) ListDiscountCodes(ctx context.Context, in *pkg.ListDiscountCodesRequest, opts ...grpc.CallOption) (*pkg.ListDiscountCodesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDiscountCodes", varargs...)
+	ret0, _ := ret[0].(*pkg.ListDiscountCodesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDiscountCodes indicates an expected call of ListDiscountCodes.
+func (mr *MockScheduleServiceClientMockRecorder) ListDiscountCodes(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDiscountCodes", reflect.TypeOf((*MockScheduleServiceClient)(nil).ListDiscountCodes), varargs...)
+}
+
 // ListLessonsByPair mocks base method.
-func (m *MockScheduleServiceClient) ListLessonsByPair(ctx context.Context, in *api.ListLessonsByPairRequest, opts ...grpc.CallOption) (*api.ListLessonsResponse, error) {
+func (m *MockScheduleServiceClient) ListLessonsByPair(ctx context.Context, in *pkg.ListLessonsByPairRequest, opts ...grpc.CallOption) (*pkg.ListLessonsResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "ListLessonsByPair", varargs...)
-	ret0, _ := ret[0].(*api.ListLessonsResponse)
+	ret0, _ := ret[0].(*pkg.ListLessonsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -203,14 +363,14 @@ func (mr *MockScheduleServiceClientMockRecorder) ListLessonsByPair(ctx, in any,
 }
 
 // ListLessonsByStudent mocks base method.
-func (m *MockScheduleServiceClient) ListLessonsByStudent(ctx context.Context, in *api.ListLessonsByStudentRequest, opts ...grpc.CallOption) (*api.ListLessonsResponse, error) {
+func (m *MockScheduleServiceClient) ListLessonsByStudent(ctx context.Context, in *pkg.ListLessonsByStudentRequest, opts ...grpc.CallOption) (*pkg.ListLessonsResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "ListLessonsByStudent", varargs...)
-	ret0, _ := ret[0].(*api.ListLessonsResponse)
+	ret0, _ := ret[0].(*pkg.ListLessonsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -223,14 +383,14 @@ func (mr *MockScheduleServiceClientMockRecorder) ListLessonsByStudent(ctx, in an
 }
 
 // ListLessonsByTutor mocks base method.
-func (m *MockScheduleServiceClient) ListLessonsByTutor(ctx context.Context, in *api.ListLessonsByTutorRequest, opts ...grpc.CallOption) (*api.ListLessonsResponse, error) {
+func (m *MockScheduleServiceClient) ListLessonsByTutor(ctx context.Context, in *pkg.ListLessonsByTutorRequest, opts ...grpc.CallOption) (*pkg.ListLessonsResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "ListLessonsByTutor", varargs...)
-	ret0, _ := ret[0].(*api.ListLessonsResponse)
+	ret0, _ := ret[0].(*pkg.ListLessonsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -242,15 +402,35 @@ func (mr *MockScheduleServiceClientMockRecorder) ListLessonsByTutor(ctx, in any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLessonsByTutor", reflect.TypeOf((*MockScheduleServiceClient)(nil).ListLessonsByTutor), varargs...)
 }
 
+// ListPricingRules mocks base method.
+func (m *MockScheduleServiceClient) ListPricingRules(ctx context.Context, in *pkg.ListPricingRulesRequest, opts ...grpc.CallOption) (*pkg.ListPricingRulesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPricingRules", varargs...)
+	ret0, _ := ret[0].(*pkg.ListPricingRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPricingRules indicates an expected call of ListPricingRules.
+func (mr *MockScheduleServiceClientMockRecorder) ListPricingRules(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPricingRules", reflect.TypeOf((*MockScheduleServiceClient)(nil).ListPricingRules), varargs...)
+}
+
 // ListSlotsByTutor mocks base method.
-func (m *MockScheduleServiceClient) ListSlotsByTutor(ctx context.Context, in *api.ListSlotsByTutorRequest, opts ...grpc.CallOption) (*api.ListSlotsResponse, error) {
+func (m *MockScheduleServiceClient) ListSlotsByTutor(ctx context.Context, in *pkg.ListSlotsByTutorRequest, opts ...grpc.CallOption) (*pkg.ListSlotsResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "ListSlotsByTutor", varargs...)
-	ret0, _ := ret[0].(*api.ListSlotsResponse)
+	ret0, _ := ret[0].(*pkg.ListSlotsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -262,15 +442,75 @@ func (mr *MockScheduleServiceClientMockRecorder) ListSlotsByTutor(ctx, in any, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSlotsByTutor", reflect.TypeOf((*MockScheduleServiceClient)(nil).ListSlotsByTutor), varargs...)
 }
 
+// MarkAsPaid mocks base method.
+func (m *MockScheduleServiceClient) MarkAsPaid(ctx context.Context, in *pkg.MarkAsPaidRequest, opts ...grpc.CallOption) (*pkg.Lesson, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MarkAsPaid", varargs...)
+	ret0, _ := ret[0].(*pkg.Lesson)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkAsPaid indicates an expected call of MarkAsPaid.
+func (mr *MockScheduleServiceClientMockRecorder) MarkAsPaid(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAsPaid", reflect.TypeOf((*MockScheduleServiceClient)(nil).MarkAsPaid), varargs...)
+}
+
+// MarkAsUnpaid mocks base method.
+func (m *MockScheduleServiceClient) MarkAsUnpaid(ctx context.Context, in *pkg.MarkAsUnpaidRequest, opts ...grpc.CallOption) (*pkg.Lesson, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MarkAsUnpaid", varargs...)
+	ret0, _ := ret[0].(*pkg.Lesson)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkAsUnpaid indicates an expected call of MarkAsUnpaid.
+func (mr *MockScheduleServiceClientMockRecorder) MarkAsUnpaid(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAsUnpaid", reflect.TypeOf((*MockScheduleServiceClient)(nil).MarkAsUnpaid), varargs...)
+}
+
+// QuotePrice mocks base method.
+func (m *MockScheduleServiceClient) QuotePrice(ctx context.Context, in *pkg.QuotePriceRequest, opts ...grpc.CallOption) (*pkg.PriceQuote, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "QuotePrice", varargs...)
+	ret0, _ := ret[0].(*pkg.PriceQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuotePrice indicates an expected call of QuotePrice.
+func (mr *MockScheduleServiceClientMockRecorder) QuotePrice(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuotePrice", reflect.TypeOf((*MockScheduleServiceClient)(nil).QuotePrice), varargs...)
+}
+
 // UpdateLesson mocks base method.
-func (m *MockScheduleServiceClient) UpdateLesson(ctx context.Context, in *api.UpdateLessonRequest, opts ...grpc.CallOption) (*api.Lesson, error) {
+func (m *MockScheduleServiceClient) UpdateLesson(ctx context.Context, in *pkg.UpdateLessonRequest, opts ...grpc.CallOption) (*pkg.Lesson, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "UpdateLesson", varargs...)
-	ret0, _ := ret[0].(*api.Lesson)
+	ret0, _ := ret[0].(*pkg.Lesson)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -283,14 +523,14 @@ func (mr *MockScheduleServiceClientMockRecorder) UpdateLesson(ctx, in any, opts
 }
 
 // UpdateSlot mocks base method.
-func (m *MockScheduleServiceClient) UpdateSlot(ctx context.Context, in *api.UpdateSlotRequest, opts ...grpc.CallOption) (*api.Slot, error) {
+func (m *MockScheduleServiceClient) UpdateSlot(ctx context.Context, in *pkg.UpdateSlotRequest, opts ...grpc.CallOption) (*pkg.Slot, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "UpdateSlot", varargs...)
-	ret0, _ := ret[0].(*api.Slot)
+	ret0, _ := ret[0].(*pkg.Slot)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -327,10 +567,10 @@ func (m *MockScheduleServiceServer) EXPECT() *MockScheduleServiceServerMockRecor
 }
 
 // CancelLesson mocks base method.
-func (m *MockScheduleServiceServer) CancelLesson(arg0 context.Context, arg1 *api.CancelLessonRequest) (*api.Lesson, error) {
+func (m *MockScheduleServiceServer) CancelLesson(arg0 context.Context, arg1 *pkg.CancelLessonRequest) (*pkg.Lesson, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CancelLesson", arg0, arg1)
-	ret0, _ := ret[0].(*api.Lesson)
+	ret0, _ := ret[0].(*pkg.Lesson)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -341,11 +581,26 @@ func (mr *MockScheduleServiceServerMockRecorder) CancelLesson(arg0, arg1 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelLesson", reflect.TypeOf((*MockScheduleServiceServer)(nil).CancelLesson), arg0, arg1)
 }
 
+// CreateDiscountCode mocks base method.
+func (m *MockScheduleServiceServer) CreateDiscountCode(arg0 context.Context, arg1 *pkg.CreateDiscountCodeRequest) (*pkg.DiscountCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDiscountCode", arg0, arg1)
+	ret0, _ := ret[0].(*pkg.DiscountCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDiscountCode indicates an expected call of CreateDiscountCode.
+func (mr *MockScheduleServiceServerMockRecorder) CreateDiscountCode(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDiscountCode", reflect.TypeOf((*MockScheduleServiceServer)(nil).CreateDiscountCode), arg0, arg1)
+}
+
 // CreateLesson mocks base method.
-func (m *MockScheduleServiceServer) CreateLesson(arg0 context.Context, arg1 *api.CreateLessonRequest) (*api.Lesson, error) {
+func (m *MockScheduleServiceServer) CreateLesson(arg0 context.Context, arg1 *pkg.CreateLessonRequest) (*pkg.Lesson, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateLesson", arg0, arg1)
-	ret0, _ := ret[0].(*api.Lesson)
+	ret0, _ := ret[0].(*pkg.Lesson)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -356,11 +611,26 @@ func (mr *MockScheduleServiceServerMockRecorder) CreateLesson(arg0, arg1 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLesson", reflect.TypeOf((*MockScheduleServiceServer)(nil).CreateLesson), arg0, arg1)
 }
 
+// CreatePricingRule mocks base method.
+func (m *MockScheduleServiceServer) CreatePricingRule(arg0 context.Context, arg1 *pkg.CreatePricingRuleRequest) (*pkg.PricingRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePricingRule", arg0, arg1)
+	ret0, _ := ret[0].(*pkg.PricingRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePricingRule indicates an expected call of CreatePricingRule.
+func (mr *MockScheduleServiceServerMockRecorder) CreatePricingRule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePricingRule", reflect.TypeOf((*MockScheduleServiceServer)(nil).CreatePricingRule), arg0, arg1)
+}
+
 // CreateSlot mocks base method.
-func (m *MockScheduleServiceServer) CreateSlot(arg0 context.Context, arg1 *api.CreateSlotRequest) (*api.Slot, error) {
+func (m *MockScheduleServiceServer) CreateSlot(arg0 context.Context, arg1 *pkg.CreateSlotRequest) (*pkg.Slot, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateSlot", arg0, arg1)
-	ret0, _ := ret[0].(*api.Slot)
+	ret0, _ := ret[0].(*pkg.Slot)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -371,11 +641,41 @@ func (mr *MockScheduleServiceServerMockRecorder) CreateSlot(arg0, arg1 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSlot", reflect.TypeOf((*MockScheduleServiceServer)(nil).CreateSlot), arg0, arg1)
 }
 
+// DeleteDiscountCode mocks base method.
+func (m *MockScheduleServiceServer) DeleteDiscountCode(arg0 context.Context, arg1 *pkg.DeleteDiscountCodeRequest) (*pkg.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDiscountCode", arg0, arg1)
+	ret0, _ := ret[0].(*pkg.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDiscountCode indicates an expected call of DeleteDiscountCode.
+func (mr *MockScheduleServiceServerMockRecorder) DeleteDiscountCode(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDiscountCode", reflect.TypeOf((*MockScheduleServiceServer)(nil).DeleteDiscountCode), arg0, arg1)
+}
+
+// DeletePricingRule mocks base method.
+func (m *MockScheduleServiceServer) DeletePricingRule(arg0 context.Context, arg1 *pkg.DeletePricingRuleRequest) (*pkg.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePricingRule", arg0, arg1)
+	ret0, _ := ret[0].(*pkg.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePricingRule indicates an expected call of DeletePricingRule.
+func (mr *MockScheduleServiceServerMockRecorder) DeletePricingRule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePricingRule", reflect.TypeOf((*MockScheduleServiceServer)(nil).DeletePricingRule), arg0, arg1)
+}
+
 // DeleteSlot mocks base method.
-func (m *MockScheduleServiceServer) DeleteSlot(arg0 context.Context, arg1 *api.DeleteSlotRequest) (*api.Empty, error) {
+func (m *MockScheduleServiceServer) DeleteSlot(arg0 context.Context, arg1 *pkg.DeleteSlotRequest) (*pkg.Empty, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteSlot", arg0, arg1)
-	ret0, _ := ret[0].(*api.Empty)
+	ret0, _ := ret[0].(*pkg.Empty)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -386,11 +686,41 @@ func (mr *MockScheduleServiceServerMockRecorder) DeleteSlot(arg0, arg1 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSlot", reflect.TypeOf((*MockScheduleServiceServer)(nil).DeleteSlot), arg0, arg1)
 }
 
+// ExportUserData mocks base method.
+func (m *MockScheduleServiceServer) ExportUserData(arg0 context.Context, arg1 *pkg.ExportUserDataRequest) (*pkg.UserDataExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportUserData", arg0, arg1)
+	ret0, _ := ret[0].(*pkg.UserDataExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportUserData indicates an expected call of ExportUserData.
+func (mr *MockScheduleServiceServerMockRecorder) ExportUserData(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportUserData", reflect.TypeOf((*MockScheduleServiceServer)(nil).ExportUserData), arg0, arg1)
+}
+
+// ForgetUser mocks base method.
+func (m *MockScheduleServiceServer) ForgetUser(arg0 context.Context, arg1 *pkg.ForgetUserRequest) (*pkg.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForgetUser", arg0, arg1)
+	ret0, _ := ret[0].(*pkg.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForgetUser indicates an expected call of ForgetUser.
+func (mr *MockScheduleServiceServerMockRecorder) ForgetUser(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForgetUser", reflect.TypeOf((*MockScheduleServiceServer)(nil).ForgetUser), arg0, arg1)
+}
+
 // GetLesson mocks base method.
-func (m *MockScheduleServiceServer) GetLesson(arg0 context.Context, arg1 *api.GetLessonRequest) (*api.Lesson, error) {
+func (m *MockScheduleServiceServer) GetLesson(arg0 context.Context, arg1 *pkg.GetLessonRequest) (*pkg.Lesson, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetLesson", arg0, arg1)
-	ret0, _ := ret[0].(*api.Lesson)
+	ret0, _ := ret[0].(*pkg.Lesson)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -401,11 +731,26 @@ func (mr *MockScheduleServiceServerMockRecorder) GetLesson(arg0, arg1 any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLesson", reflect.TypeOf((*MockScheduleServiceServer)(nil).GetLesson), arg0, arg1)
 }
 
+// GetLessonInternal mocks base method.
+func (m *MockScheduleServiceServer) GetLessonInternal(arg0 context.Context, arg1 *pkg.GetLessonRequest) (*pkg.Lesson, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLessonInternal", arg0, arg1)
+	ret0, _ := ret[0].(*pkg.Lesson)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLessonInternal indicates an expected call of GetLessonInternal.
+func (mr *MockScheduleServiceServerMockRecorder) GetLessonInternal(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLessonInternal", reflect.TypeOf((*MockScheduleServiceServer)(nil).GetLessonInternal), arg0, arg1)
+}
+
 // GetSlot mocks base method.
-func (m *MockScheduleServiceServer) GetSlot(arg0 context.Context, arg1 *api.GetSlotRequest) (*api.Slot, error) {
+func (m *MockScheduleServiceServer) GetSlot(arg0 context.Context, arg1 *pkg.GetSlotRequest) (*pkg.Slot, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetSlot", arg0, arg1)
-	ret0, _ := ret[0].(*api.Slot)
+	ret0, _ := ret[0].(*pkg.Slot)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -417,10 +762,10 @@ func (mr *MockScheduleServiceServerMockRecorder) GetSlot(arg0, arg1 any) *gomock
 }
 
 // ListCompletedUnpaidLessons mocks base method.
-func (m *MockScheduleServiceServer) ListCompletedUnpaidLessons(arg0 context.Context, arg1 *api.ListCompletedUnpaidLessonsRequest) (*api.ListLessonsResponse, error) {
+func (m *MockScheduleServiceServer) ListCompletedUnpaidLessons(arg0 context.Context, arg1 *pkg.ListCompletedUnpaidLessonsRequest) (*pkg.ListLessonsResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListCompletedUnpaidLessons", arg0, arg1)
-	ret0, _ := ret[0].(*api.ListLessonsResponse)
+	ret0, _ := ret[0].(*pkg.ListLessonsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -431,11 +776,26 @@ func (mr *MockScheduleServiceServerMockRecorder) ListCompletedUnpaidLessons(arg0
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCompletedUnpaidLessons", reflect.TypeOf((*MockScheduleServiceServer)(nil).ListCompletedUnpaidLessons), arg0, arg1)
 }
 
+// ListDiscountCodes mocks base method.
+func (m *MockScheduleServiceServer) ListDiscountCodes(arg0 context.Context, arg1 *pkg.ListDiscountCodesRequest) (*pkg.ListDiscountCodesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDiscountCodes", arg0, arg1)
+	ret0, _ := ret[0].(*pkg.ListDiscountCodesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDiscountCodes indicates an expected call of ListDiscountCodes.
+func (mr *MockScheduleServiceServerMockRecorder) ListDiscountCodes(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDiscountCodes", reflect.TypeOf((*MockScheduleServiceServer)(nil).ListDiscountCodes), arg0, arg1)
+}
+
 // ListLessonsByPair mocks base method.
-func (m *MockScheduleServiceServer) ListLessonsByPair(arg0 context.Context, arg1 *api.ListLessonsByPairRequest) (*api.ListLessonsResponse, error) {
+func (m *MockScheduleServiceServer) ListLessonsByPair(arg0 context.Context, arg1 *pkg.ListLessonsByPairRequest) (*pkg.ListLessonsResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListLessonsByPair", arg0, arg1)
-	ret0, _ := ret[0].(*api.ListLessonsResponse)
+	ret0, _ := ret[0].(*pkg.ListLessonsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -447,10 +807,10 @@ func (mr *MockScheduleServiceServerMockRecorder) ListLessonsByPair(arg0, arg1 an
 }
 
 // ListLessonsByStudent mocks base method.
-func (m *MockScheduleServiceServer) ListLessonsByStudent(arg0 context.Context, arg1 *api.ListLessonsByStudentRequest) (*api.ListLessonsResponse, error) {
+func (m *MockScheduleServiceServer) ListLessonsByStudent(arg0 context.Context, arg1 *pkg.ListLessonsByStudentRequest) (*pkg.ListLessonsResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListLessonsByStudent", arg0, arg1)
-	ret0, _ := ret[0].(*api.ListLessonsResponse)
+	ret0, _ := ret[0].(*pkg.ListLessonsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -462,10 +822,10 @@ func (mr *MockScheduleServiceServerMockRecorder) ListLessonsByStudent(arg0, arg1
 }
 
 // ListLessonsByTutor mocks base method.
-func (m *MockScheduleServiceServer) ListLessonsByTutor(arg0 context.Context, arg1 *api.ListLessonsByTutorRequest) (*api.ListLessonsResponse, error) {
+func (m *MockScheduleServiceServer) ListLessonsByTutor(arg0 context.Context, arg1 *pkg.ListLessonsByTutorRequest) (*pkg.ListLessonsResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListLessonsByTutor", arg0, arg1)
-	ret0, _ := ret[0].(*api.ListLessonsResponse)
+	ret0, _ := ret[0].(*pkg.ListLessonsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -476,11 +836,26 @@ func (mr *MockScheduleServiceServerMockRecorder) ListLessonsByTutor(arg0, arg1 a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLessonsByTutor", reflect.TypeOf((*MockScheduleServiceServer)(nil).ListLessonsByTutor), arg0, arg1)
 }
 
+// ListPricingRules mocks base method.
+func (m *MockScheduleServiceServer) ListPricingRules(arg0 context.Context, arg1 *pkg.ListPricingRulesRequest) (*pkg.ListPricingRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPricingRules", arg0, arg1)
+	ret0, _ := ret[0].(*pkg.ListPricingRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPricingRules indicates an expected call of ListPricingRules.
+func (mr *MockScheduleServiceServerMockRecorder) ListPricingRules(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPricingRules", reflect.TypeOf((*MockScheduleServiceServer)(nil).ListPricingRules), arg0, arg1)
+}
+
 // ListSlotsByTutor mocks base method.
-func (m *MockScheduleServiceServer) ListSlotsByTutor(arg0 context.Context, arg1 *api.ListSlotsByTutorRequest) (*api.ListSlotsResponse, error) {
+func (m *MockScheduleServiceServer) ListSlotsByTutor(arg0 context.Context, arg1 *pkg.ListSlotsByTutorRequest) (*pkg.ListSlotsResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListSlotsByTutor", arg0, arg1)
-	ret0, _ := ret[0].(*api.ListSlotsResponse)
+	ret0, _ := ret[0].(*pkg.ListSlotsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -491,11 +866,56 @@ func (mr *MockScheduleServiceServerMockRecorder) ListSlotsByTutor(arg0, arg1 any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSlotsByTutor", reflect.TypeOf((*MockScheduleServiceServer)(nil).ListSlotsByTutor), arg0, arg1)
 }
 
+// MarkAsPaid mocks base method.
+func (m *MockScheduleServiceServer) MarkAsPaid(arg0 context.Context, arg1 *pkg.MarkAsPaidRequest) (*pkg.Lesson, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAsPaid", arg0, arg1)
+	ret0, _ := ret[0].(*pkg.Lesson)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkAsPaid indicates an expected call of MarkAsPaid.
+func (mr *MockScheduleServiceServerMockRecorder) MarkAsPaid(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAsPaid", reflect.TypeOf((*MockScheduleServiceServer)(nil).MarkAsPaid), arg0, arg1)
+}
+
+// MarkAsUnpaid mocks base method.
+func (m *MockScheduleServiceServer) MarkAsUnpaid(arg0 context.Context, arg1 *pkg.MarkAsUnpaidRequest) (*pkg.Lesson, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAsUnpaid", arg0, arg1)
+	ret0, _ := ret[0].(*pkg.Lesson)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkAsUnpaid indicates an expected call of MarkAsUnpaid.
+func (mr *MockScheduleServiceServerMockRecorder) MarkAsUnpaid(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAsUnpaid", reflect.TypeOf((*MockScheduleServiceServer)(nil).MarkAsUnpaid), arg0, arg1)
+}
+
+// QuotePrice mocks base method.
+func (m *MockScheduleServiceServer) QuotePrice(arg0 context.Context, arg1 *pkg.QuotePriceRequest) (*pkg.PriceQuote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuotePrice", arg0, arg1)
+	ret0, _ := ret[0].(*pkg.PriceQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuotePrice indicates an expected call of QuotePrice.
+func (mr *MockScheduleServiceServerMockRecorder) QuotePrice(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuotePrice", reflect.TypeOf((*MockScheduleServiceServer)(nil).QuotePrice), arg0, arg1)
+}
+
 // UpdateLesson mocks base method.
-func (m *MockScheduleServiceServer) UpdateLesson(arg0 context.Context, arg1 *api.UpdateLessonRequest) (*api.Lesson, error) {
+func (m *MockScheduleServiceServer) UpdateLesson(arg0 context.Context, arg1 *pkg.UpdateLessonRequest) (*pkg.Lesson, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdateLesson", arg0, arg1)
-	ret0, _ := ret[0].(*api.Lesson)
+	ret0, _ := ret[0].(*pkg.Lesson)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -507,10 +927,10 @@ func (mr *MockScheduleServiceServerMockRecorder) UpdateLesson(arg0, arg1 any) *g
 }
 
 // UpdateSlot mocks base method.
-func (m *MockScheduleServiceServer) UpdateSlot(arg0 context.Context, arg1 *api.UpdateSlotRequest) (*api.Slot, error) {
+func (m *MockScheduleServiceServer) UpdateSlot(arg0 context.Context, arg1 *pkg.UpdateSlotRequest) (*pkg.Slot, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdateSlot", arg0, arg1)
-	ret0, _ := ret[0].(*api.Slot)
+	ret0, _ := ret[0].(*pkg.Slot)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
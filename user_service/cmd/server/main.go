@@ -4,18 +4,30 @@ import (
 	"common_library/logging"
 	"common_library/metadata"
 	"context"
+	filepb "fileservice/pkg/api"
 	"fmt"
 	"github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	homeworkpb "homework_service/pkg/api"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
+	paymentpb "paymentservice/pkg/api"
+	schedulepb "schedule_service/pkg/api"
 	"syscall"
+	"time"
+	"userservice/internal/authorization"
+	"userservice/internal/cache"
+	"userservice/internal/clients"
 	"userservice/internal/config"
 	"userservice/internal/data"
 	"userservice/internal/db"
 	"userservice/internal/handler"
+	"userservice/internal/mail"
+	"userservice/internal/metrics"
 	"userservice/internal/service"
 	pb "userservice/pkg/api"
 )
@@ -31,6 +43,7 @@ func main() {
 	}
 
 	logger := logging.New(zapLogger)
+	ctx = logging.ContextWithLogger(ctx, logger)
 
 	cfg, err := config.New()
 	if err != nil {
@@ -44,10 +57,121 @@ func main() {
 
 	userRepo := data.NewUserRepository(database)
 	tsRepo := data.NewTutorStudentRepository(database)
+	inviteRepo := data.NewTutorInviteRepository(database)
+	gsRepo := data.NewGuardianStudentRepository(database)
+	sessionRepo := data.NewSessionRepository(database)
+	identityRepo := data.NewIdentityRepository(database)
+	auditRepo := data.NewAuditRepository(database)
+	dataJobRepo := data.NewDataJobRepository(database)
 
-	userService := service.NewUserService(userRepo, tsRepo, cfg.TelegramSecret)
+	signingKeys, err := authorization.ParseSigningKeys(cfg.AuthSigningKeys)
+	if err != nil {
+		logger.Fatal(ctx, "cannot parse signing keys", zap.Error(err))
+	}
+	if len(signingKeys) == 0 {
+		key, err := authorization.GenerateSigningKey()
+		if err != nil {
+			logger.Fatal(ctx, "cannot generate signing key", zap.Error(err))
+		}
+		logger.Warn(ctx, "AUTH_SIGNING_KEYS is not set, issued tokens will not survive a restart")
+		signingKeys = append(signingKeys, key)
+	}
+	tokenIssuer, err := authorization.NewTokenIssuer(signingKeys, cfg.AuthIssuer, cfg.AccessTokenTTL, cfg.RefreshTokenTTL)
+	if err != nil {
+		logger.Fatal(ctx, "cannot create token issuer", zap.Error(err))
+	}
+
+	var replayCache service.ReplayCache
+	if cfg.RedisURL != "" {
+		replayCache = cache.NewRedisReplayCache(redis.NewClient(&redis.Options{Addr: cfg.RedisURL}))
+	} else {
+		logger.Warn(ctx, "REDIS_URL is not set, replay protection works only within this instance")
+		replayCache = cache.NewMemoryReplayCache()
+	}
+
+	userService := service.NewUserService(
+		userRepo,
+		tsRepo,
+		inviteRepo,
+		gsRepo,
+		sessionRepo,
+		identityRepo,
+		auditRepo,
+		dataJobRepo,
+		replayCache,
+		tokenIssuer,
+		service.AuthConfig{
+			TelegramSecret:         cfg.TelegramSecret,
+			TelegramSkew:           cfg.TelegramAuthSkew,
+			TelegramBotToken:       cfg.TelegramBotToken,
+			TelegramInitDataMaxAge: cfg.TelegramInitDataMaxAge,
+			EmailLoginURL:          cfg.EmailLoginURL,
+			EmailLoginTTL:          cfg.EmailLoginTTL,
+			OidcStateTTL:           cfg.OidcStateTTL,
+			AdminTelegramIds:       cfg.AdminTelegramIds,
+			TelegramBotUsername:    cfg.TelegramBotUsername,
+			InviteTTL:              cfg.InviteTTL,
+		},
+	)
+
+	if cfg.SMTPAddr != "" {
+		userService.WithMailer(mail.NewSMTPMailer(cfg.SMTPAddr, cfg.SMTPUsername, cfg.SMTPPassword, cfg.SMTPFrom))
+	}
+	if cfg.OidcIssuer != "" {
+		userService.WithOidcProvider(authorization.NewOidcProvider(authorization.OidcConfig{
+			Name:         cfg.OidcProviderName,
+			Issuer:       cfg.OidcIssuer,
+			ClientId:     cfg.OidcClientId,
+			ClientSecret: cfg.OidcClientSecret,
+			RedirectURL:  cfg.OidcRedirectURL,
+			Scopes:       cfg.OidcScopes,
+		}, &http.Client{Timeout: 10 * time.Second}))
+	}
+
+	var fileClient filepb.FileServiceClient
+	if cfg.FileServiceURL != "" {
+		fileConn, err := clients.New(cfg.FileServiceURL)
+		if err != nil {
+			logger.Fatal(ctx, "cannot create file client", zap.Error(err))
+		}
+		fileClient = filepb.NewFileServiceClient(fileConn)
+		userService.WithFileService(fileClient)
+	} else {
+		logger.Info(ctx, "FILE_SERVICE_URL is not set, tutor avatars are disabled")
+	}
+
+	dataServicesEnabled := cfg.ScheduleServiceURL != "" && cfg.HomeworkServiceURL != "" &&
+		cfg.PaymentServiceURL != "" && fileClient != nil
+	if dataServicesEnabled {
+		scheduleConn, err := clients.New(cfg.ScheduleServiceURL)
+		if err != nil {
+			logger.Fatal(ctx, "cannot create schedule client", zap.Error(err))
+		}
+		homeworkConn, err := clients.New(cfg.HomeworkServiceURL)
+		if err != nil {
+			logger.Fatal(ctx, "cannot create homework client", zap.Error(err))
+		}
+		paymentConn, err := clients.New(cfg.PaymentServiceURL)
+		if err != nil {
+			logger.Fatal(ctx, "cannot create payment client", zap.Error(err))
+		}
+		userService.WithDataServices(&service.DataServices{
+			Schedule: schedulepb.NewScheduleServiceClient(scheduleConn),
+			Homework: homeworkpb.NewHomeworkServiceClient(homeworkConn),
+			Payment:  paymentpb.NewPaymentServiceClient(paymentConn),
+			File:     fileClient,
+		})
+	} else {
+		logger.Info(ctx, "service URLs are not configured, account deletion and data export are disabled")
+	}
 
 	userHandler := handler.NewUserServiceServer(userService)
+	adminHandler := handler.NewAdminServiceServer(userService)
+
+	if dataServicesEnabled {
+		dataJobWorker := NewDataJobWorker(userService, logger, cfg.DataJobInterval, cfg.DataJobStaleAfter)
+		go dataJobWorker.Start(ctx)
+	}
 
 	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.GRPCPort))
 	if err != nil {
@@ -62,6 +186,17 @@ func main() {
 	)
 
 	pb.RegisterUserServiceServer(server, userHandler)
+	pb.RegisterAdminServiceServer(server, adminHandler)
+
+	if cfg.MetricsPort != 0 {
+		go func() {
+			mux := http.NewServeMux()
+			mux.Handle("/metrics", metrics.Handler())
+			if err := http.ListenAndServe(fmt.Sprintf(":%d", cfg.MetricsPort), mux); err != nil {
+				logger.Error(ctx, "metrics server stopped", zap.Error(err))
+			}
+		}()
+	}
 
 	logger.Info(ctx, "Starting gRPC server...", zap.Int("port", cfg.GRPCPort))
 	go func() {
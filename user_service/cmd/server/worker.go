@@ -0,0 +1,53 @@
+package main
+
+import (
+	"common_library/logging"
+	"context"
+	"go.uber.org/zap"
+	"time"
+	"userservice/internal/service"
+)
+
+// DataJobWorker runs account deletions and data exports.
+type DataJobWorker struct {
+	userService *service.UserService
+	logger      *logging.Logger
+	interval    time.Duration
+	staleAfter  time.Duration
+}
+
+func NewDataJobWorker(userService *service.UserService, logger *logging.Logger, interval time.Duration, staleAfter time.Duration) *DataJobWorker {
+	return &DataJobWorker{
+		userService: userService,
+		logger:      logger,
+		interval:    interval,
+		staleAfter:  staleAfter,
+	}
+}
+
+func (w *DataJobWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info(ctx, "Data job worker stopped")
+			return
+		case <-ticker.C:
+			w.process(ctx)
+		}
+	}
+}
+
+func (w *DataJobWorker) process(ctx context.Context) {
+	finished, err := w.userService.ProcessDataJobs(ctx, w.staleAfter)
+	if err != nil {
+		w.logger.Error(ctx, "Data jobs failed", zap.Error(err))
+		return
+	}
+
+	if finished > 0 {
+		w.logger.Info(ctx, "Finished data jobs", zap.Int("jobs", finished))
+	}
+}
@@ -0,0 +1,308 @@
+package authorization
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+	"userservice/internal/errdefs"
+)
+
+type OidcConfig struct {
+	// Name is stored as the identity provider, e.g. "google"
+	Name         string
+	Issuer       string
+	ClientId     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+}
+
+// OidcIdentity is the verified subject of an ID token.
+type OidcIdentity struct {
+	Subject   string
+	Email     *string
+	FirstName *string
+	LastName  *string
+}
+
+// OidcProvider is an authorization code flow client with PKCE of any OpenID Connect provider.
+// Only RS256 signed ID tokens are accepted.
+type OidcProvider struct {
+	cfg    OidcConfig
+	client *http.Client
+
+	mu        sync.Mutex
+	discovery *oidcDiscovery
+	keys      map[string]*rsa.PublicKey
+}
+
+type oidcDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JwksURI               string `json:"jwks_uri"`
+}
+
+func NewOidcProvider(cfg OidcConfig, client *http.Client) *OidcProvider {
+	return &OidcProvider{cfg: cfg, client: client}
+}
+
+func (p *OidcProvider) Name() string {
+	return p.cfg.Name
+}
+
+// AuthCodeURL returns the provider login page url, codeVerifier is sent on Exchange.
+func (p *OidcProvider) AuthCodeURL(ctx context.Context, state string, nonce string, codeVerifier string) (string, error) {
+	discovery, err := p.getDiscovery(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	challenge := sha256.Sum256([]byte(codeVerifier))
+	query := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {p.cfg.ClientId},
+		"redirect_uri":          {p.cfg.RedirectURL},
+		"scope":                 {strings.Join(p.cfg.Scopes, " ")},
+		"state":                 {state},
+		"nonce":                 {nonce},
+		"code_challenge":        {base64.RawURLEncoding.EncodeToString(challenge[:])},
+		"code_challenge_method": {"S256"},
+	}
+
+	separator := "?"
+	if strings.Contains(discovery.AuthorizationEndpoint, "?") {
+		separator = "&"
+	}
+	return discovery.AuthorizationEndpoint + separator + query.Encode(), nil
+}
+
+// Exchange redeems the authorization code and verifies the returned ID token.
+func (p *OidcProvider) Exchange(ctx context.Context, code string, codeVerifier string, nonce string) (*OidcIdentity, error) {
+	discovery, err := p.getDiscovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.cfg.RedirectURL},
+		"client_id":     {p.cfg.ClientId},
+		"client_secret": {p.cfg.ClientSecret},
+		"code_verifier": {codeVerifier},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, discovery.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("oidc: token request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusBadRequest || resp.StatusCode == http.StatusUnauthorized {
+		return nil, fmt.Errorf("oidc: code rejected with status %d: %w", resp.StatusCode, errdefs.ErrAuthInvalidToken)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("oidc: token endpoint status %d", resp.StatusCode)
+	}
+
+	var tokens struct {
+		IdToken string `json:"id_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tokens); err != nil || tokens.IdToken == "" {
+		return nil, fmt.Errorf("oidc: no id_token in response: %w", errdefs.ErrAuthMalformed)
+	}
+
+	return p.VerifyIdToken(ctx, tokens.IdToken, nonce, time.Now())
+}
+
+type idTokenClaims struct {
+	Issuer     string          `json:"iss"`
+	Subject    string          `json:"sub"`
+	Audience   json.RawMessage `json:"aud"`
+	ExpiresAt  int64           `json:"exp"`
+	Nonce      string          `json:"nonce"`
+	Email      string          `json:"email"`
+	Verified   *bool           `json:"email_verified"`
+	GivenName  string          `json:"given_name"`
+	FamilyName string          `json:"family_name"`
+}
+
+// VerifyIdToken checks the signature, issuer, audience, expiration and nonce of the ID token.
+func (p *OidcProvider) VerifyIdToken(ctx context.Context, idToken string, nonce string, now time.Time) (*OidcIdentity, error) {
+	parts := strings.Split(idToken, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("oidc: malformed id token: %w", errdefs.ErrAuthMalformed)
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeJWTSegment(parts[0], &header); err != nil || header.Alg != "RS256" {
+		return nil, fmt.Errorf("oidc: unsupported id token header: %w", errdefs.ErrAuthMalformed)
+	}
+
+	key, err := p.getKey(ctx, header.Kid)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("oidc: malformed signature: %w", errdefs.ErrAuthMalformed)
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+		return nil, fmt.Errorf("oidc: invalid id token signature: %w", errdefs.ErrAuthBadSignature)
+	}
+
+	var claims idTokenClaims
+	if err := decodeJWTSegment(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("oidc: malformed id token claims: %w", errdefs.ErrAuthMalformed)
+	}
+	if claims.Issuer != p.cfg.Issuer || !audienceContains(claims.Audience, p.cfg.ClientId) || claims.Subject == "" {
+		return nil, fmt.Errorf("oidc: id token is issued for another client: %w", errdefs.ErrAuthBadSignature)
+	}
+	if now.Unix() >= claims.ExpiresAt {
+		return nil, fmt.Errorf("oidc: id token expired: %w", errdefs.ErrAuthExpired)
+	}
+	if claims.Nonce != nonce {
+		return nil, fmt.Errorf("oidc: nonce mismatch: %w", errdefs.ErrAuthReplayed)
+	}
+
+	identity := &OidcIdentity{Subject: claims.Subject}
+	// unverified emails are not stored, they could belong to someone else
+	if claims.Email != "" && (claims.Verified == nil || *claims.Verified) {
+		identity.Email = &claims.Email
+	}
+	if claims.GivenName != "" {
+		identity.FirstName = &claims.GivenName
+	}
+	if claims.FamilyName != "" {
+		identity.LastName = &claims.FamilyName
+	}
+	return identity, nil
+}
+
+func (p *OidcProvider) getDiscovery(ctx context.Context) (*oidcDiscovery, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+
+	var discovery oidcDiscovery
+	if err := p.getJSON(ctx, strings.TrimSuffix(p.cfg.Issuer, "/")+"/.well-known/openid-configuration", &discovery); err != nil {
+		return nil, err
+	}
+	if discovery.Issuer != p.cfg.Issuer {
+		return nil, fmt.Errorf("oidc: discovery issuer %s does not match %s", discovery.Issuer, p.cfg.Issuer)
+	}
+	p.discovery = &discovery
+	return p.discovery, nil
+}
+
+// getKey returns the signing key, keys are refetched once for an unknown key id.
+func (p *OidcProvider) getKey(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	discovery, err := p.getDiscovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if key, ok := p.keys[kid]; ok {
+		return key, nil
+	}
+
+	var set struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		} `json:"keys"`
+	}
+	if err := p.getJSON(ctx, discovery.JwksURI, &set); err != nil {
+		return nil, err
+	}
+
+	p.keys = make(map[string]*rsa.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Kty != "RSA" {
+			continue
+		}
+		n, errN := base64.RawURLEncoding.DecodeString(jwk.N)
+		e, errE := base64.RawURLEncoding.DecodeString(jwk.E)
+		if errN != nil || errE != nil {
+			continue
+		}
+		p.keys[jwk.Kid] = &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+	}
+
+	key, ok := p.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("oidc: unknown signing key %s: %w", kid, errdefs.ErrAuthBadSignature)
+	}
+	return key, nil
+}
+
+func (p *OidcProvider) getJSON(ctx context.Context, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("oidc: get %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("oidc: get %s: status %d", url, resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("oidc: decode %s: %w", url, err)
+	}
+	return nil
+}
+
+func audienceContains(raw json.RawMessage, clientId string) bool {
+	var single string
+	if err := json.Unmarshal(raw, &single); err == nil {
+		return single == clientId
+	}
+	var many []string
+	if err := json.Unmarshal(raw, &many); err == nil {
+		return slices.Contains(many, clientId)
+	}
+	return false
+}
+
+func decodeJWTSegment(segment string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
@@ -0,0 +1,193 @@
+package authorization
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+	"userservice/internal/errdefs"
+)
+
+type testOidcServer struct {
+	*httptest.Server
+	key    *rsa.PrivateKey
+	claims map[string]any
+}
+
+func newTestOidcServer(t *testing.T) *testOidcServer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &testOidcServer{key: key}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 s.URL,
+			"authorization_endpoint": s.URL + "/authorize",
+			"token_endpoint":         s.URL + "/token",
+			"jwks_uri":               s.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "k1",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.PostFormValue("code") != "good-code" || r.PostFormValue("code_verifier") != "verifier" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{"id_token": s.sign(t, s.claims)})
+	})
+	s.Server = httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *testOidcServer) sign(t *testing.T, claims map[string]any) string {
+	header, _ := json.Marshal(map[string]string{"alg": "RS256", "kid": "k1"})
+	payload, _ := json.Marshal(claims)
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signed))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, s.key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func (s *testOidcServer) provider() *OidcProvider {
+	return NewOidcProvider(OidcConfig{
+		Name:         "test",
+		Issuer:       s.URL,
+		ClientId:     "client",
+		ClientSecret: "secret",
+		RedirectURL:  "https://app.test/callback",
+		Scopes:       []string{"openid", "email"},
+	}, s.Client())
+}
+
+func (s *testOidcServer) validClaims() map[string]any {
+	return map[string]any{
+		"iss":            s.URL,
+		"sub":            "user-1",
+		"aud":            []string{"client"},
+		"exp":            time.Now().Add(time.Hour).Unix(),
+		"nonce":          "nonce",
+		"email":          "parent@example.com",
+		"email_verified": true,
+		"given_name":     "Anna",
+	}
+}
+
+func TestOidcProvider_AuthCodeURL(t *testing.T) {
+	server := newTestOidcServer(t)
+
+	authURL, err := server.provider().AuthCodeURL(context.Background(), "state", "nonce", "verifier")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	parsed, err := url.Parse(authURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := parsed.Query()
+	challenge := sha256.Sum256([]byte("verifier"))
+	if parsed.Path != "/authorize" ||
+		query.Get("state") != "state" ||
+		query.Get("client_id") != "client" ||
+		query.Get("code_challenge") != base64.RawURLEncoding.EncodeToString(challenge[:]) {
+		t.Errorf("unexpected url %s", authURL)
+	}
+}
+
+func TestOidcProvider_Exchange(t *testing.T) {
+	server := newTestOidcServer(t)
+	server.claims = server.validClaims()
+
+	identity, err := server.provider().Exchange(context.Background(), "good-code", "verifier", "nonce")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if identity.Subject != "user-1" || identity.Email == nil || *identity.Email != "parent@example.com" {
+		t.Errorf("identity = %+v", identity)
+	}
+	if identity.FirstName == nil || *identity.FirstName != "Anna" || identity.LastName != nil {
+		t.Errorf("names = %v %v", identity.FirstName, identity.LastName)
+	}
+
+	_, err = server.provider().Exchange(context.Background(), "bad-code", "verifier", "nonce")
+	if !errors.Is(err, errdefs.ErrAuthInvalidToken) {
+		t.Errorf("err = %v, want %v", err, errdefs.ErrAuthInvalidToken)
+	}
+}
+
+func TestOidcProvider_VerifyIdToken_Rejects(t *testing.T) {
+	server := newTestOidcServer(t)
+
+	tests := []struct {
+		name   string
+		modify func(claims map[string]any)
+		want   error
+	}{
+		{"WrongAudience", func(c map[string]any) { c["aud"] = "other" }, errdefs.ErrAuthBadSignature},
+		{"WrongIssuer", func(c map[string]any) { c["iss"] = "https://evil.test" }, errdefs.ErrAuthBadSignature},
+		{"Expired", func(c map[string]any) { c["exp"] = time.Now().Add(-time.Minute).Unix() }, errdefs.ErrAuthExpired},
+		{"WrongNonce", func(c map[string]any) { c["nonce"] = "other" }, errdefs.ErrAuthReplayed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := server.validClaims()
+			tt.modify(claims)
+
+			_, err := server.provider().VerifyIdToken(context.Background(), server.sign(t, claims), "nonce", time.Now())
+			if !errors.Is(err, tt.want) {
+				t.Errorf("err = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	t.Run("Tampered", func(t *testing.T) {
+		parts := strings.Split(server.sign(t, server.validClaims()), ".")
+		claims := server.validClaims()
+		claims["sub"] = "admin"
+		payload, _ := json.Marshal(claims)
+		tampered := parts[0] + "." + base64.RawURLEncoding.EncodeToString(payload) + "." + parts[2]
+
+		_, err := server.provider().VerifyIdToken(context.Background(), tampered, "nonce", time.Now())
+		if !errors.Is(err, errdefs.ErrAuthBadSignature) {
+			t.Errorf("err = %v, want %v", err, errdefs.ErrAuthBadSignature)
+		}
+	})
+
+	t.Run("UnverifiedEmail", func(t *testing.T) {
+		claims := server.validClaims()
+		claims["email_verified"] = false
+
+		identity, err := server.provider().VerifyIdToken(context.Background(), server.sign(t, claims), "nonce", time.Now())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if identity.Email != nil {
+			t.Errorf("unverified email must be dropped, got %s", *identity.Email)
+		}
+	})
+}
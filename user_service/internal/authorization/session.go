@@ -0,0 +1,153 @@
+package authorization
+
+import (
+	"common_library/authtoken"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"strings"
+	"time"
+	"userservice/internal/errdefs"
+)
+
+// TokenIssuer signs access tokens with the first key and publishes all keys,
+// so tokens signed by a rotated out key stay valid until they expire.
+type TokenIssuer struct {
+	keys       []*authtoken.SigningKey
+	issuer     string
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+}
+
+func NewTokenIssuer(keys []*authtoken.SigningKey, issuer string, accessTTL, refreshTTL time.Duration) (*TokenIssuer, error) {
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("authorization: no signing keys")
+	}
+	return &TokenIssuer{keys: keys, issuer: issuer, accessTTL: accessTTL, refreshTTL: refreshTTL}, nil
+}
+
+// ParseSigningKeys parses "kid:seed" specs, seed is 32 bytes in base64url.
+func ParseSigningKeys(specs []string) ([]*authtoken.SigningKey, error) {
+	keys := make([]*authtoken.SigningKey, 0, len(specs))
+	for _, spec := range specs {
+		kid, encoded, ok := strings.Cut(strings.TrimSpace(spec), ":")
+		if !ok {
+			return nil, fmt.Errorf("authorization: signing key must be kid:seed")
+		}
+		seed, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(encoded, "="))
+		if err != nil {
+			return nil, fmt.Errorf("authorization: cannot decode signing key %s: %w", kid, err)
+		}
+		key, err := authtoken.NewSigningKey(kid, seed)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// GenerateSigningKey creates a random key, tokens signed by it do not survive a restart.
+func GenerateSigningKey() (*authtoken.SigningKey, error) {
+	seed := make([]byte, 32)
+	if _, err := rand.Read(seed); err != nil {
+		return nil, err
+	}
+	return authtoken.NewSigningKey("ephemeral-"+hex.EncodeToString(seed[:4]), seed)
+}
+
+func (i *TokenIssuer) RefreshTTL() time.Duration {
+	return i.refreshTTL
+}
+
+// IssueAccessToken signs a token of the user session.
+func (i *TokenIssuer) IssueAccessToken(userId uuid.UUID, role string, sessionId uuid.UUID, now time.Time) (string, time.Time, error) {
+	expiresAt := now.Add(i.accessTTL)
+	token, err := authtoken.Sign(i.keys[0], &authtoken.Claims{
+		Subject:   userId.String(),
+		Role:      role,
+		SessionID: sessionId.String(),
+		Issuer:    i.issuer,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: expiresAt.Unix(),
+	})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return token, expiresAt, nil
+}
+
+// VerifyAccessToken checks the token signature, issuer and expiration.
+func (i *TokenIssuer) VerifyAccessToken(token string, now time.Time) (*authtoken.Claims, error) {
+	claims, err := authtoken.Verify(token, i.KeySet(), i.issuer, now)
+	if errors.Is(err, authtoken.ErrExpiredToken) {
+		return nil, fmt.Errorf("authorization: %w: %w", err, errdefs.ErrAuthExpired)
+	}
+	if errors.Is(err, authtoken.ErrInvalidToken) {
+		return nil, fmt.Errorf("authorization: %w: %w", err, errdefs.ErrAuthMalformed)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("authorization: %w: %w", err, errdefs.ErrAuthBadSignature)
+	}
+	return claims, nil
+}
+
+func (i *TokenIssuer) KeySet() authtoken.KeySet {
+	set := authtoken.KeySet{Keys: make([]authtoken.JWK, 0, len(i.keys))}
+	for _, key := range i.keys {
+		set.Keys = append(set.Keys, key.PublicJWK())
+	}
+	return set
+}
+
+// NewRefreshToken returns an opaque "sessionId.secret" token and the hash to store.
+func NewRefreshToken(sessionId uuid.UUID) (string, string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", err
+	}
+	token := sessionId.String() + "." + base64.RawURLEncoding.EncodeToString(secret)
+	return token, HashRefreshToken(token), nil
+}
+
+// ParseRefreshToken returns the session of the token.
+func ParseRefreshToken(token string) (uuid.UUID, error) {
+	id, _, ok := strings.Cut(token, ".")
+	if !ok {
+		return uuid.Nil, fmt.Errorf("authorization: malformed refresh token: %w", errdefs.ErrAuthMalformed)
+	}
+	sessionId, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("authorization: malformed refresh token: %w", errdefs.ErrAuthMalformed)
+	}
+	return sessionId, nil
+}
+
+func HashRefreshToken(token string) string {
+	return HashToken(token)
+}
+
+// RefreshTokenMatches compares the token with the stored hash in constant time.
+func RefreshTokenMatches(token string, hash string) bool {
+	return subtle.ConstantTimeCompare([]byte(HashRefreshToken(token)), []byte(hash)) == 1
+}
+
+// NewRandomToken returns a random base64url token for one time links and OIDC state.
+func NewRandomToken() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(secret), nil
+}
+
+// HashToken is stored instead of bearer secrets.
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -11,12 +11,14 @@ import (
 	"userservice/internal/errdefs"
 )
 
-func GetTelegramId(secret string, header string) (int64, error) {
+// GetTelegramId validates a "tgId:timestamp:hmac" header whose timestamp is within skew of now.
+// The header stays valid for the whole window, callers protect it from replay.
+func GetTelegramId(secret string, header string, skew time.Duration, now time.Time) (int64, error) {
 	payload := strings.Split(header, ":")
 	if len(payload) != 3 {
 		return 0, fmt.Errorf(
 			"authorization: header payload len mismatch got %d: %w",
-			len(payload), errdefs.AuthenticationErr,
+			len(payload), errdefs.ErrAuthMalformed,
 		)
 	}
 
@@ -24,7 +26,7 @@ func GetTelegramId(secret string, header string) (int64, error) {
 	if err != nil {
 		return 0, fmt.Errorf(
 			"authorization: cannot parse tgId %s: %w",
-			payload[0], errdefs.AuthenticationErr,
+			payload[0], errdefs.ErrAuthMalformed,
 		)
 	}
 
@@ -32,15 +34,14 @@ func GetTelegramId(secret string, header string) (int64, error) {
 	if err != nil {
 		return 0, fmt.Errorf(
 			"authorization: cannot parse timestamp %s: %w",
-			payload[1], errdefs.AuthenticationErr,
+			payload[1], errdefs.ErrAuthMalformed,
 		)
 	}
-	now := time.Now().Unix()
-	var diffSeconds int64 = 5 * 60
-	if !(now-diffSeconds < timestamp && timestamp < now+diffSeconds) {
+	diffSeconds := int64(skew.Seconds())
+	if !(now.Unix()-diffSeconds < timestamp && timestamp < now.Unix()+diffSeconds) {
 		return 0, fmt.Errorf(
 			"authorization: timestamp expired %s: %w",
-			payload[1], errdefs.AuthenticationErr,
+			payload[1], errdefs.ErrAuthExpired,
 		)
 	}
 
@@ -48,7 +49,7 @@ func GetTelegramId(secret string, header string) (int64, error) {
 	if !ValidMAC(message, secret, payload[2]) {
 		return 0, fmt.Errorf(
 			"authorization: invalid hmac: %w",
-			errdefs.AuthenticationErr,
+			errdefs.ErrAuthBadSignature,
 		)
 	}
 
@@ -0,0 +1,59 @@
+package authorization
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+	"userservice/internal/errdefs"
+)
+
+func signTelegramHeader(secret string, tgId int64, timestamp time.Time) string {
+	message := fmt.Sprintf("%d:%d", tgId, timestamp.Unix())
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(message))
+	return message + ":" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestGetTelegramId(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	header := signTelegramHeader("secret", 42, now.Add(-time.Minute))
+
+	tgId, err := GetTelegramId("secret", header, 5*time.Minute, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tgId != 42 {
+		t.Errorf("tgId = %d, want 42", tgId)
+	}
+}
+
+func TestGetTelegramId_Rejects(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+
+	tests := []struct {
+		name   string
+		header string
+		want   error
+	}{
+		{"Malformed", "42:1700000000", errdefs.ErrAuthMalformed},
+		{"BadTgId", "x:1700000000:00", errdefs.ErrAuthMalformed},
+		{"Expired", signTelegramHeader("secret", 42, now.Add(-2*time.Minute)), errdefs.ErrAuthExpired},
+		{"FromFuture", signTelegramHeader("secret", 42, now.Add(2*time.Minute)), errdefs.ErrAuthExpired},
+		{"WrongSecret", signTelegramHeader("other", 42, now), errdefs.ErrAuthBadSignature},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := GetTelegramId("secret", tt.header, time.Minute, now)
+			if !errors.Is(err, tt.want) || !errors.Is(err, errdefs.AuthenticationErr) {
+				t.Errorf("err = %v, want %v", err, tt.want)
+			}
+			if reason := errdefs.AuthFailureReason(err); reason != tt.want.(*errdefs.AuthFailure).Reason {
+				t.Errorf("reason = %s", reason)
+			}
+		})
+	}
+}
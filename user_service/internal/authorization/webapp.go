@@ -0,0 +1,117 @@
+package authorization
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"userservice/internal/errdefs"
+)
+
+// webAppClockSkew tolerates auth_date slightly ahead of the server clock.
+const webAppClockSkew = time.Minute
+
+// WebAppUser is the user object of Telegram Mini App init data.
+type WebAppUser struct {
+	Id           int64  `json:"id"`
+	FirstName    string `json:"first_name"`
+	LastName     string `json:"last_name"`
+	Username     string `json:"username"`
+	LanguageCode string `json:"language_code"`
+}
+
+// WebAppInitData is validated Telegram Mini App init data.
+type WebAppInitData struct {
+	User       WebAppUser
+	AuthDate   time.Time
+	QueryId    string
+	StartParam string
+}
+
+// ParseWebAppInitData validates Telegram.WebApp.initData signed by the bot token
+// and returns its payload. Init data older than maxAge is rejected.
+// See https://core.telegram.org/bots/webapps#validating-data-received-via-the-mini-app
+func ParseWebAppInitData(botToken string, initData string, maxAge time.Duration, now time.Time) (*WebAppInitData, error) {
+	if botToken == "" {
+		return nil, fmt.Errorf(
+			"authorization: webapp auth is not configured: %w",
+			errdefs.ErrAuthNotConfigured,
+		)
+	}
+
+	values, err := url.ParseQuery(initData)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"authorization: cannot parse init data: %w",
+			errdefs.ErrAuthMalformed,
+		)
+	}
+
+	hash := values.Get("hash")
+	if hash == "" {
+		return nil, fmt.Errorf(
+			"authorization: init data hash is missing: %w",
+			errdefs.ErrAuthMalformed,
+		)
+	}
+	values.Del("hash")
+
+	secretKey := hmac.New(sha256.New, []byte("WebAppData"))
+	secretKey.Write([]byte(botToken))
+	if !ValidMAC(WebAppDataCheckString(values), string(secretKey.Sum(nil)), strings.ToLower(hash)) {
+		return nil, fmt.Errorf(
+			"authorization: invalid init data hash: %w",
+			errdefs.ErrAuthBadSignature,
+		)
+	}
+
+	authDate, err := strconv.ParseInt(values.Get("auth_date"), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"authorization: cannot parse auth_date %s: %w",
+			values.Get("auth_date"), errdefs.ErrAuthMalformed,
+		)
+	}
+	issuedAt := time.Unix(authDate, 0)
+	if now.Sub(issuedAt) > maxAge || issuedAt.Sub(now) > webAppClockSkew {
+		return nil, fmt.Errorf(
+			"authorization: auth_date expired %d: %w",
+			authDate, errdefs.ErrAuthExpired,
+		)
+	}
+
+	var user WebAppUser
+	if err := json.Unmarshal([]byte(values.Get("user")), &user); err != nil || user.Id == 0 {
+		return nil, fmt.Errorf(
+			"authorization: cannot parse init data user: %w",
+			errdefs.ErrAuthMalformed,
+		)
+	}
+
+	return &WebAppInitData{
+		User:       user,
+		AuthDate:   issuedAt,
+		QueryId:    values.Get("query_id"),
+		StartParam: values.Get("start_param"),
+	}, nil
+}
+
+// WebAppDataCheckString builds the signed string: key=value pairs sorted by key and joined by "\n".
+func WebAppDataCheckString(values url.Values) string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, key+"="+values.Get(key))
+	}
+	return strings.Join(pairs, "\n")
+}
@@ -0,0 +1,84 @@
+package authorization
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+	"userservice/internal/errdefs"
+)
+
+const (
+	testBotToken = "5768337691:AAH5YkoiEuPk8-FZa32hStHTqXiLPtAEhx8"
+	// signed with testBotToken, auth_date 1700000000
+	testInitData = "auth_date=1700000000&query_id=AAHdF6IQAAAAAN0XohDhrOrc" +
+		"&user=%7B%22id%22%3A279058397%2C%22first_name%22%3A%22Vladislav%22%2C%22last_name%22%3A%22Kibenko%22" +
+		"%2C%22username%22%3A%22vdkfrost%22%2C%22language_code%22%3A%22ru%22%7D" +
+		"&hash=d5aecb0f6af7df8c77a030b0a16c653400b3bf3ea16c94b59ad0884bedd04842"
+)
+
+var testAuthDate = time.Unix(1700000000, 0)
+
+func TestParseWebAppInitData(t *testing.T) {
+	data, err := ParseWebAppInitData(testBotToken, testInitData, 24*time.Hour, testAuthDate.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := WebAppUser{
+		Id:           279058397,
+		FirstName:    "Vladislav",
+		LastName:     "Kibenko",
+		Username:     "vdkfrost",
+		LanguageCode: "ru",
+	}
+	if data.User != want {
+		t.Errorf("user = %+v, want %+v", data.User, want)
+	}
+	if !data.AuthDate.Equal(testAuthDate) {
+		t.Errorf("auth date = %v, want %v", data.AuthDate, testAuthDate)
+	}
+	if data.QueryId != "AAHdF6IQAAAAAN0XohDhrOrc" {
+		t.Errorf("query id = %q", data.QueryId)
+	}
+}
+
+func TestParseWebAppInitData_Rejects(t *testing.T) {
+	tampered, _ := url.ParseQuery(testInitData)
+	tampered.Set("auth_date", "1700000001")
+
+	noHash, _ := url.ParseQuery(testInitData)
+	noHash.Del("hash")
+
+	tests := []struct {
+		name     string
+		botToken string
+		initData string
+		now      time.Time
+		want     error
+	}{
+		{"NotConfigured", "", testInitData, testAuthDate, errdefs.ErrAuthNotConfigured},
+		{"WrongBotToken", "1:other", testInitData, testAuthDate, errdefs.ErrAuthBadSignature},
+		{"Tampered", testBotToken, tampered.Encode(), testAuthDate, errdefs.ErrAuthBadSignature},
+		{"MissingHash", testBotToken, noHash.Encode(), testAuthDate, errdefs.ErrAuthMalformed},
+		{"Expired", testBotToken, testInitData, testAuthDate.Add(25 * time.Hour), errdefs.ErrAuthExpired},
+		{"FromFuture", testBotToken, testInitData, testAuthDate.Add(-time.Hour), errdefs.ErrAuthExpired},
+		{"Malformed", testBotToken, "%zz", testAuthDate, errdefs.ErrAuthMalformed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseWebAppInitData(tt.botToken, tt.initData, 24*time.Hour, tt.now)
+			if !errors.Is(err, tt.want) || !errors.Is(err, errdefs.AuthenticationErr) {
+				t.Errorf("err = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestWebAppDataCheckString(t *testing.T) {
+	values := url.Values{"user": {"{}"}, "auth_date": {"1"}, "query_id": {"q"}}
+	want := "auth_date=1\nquery_id=q\nuser={}"
+	if got := WebAppDataCheckString(values); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
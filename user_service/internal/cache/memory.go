@@ -0,0 +1,43 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryReplayCache keeps seen keys in process, only for a single replica and tests.
+type MemoryReplayCache struct {
+	mu        sync.Mutex
+	expiresAt map[string]time.Time
+	nextSweep time.Time
+	now       func() time.Time
+}
+
+const sweepInterval = time.Minute
+
+func NewMemoryReplayCache() *MemoryReplayCache {
+	return &MemoryReplayCache{expiresAt: make(map[string]time.Time), now: time.Now}
+}
+
+// Claim returns false if key was already claimed within ttl.
+func (c *MemoryReplayCache) Claim(_ context.Context, key string, ttl time.Duration) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	if !now.Before(c.nextSweep) {
+		for k, expiresAt := range c.expiresAt {
+			if !now.Before(expiresAt) {
+				delete(c.expiresAt, k)
+			}
+		}
+		c.nextSweep = now.Add(sweepInterval)
+	}
+
+	if expiresAt, ok := c.expiresAt[key]; ok && now.Before(expiresAt) {
+		return false, nil
+	}
+	c.expiresAt[key] = now.Add(ttl)
+	return true, nil
+}
@@ -0,0 +1,29 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestMemoryReplayCache_Claim(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1700000000, 0)
+	c := NewMemoryReplayCache()
+	c.now = func() time.Time { return now }
+
+	if ok, _ := c.Claim(ctx, "header", time.Minute); !ok {
+		t.Fatal("first claim must succeed")
+	}
+	if ok, _ := c.Claim(ctx, "header", time.Minute); ok {
+		t.Fatal("replayed claim must fail")
+	}
+	if ok, _ := c.Claim(ctx, "other", time.Minute); !ok {
+		t.Fatal("other key must be claimed")
+	}
+
+	now = now.Add(time.Minute)
+	if ok, _ := c.Claim(ctx, "header", time.Minute); !ok {
+		t.Fatal("claim must succeed after ttl")
+	}
+}
@@ -0,0 +1,22 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisReplayCache shares seen authorization headers between service replicas.
+type RedisReplayCache struct {
+	rdb *redis.Client
+}
+
+func NewRedisReplayCache(rdb *redis.Client) *RedisReplayCache {
+	return &RedisReplayCache{rdb: rdb}
+}
+
+// Claim returns false if key was already claimed within ttl.
+func (c *RedisReplayCache) Claim(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	return c.rdb.SetNX(ctx, "auth:replay:"+key, 1, ttl).Result()
+}
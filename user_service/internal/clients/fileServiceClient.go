@@ -1 +1,16 @@
 package clients
+
+import (
+	"context"
+	api "fileservice/pkg/api"
+	"google.golang.org/grpc"
+)
+
+type FileServiceClient interface {
+	CreateArchive(ctx context.Context, req *api.CreateArchiveRequest, opts ...grpc.CallOption) (*api.File, error)
+	GenerateDownloadURL(ctx context.Context, req *api.GenerateDownloadURLRequest, opts ...grpc.CallOption) (*api.DownloadURL, error)
+	GetFileMeta(ctx context.Context, req *api.GetFileMetaRequest, opts ...grpc.CallOption) (*api.File, error)
+	RegisterFileUsage(ctx context.Context, req *api.FileUsage, opts ...grpc.CallOption) (*api.Empty, error)
+	UnregisterFileUsage(ctx context.Context, req *api.FileUsage, opts ...grpc.CallOption) (*api.Empty, error)
+	ForgetUser(ctx context.Context, req *api.ForgetUserRequest, opts ...grpc.CallOption) (*api.Empty, error)
+}
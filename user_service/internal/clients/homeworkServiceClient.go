@@ -0,0 +1,12 @@
+package clients
+
+import (
+	"context"
+	"google.golang.org/grpc"
+	api "homework_service/pkg/api"
+)
+
+type HomeworkServiceClient interface {
+	ExportUserData(ctx context.Context, req *api.ExportUserDataRequest, opts ...grpc.CallOption) (*api.UserDataExport, error)
+	ForgetUser(ctx context.Context, req *api.ForgetUserRequest, opts ...grpc.CallOption) (*api.Empty, error)
+}
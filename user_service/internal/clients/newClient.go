@@ -0,0 +1,11 @@
+package clients
+
+import (
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// New creates a connection to another service. The connection is established lazily on the first call.
+func New(url string) (*grpc.ClientConn, error) {
+	return grpc.NewClient(url, grpc.WithTransportCredentials(insecure.NewCredentials()))
+}
@@ -0,0 +1,11 @@
+package clients
+
+import (
+	"context"
+	"google.golang.org/grpc"
+	api "paymentservice/pkg/api"
+)
+
+type PaymentServiceClient interface {
+	ExportUserData(ctx context.Context, req *api.ExportUserDataRequest, opts ...grpc.CallOption) (*api.UserDataExport, error)
+}
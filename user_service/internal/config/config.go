@@ -4,6 +4,7 @@ import (
 	"errors"
 	"github.com/ilyakaznacheev/cleanenv"
 	"os"
+	"time"
 )
 
 type Config struct {
@@ -13,6 +14,48 @@ type Config struct {
 	PostgresMinConn     int32  `env:"POSTGRES_MIN_CONN" env-default:"1"`
 	PostgresAutoMigrate bool   `env:"POSTGRES_AUTO_MIGRATE" env-default:"true"`
 	TelegramSecret      string `env:"TELEGRAM_SECRET" env-default:"no-secret"`
+	// TelegramAuthSkew is the allowed clock difference of telegram header timestamps
+	TelegramAuthSkew time.Duration `env:"TELEGRAM_AUTH_SKEW" env-default:"5m"`
+	// RedisURL stores used telegram headers, in memory if empty
+	RedisURL    string `env:"REDIS_URL"`
+	MetricsPort int    `env:"METRICS_PORT" env-default:"9090"`
+	// TelegramBotToken enables Mini App initData authentication
+	TelegramBotToken       string        `env:"TELEGRAM_BOT_TOKEN"`
+	TelegramInitDataMaxAge time.Duration `env:"TELEGRAM_INIT_DATA_MAX_AGE" env-default:"24h"`
+	// AuthSigningKeys are "kid:seed" pairs, the first one signs new tokens
+	AuthSigningKeys []string      `env:"AUTH_SIGNING_KEYS" env-separator:","`
+	AuthIssuer      string        `env:"AUTH_ISSUER" env-default:"studyflow"`
+	AccessTokenTTL  time.Duration `env:"ACCESS_TOKEN_TTL" env-default:"15m"`
+	RefreshTokenTTL time.Duration `env:"REFRESH_TOKEN_TTL" env-default:"720h"`
+	// SMTPAddr enables email sign in, host:port
+	SMTPAddr      string        `env:"SMTP_ADDR"`
+	SMTPUsername  string        `env:"SMTP_USERNAME"`
+	SMTPPassword  string        `env:"SMTP_PASSWORD"`
+	SMTPFrom      string        `env:"SMTP_FROM" env-default:"noreply@studyflow.local"`
+	EmailLoginURL string        `env:"EMAIL_LOGIN_URL" env-default:"http://localhost:3000/auth/email"`
+	EmailLoginTTL time.Duration `env:"EMAIL_LOGIN_TTL" env-default:"15m"`
+	// OidcIssuer enables sign in with the OpenID Connect provider
+	OidcIssuer       string        `env:"OIDC_ISSUER"`
+	OidcProviderName string        `env:"OIDC_PROVIDER_NAME" env-default:"oidc"`
+	OidcClientId     string        `env:"OIDC_CLIENT_ID"`
+	OidcClientSecret string        `env:"OIDC_CLIENT_SECRET"`
+	OidcRedirectURL  string        `env:"OIDC_REDIRECT_URL"`
+	OidcScopes       []string      `env:"OIDC_SCOPES" env-separator:"," env-default:"openid,email,profile"`
+	OidcStateTTL     time.Duration `env:"OIDC_STATE_TTL" env-default:"10m"`
+	// AdminTelegramIds may register with the admin role, nobody else can
+	AdminTelegramIds []int64 `env:"ADMIN_TELEGRAM_IDS" env-separator:","`
+	// TelegramBotUsername enables telegram deep links of tutor invites
+	TelegramBotUsername string        `env:"TELEGRAM_BOT_USERNAME"`
+	InviteTTL           time.Duration `env:"INVITE_TTL" env-default:"168h"`
+	// ScheduleServiceURL, HomeworkServiceURL, PaymentServiceURL and FileServiceURL
+	// enable account deletion and data export, all of them are required
+	ScheduleServiceURL string        `env:"SCHEDULE_SERVICE_URL"`
+	HomeworkServiceURL string        `env:"HOMEWORK_SERVICE_URL"`
+	PaymentServiceURL  string        `env:"PAYMENT_SERVICE_URL"`
+	FileServiceURL     string        `env:"FILE_SERVICE_URL"`
+	DataJobInterval    time.Duration `env:"DATA_JOB_INTERVAL" env-default:"10s"`
+	// DataJobStaleAfter is the delay before a failed or interrupted job is retried
+	DataJobStaleAfter time.Duration `env:"DATA_JOB_STALE_AFTER" env-default:"5m"`
 }
 
 func New() (*Config, error) {
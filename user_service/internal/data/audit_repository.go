@@ -0,0 +1,42 @@
+package data
+
+import (
+	"context"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"userservice/internal/model"
+)
+
+type AuditRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewAuditRepository(db *pgxpool.Pool) *AuditRepository {
+	return &AuditRepository{db: db}
+}
+
+func (r *AuditRepository) CreateAuditEvent(ctx context.Context, input *model.RepositoryCreateAuditEventInput) (*model.AuditEvent, error) {
+	query := `
+INSERT INTO admin_audit_events (id, admin_id, action, target_type, target_id, details)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, admin_id, action, target_type, target_id, details, created_at
+`
+	var event model.AuditEvent
+	err := pgxscan.Get(ctx, r.db, &event, query,
+		input.Id, input.AdminId, input.Action, input.TargetType, input.TargetId, input.Details,
+	)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return &event, nil
+}
+
+func (r *AuditRepository) ListAuditEvents(ctx context.Context, input *model.ListAuditEventsInput) ([]*model.AuditEvent, error) {
+	query, args := buildListAuditEventsQuery(input)
+	var events []*model.AuditEvent
+	err := pgxscan.Select(ctx, r.db, &events, query, args...)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return events, nil
+}
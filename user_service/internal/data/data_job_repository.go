@@ -0,0 +1,159 @@
+package data
+
+import (
+	"context"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"time"
+	"userservice/internal/model"
+)
+
+const dataJobColumns = `id, user_id, kind, status, step, attempts, result_file_id, error, created_at, edited_at, finished_at`
+
+type DataJobRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewDataJobRepository(db *pgxpool.Pool) *DataJobRepository {
+	return &DataJobRepository{db: db}
+}
+
+func (r *DataJobRepository) CreateDataJob(ctx context.Context, input *model.RepositoryCreateDataJobInput) (*model.DataJob, error) {
+	query := `
+INSERT INTO user_data_jobs (id, user_id, kind)
+VALUES ($1, $2, $3)
+RETURNING ` + dataJobColumns
+	var job model.DataJob
+	err := pgxscan.Get(ctx, r.db, &job, query, input.Id, input.UserId, input.Kind)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return &job, nil
+}
+
+// CreateDeletionJob marks the user deleted, removes the personal data stored in this service,
+// logs the user out and enqueues the deletion in the other services in one transaction.
+// Pairs with tutors and students stay, other services still refer to them.
+func (r *DataJobRepository) CreateDeletionJob(ctx context.Context, input *model.RepositoryCreateDataJobInput) (*model.DataJob, error) {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, handleError(err)
+	}
+	defer tx.Rollback(ctx)
+
+	userQuery := `UPDATE users SET status = $1, first_name = NULL, last_name = NULL, timezone = NULL WHERE id = $2`
+	if _, err := tx.Exec(ctx, userQuery, model.UserStatusDeleted, input.UserId); err != nil {
+		return nil, handleError(err)
+	}
+
+	queries := []string{
+		`DELETE FROM telegram_accounts WHERE user_id = $1`,
+		`DELETE FROM user_identities WHERE user_id = $1`,
+		`DELETE FROM email_login_tokens WHERE link_user_id = $1`,
+		`UPDATE tutor_profiles SET payment_info = NULL, lesson_connection_link = NULL, bio = NULL, avatar_file_id = NULL, is_public = false WHERE user_id = $1`,
+		`UPDATE tutor_students SET lesson_connection_link = NULL WHERE tutor_id = $1`,
+		`DELETE FROM guardian_students WHERE guardian_id = $1 OR student_id = $1`,
+		`UPDATE tutor_invites SET revoked_at = now() WHERE tutor_id = $1 AND revoked_at IS NULL`,
+		`UPDATE sessions SET revoked_at = now() WHERE user_id = $1 AND revoked_at IS NULL`,
+	}
+	for _, query := range queries {
+		if _, err := tx.Exec(ctx, query, input.UserId); err != nil {
+			return nil, handleError(err)
+		}
+	}
+
+	jobQuery := `
+INSERT INTO user_data_jobs (id, user_id, kind)
+VALUES ($1, $2, $3)
+RETURNING ` + dataJobColumns
+	var job model.DataJob
+	err = pgxscan.Get(ctx, tx, &job, jobQuery, input.Id, input.UserId, model.DataJobKindDeletion)
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, handleError(err)
+	}
+	return &job, nil
+}
+
+func (r *DataJobRepository) GetDataJob(ctx context.Context, id uuid.UUID) (*model.DataJob, error) {
+	query := `SELECT ` + dataJobColumns + ` FROM user_data_jobs WHERE id = $1`
+	var job model.DataJob
+	err := pgxscan.Get(ctx, r.db, &job, query, id)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return &job, nil
+}
+
+// GetUnfinishedDataJob returns the pending or running job of the kind, ErrNotFound if there is none.
+func (r *DataJobRepository) GetUnfinishedDataJob(ctx context.Context, userId uuid.UUID, kind model.DataJobKind) (*model.DataJob, error) {
+	query := `
+SELECT ` + dataJobColumns + `
+FROM user_data_jobs
+WHERE user_id = $1 AND kind = $2 AND status IN ($3, $4)
+ORDER BY created_at DESC
+LIMIT 1
+`
+	var job model.DataJob
+	err := pgxscan.Get(ctx, r.db, &job, query, userId, kind, model.DataJobStatusPending, model.DataJobStatusRunning)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return &job, nil
+}
+
+// ClaimDataJobs marks pending jobs and running jobs not updated since staleBefore as running
+// and increments their attempts.
+func (r *DataJobRepository) ClaimDataJobs(ctx context.Context, staleBefore time.Time, limit int) ([]*model.DataJob, error) {
+	query := `
+UPDATE user_data_jobs SET status = $1, attempts = attempts + 1
+WHERE id IN (
+	SELECT id FROM user_data_jobs
+	WHERE status = $2 OR (status = $1 AND edited_at < $3)
+	ORDER BY created_at
+	LIMIT $4
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING ` + dataJobColumns
+	var jobs []*model.DataJob
+	err := pgxscan.Select(ctx, r.db, &jobs, query,
+		model.DataJobStatusRunning,
+		model.DataJobStatusPending,
+		staleBefore,
+		limit,
+	)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return jobs, nil
+}
+
+func (r *DataJobRepository) UpdateDataJobStep(ctx context.Context, id uuid.UUID, step string) (*model.DataJob, error) {
+	query := `UPDATE user_data_jobs SET step = $1 WHERE id = $2 RETURNING ` + dataJobColumns
+	var job model.DataJob
+	err := pgxscan.Get(ctx, r.db, &job, query, step, id)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return &job, nil
+}
+
+// FinishDataJob sets the final status, errMessage is stored for failed jobs.
+func (r *DataJobRepository) FinishDataJob(ctx context.Context, id uuid.UUID, status model.DataJobStatus, resultFileId *uuid.UUID, errMessage *string) (*model.DataJob, error) {
+	query := `
+UPDATE user_data_jobs
+SET status = $1, result_file_id = $2, error = $3, finished_at = now()
+WHERE id = $4
+RETURNING ` + dataJobColumns
+	var job model.DataJob
+	err := pgxscan.Get(ctx, r.db, &job, query, status, resultFileId, errMessage, id)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return &job, nil
+}
@@ -0,0 +1,88 @@
+package data
+
+import (
+	"context"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"userservice/internal/errdefs"
+	"userservice/internal/model"
+)
+
+type GuardianStudentRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewGuardianStudentRepository(db *pgxpool.Pool) *GuardianStudentRepository {
+	return &GuardianStudentRepository{db: db}
+}
+
+func (r *GuardianStudentRepository) CreateGuardianStudent(ctx context.Context, input *model.RepositoryCreateGuardianStudentInput) (*model.GuardianStudent, error) {
+	query := `
+INSERT INTO guardian_students (id, guardian_id, student_id, status)
+VALUES ($1, $2, $3, $4)
+RETURNING id, guardian_id, student_id, status, created_at, edited_at
+`
+	var gs model.GuardianStudent
+	err := pgxscan.Get(ctx, r.db, &gs, query, input.Id, input.GuardianId, input.StudentId, input.Status)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return &gs, nil
+}
+
+func (r *GuardianStudentRepository) GetGuardianStudent(ctx context.Context, guardianId uuid.UUID, studentId uuid.UUID) (*model.GuardianStudent, error) {
+	query := `
+SELECT id, guardian_id, student_id, status, created_at, edited_at
+FROM guardian_students
+WHERE guardian_id = $1 AND student_id = $2
+`
+	var gs model.GuardianStudent
+	err := pgxscan.Get(ctx, r.db, &gs, query, guardianId, studentId)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return &gs, nil
+}
+
+func (r *GuardianStudentRepository) UpdateGuardianStudentStatus(ctx context.Context, guardianId uuid.UUID, studentId uuid.UUID, status model.GuardianStudentStatus) (*model.GuardianStudent, error) {
+	query := `
+UPDATE guardian_students
+SET status = $3
+WHERE guardian_id = $1 AND student_id = $2
+RETURNING id, guardian_id, student_id, status, created_at, edited_at
+`
+	var gs model.GuardianStudent
+	err := pgxscan.Get(ctx, r.db, &gs, query, guardianId, studentId, status)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return &gs, nil
+}
+
+func (r *GuardianStudentRepository) DeleteGuardianStudent(ctx context.Context, guardianId uuid.UUID, studentId uuid.UUID) error {
+	query := `
+DELETE FROM guardian_students
+WHERE guardian_id = $1 AND student_id = $2
+`
+	tag, err := r.db.Exec(ctx, query, guardianId, studentId)
+	if err != nil {
+		return handleError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return errdefs.ErrNotFound
+	}
+	return nil
+}
+
+// ListGuardianStudents Set guardianId or studentId to UUID.Nil to search by one parameter
+func (r *GuardianStudentRepository) ListGuardianStudents(ctx context.Context, guardianId uuid.UUID, studentId uuid.UUID) ([]*model.GuardianStudent, error) {
+	query, args := buildListGuardianStudentsQuery(guardianId, studentId)
+
+	var rows []*model.GuardianStudent
+	err := pgxscan.Select(ctx, r.db, &rows, query, args...)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return rows, nil
+}
@@ -0,0 +1,159 @@
+package data
+
+import (
+	"context"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"userservice/internal/errdefs"
+	"userservice/internal/model"
+)
+
+type IdentityRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewIdentityRepository(db *pgxpool.Pool) *IdentityRepository {
+	return &IdentityRepository{db: db}
+}
+
+func (r *IdentityRepository) CreateIdentity(ctx context.Context, input *model.RepositoryCreateIdentityInput) (*model.Identity, error) {
+	return createIdentity(ctx, r.db, input)
+}
+
+func (r *IdentityRepository) GetIdentity(ctx context.Context, provider string, subject string) (*model.Identity, error) {
+	query := `
+SELECT id, user_id, provider, subject, email, created_at
+FROM user_identities
+WHERE provider = $1 AND subject = $2
+`
+	var identity model.Identity
+	err := pgxscan.Get(ctx, r.db, &identity, query, provider, subject)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return &identity, nil
+}
+
+func (r *IdentityRepository) ListIdentities(ctx context.Context, userId uuid.UUID) ([]*model.Identity, error) {
+	query := `
+SELECT id, user_id, provider, subject, email, created_at
+FROM user_identities
+WHERE user_id = $1
+ORDER BY created_at
+`
+	var identities []*model.Identity
+	err := pgxscan.Select(ctx, r.db, &identities, query, userId)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return identities, nil
+}
+
+func (r *IdentityRepository) DeleteIdentity(ctx context.Context, userId uuid.UUID, id uuid.UUID) error {
+	query := `DELETE FROM user_identities WHERE id = $1 AND user_id = $2`
+	tag, err := r.db.Exec(ctx, query, id, userId)
+	if err != nil {
+		return handleError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return errdefs.ErrNotFound
+	}
+	return nil
+}
+
+func (r *IdentityRepository) CreateEmailLoginToken(ctx context.Context, input *model.RepositoryCreateEmailLoginTokenInput) (*model.EmailLoginToken, error) {
+	query := `
+INSERT INTO email_login_tokens (id, token_hash, email, role, link_user_id, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, token_hash, email, role, link_user_id, expires_at, used_at, created_at
+`
+	var token model.EmailLoginToken
+	err := pgxscan.Get(ctx, r.db, &token, query,
+		input.Id,
+		input.TokenHash,
+		input.Email,
+		input.Role,
+		input.LinkUserId,
+		input.ExpiresAt,
+	)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return &token, nil
+}
+
+// UseEmailLoginToken marks the token as used.
+// Returns ErrNotFound if the token does not exist, is expired or was already used.
+func (r *IdentityRepository) UseEmailLoginToken(ctx context.Context, tokenHash string) (*model.EmailLoginToken, error) {
+	query := `
+UPDATE email_login_tokens SET used_at = now()
+WHERE token_hash = $1 AND used_at IS NULL AND expires_at > now()
+RETURNING id, token_hash, email, role, link_user_id, expires_at, used_at, created_at
+`
+	var token model.EmailLoginToken
+	err := pgxscan.Get(ctx, r.db, &token, query, tokenHash)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return &token, nil
+}
+
+func (r *IdentityRepository) CreateOidcLoginState(ctx context.Context, input *model.RepositoryCreateOidcLoginStateInput) (*model.OidcLoginState, error) {
+	query := `
+INSERT INTO oidc_login_states (id, state_hash, provider, nonce, code_verifier, role, link_user_id, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id, state_hash, provider, nonce, code_verifier, role, link_user_id, expires_at, used_at, created_at
+`
+	var state model.OidcLoginState
+	err := pgxscan.Get(ctx, r.db, &state, query,
+		input.Id,
+		input.StateHash,
+		input.Provider,
+		input.Nonce,
+		input.CodeVerifier,
+		input.Role,
+		input.LinkUserId,
+		input.ExpiresAt,
+	)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return &state, nil
+}
+
+// UseOidcLoginState marks the state as used.
+// Returns ErrNotFound if the state does not exist, is expired or was already used.
+func (r *IdentityRepository) UseOidcLoginState(ctx context.Context, stateHash string) (*model.OidcLoginState, error) {
+	query := `
+UPDATE oidc_login_states SET used_at = now()
+WHERE state_hash = $1 AND used_at IS NULL AND expires_at > now()
+RETURNING id, state_hash, provider, nonce, code_verifier, role, link_user_id, expires_at, used_at, created_at
+`
+	var state model.OidcLoginState
+	err := pgxscan.Get(ctx, r.db, &state, query, stateHash)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return &state, nil
+}
+
+func createIdentity(ctx context.Context, db pgxscan.Querier, input *model.RepositoryCreateIdentityInput) (*model.Identity, error) {
+	query := `
+INSERT INTO user_identities (id, user_id, provider, subject, email)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, user_id, provider, subject, email, created_at
+`
+	var identity model.Identity
+	err := pgxscan.Get(ctx, db, &identity, query,
+		input.Id,
+		input.UserId,
+		input.Provider,
+		input.Subject,
+		input.Email,
+	)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return &identity, nil
+}
@@ -55,22 +55,54 @@ func buildTutorProfileUpdateQuery(input *model.UpdateTutorProfileInput) (string,
 		args = append(args, input.PaymentInfo)
 		argIdx++
 	}
-	if input.LessonPriceRub != nil {
-		set = append(set, fmt.Sprintf("lesson_price_rub = $%d", argIdx))
-		args = append(args, input.LessonPriceRub)
-		argIdx++
+	if input.LessonPrice != nil {
+		set = append(set, fmt.Sprintf("lesson_price_minor = $%d, currency = $%d", argIdx, argIdx+1))
+		args = append(args, input.LessonPrice.Amount, input.LessonPrice.Currency)
+		argIdx += 2
 	}
 	if input.LessonConnectionLink != nil {
 		set = append(set, fmt.Sprintf("lesson_connection_link = $%d", argIdx))
 		args = append(args, input.LessonConnectionLink)
 		argIdx++
 	}
+	if input.Bio != nil {
+		set = append(set, fmt.Sprintf("bio = $%d", argIdx))
+		args = append(args, input.Bio)
+		argIdx++
+	}
+	if input.Subjects != nil {
+		set = append(set, fmt.Sprintf("subjects = $%d", argIdx))
+		args = append(args, input.Subjects)
+		argIdx++
+	}
+	if input.Languages != nil {
+		set = append(set, fmt.Sprintf("languages = $%d", argIdx))
+		args = append(args, input.Languages)
+		argIdx++
+	}
+	if input.GradeLevels != nil {
+		set = append(set, fmt.Sprintf("grade_levels = $%d", argIdx))
+		args = append(args, input.GradeLevels)
+		argIdx++
+	}
+	if input.RemoveAvatar {
+		set = append(set, "avatar_file_id = NULL")
+	} else if input.AvatarFileId != nil {
+		set = append(set, fmt.Sprintf("avatar_file_id = $%d", argIdx))
+		args = append(args, input.AvatarFileId)
+		argIdx++
+	}
+	if input.IsPublic != nil {
+		set = append(set, fmt.Sprintf("is_public = $%d", argIdx))
+		args = append(args, input.IsPublic)
+		argIdx++
+	}
 
 	query := fmt.Sprintf(`
 UPDATE tutor_profiles
 SET %s
 WHERE user_id = $%d
-RETURNING id, user_id, payment_info, lesson_price_rub, lesson_connection_link, created_at, edited_at
+RETURNING `+tutorProfileColumns+`
 `,
 		strings.Join(set, ", "),
 		argIdx,
@@ -84,30 +116,23 @@ func buildUpdateTutorStudentQuery(input *model.UpdateTutorStudentInput) (string,
 	var args []any
 	argIdx := 1
 
-	if input.LessonPriceRub != nil {
-		set = append(set, fmt.Sprintf("lesson_price_rub = $%d", argIdx))
-		args = append(args, input.LessonPriceRub)
-		argIdx++
+	if input.LessonPrice != nil {
+		set = append(set, fmt.Sprintf("lesson_price_minor = $%d, lesson_price_currency = $%d", argIdx, argIdx+1))
+		args = append(args, input.LessonPrice.Amount, input.LessonPrice.Currency)
+		argIdx += 2
 	}
 	if input.LessonConnectionLink != nil {
 		set = append(set, fmt.Sprintf("lesson_connection_link = $%d", argIdx))
 		args = append(args, input.LessonConnectionLink)
 		argIdx++
 	}
-	if input.Status != nil {
-		set = append(set, fmt.Sprintf("status = $%d", argIdx))
-		args = append(args, input.Status)
-		argIdx++
-	}
 
 	query := fmt.Sprintf(`
 UPDATE tutor_students
 SET %s
 WHERE tutor_id = $%d AND student_id = $%d
-RETURNING id, tutor_id, student_id,
-    lesson_price_rub, lesson_connection_link,
-    status, created_at, edited_at
-`, strings.Join(set, ", "), argIdx, argIdx+1)
+RETURNING %s
+`, strings.Join(set, ", "), argIdx, argIdx+1, tutorStudentColumns)
 
 	return query, args
 }
@@ -129,10 +154,7 @@ func buildListTutorStudentsQuery(tutorID uuid.UUID, studentID uuid.UUID) (string
 	}
 
 	query := `
-SELECT 
-    id, tutor_id, student_id, lesson_price_rub, 
-    lesson_connection_link, status, 
-    created_at, edited_at
+SELECT ` + tutorStudentColumns + `
 FROM tutor_students
 `
 	if len(where) > 0 {
@@ -141,3 +163,165 @@ FROM tutor_students
 
 	return query, args
 }
+
+func buildListGuardianStudentsQuery(guardianID uuid.UUID, studentID uuid.UUID) (string, []any) {
+	var where []string
+	var args []any
+	argIdx := 1
+
+	if guardianID != uuid.Nil {
+		where = append(where, fmt.Sprintf("guardian_id = $%d", argIdx))
+		args = append(args, guardianID)
+		argIdx++
+	}
+	if studentID != uuid.Nil {
+		where = append(where, fmt.Sprintf("student_id = $%d", argIdx))
+		args = append(args, studentID)
+		argIdx++
+	}
+
+	query := `
+SELECT id, guardian_id, student_id, status, created_at, edited_at
+FROM guardian_students
+`
+	if len(where) > 0 {
+		query += "WHERE " + strings.Join(where, " AND ") + "\n"
+	}
+
+	return query, args
+}
+
+func buildSearchUsersQuery(input *model.SearchUsersInput) (string, []any) {
+	var where []string
+	var args []any
+	argIdx := 1
+
+	if input.Query != "" {
+		where = append(where, fmt.Sprintf(`(
+    u.id::text = $%[1]d
+    OR u.first_name ILIKE $%[2]d
+    OR u.last_name ILIKE $%[2]d
+    OR EXISTS (SELECT 1 FROM telegram_accounts t WHERE t.user_id = u.id AND t.username ILIKE $%[2]d)
+    OR EXISTS (SELECT 1 FROM user_identities i WHERE i.user_id = u.id AND i.email ILIKE $%[2]d)
+)`, argIdx, argIdx+1))
+		args = append(args, input.Query, likePattern(input.Query))
+		argIdx += 2
+	}
+	if input.Role != nil {
+		where = append(where, fmt.Sprintf("u.role = $%d", argIdx))
+		args = append(args, input.Role)
+		argIdx++
+	}
+	if input.Status != nil {
+		where = append(where, fmt.Sprintf("u.status = $%d", argIdx))
+		args = append(args, input.Status)
+		argIdx++
+	}
+
+	query := `
+SELECT
+	u.id, u.role, u.auth_provider, u.status,
+	u.first_name, u.last_name, u.timezone,
+	u.created_at, u.edited_at
+FROM users u
+`
+	if len(where) > 0 {
+		query += "WHERE " + strings.Join(where, " AND ") + "\n"
+	}
+	query += fmt.Sprintf("ORDER BY u.created_at DESC\nLIMIT $%d OFFSET $%d\n", argIdx, argIdx+1)
+	args = append(args, input.Limit, input.Offset)
+
+	return query, args
+}
+
+// buildSearchTutorsQuery finds public profiles of active tutors, ranked by the full text match if query is set.
+func buildSearchTutorsQuery(input *model.SearchTutorsInput) (string, []any) {
+	where := []string{"tp.is_public", fmt.Sprintf("u.status = '%s'", model.UserStatusActive)}
+	var args []any
+	argIdx := 1
+
+	order := "tp.edited_at DESC"
+	if input.Query != "" {
+		where = append(where, fmt.Sprintf(`(
+    tp.search_vector @@ websearch_to_tsquery('russian', $%[1]d)
+    OR to_tsvector('russian', coalesce(u.first_name, '') || ' ' || coalesce(u.last_name, '')) @@ websearch_to_tsquery('russian', $%[1]d)
+)`, argIdx))
+		order = fmt.Sprintf("ts_rank(tp.search_vector, websearch_to_tsquery('russian', $%d)) DESC, ", argIdx) + order
+		args = append(args, input.Query)
+		argIdx++
+	}
+	if input.Subject != nil {
+		where = append(where, fmt.Sprintf("tp.subjects @> ARRAY[$%d]::text[]", argIdx))
+		args = append(args, input.Subject)
+		argIdx++
+	}
+	if input.Language != nil {
+		where = append(where, fmt.Sprintf("tp.languages @> ARRAY[$%d]::text[]", argIdx))
+		args = append(args, input.Language)
+		argIdx++
+	}
+	if input.GradeLevel != nil {
+		where = append(where, fmt.Sprintf("tp.grade_levels @> ARRAY[$%d]::text[]", argIdx))
+		args = append(args, input.GradeLevel)
+		argIdx++
+	}
+	if input.MinPrice != nil {
+		where = append(where, fmt.Sprintf("tp.currency = $%d AND tp.lesson_price_minor >= $%d", argIdx, argIdx+1))
+		args = append(args, input.MinPrice.Currency, input.MinPrice.Amount)
+		argIdx += 2
+	}
+	if input.MaxPrice != nil {
+		where = append(where, fmt.Sprintf("tp.currency = $%d AND tp.lesson_price_minor <= $%d", argIdx, argIdx+1))
+		args = append(args, input.MaxPrice.Currency, input.MaxPrice.Amount)
+		argIdx += 2
+	}
+
+	query := `
+SELECT
+	tp.id, tp.user_id, tp.payment_info,
+	tp.lesson_price_minor, tp.currency, tp.lesson_connection_link,
+	tp.bio, tp.subjects, tp.languages, tp.grade_levels, tp.avatar_file_id, tp.is_public,
+	tp.created_at, tp.edited_at,
+	u.first_name, u.last_name
+FROM tutor_profiles tp
+JOIN users u ON u.id = tp.user_id
+WHERE ` + strings.Join(where, " AND ") + "\n"
+	query += fmt.Sprintf("ORDER BY %s\nLIMIT $%d OFFSET $%d\n", order, argIdx, argIdx+1)
+	args = append(args, input.Limit, input.Offset)
+
+	return query, args
+}
+
+func buildListAuditEventsQuery(input *model.ListAuditEventsInput) (string, []any) {
+	var where []string
+	var args []any
+	argIdx := 1
+
+	if input.AdminId != nil {
+		where = append(where, fmt.Sprintf("admin_id = $%d", argIdx))
+		args = append(args, input.AdminId)
+		argIdx++
+	}
+	if input.TargetId != nil {
+		where = append(where, fmt.Sprintf("target_id = $%d", argIdx))
+		args = append(args, input.TargetId)
+		argIdx++
+	}
+
+	query := `
+SELECT id, admin_id, action, target_type, target_id, details, created_at
+FROM admin_audit_events
+`
+	if len(where) > 0 {
+		query += "WHERE " + strings.Join(where, " AND ") + "\n"
+	}
+	query += fmt.Sprintf("ORDER BY created_at DESC\nLIMIT $%d OFFSET $%d\n", argIdx, argIdx+1)
+	args = append(args, input.Limit, input.Offset)
+
+	return query, args
+}
+
+// likePattern matches s anywhere, wildcards in s are matched literally.
+func likePattern(s string) string {
+	return "%" + strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s) + "%"
+}
@@ -0,0 +1,85 @@
+package data
+
+import (
+	"context"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"time"
+	"userservice/internal/model"
+)
+
+const sessionColumns = `id, user_id, refresh_token_hash, previous_refresh_token_hash, expires_at, revoked_at, created_at, edited_at`
+
+type SessionRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewSessionRepository(db *pgxpool.Pool) *SessionRepository {
+	return &SessionRepository{db: db}
+}
+
+func (r *SessionRepository) CreateSession(ctx context.Context, input *model.RepositoryCreateSessionInput) (*model.Session, error) {
+	query := `
+INSERT INTO sessions (id, user_id, refresh_token_hash, expires_at)
+VALUES ($1, $2, $3, $4)
+RETURNING ` + sessionColumns
+	var session model.Session
+	err := pgxscan.Get(ctx, r.db, &session, query,
+		input.Id,
+		input.UserId,
+		input.RefreshTokenHash,
+		input.ExpiresAt,
+	)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return &session, nil
+}
+
+func (r *SessionRepository) GetSession(ctx context.Context, id uuid.UUID) (*model.Session, error) {
+	query := `SELECT ` + sessionColumns + `
+FROM sessions
+WHERE id = $1
+`
+	var session model.Session
+	err := pgxscan.Get(ctx, r.db, &session, query, id)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return &session, nil
+}
+
+// RotateRefreshToken replaces the refresh token hash if it still equals oldHash and keeps oldHash to detect reuse.
+// Returns ErrNotFound if the token was already rotated or the session is revoked.
+func (r *SessionRepository) RotateRefreshToken(ctx context.Context, id uuid.UUID, oldHash string, newHash string, expiresAt time.Time) (*model.Session, error) {
+	query := `
+UPDATE sessions SET previous_refresh_token_hash = refresh_token_hash, refresh_token_hash = $1, expires_at = $2
+WHERE id = $3 AND refresh_token_hash = $4 AND revoked_at IS NULL
+RETURNING ` + sessionColumns
+	var session model.Session
+	err := pgxscan.Get(ctx, r.db, &session, query, newHash, expiresAt, id, oldHash)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return &session, nil
+}
+
+func (r *SessionRepository) RevokeSession(ctx context.Context, id uuid.UUID) error {
+	query := `UPDATE sessions SET revoked_at = now() WHERE id = $1 AND revoked_at IS NULL`
+	_, err := r.db.Exec(ctx, query, id)
+	if err != nil {
+		return handleError(err)
+	}
+	return nil
+}
+
+// RevokeUserSessions logs the user out everywhere.
+func (r *SessionRepository) RevokeUserSessions(ctx context.Context, userId uuid.UUID) error {
+	query := `UPDATE sessions SET revoked_at = now() WHERE user_id = $1 AND revoked_at IS NULL`
+	_, err := r.db.Exec(ctx, query, userId)
+	if err != nil {
+		return handleError(err)
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"userservice/internal/errdefs"
+	"userservice/internal/model"
+)
+
+const tutorInviteColumns = `id, tutor_id, code,
+    lesson_price_minor, lesson_price_currency, lesson_connection_link,
+    max_uses, uses, expires_at, revoked_at, created_at`
+
+type TutorInviteRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewTutorInviteRepository(db *pgxpool.Pool) *TutorInviteRepository {
+	return &TutorInviteRepository{db: db}
+}
+
+func (r *TutorInviteRepository) CreateTutorInvite(ctx context.Context, input *model.RepositoryCreateTutorInviteInput) (*model.TutorInvite, error) {
+	query := `
+INSERT INTO tutor_invites (
+	id, tutor_id, code,
+    lesson_price_minor, lesson_price_currency, lesson_connection_link,
+    max_uses, expires_at
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING ` + tutorInviteColumns
+	var invite model.TutorInvite
+	err := pgxscan.Get(ctx, r.db, &invite, query,
+		input.Id,
+		input.TutorId,
+		input.Code,
+		input.LessonPriceMinor,
+		input.LessonPriceCurrency,
+		input.LessonConnectionLink,
+		input.MaxUses,
+		input.ExpiresAt,
+	)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return &invite, nil
+}
+
+// ListTutorInvites returns invites of the tutor, newest first.
+func (r *TutorInviteRepository) ListTutorInvites(ctx context.Context, tutorId uuid.UUID) ([]*model.TutorInvite, error) {
+	query := `
+SELECT ` + tutorInviteColumns + `
+FROM tutor_invites
+WHERE tutor_id = $1
+ORDER BY created_at DESC
+`
+	var invites []*model.TutorInvite
+	err := pgxscan.Select(ctx, r.db, &invites, query, tutorId)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return invites, nil
+}
+
+// RevokeTutorInvite returns ErrNotFound if the tutor has no such invite.
+func (r *TutorInviteRepository) RevokeTutorInvite(ctx context.Context, tutorId uuid.UUID, id uuid.UUID) (*model.TutorInvite, error) {
+	query := `
+UPDATE tutor_invites SET revoked_at = COALESCE(revoked_at, now())
+WHERE id = $1 AND tutor_id = $2
+RETURNING ` + tutorInviteColumns
+	var invite model.TutorInvite
+	err := pgxscan.Get(ctx, r.db, &invite, query, id, tutorId)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return &invite, nil
+}
+
+// RedeemTutorInvite uses the invite and makes the pair active in one transaction.
+// An invited pair is activated, the price and link of the invite override its own.
+// Returns ErrInviteUnavailable if the invite cannot be used and ErrAlreadyExists if the pair is already active.
+func (r *TutorInviteRepository) RedeemTutorInvite(ctx context.Context, input *model.RepositoryRedeemTutorInviteInput) (*model.TutorStudent, error) {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, handleError(err)
+	}
+	defer tx.Rollback(ctx)
+
+	useQuery := `
+UPDATE tutor_invites SET uses = uses + 1
+WHERE code = $1 AND revoked_at IS NULL AND expires_at > now()
+    AND (max_uses IS NULL OR uses < max_uses)
+RETURNING ` + tutorInviteColumns
+	var invite model.TutorInvite
+	err = pgxscan.Get(ctx, tx, &invite, useQuery, input.Code)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, errdefs.ErrInviteUnavailable
+	}
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	pairQuery := `
+INSERT INTO tutor_students (
+	id, tutor_id, student_id,
+    lesson_price_minor, lesson_price_currency, lesson_connection_link,
+    status
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (tutor_id, student_id) DO UPDATE SET
+    lesson_price_minor = COALESCE(EXCLUDED.lesson_price_minor, tutor_students.lesson_price_minor),
+    lesson_price_currency = COALESCE(EXCLUDED.lesson_price_currency, tutor_students.lesson_price_currency),
+    lesson_connection_link = COALESCE(EXCLUDED.lesson_connection_link, tutor_students.lesson_connection_link),
+    status = EXCLUDED.status
+WHERE tutor_students.status = $8
+RETURNING ` + tutorStudentColumns
+	var ts model.TutorStudent
+	err = pgxscan.Get(ctx, tx, &ts, pairQuery,
+		input.PairId,
+		invite.TutorId,
+		input.StudentId,
+		invite.LessonPriceMinor,
+		invite.LessonPriceCurrency,
+		invite.LessonConnectionLink,
+		model.TutorStudentStatusActive,
+		model.TutorStudentStatusInvited,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, errdefs.ErrAlreadyExists
+	}
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	// the id of an existing pair is kept, so the invited pair was activated
+	if ts.Id != input.PairId {
+		if err := insertTutorStudentStatusEvent(ctx, tx, &model.TutorStudentStatusEvent{
+			Id:             input.EventId,
+			TutorStudentId: ts.Id,
+			FromStatus:     model.TutorStudentStatusInvited,
+			ToStatus:       ts.Status,
+			ChangedBy:      input.StudentId,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, handleError(err)
+	}
+	return &ts, nil
+}
@@ -4,10 +4,19 @@ import (
 	"context"
 	"github.com/georgysavva/scany/v2/pgxscan"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"slices"
+	"userservice/internal/errdefs"
 	"userservice/internal/model"
 )
 
+const tutorStudentColumns = `id, tutor_id, student_id,
+    lesson_price_minor, lesson_price_currency, lesson_connection_link,
+    status, status_reason, paused_at, archived_at, created_at, edited_at`
+
+const tutorStudentStatusEventColumns = `id, tutor_student_id, from_status, to_status, reason, changed_by, created_at`
+
 type TutorStudentRepository struct {
 	db *pgxpool.Pool
 }
@@ -20,20 +29,18 @@ func (r *TutorStudentRepository) CreateTutorStudent(ctx context.Context, input *
 	query := `
 INSERT INTO tutor_students (
 	id, tutor_id, student_id,
-    lesson_price_rub, lesson_connection_link,
+    lesson_price_minor, lesson_price_currency, lesson_connection_link,
     status
 )
-VALUES ($1, $2, $3, $4, $5, $6)
-RETURNING id, tutor_id, student_id,
-    lesson_price_rub, lesson_connection_link,
-    status, created_at, edited_at
-`
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING ` + tutorStudentColumns
 	var ts model.TutorStudent
 	err := pgxscan.Get(ctx, r.db, &ts, query,
 		input.Id,
 		input.TutorId,
 		input.StudentId,
-		input.LessonPriceRub,
+		input.LessonPriceMinor,
+		input.LessonPriceCurrency,
 		input.LessonConnectionLink,
 		input.Status,
 	)
@@ -58,11 +65,7 @@ func (r *TutorStudentRepository) UpdateTutorStudent(ctx context.Context, tutorId
 
 func (r *TutorStudentRepository) GetTutorStudent(ctx context.Context, tutorId uuid.UUID, studentId uuid.UUID) (*model.TutorStudent, error) {
 	query := `
-SELECT 
-	id, tutor_id, student_id, 
-	lesson_price_rub, lesson_connection_link,
-	status, created_at, edited_at
-
+SELECT ` + tutorStudentColumns + `
 FROM tutor_students
 WHERE tutor_id = $1 AND student_id = $2
 `
@@ -97,3 +100,90 @@ func (r *TutorStudentRepository) ListTutorStudents(ctx context.Context, tutorId
 	}
 	return rows, nil
 }
+
+// ChangeTutorStudentStatus updates the status and its timestamps and records the change in one transaction.
+// ErrInvalidStatusTransition is returned if the current status is not in input.From.
+func (r *TutorStudentRepository) ChangeTutorStudentStatus(ctx context.Context, input *model.RepositoryChangeTutorStudentStatusInput) (*model.TutorStudent, error) {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, handleError(err)
+	}
+	defer tx.Rollback(ctx)
+
+	var current model.TutorStudent
+	selectQuery := `
+SELECT ` + tutorStudentColumns + `
+FROM tutor_students
+WHERE tutor_id = $1 AND student_id = $2
+FOR UPDATE
+`
+	if err := pgxscan.Get(ctx, tx, &current, selectQuery, input.TutorId, input.StudentId); err != nil {
+		return nil, handleError(err)
+	}
+	if !slices.Contains(input.From, current.Status) {
+		return nil, errdefs.ErrInvalidStatusTransition
+	}
+
+	updateQuery := `
+UPDATE tutor_students
+SET status = $1,
+    status_reason = $2,
+    paused_at = CASE WHEN $1 = 'paused' THEN now() END,
+    archived_at = CASE WHEN $1 = 'archived' THEN now() END
+WHERE id = $3
+RETURNING ` + tutorStudentColumns
+	var ts model.TutorStudent
+	if err := pgxscan.Get(ctx, tx, &ts, updateQuery, input.To, input.Reason, current.Id); err != nil {
+		return nil, handleError(err)
+	}
+
+	if err := insertTutorStudentStatusEvent(ctx, tx, &model.TutorStudentStatusEvent{
+		Id:             input.EventId,
+		TutorStudentId: current.Id,
+		FromStatus:     current.Status,
+		ToStatus:       input.To,
+		Reason:         input.Reason,
+		ChangedBy:      input.ChangedBy,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, handleError(err)
+	}
+	return &ts, nil
+}
+
+// ListTutorStudentStatusEvents returns the status history of the pair, oldest first.
+func (r *TutorStudentRepository) ListTutorStudentStatusEvents(ctx context.Context, tutorStudentId uuid.UUID) ([]*model.TutorStudentStatusEvent, error) {
+	query := `
+SELECT ` + tutorStudentStatusEventColumns + `
+FROM tutor_student_status_events
+WHERE tutor_student_id = $1
+ORDER BY created_at, id
+`
+	var events []*model.TutorStudentStatusEvent
+	if err := pgxscan.Select(ctx, r.db, &events, query, tutorStudentId); err != nil {
+		return nil, handleError(err)
+	}
+	return events, nil
+}
+
+func insertTutorStudentStatusEvent(ctx context.Context, tx pgx.Tx, event *model.TutorStudentStatusEvent) error {
+	query := `
+INSERT INTO tutor_student_status_events (id, tutor_student_id, from_status, to_status, reason, changed_by)
+VALUES ($1, $2, $3, $4, $5, $6)
+`
+	_, err := tx.Exec(ctx, query,
+		event.Id,
+		event.TutorStudentId,
+		event.FromStatus,
+		event.ToStatus,
+		event.Reason,
+		event.ChangedBy,
+	)
+	if err != nil {
+		return handleError(err)
+	}
+	return nil
+}
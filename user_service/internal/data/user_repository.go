@@ -10,6 +10,11 @@ import (
 	"userservice/internal/service"
 )
 
+const tutorProfileColumns = `id, user_id, payment_info,
+	lesson_price_minor, currency, lesson_connection_link,
+	bio, subjects, languages, grade_levels, avatar_file_id, is_public,
+	created_at, edited_at`
+
 type UserRepository struct {
 	db *pgxpool.Pool
 }
@@ -55,13 +60,37 @@ func (r *UserRepository) UpdateUser(ctx context.Context, id uuid.UUID, input *mo
 	return &user, nil
 }
 
-func (r *UserRepository) GetTutorProfile(ctx context.Context, userId uuid.UUID) (*model.TutorProfile, error) {
+func (r *UserRepository) UpdateUserStatus(ctx context.Context, id uuid.UUID, status model.UserStatus) (*model.User, error) {
 	query := `
-SELECT 
-	id, user_id, payment_info, 
-	lesson_price_rub, lesson_connection_link, 
-	created_at, edited_at 
+UPDATE users
+SET status = $1
+WHERE id = $2
+RETURNING
+	id, role, auth_provider, status,
+	first_name, last_name, timezone,
+	created_at, edited_at
+`
+	var user model.User
+	err := pgxscan.Get(ctx, r.db, &user, query, status, id)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return &user, nil
+}
 
+func (r *UserRepository) SearchUsers(ctx context.Context, input *model.SearchUsersInput) ([]*model.User, error) {
+	query, args := buildSearchUsersQuery(input)
+	var users []*model.User
+	err := pgxscan.Select(ctx, r.db, &users, query, args...)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return users, nil
+}
+
+func (r *UserRepository) GetTutorProfile(ctx context.Context, userId uuid.UUID) (*model.TutorProfile, error) {
+	query := `
+SELECT ` + tutorProfileColumns + `
 FROM tutor_profiles
 WHERE user_id = $1
 `
@@ -87,6 +116,16 @@ func (r *UserRepository) UpdateTutorProfile(ctx context.Context, userId uuid.UUI
 	return &user, nil
 }
 
+func (r *UserRepository) SearchTutors(ctx context.Context, input *model.SearchTutorsInput) ([]*model.TutorSearchResult, error) {
+	query, args := buildSearchTutorsQuery(input)
+	var tutors []*model.TutorSearchResult
+	err := pgxscan.Select(ctx, r.db, &tutors, query, args...)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return tutors, nil
+}
+
 func (r *UserRepository) GetTelegramAccount(ctx context.Context, userId uuid.UUID) (*model.TelegramAccount, error) {
 	query := `
 SELECT id, user_id, telegram_id, username, created_at
@@ -119,6 +158,7 @@ type UserCreationRepositoryTxInterface interface {
 	CreateUser(ctx context.Context, input *model.RepositoryCreateUserInput) (*model.User, error)
 	CreateTutorProfile(ctx context.Context, input *model.RepositoryCreateTutorProfileInput) (*model.TutorProfile, error)
 	CreateTelegramAccount(ctx context.Context, input *model.RepositoryCreateTelegramAccountInput) (*model.TelegramAccount, error)
+	CreateIdentity(ctx context.Context, input *model.RepositoryCreateIdentityInput) (*model.Identity, error)
 	Commit(ctx context.Context) error
 	Rollback(ctx context.Context) error
 }
@@ -161,21 +201,17 @@ func (r *UserCreationRepository) CreateTutorProfile(ctx context.Context, input *
 	query := `
 INSERT INTO tutor_profiles (
 	id, user_id, payment_info, 
-	lesson_price_rub, lesson_connection_link
+	lesson_price_minor, currency, lesson_connection_link
 )
-VALUES ($1, $2, $3, $4, $5)
-RETURNING 
-	id, user_id, payment_info, 
-	lesson_price_rub, lesson_connection_link,
-	created_at, edited_at
-
-`
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING ` + tutorProfileColumns
 	var profile model.TutorProfile
 	err := pgxscan.Get(ctx, r.tx, &profile, query,
 		input.Id,
 		input.UserId,
 		input.PaymentInfo,
-		input.LessonPriceRub,
+		input.LessonPriceMinor,
+		input.Currency,
 		input.LessonConnectionLink,
 	)
 	if err != nil {
@@ -205,6 +241,10 @@ RETURNING id, user_id, telegram_id, username, created_at
 	return &account, nil
 }
 
+func (r *UserCreationRepository) CreateIdentity(ctx context.Context, input *model.RepositoryCreateIdentityInput) (*model.Identity, error) {
+	return createIdentity(ctx, r.tx, input)
+}
+
 func (r *UserCreationRepository) Commit(ctx context.Context) error {
 	err := r.tx.Commit(ctx)
 	return err
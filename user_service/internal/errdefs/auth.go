@@ -0,0 +1,39 @@
+package errdefs
+
+import "errors"
+
+// AuthFailure is an AuthenticationErr with the reason for logs and metrics.
+type AuthFailure struct {
+	Reason string
+}
+
+func (f *AuthFailure) Error() string {
+	return AuthenticationErr.Error() + ": " + f.Reason
+}
+
+func (f *AuthFailure) Unwrap() error {
+	return AuthenticationErr
+}
+
+var (
+	ErrAuthUnknownScheme  = &AuthFailure{Reason: "unknown_scheme"}
+	ErrAuthNotConfigured  = &AuthFailure{Reason: "not_configured"}
+	ErrAuthMalformed      = &AuthFailure{Reason: "malformed"}
+	ErrAuthExpired        = &AuthFailure{Reason: "expired"}
+	ErrAuthBadSignature   = &AuthFailure{Reason: "bad_signature"}
+	ErrAuthReplayed       = &AuthFailure{Reason: "replayed"}
+	ErrAuthUnknownUser    = &AuthFailure{Reason: "unknown_user"}
+	ErrAuthSessionRevoked = &AuthFailure{Reason: "session_revoked"}
+	ErrAuthTokenReuse     = &AuthFailure{Reason: "token_reuse"}
+	ErrAuthInvalidToken   = &AuthFailure{Reason: "invalid_token"}
+	ErrAuthUserBlocked    = &AuthFailure{Reason: "user_blocked"}
+)
+
+// AuthFailureReason returns the reason of an authentication error or "other".
+func AuthFailureReason(err error) string {
+	var failure *AuthFailure
+	if errors.As(err, &failure) {
+		return failure.Reason
+	}
+	return "other"
+}
@@ -8,4 +8,11 @@ var (
 	AuthenticationErr   = errors.New("authentication error")
 	ErrNotFound         = errors.New("user not found")
 	ErrPermissionDenied = errors.New("permission denied")
+	ErrNotConfigured    = errors.New("not configured")
+	// ErrLastIdentity is returned on removing the only sign in method of the user
+	ErrLastIdentity = errors.New("cannot remove the last sign in method")
+	// ErrInviteUnavailable is returned on redeeming an expired, revoked or used up invite
+	ErrInviteUnavailable = errors.New("invite is expired, revoked or used up")
+	// ErrInvalidStatusTransition is returned on changing the pair status in an unsupported way
+	ErrInvalidStatusTransition = errors.New("invalid tutor student status transition")
 )
@@ -0,0 +1,155 @@
+package handler
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"userservice/internal/errdefs"
+	"userservice/internal/model"
+	pb "userservice/pkg/api"
+)
+
+type AdminService interface {
+	SearchUsers(ctx context.Context, input *model.SearchUsersInput) ([]*model.User, error)
+	SuspendUser(ctx context.Context, id uuid.UUID, reason *string) (*model.User, error)
+	ReactivateUser(ctx context.Context, id uuid.UUID) (*model.User, error)
+	ListUserTutorStudents(ctx context.Context, userId uuid.UUID) ([]*model.TutorStudent, error)
+	RecordAuditEvent(ctx context.Context, input *model.RecordAuditEventInput) error
+	ListAuditEvents(ctx context.Context, input *model.ListAuditEventsInput) ([]*model.AuditEvent, error)
+}
+
+type AdminServiceServer struct {
+	pb.UnimplementedAdminServiceServer
+	service AdminService
+}
+
+func NewAdminServiceServer(adminService AdminService) *AdminServiceServer {
+	return &AdminServiceServer{service: adminService}
+}
+
+func (h *AdminServiceServer) SearchUsers(ctx context.Context, req *pb.SearchUsersRequest) (*pb.SearchUsersResponse, error) {
+	input := &model.SearchUsersInput{
+		Query:  req.GetQuery(),
+		Limit:  int(req.GetLimit()),
+		Offset: int(req.GetOffset()),
+	}
+	if req.Role != nil {
+		role := model.Role(req.GetRole())
+		input.Role = &role
+	}
+	if req.Status != nil {
+		userStatus := model.UserStatus(req.GetStatus())
+		input.Status = &userStatus
+	}
+
+	users, err := h.service.SearchUsers(ctx, input)
+	if err != nil {
+		return nil, mapError(err, errdefs.ValidationErr, errdefs.ErrPermissionDenied, errdefs.AuthenticationErr)
+	}
+
+	resp := &pb.SearchUsersResponse{Users: make([]*pb.User, len(users))}
+	for i, user := range users {
+		resp.Users[i] = toPbUser(user)
+	}
+	return resp, nil
+}
+
+func (h *AdminServiceServer) SuspendUser(ctx context.Context, req *pb.SuspendUserRequest) (*pb.User, error) {
+	id, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	user, err := h.service.SuspendUser(ctx, id, req.Reason)
+	if err != nil {
+		return nil, mapError(err, errdefs.ValidationErr, errdefs.ErrNotFound, errdefs.ErrPermissionDenied, errdefs.AuthenticationErr)
+	}
+
+	return toPbUser(user), nil
+}
+
+func (h *AdminServiceServer) ReactivateUser(ctx context.Context, req *pb.ReactivateUserRequest) (*pb.User, error) {
+	id, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	user, err := h.service.ReactivateUser(ctx, id)
+	if err != nil {
+		return nil, mapError(err, errdefs.ErrNotFound, errdefs.ErrPermissionDenied, errdefs.AuthenticationErr)
+	}
+
+	return toPbUser(user), nil
+}
+
+func (h *AdminServiceServer) ListUserTutorStudents(ctx context.Context, req *pb.ListUserTutorStudentsRequest) (*pb.ListTutorStudentsResponse, error) {
+	id, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tutorStudents, err := h.service.ListUserTutorStudents(ctx, id)
+	if err != nil {
+		return nil, mapError(err, errdefs.ErrNotFound, errdefs.ErrPermissionDenied, errdefs.AuthenticationErr)
+	}
+
+	resp := &pb.ListTutorStudentsResponse{Students: make([]*pb.TutorStudent, len(tutorStudents))}
+	for i, ts := range tutorStudents {
+		resp.Students[i] = toPbTutorStudent(ts)
+	}
+	return resp, nil
+}
+
+func (h *AdminServiceServer) RecordAuditEvent(ctx context.Context, req *pb.RecordAuditEventRequest) (*pb.Empty, error) {
+	err := h.service.RecordAuditEvent(ctx, &model.RecordAuditEventInput{
+		Action:     req.GetAction(),
+		TargetType: req.GetTargetType(),
+		TargetId:   req.GetTargetId(),
+		Details:    req.Details,
+	})
+	if err != nil {
+		return nil, mapError(err, errdefs.ValidationErr, errdefs.ErrPermissionDenied, errdefs.AuthenticationErr)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (h *AdminServiceServer) ListAuditEvents(ctx context.Context, req *pb.ListAuditEventsRequest) (*pb.ListAuditEventsResponse, error) {
+	input := &model.ListAuditEventsInput{
+		TargetId: req.TargetId,
+		Limit:    int(req.GetLimit()),
+		Offset:   int(req.GetOffset()),
+	}
+	if req.AdminId != nil {
+		adminId, err := uuid.Parse(req.GetAdminId())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		input.AdminId = &adminId
+	}
+
+	events, err := h.service.ListAuditEvents(ctx, input)
+	if err != nil {
+		return nil, mapError(err, errdefs.ValidationErr, errdefs.ErrPermissionDenied, errdefs.AuthenticationErr)
+	}
+
+	resp := &pb.ListAuditEventsResponse{Events: make([]*pb.AuditEvent, len(events))}
+	for i, event := range events {
+		resp.Events[i] = toPbAuditEvent(event)
+	}
+	return resp, nil
+}
+
+func toPbAuditEvent(event *model.AuditEvent) *pb.AuditEvent {
+	return &pb.AuditEvent{
+		Id:         event.Id.String(),
+		AdminId:    event.AdminId.String(),
+		Action:     event.Action,
+		TargetType: event.TargetType,
+		TargetId:   event.TargetId,
+		Details:    event.Details,
+		CreatedAt:  timestamppb.New(event.CreatedAt),
+	}
+}
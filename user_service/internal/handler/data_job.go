@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"userservice/internal/errdefs"
+	"userservice/internal/model"
+	pb "userservice/pkg/api"
+)
+
+func (h *UserServiceServer) DeleteAccount(ctx context.Context, req *pb.Empty) (*pb.DataJob, error) {
+	job, err := h.service.DeleteAccount(ctx)
+	if err != nil {
+		return nil, mapError(err, errdefs.AuthenticationErr, errdefs.ErrNotConfigured)
+	}
+
+	return toPbDataJob(job), nil
+}
+
+func (h *UserServiceServer) ExportMyData(ctx context.Context, req *pb.Empty) (*pb.DataJob, error) {
+	job, err := h.service.ExportMyData(ctx)
+	if err != nil {
+		return nil, mapError(err, errdefs.AuthenticationErr, errdefs.ErrNotConfigured)
+	}
+
+	return toPbDataJob(job), nil
+}
+
+func (h *UserServiceServer) GetDataJob(ctx context.Context, req *pb.GetDataJobRequest) (*pb.DataJob, error) {
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid id")
+	}
+
+	job, err := h.service.GetDataJob(ctx, id)
+	if err != nil {
+		return nil, mapError(err, errdefs.ErrNotFound, errdefs.ErrPermissionDenied, errdefs.AuthenticationErr)
+	}
+
+	return toPbDataJob(job), nil
+}
+
+func toPbDataJob(job *model.DataJob) *pb.DataJob {
+	resp := &pb.DataJob{
+		Id:        job.Id.String(),
+		UserId:    job.UserId.String(),
+		Kind:      job.Kind.String(),
+		Status:    job.Status.String(),
+		Step:      job.Step,
+		Error:     job.Error,
+		CreatedAt: timestamppb.New(job.CreatedAt),
+		EditedAt:  timestamppb.New(job.EditedAt),
+	}
+	if job.ResultFileId != nil {
+		resultFileId := job.ResultFileId.String()
+		resp.ResultFileId = &resultFileId
+	}
+	if job.FinishedAt != nil {
+		resp.FinishedAt = timestamppb.New(*job.FinishedAt)
+	}
+	return resp
+}
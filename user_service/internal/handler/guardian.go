@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"userservice/internal/errdefs"
+	"userservice/internal/model"
+	pb "userservice/pkg/api"
+)
+
+func (h *UserServiceServer) CreateGuardianStudent(ctx context.Context, req *pb.CreateGuardianStudentRequest) (*pb.GuardianStudent, error) {
+	guardianId, studentId, err := parseGuardianStudentIds(req.GetGuardianId(), req.GetStudentId())
+	if err != nil {
+		return nil, err
+	}
+
+	gs, err := h.service.CreateGuardianStudent(ctx, guardianId, studentId)
+	if err != nil {
+		return nil, mapError(err, errdefs.ValidationErr, errdefs.ErrNotFound, errdefs.ErrAlreadyExists, errdefs.ErrPermissionDenied, errdefs.AuthenticationErr)
+	}
+
+	return toPbGuardianStudent(gs), nil
+}
+
+func (h *UserServiceServer) GetGuardianStudent(ctx context.Context, req *pb.GetGuardianStudentRequest) (*pb.GuardianStudent, error) {
+	guardianId, studentId, err := parseGuardianStudentIds(req.GetGuardianId(), req.GetStudentId())
+	if err != nil {
+		return nil, err
+	}
+
+	gs, err := h.service.GetGuardianStudent(ctx, guardianId, studentId)
+	if err != nil {
+		return nil, mapError(err, errdefs.ErrNotFound, errdefs.ErrPermissionDenied, errdefs.AuthenticationErr)
+	}
+
+	return toPbGuardianStudent(gs), nil
+}
+
+func (h *UserServiceServer) DeleteGuardianStudent(ctx context.Context, req *pb.DeleteGuardianStudentRequest) (*pb.Empty, error) {
+	guardianId, studentId, err := parseGuardianStudentIds(req.GetGuardianId(), req.GetStudentId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.service.DeleteGuardianStudent(ctx, guardianId, studentId); err != nil {
+		return nil, mapError(err, errdefs.ErrNotFound, errdefs.ErrPermissionDenied, errdefs.AuthenticationErr)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (h *UserServiceServer) ListGuardianStudents(ctx context.Context, req *pb.ListGuardianStudentsRequest) (*pb.ListGuardianStudentsResponse, error) {
+	guardianId, err := uuid.Parse(req.GetGuardianId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	links, err := h.service.ListGuardianStudents(ctx, guardianId)
+	if err != nil {
+		return nil, mapError(err, errdefs.ErrPermissionDenied, errdefs.AuthenticationErr)
+	}
+
+	return toPbListGuardianStudents(links), nil
+}
+
+func (h *UserServiceServer) ListGuardiansForStudent(ctx context.Context, req *pb.ListGuardiansForStudentRequest) (*pb.ListGuardianStudentsResponse, error) {
+	studentId, err := uuid.Parse(req.GetStudentId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	links, err := h.service.ListGuardiansForStudent(ctx, studentId)
+	if err != nil {
+		return nil, mapError(err, errdefs.ErrPermissionDenied, errdefs.AuthenticationErr)
+	}
+
+	return toPbListGuardianStudents(links), nil
+}
+
+func (h *UserServiceServer) AcceptGuardianRequest(ctx context.Context, req *pb.AcceptGuardianRequestRequest) (*pb.Empty, error) {
+	guardianId, err := uuid.Parse(req.GetGuardianId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := h.service.AcceptGuardianRequest(ctx, guardianId); err != nil {
+		return nil, mapError(err, errdefs.ErrPermissionDenied, errdefs.ErrNotFound, errdefs.AuthenticationErr)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func parseGuardianStudentIds(guardian string, student string) (uuid.UUID, uuid.UUID, error) {
+	guardianId, err := uuid.Parse(guardian)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, "invalid guardian id")
+	}
+	studentId, err := uuid.Parse(student)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, "invalid student id")
+	}
+	return guardianId, studentId, nil
+}
+
+func toPbListGuardianStudents(links []*model.GuardianStudent) *pb.ListGuardianStudentsResponse {
+	resp := &pb.ListGuardianStudentsResponse{GuardianStudents: make([]*pb.GuardianStudent, 0, len(links))}
+	for _, gs := range links {
+		resp.GuardianStudents = append(resp.GuardianStudents, toPbGuardianStudent(gs))
+	}
+	return resp
+}
+
+func toPbGuardianStudent(gs *model.GuardianStudent) *pb.GuardianStudent {
+	return &pb.GuardianStudent{
+		Id:         gs.Id.String(),
+		GuardianId: gs.GuardianId.String(),
+		StudentId:  gs.StudentId.String(),
+		Status:     gs.Status.String(),
+		CreatedAt:  timestamppb.New(gs.CreatedAt),
+		EditedAt:   timestamppb.New(gs.EditedAt),
+	}
+}
@@ -1,7 +1,9 @@
 package handler
 
 import (
+	"common_library/authtoken"
 	"common_library/logging"
+	"common_library/money"
 	"context"
 	"errors"
 	"github.com/google/uuid"
@@ -18,19 +20,50 @@ import (
 type UserService interface {
 	RegisterViaTelegram(ctx context.Context, input *model.RegisterViaTelegramInput) (*model.User, error)
 	Authorize(ctx context.Context, input *model.AuthorizeInput) (*model.User, error)
+	CreateSession(ctx context.Context, input *model.AuthorizeInput) (*model.SessionTokens, error)
+	RefreshSession(ctx context.Context, refreshToken string) (*model.SessionTokens, error)
+	RevokeSession(ctx context.Context, refreshToken string) error
+	IsSessionActive(ctx context.Context, sessionId uuid.UUID) (bool, error)
+	GetSigningKeys(ctx context.Context) authtoken.KeySet
+	RequestEmailLogin(ctx context.Context, input *model.RequestEmailLoginInput) error
+	RequestEmailLink(ctx context.Context, email string) error
+	VerifyEmailLogin(ctx context.Context, token string) (*model.SessionTokens, error)
+	StartOidcLogin(ctx context.Context, role *model.Role) (string, error)
+	StartOidcLink(ctx context.Context) (string, error)
+	CompleteOidcLogin(ctx context.Context, state string, code string) (*model.SessionTokens, error)
+	ListMyIdentities(ctx context.Context) ([]*model.Identity, error)
+	DeleteIdentity(ctx context.Context, id uuid.UUID) error
 	GetMe(ctx context.Context) (*model.User, error)
 	GetUserPublic(ctx context.Context, id uuid.UUID) (*model.UserPublic, error)
 	UpdateUser(ctx context.Context, id uuid.UUID, input *model.UpdateUserInput) (*model.User, error)
 	GetTutorProfile(ctx context.Context, userId uuid.UUID) (*model.TutorProfile, error)
 	UpdateTutorProfile(ctx context.Context, userId uuid.UUID, input *model.UpdateTutorProfileInput) (*model.TutorProfile, error)
+	GetTutorPublicProfile(ctx context.Context, tutorId uuid.UUID) (*model.TutorPublicProfile, error)
+	SearchTutors(ctx context.Context, input *model.SearchTutorsInput) ([]*model.TutorPublicProfile, error)
 	CreateTutorStudent(ctx context.Context, input *model.CreateTutorStudentInput) (*model.TutorStudent, error)
 	GetTutorStudent(ctx context.Context, tutorId uuid.UUID, studentId uuid.UUID) (*model.TutorStudent, error)
 	UpdateTutorStudent(ctx context.Context, tutorId uuid.UUID, studentId uuid.UUID, input *model.UpdateTutorStudentInput) (*model.TutorStudent, error)
 	DeleteTutorStudent(ctx context.Context, tutorId uuid.UUID, studentId uuid.UUID) error
+	ChangeTutorStudentStatus(ctx context.Context, tutorId uuid.UUID, studentId uuid.UUID, status model.TutorStudentStatus, reason *string) (*model.TutorStudent, error)
+	ListTutorStudentStatusEvents(ctx context.Context, tutorId uuid.UUID, studentId uuid.UUID) ([]*model.TutorStudentStatusEvent, error)
 	ListTutorStudents(ctx context.Context, tutorId uuid.UUID) ([]*model.TutorStudent, error)
 	ListTutorStudentsForStudent(ctx context.Context, studentId uuid.UUID) ([]*model.TutorStudent, error)
 	ResolveTutorStudentContext(ctx context.Context, tutorId uuid.UUID, studentId uuid.UUID) (*model.TutorStudentContext, error)
 	AcceptInvitationFromTutor(ctx context.Context, tutorId uuid.UUID) error
+	RejectInvitation(ctx context.Context, tutorId uuid.UUID) error
+	CreateTutorInvite(ctx context.Context, input *model.CreateTutorInviteInput) (*model.TutorInvite, error)
+	ListTutorInvites(ctx context.Context, tutorId uuid.UUID) ([]*model.TutorInvite, error)
+	RevokeTutorInvite(ctx context.Context, id uuid.UUID) (*model.TutorInvite, error)
+	RedeemInvite(ctx context.Context, code string) (*model.TutorStudent, error)
+	CreateGuardianStudent(ctx context.Context, guardianId uuid.UUID, studentId uuid.UUID) (*model.GuardianStudent, error)
+	GetGuardianStudent(ctx context.Context, guardianId uuid.UUID, studentId uuid.UUID) (*model.GuardianStudent, error)
+	DeleteGuardianStudent(ctx context.Context, guardianId uuid.UUID, studentId uuid.UUID) error
+	ListGuardianStudents(ctx context.Context, guardianId uuid.UUID) ([]*model.GuardianStudent, error)
+	ListGuardiansForStudent(ctx context.Context, studentId uuid.UUID) ([]*model.GuardianStudent, error)
+	AcceptGuardianRequest(ctx context.Context, guardianId uuid.UUID) error
+	DeleteAccount(ctx context.Context) (*model.DataJob, error)
+	ExportMyData(ctx context.Context) (*model.DataJob, error)
+	GetDataJob(ctx context.Context, id uuid.UUID) (*model.DataJob, error)
 }
 
 type UserServiceServer struct {
@@ -54,9 +87,10 @@ func (h *UserServiceServer) RegisterViaTelegram(ctx context.Context, req *pb.Reg
 	if logger, ok := logging.GetFromContext(ctx); ok {
 		logger.Info(ctx, "registering user", zap.Any("input", input))
 	}
+	input.AuthorizationHeader = req.AuthorizationHeader
 	user, err := h.service.RegisterViaTelegram(ctx, input)
 	if err != nil {
-		return nil, mapError(err, errdefs.ErrAlreadyExists, errdefs.ValidationErr)
+		return nil, mapError(err, errdefs.ErrAlreadyExists, errdefs.ValidationErr, errdefs.AuthenticationErr, errdefs.ErrPermissionDenied)
 	}
 
 	return toPbUser(user), nil
@@ -148,15 +182,33 @@ func (h *UserServiceServer) UpdateTutorProfile(ctx context.Context, req *pb.Upda
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
+	lessonPrice, err := fromPbMoney(req.LessonPrice)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	input := &model.UpdateTutorProfileInput{
 		PaymentInfo:          req.PaymentInfo,
-		LessonPriceRub:       req.LessonPriceRub,
+		LessonPrice:          lessonPrice,
 		LessonConnectionLink: req.LessonConnectionLink,
+		Bio:                  req.Bio,
+		Subjects:             fromPbStringList(req.Subjects),
+		Languages:            fromPbStringList(req.Languages),
+		GradeLevels:          fromPbStringList(req.GradeLevels),
+		RemoveAvatar:         req.RemoveAvatar,
+		IsPublic:             req.IsPublic,
+	}
+	if req.AvatarFileId != nil {
+		avatarFileId, err := uuid.Parse(req.GetAvatarFileId())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid avatar file id")
+		}
+		input.AvatarFileId = &avatarFileId
 	}
 
 	profile, err := h.service.UpdateTutorProfile(ctx, id, input)
 	if err != nil {
-		return nil, mapError(err, errdefs.ErrNotFound, errdefs.ValidationErr, errdefs.ErrPermissionDenied)
+		return nil, mapError(err, errdefs.ErrNotFound, errdefs.ValidationErr, errdefs.ErrPermissionDenied, errdefs.ErrNotConfigured)
 	}
 
 	return toPbTutorProfile(profile), nil
@@ -173,10 +225,15 @@ func (h *UserServiceServer) CreateTutorStudent(ctx context.Context, req *pb.Crea
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
+	lessonPrice, err := fromPbMoney(req.LessonPrice)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	input := &model.CreateTutorStudentInput{
-		TutorId:        tutorId,
-		StudentId:      studentId,
-		LessonPriceRub: req.LessonPriceRub,
+		TutorId:     tutorId,
+		StudentId:   studentId,
+		LessonPrice: lessonPrice,
 	}
 
 	tutorStudent, err := h.service.CreateTutorStudent(ctx, input)
@@ -215,9 +272,15 @@ func (h *UserServiceServer) UpdateTutorStudent(ctx context.Context, req *pb.Upda
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
+	lessonPrice, err := fromPbMoney(req.LessonPrice)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	input := &model.UpdateTutorStudentInput{
-		LessonPriceRub:       req.LessonPriceRub,
+		LessonPrice:          lessonPrice,
 		LessonConnectionLink: req.LessonConnectionLink,
+		StatusReason:         req.StatusReason,
 	}
 
 	if req.Status != nil {
@@ -230,7 +293,7 @@ func (h *UserServiceServer) UpdateTutorStudent(ctx context.Context, req *pb.Upda
 
 	tutorStudent, err := h.service.UpdateTutorStudent(ctx, tutorId, studentId, input)
 	if err != nil {
-		return nil, mapError(err, errdefs.ErrNotFound, errdefs.ErrPermissionDenied, errdefs.ErrPermissionDenied)
+		return nil, mapError(err, errdefs.ErrNotFound, errdefs.ErrPermissionDenied, errdefs.ValidationErr, errdefs.ErrInvalidStatusTransition)
 	}
 
 	return toPbTutorStudent(tutorStudent), nil
@@ -249,7 +312,7 @@ func (h *UserServiceServer) DeleteTutorStudent(ctx context.Context, req *pb.Dele
 
 	err = h.service.DeleteTutorStudent(ctx, tutorId, studentId)
 	if err != nil {
-		return nil, mapError(err, errdefs.ErrNotFound, errdefs.ErrPermissionDenied)
+		return nil, mapError(err, errdefs.ErrNotFound, errdefs.ErrPermissionDenied, errdefs.ErrInvalidStatusTransition)
 	}
 
 	return &pb.Empty{}, nil
@@ -310,7 +373,7 @@ func (h *UserServiceServer) ResolveTutorStudentContext(ctx context.Context, req
 
 	resp := &pb.ResolvedTutorStudentContext{
 		RelationshipStatus:   result.RelationshipStatus.String(),
-		LessonPriceRub:       result.LessonPriceRub,
+		LessonPrice:          toPbMoney(result.LessonPrice),
 		LessonConnectionLink: result.LessonConnectionLink,
 		PaymentInfo:          result.PaymentInfo,
 	}
@@ -348,28 +411,69 @@ func toPbUser(user *model.User) *pb.User {
 }
 
 func toPbTutorProfile(profile *model.TutorProfile) *pb.TutorProfile {
-	return &pb.TutorProfile{
+	resp := &pb.TutorProfile{
 		Id:                   profile.Id.String(),
 		UserId:               profile.UserId.String(),
 		PaymentInfo:          profile.PaymentInfo,
-		LessonPriceRub:       profile.LessonPriceRub,
+		LessonPrice:          toPbMoney(profile.LessonPrice()),
+		Currency:             profile.Currency.String(),
 		LessonConnectionLink: profile.LessonConnectionLink,
+		Bio:                  profile.Bio,
+		Subjects:             profile.Subjects,
+		Languages:            profile.Languages,
+		GradeLevels:          profile.GradeLevels,
+		IsPublic:             profile.IsPublic,
 		CreatedAt:            timestamppb.New(profile.CreatedAt),
 		EditedAt:             timestamppb.New(profile.EditedAt),
 	}
+	if profile.AvatarFileId != nil {
+		avatarFileId := profile.AvatarFileId.String()
+		resp.AvatarFileId = &avatarFileId
+	}
+	return resp
 }
 
 func toPbTutorStudent(userStudent *model.TutorStudent) *pb.TutorStudent {
-	return &pb.TutorStudent{
+	resp := &pb.TutorStudent{
 		Id:                   userStudent.Id.String(),
 		TutorId:              userStudent.TutorId.String(),
 		StudentId:            userStudent.StudentId.String(),
 		Status:               userStudent.Status.String(),
-		LessonPriceRub:       userStudent.LessonPriceRub,
+		StatusReason:         userStudent.StatusReason,
+		LessonPrice:          toPbMoney(userStudent.LessonPrice()),
 		LessonConnectionLink: userStudent.LessonConnectionLink,
 		CreatedAt:            timestamppb.New(userStudent.CreatedAt),
 		EditedAt:             timestamppb.New(userStudent.EditedAt),
 	}
+	if userStudent.PausedAt != nil {
+		resp.PausedAt = timestamppb.New(*userStudent.PausedAt)
+	}
+	if userStudent.ArchivedAt != nil {
+		resp.ArchivedAt = timestamppb.New(*userStudent.ArchivedAt)
+	}
+	return resp
+}
+
+func toPbMoney(m *money.Money) *pb.Money {
+	if m == nil {
+		return nil
+	}
+	return &pb.Money{AmountMinor: m.Amount, Currency: m.Currency.String()}
+}
+
+func fromPbMoney(m *pb.Money) (*money.Money, error) {
+	if m == nil {
+		return nil, nil
+	}
+	currency, err := money.ParseCurrency(m.Currency)
+	if err != nil {
+		return nil, err
+	}
+	if m.AmountMinor < 0 {
+		return nil, errors.New("price must not be negative")
+	}
+	price := money.New(m.AmountMinor, currency)
+	return &price, nil
 }
 
 func mapError(err error, possibleErrors ...error) error {
@@ -392,6 +496,18 @@ func mapError(err error, possibleErrors ...error) error {
 	case errors.Is(err, errdefs.ErrPermissionDenied) && slices.Contains(possibleErrors, errdefs.ErrPermissionDenied):
 		return status.Errorf(codes.PermissionDenied, err.Error())
 
+	case errors.Is(err, errdefs.ErrLastIdentity) && slices.Contains(possibleErrors, errdefs.ErrLastIdentity):
+		return status.Error(codes.FailedPrecondition, err.Error())
+
+	case errors.Is(err, errdefs.ErrInviteUnavailable) && slices.Contains(possibleErrors, errdefs.ErrInviteUnavailable):
+		return status.Error(codes.FailedPrecondition, err.Error())
+
+	case errors.Is(err, errdefs.ErrInvalidStatusTransition) && slices.Contains(possibleErrors, errdefs.ErrInvalidStatusTransition):
+		return status.Error(codes.FailedPrecondition, err.Error())
+
+	case errors.Is(err, errdefs.ErrNotConfigured) && slices.Contains(possibleErrors, errdefs.ErrNotConfigured):
+		return status.Error(codes.Unimplemented, err.Error())
+
 	default:
 		return status.Errorf(codes.Internal, err.Error())
 	}
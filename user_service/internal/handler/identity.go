@@ -0,0 +1,118 @@
+package handler
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"userservice/internal/errdefs"
+	"userservice/internal/model"
+	pb "userservice/pkg/api"
+)
+
+func (h *UserServiceServer) RequestEmailLogin(ctx context.Context, req *pb.RequestEmailLoginRequest) (*pb.Empty, error) {
+	input := &model.RequestEmailLoginInput{
+		Email: req.GetEmail(),
+	}
+	if req.Role != nil {
+		role := model.Role(req.GetRole())
+		input.Role = &role
+	}
+
+	err := h.service.RequestEmailLogin(ctx, input)
+	if err != nil {
+		return nil, mapError(err, errdefs.ValidationErr, errdefs.ErrNotConfigured)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (h *UserServiceServer) VerifyEmailLogin(ctx context.Context, req *pb.VerifyEmailLoginRequest) (*pb.SessionTokens, error) {
+	tokens, err := h.service.VerifyEmailLogin(ctx, req.GetToken())
+	if err != nil {
+		return nil, mapError(err, errdefs.AuthenticationErr, errdefs.ErrAlreadyExists)
+	}
+
+	return toPbSessionTokens(tokens), nil
+}
+
+func (h *UserServiceServer) StartOidcLogin(ctx context.Context, req *pb.StartOidcLoginRequest) (*pb.OidcAuthorization, error) {
+	var role *model.Role
+	if req.Role != nil {
+		r := model.Role(req.GetRole())
+		role = &r
+	}
+
+	authorizationURL, err := h.service.StartOidcLogin(ctx, role)
+	if err != nil {
+		return nil, mapError(err, errdefs.ValidationErr, errdefs.ErrNotConfigured)
+	}
+
+	return &pb.OidcAuthorization{AuthorizationUrl: authorizationURL}, nil
+}
+
+func (h *UserServiceServer) CompleteOidcLogin(ctx context.Context, req *pb.CompleteOidcLoginRequest) (*pb.SessionTokens, error) {
+	tokens, err := h.service.CompleteOidcLogin(ctx, req.GetState(), req.GetCode())
+	if err != nil {
+		return nil, mapError(err, errdefs.AuthenticationErr, errdefs.ErrAlreadyExists, errdefs.ErrNotConfigured)
+	}
+
+	return toPbSessionTokens(tokens), nil
+}
+
+func (h *UserServiceServer) RequestEmailLink(ctx context.Context, req *pb.RequestEmailLinkRequest) (*pb.Empty, error) {
+	err := h.service.RequestEmailLink(ctx, req.GetEmail())
+	if err != nil {
+		return nil, mapError(err, errdefs.ValidationErr, errdefs.AuthenticationErr, errdefs.ErrAlreadyExists, errdefs.ErrNotConfigured)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (h *UserServiceServer) StartOidcLink(ctx context.Context, _ *pb.Empty) (*pb.OidcAuthorization, error) {
+	authorizationURL, err := h.service.StartOidcLink(ctx)
+	if err != nil {
+		return nil, mapError(err, errdefs.AuthenticationErr, errdefs.ErrNotConfigured)
+	}
+
+	return &pb.OidcAuthorization{AuthorizationUrl: authorizationURL}, nil
+}
+
+func (h *UserServiceServer) ListMyIdentities(ctx context.Context, _ *pb.Empty) (*pb.ListIdentitiesResponse, error) {
+	identities, err := h.service.ListMyIdentities(ctx)
+	if err != nil {
+		return nil, mapError(err, errdefs.AuthenticationErr)
+	}
+
+	resp := &pb.ListIdentitiesResponse{Identities: make([]*pb.Identity, 0, len(identities))}
+	for _, identity := range identities {
+		resp.Identities = append(resp.Identities, toPbIdentity(identity))
+	}
+
+	return resp, nil
+}
+
+func (h *UserServiceServer) DeleteIdentity(ctx context.Context, req *pb.DeleteIdentityRequest) (*pb.Empty, error) {
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid identity id")
+	}
+
+	err = h.service.DeleteIdentity(ctx, id)
+	if err != nil {
+		return nil, mapError(err, errdefs.AuthenticationErr, errdefs.ErrNotFound, errdefs.ErrPermissionDenied, errdefs.ErrLastIdentity)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func toPbIdentity(identity *model.Identity) *pb.Identity {
+	return &pb.Identity{
+		Id:        identity.Id.String(),
+		Provider:  identity.Provider,
+		Subject:   identity.Subject,
+		Email:     identity.Email,
+		CreatedAt: timestamppb.New(identity.CreatedAt),
+	}
+}
@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"userservice/internal/errdefs"
+	"userservice/internal/model"
+	pb "userservice/pkg/api"
+)
+
+func (h *UserServiceServer) RejectInvitation(ctx context.Context, req *pb.RejectInvitationRequest) (*pb.Empty, error) {
+	tutorId, err := uuid.Parse(req.GetTutorId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid tutor id")
+	}
+
+	if err := h.service.RejectInvitation(ctx, tutorId); err != nil {
+		return nil, mapError(err, errdefs.ErrNotFound, errdefs.ErrPermissionDenied, errdefs.AuthenticationErr)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (h *UserServiceServer) CreateInvite(ctx context.Context, req *pb.CreateInviteRequest) (*pb.Invite, error) {
+	tutorId, err := uuid.Parse(req.GetTutorId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid tutor id")
+	}
+
+	lessonPrice, err := fromPbMoney(req.LessonPrice)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	input := &model.CreateTutorInviteInput{
+		TutorId:              tutorId,
+		LessonPrice:          lessonPrice,
+		LessonConnectionLink: req.LessonConnectionLink,
+	}
+	if req.MaxUses != nil {
+		maxUses := int(req.GetMaxUses())
+		input.MaxUses = &maxUses
+	}
+	if req.ExpiresAt != nil {
+		expiresAt := req.GetExpiresAt().AsTime()
+		input.ExpiresAt = &expiresAt
+	}
+
+	invite, err := h.service.CreateTutorInvite(ctx, input)
+	if err != nil {
+		return nil, mapError(err, errdefs.ValidationErr, errdefs.ErrPermissionDenied, errdefs.AuthenticationErr)
+	}
+
+	return toPbInvite(invite), nil
+}
+
+func (h *UserServiceServer) ListInvites(ctx context.Context, req *pb.ListInvitesRequest) (*pb.ListInvitesResponse, error) {
+	tutorId, err := uuid.Parse(req.GetTutorId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid tutor id")
+	}
+
+	invites, err := h.service.ListTutorInvites(ctx, tutorId)
+	if err != nil {
+		return nil, mapError(err, errdefs.ErrPermissionDenied, errdefs.AuthenticationErr)
+	}
+
+	resp := &pb.ListInvitesResponse{Invites: make([]*pb.Invite, len(invites))}
+	for i, invite := range invites {
+		resp.Invites[i] = toPbInvite(invite)
+	}
+	return resp, nil
+}
+
+func (h *UserServiceServer) RevokeInvite(ctx context.Context, req *pb.RevokeInviteRequest) (*pb.Invite, error) {
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid invite id")
+	}
+
+	invite, err := h.service.RevokeTutorInvite(ctx, id)
+	if err != nil {
+		return nil, mapError(err, errdefs.ErrNotFound, errdefs.AuthenticationErr)
+	}
+
+	return toPbInvite(invite), nil
+}
+
+func (h *UserServiceServer) RedeemInvite(ctx context.Context, req *pb.RedeemInviteRequest) (*pb.TutorStudent, error) {
+	tutorStudent, err := h.service.RedeemInvite(ctx, req.GetCode())
+	if err != nil {
+		return nil, mapError(err, errdefs.ValidationErr, errdefs.ErrInviteUnavailable, errdefs.ErrAlreadyExists, errdefs.ErrPermissionDenied, errdefs.AuthenticationErr)
+	}
+
+	return toPbTutorStudent(tutorStudent), nil
+}
+
+func toPbInvite(invite *model.TutorInvite) *pb.Invite {
+	resp := &pb.Invite{
+		Id:                   invite.Id.String(),
+		TutorId:              invite.TutorId.String(),
+		Code:                 invite.Code,
+		Link:                 invite.Link,
+		LessonPrice:          toPbMoney(invite.LessonPrice()),
+		LessonConnectionLink: invite.LessonConnectionLink,
+		Uses:                 int32(invite.Uses),
+		ExpiresAt:            timestamppb.New(invite.ExpiresAt),
+		CreatedAt:            timestamppb.New(invite.CreatedAt),
+	}
+	if invite.MaxUses != nil {
+		maxUses := int32(*invite.MaxUses)
+		resp.MaxUses = &maxUses
+	}
+	if invite.RevokedAt != nil {
+		resp.RevokedAt = timestamppb.New(*invite.RevokedAt)
+	}
+	return resp
+}
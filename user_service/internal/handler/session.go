@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"userservice/internal/errdefs"
+	"userservice/internal/model"
+	pb "userservice/pkg/api"
+)
+
+func (h *UserServiceServer) CreateSession(ctx context.Context, req *pb.CreateSessionRequest) (*pb.SessionTokens, error) {
+	input := &model.AuthorizeInput{
+		AuthorizationHeader: req.GetAuthorizationHeader(),
+	}
+
+	tokens, err := h.service.CreateSession(ctx, input)
+	if err != nil {
+		return nil, mapError(err, errdefs.ValidationErr, errdefs.AuthenticationErr)
+	}
+
+	return toPbSessionTokens(tokens), nil
+}
+
+func (h *UserServiceServer) RefreshSession(ctx context.Context, req *pb.RefreshSessionRequest) (*pb.SessionTokens, error) {
+	tokens, err := h.service.RefreshSession(ctx, req.GetRefreshToken())
+	if err != nil {
+		return nil, mapError(err, errdefs.AuthenticationErr)
+	}
+
+	return toPbSessionTokens(tokens), nil
+}
+
+func (h *UserServiceServer) RevokeSession(ctx context.Context, req *pb.RevokeSessionRequest) (*pb.Empty, error) {
+	err := h.service.RevokeSession(ctx, req.GetRefreshToken())
+	if err != nil {
+		return nil, mapError(err, errdefs.AuthenticationErr)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (h *UserServiceServer) CheckSession(ctx context.Context, req *pb.CheckSessionRequest) (*pb.CheckSessionResponse, error) {
+	sessionId, err := uuid.Parse(req.GetSessionId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid session id")
+	}
+
+	active, err := h.service.IsSessionActive(ctx, sessionId)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &pb.CheckSessionResponse{Active: active}, nil
+}
+
+func (h *UserServiceServer) GetSigningKeys(ctx context.Context, _ *pb.Empty) (*pb.SigningKeySet, error) {
+	keySet := h.service.GetSigningKeys(ctx)
+
+	keys := make([]*pb.SigningKey, 0, len(keySet.Keys))
+	for _, key := range keySet.Keys {
+		keys = append(keys, &pb.SigningKey{
+			Kty: key.Kty,
+			Crv: key.Crv,
+			Alg: key.Alg,
+			Use: key.Use,
+			Kid: key.Kid,
+			X:   key.X,
+		})
+	}
+
+	return &pb.SigningKeySet{Keys: keys}, nil
+}
+
+func toPbSessionTokens(tokens *model.SessionTokens) *pb.SessionTokens {
+	return &pb.SessionTokens{
+		AccessToken:           tokens.AccessToken,
+		AccessTokenExpiresAt:  timestamppb.New(tokens.AccessTokenExpiresAt),
+		RefreshToken:          tokens.RefreshToken,
+		RefreshTokenExpiresAt: timestamppb.New(tokens.RefreshTokenExpiresAt),
+	}
+}
@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"userservice/internal/errdefs"
+	"userservice/internal/model"
+	pb "userservice/pkg/api"
+)
+
+func (h *UserServiceServer) GetTutorPublicProfile(ctx context.Context, req *pb.GetTutorPublicProfileRequest) (*pb.TutorPublicProfile, error) {
+	id, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+
+	profile, err := h.service.GetTutorPublicProfile(ctx, id)
+	if err != nil {
+		return nil, mapError(err, errdefs.ErrNotFound, errdefs.AuthenticationErr)
+	}
+
+	return toPbTutorPublicProfile(profile), nil
+}
+
+func (h *UserServiceServer) SearchTutors(ctx context.Context, req *pb.SearchTutorsRequest) (*pb.SearchTutorsResponse, error) {
+	minPrice, err := fromPbMoney(req.MinPrice)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	maxPrice, err := fromPbMoney(req.MaxPrice)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tutors, err := h.service.SearchTutors(ctx, &model.SearchTutorsInput{
+		Query:      req.GetQuery(),
+		Subject:    req.Subject,
+		Language:   req.Language,
+		GradeLevel: req.GradeLevel,
+		MinPrice:   minPrice,
+		MaxPrice:   maxPrice,
+		Limit:      int(req.GetLimit()),
+		Offset:     int(req.GetOffset()),
+	})
+	if err != nil {
+		return nil, mapError(err, errdefs.ValidationErr, errdefs.AuthenticationErr)
+	}
+
+	resp := &pb.SearchTutorsResponse{Tutors: make([]*pb.TutorPublicProfile, len(tutors))}
+	for i, tutor := range tutors {
+		resp.Tutors[i] = toPbTutorPublicProfile(tutor)
+	}
+	return resp, nil
+}
+
+func toPbTutorPublicProfile(tutor *model.TutorPublicProfile) *pb.TutorPublicProfile {
+	resp := &pb.TutorPublicProfile{
+		UserId:      tutor.User.Id.String(),
+		FirstName:   tutor.User.FirstName,
+		LastName:    tutor.User.LastName,
+		Bio:         tutor.Profile.Bio,
+		Subjects:    tutor.Profile.Subjects,
+		Languages:   tutor.Profile.Languages,
+		GradeLevels: tutor.Profile.GradeLevels,
+		LessonPrice: toPbMoney(tutor.Profile.LessonPrice()),
+		Currency:    tutor.Profile.Currency.String(),
+		AvatarUrl:   tutor.AvatarURL,
+		PaymentInfo: tutor.PaymentInfo,
+		IsPublic:    tutor.Profile.IsPublic,
+	}
+	if tutor.Profile.AvatarFileId != nil {
+		avatarFileId := tutor.Profile.AvatarFileId.String()
+		resp.AvatarFileId = &avatarFileId
+	}
+	return resp
+}
+
+// fromPbStringList returns nil if the list is not set and an empty slice if it is set to empty.
+func fromPbStringList(list *pb.StringList) []string {
+	if list == nil {
+		return nil
+	}
+	if list.Values == nil {
+		return []string{}
+	}
+	return list.Values
+}
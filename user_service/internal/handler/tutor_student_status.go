@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"userservice/internal/errdefs"
+	"userservice/internal/model"
+	pb "userservice/pkg/api"
+)
+
+func (h *UserServiceServer) ChangeTutorStudentStatus(ctx context.Context, req *pb.ChangeTutorStudentStatusRequest) (*pb.TutorStudent, error) {
+	tutorId, err := uuid.Parse(req.TutorId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	studentId, err := uuid.Parse(req.StudentId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tsStatus, ok := model.TutorStudentStatusFromString(req.Status)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid status")
+	}
+
+	tutorStudent, err := h.service.ChangeTutorStudentStatus(ctx, tutorId, studentId, tsStatus, req.Reason)
+	if err != nil {
+		return nil, mapError(err, errdefs.ErrNotFound, errdefs.ErrPermissionDenied, errdefs.ValidationErr, errdefs.ErrInvalidStatusTransition)
+	}
+
+	return toPbTutorStudent(tutorStudent), nil
+}
+
+func (h *UserServiceServer) ListTutorStudentStatusEvents(ctx context.Context, req *pb.ListTutorStudentStatusEventsRequest) (*pb.ListTutorStudentStatusEventsResponse, error) {
+	tutorId, err := uuid.Parse(req.TutorId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	studentId, err := uuid.Parse(req.StudentId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	events, err := h.service.ListTutorStudentStatusEvents(ctx, tutorId, studentId)
+	if err != nil {
+		return nil, mapError(err, errdefs.ErrNotFound, errdefs.ErrPermissionDenied)
+	}
+
+	resp := make([]*pb.TutorStudentStatusEvent, len(events))
+	for i, event := range events {
+		resp[i] = toPbTutorStudentStatusEvent(event)
+	}
+
+	return &pb.ListTutorStudentStatusEventsResponse{Events: resp}, nil
+}
+
+func toPbTutorStudentStatusEvent(event *model.TutorStudentStatusEvent) *pb.TutorStudentStatusEvent {
+	return &pb.TutorStudentStatusEvent{
+		Id:             event.Id.String(),
+		TutorStudentId: event.TutorStudentId.String(),
+		FromStatus:     event.FromStatus.String(),
+		ToStatus:       event.ToStatus.String(),
+		Reason:         event.Reason,
+		ChangedBy:      event.ChangedBy.String(),
+		CreatedAt:      timestamppb.New(event.CreatedAt),
+	}
+}
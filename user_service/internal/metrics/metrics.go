@@ -0,0 +1,15 @@
+// Package metrics exposes service counters in expvar format.
+package metrics
+
+import (
+	"expvar"
+	"net/http"
+)
+
+// AuthFailures counts failed authorizations by errdefs.AuthFailure reason.
+var AuthFailures = expvar.NewMap("auth_failures")
+
+// Handler serves all counters as JSON.
+func Handler() http.Handler {
+	return expvar.Handler()
+}
@@ -1,6 +1,10 @@
 package model
 
-import "github.com/google/uuid"
+import (
+	"common_library/money"
+	"github.com/google/uuid"
+	"time"
+)
 
 type RegisterViaTelegramInput struct {
 	TelegramId int64
@@ -9,6 +13,25 @@ type RegisterViaTelegramInput struct {
 	FirstName  *string
 	LastName   *string
 	Timezone   *string
+	// AuthorizationHeader proves the telegram account, required for the admin role
+	AuthorizationHeader *string
+}
+
+type RequestEmailLoginInput struct {
+	Email string
+	// Role registers a new user if nobody has the email yet
+	Role *Role
+}
+
+// IdentitySignInInput is a verified identity of an external provider.
+type IdentitySignInInput struct {
+	Provider   string
+	Subject    string
+	Email      *string
+	FirstName  *string
+	LastName   *string
+	Role       *Role
+	LinkUserId *uuid.UUID
 }
 
 type AuthorizeInput struct {
@@ -24,18 +47,77 @@ type UpdateUserInput struct {
 type CreateTutorStudentInput struct {
 	TutorId              uuid.UUID
 	StudentId            uuid.UUID
-	LessonPriceRub       *int32
+	LessonPrice          *money.Money
 	LessonConnectionLink *string
 }
 
 type UpdateTutorStudentInput struct {
-	LessonPriceRub       *int32
+	LessonPrice          *money.Money
 	LessonConnectionLink *string
 	Status               *TutorStudentStatus
+	// StatusReason is saved with the status change
+	StatusReason *string
+}
+
+type CreateTutorInviteInput struct {
+	TutorId              uuid.UUID
+	LessonPrice          *money.Money
+	LessonConnectionLink *string
+	// MaxUses is 1 by default, 0 for unlimited
+	MaxUses *int
+	// ExpiresAt is INVITE_TTL from now by default
+	ExpiresAt *time.Time
 }
 
 type UpdateTutorProfileInput struct {
-	PaymentInfo          *string
-	LessonPriceRub       *int32
+	PaymentInfo *string
+	// also sets the currency of the tutor
+	LessonPrice          *money.Money
 	LessonConnectionLink *string
+	Bio                  *string
+	// Subjects, Languages and GradeLevels replace the lists if not nil
+	Subjects     []string
+	Languages    []string
+	GradeLevels  []string
+	AvatarFileId *uuid.UUID
+	// RemoveAvatar unsets the avatar, AvatarFileId is ignored
+	RemoveAvatar bool
+	IsPublic     *bool
+}
+
+// SearchTutorsInput finds public tutors, Query is searched in full text over subjects, bio and names.
+// MinPrice and MaxPrice must be in the same currency, tutors without a price do not match them.
+type SearchTutorsInput struct {
+	Query      string
+	Subject    *string
+	Language   *string
+	GradeLevel *string
+	MinPrice   *money.Money
+	MaxPrice   *money.Money
+	Limit      int
+	Offset     int
+}
+
+// SearchUsersInput matches query against the id, names, telegram username and email of users.
+type SearchUsersInput struct {
+	Query  string
+	Role   *Role
+	Status *UserStatus
+	Limit  int
+	Offset int
+}
+
+// RecordAuditEventInput is an admin action performed by another service.
+type RecordAuditEventInput struct {
+	Action     string
+	TargetType string
+	TargetId   string
+	Details    *string
+}
+
+type ListAuditEventsInput struct {
+	AdminId  *uuid.UUID
+	TargetId *string
+	Limit    int
+	Offset   int
 }
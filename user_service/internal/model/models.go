@@ -1,6 +1,7 @@
 package model
 
 import (
+	"common_library/money"
 	"github.com/google/uuid"
 	"time"
 )
@@ -10,6 +11,10 @@ type Role string
 const (
 	RoleStudent Role = "student"
 	RoleTutor   Role = "tutor"
+	// RoleGuardian is a parent with read access to linked students
+	RoleGuardian Role = "guardian"
+	// RoleAdmin manages users through the back office, it cannot be chosen on sign up
+	RoleAdmin Role = "admin"
 )
 
 func (r Role) String() string {
@@ -17,13 +22,20 @@ func (r Role) String() string {
 }
 
 func (r Role) IsValid() bool {
-	return r == RoleStudent || r == RoleTutor
+	return r == RoleStudent || r == RoleTutor || r == RoleGuardian || r == RoleAdmin
+}
+
+// IsRegistrable reports whether users can choose the role on sign up.
+func (r Role) IsRegistrable() bool {
+	return r.IsValid() && r != RoleAdmin
 }
 
 type AuthProvider string
 
 const (
 	AuthProviderTelegram AuthProvider = "telegram"
+	AuthProviderEmail    AuthProvider = "email"
+	AuthProviderOidc     AuthProvider = "oidc"
 )
 
 func (a AuthProvider) String() string {
@@ -31,13 +43,15 @@ func (a AuthProvider) String() string {
 }
 
 func (a AuthProvider) IsValid() bool {
-	return a == AuthProviderTelegram
+	return a == AuthProviderTelegram || a == AuthProviderEmail || a == AuthProviderOidc
 }
 
 type UserStatus string
 
 const (
-	UserStatusActive  UserStatus = "active"
+	UserStatusActive UserStatus = "active"
+	// UserStatusBlocked is set by admins, blocked users cannot sign in
+	UserStatusBlocked UserStatus = "blocked"
 	UserStatusDeleted UserStatus = "deleted"
 )
 
@@ -46,7 +60,7 @@ func (u UserStatus) String() string {
 }
 
 func (u UserStatus) IsValid() bool {
-	return u == UserStatusActive || u == UserStatusDeleted
+	return u == UserStatusActive || u == UserStatusBlocked || u == UserStatusDeleted
 }
 
 type User struct {
@@ -70,13 +84,30 @@ type TelegramAccount struct {
 }
 
 type TutorProfile struct {
-	Id                   uuid.UUID `db:"id"`
-	UserId               uuid.UUID `db:"user_id"`
-	PaymentInfo          *string   `db:"payment_info"`
-	LessonPriceRub       *int32    `db:"lesson_price_rub"`
-	LessonConnectionLink *string   `db:"lesson_connection_link"`
-	CreatedAt            time.Time `db:"created_at"`
-	EditedAt             time.Time `db:"edited_at"`
+	Id                   uuid.UUID      `db:"id"`
+	UserId               uuid.UUID      `db:"user_id"`
+	PaymentInfo          *string        `db:"payment_info"`
+	LessonPriceMinor     *int64         `db:"lesson_price_minor"`
+	Currency             money.Currency `db:"currency"`
+	LessonConnectionLink *string        `db:"lesson_connection_link"`
+	Bio                  *string        `db:"bio"`
+	Subjects             []string       `db:"subjects"`
+	Languages            []string       `db:"languages"`
+	GradeLevels          []string       `db:"grade_levels"`
+	AvatarFileId         *uuid.UUID     `db:"avatar_file_id"`
+	// IsPublic profiles are found by SearchTutors
+	IsPublic  bool      `db:"is_public"`
+	CreatedAt time.Time `db:"created_at"`
+	EditedAt  time.Time `db:"edited_at"`
+}
+
+// LessonPrice returns the default lesson price of the tutor or nil if it is not set.
+func (p *TutorProfile) LessonPrice() *money.Money {
+	if p.LessonPriceMinor == nil {
+		return nil
+	}
+	price := money.New(*p.LessonPriceMinor, p.Currency)
+	return &price
 }
 
 type TutorStudentStatus string
@@ -84,6 +115,10 @@ type TutorStudentStatus string
 const (
 	TutorStudentStatusActive  TutorStudentStatus = "active"
 	TutorStudentStatusInvited TutorStudentStatus = "invited"
+	// TutorStudentStatusPaused stops new lessons and assignments until the tutor resumes the pair
+	TutorStudentStatusPaused TutorStudentStatus = "paused"
+	// TutorStudentStatusArchived ends the pair, the history stays visible
+	TutorStudentStatusArchived TutorStudentStatus = "archived"
 )
 
 func (t TutorStudentStatus) String() string {
@@ -91,7 +126,12 @@ func (t TutorStudentStatus) String() string {
 }
 
 func (t TutorStudentStatus) IsValid() bool {
-	return t == TutorStudentStatusInvited || t == TutorStudentStatusActive
+	switch t {
+	case TutorStudentStatusInvited, TutorStudentStatusActive, TutorStudentStatusPaused, TutorStudentStatusArchived:
+		return true
+	default:
+		return false
+	}
 }
 
 func TutorStudentStatusFromString(s string) (TutorStudentStatus, bool) {
@@ -103,25 +143,232 @@ type TutorStudent struct {
 	Id                   uuid.UUID          `db:"id"`
 	TutorId              uuid.UUID          `db:"tutor_id"`
 	StudentId            uuid.UUID          `db:"student_id"`
-	LessonPriceRub       *int32             `db:"lesson_price_rub"`
+	LessonPriceMinor     *int64             `db:"lesson_price_minor"`
+	LessonPriceCurrency  *money.Currency    `db:"lesson_price_currency"`
 	LessonConnectionLink *string            `db:"lesson_connection_link"`
 	Status               TutorStudentStatus `db:"status"`
+	StatusReason         *string            `db:"status_reason"`
+	PausedAt             *time.Time         `db:"paused_at"`
+	ArchivedAt           *time.Time         `db:"archived_at"`
 	CreatedAt            time.Time          `db:"created_at"`
 	EditedAt             time.Time          `db:"edited_at"`
 }
 
+// TutorStudentStatusEvent is a status change of the pair, ChangedBy is the tutor or the student.
+type TutorStudentStatusEvent struct {
+	Id             uuid.UUID          `db:"id"`
+	TutorStudentId uuid.UUID          `db:"tutor_student_id"`
+	FromStatus     TutorStudentStatus `db:"from_status"`
+	ToStatus       TutorStudentStatus `db:"to_status"`
+	Reason         *string            `db:"reason"`
+	ChangedBy      uuid.UUID          `db:"changed_by"`
+	CreatedAt      time.Time          `db:"created_at"`
+}
+
+type GuardianStudentStatus string
+
+const (
+	GuardianStudentStatusPending GuardianStudentStatus = "pending"
+	GuardianStudentStatusActive  GuardianStudentStatus = "active"
+)
+
+func (g GuardianStudentStatus) String() string {
+	return string(g)
+}
+
+// GuardianStudent links a guardian to a student, the link is active after the student accepts it.
+type GuardianStudent struct {
+	Id         uuid.UUID             `db:"id"`
+	GuardianId uuid.UUID             `db:"guardian_id"`
+	StudentId  uuid.UUID             `db:"student_id"`
+	Status     GuardianStudentStatus `db:"status"`
+	CreatedAt  time.Time             `db:"created_at"`
+	EditedAt   time.Time             `db:"edited_at"`
+}
+
+// LessonPrice returns the lesson price of the pair or nil if the tutor default is used.
+func (ts *TutorStudent) LessonPrice() *money.Money {
+	if ts.LessonPriceMinor == nil || ts.LessonPriceCurrency == nil {
+		return nil
+	}
+	price := money.New(*ts.LessonPriceMinor, *ts.LessonPriceCurrency)
+	return &price
+}
+
 // TutorStudentContext not from db
 type TutorStudentContext struct {
 	RelationshipStatus TutorStudentStatus
 
-	LessonPriceRub       *int32
+	LessonPrice          *money.Money
 	LessonConnectionLink *string
 	PaymentInfo          *string
 }
 
+// TutorInvite is a code the tutor shares with students, redeeming it creates an active pair.
+type TutorInvite struct {
+	Id                   uuid.UUID       `db:"id"`
+	TutorId              uuid.UUID       `db:"tutor_id"`
+	Code                 string          `db:"code"`
+	LessonPriceMinor     *int64          `db:"lesson_price_minor"`
+	LessonPriceCurrency  *money.Currency `db:"lesson_price_currency"`
+	LessonConnectionLink *string         `db:"lesson_connection_link"`
+	// MaxUses is nil for unlimited uses
+	MaxUses   *int       `db:"max_uses"`
+	Uses      int        `db:"uses"`
+	ExpiresAt time.Time  `db:"expires_at"`
+	RevokedAt *time.Time `db:"revoked_at"`
+	CreatedAt time.Time  `db:"created_at"`
+
+	// Link is the telegram deep link of the code, nil if the bot is not configured
+	Link *string `db:"-"`
+}
+
+// LessonPrice returns the price of the pairs created by the invite or nil if it is not set.
+func (i *TutorInvite) LessonPrice() *money.Money {
+	if i.LessonPriceMinor == nil || i.LessonPriceCurrency == nil {
+		return nil
+	}
+	price := money.New(*i.LessonPriceMinor, *i.LessonPriceCurrency)
+	return &price
+}
+
+// TutorSearchResult is a public tutor profile with the name of the tutor.
+type TutorSearchResult struct {
+	TutorProfile
+	FirstName *string `db:"first_name"`
+	LastName  *string `db:"last_name"`
+}
+
+// TutorPublicProfile is what students see about a tutor, not from db.
+// PaymentInfo is set only for the tutor and their active students.
+type TutorPublicProfile struct {
+	User    *UserPublic
+	Profile *TutorProfile
+
+	PaymentInfo *string
+	// AvatarURL is a download link of the avatar, nil if there is no avatar or file_service is not configured
+	AvatarURL *string
+}
+
 type UserPublic struct {
 	Id        uuid.UUID
 	Role      Role
 	FirstName *string
 	LastName  *string
 }
+
+type Session struct {
+	Id               uuid.UUID `db:"id"`
+	UserId           uuid.UUID `db:"user_id"`
+	RefreshTokenHash string    `db:"refresh_token_hash"`
+	// PreviousRefreshTokenHash is the hash of the last rotated token, presenting it again means reuse
+	PreviousRefreshTokenHash *string    `db:"previous_refresh_token_hash"`
+	ExpiresAt                time.Time  `db:"expires_at"`
+	RevokedAt                *time.Time `db:"revoked_at"`
+	CreatedAt                time.Time  `db:"created_at"`
+	EditedAt                 time.Time  `db:"edited_at"`
+}
+
+// IsActive reports whether the session can be refreshed at now.
+func (s *Session) IsActive(now time.Time) bool {
+	return s.RevokedAt == nil && now.Before(s.ExpiresAt)
+}
+
+// SessionTokens not from db
+type SessionTokens struct {
+	AccessToken           string
+	AccessTokenExpiresAt  time.Time
+	RefreshToken          string
+	RefreshTokenExpiresAt time.Time
+}
+
+// Identity is a sign in method of the user besides the telegram account.
+// Provider is "email" or the name of an OIDC provider.
+type Identity struct {
+	Id        uuid.UUID `db:"id"`
+	UserId    uuid.UUID `db:"user_id"`
+	Provider  string    `db:"provider"`
+	Subject   string    `db:"subject"`
+	Email     *string   `db:"email"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+const IdentityProviderEmail = "email"
+
+type EmailLoginToken struct {
+	Id         uuid.UUID  `db:"id"`
+	TokenHash  string     `db:"token_hash"`
+	Email      string     `db:"email"`
+	Role       *Role      `db:"role"`
+	LinkUserId *uuid.UUID `db:"link_user_id"`
+	ExpiresAt  time.Time  `db:"expires_at"`
+	UsedAt     *time.Time `db:"used_at"`
+	CreatedAt  time.Time  `db:"created_at"`
+}
+
+type OidcLoginState struct {
+	Id           uuid.UUID  `db:"id"`
+	StateHash    string     `db:"state_hash"`
+	Provider     string     `db:"provider"`
+	Nonce        string     `db:"nonce"`
+	CodeVerifier string     `db:"code_verifier"`
+	Role         *Role      `db:"role"`
+	LinkUserId   *uuid.UUID `db:"link_user_id"`
+	ExpiresAt    time.Time  `db:"expires_at"`
+	UsedAt       *time.Time `db:"used_at"`
+	CreatedAt    time.Time  `db:"created_at"`
+}
+
+// AuditEvent is an action of an admin, target id is empty for searches.
+type AuditEvent struct {
+	Id         uuid.UUID `db:"id"`
+	AdminId    uuid.UUID `db:"admin_id"`
+	Action     string    `db:"action"`
+	TargetType string    `db:"target_type"`
+	TargetId   string    `db:"target_id"`
+	Details    *string   `db:"details"`
+	CreatedAt  time.Time `db:"created_at"`
+}
+
+type DataJobKind string
+
+const (
+	// DataJobKindDeletion removes the data of a deleted account from the other services
+	DataJobKindDeletion DataJobKind = "delete"
+	// DataJobKindExport collects the data of the user into a zip archive in file_service
+	DataJobKindExport DataJobKind = "export"
+)
+
+func (k DataJobKind) String() string {
+	return string(k)
+}
+
+type DataJobStatus string
+
+const (
+	DataJobStatusPending DataJobStatus = "pending"
+	DataJobStatusRunning DataJobStatus = "running"
+	DataJobStatusDone    DataJobStatus = "done"
+	DataJobStatusFailed  DataJobStatus = "failed"
+)
+
+func (s DataJobStatus) String() string {
+	return string(s)
+}
+
+// DataJob is an account deletion or a data export processed by the background worker.
+type DataJob struct {
+	Id     uuid.UUID     `db:"id"`
+	UserId uuid.UUID     `db:"user_id"`
+	Kind   DataJobKind   `db:"kind"`
+	Status DataJobStatus `db:"status"`
+	// Step is the last finished step, nil before the first one
+	Step     *string `db:"step"`
+	Attempts int     `db:"attempts"`
+	// ResultFileId is the export archive in file_service
+	ResultFileId *uuid.UUID `db:"result_file_id"`
+	Error        *string    `db:"error"`
+	CreatedAt    time.Time  `db:"created_at"`
+	EditedAt     time.Time  `db:"edited_at"`
+	FinishedAt   *time.Time `db:"finished_at"`
+}
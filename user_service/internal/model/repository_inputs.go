@@ -1,7 +1,9 @@
 package model
 
 import (
+	"common_library/money"
 	"github.com/google/uuid"
+	"time"
 )
 
 type RepositoryCreateUserInput struct {
@@ -15,11 +17,12 @@ type RepositoryCreateUserInput struct {
 }
 
 type RepositoryCreateTutorProfileInput struct {
-	Id                   uuid.UUID `db:"id"`
-	UserId               uuid.UUID `db:"user_id"`
-	PaymentInfo          *string   `db:"payment_info"`
-	LessonPriceRub       *int32    `db:"lesson_price_rub"`
-	LessonConnectionLink *string   `db:"lesson_connection_link"`
+	Id                   uuid.UUID      `db:"id"`
+	UserId               uuid.UUID      `db:"user_id"`
+	PaymentInfo          *string        `db:"payment_info"`
+	LessonPriceMinor     *int64         `db:"lesson_price_minor"`
+	Currency             money.Currency `db:"currency"`
+	LessonConnectionLink *string        `db:"lesson_connection_link"`
 }
 
 type RepositoryCreateTelegramAccountInput struct {
@@ -33,7 +36,96 @@ type RepositoryCreateTutorStudentInput struct {
 	Id                   uuid.UUID          `db:"id"`
 	TutorId              uuid.UUID          `db:"tutor_id"`
 	StudentId            uuid.UUID          `db:"student_id"`
-	LessonPriceRub       *int32             `db:"lesson_price_rub"`
+	LessonPriceMinor     *int64             `db:"lesson_price_minor"`
+	LessonPriceCurrency  *money.Currency    `db:"lesson_price_currency"`
 	LessonConnectionLink *string            `db:"lesson_connection_link"`
 	Status               TutorStudentStatus `db:"status"`
 }
+
+type RepositoryCreateTutorInviteInput struct {
+	Id                   uuid.UUID       `db:"id"`
+	TutorId              uuid.UUID       `db:"tutor_id"`
+	Code                 string          `db:"code"`
+	LessonPriceMinor     *int64          `db:"lesson_price_minor"`
+	LessonPriceCurrency  *money.Currency `db:"lesson_price_currency"`
+	LessonConnectionLink *string         `db:"lesson_connection_link"`
+	MaxUses              *int            `db:"max_uses"`
+	ExpiresAt            time.Time       `db:"expires_at"`
+}
+
+// RepositoryRedeemTutorInviteInput uses the invite and creates the pair, PairId is used for a new pair.
+// EventId is used if an invited pair becomes active.
+type RepositoryRedeemTutorInviteInput struct {
+	Code      string
+	StudentId uuid.UUID
+	PairId    uuid.UUID
+	EventId   uuid.UUID
+}
+
+// RepositoryChangeTutorStudentStatusInput changes the status of the pair if it is one of From.
+type RepositoryChangeTutorStudentStatusInput struct {
+	EventId   uuid.UUID
+	TutorId   uuid.UUID
+	StudentId uuid.UUID
+	From      []TutorStudentStatus
+	To        TutorStudentStatus
+	Reason    *string
+	ChangedBy uuid.UUID
+}
+
+type RepositoryCreateSessionInput struct {
+	Id               uuid.UUID `db:"id"`
+	UserId           uuid.UUID `db:"user_id"`
+	RefreshTokenHash string    `db:"refresh_token_hash"`
+	ExpiresAt        time.Time `db:"expires_at"`
+}
+
+type RepositoryCreateIdentityInput struct {
+	Id       uuid.UUID `db:"id"`
+	UserId   uuid.UUID `db:"user_id"`
+	Provider string    `db:"provider"`
+	Subject  string    `db:"subject"`
+	Email    *string   `db:"email"`
+}
+
+type RepositoryCreateEmailLoginTokenInput struct {
+	Id         uuid.UUID  `db:"id"`
+	TokenHash  string     `db:"token_hash"`
+	Email      string     `db:"email"`
+	Role       *Role      `db:"role"`
+	LinkUserId *uuid.UUID `db:"link_user_id"`
+	ExpiresAt  time.Time  `db:"expires_at"`
+}
+
+type RepositoryCreateOidcLoginStateInput struct {
+	Id           uuid.UUID  `db:"id"`
+	StateHash    string     `db:"state_hash"`
+	Provider     string     `db:"provider"`
+	Nonce        string     `db:"nonce"`
+	CodeVerifier string     `db:"code_verifier"`
+	Role         *Role      `db:"role"`
+	LinkUserId   *uuid.UUID `db:"link_user_id"`
+	ExpiresAt    time.Time  `db:"expires_at"`
+}
+
+type RepositoryCreateGuardianStudentInput struct {
+	Id         uuid.UUID             `db:"id"`
+	GuardianId uuid.UUID             `db:"guardian_id"`
+	StudentId  uuid.UUID             `db:"student_id"`
+	Status     GuardianStudentStatus `db:"status"`
+}
+
+type RepositoryCreateAuditEventInput struct {
+	Id         uuid.UUID `db:"id"`
+	AdminId    uuid.UUID `db:"admin_id"`
+	Action     string    `db:"action"`
+	TargetType string    `db:"target_type"`
+	TargetId   string    `db:"target_id"`
+	Details    *string   `db:"details"`
+}
+
+type RepositoryCreateDataJobInput struct {
+	Id     uuid.UUID   `db:"id"`
+	UserId uuid.UUID   `db:"user_id"`
+	Kind   DataJobKind `db:"kind"`
+}
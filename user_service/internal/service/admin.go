@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"userservice/internal/errdefs"
+	"userservice/internal/model"
+)
+
+const (
+	defaultAdminListLimit = 50
+	maxAdminListLimit     = 200
+
+	auditActionSearchUsers       = "search_users"
+	auditActionSuspendUser       = "suspend_user"
+	auditActionReactivateUser    = "reactivate_user"
+	auditActionViewTutorStudents = "view_tutor_students"
+
+	auditTargetUser = "user"
+
+	maxAuditActionLength     = 64
+	maxAuditTargetTypeLength = 32
+	maxAuditTargetIdLength   = 64
+)
+
+// SearchUsers finds users by id, names, telegram username or email.
+func (s *UserService) SearchUsers(ctx context.Context, input *model.SearchUsersInput) ([]*model.User, error) {
+	if err := ensureCurrentUserRole(ctx, model.RoleAdmin); err != nil {
+		return nil, err
+	}
+	if input.Role != nil && !input.Role.IsValid() {
+		return nil, errdefs.ValidationErr
+	}
+	if input.Status != nil && !input.Status.IsValid() {
+		return nil, errdefs.ValidationErr
+	}
+	limit, err := adminListLimit(input.Limit, input.Offset)
+	if err != nil {
+		return nil, err
+	}
+	input.Limit = limit
+
+	details := fmt.Sprintf("query=%q", input.Query)
+	if err := s.audit(ctx, auditActionSearchUsers, auditTargetUser, "", &details); err != nil {
+		return nil, err
+	}
+
+	return s.userRepository.SearchUsers(ctx, input)
+}
+
+// SuspendUser blocks the user and logs them out everywhere. Admins cannot suspend themselves.
+func (s *UserService) SuspendUser(ctx context.Context, id uuid.UUID, reason *string) (*model.User, error) {
+	if err := ensureCurrentUserRole(ctx, model.RoleAdmin); err != nil {
+		return nil, err
+	}
+	if err := ensureCurrentUserIs(ctx, id); err == nil {
+		return nil, errdefs.ValidationErr
+	}
+	if _, err := s.userRepository.GetUser(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if err := s.audit(ctx, auditActionSuspendUser, auditTargetUser, id.String(), reason); err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepository.UpdateUserStatus(ctx, id, model.UserStatusBlocked)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.sessionRepository.RevokeUserSessions(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// ReactivateUser lets a suspended user sign in again.
+func (s *UserService) ReactivateUser(ctx context.Context, id uuid.UUID) (*model.User, error) {
+	if err := ensureCurrentUserRole(ctx, model.RoleAdmin); err != nil {
+		return nil, err
+	}
+	if _, err := s.userRepository.GetUser(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if err := s.audit(ctx, auditActionReactivateUser, auditTargetUser, id.String(), nil); err != nil {
+		return nil, err
+	}
+
+	return s.userRepository.UpdateUserStatus(ctx, id, model.UserStatusActive)
+}
+
+// ListUserTutorStudents returns the pairs in which the user is either the tutor or the student.
+func (s *UserService) ListUserTutorStudents(ctx context.Context, userId uuid.UUID) ([]*model.TutorStudent, error) {
+	if err := ensureCurrentUserRole(ctx, model.RoleAdmin); err != nil {
+		return nil, err
+	}
+	if _, err := s.userRepository.GetUser(ctx, userId); err != nil {
+		return nil, err
+	}
+
+	if err := s.audit(ctx, auditActionViewTutorStudents, auditTargetUser, userId.String(), nil); err != nil {
+		return nil, err
+	}
+
+	asTutor, err := s.tsRepository.ListTutorStudents(ctx, userId, uuid.Nil)
+	if err != nil {
+		return nil, err
+	}
+	asStudent, err := s.tsRepository.ListTutorStudents(ctx, uuid.Nil, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(asTutor, asStudent...), nil
+}
+
+// RecordAuditEvent is used by other services to log admin actions performed there.
+// The admin is always the caller.
+func (s *UserService) RecordAuditEvent(ctx context.Context, input *model.RecordAuditEventInput) error {
+	if err := ensureCurrentUserRole(ctx, model.RoleAdmin); err != nil {
+		return err
+	}
+	if input.Action == "" || len(input.Action) > maxAuditActionLength ||
+		input.TargetType == "" || len(input.TargetType) > maxAuditTargetTypeLength ||
+		len(input.TargetId) > maxAuditTargetIdLength {
+		return errdefs.ValidationErr
+	}
+
+	return s.audit(ctx, input.Action, input.TargetType, input.TargetId, input.Details)
+}
+
+// ListAuditEvents returns admin actions, newest first.
+func (s *UserService) ListAuditEvents(ctx context.Context, input *model.ListAuditEventsInput) ([]*model.AuditEvent, error) {
+	if err := ensureCurrentUserRole(ctx, model.RoleAdmin); err != nil {
+		return nil, err
+	}
+	limit, err := adminListLimit(input.Limit, input.Offset)
+	if err != nil {
+		return nil, err
+	}
+	input.Limit = limit
+
+	return s.auditRepository.ListAuditEvents(ctx, input)
+}
+
+// audit records the action of the current admin. It is called before the action,
+// so that nothing is done without a record.
+func (s *UserService) audit(ctx context.Context, action string, targetType string, targetId string, details *string) error {
+	adminId, err := getUserId(ctx)
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return err
+	}
+
+	_, err = s.auditRepository.CreateAuditEvent(ctx, &model.RepositoryCreateAuditEventInput{
+		Id:         id,
+		AdminId:    adminId,
+		Action:     action,
+		TargetType: targetType,
+		TargetId:   targetId,
+		Details:    details,
+	})
+	return err
+}
+
+func adminListLimit(limit int, offset int) (int, error) {
+	if limit < 0 || limit > maxAdminListLimit || offset < 0 {
+		return 0, errdefs.ValidationErr
+	}
+	if limit == 0 {
+		return defaultAdminListLimit, nil
+	}
+	return limit, nil
+}
@@ -0,0 +1,368 @@
+package service
+
+import (
+	"common_library/logging"
+	"context"
+	"encoding/json"
+	"errors"
+	fileapi "fileservice/pkg/api"
+	"fmt"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	homeworkapi "homework_service/pkg/api"
+	paymentapi "paymentservice/pkg/api"
+	scheduleapi "schedule_service/pkg/api"
+	"time"
+	"userservice/internal/clients"
+	"userservice/internal/errdefs"
+	"userservice/internal/model"
+)
+
+const (
+	dataJobBatchSize      = 10
+	maxDataJobAttempts    = 5
+	maxDataJobErrorLength = 1024
+
+	dataJobStepSchedule = "schedule"
+	dataJobStepHomework = "homework"
+	dataJobStepFiles    = "files"
+)
+
+// DataServices store the data of users besides user_service.
+type DataServices struct {
+	Schedule clients.ScheduleServiceClient
+	Homework clients.HomeworkServiceClient
+	Payment  clients.PaymentServiceClient
+	File     clients.FileServiceClient
+}
+
+type dataJobStep struct {
+	name string
+	run  func(ctx context.Context, userId string) error
+}
+
+// deletionSteps run in order, homework detaches its files before file_service forgets the user.
+// Payments are kept for accounting.
+func (s *UserService) deletionSteps() []dataJobStep {
+	return []dataJobStep{
+		{dataJobStepSchedule, func(ctx context.Context, userId string) error {
+			_, err := s.dataServices.Schedule.ForgetUser(ctx, &scheduleapi.ForgetUserRequest{UserId: userId})
+			return err
+		}},
+		{dataJobStepHomework, func(ctx context.Context, userId string) error {
+			_, err := s.dataServices.Homework.ForgetUser(ctx, &homeworkapi.ForgetUserRequest{UserId: userId})
+			return err
+		}},
+		{dataJobStepFiles, func(ctx context.Context, userId string) error {
+			_, err := s.dataServices.File.ForgetUser(ctx, &fileapi.ForgetUserRequest{UserId: userId})
+			return err
+		}},
+	}
+}
+
+// DeleteAccount marks the current user deleted, removes the personal data and logs them out everywhere.
+// The data in other services is removed by the returned job.
+func (s *UserService) DeleteAccount(ctx context.Context) (*model.DataJob, error) {
+	userId, err := getUserId(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if s.dataServices == nil {
+		return nil, errdefs.ErrNotConfigured
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.dataJobRepository.CreateDeletionJob(ctx, &model.RepositoryCreateDataJobInput{
+		Id:     id,
+		UserId: userId,
+		Kind:   model.DataJobKindDeletion,
+	})
+}
+
+// ExportMyData starts collecting the data of the current user into a zip archive.
+// The unfinished export is returned if there is one.
+func (s *UserService) ExportMyData(ctx context.Context) (*model.DataJob, error) {
+	userId, err := getUserId(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if s.dataServices == nil {
+		return nil, errdefs.ErrNotConfigured
+	}
+
+	job, err := s.dataJobRepository.GetUnfinishedDataJob(ctx, userId, model.DataJobKindExport)
+	if err == nil {
+		return job, nil
+	}
+	if !errors.Is(err, errdefs.ErrNotFound) {
+		return nil, err
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.dataJobRepository.CreateDataJob(ctx, &model.RepositoryCreateDataJobInput{
+		Id:     id,
+		UserId: userId,
+		Kind:   model.DataJobKindExport,
+	})
+}
+
+func (s *UserService) GetDataJob(ctx context.Context, id uuid.UUID) (*model.DataJob, error) {
+	job, err := s.dataJobRepository.GetDataJob(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ensureCurrentUserIs(ctx, job.UserId); err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+// ProcessDataJobs runs pending jobs and jobs interrupted more than staleAfter ago.
+// Failed attempts are retried after staleAfter, the job fails after maxDataJobAttempts.
+func (s *UserService) ProcessDataJobs(ctx context.Context, staleAfter time.Duration) (int, error) {
+	if s.dataServices == nil {
+		return 0, errdefs.ErrNotConfigured
+	}
+
+	jobs, err := s.dataJobRepository.ClaimDataJobs(ctx, time.Now().Add(-staleAfter), dataJobBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	logger, hasLogger := logging.GetFromContext(ctx)
+
+	finished := 0
+	for _, job := range jobs {
+		err := s.runDataJob(ctx, job)
+		if err == nil {
+			finished++
+			continue
+		}
+
+		if hasLogger {
+			logger.Error(ctx, "data job failed",
+				zap.String("job_id", job.Id.String()),
+				zap.String("kind", job.Kind.String()),
+				zap.Int("attempts", job.Attempts),
+				zap.Error(err),
+			)
+		}
+		if job.Attempts < maxDataJobAttempts {
+			continue
+		}
+
+		message := err.Error()
+		if len(message) > maxDataJobErrorLength {
+			message = message[:maxDataJobErrorLength]
+		}
+		if _, err := s.dataJobRepository.FinishDataJob(ctx, job.Id, model.DataJobStatusFailed, nil, &message); err != nil {
+			return finished, err
+		}
+	}
+
+	return finished, nil
+}
+
+// runDataJob calls other services on behalf of the user of the job.
+func (s *UserService) runDataJob(ctx context.Context, job *model.DataJob) error {
+	user, err := s.userRepository.GetUser(ctx, job.UserId)
+	if err != nil {
+		return err
+	}
+	reqCtx := userContext(ctx, user.Id, user.Role)
+
+	switch job.Kind {
+	case model.DataJobKindDeletion:
+		return s.runDeletionJob(reqCtx, job)
+	case model.DataJobKindExport:
+		return s.runExportJob(reqCtx, job, user)
+	default:
+		return fmt.Errorf("unknown data job kind %q", job.Kind)
+	}
+}
+
+func (s *UserService) runDeletionJob(ctx context.Context, job *model.DataJob) error {
+	steps := s.deletionSteps()
+
+	next := 0
+	if job.Step != nil {
+		for i, step := range steps {
+			if step.name == *job.Step {
+				next = i + 1
+			}
+		}
+	}
+
+	for _, step := range steps[next:] {
+		if err := step.run(ctx, job.UserId.String()); err != nil {
+			return fmt.Errorf("step %s: %w", step.name, err)
+		}
+		if _, err := s.dataJobRepository.UpdateDataJobStep(ctx, job.Id, step.name); err != nil {
+			return err
+		}
+	}
+
+	_, err := s.dataJobRepository.FinishDataJob(ctx, job.Id, model.DataJobStatusDone, nil, nil)
+	return err
+}
+
+// profileExport is the user_service part of the export.
+type profileExport struct {
+	User             *model.User              `json:"user"`
+	TelegramAccount  *model.TelegramAccount   `json:"telegram_account,omitempty"`
+	Identities       []*model.Identity        `json:"identities"`
+	TutorProfile     *model.TutorProfile      `json:"tutor_profile,omitempty"`
+	TutorStudents    []*model.TutorStudent    `json:"tutor_students"`
+	GuardianStudents []*model.GuardianStudent `json:"guardian_students"`
+	TutorInvites     []*model.TutorInvite     `json:"tutor_invites,omitempty"`
+}
+
+func (s *UserService) runExportJob(ctx context.Context, job *model.DataJob, user *model.User) error {
+	userId := user.Id.String()
+
+	profile, err := s.exportProfile(ctx, user)
+	if err != nil {
+		return err
+	}
+	profileJson, err := json.MarshalIndent(profile, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	schedule, err := s.dataServices.Schedule.ExportUserData(ctx, &scheduleapi.ExportUserDataRequest{UserId: userId})
+	if err != nil {
+		return fmt.Errorf("schedule export: %w", err)
+	}
+	homework, err := s.dataServices.Homework.ExportUserData(ctx, &homeworkapi.ExportUserDataRequest{UserId: userId})
+	if err != nil {
+		return fmt.Errorf("homework export: %w", err)
+	}
+	payments, err := s.dataServices.Payment.ExportUserData(ctx, &paymentapi.ExportUserDataRequest{UserId: userId})
+	if err != nil {
+		return fmt.Errorf("payment export: %w", err)
+	}
+
+	req := &fileapi.CreateArchiveRequest{
+		OwnerId:  userId,
+		Filename: "studyflow-export-" + time.Now().UTC().Format("2006-01-02") + ".zip",
+		Entries:  []*fileapi.ArchiveEntry{{Name: "profile.json", Content: profileJson}},
+		Files:    exportFiles(homework, payments),
+	}
+	for _, entry := range []struct {
+		name    string
+		message proto.Message
+	}{
+		{"lessons.json", schedule},
+		{"homework.json", homework},
+		{"payments.json", payments},
+	} {
+		content, err := protojson.MarshalOptions{Multiline: true}.Marshal(entry.message)
+		if err != nil {
+			return err
+		}
+		req.Entries = append(req.Entries, &fileapi.ArchiveEntry{Name: entry.name, Content: content})
+	}
+
+	archive, err := s.dataServices.File.CreateArchive(ctx, req)
+	if err != nil {
+		return fmt.Errorf("create archive: %w", err)
+	}
+	archiveId, err := uuid.Parse(archive.Id)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.dataJobRepository.FinishDataJob(ctx, job.Id, model.DataJobStatusDone, &archiveId, nil)
+	return err
+}
+
+func (s *UserService) exportProfile(ctx context.Context, user *model.User) (*profileExport, error) {
+	profile := &profileExport{User: user}
+
+	account, err := s.userRepository.GetTelegramAccount(ctx, user.Id)
+	if err != nil && !errors.Is(err, errdefs.ErrNotFound) {
+		return nil, err
+	}
+	profile.TelegramAccount = account
+
+	profile.Identities, err = s.identityRepository.ListIdentities(ctx, user.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	asTutor, err := s.tsRepository.ListTutorStudents(ctx, user.Id, uuid.Nil)
+	if err != nil {
+		return nil, err
+	}
+	asStudent, err := s.tsRepository.ListTutorStudents(ctx, uuid.Nil, user.Id)
+	if err != nil {
+		return nil, err
+	}
+	profile.TutorStudents = append(asTutor, asStudent...)
+
+	asGuardian, err := s.gsRepository.ListGuardianStudents(ctx, user.Id, uuid.Nil)
+	if err != nil {
+		return nil, err
+	}
+	asLinkedStudent, err := s.gsRepository.ListGuardianStudents(ctx, uuid.Nil, user.Id)
+	if err != nil {
+		return nil, err
+	}
+	profile.GuardianStudents = append(asGuardian, asLinkedStudent...)
+
+	if user.Role == model.RoleTutor {
+		profile.TutorProfile, err = s.userRepository.GetTutorProfile(ctx, user.Id)
+		if err != nil && !errors.Is(err, errdefs.ErrNotFound) {
+			return nil, err
+		}
+		profile.TutorInvites, err = s.inviteRepository.ListTutorInvites(ctx, user.Id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return profile, nil
+}
+
+// exportFiles lists the attachments and receipts once each, file_service skips the inaccessible ones.
+func exportFiles(homework *homeworkapi.UserDataExport, payments *paymentapi.UserDataExport) []*fileapi.ArchiveFile {
+	var ids []string
+	for _, assignment := range homework.Assignments {
+		ids = append(ids, assignment.GetFileId())
+	}
+	for _, submission := range homework.Submissions {
+		ids = append(ids, submission.GetFileId())
+	}
+	for _, feedback := range homework.Feedbacks {
+		ids = append(ids, feedback.GetFileId())
+	}
+	for _, receipt := range payments.Receipts {
+		ids = append(ids, receipt.GetFileId())
+	}
+	for _, invoice := range payments.Invoices {
+		ids = append(ids, invoice.GetFileId())
+	}
+
+	seen := make(map[string]bool)
+	var files []*fileapi.ArchiveFile
+	for _, id := range ids {
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		files = append(files, &fileapi.ArchiveFile{FileId: id})
+	}
+	return files
+}
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"userservice/internal/errdefs"
+	"userservice/internal/model"
+)
+
+// CreateGuardianStudent requests access of the current guardian to the student.
+// The link stays pending until the student accepts it.
+func (s *UserService) CreateGuardianStudent(ctx context.Context, guardianId uuid.UUID, studentId uuid.UUID) (*model.GuardianStudent, error) {
+	if err := ensureCurrentUserIs(ctx, guardianId); err != nil {
+		return nil, err
+	}
+
+	if err := ensureCurrentUserRole(ctx, model.RoleGuardian); err != nil {
+		return nil, err
+	}
+
+	student, err := s.userRepository.GetUser(ctx, studentId)
+	if err != nil {
+		return nil, err
+	}
+	if student.Role != model.RoleStudent {
+		return nil, errdefs.ValidationErr
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	gs, err := s.gsRepository.CreateGuardianStudent(ctx, &model.RepositoryCreateGuardianStudentInput{
+		Id:         id,
+		GuardianId: guardianId,
+		StudentId:  studentId,
+		Status:     model.GuardianStudentStatusPending,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return gs, nil
+}
+
+// AcceptGuardianRequest gives the guardian access to the current student.
+func (s *UserService) AcceptGuardianRequest(ctx context.Context, guardianId uuid.UUID) error {
+	id, err := getUserId(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := ensureCurrentUserRole(ctx, model.RoleStudent); err != nil {
+		return err
+	}
+
+	_, err = s.gsRepository.UpdateGuardianStudentStatus(ctx, guardianId, id, model.GuardianStudentStatusActive)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetGuardianStudent is used by other services to check access of guardians.
+func (s *UserService) GetGuardianStudent(ctx context.Context, guardianId uuid.UUID, studentId uuid.UUID) (*model.GuardianStudent, error) {
+	if err := ensureCurrentUserIs(ctx, guardianId, studentId); err != nil {
+		return nil, err
+	}
+
+	gs, err := s.gsRepository.GetGuardianStudent(ctx, guardianId, studentId)
+	if err != nil {
+		return nil, err
+	}
+
+	return gs, nil
+}
+
+// DeleteGuardianStudent revokes the link, both the guardian and the student can do it.
+func (s *UserService) DeleteGuardianStudent(ctx context.Context, guardianId uuid.UUID, studentId uuid.UUID) error {
+	if err := ensureCurrentUserIs(ctx, guardianId, studentId); err != nil {
+		return err
+	}
+
+	if err := s.gsRepository.DeleteGuardianStudent(ctx, guardianId, studentId); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *UserService) ListGuardianStudents(ctx context.Context, guardianId uuid.UUID) ([]*model.GuardianStudent, error) {
+	if err := ensureCurrentUserIs(ctx, guardianId); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.gsRepository.ListGuardianStudents(ctx, guardianId, uuid.Nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *UserService) ListGuardiansForStudent(ctx context.Context, studentId uuid.UUID) ([]*model.GuardianStudent, error) {
+	if err := ensureCurrentUserIs(ctx, studentId); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.gsRepository.ListGuardianStudents(ctx, uuid.Nil, studentId)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// ensureCurrentUserIsOrGuardianOf passes the users with ids and active guardians of the student.
+func (s *UserService) ensureCurrentUserIsOrGuardianOf(ctx context.Context, studentId uuid.UUID, ids ...uuid.UUID) error {
+	err := ensureCurrentUserIs(ctx, ids...)
+	if !errors.Is(err, errdefs.ErrPermissionDenied) {
+		return err
+	}
+
+	if err := ensureCurrentUserRole(ctx, model.RoleGuardian); err != nil {
+		return err
+	}
+
+	guardianId, err := getUserId(ctx)
+	if err != nil {
+		return err
+	}
+
+	gs, err := s.gsRepository.GetGuardianStudent(ctx, guardianId, studentId)
+	if errors.Is(err, errdefs.ErrNotFound) {
+		return errdefs.ErrPermissionDenied
+	}
+	if err != nil {
+		return err
+	}
+	if gs.Status != model.GuardianStudentStatusActive {
+		return errdefs.ErrPermissionDenied
+	}
+
+	return nil
+}
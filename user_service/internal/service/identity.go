@@ -0,0 +1,425 @@
+package service
+
+import (
+	"common_library/logging"
+	"common_library/money"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"net/mail"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+	"userservice/internal/authorization"
+	"userservice/internal/errdefs"
+	"userservice/internal/model"
+)
+
+const identityProviderTelegram = "telegram"
+
+// RequestEmailLogin sends a one time sign in link.
+// Nothing is sent for an unknown email unless a role to register with is given,
+// the result is the same so that registered emails cannot be enumerated.
+func (s *UserService) RequestEmailLogin(ctx context.Context, input *model.RequestEmailLoginInput) error {
+	email, err := normalizeEmail(input.Email)
+	if err != nil {
+		return err
+	}
+	if input.Role != nil && !input.Role.IsRegistrable() {
+		return errdefs.ValidationErr
+	}
+	if s.mailer == nil {
+		return errdefs.ErrNotConfigured
+	}
+
+	if input.Role == nil {
+		_, err := s.identityRepository.GetIdentity(ctx, model.IdentityProviderEmail, email)
+		if errors.Is(err, errdefs.ErrNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.sendEmailLoginToken(ctx, email, input.Role, nil)
+}
+
+// RequestEmailLink sends a link that adds the email as a sign in method of the current user.
+func (s *UserService) RequestEmailLink(ctx context.Context, email string) error {
+	userId, err := getUserId(ctx)
+	if err != nil {
+		return err
+	}
+	email, err = normalizeEmail(email)
+	if err != nil {
+		return err
+	}
+	if s.mailer == nil {
+		return errdefs.ErrNotConfigured
+	}
+
+	identity, err := s.identityRepository.GetIdentity(ctx, model.IdentityProviderEmail, email)
+	if err == nil {
+		if identity.UserId == userId {
+			return nil
+		}
+		return errdefs.ErrAlreadyExists
+	}
+	if !errors.Is(err, errdefs.ErrNotFound) {
+		return err
+	}
+
+	return s.sendEmailLoginToken(ctx, email, nil, &userId)
+}
+
+func (s *UserService) sendEmailLoginToken(ctx context.Context, email string, role *model.Role, linkUserId *uuid.UUID) error {
+	token, err := authorization.NewRandomToken()
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return err
+	}
+
+	_, err = s.identityRepository.CreateEmailLoginToken(ctx, &model.RepositoryCreateEmailLoginTokenInput{
+		Id:         id,
+		TokenHash:  authorization.HashToken(token),
+		Email:      email,
+		Role:       role,
+		LinkUserId: linkUserId,
+		ExpiresAt:  time.Now().Add(s.authConfig.EmailLoginTTL),
+	})
+	if err != nil {
+		return err
+	}
+
+	link := s.authConfig.EmailLoginURL + "?token=" + url.QueryEscape(token)
+	body := fmt.Sprintf(
+		"Follow the link to sign in to StudyFlow:\r\n\r\n%s\r\n\r\nThe link expires in %s. If you did not request it, ignore this email.\r\n",
+		link, s.authConfig.EmailLoginTTL,
+	)
+	return s.mailer.Send(ctx, email, "StudyFlow sign in", body)
+}
+
+// VerifyEmailLogin redeems the emailed token for session tokens.
+func (s *UserService) VerifyEmailLogin(ctx context.Context, token string) (*model.SessionTokens, error) {
+	tokens, err := s.verifyEmailLogin(ctx, token)
+	if err != nil {
+		reportAuthFailure(ctx, err)
+	}
+	return tokens, err
+}
+
+func (s *UserService) verifyEmailLogin(ctx context.Context, token string) (*model.SessionTokens, error) {
+	loginToken, err := s.identityRepository.UseEmailLoginToken(ctx, authorization.HashToken(token))
+	if errors.Is(err, errdefs.ErrNotFound) {
+		return nil, fmt.Errorf("identity: email login token is unknown, used or expired: %w", errdefs.ErrAuthInvalidToken)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	email := loginToken.Email
+	user, err := s.signInWithIdentity(ctx, &model.IdentitySignInInput{
+		Provider:   model.IdentityProviderEmail,
+		Subject:    email,
+		Email:      &email,
+		Role:       loginToken.Role,
+		LinkUserId: loginToken.LinkUserId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.createSession(ctx, user)
+}
+
+// StartOidcLogin returns the provider login page url, role registers a new user on the first sign in.
+func (s *UserService) StartOidcLogin(ctx context.Context, role *model.Role) (string, error) {
+	if role != nil && !role.IsRegistrable() {
+		return "", errdefs.ValidationErr
+	}
+	return s.startOidc(ctx, role, nil)
+}
+
+// StartOidcLink returns the provider login page url, the account is linked to the current user.
+func (s *UserService) StartOidcLink(ctx context.Context) (string, error) {
+	userId, err := getUserId(ctx)
+	if err != nil {
+		return "", err
+	}
+	return s.startOidc(ctx, nil, &userId)
+}
+
+func (s *UserService) startOidc(ctx context.Context, role *model.Role, linkUserId *uuid.UUID) (string, error) {
+	if s.oidcProvider == nil {
+		return "", errdefs.ErrNotConfigured
+	}
+
+	state, err := authorization.NewRandomToken()
+	if err != nil {
+		return "", err
+	}
+	nonce, err := authorization.NewRandomToken()
+	if err != nil {
+		return "", err
+	}
+	codeVerifier, err := authorization.NewRandomToken()
+	if err != nil {
+		return "", err
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", err
+	}
+
+	_, err = s.identityRepository.CreateOidcLoginState(ctx, &model.RepositoryCreateOidcLoginStateInput{
+		Id:           id,
+		StateHash:    authorization.HashToken(state),
+		Provider:     s.oidcProvider.Name(),
+		Nonce:        nonce,
+		CodeVerifier: codeVerifier,
+		Role:         role,
+		LinkUserId:   linkUserId,
+		ExpiresAt:    time.Now().Add(s.authConfig.OidcStateTTL),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return s.oidcProvider.AuthCodeURL(ctx, state, nonce, codeVerifier)
+}
+
+// CompleteOidcLogin handles the provider redirect and returns session tokens.
+func (s *UserService) CompleteOidcLogin(ctx context.Context, state string, code string) (*model.SessionTokens, error) {
+	tokens, err := s.completeOidcLogin(ctx, state, code)
+	if err != nil {
+		reportAuthFailure(ctx, err)
+	}
+	return tokens, err
+}
+
+func (s *UserService) completeOidcLogin(ctx context.Context, state string, code string) (*model.SessionTokens, error) {
+	if s.oidcProvider == nil {
+		return nil, errdefs.ErrNotConfigured
+	}
+
+	loginState, err := s.identityRepository.UseOidcLoginState(ctx, authorization.HashToken(state))
+	if errors.Is(err, errdefs.ErrNotFound) {
+		return nil, fmt.Errorf("identity: oidc state is unknown, used or expired: %w", errdefs.ErrAuthInvalidToken)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if loginState.Provider != s.oidcProvider.Name() {
+		return nil, fmt.Errorf("identity: oidc state of provider %s: %w", loginState.Provider, errdefs.ErrAuthInvalidToken)
+	}
+
+	oidcIdentity, err := s.oidcProvider.Exchange(ctx, code, loginState.CodeVerifier, loginState.Nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.signInWithIdentity(ctx, &model.IdentitySignInInput{
+		Provider:   loginState.Provider,
+		Subject:    oidcIdentity.Subject,
+		Email:      oidcIdentity.Email,
+		FirstName:  oidcIdentity.FirstName,
+		LastName:   oidcIdentity.LastName,
+		Role:       loginState.Role,
+		LinkUserId: loginState.LinkUserId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.createSession(ctx, user)
+}
+
+// signInWithIdentity returns the owner of the identity, links it or registers a new user.
+// Identities are matched only by provider and subject, never by email.
+func (s *UserService) signInWithIdentity(ctx context.Context, input *model.IdentitySignInInput) (*model.User, error) {
+	identity, err := s.identityRepository.GetIdentity(ctx, input.Provider, input.Subject)
+	if err == nil {
+		if input.LinkUserId != nil && *input.LinkUserId != identity.UserId {
+			return nil, errdefs.ErrAlreadyExists
+		}
+		return s.userRepository.GetUser(ctx, identity.UserId)
+	}
+	if !errors.Is(err, errdefs.ErrNotFound) {
+		return nil, err
+	}
+
+	if input.LinkUserId != nil {
+		id, err := uuid.NewV7()
+		if err != nil {
+			return nil, err
+		}
+		_, err = s.identityRepository.CreateIdentity(ctx, &model.RepositoryCreateIdentityInput{
+			Id:       id,
+			UserId:   *input.LinkUserId,
+			Provider: input.Provider,
+			Subject:  input.Subject,
+			Email:    input.Email,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return s.userRepository.GetUser(ctx, *input.LinkUserId)
+	}
+
+	if input.Role == nil {
+		return nil, fmt.Errorf("identity: no user with %s identity: %w", input.Provider, errdefs.ErrAuthUnknownUser)
+	}
+	return s.registerWithIdentity(ctx, input)
+}
+
+func (s *UserService) registerWithIdentity(ctx context.Context, input *model.IdentitySignInInput) (*model.User, error) {
+	repo, err := s.userRepository.NewUserCreationRepositoryTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func(repo UserCreationRepositoryTx, ctx context.Context) {
+		err := repo.Rollback(ctx)
+		if err != nil {
+			logger, ok := logging.GetFromContext(ctx)
+			if ok {
+				logger.Error(ctx, "Failed to Rollback", zap.Error(err))
+			}
+		}
+	}(repo, ctx)
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	authProvider := model.AuthProviderOidc
+	if input.Provider == model.IdentityProviderEmail {
+		authProvider = model.AuthProviderEmail
+	}
+
+	user, err := repo.CreateUser(ctx, &model.RepositoryCreateUserInput{
+		Id:           id,
+		Role:         *input.Role,
+		AuthProvider: authProvider,
+		Status:       model.UserStatusActive,
+		FirstName:    input.FirstName,
+		LastName:     input.LastName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	id, err = uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = repo.CreateIdentity(ctx, &model.RepositoryCreateIdentityInput{
+		Id:       id,
+		UserId:   user.Id,
+		Provider: input.Provider,
+		Subject:  input.Subject,
+		Email:    input.Email,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Role == model.RoleTutor {
+		id, err = uuid.NewV7()
+		if err != nil {
+			return nil, err
+		}
+		_, err := repo.CreateTutorProfile(ctx, &model.RepositoryCreateTutorProfileInput{
+			Id:       id,
+			UserId:   user.Id,
+			Currency: money.Default,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = repo.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// ListMyIdentities returns sign in methods of the current user, the telegram account included.
+func (s *UserService) ListMyIdentities(ctx context.Context) ([]*model.Identity, error) {
+	userId, err := getUserId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	identities, err := s.identityRepository.ListIdentities(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := s.userRepository.GetTelegramAccount(ctx, userId)
+	if errors.Is(err, errdefs.ErrNotFound) {
+		return identities, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	telegram := &model.Identity{
+		Id:        account.Id,
+		UserId:    account.UserId,
+		Provider:  identityProviderTelegram,
+		Subject:   strconv.FormatInt(account.TelegramId, 10),
+		CreatedAt: account.CreatedAt,
+	}
+	return append([]*model.Identity{telegram}, identities...), nil
+}
+
+// DeleteIdentity unlinks a sign in method, the last one cannot be removed.
+// The telegram account is not removable.
+func (s *UserService) DeleteIdentity(ctx context.Context, id uuid.UUID) error {
+	identities, err := s.ListMyIdentities(ctx)
+	if err != nil {
+		return err
+	}
+
+	var found *model.Identity
+	for _, identity := range identities {
+		if identity.Id == id {
+			found = identity
+		}
+	}
+	if found == nil {
+		return errdefs.ErrNotFound
+	}
+	if found.Provider == identityProviderTelegram {
+		return errdefs.ErrPermissionDenied
+	}
+	if len(identities) == 1 {
+		return errdefs.ErrLastIdentity
+	}
+
+	return s.identityRepository.DeleteIdentity(ctx, found.UserId, found.Id)
+}
+
+func normalizeEmail(email string) (string, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Address != email {
+		return "", errdefs.ValidationErr
+	}
+	return email, nil
+}
@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"github.com/google/uuid"
+	"net/url"
+	"strings"
+	"time"
+	"userservice/internal/errdefs"
+	"userservice/internal/model"
+)
+
+const (
+	// invitePayloadPrefix marks invites in telegram /start payloads: https://t.me/<bot>?start=invite_<code>
+	invitePayloadPrefix = "invite_"
+	inviteCodeBytes     = 10
+	maxInviteTTL        = 30 * 24 * time.Hour
+)
+
+// CreateTutorInvite creates a code the tutor can share instead of knowing the student id.
+func (s *UserService) CreateTutorInvite(ctx context.Context, input *model.CreateTutorInviteInput) (*model.TutorInvite, error) {
+	if err := ensureCurrentUserIs(ctx, input.TutorId); err != nil {
+		return nil, err
+	}
+
+	if err := ensureCurrentUserRole(ctx, model.RoleTutor); err != nil {
+		return nil, err
+	}
+
+	maxUses := 1
+	if input.MaxUses != nil {
+		maxUses = *input.MaxUses
+	}
+	if maxUses < 0 {
+		return nil, errdefs.ValidationErr
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(s.authConfig.InviteTTL)
+	if input.ExpiresAt != nil {
+		expiresAt = *input.ExpiresAt
+	}
+	if !expiresAt.After(now) || expiresAt.After(now.Add(maxInviteTTL)) {
+		return nil, errdefs.ValidationErr
+	}
+
+	code, err := newInviteCode()
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	repoInput := &model.RepositoryCreateTutorInviteInput{
+		Id:                   id,
+		TutorId:              input.TutorId,
+		Code:                 code,
+		LessonConnectionLink: input.LessonConnectionLink,
+		ExpiresAt:            expiresAt,
+	}
+	if maxUses > 0 {
+		repoInput.MaxUses = &maxUses
+	}
+	if input.LessonPrice != nil {
+		repoInput.LessonPriceMinor = &input.LessonPrice.Amount
+		repoInput.LessonPriceCurrency = &input.LessonPrice.Currency
+	}
+
+	invite, err := s.inviteRepository.CreateTutorInvite(ctx, repoInput)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.withInviteLink(invite), nil
+}
+
+func (s *UserService) ListTutorInvites(ctx context.Context, tutorId uuid.UUID) ([]*model.TutorInvite, error) {
+	if err := ensureCurrentUserIs(ctx, tutorId); err != nil {
+		return nil, err
+	}
+
+	invites, err := s.inviteRepository.ListTutorInvites(ctx, tutorId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, invite := range invites {
+		s.withInviteLink(invite)
+	}
+	return invites, nil
+}
+
+// RevokeTutorInvite stops the invite of the current tutor, pairs created by it stay.
+func (s *UserService) RevokeTutorInvite(ctx context.Context, id uuid.UUID) (*model.TutorInvite, error) {
+	tutorId, err := getUserId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invite, err := s.inviteRepository.RevokeTutorInvite(ctx, tutorId, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.withInviteLink(invite), nil
+}
+
+// RedeemInvite makes an active pair of the current student and the tutor of the invite.
+// code may be the telegram /start payload as is.
+func (s *UserService) RedeemInvite(ctx context.Context, code string) (*model.TutorStudent, error) {
+	studentId, err := getUserId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ensureCurrentUserRole(ctx, model.RoleStudent); err != nil {
+		return nil, err
+	}
+
+	code = strings.ToUpper(strings.TrimPrefix(strings.TrimSpace(code), invitePayloadPrefix))
+	if code == "" {
+		return nil, errdefs.ValidationErr
+	}
+
+	pairId, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+	eventId, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.inviteRepository.RedeemTutorInvite(ctx, &model.RepositoryRedeemTutorInviteInput{
+		Code:      code,
+		StudentId: studentId,
+		PairId:    pairId,
+		EventId:   eventId,
+	})
+}
+
+// RejectInvitation declines the invitation of the tutor to the current student.
+// Only invited pairs can be rejected, the pair is deleted or archived if it has history.
+func (s *UserService) RejectInvitation(ctx context.Context, tutorId uuid.UUID) error {
+	studentId, err := getUserId(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := ensureCurrentUserRole(ctx, model.RoleStudent); err != nil {
+		return err
+	}
+
+	ts, err := s.tsRepository.GetTutorStudent(ctx, tutorId, studentId)
+	if err != nil {
+		return err
+	}
+	if ts.Status != model.TutorStudentStatusInvited {
+		return errdefs.ErrNotFound
+	}
+
+	return s.endInvitation(ctx, ts, studentId)
+}
+
+func (s *UserService) withInviteLink(invite *model.TutorInvite) *model.TutorInvite {
+	if s.authConfig.TelegramBotUsername == "" {
+		return invite
+	}
+	link := "https://t.me/" + url.PathEscape(s.authConfig.TelegramBotUsername) + "?start=" + invitePayloadPrefix + invite.Code
+	invite.Link = &link
+	return invite
+}
+
+// newInviteCode returns 16 characters of base32, they are allowed in telegram /start payloads.
+func newInviteCode() (string, error) {
+	secret := make([]byte, inviteCodeBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(secret), nil
+}
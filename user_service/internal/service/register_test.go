@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+	"userservice/internal/cache"
+	"userservice/internal/errdefs"
+	"userservice/internal/model"
+)
+
+func signTelegramHeader(secret string, tgId int64, timestamp time.Time) string {
+	message := fmt.Sprintf("%d:%d", tgId, timestamp.Unix())
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(message))
+	return "telegram " + message + ":" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// the service has no repositories, so the checks must reject the request before creating the user
+func TestRegisterViaTelegram_RejectsAdminWithoutProof(t *testing.T) {
+	s := &UserService{
+		replayCache: cache.NewMemoryReplayCache(),
+		authConfig: AuthConfig{
+			TelegramSecret:   "secret",
+			TelegramSkew:     time.Minute,
+			AdminTelegramIds: []int64{42},
+		},
+	}
+
+	header := func(secret string, tgId int64) *string {
+		h := signTelegramHeader(secret, tgId, time.Now())
+		return &h
+	}
+
+	tests := []struct {
+		name       string
+		telegramId int64
+		header     *string
+		want       error
+	}{
+		{"NoHeader", 42, nil, errdefs.AuthenticationErr},
+		{"BadSignature", 42, header("other", 42), errdefs.AuthenticationErr},
+		{"UnknownScheme", 42, func() *string { h := "Bearer token"; return &h }(), errdefs.AuthenticationErr},
+		{"HeaderOfOtherAccount", 42, header("secret", 7), errdefs.ErrPermissionDenied},
+		{"NotAnAdminAccount", 7, header("secret", 7), errdefs.ErrPermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s.replayCache = cache.NewMemoryReplayCache()
+			_, err := s.RegisterViaTelegram(context.Background(), &model.RegisterViaTelegramInput{
+				TelegramId:          tt.telegramId,
+				Role:                model.RoleAdmin,
+				AuthorizationHeader: tt.header,
+			})
+			if !errors.Is(err, tt.want) {
+				t.Errorf("err = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegisterViaTelegram_RejectsReplayedAdminHeader(t *testing.T) {
+	s := &UserService{
+		replayCache: cache.NewMemoryReplayCache(),
+		authConfig: AuthConfig{
+			TelegramSecret:   "secret",
+			TelegramSkew:     time.Minute,
+			AdminTelegramIds: []int64{42},
+		},
+	}
+	header := signTelegramHeader("secret", 42, time.Now())
+	input := &model.RegisterViaTelegramInput{TelegramId: 42, Role: model.RoleAdmin, AuthorizationHeader: &header}
+
+	if err := s.ensureAdminTelegramAccount(context.Background(), input); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := s.ensureAdminTelegramAccount(context.Background(), input); !errors.Is(err, errdefs.ErrAuthReplayed) {
+		t.Errorf("err = %v, want %v", err, errdefs.ErrAuthReplayed)
+	}
+}
@@ -3,14 +3,20 @@ package service
 import (
 	"common_library/ctxdata"
 	"common_library/logging"
+	"common_library/money"
 	"context"
+	"errors"
 	"fmt"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
 	"slices"
 	"strings"
+	"time"
 	"userservice/internal/authorization"
+	"userservice/internal/clients"
 	"userservice/internal/errdefs"
+	"userservice/internal/metrics"
 	"userservice/internal/model"
 )
 
@@ -19,9 +25,12 @@ type UserRepository interface {
 
 	GetUser(ctx context.Context, id uuid.UUID) (*model.User, error)
 	UpdateUser(ctx context.Context, id uuid.UUID, input *model.UpdateUserInput) (*model.User, error)
+	UpdateUserStatus(ctx context.Context, id uuid.UUID, status model.UserStatus) (*model.User, error)
+	SearchUsers(ctx context.Context, input *model.SearchUsersInput) ([]*model.User, error)
 
 	GetTutorProfile(ctx context.Context, userId uuid.UUID) (*model.TutorProfile, error)
 	UpdateTutorProfile(ctx context.Context, userId uuid.UUID, input *model.UpdateTutorProfileInput) (*model.TutorProfile, error)
+	SearchTutors(ctx context.Context, input *model.SearchTutorsInput) ([]*model.TutorSearchResult, error)
 
 	GetTelegramAccount(ctx context.Context, userId uuid.UUID) (*model.TelegramAccount, error)
 	GetTelegramAccountByTelegramId(ctx context.Context, telegramId int64) (*model.TelegramAccount, error)
@@ -31,6 +40,7 @@ type UserCreationRepositoryTx interface {
 	CreateUser(ctx context.Context, input *model.RepositoryCreateUserInput) (*model.User, error)
 	CreateTutorProfile(ctx context.Context, input *model.RepositoryCreateTutorProfileInput) (*model.TutorProfile, error)
 	CreateTelegramAccount(ctx context.Context, input *model.RepositoryCreateTelegramAccountInput) (*model.TelegramAccount, error)
+	CreateIdentity(ctx context.Context, input *model.RepositoryCreateIdentityInput) (*model.Identity, error)
 	Commit(ctx context.Context) error
 	Rollback(ctx context.Context) error
 }
@@ -42,24 +52,175 @@ type TutorStudentsRepository interface {
 	DeleteTutorStudent(ctx context.Context, tutorId uuid.UUID, studentId uuid.UUID) error
 	// ListTutorStudents Set tutorId or studentId to UUID.Nil to search by one parameter
 	ListTutorStudents(ctx context.Context, tutorId uuid.UUID, studentId uuid.UUID) ([]*model.TutorStudent, error)
+	ChangeTutorStudentStatus(ctx context.Context, input *model.RepositoryChangeTutorStudentStatusInput) (*model.TutorStudent, error)
+	ListTutorStudentStatusEvents(ctx context.Context, tutorStudentId uuid.UUID) ([]*model.TutorStudentStatusEvent, error)
+}
+
+type TutorInviteRepository interface {
+	CreateTutorInvite(ctx context.Context, input *model.RepositoryCreateTutorInviteInput) (*model.TutorInvite, error)
+	ListTutorInvites(ctx context.Context, tutorId uuid.UUID) ([]*model.TutorInvite, error)
+	RevokeTutorInvite(ctx context.Context, tutorId uuid.UUID, id uuid.UUID) (*model.TutorInvite, error)
+	RedeemTutorInvite(ctx context.Context, input *model.RepositoryRedeemTutorInviteInput) (*model.TutorStudent, error)
+}
+
+type GuardianStudentRepository interface {
+	CreateGuardianStudent(ctx context.Context, input *model.RepositoryCreateGuardianStudentInput) (*model.GuardianStudent, error)
+	GetGuardianStudent(ctx context.Context, guardianId uuid.UUID, studentId uuid.UUID) (*model.GuardianStudent, error)
+	UpdateGuardianStudentStatus(ctx context.Context, guardianId uuid.UUID, studentId uuid.UUID, status model.GuardianStudentStatus) (*model.GuardianStudent, error)
+	DeleteGuardianStudent(ctx context.Context, guardianId uuid.UUID, studentId uuid.UUID) error
+	// ListGuardianStudents Set guardianId or studentId to UUID.Nil to search by one parameter
+	ListGuardianStudents(ctx context.Context, guardianId uuid.UUID, studentId uuid.UUID) ([]*model.GuardianStudent, error)
+}
+
+type SessionRepository interface {
+	CreateSession(ctx context.Context, input *model.RepositoryCreateSessionInput) (*model.Session, error)
+	GetSession(ctx context.Context, id uuid.UUID) (*model.Session, error)
+	RotateRefreshToken(ctx context.Context, id uuid.UUID, oldHash string, newHash string, expiresAt time.Time) (*model.Session, error)
+	RevokeSession(ctx context.Context, id uuid.UUID) error
+	RevokeUserSessions(ctx context.Context, userId uuid.UUID) error
+}
+
+type IdentityRepository interface {
+	CreateIdentity(ctx context.Context, input *model.RepositoryCreateIdentityInput) (*model.Identity, error)
+	GetIdentity(ctx context.Context, provider string, subject string) (*model.Identity, error)
+	ListIdentities(ctx context.Context, userId uuid.UUID) ([]*model.Identity, error)
+	DeleteIdentity(ctx context.Context, userId uuid.UUID, id uuid.UUID) error
+
+	CreateEmailLoginToken(ctx context.Context, input *model.RepositoryCreateEmailLoginTokenInput) (*model.EmailLoginToken, error)
+	UseEmailLoginToken(ctx context.Context, tokenHash string) (*model.EmailLoginToken, error)
+
+	CreateOidcLoginState(ctx context.Context, input *model.RepositoryCreateOidcLoginStateInput) (*model.OidcLoginState, error)
+	UseOidcLoginState(ctx context.Context, stateHash string) (*model.OidcLoginState, error)
+}
+
+type AuditRepository interface {
+	CreateAuditEvent(ctx context.Context, input *model.RepositoryCreateAuditEventInput) (*model.AuditEvent, error)
+	ListAuditEvents(ctx context.Context, input *model.ListAuditEventsInput) ([]*model.AuditEvent, error)
+}
+
+type DataJobRepository interface {
+	CreateDataJob(ctx context.Context, input *model.RepositoryCreateDataJobInput) (*model.DataJob, error)
+	// CreateDeletionJob marks the user deleted and removes personal data together with creating the job
+	CreateDeletionJob(ctx context.Context, input *model.RepositoryCreateDataJobInput) (*model.DataJob, error)
+	GetDataJob(ctx context.Context, id uuid.UUID) (*model.DataJob, error)
+	GetUnfinishedDataJob(ctx context.Context, userId uuid.UUID, kind model.DataJobKind) (*model.DataJob, error)
+	ClaimDataJobs(ctx context.Context, staleBefore time.Time, limit int) ([]*model.DataJob, error)
+	UpdateDataJobStep(ctx context.Context, id uuid.UUID, step string) (*model.DataJob, error)
+	FinishDataJob(ctx context.Context, id uuid.UUID, status model.DataJobStatus, resultFileId *uuid.UUID, errMessage *string) (*model.DataJob, error)
+}
+
+// Mailer delivers email login links.
+type Mailer interface {
+	Send(ctx context.Context, to string, subject string, body string) error
+}
+
+// OidcProvider is an external OpenID Connect sign in.
+type OidcProvider interface {
+	Name() string
+	AuthCodeURL(ctx context.Context, state string, nonce string, codeVerifier string) (string, error)
+	Exchange(ctx context.Context, code string, codeVerifier string, nonce string) (*authorization.OidcIdentity, error)
 }
 
 type UserService struct {
 	userRepository     UserRepository
 	tsRepository       TutorStudentsRepository
-	telegramAuthSecret string
+	inviteRepository   TutorInviteRepository
+	gsRepository       GuardianStudentRepository
+	sessionRepository  SessionRepository
+	identityRepository IdentityRepository
+	auditRepository    AuditRepository
+	dataJobRepository  DataJobRepository
+	replayCache        ReplayCache
+	mailer             Mailer
+	oidcProvider       OidcProvider
+	dataServices       *DataServices
+	fileService        clients.FileServiceClient
+	tokenIssuer        *authorization.TokenIssuer
+	authConfig         AuthConfig
+}
+
+// ReplayCache remembers signed authorization headers until they expire.
+type ReplayCache interface {
+	// Claim returns false if key was already claimed within ttl.
+	Claim(ctx context.Context, key string, ttl time.Duration) (bool, error)
+}
+
+type AuthConfig struct {
+	TelegramSecret string
+	// TelegramSkew is the allowed difference between the header timestamp and now
+	TelegramSkew time.Duration
+	// TelegramBotToken validates Mini App init data, empty disables it
+	TelegramBotToken       string
+	TelegramInitDataMaxAge time.Duration
+	// EmailLoginURL is the frontend page, the token is appended as ?token=
+	EmailLoginURL string
+	EmailLoginTTL time.Duration
+	OidcStateTTL  time.Duration
+	// AdminTelegramIds may register with the admin role
+	AdminTelegramIds []int64
+	// TelegramBotUsername builds deep links of invites, empty disables them
+	TelegramBotUsername string
+	InviteTTL           time.Duration
 }
 
 func NewUserService(
 	userRepository UserRepository,
 	tutorStudentsRepository TutorStudentsRepository,
-	telegramAuthSecret string,
+	tutorInviteRepository TutorInviteRepository,
+	guardianStudentRepository GuardianStudentRepository,
+	sessionRepository SessionRepository,
+	identityRepository IdentityRepository,
+	auditRepository AuditRepository,
+	dataJobRepository DataJobRepository,
+	replayCache ReplayCache,
+	tokenIssuer *authorization.TokenIssuer,
+	authConfig AuthConfig,
 ) *UserService {
-	return &UserService{userRepository, tutorStudentsRepository, telegramAuthSecret}
+	return &UserService{
+		userRepository:     userRepository,
+		tsRepository:       tutorStudentsRepository,
+		inviteRepository:   tutorInviteRepository,
+		gsRepository:       guardianStudentRepository,
+		sessionRepository:  sessionRepository,
+		identityRepository: identityRepository,
+		auditRepository:    auditRepository,
+		dataJobRepository:  dataJobRepository,
+		replayCache:        replayCache,
+		tokenIssuer:        tokenIssuer,
+		authConfig:         authConfig,
+	}
+}
+
+// WithMailer enables email sign in.
+func (s *UserService) WithMailer(mailer Mailer) *UserService {
+	s.mailer = mailer
+	return s
+}
+
+// WithOidcProvider enables sign in with the OpenID Connect provider.
+func (s *UserService) WithOidcProvider(provider OidcProvider) *UserService {
+	s.oidcProvider = provider
+	return s
+}
+
+// WithFileService enables tutor avatars.
+func (s *UserService) WithFileService(fileService clients.FileServiceClient) *UserService {
+	s.fileService = fileService
+	return s
+}
+
+// WithDataServices enables account deletion and data export.
+func (s *UserService) WithDataServices(services *DataServices) *UserService {
+	s.dataServices = services
+	return s
 }
 
 func (s *UserService) RegisterViaTelegram(ctx context.Context, input *model.RegisterViaTelegramInput) (*model.User, error) {
-	if !input.Role.IsValid() {
+	if input.Role == model.RoleAdmin {
+		if err := s.ensureAdminTelegramAccount(ctx, input); err != nil {
+			return nil, err
+		}
+	} else if !input.Role.IsRegistrable() {
 		return nil, errdefs.ValidationErr
 	}
 
@@ -121,8 +282,9 @@ func (s *UserService) RegisterViaTelegram(ctx context.Context, input *model.Regi
 			return nil, err
 		}
 		tutorProfileInput := &model.RepositoryCreateTutorProfileInput{
-			Id:     id,
-			UserId: user.Id,
+			Id:       id,
+			UserId:   user.Id,
+			Currency: money.Default,
 		}
 
 		_, err := repo.CreateTutorProfile(ctx, tutorProfileInput)
@@ -139,22 +301,135 @@ func (s *UserService) RegisterViaTelegram(ctx context.Context, input *model.Regi
 	return user, nil
 }
 
+// ensureAdminTelegramAccount lets only the accounts from AdminTelegramIds register as admins.
+// The telegram id of the request body is not trusted, it must be proven by the authorization header.
+func (s *UserService) ensureAdminTelegramAccount(ctx context.Context, input *model.RegisterViaTelegramInput) error {
+	if input.AuthorizationHeader == nil {
+		return errdefs.ErrAuthMalformed
+	}
+
+	telegramId, err := s.verifyTelegramHeader(ctx, *input.AuthorizationHeader)
+	if err != nil {
+		reportAuthFailure(ctx, err)
+		return err
+	}
+	if telegramId != input.TelegramId || !slices.Contains(s.authConfig.AdminTelegramIds, telegramId) {
+		return errdefs.ErrPermissionDenied
+	}
+	return nil
+}
+
+// verifyTelegramHeader returns the telegram id of a telegram or tma authorization header.
+func (s *UserService) verifyTelegramHeader(ctx context.Context, header string) (int64, error) {
+	if strings.HasPrefix(header, "telegram") {
+		return s.verifyTelegramAuth(ctx, strings.Trim(strings.TrimPrefix(header, "telegram"), " "))
+	}
+	if strings.HasPrefix(header, "tma") {
+		return s.verifyTelegramWebApp(strings.Trim(strings.TrimPrefix(header, "tma"), " "))
+	}
+	return 0, errdefs.ErrAuthUnknownScheme
+}
+
+// Authorize checks the authorization header, failures are logged and counted by reason.
 func (s *UserService) Authorize(ctx context.Context, input *model.AuthorizeInput) (*model.User, error) {
+	user, err := s.authorize(ctx, input)
+	if err == nil {
+		err = ensureUserActive(user)
+	}
+	if err != nil {
+		reportAuthFailure(ctx, err)
+		return nil, err
+	}
+	return user, nil
+}
+
+// ensureUserActive rejects users blocked by admins or deleted.
+func ensureUserActive(user *model.User) error {
+	if user.Status != model.UserStatusActive {
+		return fmt.Errorf("authorization: user %s is %s: %w", user.Id, user.Status, errdefs.ErrAuthUserBlocked)
+	}
+	return nil
+}
+
+func reportAuthFailure(ctx context.Context, err error) {
+	if !errors.Is(err, errdefs.AuthenticationErr) {
+		return
+	}
+	reason := errdefs.AuthFailureReason(err)
+	metrics.AuthFailures.Add(reason, 1)
+	if logger, ok := logging.GetFromContext(ctx); ok {
+		logger.Info(ctx, "authorization failed", zap.String("reason", reason), zap.Error(err))
+	}
+}
+
+func (s *UserService) authorize(ctx context.Context, input *model.AuthorizeInput) (*model.User, error) {
 	header := input.AuthorizationHeader
 	if strings.HasPrefix(header, "telegram") {
 		return s.authorizeWithTelegram(ctx, strings.Trim(strings.TrimPrefix(header, "telegram"), " "))
 	}
+	if strings.HasPrefix(header, "tma") {
+		return s.authorizeWithTelegramWebApp(ctx, strings.Trim(strings.TrimPrefix(header, "tma"), " "))
+	}
+	if strings.HasPrefix(header, "Bearer") {
+		return s.authorizeWithAccessToken(ctx, strings.Trim(strings.TrimPrefix(header, "Bearer"), " "))
+	}
 
-	return nil, errdefs.AuthenticationErr
+	return nil, errdefs.ErrAuthUnknownScheme
 }
 
 func (s *UserService) authorizeWithTelegram(ctx context.Context, header string) (*model.User, error) {
-	telegramId, err := authorization.GetTelegramId(s.telegramAuthSecret, header)
+	telegramId, err := s.verifyTelegramAuth(ctx, header)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getUserByTelegramId(ctx, telegramId)
+}
+
+func (s *UserService) authorizeWithTelegramWebApp(ctx context.Context, initData string) (*model.User, error) {
+	telegramId, err := s.verifyTelegramWebApp(initData)
 	if err != nil {
 		return nil, err
 	}
 
+	return s.getUserByTelegramId(ctx, telegramId)
+}
+
+func (s *UserService) verifyTelegramAuth(ctx context.Context, header string) (int64, error) {
+	skew := s.authConfig.TelegramSkew
+	telegramId, err := authorization.GetTelegramId(s.authConfig.TelegramSecret, header, skew, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	// the header is valid for 2*skew around its timestamp, the hmac makes it unique
+	fresh, err := s.replayCache.Claim(ctx, header, 2*skew)
+	if err != nil {
+		return 0, err
+	}
+	if !fresh {
+		return 0, fmt.Errorf("authorization: header already used: %w", errdefs.ErrAuthReplayed)
+	}
+
+	return telegramId, nil
+}
+
+func (s *UserService) verifyTelegramWebApp(initData string) (int64, error) {
+	data, err := authorization.ParseWebAppInitData(
+		s.authConfig.TelegramBotToken, initData, s.authConfig.TelegramInitDataMaxAge, time.Now(),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return data.User.Id, nil
+}
+
+func (s *UserService) getUserByTelegramId(ctx context.Context, telegramId int64) (*model.User, error) {
 	tgAccount, err := s.userRepository.GetTelegramAccountByTelegramId(ctx, telegramId)
+	if errors.Is(err, errdefs.ErrNotFound) {
+		return nil, fmt.Errorf("authorization: telegram account %d not found: %w", telegramId, errdefs.ErrAuthUnknownUser)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -227,19 +502,6 @@ func (s *UserService) GetTutorProfile(ctx context.Context, userId uuid.UUID) (*m
 	return profile, nil
 }
 
-func (s *UserService) UpdateTutorProfile(ctx context.Context, userId uuid.UUID, input *model.UpdateTutorProfileInput) (*model.TutorProfile, error) {
-	if err := ensureCurrentUserIs(ctx, userId); err != nil {
-		return nil, err
-	}
-
-	profile, err := s.userRepository.UpdateTutorProfile(ctx, userId, input)
-	if err != nil {
-		return nil, err
-	}
-
-	return profile, nil
-}
-
 func (s *UserService) CreateTutorStudent(ctx context.Context, input *model.CreateTutorStudentInput) (*model.TutorStudent, error) {
 	if err := ensureCurrentUserIs(ctx, input.TutorId); err != nil {
 		return nil, err
@@ -258,10 +520,13 @@ func (s *UserService) CreateTutorStudent(ctx context.Context, input *model.Creat
 		Id:                   id,
 		TutorId:              input.TutorId,
 		StudentId:            input.StudentId,
-		LessonPriceRub:       input.LessonPriceRub,
 		LessonConnectionLink: input.LessonConnectionLink,
 		Status:               model.TutorStudentStatusInvited,
 	}
+	if input.LessonPrice != nil {
+		tsInput.LessonPriceMinor = &input.LessonPrice.Amount
+		tsInput.LessonPriceCurrency = &input.LessonPrice.Currency
+	}
 	ts, err := s.tsRepository.CreateTutorStudent(ctx, tsInput)
 	if err != nil {
 		return nil, err
@@ -271,7 +536,7 @@ func (s *UserService) CreateTutorStudent(ctx context.Context, input *model.Creat
 }
 
 func (s *UserService) GetTutorStudent(ctx context.Context, tutorId uuid.UUID, studentId uuid.UUID) (*model.TutorStudent, error) {
-	if err := ensureCurrentUserIs(ctx, tutorId, studentId); err != nil {
+	if err := s.ensureCurrentUserIsOrGuardianOf(ctx, studentId, tutorId, studentId); err != nil {
 		return nil, err
 	}
 	ts, err := s.tsRepository.GetTutorStudent(ctx, tutorId, studentId)
@@ -282,28 +547,33 @@ func (s *UserService) GetTutorStudent(ctx context.Context, tutorId uuid.UUID, st
 	return ts, nil
 }
 
+// UpdateTutorStudent changes the status the same way ChangeTutorStudentStatus does.
 func (s *UserService) UpdateTutorStudent(ctx context.Context, tutorId uuid.UUID, studentId uuid.UUID, input *model.UpdateTutorStudentInput) (*model.TutorStudent, error) {
 	if err := ensureCurrentUserIs(ctx, tutorId); err != nil {
 		return nil, err
 	}
-	ts, err := s.tsRepository.UpdateTutorStudent(ctx, tutorId, studentId, input)
-	if err != nil {
-		return nil, err
-	}
-
-	return ts, nil
-}
 
-func (s *UserService) DeleteTutorStudent(ctx context.Context, tutorId uuid.UUID, studentId uuid.UUID) error {
-	if err := ensureCurrentUserIs(ctx, tutorId); err != nil {
-		return err
+	var ts *model.TutorStudent
+	var err error
+	if input.Status != nil {
+		ts, err = s.ChangeTutorStudentStatus(ctx, tutorId, studentId, *input.Status, input.StatusReason)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if input.LessonPrice == nil && input.LessonConnectionLink == nil {
+		if ts != nil {
+			return ts, nil
+		}
+		return s.tsRepository.GetTutorStudent(ctx, tutorId, studentId)
 	}
 
-	if err := s.tsRepository.DeleteTutorStudent(ctx, tutorId, studentId); err != nil {
-		return err
+	ts, err = s.tsRepository.UpdateTutorStudent(ctx, tutorId, studentId, input)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	return ts, nil
 }
 
 func (s *UserService) ListTutorStudents(ctx context.Context, tutorId uuid.UUID) ([]*model.TutorStudent, error) {
@@ -320,7 +590,7 @@ func (s *UserService) ListTutorStudents(ctx context.Context, tutorId uuid.UUID)
 }
 
 func (s *UserService) ListTutorStudentsForStudent(ctx context.Context, studentId uuid.UUID) ([]*model.TutorStudent, error) {
-	if err := ensureCurrentUserIs(ctx, studentId); err != nil {
+	if err := s.ensureCurrentUserIsOrGuardianOf(ctx, studentId, studentId); err != nil {
 		return nil, err
 	}
 
@@ -333,7 +603,7 @@ func (s *UserService) ListTutorStudentsForStudent(ctx context.Context, studentId
 }
 
 func (s *UserService) ResolveTutorStudentContext(ctx context.Context, tutorId uuid.UUID, studentId uuid.UUID) (*model.TutorStudentContext, error) {
-	if err := ensureCurrentUserIs(ctx, tutorId, studentId); err != nil {
+	if err := s.ensureCurrentUserIsOrGuardianOf(ctx, studentId, tutorId, studentId); err != nil {
 		return nil, err
 	}
 
@@ -349,13 +619,13 @@ func (s *UserService) ResolveTutorStudentContext(ctx context.Context, tutorId uu
 
 	resp := &model.TutorStudentContext{
 		RelationshipStatus:   ts.Status,
-		LessonPriceRub:       tutorProfile.LessonPriceRub,
+		LessonPrice:          tutorProfile.LessonPrice(),
 		LessonConnectionLink: tutorProfile.LessonConnectionLink,
 		PaymentInfo:          tutorProfile.PaymentInfo,
 	}
 
-	if ts.LessonPriceRub != nil {
-		resp.LessonPriceRub = ts.LessonPriceRub
+	if price := ts.LessonPrice(); price != nil {
+		resp.LessonPrice = price
 	}
 
 	if ts.LessonConnectionLink != nil {
@@ -365,25 +635,6 @@ func (s *UserService) ResolveTutorStudentContext(ctx context.Context, tutorId uu
 	return resp, nil
 }
 
-func (s *UserService) AcceptInvitationFromTutor(ctx context.Context, tutorId uuid.UUID) error {
-	id, err := getUserId(ctx)
-	if err != nil {
-		return err
-	}
-
-	if err := ensureCurrentUserRole(ctx, model.RoleStudent); err != nil {
-		return err
-	}
-
-	status := model.TutorStudentStatusActive
-	_, err = s.tsRepository.UpdateTutorStudent(ctx, tutorId, id, &model.UpdateTutorStudentInput{Status: &status})
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
 func getUserId(ctx context.Context) (uuid.UUID, error) {
 	id, ok := ctxdata.GetUserID(ctx)
 	if !ok {
@@ -398,6 +649,15 @@ func getUserId(ctx context.Context) (uuid.UUID, error) {
 	return idUUID, nil
 }
 
+// userContext calls other services on behalf of the user.
+func userContext(ctx context.Context, userId uuid.UUID, role model.Role) context.Context {
+	return metadata.NewOutgoingContext(ctx, metadata.Pairs(
+		"x-user-id", userId.String(),
+		"x-user-role", role.String(),
+		"x-caller-service", "user_service",
+	))
+}
+
 func getRole(ctx context.Context) (model.Role, error) {
 	roleString, ok := ctxdata.GetUserRole(ctx)
 	if !ok {
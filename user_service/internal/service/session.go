@@ -0,0 +1,204 @@
+package service
+
+import (
+	"common_library/authtoken"
+	"common_library/logging"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"strings"
+	"time"
+	"userservice/internal/authorization"
+	"userservice/internal/errdefs"
+	"userservice/internal/model"
+)
+
+// CreateSession exchanges an initial Telegram authorization header for session tokens.
+func (s *UserService) CreateSession(ctx context.Context, input *model.AuthorizeInput) (*model.SessionTokens, error) {
+	if strings.HasPrefix(input.AuthorizationHeader, "Bearer") {
+		err := fmt.Errorf("session: access token cannot create a session: %w", errdefs.ErrAuthUnknownScheme)
+		reportAuthFailure(ctx, err)
+		return nil, err
+	}
+
+	user, err := s.Authorize(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.createSession(ctx, user)
+}
+
+func (s *UserService) createSession(ctx context.Context, user *model.User) (*model.SessionTokens, error) {
+	if err := ensureUserActive(user); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	sessionId := uuid.New()
+	refreshToken, refreshHash, err := authorization.NewRefreshToken(sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := s.sessionRepository.CreateSession(ctx, &model.RepositoryCreateSessionInput{
+		Id:               sessionId,
+		UserId:           user.Id,
+		RefreshTokenHash: refreshHash,
+		ExpiresAt:        now.Add(s.tokenIssuer.RefreshTTL()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.issueSessionTokens(user, session, refreshToken, now)
+}
+
+// RefreshSession rotates the refresh token and issues a new access token.
+// Reusing an already rotated refresh token revokes the whole session,
+// a token with an unknown secret is rejected without touching the session.
+func (s *UserService) RefreshSession(ctx context.Context, refreshToken string) (*model.SessionTokens, error) {
+	tokens, err := s.refreshSession(ctx, refreshToken)
+	if err != nil {
+		reportAuthFailure(ctx, err)
+	}
+	return tokens, err
+}
+
+func (s *UserService) refreshSession(ctx context.Context, refreshToken string) (*model.SessionTokens, error) {
+	session, err := s.getSessionByRefreshToken(ctx, refreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if !session.IsActive(now) {
+		return nil, fmt.Errorf("session: session is expired or revoked: %w", errdefs.ErrAuthSessionRevoked)
+	}
+
+	newToken, newHash, err := authorization.NewRefreshToken(session.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	rotated, err := s.sessionRepository.RotateRefreshToken(
+		ctx, session.Id, authorization.HashRefreshToken(refreshToken), newHash, now.Add(s.tokenIssuer.RefreshTTL()),
+	)
+	if errors.Is(err, errdefs.ErrNotFound) {
+		// the same token was rotated concurrently
+		return nil, s.revokeReusedSession(ctx, session.Id)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepository.GetUser(ctx, rotated.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureUserActive(user); err != nil {
+		return nil, err
+	}
+
+	return s.issueSessionTokens(user, rotated, newToken, now)
+}
+
+// RevokeSession logs out the session of the refresh token.
+func (s *UserService) RevokeSession(ctx context.Context, refreshToken string) error {
+	session, err := s.getSessionByRefreshToken(ctx, refreshToken)
+	if err != nil {
+		return err
+	}
+	return s.sessionRepository.RevokeSession(ctx, session.Id)
+}
+
+// IsSessionActive is used by api gateway to check revocation of locally verified access tokens.
+func (s *UserService) IsSessionActive(ctx context.Context, sessionId uuid.UUID) (bool, error) {
+	session, err := s.sessionRepository.GetSession(ctx, sessionId)
+	if errors.Is(err, errdefs.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return session.IsActive(time.Now()), nil
+}
+
+func (s *UserService) GetSigningKeys(ctx context.Context) authtoken.KeySet {
+	return s.tokenIssuer.KeySet()
+}
+
+func (s *UserService) authorizeWithAccessToken(ctx context.Context, token string) (*model.User, error) {
+	claims, err := s.tokenIssuer.VerifyAccessToken(token, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	sessionId, err := uuid.Parse(claims.SessionID)
+	if err != nil {
+		return nil, fmt.Errorf("session: invalid session id: %w", errdefs.ErrAuthMalformed)
+	}
+	active, err := s.IsSessionActive(ctx, sessionId)
+	if err != nil {
+		return nil, err
+	}
+	if !active {
+		return nil, fmt.Errorf("session: session is expired or revoked: %w", errdefs.ErrAuthSessionRevoked)
+	}
+
+	userId, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return nil, fmt.Errorf("session: invalid subject: %w", errdefs.ErrAuthMalformed)
+	}
+	return s.userRepository.GetUser(ctx, userId)
+}
+
+// getSessionByRefreshToken returns the session only for its current refresh token.
+// The previous token of the session revokes it as reused, only one previous hash is kept.
+func (s *UserService) getSessionByRefreshToken(ctx context.Context, refreshToken string) (*model.Session, error) {
+	sessionId, err := authorization.ParseRefreshToken(refreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := s.sessionRepository.GetSession(ctx, sessionId)
+	if errors.Is(err, errdefs.ErrNotFound) {
+		return nil, fmt.Errorf("session: unknown session: %w", errdefs.ErrAuthSessionRevoked)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if authorization.RefreshTokenMatches(refreshToken, session.RefreshTokenHash) {
+		return session, nil
+	}
+	if session.PreviousRefreshTokenHash != nil && authorization.RefreshTokenMatches(refreshToken, *session.PreviousRefreshTokenHash) {
+		return nil, s.revokeReusedSession(ctx, session.Id)
+	}
+	return nil, fmt.Errorf("session: refresh token does not match the session: %w", errdefs.ErrAuthInvalidToken)
+}
+
+func (s *UserService) revokeReusedSession(ctx context.Context, sessionId uuid.UUID) error {
+	if logger, ok := logging.GetFromContext(ctx); ok {
+		logger.Warn(ctx, "refresh token reuse, revoking session", zap.String("session_id", sessionId.String()))
+	}
+	if err := s.sessionRepository.RevokeSession(ctx, sessionId); err != nil {
+		return err
+	}
+	return fmt.Errorf("session: refresh token reuse: %w", errdefs.ErrAuthTokenReuse)
+}
+
+func (s *UserService) issueSessionTokens(user *model.User, session *model.Session, refreshToken string, now time.Time) (*model.SessionTokens, error) {
+	accessToken, accessExpiresAt, err := s.tokenIssuer.IssueAccessToken(user.Id, user.Role.String(), session.Id, now)
+	if err != nil {
+		return nil, err
+	}
+	return &model.SessionTokens{
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  accessExpiresAt,
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: session.ExpiresAt,
+	}, nil
+}
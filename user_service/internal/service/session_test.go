@@ -0,0 +1,194 @@
+package service
+
+import (
+	"common_library/authtoken"
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"testing"
+	"time"
+	"userservice/internal/authorization"
+	"userservice/internal/errdefs"
+	"userservice/internal/model"
+)
+
+type fakeSessionRepository struct {
+	sessions map[uuid.UUID]*model.Session
+}
+
+func (r *fakeSessionRepository) CreateSession(ctx context.Context, input *model.RepositoryCreateSessionInput) (*model.Session, error) {
+	session := &model.Session{
+		Id:               input.Id,
+		UserId:           input.UserId,
+		RefreshTokenHash: input.RefreshTokenHash,
+		ExpiresAt:        input.ExpiresAt,
+	}
+	r.sessions[session.Id] = session
+	copied := *session
+	return &copied, nil
+}
+
+func (r *fakeSessionRepository) GetSession(ctx context.Context, id uuid.UUID) (*model.Session, error) {
+	session, ok := r.sessions[id]
+	if !ok {
+		return nil, errdefs.ErrNotFound
+	}
+	copied := *session
+	return &copied, nil
+}
+
+func (r *fakeSessionRepository) RotateRefreshToken(ctx context.Context, id uuid.UUID, oldHash string, newHash string, expiresAt time.Time) (*model.Session, error) {
+	session, ok := r.sessions[id]
+	if !ok || session.RefreshTokenHash != oldHash || session.RevokedAt != nil {
+		return nil, errdefs.ErrNotFound
+	}
+	previous := session.RefreshTokenHash
+	session.PreviousRefreshTokenHash = &previous
+	session.RefreshTokenHash = newHash
+	session.ExpiresAt = expiresAt
+	copied := *session
+	return &copied, nil
+}
+
+func (r *fakeSessionRepository) RevokeSession(ctx context.Context, id uuid.UUID) error {
+	if session, ok := r.sessions[id]; ok && session.RevokedAt == nil {
+		now := time.Now()
+		session.RevokedAt = &now
+	}
+	return nil
+}
+
+func (r *fakeSessionRepository) RevokeUserSessions(ctx context.Context, userId uuid.UUID) error {
+	for _, session := range r.sessions {
+		if session.UserId == userId {
+			_ = r.RevokeSession(ctx, session.Id)
+		}
+	}
+	return nil
+}
+
+// fakeUserRepository implements only the methods used by sessions
+type fakeUserRepository struct {
+	UserRepository
+	users map[uuid.UUID]*model.User
+}
+
+func (r *fakeUserRepository) GetUser(ctx context.Context, id uuid.UUID) (*model.User, error) {
+	user, ok := r.users[id]
+	if !ok {
+		return nil, errdefs.ErrNotFound
+	}
+	return user, nil
+}
+
+func newSessionTestService(t *testing.T) (*UserService, *fakeSessionRepository, *model.User) {
+	t.Helper()
+	key, err := authorization.GenerateSigningKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	issuer, err := authorization.NewTokenIssuer([]*authtoken.SigningKey{key}, "studyflow", time.Minute, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	user := &model.User{Id: uuid.New(), Role: model.RoleStudent, Status: model.UserStatusActive}
+	sessions := &fakeSessionRepository{sessions: map[uuid.UUID]*model.Session{}}
+	s := &UserService{
+		userRepository:    &fakeUserRepository{users: map[uuid.UUID]*model.User{user.Id: user}},
+		sessionRepository: sessions,
+		tokenIssuer:       issuer,
+	}
+	return s, sessions, user
+}
+
+func TestRefreshSession_RotatesToken(t *testing.T) {
+	s, sessions, user := newSessionTestService(t)
+	ctx := context.Background()
+
+	first, err := s.createSession(ctx, user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := s.RefreshSession(ctx, first.RefreshToken)
+	if err != nil {
+		t.Fatalf("refresh: %v", err)
+	}
+	if second.RefreshToken == first.RefreshToken {
+		t.Fatal("refresh token was not rotated")
+	}
+	if _, err := s.RefreshSession(ctx, second.RefreshToken); err != nil {
+		t.Fatalf("refresh with the rotated token: %v", err)
+	}
+	for _, session := range sessions.sessions {
+		if session.RevokedAt != nil {
+			t.Fatal("session was revoked")
+		}
+	}
+}
+
+func TestRefreshSession_ReusedTokenRevokesSession(t *testing.T) {
+	s, sessions, user := newSessionTestService(t)
+	ctx := context.Background()
+
+	first, err := s.createSession(ctx, user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := s.RefreshSession(ctx, first.RefreshToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := s.RefreshSession(ctx, first.RefreshToken); !errors.Is(err, errdefs.ErrAuthTokenReuse) {
+		t.Fatalf("err = %v, want %v", err, errdefs.ErrAuthTokenReuse)
+	}
+	sessionId, _ := authorization.ParseRefreshToken(first.RefreshToken)
+	if sessions.sessions[sessionId].RevokedAt == nil {
+		t.Fatal("session was not revoked")
+	}
+	if _, err := s.RefreshSession(ctx, second.RefreshToken); !errors.Is(err, errdefs.ErrAuthSessionRevoked) {
+		t.Fatalf("err = %v, want %v", err, errdefs.ErrAuthSessionRevoked)
+	}
+}
+
+func TestRefreshSession_ForgedTokenKeepsSession(t *testing.T) {
+	s, sessions, user := newSessionTestService(t)
+	ctx := context.Background()
+
+	tokens, err := s.createSession(ctx, user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sessionId, _ := authorization.ParseRefreshToken(tokens.RefreshToken)
+	forged := sessionId.String() + ".anything"
+
+	if _, err := s.RefreshSession(ctx, forged); !errors.Is(err, errdefs.ErrAuthInvalidToken) {
+		t.Fatalf("refresh: err = %v, want %v", err, errdefs.ErrAuthInvalidToken)
+	}
+	if err := s.RevokeSession(ctx, forged); !errors.Is(err, errdefs.ErrAuthInvalidToken) {
+		t.Fatalf("revoke: err = %v, want %v", err, errdefs.ErrAuthInvalidToken)
+	}
+	if sessions.sessions[sessionId].RevokedAt != nil {
+		t.Fatal("session was revoked by a forged token")
+	}
+	if _, err := s.RefreshSession(ctx, tokens.RefreshToken); err != nil {
+		t.Fatalf("refresh with the real token: %v", err)
+	}
+}
+
+func TestRevokeSession(t *testing.T) {
+	s, sessions, user := newSessionTestService(t)
+	ctx := context.Background()
+
+	tokens, err := s.createSession(ctx, user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.RevokeSession(ctx, tokens.RefreshToken); err != nil {
+		t.Fatal(err)
+	}
+	sessionId, _ := authorization.ParseRefreshToken(tokens.RefreshToken)
+	if sessions.sessions[sessionId].RevokedAt == nil {
+		t.Fatal("session was not revoked")
+	}
+}
@@ -0,0 +1,264 @@
+package service
+
+import (
+	"common_library/logging"
+	"context"
+	"errors"
+	fileapi "fileservice/pkg/api"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"slices"
+	"strings"
+	"unicode/utf8"
+	"userservice/internal/errdefs"
+	"userservice/internal/model"
+)
+
+const (
+	maxBioLength             = 2000
+	maxProfileListLength     = 20
+	maxProfileListItemLength = 64
+
+	defaultTutorSearchLimit = 20
+	maxTutorSearchLimit     = 50
+
+	// avatarFileOwnerType registers the avatar as a usage of the profile in file_service
+	avatarFileOwnerType = "tutor_profile"
+)
+
+var avatarExtensions = []string{".jpg", ".jpeg", ".png", ".webp"}
+
+func (s *UserService) UpdateTutorProfile(ctx context.Context, userId uuid.UUID, input *model.UpdateTutorProfileInput) (*model.TutorProfile, error) {
+	if err := ensureCurrentUserIs(ctx, userId); err != nil {
+		return nil, err
+	}
+
+	if input.Bio != nil && utf8.RuneCountInString(*input.Bio) > maxBioLength {
+		return nil, errdefs.ValidationErr
+	}
+	var err error
+	if input.Subjects, err = normalizeProfileList(input.Subjects); err != nil {
+		return nil, err
+	}
+	if input.Languages, err = normalizeProfileList(input.Languages); err != nil {
+		return nil, err
+	}
+	if input.GradeLevels, err = normalizeProfileList(input.GradeLevels); err != nil {
+		return nil, err
+	}
+
+	current, err := s.userRepository.GetTutorProfile(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	newAvatar := !input.RemoveAvatar && input.AvatarFileId != nil &&
+		(current.AvatarFileId == nil || *current.AvatarFileId != *input.AvatarFileId)
+	if newAvatar {
+		if err := s.attachAvatar(ctx, current, *input.AvatarFileId); err != nil {
+			return nil, err
+		}
+	}
+
+	profile, err := s.userRepository.UpdateTutorProfile(ctx, userId, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if (newAvatar || input.RemoveAvatar) && current.AvatarFileId != nil {
+		s.detachAvatar(ctx, current, *current.AvatarFileId)
+	}
+
+	return profile, nil
+}
+
+// GetTutorPublicProfile returns the profile without the lesson connection link.
+// Hidden profiles are visible only to the tutor and their students.
+func (s *UserService) GetTutorPublicProfile(ctx context.Context, tutorId uuid.UUID) (*model.TutorPublicProfile, error) {
+	currentUserId, err := getUserId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepository.GetUser(ctx, tutorId)
+	if err != nil {
+		return nil, err
+	}
+	if user.Role != model.RoleTutor || user.Status != model.UserStatusActive {
+		return nil, errdefs.ErrNotFound
+	}
+
+	profile, err := s.userRepository.GetTutorProfile(ctx, tutorId)
+	if err != nil {
+		return nil, err
+	}
+
+	isSelf := currentUserId == tutorId
+	var pair *model.TutorStudent
+	if !isSelf {
+		pair, err = s.tsRepository.GetTutorStudent(ctx, tutorId, currentUserId)
+		if err != nil && !errors.Is(err, errdefs.ErrNotFound) {
+			return nil, err
+		}
+	}
+	if !profile.IsPublic && !isSelf && pair == nil {
+		return nil, errdefs.ErrNotFound
+	}
+
+	result := &model.TutorPublicProfile{
+		User: &model.UserPublic{
+			Id:        user.Id,
+			Role:      user.Role,
+			FirstName: user.FirstName,
+			LastName:  user.LastName,
+		},
+		Profile:   profile,
+		AvatarURL: s.avatarURL(ctx, profile),
+	}
+	if isSelf || (pair != nil && pair.Status == model.TutorStudentStatusActive) {
+		result.PaymentInfo = profile.PaymentInfo
+	}
+
+	return result, nil
+}
+
+// SearchTutors finds public tutors for any signed in user, payment info is never returned.
+func (s *UserService) SearchTutors(ctx context.Context, input *model.SearchTutorsInput) ([]*model.TutorPublicProfile, error) {
+	if _, err := getUserId(ctx); err != nil {
+		return nil, err
+	}
+
+	if input.Limit < 0 || input.Limit > maxTutorSearchLimit || input.Offset < 0 {
+		return nil, errdefs.ValidationErr
+	}
+	if input.Limit == 0 {
+		input.Limit = defaultTutorSearchLimit
+	}
+	if input.MinPrice != nil && input.MaxPrice != nil &&
+		(input.MinPrice.Currency != input.MaxPrice.Currency || input.MinPrice.Amount > input.MaxPrice.Amount) {
+		return nil, errdefs.ValidationErr
+	}
+
+	input.Query = strings.TrimSpace(input.Query)
+	input.Subject = normalizeProfileFilter(input.Subject)
+	input.Language = normalizeProfileFilter(input.Language)
+	input.GradeLevel = normalizeProfileFilter(input.GradeLevel)
+
+	tutors, err := s.userRepository.SearchTutors(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.TutorPublicProfile, len(tutors))
+	for i, tutor := range tutors {
+		result[i] = &model.TutorPublicProfile{
+			User: &model.UserPublic{
+				Id:        tutor.UserId,
+				Role:      model.RoleTutor,
+				FirstName: tutor.FirstName,
+				LastName:  tutor.LastName,
+			},
+			Profile:   &tutor.TutorProfile,
+			AvatarURL: s.avatarURL(ctx, &tutor.TutorProfile),
+		}
+	}
+
+	return result, nil
+}
+
+// attachAvatar checks that the tutor uploaded the image and keeps it from garbage collection.
+func (s *UserService) attachAvatar(ctx context.Context, profile *model.TutorProfile, fileId uuid.UUID) error {
+	if s.fileService == nil {
+		return errdefs.ErrNotConfigured
+	}
+	reqCtx := userContext(ctx, profile.UserId, model.RoleTutor)
+
+	file, err := s.fileService.GetFileMeta(reqCtx, &fileapi.GetFileMetaRequest{FileId: fileId.String()})
+	if code := status.Code(err); code == codes.NotFound || code == codes.PermissionDenied || code == codes.InvalidArgument {
+		return errdefs.ValidationErr
+	}
+	if err != nil {
+		return err
+	}
+	if file.UploadedBy != profile.UserId.String() || !slices.Contains(avatarExtensions, strings.ToLower(file.Extension)) {
+		return errdefs.ValidationErr
+	}
+
+	_, err = s.fileService.RegisterFileUsage(reqCtx, &fileapi.FileUsage{
+		FileId:    fileId.String(),
+		OwnerType: avatarFileOwnerType,
+		OwnerId:   profile.Id.String(),
+	})
+	return err
+}
+
+// detachAvatar lets file_service collect the previous avatar, failures are only logged.
+func (s *UserService) detachAvatar(ctx context.Context, profile *model.TutorProfile, fileId uuid.UUID) {
+	if s.fileService == nil {
+		return
+	}
+
+	_, err := s.fileService.UnregisterFileUsage(userContext(ctx, profile.UserId, model.RoleTutor), &fileapi.FileUsage{
+		FileId:    fileId.String(),
+		OwnerType: avatarFileOwnerType,
+		OwnerId:   profile.Id.String(),
+	})
+	if err != nil {
+		if logger, ok := logging.GetFromContext(ctx); ok {
+			logger.Error(ctx, "Failed to unregister avatar", zap.String("file_id", fileId.String()), zap.Error(err))
+		}
+	}
+}
+
+// avatarURL returns a download link issued on behalf of the tutor, nil on failures.
+func (s *UserService) avatarURL(ctx context.Context, profile *model.TutorProfile) *string {
+	if s.fileService == nil || profile.AvatarFileId == nil {
+		return nil
+	}
+
+	url, err := s.fileService.GenerateDownloadURL(userContext(ctx, profile.UserId, model.RoleTutor), &fileapi.GenerateDownloadURLRequest{
+		FileId: profile.AvatarFileId.String(),
+	})
+	if err != nil {
+		if logger, ok := logging.GetFromContext(ctx); ok {
+			logger.Error(ctx, "Failed to generate avatar URL", zap.String("file_id", profile.AvatarFileId.String()), zap.Error(err))
+		}
+		return nil
+	}
+	return &url.Url
+}
+
+// normalizeProfileList lower cases the values and removes duplicates, nil stays nil.
+func normalizeProfileList(values []string) ([]string, error) {
+	if values == nil {
+		return nil, nil
+	}
+
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		value = strings.ToLower(strings.TrimSpace(value))
+		if value == "" || utf8.RuneCountInString(value) > maxProfileListItemLength {
+			return nil, errdefs.ValidationErr
+		}
+		if !slices.Contains(result, value) {
+			result = append(result, value)
+		}
+	}
+	if len(result) > maxProfileListLength {
+		return nil, errdefs.ValidationErr
+	}
+	return result, nil
+}
+
+func normalizeProfileFilter(value *string) *string {
+	if value == nil {
+		return nil
+	}
+	normalized := strings.ToLower(strings.TrimSpace(*value))
+	if normalized == "" {
+		return nil
+	}
+	return &normalized
+}
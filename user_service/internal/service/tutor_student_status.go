@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"unicode/utf8"
+	"userservice/internal/errdefs"
+	"userservice/internal/model"
+)
+
+const maxStatusReasonLength = 500
+
+// tutorStudentTransitions lists the statuses a pair may move from, by the role changing it and the new status.
+// Invited pairs become active only when the student accepts the invitation,
+// so the tutor restores an archived pair by inviting the student again.
+var tutorStudentTransitions = map[model.Role]map[model.TutorStudentStatus][]model.TutorStudentStatus{
+	model.RoleTutor: {
+		model.TutorStudentStatusPaused:   {model.TutorStudentStatusActive},
+		model.TutorStudentStatusActive:   {model.TutorStudentStatusPaused},
+		model.TutorStudentStatusArchived: {model.TutorStudentStatusActive, model.TutorStudentStatusPaused},
+		model.TutorStudentStatusInvited:  {model.TutorStudentStatusArchived},
+	},
+	model.RoleStudent: {
+		model.TutorStudentStatusArchived: {model.TutorStudentStatusActive, model.TutorStudentStatusPaused},
+	},
+}
+
+// ChangeTutorStudentStatus pauses, resumes or archives the pair or invites the student of the archived pair again,
+// the change is kept in the pair history. The student may only archive the pair.
+func (s *UserService) ChangeTutorStudentStatus(ctx context.Context, tutorId uuid.UUID, studentId uuid.UUID, status model.TutorStudentStatus, reason *string) (*model.TutorStudent, error) {
+	if err := ensureCurrentUserIs(ctx, tutorId, studentId); err != nil {
+		return nil, err
+	}
+	currentUserId, err := getUserId(ctx)
+	if err != nil {
+		return nil, err
+	}
+	role, err := getRole(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if (role == model.RoleTutor && currentUserId != tutorId) || (role == model.RoleStudent && currentUserId != studentId) {
+		return nil, errdefs.ErrPermissionDenied
+	}
+
+	if !status.IsValid() {
+		return nil, errdefs.ValidationErr
+	}
+	if reason != nil && utf8.RuneCountInString(*reason) > maxStatusReasonLength {
+		return nil, errdefs.ValidationErr
+	}
+
+	from, ok := tutorStudentTransitions[role][status]
+	if !ok {
+		return nil, errdefs.ErrInvalidStatusTransition
+	}
+
+	eventId, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.tsRepository.ChangeTutorStudentStatus(ctx, &model.RepositoryChangeTutorStudentStatusInput{
+		EventId:   eventId,
+		TutorId:   tutorId,
+		StudentId: studentId,
+		From:      from,
+		To:        status,
+		Reason:    reason,
+		ChangedBy: currentUserId,
+	})
+}
+
+// ListTutorStudentStatusEvents returns the status history of the pair to the pair and active guardians of the student.
+func (s *UserService) ListTutorStudentStatusEvents(ctx context.Context, tutorId uuid.UUID, studentId uuid.UUID) ([]*model.TutorStudentStatusEvent, error) {
+	if err := s.ensureCurrentUserIsOrGuardianOf(ctx, studentId, tutorId, studentId); err != nil {
+		return nil, err
+	}
+
+	ts, err := s.tsRepository.GetTutorStudent(ctx, tutorId, studentId)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.tsRepository.ListTutorStudentStatusEvents(ctx, ts.Id)
+}
+
+// DeleteTutorStudent withdraws the invitation of the tutor or archives the established pair.
+// Lessons, homework and payments of the pair refer to it, so it is never deleted.
+func (s *UserService) DeleteTutorStudent(ctx context.Context, tutorId uuid.UUID, studentId uuid.UUID) error {
+	if err := ensureCurrentUserIs(ctx, tutorId); err != nil {
+		return err
+	}
+
+	ts, err := s.tsRepository.GetTutorStudent(ctx, tutorId, studentId)
+	if err != nil {
+		return err
+	}
+
+	switch ts.Status {
+	case model.TutorStudentStatusInvited:
+		return s.endInvitation(ctx, ts, tutorId)
+	case model.TutorStudentStatusArchived:
+		return nil
+	default:
+		_, err := s.ChangeTutorStudentStatus(ctx, tutorId, studentId, model.TutorStudentStatusArchived, nil)
+		return err
+	}
+}
+
+// endInvitation deletes a new invited pair, a pair invited again after the archive goes back to the archive.
+func (s *UserService) endInvitation(ctx context.Context, ts *model.TutorStudent, changedBy uuid.UUID) error {
+	events, err := s.tsRepository.ListTutorStudentStatusEvents(ctx, ts.Id)
+	if err != nil {
+		return err
+	}
+	if len(events) == 0 {
+		return s.tsRepository.DeleteTutorStudent(ctx, ts.TutorId, ts.StudentId)
+	}
+
+	eventId, err := uuid.NewV7()
+	if err != nil {
+		return err
+	}
+
+	_, err = s.tsRepository.ChangeTutorStudentStatus(ctx, &model.RepositoryChangeTutorStudentStatusInput{
+		EventId:   eventId,
+		TutorId:   ts.TutorId,
+		StudentId: ts.StudentId,
+		From:      []model.TutorStudentStatus{model.TutorStudentStatusInvited},
+		To:        model.TutorStudentStatusArchived,
+		ChangedBy: changedBy,
+	})
+	return err
+}
+
+// AcceptInvitationFromTutor activates the invited pair of the tutor and the current student.
+func (s *UserService) AcceptInvitationFromTutor(ctx context.Context, tutorId uuid.UUID) error {
+	studentId, err := getUserId(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := ensureCurrentUserRole(ctx, model.RoleStudent); err != nil {
+		return err
+	}
+
+	eventId, err := uuid.NewV7()
+	if err != nil {
+		return err
+	}
+
+	_, err = s.tsRepository.ChangeTutorStudentStatus(ctx, &model.RepositoryChangeTutorStudentStatusInput{
+		EventId:   eventId,
+		TutorId:   tutorId,
+		StudentId: studentId,
+		From:      []model.TutorStudentStatus{model.TutorStudentStatusInvited},
+		To:        model.TutorStudentStatusActive,
+		ChangedBy: studentId,
+	})
+	if errors.Is(err, errdefs.ErrInvalidStatusTransition) {
+		return errdefs.ErrNotFound
+	}
+	return err
+}
@@ -0,0 +1,10 @@
+ALTER TABLE tutor_students DROP CONSTRAINT tutor_students_lesson_price_currency_check;
+ALTER TABLE tutor_students ADD COLUMN lesson_price_rub INTEGER;
+UPDATE tutor_students SET lesson_price_rub = lesson_price_minor / 100;
+ALTER TABLE tutor_students DROP COLUMN lesson_price_currency;
+ALTER TABLE tutor_students DROP COLUMN lesson_price_minor;
+
+ALTER TABLE tutor_profiles ADD COLUMN lesson_price_rub INTEGER;
+UPDATE tutor_profiles SET lesson_price_rub = lesson_price_minor / 100;
+ALTER TABLE tutor_profiles DROP COLUMN currency;
+ALTER TABLE tutor_profiles DROP COLUMN lesson_price_minor;
\ No newline at end of file
@@ -0,0 +1,17 @@
+ALTER TABLE tutor_profiles ADD COLUMN lesson_price_minor BIGINT CHECK (lesson_price_minor >= 0);
+ALTER TABLE tutor_profiles ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'RUB';
+UPDATE tutor_profiles SET lesson_price_minor = lesson_price_rub::BIGINT * 100;
+ALTER TABLE tutor_profiles DROP COLUMN lesson_price_rub;
+
+COMMENT ON COLUMN tutor_profiles.lesson_price_minor IS 'Default lesson price in minor units of currency';
+COMMENT ON COLUMN tutor_profiles.currency IS 'ISO 4217 currency of the tutor';
+
+ALTER TABLE tutor_students ADD COLUMN lesson_price_minor BIGINT CHECK (lesson_price_minor >= 0);
+ALTER TABLE tutor_students ADD COLUMN lesson_price_currency CHAR(3);
+UPDATE tutor_students SET lesson_price_minor = lesson_price_rub::BIGINT * 100, lesson_price_currency = 'RUB'
+WHERE lesson_price_rub IS NOT NULL;
+ALTER TABLE tutor_students DROP COLUMN lesson_price_rub;
+ALTER TABLE tutor_students ADD CONSTRAINT tutor_students_lesson_price_currency_check
+    CHECK ((lesson_price_minor IS NULL) = (lesson_price_currency IS NULL));
+
+COMMENT ON COLUMN tutor_students.lesson_price_minor IS 'Lesson price of the pair in minor units, overrides the tutor default';
@@ -0,0 +1,18 @@
+CREATE TABLE sessions (
+   id UUID PRIMARY KEY,
+   user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+   refresh_token_hash CHAR(64) NOT NULL,
+   expires_at TIMESTAMP NOT NULL,
+   revoked_at TIMESTAMP,
+   created_at TIMESTAMP NOT NULL DEFAULT now(),
+   edited_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_sessions_user_id ON sessions(user_id);
+
+COMMENT ON COLUMN sessions.refresh_token_hash IS 'SHA-256 of the current refresh token, replaced on every refresh';
+
+CREATE TRIGGER trg_edited_at_sessions
+    BEFORE UPDATE ON sessions
+    FOR EACH ROW
+    EXECUTE FUNCTION set_edited_at();
@@ -0,0 +1,14 @@
+CREATE TABLE user_identities (
+   id UUID PRIMARY KEY,
+   user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+   provider VARCHAR(32) NOT NULL,
+   subject VARCHAR(255) NOT NULL,
+   email VARCHAR(255),
+   created_at TIMESTAMP NOT NULL DEFAULT now(),
+   UNIQUE (provider, subject)
+);
+
+CREATE INDEX idx_user_identities_user_id ON user_identities(user_id);
+
+COMMENT ON TABLE user_identities IS 'Sign in identities besides telegram_accounts';
+COMMENT ON COLUMN user_identities.subject IS 'Lower case email for the email provider, sub claim for OIDC providers';
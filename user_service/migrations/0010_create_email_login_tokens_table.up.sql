@@ -0,0 +1,13 @@
+CREATE TABLE email_login_tokens (
+   id UUID PRIMARY KEY,
+   token_hash CHAR(64) NOT NULL UNIQUE,
+   email VARCHAR(255) NOT NULL,
+   role VARCHAR(16),
+   link_user_id UUID REFERENCES users(id) ON DELETE CASCADE,
+   expires_at TIMESTAMP NOT NULL,
+   used_at TIMESTAMP,
+   created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+COMMENT ON COLUMN email_login_tokens.role IS 'Role of the user registered by the token, NULL to sign in only';
+COMMENT ON COLUMN email_login_tokens.link_user_id IS 'User the email is linked to instead of signing in';
@@ -0,0 +1,12 @@
+CREATE TABLE oidc_login_states (
+   id UUID PRIMARY KEY,
+   state_hash CHAR(64) NOT NULL UNIQUE,
+   provider VARCHAR(32) NOT NULL,
+   nonce VARCHAR(64) NOT NULL,
+   code_verifier VARCHAR(128) NOT NULL,
+   role VARCHAR(16),
+   link_user_id UUID REFERENCES users(id) ON DELETE CASCADE,
+   expires_at TIMESTAMP NOT NULL,
+   used_at TIMESTAMP,
+   created_at TIMESTAMP NOT NULL DEFAULT now()
+);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS guardian_students;
\ No newline at end of file
@@ -0,0 +1,18 @@
+CREATE TABLE guardian_students (
+   id UUID PRIMARY KEY,
+   guardian_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+   student_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+   status VARCHAR(16) NOT NULL DEFAULT 'pending',
+   created_at TIMESTAMP NOT NULL DEFAULT now(),
+   edited_at TIMESTAMP NOT NULL DEFAULT now(),
+   UNIQUE (guardian_id, student_id)
+);
+
+CREATE INDEX idx_guardian_students_student_id ON guardian_students(student_id);
+
+COMMENT ON COLUMN guardian_students.status IS 'pending until the student accepts the request of the guardian';
+
+CREATE TRIGGER trg_edited_at_guardian_students
+    BEFORE UPDATE ON guardian_students
+    FOR EACH ROW
+    EXECUTE FUNCTION set_edited_at();
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS admin_audit_events;
\ No newline at end of file
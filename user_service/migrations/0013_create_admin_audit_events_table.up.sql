@@ -0,0 +1,14 @@
+CREATE TABLE admin_audit_events (
+   id UUID PRIMARY KEY,
+   admin_id UUID NOT NULL REFERENCES users(id),
+   action VARCHAR(64) NOT NULL,
+   target_type VARCHAR(32) NOT NULL,
+   target_id VARCHAR(64) NOT NULL DEFAULT '',
+   details TEXT,
+   created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_admin_audit_events_admin_id ON admin_audit_events(admin_id, created_at);
+CREATE INDEX idx_admin_audit_events_target_id ON admin_audit_events(target_id, created_at);
+
+COMMENT ON TABLE admin_audit_events IS 'append only log of admin actions, including ones performed in other services';
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS tutor_invites;
\ No newline at end of file
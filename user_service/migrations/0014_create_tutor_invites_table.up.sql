@@ -0,0 +1,20 @@
+CREATE TABLE tutor_invites (
+   id UUID PRIMARY KEY,
+   tutor_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+   code VARCHAR(32) NOT NULL UNIQUE,
+   lesson_price_minor BIGINT CHECK (lesson_price_minor >= 0),
+   lesson_price_currency CHAR(3),
+   lesson_connection_link TEXT,
+   max_uses INTEGER CHECK (max_uses > 0),
+   uses INTEGER NOT NULL DEFAULT 0,
+   expires_at TIMESTAMP NOT NULL,
+   revoked_at TIMESTAMP,
+   created_at TIMESTAMP NOT NULL DEFAULT now(),
+   CHECK ((lesson_price_minor IS NULL) = (lesson_price_currency IS NULL))
+);
+
+CREATE INDEX idx_tutor_invites_tutor_id ON tutor_invites(tutor_id, created_at);
+
+COMMENT ON COLUMN tutor_invites.code IS 'Shared in chats and telegram deep links, not a secret of the tutor';
+COMMENT ON COLUMN tutor_invites.lesson_price_minor IS 'Lesson price of the pairs created by the invite, the tutor default if NULL';
+COMMENT ON COLUMN tutor_invites.max_uses IS 'NULL for unlimited uses';
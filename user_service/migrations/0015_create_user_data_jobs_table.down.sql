@@ -0,0 +1 @@
+DROP TABLE IF EXISTS user_data_jobs;
\ No newline at end of file
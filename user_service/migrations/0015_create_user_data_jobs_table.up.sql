@@ -0,0 +1,25 @@
+CREATE TABLE user_data_jobs (
+   id UUID PRIMARY KEY,
+   user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+   kind VARCHAR(16) NOT NULL,
+   status VARCHAR(16) NOT NULL DEFAULT 'pending',
+   step VARCHAR(32),
+   attempts INTEGER NOT NULL DEFAULT 0,
+   result_file_id UUID,
+   error TEXT,
+   created_at TIMESTAMP NOT NULL DEFAULT now(),
+   edited_at TIMESTAMP NOT NULL DEFAULT now(),
+   finished_at TIMESTAMP
+);
+
+CREATE INDEX idx_user_data_jobs_user_id ON user_data_jobs(user_id, created_at);
+CREATE INDEX idx_user_data_jobs_unfinished ON user_data_jobs(edited_at) WHERE status IN ('pending', 'running');
+
+CREATE TRIGGER trg_edited_at_user_data_jobs
+    BEFORE UPDATE ON user_data_jobs
+    FOR EACH ROW
+    EXECUTE FUNCTION set_edited_at();
+
+COMMENT ON TABLE user_data_jobs IS 'Account deletions and personal data exports processed in the background';
+COMMENT ON COLUMN user_data_jobs.step IS 'Last finished step, the job resumes after it';
+COMMENT ON COLUMN user_data_jobs.result_file_id IS 'Archive of the export in file_service';
@@ -0,0 +1,11 @@
+DROP TRIGGER IF EXISTS trg_search_vector_tutor_profiles ON tutor_profiles;
+DROP FUNCTION IF EXISTS set_tutor_profile_search_vector();
+
+ALTER TABLE tutor_profiles
+    DROP COLUMN IF EXISTS bio,
+    DROP COLUMN IF EXISTS subjects,
+    DROP COLUMN IF EXISTS languages,
+    DROP COLUMN IF EXISTS grade_levels,
+    DROP COLUMN IF EXISTS avatar_file_id,
+    DROP COLUMN IF EXISTS is_public,
+    DROP COLUMN IF EXISTS search_vector;
\ No newline at end of file
@@ -0,0 +1,31 @@
+ALTER TABLE tutor_profiles
+    ADD COLUMN bio TEXT,
+    ADD COLUMN subjects TEXT[] NOT NULL DEFAULT '{}',
+    ADD COLUMN languages TEXT[] NOT NULL DEFAULT '{}',
+    ADD COLUMN grade_levels TEXT[] NOT NULL DEFAULT '{}',
+    ADD COLUMN avatar_file_id UUID,
+    ADD COLUMN is_public BOOLEAN NOT NULL DEFAULT false,
+    ADD COLUMN search_vector TSVECTOR NOT NULL DEFAULT ''::tsvector;
+
+CREATE OR REPLACE FUNCTION set_tutor_profile_search_vector()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.search_vector :=
+        setweight(to_tsvector('russian', array_to_string(NEW.subjects, ' ')), 'A') ||
+        setweight(to_tsvector('russian', coalesce(NEW.bio, '')), 'B');
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_search_vector_tutor_profiles
+    BEFORE INSERT OR UPDATE OF bio, subjects ON tutor_profiles
+    FOR EACH ROW
+    EXECUTE FUNCTION set_tutor_profile_search_vector();
+
+CREATE INDEX idx_tutor_profiles_search_vector ON tutor_profiles USING GIN (search_vector);
+CREATE INDEX idx_tutor_profiles_subjects ON tutor_profiles USING GIN (subjects);
+CREATE INDEX idx_tutor_profiles_public_price ON tutor_profiles(currency, lesson_price_minor) WHERE is_public;
+
+COMMENT ON COLUMN tutor_profiles.subjects IS 'Lower case, searched by exact match and in full text';
+COMMENT ON COLUMN tutor_profiles.avatar_file_id IS 'Image in file_service, registered as a usage of the profile';
+COMMENT ON COLUMN tutor_profiles.is_public IS 'Public profiles are found by SearchTutors';
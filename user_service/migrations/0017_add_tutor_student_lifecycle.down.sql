@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS tutor_student_status_events;
+
+ALTER TABLE tutor_students
+    DROP COLUMN IF EXISTS archived_at,
+    DROP COLUMN IF EXISTS paused_at,
+    DROP COLUMN IF EXISTS status_reason;
\ No newline at end of file
@@ -0,0 +1,21 @@
+ALTER TABLE tutor_students
+    ADD COLUMN status_reason TEXT,
+    ADD COLUMN paused_at TIMESTAMP,
+    ADD COLUMN archived_at TIMESTAMP;
+
+COMMENT ON COLUMN tutor_students.status IS 'invited / active / paused / archived, archived pairs keep their history read-only';
+COMMENT ON COLUMN tutor_students.status_reason IS 'Reason of the last status change';
+
+CREATE TABLE tutor_student_status_events (
+   id UUID PRIMARY KEY,
+   tutor_student_id UUID NOT NULL REFERENCES tutor_students(id) ON DELETE CASCADE,
+   from_status VARCHAR(16) NOT NULL,
+   to_status VARCHAR(16) NOT NULL,
+   reason TEXT,
+   changed_by UUID NOT NULL REFERENCES users(id),
+   created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_tutor_student_status_events_pair ON tutor_student_status_events(tutor_student_id, created_at);
+
+COMMENT ON TABLE tutor_student_status_events IS 'append only history of tutor_students status changes';
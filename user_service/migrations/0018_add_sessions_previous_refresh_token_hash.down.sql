@@ -0,0 +1 @@
+ALTER TABLE sessions DROP COLUMN IF EXISTS previous_refresh_token_hash;
\ No newline at end of file
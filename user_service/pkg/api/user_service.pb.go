@@ -23,15 +23,17 @@ const (
 )
 
 type RegisterViaTelegramRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TelegramId    int64                  `protobuf:"varint,1,opt,name=telegram_id,json=telegramId,proto3" json:"telegram_id,omitempty"`
-	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
-	Username      *string                `protobuf:"bytes,3,opt,name=username,proto3,oneof" json:"username,omitempty"`
-	FirstName     *string                `protobuf:"bytes,4,opt,name=first_name,json=firstName,proto3,oneof" json:"first_name,omitempty"`
-	LastName      *string                `protobuf:"bytes,5,opt,name=last_name,json=lastName,proto3,oneof" json:"last_name,omitempty"`
-	Timezone      *string                `protobuf:"bytes,6,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TelegramId int64                  `protobuf:"varint,1,opt,name=telegram_id,json=telegramId,proto3" json:"telegram_id,omitempty"`
+	Role       string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Username   *string                `protobuf:"bytes,3,opt,name=username,proto3,oneof" json:"username,omitempty"`
+	FirstName  *string                `protobuf:"bytes,4,opt,name=first_name,json=firstName,proto3,oneof" json:"first_name,omitempty"`
+	LastName   *string                `protobuf:"bytes,5,opt,name=last_name,json=lastName,proto3,oneof" json:"last_name,omitempty"`
+	Timezone   *string                `protobuf:"bytes,6,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"`
+	// telegram or tma header of the account, required for the admin role
+	AuthorizationHeader *string `protobuf:"bytes,7,opt,name=authorization_header,json=authorizationHeader,proto3,oneof" json:"authorization_header,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *RegisterViaTelegramRequest) Reset() {
@@ -106,6 +108,13 @@ func (x *RegisterViaTelegramRequest) GetTimezone() string {
 	return ""
 }
 
+func (x *RegisterViaTelegramRequest) GetAuthorizationHeader() string {
+	if x != nil && x.AuthorizationHeader != nil {
+		return *x.AuthorizationHeader
+	}
+	return ""
+}
+
 type AuthorizeByAuthHeaderRequest struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
 	AuthorizationHeader string                 `protobuf:"bytes,1,opt,name=authorization_header,json=authorizationHeader,proto3" json:"authorization_header,omitempty"`
@@ -150,27 +159,28 @@ func (x *AuthorizeByAuthHeaderRequest) GetAuthorizationHeader() string {
 	return ""
 }
 
-type GetUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// authorization_header is an initial telegram or tma header
+type CreateSessionRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	AuthorizationHeader string                 `protobuf:"bytes,1,opt,name=authorization_header,json=authorizationHeader,proto3" json:"authorization_header,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *GetUserRequest) Reset() {
-	*x = GetUserRequest{}
+func (x *CreateSessionRequest) Reset() {
+	*x = CreateSessionRequest{}
 	mi := &file_user_service_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserRequest) String() string {
+func (x *CreateSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserRequest) ProtoMessage() {}
+func (*CreateSessionRequest) ProtoMessage() {}
 
-func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateSessionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_service_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -182,42 +192,39 @@ func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
-func (*GetUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateSessionRequest.ProtoReflect.Descriptor instead.
+func (*CreateSessionRequest) Descriptor() ([]byte, []int) {
 	return file_user_service_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *GetUserRequest) GetId() string {
+func (x *CreateSessionRequest) GetAuthorizationHeader() string {
 	if x != nil {
-		return x.Id
+		return x.AuthorizationHeader
 	}
 	return ""
 }
 
-type UpdateUserRequest struct {
+type RefreshSessionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	FirstName     *string                `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3,oneof" json:"first_name,omitempty"`
-	LastName      *string                `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3,oneof" json:"last_name,omitempty"`
-	Timezone      *string                `protobuf:"bytes,4,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserRequest) Reset() {
-	*x = UpdateUserRequest{}
+func (x *RefreshSessionRequest) Reset() {
+	*x = RefreshSessionRequest{}
 	mi := &file_user_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserRequest) String() string {
+func (x *RefreshSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserRequest) ProtoMessage() {}
+func (*RefreshSessionRequest) ProtoMessage() {}
 
-func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
+func (x *RefreshSessionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -229,60 +236,39 @@ func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
-func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RefreshSessionRequest.ProtoReflect.Descriptor instead.
+func (*RefreshSessionRequest) Descriptor() ([]byte, []int) {
 	return file_user_service_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *UpdateUserRequest) GetId() string {
+func (x *RefreshSessionRequest) GetRefreshToken() string {
 	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *UpdateUserRequest) GetFirstName() string {
-	if x != nil && x.FirstName != nil {
-		return *x.FirstName
-	}
-	return ""
-}
-
-func (x *UpdateUserRequest) GetLastName() string {
-	if x != nil && x.LastName != nil {
-		return *x.LastName
-	}
-	return ""
-}
-
-func (x *UpdateUserRequest) GetTimezone() string {
-	if x != nil && x.Timezone != nil {
-		return *x.Timezone
+		return x.RefreshToken
 	}
 	return ""
 }
 
-type GetTutorProfileByUserIdRequest struct {
+type RevokeSessionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTutorProfileByUserIdRequest) Reset() {
-	*x = GetTutorProfileByUserIdRequest{}
+func (x *RevokeSessionRequest) Reset() {
+	*x = RevokeSessionRequest{}
 	mi := &file_user_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTutorProfileByUserIdRequest) String() string {
+func (x *RevokeSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTutorProfileByUserIdRequest) ProtoMessage() {}
+func (*RevokeSessionRequest) ProtoMessage() {}
 
-func (x *GetTutorProfileByUserIdRequest) ProtoReflect() protoreflect.Message {
+func (x *RevokeSessionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -294,42 +280,39 @@ func (x *GetTutorProfileByUserIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTutorProfileByUserIdRequest.ProtoReflect.Descriptor instead.
-func (*GetTutorProfileByUserIdRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RevokeSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSessionRequest) Descriptor() ([]byte, []int) {
 	return file_user_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetTutorProfileByUserIdRequest) GetUserId() string {
+func (x *RevokeSessionRequest) GetRefreshToken() string {
 	if x != nil {
-		return x.UserId
+		return x.RefreshToken
 	}
 	return ""
 }
 
-type UpdateTutorProfileRequest struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	UserId               string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PaymentInfo          *string                `protobuf:"bytes,2,opt,name=payment_info,json=paymentInfo,proto3,oneof" json:"payment_info,omitempty"`
-	LessonPriceRub       *int32                 `protobuf:"varint,3,opt,name=lesson_price_rub,json=lessonPriceRub,proto3,oneof" json:"lesson_price_rub,omitempty"`
-	LessonConnectionLink *string                `protobuf:"bytes,4,opt,name=lesson_connection_link,json=lessonConnectionLink,proto3,oneof" json:"lesson_connection_link,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type CheckSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTutorProfileRequest) Reset() {
-	*x = UpdateTutorProfileRequest{}
+func (x *CheckSessionRequest) Reset() {
+	*x = CheckSessionRequest{}
 	mi := &file_user_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTutorProfileRequest) String() string {
+func (x *CheckSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTutorProfileRequest) ProtoMessage() {}
+func (*CheckSessionRequest) ProtoMessage() {}
 
-func (x *UpdateTutorProfileRequest) ProtoReflect() protoreflect.Message {
+func (x *CheckSessionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -341,61 +324,39 @@ func (x *UpdateTutorProfileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTutorProfileRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTutorProfileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckSessionRequest.ProtoReflect.Descriptor instead.
+func (*CheckSessionRequest) Descriptor() ([]byte, []int) {
 	return file_user_service_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *UpdateTutorProfileRequest) GetUserId() string {
+func (x *CheckSessionRequest) GetSessionId() string {
 	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *UpdateTutorProfileRequest) GetPaymentInfo() string {
-	if x != nil && x.PaymentInfo != nil {
-		return *x.PaymentInfo
-	}
-	return ""
-}
-
-func (x *UpdateTutorProfileRequest) GetLessonPriceRub() int32 {
-	if x != nil && x.LessonPriceRub != nil {
-		return *x.LessonPriceRub
-	}
-	return 0
-}
-
-func (x *UpdateTutorProfileRequest) GetLessonConnectionLink() string {
-	if x != nil && x.LessonConnectionLink != nil {
-		return *x.LessonConnectionLink
+		return x.SessionId
 	}
 	return ""
 }
 
-type GetTutorStudentRequest struct {
+type CheckSessionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TutorId       string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
-	StudentId     string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Active        bool                   `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTutorStudentRequest) Reset() {
-	*x = GetTutorStudentRequest{}
+func (x *CheckSessionResponse) Reset() {
+	*x = CheckSessionResponse{}
 	mi := &file_user_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTutorStudentRequest) String() string {
+func (x *CheckSessionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTutorStudentRequest) ProtoMessage() {}
+func (*CheckSessionResponse) ProtoMessage() {}
 
-func (x *GetTutorStudentRequest) ProtoReflect() protoreflect.Message {
+func (x *CheckSessionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_user_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -407,49 +368,41 @@ func (x *GetTutorStudentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTutorStudentRequest.ProtoReflect.Descriptor instead.
-func (*GetTutorStudentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckSessionResponse.ProtoReflect.Descriptor instead.
+func (*CheckSessionResponse) Descriptor() ([]byte, []int) {
 	return file_user_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetTutorStudentRequest) GetTutorId() string {
-	if x != nil {
-		return x.TutorId
-	}
-	return ""
-}
-
-func (x *GetTutorStudentRequest) GetStudentId() string {
+func (x *CheckSessionResponse) GetActive() bool {
 	if x != nil {
-		return x.StudentId
+		return x.Active
 	}
-	return ""
+	return false
 }
 
-type CreateTutorStudentRequest struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	TutorId              string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
-	StudentId            string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
-	LessonPriceRub       *int32                 `protobuf:"varint,3,opt,name=lesson_price_rub,json=lessonPriceRub,proto3,oneof" json:"lesson_price_rub,omitempty"`
-	LessonConnectionLink *string                `protobuf:"bytes,4,opt,name=lesson_connection_link,json=lessonConnectionLink,proto3,oneof" json:"lesson_connection_link,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+// role registers a new user if nobody has the email yet
+type RequestEmailLoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Role          *string                `protobuf:"bytes,2,opt,name=role,proto3,oneof" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTutorStudentRequest) Reset() {
-	*x = CreateTutorStudentRequest{}
+func (x *RequestEmailLoginRequest) Reset() {
+	*x = RequestEmailLoginRequest{}
 	mi := &file_user_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTutorStudentRequest) String() string {
+func (x *RequestEmailLoginRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTutorStudentRequest) ProtoMessage() {}
+func (*RequestEmailLoginRequest) ProtoMessage() {}
 
-func (x *CreateTutorStudentRequest) ProtoReflect() protoreflect.Message {
+func (x *RequestEmailLoginRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -461,64 +414,46 @@ func (x *CreateTutorStudentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTutorStudentRequest.ProtoReflect.Descriptor instead.
-func (*CreateTutorStudentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestEmailLoginRequest.ProtoReflect.Descriptor instead.
+func (*RequestEmailLoginRequest) Descriptor() ([]byte, []int) {
 	return file_user_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *CreateTutorStudentRequest) GetTutorId() string {
-	if x != nil {
-		return x.TutorId
-	}
-	return ""
-}
-
-func (x *CreateTutorStudentRequest) GetStudentId() string {
+func (x *RequestEmailLoginRequest) GetEmail() string {
 	if x != nil {
-		return x.StudentId
+		return x.Email
 	}
 	return ""
 }
 
-func (x *CreateTutorStudentRequest) GetLessonPriceRub() int32 {
-	if x != nil && x.LessonPriceRub != nil {
-		return *x.LessonPriceRub
-	}
-	return 0
-}
-
-func (x *CreateTutorStudentRequest) GetLessonConnectionLink() string {
-	if x != nil && x.LessonConnectionLink != nil {
-		return *x.LessonConnectionLink
+func (x *RequestEmailLoginRequest) GetRole() string {
+	if x != nil && x.Role != nil {
+		return *x.Role
 	}
 	return ""
 }
 
-type UpdateTutorStudentRequest struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	TutorId              string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
-	StudentId            string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
-	LessonPriceRub       *int32                 `protobuf:"varint,3,opt,name=lesson_price_rub,json=lessonPriceRub,proto3,oneof" json:"lesson_price_rub,omitempty"`
-	LessonConnectionLink *string                `protobuf:"bytes,4,opt,name=lesson_connection_link,json=lessonConnectionLink,proto3,oneof" json:"lesson_connection_link,omitempty"`
-	Status               *string                `protobuf:"bytes,5,opt,name=status,proto3,oneof" json:"status,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type VerifyEmailLoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTutorStudentRequest) Reset() {
-	*x = UpdateTutorStudentRequest{}
+func (x *VerifyEmailLoginRequest) Reset() {
+	*x = VerifyEmailLoginRequest{}
 	mi := &file_user_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTutorStudentRequest) String() string {
+func (x *VerifyEmailLoginRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTutorStudentRequest) ProtoMessage() {}
+func (*VerifyEmailLoginRequest) ProtoMessage() {}
 
-func (x *UpdateTutorStudentRequest) ProtoReflect() protoreflect.Message {
+func (x *VerifyEmailLoginRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -530,68 +465,40 @@ func (x *UpdateTutorStudentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTutorStudentRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTutorStudentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use VerifyEmailLoginRequest.ProtoReflect.Descriptor instead.
+func (*VerifyEmailLoginRequest) Descriptor() ([]byte, []int) {
 	return file_user_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *UpdateTutorStudentRequest) GetTutorId() string {
-	if x != nil {
-		return x.TutorId
-	}
-	return ""
-}
-
-func (x *UpdateTutorStudentRequest) GetStudentId() string {
+func (x *VerifyEmailLoginRequest) GetToken() string {
 	if x != nil {
-		return x.StudentId
+		return x.Token
 	}
 	return ""
 }
 
-func (x *UpdateTutorStudentRequest) GetLessonPriceRub() int32 {
-	if x != nil && x.LessonPriceRub != nil {
-		return *x.LessonPriceRub
-	}
-	return 0
-}
-
-func (x *UpdateTutorStudentRequest) GetLessonConnectionLink() string {
-	if x != nil && x.LessonConnectionLink != nil {
-		return *x.LessonConnectionLink
-	}
-	return ""
-}
-
-func (x *UpdateTutorStudentRequest) GetStatus() string {
-	if x != nil && x.Status != nil {
-		return *x.Status
-	}
-	return ""
-}
-
-type DeleteTutorStudentRequest struct {
+// role registers a new user on the first sign in
+type StartOidcLoginRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TutorId       string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
-	StudentId     string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Role          *string                `protobuf:"bytes,1,opt,name=role,proto3,oneof" json:"role,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTutorStudentRequest) Reset() {
-	*x = DeleteTutorStudentRequest{}
+func (x *StartOidcLoginRequest) Reset() {
+	*x = StartOidcLoginRequest{}
 	mi := &file_user_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTutorStudentRequest) String() string {
+func (x *StartOidcLoginRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTutorStudentRequest) ProtoMessage() {}
+func (*StartOidcLoginRequest) ProtoMessage() {}
 
-func (x *DeleteTutorStudentRequest) ProtoReflect() protoreflect.Message {
+func (x *StartOidcLoginRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -603,46 +510,40 @@ func (x *DeleteTutorStudentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTutorStudentRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTutorStudentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StartOidcLoginRequest.ProtoReflect.Descriptor instead.
+func (*StartOidcLoginRequest) Descriptor() ([]byte, []int) {
 	return file_user_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *DeleteTutorStudentRequest) GetTutorId() string {
-	if x != nil {
-		return x.TutorId
-	}
-	return ""
-}
-
-func (x *DeleteTutorStudentRequest) GetStudentId() string {
-	if x != nil {
-		return x.StudentId
+func (x *StartOidcLoginRequest) GetRole() string {
+	if x != nil && x.Role != nil {
+		return *x.Role
 	}
 	return ""
 }
 
-type ListTutorStudentsRequest struct {
+type CompleteOidcLoginRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TutorId       string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	State         string                 `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTutorStudentsRequest) Reset() {
-	*x = ListTutorStudentsRequest{}
+func (x *CompleteOidcLoginRequest) Reset() {
+	*x = CompleteOidcLoginRequest{}
 	mi := &file_user_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTutorStudentsRequest) String() string {
+func (x *CompleteOidcLoginRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTutorStudentsRequest) ProtoMessage() {}
+func (*CompleteOidcLoginRequest) ProtoMessage() {}
 
-func (x *ListTutorStudentsRequest) ProtoReflect() protoreflect.Message {
+func (x *CompleteOidcLoginRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -654,39 +555,46 @@ func (x *ListTutorStudentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTutorStudentsRequest.ProtoReflect.Descriptor instead.
-func (*ListTutorStudentsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CompleteOidcLoginRequest.ProtoReflect.Descriptor instead.
+func (*CompleteOidcLoginRequest) Descriptor() ([]byte, []int) {
 	return file_user_service_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ListTutorStudentsRequest) GetTutorId() string {
+func (x *CompleteOidcLoginRequest) GetState() string {
 	if x != nil {
-		return x.TutorId
+		return x.State
 	}
 	return ""
 }
 
-type ListTutorStudentsResponse struct {
+func (x *CompleteOidcLoginRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type RequestEmailLinkRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Students      []*TutorStudent        `protobuf:"bytes,1,rep,name=students,proto3" json:"students,omitempty"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTutorStudentsResponse) Reset() {
-	*x = ListTutorStudentsResponse{}
+func (x *RequestEmailLinkRequest) Reset() {
+	*x = RequestEmailLinkRequest{}
 	mi := &file_user_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTutorStudentsResponse) String() string {
+func (x *RequestEmailLinkRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTutorStudentsResponse) ProtoMessage() {}
+func (*RequestEmailLinkRequest) ProtoMessage() {}
 
-func (x *ListTutorStudentsResponse) ProtoReflect() protoreflect.Message {
+func (x *RequestEmailLinkRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -698,39 +606,39 @@ func (x *ListTutorStudentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTutorStudentsResponse.ProtoReflect.Descriptor instead.
-func (*ListTutorStudentsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestEmailLinkRequest.ProtoReflect.Descriptor instead.
+func (*RequestEmailLinkRequest) Descriptor() ([]byte, []int) {
 	return file_user_service_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ListTutorStudentsResponse) GetStudents() []*TutorStudent {
+func (x *RequestEmailLinkRequest) GetEmail() string {
 	if x != nil {
-		return x.Students
+		return x.Email
 	}
-	return nil
+	return ""
 }
 
-type ListTutorsForStudentRequest struct {
+type ListIdentitiesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StudentId     string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Identities    []*Identity            `protobuf:"bytes,1,rep,name=identities,proto3" json:"identities,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTutorsForStudentRequest) Reset() {
-	*x = ListTutorsForStudentRequest{}
+func (x *ListIdentitiesResponse) Reset() {
+	*x = ListIdentitiesResponse{}
 	mi := &file_user_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTutorsForStudentRequest) String() string {
+func (x *ListIdentitiesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTutorsForStudentRequest) ProtoMessage() {}
+func (*ListIdentitiesResponse) ProtoMessage() {}
 
-func (x *ListTutorsForStudentRequest) ProtoReflect() protoreflect.Message {
+func (x *ListIdentitiesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_user_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -742,39 +650,39 @@ func (x *ListTutorsForStudentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTutorsForStudentRequest.ProtoReflect.Descriptor instead.
-func (*ListTutorsForStudentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListIdentitiesResponse.ProtoReflect.Descriptor instead.
+func (*ListIdentitiesResponse) Descriptor() ([]byte, []int) {
 	return file_user_service_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ListTutorsForStudentRequest) GetStudentId() string {
+func (x *ListIdentitiesResponse) GetIdentities() []*Identity {
 	if x != nil {
-		return x.StudentId
+		return x.Identities
 	}
-	return ""
+	return nil
 }
 
-type ListTutorsForStudentResponse struct {
+type DeleteIdentityRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tutors        []*TutorStudent        `protobuf:"bytes,1,rep,name=tutors,proto3" json:"tutors,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTutorsForStudentResponse) Reset() {
-	*x = ListTutorsForStudentResponse{}
+func (x *DeleteIdentityRequest) Reset() {
+	*x = DeleteIdentityRequest{}
 	mi := &file_user_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTutorsForStudentResponse) String() string {
+func (x *DeleteIdentityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTutorsForStudentResponse) ProtoMessage() {}
+func (*DeleteIdentityRequest) ProtoMessage() {}
 
-func (x *ListTutorsForStudentResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteIdentityRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -786,40 +694,39 @@ func (x *ListTutorsForStudentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTutorsForStudentResponse.ProtoReflect.Descriptor instead.
-func (*ListTutorsForStudentResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteIdentityRequest.ProtoReflect.Descriptor instead.
+func (*DeleteIdentityRequest) Descriptor() ([]byte, []int) {
 	return file_user_service_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ListTutorsForStudentResponse) GetTutors() []*TutorStudent {
+func (x *DeleteIdentityRequest) GetId() string {
 	if x != nil {
-		return x.Tutors
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type ResolveTutorStudentContextRequest struct {
+type GetUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TutorId       string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
-	StudentId     string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResolveTutorStudentContextRequest) Reset() {
-	*x = ResolveTutorStudentContextRequest{}
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
 	mi := &file_user_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResolveTutorStudentContextRequest) String() string {
+func (x *GetUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResolveTutorStudentContextRequest) ProtoMessage() {}
+func (*GetUserRequest) ProtoMessage() {}
 
-func (x *ResolveTutorStudentContextRequest) ProtoReflect() protoreflect.Message {
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -831,49 +738,42 @@ func (x *ResolveTutorStudentContextRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResolveTutorStudentContextRequest.ProtoReflect.Descriptor instead.
-func (*ResolveTutorStudentContextRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
 	return file_user_service_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ResolveTutorStudentContextRequest) GetTutorId() string {
-	if x != nil {
-		return x.TutorId
-	}
-	return ""
-}
-
-func (x *ResolveTutorStudentContextRequest) GetStudentId() string {
+func (x *GetUserRequest) GetId() string {
 	if x != nil {
-		return x.StudentId
+		return x.Id
 	}
 	return ""
 }
 
-type ResolvedTutorStudentContext struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	RelationshipStatus   string                 `protobuf:"bytes,2,opt,name=relationship_status,json=relationshipStatus,proto3" json:"relationship_status,omitempty"` // invited / active / blocked / removed
-	LessonPriceRub       *int32                 `protobuf:"varint,3,opt,name=lesson_price_rub,json=lessonPriceRub,proto3,oneof" json:"lesson_price_rub,omitempty"`
-	LessonConnectionLink *string                `protobuf:"bytes,4,opt,name=lesson_connection_link,json=lessonConnectionLink,proto3,oneof" json:"lesson_connection_link,omitempty"`
-	PaymentInfo          *string                `protobuf:"bytes,5,opt,name=payment_info,json=paymentInfo,proto3,oneof" json:"payment_info,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type UpdateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FirstName     *string                `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3,oneof" json:"first_name,omitempty"`
+	LastName      *string                `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3,oneof" json:"last_name,omitempty"`
+	Timezone      *string                `protobuf:"bytes,4,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResolvedTutorStudentContext) Reset() {
-	*x = ResolvedTutorStudentContext{}
+func (x *UpdateUserRequest) Reset() {
+	*x = UpdateUserRequest{}
 	mi := &file_user_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResolvedTutorStudentContext) String() string {
+func (x *UpdateUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResolvedTutorStudentContext) ProtoMessage() {}
+func (*UpdateUserRequest) ProtoMessage() {}
 
-func (x *ResolvedTutorStudentContext) ProtoReflect() protoreflect.Message {
+func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -885,60 +785,60 @@ func (x *ResolvedTutorStudentContext) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResolvedTutorStudentContext.ProtoReflect.Descriptor instead.
-func (*ResolvedTutorStudentContext) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
 	return file_user_service_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ResolvedTutorStudentContext) GetRelationshipStatus() string {
+func (x *UpdateUserRequest) GetId() string {
 	if x != nil {
-		return x.RelationshipStatus
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ResolvedTutorStudentContext) GetLessonPriceRub() int32 {
-	if x != nil && x.LessonPriceRub != nil {
-		return *x.LessonPriceRub
+func (x *UpdateUserRequest) GetFirstName() string {
+	if x != nil && x.FirstName != nil {
+		return *x.FirstName
 	}
-	return 0
+	return ""
 }
 
-func (x *ResolvedTutorStudentContext) GetLessonConnectionLink() string {
-	if x != nil && x.LessonConnectionLink != nil {
-		return *x.LessonConnectionLink
+func (x *UpdateUserRequest) GetLastName() string {
+	if x != nil && x.LastName != nil {
+		return *x.LastName
 	}
 	return ""
 }
 
-func (x *ResolvedTutorStudentContext) GetPaymentInfo() string {
-	if x != nil && x.PaymentInfo != nil {
-		return *x.PaymentInfo
+func (x *UpdateUserRequest) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
 	}
 	return ""
 }
 
-type AcceptInvitationFromTutorRequest struct {
+type GetTutorProfileByUserIdRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TutorId       string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AcceptInvitationFromTutorRequest) Reset() {
-	*x = AcceptInvitationFromTutorRequest{}
+func (x *GetTutorProfileByUserIdRequest) Reset() {
+	*x = GetTutorProfileByUserIdRequest{}
 	mi := &file_user_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AcceptInvitationFromTutorRequest) String() string {
+func (x *GetTutorProfileByUserIdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AcceptInvitationFromTutorRequest) ProtoMessage() {}
+func (*GetTutorProfileByUserIdRequest) ProtoMessage() {}
 
-func (x *AcceptInvitationFromTutorRequest) ProtoReflect() protoreflect.Message {
+func (x *GetTutorProfileByUserIdRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -950,38 +850,39 @@ func (x *AcceptInvitationFromTutorRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AcceptInvitationFromTutorRequest.ProtoReflect.Descriptor instead.
-func (*AcceptInvitationFromTutorRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTutorProfileByUserIdRequest.ProtoReflect.Descriptor instead.
+func (*GetTutorProfileByUserIdRequest) Descriptor() ([]byte, []int) {
 	return file_user_service_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *AcceptInvitationFromTutorRequest) GetTutorId() string {
+func (x *GetTutorProfileByUserIdRequest) GetUserId() string {
 	if x != nil {
-		return x.TutorId
+		return x.UserId
 	}
 	return ""
 }
 
-type Empty struct {
+type GetTutorPublicProfileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Empty) Reset() {
-	*x = Empty{}
+func (x *GetTutorPublicProfileRequest) Reset() {
+	*x = GetTutorPublicProfileRequest{}
 	mi := &file_user_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Empty) String() string {
+func (x *GetTutorPublicProfileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Empty) ProtoMessage() {}
+func (*GetTutorPublicProfileRequest) ProtoMessage() {}
 
-func (x *Empty) ProtoReflect() protoreflect.Message {
+func (x *GetTutorPublicProfileRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -993,41 +894,3326 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
-func (*Empty) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTutorPublicProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetTutorPublicProfileRequest) Descriptor() ([]byte, []int) {
 	return file_user_service_proto_rawDescGZIP(), []int{17}
 }
 
-type User struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"` // tutor / student
-	AuthProvider  string                 `protobuf:"bytes,3,opt,name=auth_provider,json=authProvider,proto3" json:"auth_provider,omitempty"`
-	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // active / blocked / deleted
-	FirstName     *string                `protobuf:"bytes,5,opt,name=first_name,json=firstName,proto3,oneof" json:"first_name,omitempty"`
-	LastName      *string                `protobuf:"bytes,6,opt,name=last_name,json=lastName,proto3,oneof" json:"last_name,omitempty"`
-	Timezone      *string                `protobuf:"bytes,7,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	EditedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetTutorPublicProfileRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// query is searched in subjects, bio and names of public tutors, filters match exactly
+type SearchTutorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         *string                `protobuf:"bytes,1,opt,name=query,proto3,oneof" json:"query,omitempty"`
+	Subject       *string                `protobuf:"bytes,2,opt,name=subject,proto3,oneof" json:"subject,omitempty"`
+	Language      *string                `protobuf:"bytes,3,opt,name=language,proto3,oneof" json:"language,omitempty"`
+	GradeLevel    *string                `protobuf:"bytes,4,opt,name=grade_level,json=gradeLevel,proto3,oneof" json:"grade_level,omitempty"`
+	MinPrice      *Money                 `protobuf:"bytes,5,opt,name=min_price,json=minPrice,proto3,oneof" json:"min_price,omitempty"` // min_price and max_price must be in the same currency
+	MaxPrice      *Money                 `protobuf:"bytes,6,opt,name=max_price,json=maxPrice,proto3,oneof" json:"max_price,omitempty"`
+	Limit         int32                  `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,8,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchTutorsRequest) Reset() {
+	*x = SearchTutorsRequest{}
+	mi := &file_user_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchTutorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchTutorsRequest) ProtoMessage() {}
+
+func (x *SearchTutorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchTutorsRequest.ProtoReflect.Descriptor instead.
+func (*SearchTutorsRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SearchTutorsRequest) GetQuery() string {
+	if x != nil && x.Query != nil {
+		return *x.Query
+	}
+	return ""
+}
+
+func (x *SearchTutorsRequest) GetSubject() string {
+	if x != nil && x.Subject != nil {
+		return *x.Subject
+	}
+	return ""
+}
+
+func (x *SearchTutorsRequest) GetLanguage() string {
+	if x != nil && x.Language != nil {
+		return *x.Language
+	}
+	return ""
+}
+
+func (x *SearchTutorsRequest) GetGradeLevel() string {
+	if x != nil && x.GradeLevel != nil {
+		return *x.GradeLevel
+	}
+	return ""
+}
+
+func (x *SearchTutorsRequest) GetMinPrice() *Money {
+	if x != nil {
+		return x.MinPrice
+	}
+	return nil
+}
+
+func (x *SearchTutorsRequest) GetMaxPrice() *Money {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return nil
+}
+
+func (x *SearchTutorsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchTutorsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type SearchTutorsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tutors        []*TutorPublicProfile  `protobuf:"bytes,1,rep,name=tutors,proto3" json:"tutors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchTutorsResponse) Reset() {
+	*x = SearchTutorsResponse{}
+	mi := &file_user_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchTutorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchTutorsResponse) ProtoMessage() {}
+
+func (x *SearchTutorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchTutorsResponse.ProtoReflect.Descriptor instead.
+func (*SearchTutorsResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SearchTutorsResponse) GetTutors() []*TutorPublicProfile {
+	if x != nil {
+		return x.Tutors
+	}
+	return nil
+}
+
+// StringList distinguishes an empty list from an unset one in updates
+type StringList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []string               `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StringList) Reset() {
+	*x = StringList{}
+	mi := &file_user_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StringList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StringList) ProtoMessage() {}
+
+func (x *StringList) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StringList.ProtoReflect.Descriptor instead.
+func (*StringList) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *StringList) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type UpdateTutorProfileRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	UserId               string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PaymentInfo          *string                `protobuf:"bytes,2,opt,name=payment_info,json=paymentInfo,proto3,oneof" json:"payment_info,omitempty"`
+	LessonConnectionLink *string                `protobuf:"bytes,4,opt,name=lesson_connection_link,json=lessonConnectionLink,proto3,oneof" json:"lesson_connection_link,omitempty"`
+	LessonPrice          *Money                 `protobuf:"bytes,5,opt,name=lesson_price,json=lessonPrice,proto3,oneof" json:"lesson_price,omitempty"` // also sets the currency of the tutor
+	Bio                  *string                `protobuf:"bytes,6,opt,name=bio,proto3,oneof" json:"bio,omitempty"`
+	Subjects             *StringList            `protobuf:"bytes,7,opt,name=subjects,proto3,oneof" json:"subjects,omitempty"`
+	Languages            *StringList            `protobuf:"bytes,8,opt,name=languages,proto3,oneof" json:"languages,omitempty"`
+	GradeLevels          *StringList            `protobuf:"bytes,9,opt,name=grade_levels,json=gradeLevels,proto3,oneof" json:"grade_levels,omitempty"`
+	AvatarFileId         *string                `protobuf:"bytes,10,opt,name=avatar_file_id,json=avatarFileId,proto3,oneof" json:"avatar_file_id,omitempty"` // image uploaded by the tutor to file_service
+	RemoveAvatar         bool                   `protobuf:"varint,11,opt,name=remove_avatar,json=removeAvatar,proto3" json:"remove_avatar,omitempty"`
+	IsPublic             *bool                  `protobuf:"varint,12,opt,name=is_public,json=isPublic,proto3,oneof" json:"is_public,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UpdateTutorProfileRequest) Reset() {
+	*x = UpdateTutorProfileRequest{}
+	mi := &file_user_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTutorProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTutorProfileRequest) ProtoMessage() {}
+
+func (x *UpdateTutorProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTutorProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTutorProfileRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpdateTutorProfileRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateTutorProfileRequest) GetPaymentInfo() string {
+	if x != nil && x.PaymentInfo != nil {
+		return *x.PaymentInfo
+	}
+	return ""
+}
+
+func (x *UpdateTutorProfileRequest) GetLessonConnectionLink() string {
+	if x != nil && x.LessonConnectionLink != nil {
+		return *x.LessonConnectionLink
+	}
+	return ""
+}
+
+func (x *UpdateTutorProfileRequest) GetLessonPrice() *Money {
+	if x != nil {
+		return x.LessonPrice
+	}
+	return nil
+}
+
+func (x *UpdateTutorProfileRequest) GetBio() string {
+	if x != nil && x.Bio != nil {
+		return *x.Bio
+	}
+	return ""
+}
+
+func (x *UpdateTutorProfileRequest) GetSubjects() *StringList {
+	if x != nil {
+		return x.Subjects
+	}
+	return nil
+}
+
+func (x *UpdateTutorProfileRequest) GetLanguages() *StringList {
+	if x != nil {
+		return x.Languages
+	}
+	return nil
+}
+
+func (x *UpdateTutorProfileRequest) GetGradeLevels() *StringList {
+	if x != nil {
+		return x.GradeLevels
+	}
+	return nil
+}
+
+func (x *UpdateTutorProfileRequest) GetAvatarFileId() string {
+	if x != nil && x.AvatarFileId != nil {
+		return *x.AvatarFileId
+	}
+	return ""
+}
+
+func (x *UpdateTutorProfileRequest) GetRemoveAvatar() bool {
+	if x != nil {
+		return x.RemoveAvatar
+	}
+	return false
+}
+
+func (x *UpdateTutorProfileRequest) GetIsPublic() bool {
+	if x != nil && x.IsPublic != nil {
+		return *x.IsPublic
+	}
+	return false
+}
+
+type GetTutorStudentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TutorId       string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTutorStudentRequest) Reset() {
+	*x = GetTutorStudentRequest{}
+	mi := &file_user_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTutorStudentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTutorStudentRequest) ProtoMessage() {}
+
+func (x *GetTutorStudentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTutorStudentRequest.ProtoReflect.Descriptor instead.
+func (*GetTutorStudentRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetTutorStudentRequest) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+func (x *GetTutorStudentRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+type CreateTutorStudentRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	TutorId              string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	StudentId            string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	LessonConnectionLink *string                `protobuf:"bytes,4,opt,name=lesson_connection_link,json=lessonConnectionLink,proto3,oneof" json:"lesson_connection_link,omitempty"`
+	LessonPrice          *Money                 `protobuf:"bytes,5,opt,name=lesson_price,json=lessonPrice,proto3,oneof" json:"lesson_price,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CreateTutorStudentRequest) Reset() {
+	*x = CreateTutorStudentRequest{}
+	mi := &file_user_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTutorStudentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTutorStudentRequest) ProtoMessage() {}
+
+func (x *CreateTutorStudentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTutorStudentRequest.ProtoReflect.Descriptor instead.
+func (*CreateTutorStudentRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateTutorStudentRequest) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+func (x *CreateTutorStudentRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *CreateTutorStudentRequest) GetLessonConnectionLink() string {
+	if x != nil && x.LessonConnectionLink != nil {
+		return *x.LessonConnectionLink
+	}
+	return ""
+}
+
+func (x *CreateTutorStudentRequest) GetLessonPrice() *Money {
+	if x != nil {
+		return x.LessonPrice
+	}
+	return nil
+}
+
+type UpdateTutorStudentRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	TutorId              string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	StudentId            string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	LessonConnectionLink *string                `protobuf:"bytes,4,opt,name=lesson_connection_link,json=lessonConnectionLink,proto3,oneof" json:"lesson_connection_link,omitempty"`
+	Status               *string                `protobuf:"bytes,5,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	LessonPrice          *Money                 `protobuf:"bytes,6,opt,name=lesson_price,json=lessonPrice,proto3,oneof" json:"lesson_price,omitempty"`
+	StatusReason         *string                `protobuf:"bytes,7,opt,name=status_reason,json=statusReason,proto3,oneof" json:"status_reason,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UpdateTutorStudentRequest) Reset() {
+	*x = UpdateTutorStudentRequest{}
+	mi := &file_user_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTutorStudentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTutorStudentRequest) ProtoMessage() {}
+
+func (x *UpdateTutorStudentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTutorStudentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTutorStudentRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdateTutorStudentRequest) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+func (x *UpdateTutorStudentRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *UpdateTutorStudentRequest) GetLessonConnectionLink() string {
+	if x != nil && x.LessonConnectionLink != nil {
+		return *x.LessonConnectionLink
+	}
+	return ""
+}
+
+func (x *UpdateTutorStudentRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *UpdateTutorStudentRequest) GetLessonPrice() *Money {
+	if x != nil {
+		return x.LessonPrice
+	}
+	return nil
+}
+
+func (x *UpdateTutorStudentRequest) GetStatusReason() string {
+	if x != nil && x.StatusReason != nil {
+		return *x.StatusReason
+	}
+	return ""
+}
+
+type DeleteTutorStudentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TutorId       string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTutorStudentRequest) Reset() {
+	*x = DeleteTutorStudentRequest{}
+	mi := &file_user_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTutorStudentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTutorStudentRequest) ProtoMessage() {}
+
+func (x *DeleteTutorStudentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTutorStudentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTutorStudentRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeleteTutorStudentRequest) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+func (x *DeleteTutorStudentRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+type ChangeTutorStudentStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TutorId       string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // active / paused / archived, invited to restore an archived pair
+	Reason        *string                `protobuf:"bytes,4,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeTutorStudentStatusRequest) Reset() {
+	*x = ChangeTutorStudentStatusRequest{}
+	mi := &file_user_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeTutorStudentStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeTutorStudentStatusRequest) ProtoMessage() {}
+
+func (x *ChangeTutorStudentStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeTutorStudentStatusRequest.ProtoReflect.Descriptor instead.
+func (*ChangeTutorStudentStatusRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ChangeTutorStudentStatusRequest) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+func (x *ChangeTutorStudentStatusRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *ChangeTutorStudentStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ChangeTutorStudentStatusRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+type ListTutorStudentStatusEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TutorId       string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTutorStudentStatusEventsRequest) Reset() {
+	*x = ListTutorStudentStatusEventsRequest{}
+	mi := &file_user_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTutorStudentStatusEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTutorStudentStatusEventsRequest) ProtoMessage() {}
+
+func (x *ListTutorStudentStatusEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTutorStudentStatusEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListTutorStudentStatusEventsRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListTutorStudentStatusEventsRequest) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+func (x *ListTutorStudentStatusEventsRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+type ListTutorStudentStatusEventsResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Events        []*TutorStudentStatusEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTutorStudentStatusEventsResponse) Reset() {
+	*x = ListTutorStudentStatusEventsResponse{}
+	mi := &file_user_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTutorStudentStatusEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTutorStudentStatusEventsResponse) ProtoMessage() {}
+
+func (x *ListTutorStudentStatusEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTutorStudentStatusEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListTutorStudentStatusEventsResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListTutorStudentStatusEventsResponse) GetEvents() []*TutorStudentStatusEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type ListTutorStudentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TutorId       string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTutorStudentsRequest) Reset() {
+	*x = ListTutorStudentsRequest{}
+	mi := &file_user_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTutorStudentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTutorStudentsRequest) ProtoMessage() {}
+
+func (x *ListTutorStudentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTutorStudentsRequest.ProtoReflect.Descriptor instead.
+func (*ListTutorStudentsRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListTutorStudentsRequest) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+type ListTutorStudentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Students      []*TutorStudent        `protobuf:"bytes,1,rep,name=students,proto3" json:"students,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTutorStudentsResponse) Reset() {
+	*x = ListTutorStudentsResponse{}
+	mi := &file_user_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTutorStudentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTutorStudentsResponse) ProtoMessage() {}
+
+func (x *ListTutorStudentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTutorStudentsResponse.ProtoReflect.Descriptor instead.
+func (*ListTutorStudentsResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListTutorStudentsResponse) GetStudents() []*TutorStudent {
+	if x != nil {
+		return x.Students
+	}
+	return nil
+}
+
+type ListTutorsForStudentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTutorsForStudentRequest) Reset() {
+	*x = ListTutorsForStudentRequest{}
+	mi := &file_user_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTutorsForStudentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTutorsForStudentRequest) ProtoMessage() {}
+
+func (x *ListTutorsForStudentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTutorsForStudentRequest.ProtoReflect.Descriptor instead.
+func (*ListTutorsForStudentRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListTutorsForStudentRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+type ListTutorsForStudentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tutors        []*TutorStudent        `protobuf:"bytes,1,rep,name=tutors,proto3" json:"tutors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTutorsForStudentResponse) Reset() {
+	*x = ListTutorsForStudentResponse{}
+	mi := &file_user_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTutorsForStudentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTutorsForStudentResponse) ProtoMessage() {}
+
+func (x *ListTutorsForStudentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTutorsForStudentResponse.ProtoReflect.Descriptor instead.
+func (*ListTutorsForStudentResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListTutorsForStudentResponse) GetTutors() []*TutorStudent {
+	if x != nil {
+		return x.Tutors
+	}
+	return nil
+}
+
+type ResolveTutorStudentContextRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TutorId       string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveTutorStudentContextRequest) Reset() {
+	*x = ResolveTutorStudentContextRequest{}
+	mi := &file_user_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveTutorStudentContextRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveTutorStudentContextRequest) ProtoMessage() {}
+
+func (x *ResolveTutorStudentContextRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveTutorStudentContextRequest.ProtoReflect.Descriptor instead.
+func (*ResolveTutorStudentContextRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ResolveTutorStudentContextRequest) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+func (x *ResolveTutorStudentContextRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+type ResolvedTutorStudentContext struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	RelationshipStatus   string                 `protobuf:"bytes,2,opt,name=relationship_status,json=relationshipStatus,proto3" json:"relationship_status,omitempty"` // invited / active / blocked / removed
+	LessonConnectionLink *string                `protobuf:"bytes,4,opt,name=lesson_connection_link,json=lessonConnectionLink,proto3,oneof" json:"lesson_connection_link,omitempty"`
+	PaymentInfo          *string                `protobuf:"bytes,5,opt,name=payment_info,json=paymentInfo,proto3,oneof" json:"payment_info,omitempty"`
+	LessonPrice          *Money                 `protobuf:"bytes,6,opt,name=lesson_price,json=lessonPrice,proto3,oneof" json:"lesson_price,omitempty"` // price of the pair or the tutor default
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ResolvedTutorStudentContext) Reset() {
+	*x = ResolvedTutorStudentContext{}
+	mi := &file_user_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolvedTutorStudentContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolvedTutorStudentContext) ProtoMessage() {}
+
+func (x *ResolvedTutorStudentContext) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolvedTutorStudentContext.ProtoReflect.Descriptor instead.
+func (*ResolvedTutorStudentContext) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ResolvedTutorStudentContext) GetRelationshipStatus() string {
+	if x != nil {
+		return x.RelationshipStatus
+	}
+	return ""
+}
+
+func (x *ResolvedTutorStudentContext) GetLessonConnectionLink() string {
+	if x != nil && x.LessonConnectionLink != nil {
+		return *x.LessonConnectionLink
+	}
+	return ""
+}
+
+func (x *ResolvedTutorStudentContext) GetPaymentInfo() string {
+	if x != nil && x.PaymentInfo != nil {
+		return *x.PaymentInfo
+	}
+	return ""
+}
+
+func (x *ResolvedTutorStudentContext) GetLessonPrice() *Money {
+	if x != nil {
+		return x.LessonPrice
+	}
+	return nil
+}
+
+type AcceptInvitationFromTutorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TutorId       string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptInvitationFromTutorRequest) Reset() {
+	*x = AcceptInvitationFromTutorRequest{}
+	mi := &file_user_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptInvitationFromTutorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptInvitationFromTutorRequest) ProtoMessage() {}
+
+func (x *AcceptInvitationFromTutorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptInvitationFromTutorRequest.ProtoReflect.Descriptor instead.
+func (*AcceptInvitationFromTutorRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AcceptInvitationFromTutorRequest) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+type RejectInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TutorId       string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectInvitationRequest) Reset() {
+	*x = RejectInvitationRequest{}
+	mi := &file_user_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectInvitationRequest) ProtoMessage() {}
+
+func (x *RejectInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectInvitationRequest.ProtoReflect.Descriptor instead.
+func (*RejectInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RejectInvitationRequest) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+type CreateInviteRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	TutorId              string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	LessonPrice          *Money                 `protobuf:"bytes,2,opt,name=lesson_price,json=lessonPrice,proto3,oneof" json:"lesson_price,omitempty"`
+	LessonConnectionLink *string                `protobuf:"bytes,3,opt,name=lesson_connection_link,json=lessonConnectionLink,proto3,oneof" json:"lesson_connection_link,omitempty"`
+	MaxUses              *int32                 `protobuf:"varint,4,opt,name=max_uses,json=maxUses,proto3,oneof" json:"max_uses,omitempty"`      // 1 by default, 0 for unlimited
+	ExpiresAt            *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"` // INVITE_TTL from now by default, at most 30 days
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CreateInviteRequest) Reset() {
+	*x = CreateInviteRequest{}
+	mi := &file_user_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInviteRequest) ProtoMessage() {}
+
+func (x *CreateInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInviteRequest.ProtoReflect.Descriptor instead.
+func (*CreateInviteRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CreateInviteRequest) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+func (x *CreateInviteRequest) GetLessonPrice() *Money {
+	if x != nil {
+		return x.LessonPrice
+	}
+	return nil
+}
+
+func (x *CreateInviteRequest) GetLessonConnectionLink() string {
+	if x != nil && x.LessonConnectionLink != nil {
+		return *x.LessonConnectionLink
+	}
+	return ""
+}
+
+func (x *CreateInviteRequest) GetMaxUses() int32 {
+	if x != nil && x.MaxUses != nil {
+		return *x.MaxUses
+	}
+	return 0
+}
+
+func (x *CreateInviteRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type ListInvitesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TutorId       string                 `protobuf:"bytes,1,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitesRequest) Reset() {
+	*x = ListInvitesRequest{}
+	mi := &file_user_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitesRequest) ProtoMessage() {}
+
+func (x *ListInvitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitesRequest.ProtoReflect.Descriptor instead.
+func (*ListInvitesRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListInvitesRequest) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+type ListInvitesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invites       []*Invite              `protobuf:"bytes,1,rep,name=invites,proto3" json:"invites,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitesResponse) Reset() {
+	*x = ListInvitesResponse{}
+	mi := &file_user_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitesResponse) ProtoMessage() {}
+
+func (x *ListInvitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitesResponse.ProtoReflect.Descriptor instead.
+func (*ListInvitesResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListInvitesResponse) GetInvites() []*Invite {
+	if x != nil {
+		return x.Invites
+	}
+	return nil
+}
+
+type RevokeInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInviteRequest) Reset() {
+	*x = RevokeInviteRequest{}
+	mi := &file_user_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInviteRequest) ProtoMessage() {}
+
+func (x *RevokeInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInviteRequest.ProtoReflect.Descriptor instead.
+func (*RevokeInviteRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RevokeInviteRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetDataJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDataJobRequest) Reset() {
+	*x = GetDataJobRequest{}
+	mi := &file_user_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDataJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataJobRequest) ProtoMessage() {}
+
+func (x *GetDataJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataJobRequest.ProtoReflect.Descriptor instead.
+func (*GetDataJobRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetDataJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// code may be the telegram start payload invite_<code> as is
+type RedeemInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeemInviteRequest) Reset() {
+	*x = RedeemInviteRequest{}
+	mi := &file_user_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeemInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeemInviteRequest) ProtoMessage() {}
+
+func (x *RedeemInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeemInviteRequest.ProtoReflect.Descriptor instead.
+func (*RedeemInviteRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RedeemInviteRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type CreateGuardianStudentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuardianId    string                 `protobuf:"bytes,1,opt,name=guardian_id,json=guardianId,proto3" json:"guardian_id,omitempty"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGuardianStudentRequest) Reset() {
+	*x = CreateGuardianStudentRequest{}
+	mi := &file_user_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGuardianStudentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGuardianStudentRequest) ProtoMessage() {}
+
+func (x *CreateGuardianStudentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGuardianStudentRequest.ProtoReflect.Descriptor instead.
+func (*CreateGuardianStudentRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CreateGuardianStudentRequest) GetGuardianId() string {
+	if x != nil {
+		return x.GuardianId
+	}
+	return ""
+}
+
+func (x *CreateGuardianStudentRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+type GetGuardianStudentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuardianId    string                 `protobuf:"bytes,1,opt,name=guardian_id,json=guardianId,proto3" json:"guardian_id,omitempty"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGuardianStudentRequest) Reset() {
+	*x = GetGuardianStudentRequest{}
+	mi := &file_user_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGuardianStudentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGuardianStudentRequest) ProtoMessage() {}
+
+func (x *GetGuardianStudentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGuardianStudentRequest.ProtoReflect.Descriptor instead.
+func (*GetGuardianStudentRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetGuardianStudentRequest) GetGuardianId() string {
+	if x != nil {
+		return x.GuardianId
+	}
+	return ""
+}
+
+func (x *GetGuardianStudentRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+type DeleteGuardianStudentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuardianId    string                 `protobuf:"bytes,1,opt,name=guardian_id,json=guardianId,proto3" json:"guardian_id,omitempty"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGuardianStudentRequest) Reset() {
+	*x = DeleteGuardianStudentRequest{}
+	mi := &file_user_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGuardianStudentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGuardianStudentRequest) ProtoMessage() {}
+
+func (x *DeleteGuardianStudentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGuardianStudentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGuardianStudentRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DeleteGuardianStudentRequest) GetGuardianId() string {
+	if x != nil {
+		return x.GuardianId
+	}
+	return ""
+}
+
+func (x *DeleteGuardianStudentRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+type ListGuardianStudentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuardianId    string                 `protobuf:"bytes,1,opt,name=guardian_id,json=guardianId,proto3" json:"guardian_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGuardianStudentsRequest) Reset() {
+	*x = ListGuardianStudentsRequest{}
+	mi := &file_user_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGuardianStudentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGuardianStudentsRequest) ProtoMessage() {}
+
+func (x *ListGuardianStudentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGuardianStudentsRequest.ProtoReflect.Descriptor instead.
+func (*ListGuardianStudentsRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListGuardianStudentsRequest) GetGuardianId() string {
+	if x != nil {
+		return x.GuardianId
+	}
+	return ""
+}
+
+type ListGuardiansForStudentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGuardiansForStudentRequest) Reset() {
+	*x = ListGuardiansForStudentRequest{}
+	mi := &file_user_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGuardiansForStudentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGuardiansForStudentRequest) ProtoMessage() {}
+
+func (x *ListGuardiansForStudentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGuardiansForStudentRequest.ProtoReflect.Descriptor instead.
+func (*ListGuardiansForStudentRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListGuardiansForStudentRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+type ListGuardianStudentsResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	GuardianStudents []*GuardianStudent     `protobuf:"bytes,1,rep,name=guardian_students,json=guardianStudents,proto3" json:"guardian_students,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListGuardianStudentsResponse) Reset() {
+	*x = ListGuardianStudentsResponse{}
+	mi := &file_user_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGuardianStudentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGuardianStudentsResponse) ProtoMessage() {}
+
+func (x *ListGuardianStudentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGuardianStudentsResponse.ProtoReflect.Descriptor instead.
+func (*ListGuardianStudentsResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListGuardianStudentsResponse) GetGuardianStudents() []*GuardianStudent {
+	if x != nil {
+		return x.GuardianStudents
+	}
+	return nil
+}
+
+type AcceptGuardianRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuardianId    string                 `protobuf:"bytes,1,opt,name=guardian_id,json=guardianId,proto3" json:"guardian_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptGuardianRequestRequest) Reset() {
+	*x = AcceptGuardianRequestRequest{}
+	mi := &file_user_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptGuardianRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptGuardianRequestRequest) ProtoMessage() {}
+
+func (x *AcceptGuardianRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptGuardianRequestRequest.ProtoReflect.Descriptor instead.
+func (*AcceptGuardianRequestRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *AcceptGuardianRequestRequest) GetGuardianId() string {
+	if x != nil {
+		return x.GuardianId
+	}
+	return ""
+}
+
+// query matches the id, names, telegram username or email
+type SearchUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Role          *string                `protobuf:"bytes,2,opt,name=role,proto3,oneof" json:"role,omitempty"`
+	Status        *string                `protobuf:"bytes,3,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUsersRequest) Reset() {
+	*x = SearchUsersRequest{}
+	mi := &file_user_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersRequest) ProtoMessage() {}
+
+func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersRequest.ProtoReflect.Descriptor instead.
+func (*SearchUsersRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SearchUsersRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchUsersRequest) GetRole() string {
+	if x != nil && x.Role != nil {
+		return *x.Role
+	}
+	return ""
+}
+
+func (x *SearchUsersRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *SearchUsersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchUsersRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type SearchUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUsersResponse) Reset() {
+	*x = SearchUsersResponse{}
+	mi := &file_user_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersResponse) ProtoMessage() {}
+
+func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersResponse.ProtoReflect.Descriptor instead.
+func (*SearchUsersResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SearchUsersResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type SuspendUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason        *string                `protobuf:"bytes,2,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendUserRequest) Reset() {
+	*x = SuspendUserRequest{}
+	mi := &file_user_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendUserRequest) ProtoMessage() {}
+
+func (x *SuspendUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendUserRequest.ProtoReflect.Descriptor instead.
+func (*SuspendUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SuspendUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SuspendUserRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+type ReactivateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactivateUserRequest) Reset() {
+	*x = ReactivateUserRequest{}
+	mi := &file_user_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactivateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactivateUserRequest) ProtoMessage() {}
+
+func (x *ReactivateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactivateUserRequest.ProtoReflect.Descriptor instead.
+func (*ReactivateUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ReactivateUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListUserTutorStudentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserTutorStudentsRequest) Reset() {
+	*x = ListUserTutorStudentsRequest{}
+	mi := &file_user_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserTutorStudentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserTutorStudentsRequest) ProtoMessage() {}
+
+func (x *ListUserTutorStudentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserTutorStudentsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserTutorStudentsRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListUserTutorStudentsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RecordAuditEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	TargetType    string                 `protobuf:"bytes,2,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`
+	TargetId      string                 `protobuf:"bytes,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Details       *string                `protobuf:"bytes,4,opt,name=details,proto3,oneof" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordAuditEventRequest) Reset() {
+	*x = RecordAuditEventRequest{}
+	mi := &file_user_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordAuditEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordAuditEventRequest) ProtoMessage() {}
+
+func (x *RecordAuditEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordAuditEventRequest.ProtoReflect.Descriptor instead.
+func (*RecordAuditEventRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *RecordAuditEventRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *RecordAuditEventRequest) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *RecordAuditEventRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *RecordAuditEventRequest) GetDetails() string {
+	if x != nil && x.Details != nil {
+		return *x.Details
+	}
+	return ""
+}
+
+type ListAuditEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdminId       *string                `protobuf:"bytes,1,opt,name=admin_id,json=adminId,proto3,oneof" json:"admin_id,omitempty"`
+	TargetId      *string                `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3,oneof" json:"target_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	mi := &file_user_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsRequest) ProtoMessage() {}
+
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListAuditEventsRequest) GetAdminId() string {
+	if x != nil && x.AdminId != nil {
+		return *x.AdminId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetTargetId() string {
+	if x != nil && x.TargetId != nil {
+		return *x.TargetId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListAuditEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*AuditEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsResponse) Reset() {
+	*x = ListAuditEventsResponse{}
+	mi := &file_user_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsResponse) ProtoMessage() {}
+
+func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListAuditEventsResponse) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type Empty struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	mi := &file_user_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{58}
+}
+
+// Money is an amount in minor units of an ISO 4217 currency, e.g. 150000 RUB is 1500 rubles
+type Money struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AmountMinor   int64                  `protobuf:"varint,1,opt,name=amount_minor,json=amountMinor,proto3" json:"amount_minor,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_user_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *Money) GetAmountMinor() int64 {
+	if x != nil {
+		return x.AmountMinor
+	}
+	return 0
+}
+
+func (x *Money) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type SessionTokens struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken           string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	AccessTokenExpiresAt  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=access_token_expires_at,json=accessTokenExpiresAt,proto3" json:"access_token_expires_at,omitempty"`
+	RefreshToken          string                 `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	RefreshTokenExpiresAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=refresh_token_expires_at,json=refreshTokenExpiresAt,proto3" json:"refresh_token_expires_at,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *SessionTokens) Reset() {
+	*x = SessionTokens{}
+	mi := &file_user_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionTokens) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionTokens) ProtoMessage() {}
+
+func (x *SessionTokens) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionTokens.ProtoReflect.Descriptor instead.
+func (*SessionTokens) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SessionTokens) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *SessionTokens) GetAccessTokenExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AccessTokenExpiresAt
+	}
+	return nil
+}
+
+func (x *SessionTokens) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *SessionTokens) GetRefreshTokenExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RefreshTokenExpiresAt
+	}
+	return nil
+}
+
+// SigningKey is a public Ed25519 key in JWK format
+type SigningKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kty           string                 `protobuf:"bytes,1,opt,name=kty,proto3" json:"kty,omitempty"`
+	Crv           string                 `protobuf:"bytes,2,opt,name=crv,proto3" json:"crv,omitempty"`
+	Alg           string                 `protobuf:"bytes,3,opt,name=alg,proto3" json:"alg,omitempty"`
+	Use           string                 `protobuf:"bytes,4,opt,name=use,proto3" json:"use,omitempty"`
+	Kid           string                 `protobuf:"bytes,5,opt,name=kid,proto3" json:"kid,omitempty"`
+	X             string                 `protobuf:"bytes,6,opt,name=x,proto3" json:"x,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SigningKey) Reset() {
+	*x = SigningKey{}
+	mi := &file_user_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SigningKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SigningKey) ProtoMessage() {}
+
+func (x *SigningKey) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SigningKey.ProtoReflect.Descriptor instead.
+func (*SigningKey) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SigningKey) GetKty() string {
+	if x != nil {
+		return x.Kty
+	}
+	return ""
+}
+
+func (x *SigningKey) GetCrv() string {
+	if x != nil {
+		return x.Crv
+	}
+	return ""
+}
+
+func (x *SigningKey) GetAlg() string {
+	if x != nil {
+		return x.Alg
+	}
+	return ""
+}
+
+func (x *SigningKey) GetUse() string {
+	if x != nil {
+		return x.Use
+	}
+	return ""
+}
+
+func (x *SigningKey) GetKid() string {
+	if x != nil {
+		return x.Kid
+	}
+	return ""
+}
+
+func (x *SigningKey) GetX() string {
+	if x != nil {
+		return x.X
+	}
+	return ""
+}
+
+type SigningKeySet struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []*SigningKey          `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SigningKeySet) Reset() {
+	*x = SigningKeySet{}
+	mi := &file_user_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SigningKeySet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SigningKeySet) ProtoMessage() {}
+
+func (x *SigningKeySet) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SigningKeySet.ProtoReflect.Descriptor instead.
+func (*SigningKeySet) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SigningKeySet) GetKeys() []*SigningKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type OidcAuthorization struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AuthorizationUrl string                 `protobuf:"bytes,1,opt,name=authorization_url,json=authorizationUrl,proto3" json:"authorization_url,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *OidcAuthorization) Reset() {
+	*x = OidcAuthorization{}
+	mi := &file_user_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OidcAuthorization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OidcAuthorization) ProtoMessage() {}
+
+func (x *OidcAuthorization) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OidcAuthorization.ProtoReflect.Descriptor instead.
+func (*OidcAuthorization) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *OidcAuthorization) GetAuthorizationUrl() string {
+	if x != nil {
+		return x.AuthorizationUrl
+	}
+	return ""
+}
+
+type Identity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Provider      string                 `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"` // telegram / email / name of the oidc provider
+	Subject       string                 `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	Email         *string                `protobuf:"bytes,4,opt,name=email,proto3,oneof" json:"email,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Identity) Reset() {
+	*x = Identity{}
+	mi := &file_user_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Identity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Identity) ProtoMessage() {}
+
+func (x *Identity) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Identity.ProtoReflect.Descriptor instead.
+func (*Identity) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *Identity) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Identity) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *Identity) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *Identity) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ""
+}
+
+func (x *Identity) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type User struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"` // tutor / student / guardian / admin
+	AuthProvider  string                 `protobuf:"bytes,3,opt,name=auth_provider,json=authProvider,proto3" json:"auth_provider,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // active / blocked / deleted
+	FirstName     *string                `protobuf:"bytes,5,opt,name=first_name,json=firstName,proto3,oneof" json:"first_name,omitempty"`
+	LastName      *string                `protobuf:"bytes,6,opt,name=last_name,json=lastName,proto3,oneof" json:"last_name,omitempty"`
+	Timezone      *string                `protobuf:"bytes,7,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	EditedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_user_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *User) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *User) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *User) GetAuthProvider() string {
+	if x != nil {
+		return x.AuthProvider
+	}
+	return ""
+}
+
+func (x *User) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *User) GetFirstName() string {
+	if x != nil && x.FirstName != nil {
+		return *x.FirstName
+	}
+	return ""
+}
+
+func (x *User) GetLastName() string {
+	if x != nil && x.LastName != nil {
+		return *x.LastName
+	}
+	return ""
+}
+
+func (x *User) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
+	}
+	return ""
+}
+
+func (x *User) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *User) GetEditedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EditedAt
+	}
+	return nil
+}
+
+type UserPublic struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	FirstName     *string                `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3,oneof" json:"first_name,omitempty"`
+	LastName      *string                `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3,oneof" json:"last_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserPublic) Reset() {
+	*x = UserPublic{}
+	mi := &file_user_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserPublic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserPublic) ProtoMessage() {}
+
+func (x *UserPublic) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserPublic.ProtoReflect.Descriptor instead.
+func (*UserPublic) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *UserPublic) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UserPublic) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *UserPublic) GetFirstName() string {
+	if x != nil && x.FirstName != nil {
+		return *x.FirstName
+	}
+	return ""
+}
+
+func (x *UserPublic) GetLastName() string {
+	if x != nil && x.LastName != nil {
+		return *x.LastName
+	}
+	return ""
+}
+
+type TutorProfile struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId               string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PaymentInfo          *string                `protobuf:"bytes,3,opt,name=payment_info,json=paymentInfo,proto3,oneof" json:"payment_info,omitempty"`
+	LessonConnectionLink *string                `protobuf:"bytes,5,opt,name=lesson_connection_link,json=lessonConnectionLink,proto3,oneof" json:"lesson_connection_link,omitempty"`
+	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	EditedAt             *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+	LessonPrice          *Money                 `protobuf:"bytes,8,opt,name=lesson_price,json=lessonPrice,proto3,oneof" json:"lesson_price,omitempty"`
+	Currency             string                 `protobuf:"bytes,9,opt,name=currency,proto3" json:"currency,omitempty"` // ISO 4217, RUB by default
+	Bio                  *string                `protobuf:"bytes,10,opt,name=bio,proto3,oneof" json:"bio,omitempty"`
+	Subjects             []string               `protobuf:"bytes,11,rep,name=subjects,proto3" json:"subjects,omitempty"`
+	Languages            []string               `protobuf:"bytes,12,rep,name=languages,proto3" json:"languages,omitempty"`
+	GradeLevels          []string               `protobuf:"bytes,13,rep,name=grade_levels,json=gradeLevels,proto3" json:"grade_levels,omitempty"`
+	AvatarFileId         *string                `protobuf:"bytes,14,opt,name=avatar_file_id,json=avatarFileId,proto3,oneof" json:"avatar_file_id,omitempty"`
+	IsPublic             bool                   `protobuf:"varint,15,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"` // public profiles are found by SearchTutors
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *TutorProfile) Reset() {
+	*x = TutorProfile{}
+	mi := &file_user_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TutorProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TutorProfile) ProtoMessage() {}
+
+func (x *TutorProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TutorProfile.ProtoReflect.Descriptor instead.
+func (*TutorProfile) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *TutorProfile) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TutorProfile) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TutorProfile) GetPaymentInfo() string {
+	if x != nil && x.PaymentInfo != nil {
+		return *x.PaymentInfo
+	}
+	return ""
+}
+
+func (x *TutorProfile) GetLessonConnectionLink() string {
+	if x != nil && x.LessonConnectionLink != nil {
+		return *x.LessonConnectionLink
+	}
+	return ""
+}
+
+func (x *TutorProfile) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TutorProfile) GetEditedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EditedAt
+	}
+	return nil
+}
+
+func (x *TutorProfile) GetLessonPrice() *Money {
+	if x != nil {
+		return x.LessonPrice
+	}
+	return nil
+}
+
+func (x *TutorProfile) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TutorProfile) GetBio() string {
+	if x != nil && x.Bio != nil {
+		return *x.Bio
+	}
+	return ""
+}
+
+func (x *TutorProfile) GetSubjects() []string {
+	if x != nil {
+		return x.Subjects
+	}
+	return nil
+}
+
+func (x *TutorProfile) GetLanguages() []string {
+	if x != nil {
+		return x.Languages
+	}
+	return nil
+}
+
+func (x *TutorProfile) GetGradeLevels() []string {
+	if x != nil {
+		return x.GradeLevels
+	}
+	return nil
+}
+
+func (x *TutorProfile) GetAvatarFileId() string {
+	if x != nil && x.AvatarFileId != nil {
+		return *x.AvatarFileId
+	}
+	return ""
+}
+
+func (x *TutorProfile) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+// TutorPublicProfile has no lesson connection link,
+// payment_info is set only for the tutor and their active students
+type TutorPublicProfile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FirstName     *string                `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3,oneof" json:"first_name,omitempty"`
+	LastName      *string                `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3,oneof" json:"last_name,omitempty"`
+	Bio           *string                `protobuf:"bytes,4,opt,name=bio,proto3,oneof" json:"bio,omitempty"`
+	Subjects      []string               `protobuf:"bytes,5,rep,name=subjects,proto3" json:"subjects,omitempty"`
+	Languages     []string               `protobuf:"bytes,6,rep,name=languages,proto3" json:"languages,omitempty"`
+	GradeLevels   []string               `protobuf:"bytes,7,rep,name=grade_levels,json=gradeLevels,proto3" json:"grade_levels,omitempty"`
+	LessonPrice   *Money                 `protobuf:"bytes,8,opt,name=lesson_price,json=lessonPrice,proto3,oneof" json:"lesson_price,omitempty"`
+	Currency      string                 `protobuf:"bytes,9,opt,name=currency,proto3" json:"currency,omitempty"`
+	AvatarFileId  *string                `protobuf:"bytes,10,opt,name=avatar_file_id,json=avatarFileId,proto3,oneof" json:"avatar_file_id,omitempty"`
+	AvatarUrl     *string                `protobuf:"bytes,11,opt,name=avatar_url,json=avatarUrl,proto3,oneof" json:"avatar_url,omitempty"` // download link, if file_service is configured
+	PaymentInfo   *string                `protobuf:"bytes,12,opt,name=payment_info,json=paymentInfo,proto3,oneof" json:"payment_info,omitempty"`
+	IsPublic      bool                   `protobuf:"varint,13,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TutorPublicProfile) Reset() {
+	*x = TutorPublicProfile{}
+	mi := &file_user_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TutorPublicProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TutorPublicProfile) ProtoMessage() {}
+
+func (x *TutorPublicProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TutorPublicProfile.ProtoReflect.Descriptor instead.
+func (*TutorPublicProfile) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *TutorPublicProfile) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TutorPublicProfile) GetFirstName() string {
+	if x != nil && x.FirstName != nil {
+		return *x.FirstName
+	}
+	return ""
+}
+
+func (x *TutorPublicProfile) GetLastName() string {
+	if x != nil && x.LastName != nil {
+		return *x.LastName
+	}
+	return ""
+}
+
+func (x *TutorPublicProfile) GetBio() string {
+	if x != nil && x.Bio != nil {
+		return *x.Bio
+	}
+	return ""
+}
+
+func (x *TutorPublicProfile) GetSubjects() []string {
+	if x != nil {
+		return x.Subjects
+	}
+	return nil
+}
+
+func (x *TutorPublicProfile) GetLanguages() []string {
+	if x != nil {
+		return x.Languages
+	}
+	return nil
+}
+
+func (x *TutorPublicProfile) GetGradeLevels() []string {
+	if x != nil {
+		return x.GradeLevels
+	}
+	return nil
+}
+
+func (x *TutorPublicProfile) GetLessonPrice() *Money {
+	if x != nil {
+		return x.LessonPrice
+	}
+	return nil
+}
+
+func (x *TutorPublicProfile) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TutorPublicProfile) GetAvatarFileId() string {
+	if x != nil && x.AvatarFileId != nil {
+		return *x.AvatarFileId
+	}
+	return ""
+}
+
+func (x *TutorPublicProfile) GetAvatarUrl() string {
+	if x != nil && x.AvatarUrl != nil {
+		return *x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *TutorPublicProfile) GetPaymentInfo() string {
+	if x != nil && x.PaymentInfo != nil {
+		return *x.PaymentInfo
+	}
+	return ""
+}
+
+func (x *TutorPublicProfile) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+type TutorStudent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TutorId              string                 `protobuf:"bytes,2,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	StudentId            string                 `protobuf:"bytes,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	LessonConnectionLink *string                `protobuf:"bytes,5,opt,name=lesson_connection_link,json=lessonConnectionLink,proto3,oneof" json:"lesson_connection_link,omitempty"`
+	Status               string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"` // invited / active / paused / archived
+	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	EditedAt             *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+	LessonPrice          *Money                 `protobuf:"bytes,9,opt,name=lesson_price,json=lessonPrice,proto3,oneof" json:"lesson_price,omitempty"` // overrides the tutor default
+	StatusReason         *string                `protobuf:"bytes,10,opt,name=status_reason,json=statusReason,proto3,oneof" json:"status_reason,omitempty"`
+	PausedAt             *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=paused_at,json=pausedAt,proto3,oneof" json:"paused_at,omitempty"`
+	ArchivedAt           *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *TutorStudent) Reset() {
+	*x = TutorStudent{}
+	mi := &file_user_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TutorStudent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TutorStudent) ProtoMessage() {}
+
+func (x *TutorStudent) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TutorStudent.ProtoReflect.Descriptor instead.
+func (*TutorStudent) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *TutorStudent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TutorStudent) GetTutorId() string {
+	if x != nil {
+		return x.TutorId
+	}
+	return ""
+}
+
+func (x *TutorStudent) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *TutorStudent) GetLessonConnectionLink() string {
+	if x != nil && x.LessonConnectionLink != nil {
+		return *x.LessonConnectionLink
+	}
+	return ""
+}
+
+func (x *TutorStudent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TutorStudent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TutorStudent) GetEditedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EditedAt
+	}
+	return nil
+}
+
+func (x *TutorStudent) GetLessonPrice() *Money {
+	if x != nil {
+		return x.LessonPrice
+	}
+	return nil
+}
+
+func (x *TutorStudent) GetStatusReason() string {
+	if x != nil && x.StatusReason != nil {
+		return *x.StatusReason
+	}
+	return ""
+}
+
+func (x *TutorStudent) GetPausedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PausedAt
+	}
+	return nil
+}
+
+func (x *TutorStudent) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+type TutorStudentStatusEvent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TutorStudentId string                 `protobuf:"bytes,2,opt,name=tutor_student_id,json=tutorStudentId,proto3" json:"tutor_student_id,omitempty"`
+	FromStatus     string                 `protobuf:"bytes,3,opt,name=from_status,json=fromStatus,proto3" json:"from_status,omitempty"`
+	ToStatus       string                 `protobuf:"bytes,4,opt,name=to_status,json=toStatus,proto3" json:"to_status,omitempty"`
+	Reason         *string                `protobuf:"bytes,5,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	ChangedBy      string                 `protobuf:"bytes,6,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TutorStudentStatusEvent) Reset() {
+	*x = TutorStudentStatusEvent{}
+	mi := &file_user_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TutorStudentStatusEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TutorStudentStatusEvent) ProtoMessage() {}
+
+func (x *TutorStudentStatusEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TutorStudentStatusEvent.ProtoReflect.Descriptor instead.
+func (*TutorStudentStatusEvent) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *TutorStudentStatusEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TutorStudentStatusEvent) GetTutorStudentId() string {
+	if x != nil {
+		return x.TutorStudentId
+	}
+	return ""
+}
+
+func (x *TutorStudentStatusEvent) GetFromStatus() string {
+	if x != nil {
+		return x.FromStatus
+	}
+	return ""
+}
+
+func (x *TutorStudentStatusEvent) GetToStatus() string {
+	if x != nil {
+		return x.ToStatus
+	}
+	return ""
+}
+
+func (x *TutorStudentStatusEvent) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *TutorStudentStatusEvent) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+func (x *TutorStudentStatusEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type Invite struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TutorId              string                 `protobuf:"bytes,2,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
+	Code                 string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Link                 *string                `protobuf:"bytes,4,opt,name=link,proto3,oneof" json:"link,omitempty"`                                  // https://t.me/<bot>?start=invite_<code>, if the bot is configured
+	LessonPrice          *Money                 `protobuf:"bytes,5,opt,name=lesson_price,json=lessonPrice,proto3,oneof" json:"lesson_price,omitempty"` // price of the pairs created by the invite
+	LessonConnectionLink *string                `protobuf:"bytes,6,opt,name=lesson_connection_link,json=lessonConnectionLink,proto3,oneof" json:"lesson_connection_link,omitempty"`
+	MaxUses              *int32                 `protobuf:"varint,7,opt,name=max_uses,json=maxUses,proto3,oneof" json:"max_uses,omitempty"` // not set for unlimited
+	Uses                 int32                  `protobuf:"varint,8,opt,name=uses,proto3" json:"uses,omitempty"`
+	ExpiresAt            *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	RevokedAt            *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=revoked_at,json=revokedAt,proto3,oneof" json:"revoked_at,omitempty"`
+	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *User) Reset() {
-	*x = User{}
-	mi := &file_user_service_proto_msgTypes[18]
+func (x *Invite) Reset() {
+	*x = Invite{}
+	mi := &file_user_service_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *User) String() string {
+func (x *Invite) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*User) ProtoMessage() {}
+func (*Invite) ProtoMessage() {}
 
-func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[18]
+func (x *Invite) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1038,99 +4224,115 @@ func (x *User) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use User.ProtoReflect.Descriptor instead.
-func (*User) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use Invite.ProtoReflect.Descriptor instead.
+func (*Invite) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *User) GetId() string {
+func (x *Invite) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *User) GetRole() string {
+func (x *Invite) GetTutorId() string {
 	if x != nil {
-		return x.Role
+		return x.TutorId
 	}
 	return ""
 }
 
-func (x *User) GetAuthProvider() string {
+func (x *Invite) GetCode() string {
 	if x != nil {
-		return x.AuthProvider
+		return x.Code
 	}
 	return ""
 }
 
-func (x *User) GetStatus() string {
-	if x != nil {
-		return x.Status
+func (x *Invite) GetLink() string {
+	if x != nil && x.Link != nil {
+		return *x.Link
 	}
 	return ""
 }
 
-func (x *User) GetFirstName() string {
-	if x != nil && x.FirstName != nil {
-		return *x.FirstName
+func (x *Invite) GetLessonPrice() *Money {
+	if x != nil {
+		return x.LessonPrice
 	}
-	return ""
+	return nil
 }
 
-func (x *User) GetLastName() string {
-	if x != nil && x.LastName != nil {
-		return *x.LastName
+func (x *Invite) GetLessonConnectionLink() string {
+	if x != nil && x.LessonConnectionLink != nil {
+		return *x.LessonConnectionLink
 	}
 	return ""
 }
 
-func (x *User) GetTimezone() string {
-	if x != nil && x.Timezone != nil {
-		return *x.Timezone
+func (x *Invite) GetMaxUses() int32 {
+	if x != nil && x.MaxUses != nil {
+		return *x.MaxUses
 	}
-	return ""
+	return 0
 }
 
-func (x *User) GetCreatedAt() *timestamppb.Timestamp {
+func (x *Invite) GetUses() int32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.Uses
+	}
+	return 0
+}
+
+func (x *Invite) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
 	}
 	return nil
 }
 
-func (x *User) GetEditedAt() *timestamppb.Timestamp {
+func (x *Invite) GetRevokedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.EditedAt
+		return x.RevokedAt
 	}
 	return nil
 }
 
-type UserPublic struct {
+func (x *Invite) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GuardianStudent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
-	FirstName     *string                `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3,oneof" json:"first_name,omitempty"`
-	LastName      *string                `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3,oneof" json:"last_name,omitempty"`
+	GuardianId    string                 `protobuf:"bytes,2,opt,name=guardian_id,json=guardianId,proto3" json:"guardian_id,omitempty"`
+	StudentId     string                 `protobuf:"bytes,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // pending / active
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	EditedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserPublic) Reset() {
-	*x = UserPublic{}
-	mi := &file_user_service_proto_msgTypes[19]
+func (x *GuardianStudent) Reset() {
+	*x = GuardianStudent{}
+	mi := &file_user_service_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserPublic) String() string {
+func (x *GuardianStudent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserPublic) ProtoMessage() {}
+func (*GuardianStudent) ProtoMessage() {}
 
-func (x *UserPublic) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[19]
+func (x *GuardianStudent) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1141,67 +4343,81 @@ func (x *UserPublic) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserPublic.ProtoReflect.Descriptor instead.
-func (*UserPublic) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use GuardianStudent.ProtoReflect.Descriptor instead.
+func (*GuardianStudent) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *UserPublic) GetId() string {
+func (x *GuardianStudent) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UserPublic) GetRole() string {
+func (x *GuardianStudent) GetGuardianId() string {
 	if x != nil {
-		return x.Role
+		return x.GuardianId
 	}
 	return ""
 }
 
-func (x *UserPublic) GetFirstName() string {
-	if x != nil && x.FirstName != nil {
-		return *x.FirstName
+func (x *GuardianStudent) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
 	}
 	return ""
 }
 
-func (x *UserPublic) GetLastName() string {
-	if x != nil && x.LastName != nil {
-		return *x.LastName
+func (x *GuardianStudent) GetStatus() string {
+	if x != nil {
+		return x.Status
 	}
 	return ""
 }
 
-type TutorProfile struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId               string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PaymentInfo          *string                `protobuf:"bytes,3,opt,name=payment_info,json=paymentInfo,proto3,oneof" json:"payment_info,omitempty"`
-	LessonPriceRub       *int32                 `protobuf:"varint,4,opt,name=lesson_price_rub,json=lessonPriceRub,proto3,oneof" json:"lesson_price_rub,omitempty"`
-	LessonConnectionLink *string                `protobuf:"bytes,5,opt,name=lesson_connection_link,json=lessonConnectionLink,proto3,oneof" json:"lesson_connection_link,omitempty"`
-	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	EditedAt             *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+func (x *GuardianStudent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (x *TutorProfile) Reset() {
-	*x = TutorProfile{}
-	mi := &file_user_service_proto_msgTypes[20]
+func (x *GuardianStudent) GetEditedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EditedAt
+	}
+	return nil
+}
+
+type AuditEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AdminId       string                 `protobuf:"bytes,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	TargetType    string                 `protobuf:"bytes,4,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`
+	TargetId      string                 `protobuf:"bytes,5,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Details       *string                `protobuf:"bytes,6,opt,name=details,proto3,oneof" json:"details,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_user_service_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TutorProfile) String() string {
+func (x *AuditEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TutorProfile) ProtoMessage() {}
+func (*AuditEvent) ProtoMessage() {}
 
-func (x *TutorProfile) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[20]
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1212,89 +4428,92 @@ func (x *TutorProfile) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TutorProfile.ProtoReflect.Descriptor instead.
-func (*TutorProfile) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *TutorProfile) GetId() string {
+func (x *AuditEvent) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *TutorProfile) GetUserId() string {
+func (x *AuditEvent) GetAdminId() string {
 	if x != nil {
-		return x.UserId
+		return x.AdminId
 	}
 	return ""
 }
 
-func (x *TutorProfile) GetPaymentInfo() string {
-	if x != nil && x.PaymentInfo != nil {
-		return *x.PaymentInfo
+func (x *AuditEvent) GetAction() string {
+	if x != nil {
+		return x.Action
 	}
 	return ""
 }
 
-func (x *TutorProfile) GetLessonPriceRub() int32 {
-	if x != nil && x.LessonPriceRub != nil {
-		return *x.LessonPriceRub
+func (x *AuditEvent) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
 	}
-	return 0
+	return ""
 }
 
-func (x *TutorProfile) GetLessonConnectionLink() string {
-	if x != nil && x.LessonConnectionLink != nil {
-		return *x.LessonConnectionLink
+func (x *AuditEvent) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
 	}
 	return ""
 }
 
-func (x *TutorProfile) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
+func (x *AuditEvent) GetDetails() string {
+	if x != nil && x.Details != nil {
+		return *x.Details
 	}
-	return nil
+	return ""
 }
 
-func (x *TutorProfile) GetEditedAt() *timestamppb.Timestamp {
+func (x *AuditEvent) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.EditedAt
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type TutorStudent struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	TutorId              string                 `protobuf:"bytes,2,opt,name=tutor_id,json=tutorId,proto3" json:"tutor_id,omitempty"`
-	StudentId            string                 `protobuf:"bytes,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
-	LessonPriceRub       *int32                 `protobuf:"varint,4,opt,name=lesson_price_rub,json=lessonPriceRub,proto3,oneof" json:"lesson_price_rub,omitempty"`
-	LessonConnectionLink *string                `protobuf:"bytes,5,opt,name=lesson_connection_link,json=lessonConnectionLink,proto3,oneof" json:"lesson_connection_link,omitempty"`
-	Status               string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"` // invited / active / blocked / removed
-	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	EditedAt             *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+// DataJob is an account deletion or a data export processed in the background
+type DataJob struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`                                             // delete / export
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`                                         // pending / running / done / failed
+	Step          *string                `protobuf:"bytes,5,opt,name=step,proto3,oneof" json:"step,omitempty"`                                       // last finished step
+	ResultFileId  *string                `protobuf:"bytes,6,opt,name=result_file_id,json=resultFileId,proto3,oneof" json:"result_file_id,omitempty"` // zip archive of the export in file_service
+	Error         *string                `protobuf:"bytes,7,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	EditedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+	FinishedAt    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=finished_at,json=finishedAt,proto3,oneof" json:"finished_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TutorStudent) Reset() {
-	*x = TutorStudent{}
-	mi := &file_user_service_proto_msgTypes[21]
+func (x *DataJob) Reset() {
+	*x = DataJob{}
+	mi := &file_user_service_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TutorStudent) String() string {
+func (x *DataJob) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TutorStudent) ProtoMessage() {}
+func (*DataJob) ProtoMessage() {}
 
-func (x *TutorStudent) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[21]
+func (x *DataJob) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1305,72 +4524,86 @@ func (x *TutorStudent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TutorStudent.ProtoReflect.Descriptor instead.
-func (*TutorStudent) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use DataJob.ProtoReflect.Descriptor instead.
+func (*DataJob) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *TutorStudent) GetId() string {
+func (x *DataJob) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *TutorStudent) GetTutorId() string {
+func (x *DataJob) GetUserId() string {
 	if x != nil {
-		return x.TutorId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *TutorStudent) GetStudentId() string {
+func (x *DataJob) GetKind() string {
 	if x != nil {
-		return x.StudentId
+		return x.Kind
 	}
 	return ""
 }
 
-func (x *TutorStudent) GetLessonPriceRub() int32 {
-	if x != nil && x.LessonPriceRub != nil {
-		return *x.LessonPriceRub
+func (x *DataJob) GetStatus() string {
+	if x != nil {
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-func (x *TutorStudent) GetLessonConnectionLink() string {
-	if x != nil && x.LessonConnectionLink != nil {
-		return *x.LessonConnectionLink
+func (x *DataJob) GetStep() string {
+	if x != nil && x.Step != nil {
+		return *x.Step
 	}
 	return ""
 }
 
-func (x *TutorStudent) GetStatus() string {
-	if x != nil {
-		return x.Status
+func (x *DataJob) GetResultFileId() string {
+	if x != nil && x.ResultFileId != nil {
+		return *x.ResultFileId
 	}
 	return ""
 }
 
-func (x *TutorStudent) GetCreatedAt() *timestamppb.Timestamp {
+func (x *DataJob) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *DataJob) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *TutorStudent) GetEditedAt() *timestamppb.Timestamp {
+func (x *DataJob) GetEditedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.EditedAt
 	}
 	return nil
 }
 
+func (x *DataJob) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
 var File_user_service_proto protoreflect.FileDescriptor
 
 const file_user_service_proto_rawDesc = "" +
 	"\n" +
-	"\x12user_service.proto\x12\auser.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x90\x02\n" +
+	"\x12user_service.proto\x12\auser.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe1\x02\n" +
 	"\x1aRegisterViaTelegramRequest\x12\x1f\n" +
 	"\vtelegram_id\x18\x01 \x01(\x03R\n" +
 	"telegramId\x12\x12\n" +
@@ -1379,14 +4612,47 @@ const file_user_service_proto_rawDesc = "" +
 	"\n" +
 	"first_name\x18\x04 \x01(\tH\x01R\tfirstName\x88\x01\x01\x12 \n" +
 	"\tlast_name\x18\x05 \x01(\tH\x02R\blastName\x88\x01\x01\x12\x1f\n" +
-	"\btimezone\x18\x06 \x01(\tH\x03R\btimezone\x88\x01\x01B\v\n" +
+	"\btimezone\x18\x06 \x01(\tH\x03R\btimezone\x88\x01\x01\x126\n" +
+	"\x14authorization_header\x18\a \x01(\tH\x04R\x13authorizationHeader\x88\x01\x01B\v\n" +
 	"\t_usernameB\r\n" +
 	"\v_first_nameB\f\n" +
 	"\n" +
 	"_last_nameB\v\n" +
-	"\t_timezone\"Q\n" +
+	"\t_timezoneB\x17\n" +
+	"\x15_authorization_header\"Q\n" +
 	"\x1cAuthorizeByAuthHeaderRequest\x121\n" +
-	"\x14authorization_header\x18\x01 \x01(\tR\x13authorizationHeader\" \n" +
+	"\x14authorization_header\x18\x01 \x01(\tR\x13authorizationHeader\"I\n" +
+	"\x14CreateSessionRequest\x121\n" +
+	"\x14authorization_header\x18\x01 \x01(\tR\x13authorizationHeader\"<\n" +
+	"\x15RefreshSessionRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\";\n" +
+	"\x14RevokeSessionRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"4\n" +
+	"\x13CheckSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\".\n" +
+	"\x14CheckSessionResponse\x12\x16\n" +
+	"\x06active\x18\x01 \x01(\bR\x06active\"R\n" +
+	"\x18RequestEmailLoginRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x17\n" +
+	"\x04role\x18\x02 \x01(\tH\x00R\x04role\x88\x01\x01B\a\n" +
+	"\x05_role\"/\n" +
+	"\x17VerifyEmailLoginRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"9\n" +
+	"\x15StartOidcLoginRequest\x12\x17\n" +
+	"\x04role\x18\x01 \x01(\tH\x00R\x04role\x88\x01\x01B\a\n" +
+	"\x05_role\"D\n" +
+	"\x18CompleteOidcLoginRequest\x12\x14\n" +
+	"\x05state\x18\x01 \x01(\tR\x05state\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"/\n" +
+	"\x17RequestEmailLinkRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"K\n" +
+	"\x16ListIdentitiesResponse\x121\n" +
+	"\n" +
+	"identities\x18\x01 \x03(\v2\x11.user.v1.IdentityR\n" +
+	"identities\"'\n" +
+	"\x15DeleteIdentityRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\" \n" +
 	"\x0eGetUserRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"\xb4\x01\n" +
 	"\x11UpdateUserRequest\x12\x0e\n" +
@@ -1400,41 +4666,98 @@ const file_user_service_proto_rawDesc = "" +
 	"_last_nameB\v\n" +
 	"\t_timezone\"9\n" +
 	"\x1eGetTutorProfileByUserIdRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x87\x02\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"7\n" +
+	"\x1cGetTutorPublicProfileRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xf7\x02\n" +
+	"\x13SearchTutorsRequest\x12\x19\n" +
+	"\x05query\x18\x01 \x01(\tH\x00R\x05query\x88\x01\x01\x12\x1d\n" +
+	"\asubject\x18\x02 \x01(\tH\x01R\asubject\x88\x01\x01\x12\x1f\n" +
+	"\blanguage\x18\x03 \x01(\tH\x02R\blanguage\x88\x01\x01\x12$\n" +
+	"\vgrade_level\x18\x04 \x01(\tH\x03R\n" +
+	"gradeLevel\x88\x01\x01\x120\n" +
+	"\tmin_price\x18\x05 \x01(\v2\x0e.user.v1.MoneyH\x04R\bminPrice\x88\x01\x01\x120\n" +
+	"\tmax_price\x18\x06 \x01(\v2\x0e.user.v1.MoneyH\x05R\bmaxPrice\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\a \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\b \x01(\x05R\x06offsetB\b\n" +
+	"\x06_queryB\n" +
+	"\n" +
+	"\b_subjectB\v\n" +
+	"\t_languageB\x0e\n" +
+	"\f_grade_levelB\f\n" +
+	"\n" +
+	"_min_priceB\f\n" +
+	"\n" +
+	"_max_price\"K\n" +
+	"\x14SearchTutorsResponse\x123\n" +
+	"\x06tutors\x18\x01 \x03(\v2\x1b.user.v1.TutorPublicProfileR\x06tutors\"$\n" +
+	"\n" +
+	"StringList\x12\x16\n" +
+	"\x06values\x18\x01 \x03(\tR\x06values\"\xad\x05\n" +
 	"\x19UpdateTutorProfileRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12&\n" +
-	"\fpayment_info\x18\x02 \x01(\tH\x00R\vpaymentInfo\x88\x01\x01\x12-\n" +
-	"\x10lesson_price_rub\x18\x03 \x01(\x05H\x01R\x0elessonPriceRub\x88\x01\x01\x129\n" +
-	"\x16lesson_connection_link\x18\x04 \x01(\tH\x02R\x14lessonConnectionLink\x88\x01\x01B\x0f\n" +
-	"\r_payment_infoB\x13\n" +
-	"\x11_lesson_price_rubB\x19\n" +
-	"\x17_lesson_connection_link\"R\n" +
+	"\fpayment_info\x18\x02 \x01(\tH\x00R\vpaymentInfo\x88\x01\x01\x129\n" +
+	"\x16lesson_connection_link\x18\x04 \x01(\tH\x01R\x14lessonConnectionLink\x88\x01\x01\x126\n" +
+	"\flesson_price\x18\x05 \x01(\v2\x0e.user.v1.MoneyH\x02R\vlessonPrice\x88\x01\x01\x12\x15\n" +
+	"\x03bio\x18\x06 \x01(\tH\x03R\x03bio\x88\x01\x01\x124\n" +
+	"\bsubjects\x18\a \x01(\v2\x13.user.v1.StringListH\x04R\bsubjects\x88\x01\x01\x126\n" +
+	"\tlanguages\x18\b \x01(\v2\x13.user.v1.StringListH\x05R\tlanguages\x88\x01\x01\x12;\n" +
+	"\fgrade_levels\x18\t \x01(\v2\x13.user.v1.StringListH\x06R\vgradeLevels\x88\x01\x01\x12)\n" +
+	"\x0eavatar_file_id\x18\n" +
+	" \x01(\tH\aR\favatarFileId\x88\x01\x01\x12#\n" +
+	"\rremove_avatar\x18\v \x01(\bR\fremoveAvatar\x12 \n" +
+	"\tis_public\x18\f \x01(\bH\bR\bisPublic\x88\x01\x01B\x0f\n" +
+	"\r_payment_infoB\x19\n" +
+	"\x17_lesson_connection_linkB\x0f\n" +
+	"\r_lesson_priceB\x06\n" +
+	"\x04_bioB\v\n" +
+	"\t_subjectsB\f\n" +
+	"\n" +
+	"_languagesB\x0f\n" +
+	"\r_grade_levelsB\x11\n" +
+	"\x0f_avatar_file_idB\f\n" +
+	"\n" +
+	"_is_publicJ\x04\b\x03\x10\x04R\x10lesson_price_rub\"R\n" +
 	"\x16GetTutorStudentRequest\x12\x19\n" +
 	"\btutor_id\x18\x01 \x01(\tR\atutorId\x12\x1d\n" +
 	"\n" +
-	"student_id\x18\x02 \x01(\tR\tstudentId\"\xef\x01\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\"\x8c\x02\n" +
 	"\x19CreateTutorStudentRequest\x12\x19\n" +
 	"\btutor_id\x18\x01 \x01(\tR\atutorId\x12\x1d\n" +
 	"\n" +
-	"student_id\x18\x02 \x01(\tR\tstudentId\x12-\n" +
-	"\x10lesson_price_rub\x18\x03 \x01(\x05H\x00R\x0elessonPriceRub\x88\x01\x01\x129\n" +
-	"\x16lesson_connection_link\x18\x04 \x01(\tH\x01R\x14lessonConnectionLink\x88\x01\x01B\x13\n" +
-	"\x11_lesson_price_rubB\x19\n" +
-	"\x17_lesson_connection_link\"\x97\x02\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\x129\n" +
+	"\x16lesson_connection_link\x18\x04 \x01(\tH\x00R\x14lessonConnectionLink\x88\x01\x01\x126\n" +
+	"\flesson_price\x18\x05 \x01(\v2\x0e.user.v1.MoneyH\x01R\vlessonPrice\x88\x01\x01B\x19\n" +
+	"\x17_lesson_connection_linkB\x0f\n" +
+	"\r_lesson_priceJ\x04\b\x03\x10\x04R\x10lesson_price_rub\"\xf0\x02\n" +
 	"\x19UpdateTutorStudentRequest\x12\x19\n" +
 	"\btutor_id\x18\x01 \x01(\tR\atutorId\x12\x1d\n" +
 	"\n" +
-	"student_id\x18\x02 \x01(\tR\tstudentId\x12-\n" +
-	"\x10lesson_price_rub\x18\x03 \x01(\x05H\x00R\x0elessonPriceRub\x88\x01\x01\x129\n" +
-	"\x16lesson_connection_link\x18\x04 \x01(\tH\x01R\x14lessonConnectionLink\x88\x01\x01\x12\x1b\n" +
-	"\x06status\x18\x05 \x01(\tH\x02R\x06status\x88\x01\x01B\x13\n" +
-	"\x11_lesson_price_rubB\x19\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\x129\n" +
+	"\x16lesson_connection_link\x18\x04 \x01(\tH\x00R\x14lessonConnectionLink\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x05 \x01(\tH\x01R\x06status\x88\x01\x01\x126\n" +
+	"\flesson_price\x18\x06 \x01(\v2\x0e.user.v1.MoneyH\x02R\vlessonPrice\x88\x01\x01\x12(\n" +
+	"\rstatus_reason\x18\a \x01(\tH\x03R\fstatusReason\x88\x01\x01B\x19\n" +
 	"\x17_lesson_connection_linkB\t\n" +
-	"\a_status\"U\n" +
+	"\a_statusB\x0f\n" +
+	"\r_lesson_priceB\x10\n" +
+	"\x0e_status_reasonJ\x04\b\x03\x10\x04R\x10lesson_price_rub\"U\n" +
 	"\x19DeleteTutorStudentRequest\x12\x19\n" +
 	"\btutor_id\x18\x01 \x01(\tR\atutorId\x12\x1d\n" +
 	"\n" +
-	"student_id\x18\x02 \x01(\tR\tstudentId\"5\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\"\x9b\x01\n" +
+	"\x1fChangeTutorStudentStatusRequest\x12\x19\n" +
+	"\btutor_id\x18\x01 \x01(\tR\atutorId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1b\n" +
+	"\x06reason\x18\x04 \x01(\tH\x00R\x06reason\x88\x01\x01B\t\n" +
+	"\a_reason\"_\n" +
+	"#ListTutorStudentStatusEventsRequest\x12\x19\n" +
+	"\btutor_id\x18\x01 \x01(\tR\atutorId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\"`\n" +
+	"$ListTutorStudentStatusEventsResponse\x128\n" +
+	"\x06events\x18\x01 \x03(\v2 .user.v1.TutorStudentStatusEventR\x06events\"5\n" +
 	"\x18ListTutorStudentsRequest\x12\x19\n" +
 	"\btutor_id\x18\x01 \x01(\tR\atutorId\"N\n" +
 	"\x19ListTutorStudentsResponse\x121\n" +
@@ -1447,18 +4770,131 @@ const file_user_service_proto_rawDesc = "" +
 	"!ResolveTutorStudentContextRequest\x12\x19\n" +
 	"\btutor_id\x18\x01 \x01(\tR\atutorId\x12\x1d\n" +
 	"\n" +
-	"student_id\x18\x02 \x01(\tR\tstudentId\"\xa1\x02\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\"\xbe\x02\n" +
 	"\x1bResolvedTutorStudentContext\x12/\n" +
-	"\x13relationship_status\x18\x02 \x01(\tR\x12relationshipStatus\x12-\n" +
-	"\x10lesson_price_rub\x18\x03 \x01(\x05H\x00R\x0elessonPriceRub\x88\x01\x01\x129\n" +
-	"\x16lesson_connection_link\x18\x04 \x01(\tH\x01R\x14lessonConnectionLink\x88\x01\x01\x12&\n" +
-	"\fpayment_info\x18\x05 \x01(\tH\x02R\vpaymentInfo\x88\x01\x01B\x13\n" +
-	"\x11_lesson_price_rubB\x19\n" +
+	"\x13relationship_status\x18\x02 \x01(\tR\x12relationshipStatus\x129\n" +
+	"\x16lesson_connection_link\x18\x04 \x01(\tH\x00R\x14lessonConnectionLink\x88\x01\x01\x12&\n" +
+	"\fpayment_info\x18\x05 \x01(\tH\x01R\vpaymentInfo\x88\x01\x01\x126\n" +
+	"\flesson_price\x18\x06 \x01(\v2\x0e.user.v1.MoneyH\x02R\vlessonPrice\x88\x01\x01B\x19\n" +
 	"\x17_lesson_connection_linkB\x0f\n" +
-	"\r_payment_info\"=\n" +
+	"\r_payment_infoB\x0f\n" +
+	"\r_lesson_priceJ\x04\b\x03\x10\x04R\x10lesson_price_rub\"=\n" +
 	" AcceptInvitationFromTutorRequest\x12\x19\n" +
-	"\btutor_id\x18\x01 \x01(\tR\atutorId\"\a\n" +
-	"\x05Empty\"\xec\x02\n" +
+	"\btutor_id\x18\x01 \x01(\tR\atutorId\"4\n" +
+	"\x17RejectInvitationRequest\x12\x19\n" +
+	"\btutor_id\x18\x01 \x01(\tR\atutorId\"\xcb\x02\n" +
+	"\x13CreateInviteRequest\x12\x19\n" +
+	"\btutor_id\x18\x01 \x01(\tR\atutorId\x126\n" +
+	"\flesson_price\x18\x02 \x01(\v2\x0e.user.v1.MoneyH\x00R\vlessonPrice\x88\x01\x01\x129\n" +
+	"\x16lesson_connection_link\x18\x03 \x01(\tH\x01R\x14lessonConnectionLink\x88\x01\x01\x12\x1e\n" +
+	"\bmax_uses\x18\x04 \x01(\x05H\x02R\amaxUses\x88\x01\x01\x12>\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x03R\texpiresAt\x88\x01\x01B\x0f\n" +
+	"\r_lesson_priceB\x19\n" +
+	"\x17_lesson_connection_linkB\v\n" +
+	"\t_max_usesB\r\n" +
+	"\v_expires_at\"/\n" +
+	"\x12ListInvitesRequest\x12\x19\n" +
+	"\btutor_id\x18\x01 \x01(\tR\atutorId\"@\n" +
+	"\x13ListInvitesResponse\x12)\n" +
+	"\ainvites\x18\x01 \x03(\v2\x0f.user.v1.InviteR\ainvites\"%\n" +
+	"\x13RevokeInviteRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"#\n" +
+	"\x11GetDataJobRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\")\n" +
+	"\x13RedeemInviteRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"^\n" +
+	"\x1cCreateGuardianStudentRequest\x12\x1f\n" +
+	"\vguardian_id\x18\x01 \x01(\tR\n" +
+	"guardianId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\"[\n" +
+	"\x19GetGuardianStudentRequest\x12\x1f\n" +
+	"\vguardian_id\x18\x01 \x01(\tR\n" +
+	"guardianId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\"^\n" +
+	"\x1cDeleteGuardianStudentRequest\x12\x1f\n" +
+	"\vguardian_id\x18\x01 \x01(\tR\n" +
+	"guardianId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\">\n" +
+	"\x1bListGuardianStudentsRequest\x12\x1f\n" +
+	"\vguardian_id\x18\x01 \x01(\tR\n" +
+	"guardianId\"?\n" +
+	"\x1eListGuardiansForStudentRequest\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tR\tstudentId\"e\n" +
+	"\x1cListGuardianStudentsResponse\x12E\n" +
+	"\x11guardian_students\x18\x01 \x03(\v2\x18.user.v1.GuardianStudentR\x10guardianStudents\"?\n" +
+	"\x1cAcceptGuardianRequestRequest\x12\x1f\n" +
+	"\vguardian_id\x18\x01 \x01(\tR\n" +
+	"guardianId\"\xa2\x01\n" +
+	"\x12SearchUsersRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x17\n" +
+	"\x04role\x18\x02 \x01(\tH\x00R\x04role\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x03 \x01(\tH\x01R\x06status\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x05 \x01(\x05R\x06offsetB\a\n" +
+	"\x05_roleB\t\n" +
+	"\a_status\":\n" +
+	"\x13SearchUsersResponse\x12#\n" +
+	"\x05users\x18\x01 \x03(\v2\r.user.v1.UserR\x05users\"U\n" +
+	"\x12SuspendUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\x06reason\x18\x02 \x01(\tH\x00R\x06reason\x88\x01\x01B\t\n" +
+	"\a_reason\"0\n" +
+	"\x15ReactivateUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"7\n" +
+	"\x1cListUserTutorStudentsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x9a\x01\n" +
+	"\x17RecordAuditEventRequest\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x1f\n" +
+	"\vtarget_type\x18\x02 \x01(\tR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x03 \x01(\tR\btargetId\x12\x1d\n" +
+	"\adetails\x18\x04 \x01(\tH\x00R\adetails\x88\x01\x01B\n" +
+	"\n" +
+	"\b_details\"\xa3\x01\n" +
+	"\x16ListAuditEventsRequest\x12\x1e\n" +
+	"\badmin_id\x18\x01 \x01(\tH\x00R\aadminId\x88\x01\x01\x12 \n" +
+	"\ttarget_id\x18\x02 \x01(\tH\x01R\btargetId\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offsetB\v\n" +
+	"\t_admin_idB\f\n" +
+	"\n" +
+	"_target_id\"F\n" +
+	"\x17ListAuditEventsResponse\x12+\n" +
+	"\x06events\x18\x01 \x03(\v2\x13.user.v1.AuditEventR\x06events\"\a\n" +
+	"\x05Empty\"F\n" +
+	"\x05Money\x12!\n" +
+	"\famount_minor\x18\x01 \x01(\x03R\vamountMinor\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\"\xff\x01\n" +
+	"\rSessionTokens\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12Q\n" +
+	"\x17access_token_expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x14accessTokenExpiresAt\x12#\n" +
+	"\rrefresh_token\x18\x03 \x01(\tR\frefreshToken\x12S\n" +
+	"\x18refresh_token_expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x15refreshTokenExpiresAt\"t\n" +
+	"\n" +
+	"SigningKey\x12\x10\n" +
+	"\x03kty\x18\x01 \x01(\tR\x03kty\x12\x10\n" +
+	"\x03crv\x18\x02 \x01(\tR\x03crv\x12\x10\n" +
+	"\x03alg\x18\x03 \x01(\tR\x03alg\x12\x10\n" +
+	"\x03use\x18\x04 \x01(\tR\x03use\x12\x10\n" +
+	"\x03kid\x18\x05 \x01(\tR\x03kid\x12\f\n" +
+	"\x01x\x18\x06 \x01(\tR\x01x\"8\n" +
+	"\rSigningKeySet\x12'\n" +
+	"\x04keys\x18\x01 \x03(\v2\x13.user.v1.SigningKeyR\x04keys\"@\n" +
+	"\x11OidcAuthorization\x12+\n" +
+	"\x11authorization_url\x18\x01 \x01(\tR\x10authorizationUrl\"\xb0\x01\n" +
+	"\bIdentity\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\x12\x18\n" +
+	"\asubject\x18\x03 \x01(\tR\asubject\x12\x19\n" +
+	"\x05email\x18\x04 \x01(\tH\x00R\x05email\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\b\n" +
+	"\x06_email\"\xec\x02\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04role\x18\x02 \x01(\tR\x04role\x12#\n" +
@@ -1484,49 +4920,206 @@ const file_user_service_proto_rawDesc = "" +
 	"\tlast_name\x18\x04 \x01(\tH\x01R\blastName\x88\x01\x01B\r\n" +
 	"\v_first_nameB\f\n" +
 	"\n" +
-	"_last_name\"\xfe\x02\n" +
+	"_last_name\"\x8e\x05\n" +
 	"\fTutorProfile\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12&\n" +
-	"\fpayment_info\x18\x03 \x01(\tH\x00R\vpaymentInfo\x88\x01\x01\x12-\n" +
-	"\x10lesson_price_rub\x18\x04 \x01(\x05H\x01R\x0elessonPriceRub\x88\x01\x01\x129\n" +
-	"\x16lesson_connection_link\x18\x05 \x01(\tH\x02R\x14lessonConnectionLink\x88\x01\x01\x129\n" +
+	"\fpayment_info\x18\x03 \x01(\tH\x00R\vpaymentInfo\x88\x01\x01\x129\n" +
+	"\x16lesson_connection_link\x18\x05 \x01(\tH\x01R\x14lessonConnectionLink\x88\x01\x01\x129\n" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x127\n" +
-	"\tedited_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\beditedAtB\x0f\n" +
-	"\r_payment_infoB\x13\n" +
-	"\x11_lesson_price_rubB\x19\n" +
-	"\x17_lesson_connection_link\"\xfe\x02\n" +
+	"\tedited_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\beditedAt\x126\n" +
+	"\flesson_price\x18\b \x01(\v2\x0e.user.v1.MoneyH\x02R\vlessonPrice\x88\x01\x01\x12\x1a\n" +
+	"\bcurrency\x18\t \x01(\tR\bcurrency\x12\x15\n" +
+	"\x03bio\x18\n" +
+	" \x01(\tH\x03R\x03bio\x88\x01\x01\x12\x1a\n" +
+	"\bsubjects\x18\v \x03(\tR\bsubjects\x12\x1c\n" +
+	"\tlanguages\x18\f \x03(\tR\tlanguages\x12!\n" +
+	"\fgrade_levels\x18\r \x03(\tR\vgradeLevels\x12)\n" +
+	"\x0eavatar_file_id\x18\x0e \x01(\tH\x04R\favatarFileId\x88\x01\x01\x12\x1b\n" +
+	"\tis_public\x18\x0f \x01(\bR\bisPublicB\x0f\n" +
+	"\r_payment_infoB\x19\n" +
+	"\x17_lesson_connection_linkB\x0f\n" +
+	"\r_lesson_priceB\x06\n" +
+	"\x04_bioB\x11\n" +
+	"\x0f_avatar_file_idJ\x04\b\x04\x10\x05R\x10lesson_price_rub\"\xb8\x04\n" +
+	"\x12TutorPublicProfile\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\"\n" +
+	"\n" +
+	"first_name\x18\x02 \x01(\tH\x00R\tfirstName\x88\x01\x01\x12 \n" +
+	"\tlast_name\x18\x03 \x01(\tH\x01R\blastName\x88\x01\x01\x12\x15\n" +
+	"\x03bio\x18\x04 \x01(\tH\x02R\x03bio\x88\x01\x01\x12\x1a\n" +
+	"\bsubjects\x18\x05 \x03(\tR\bsubjects\x12\x1c\n" +
+	"\tlanguages\x18\x06 \x03(\tR\tlanguages\x12!\n" +
+	"\fgrade_levels\x18\a \x03(\tR\vgradeLevels\x126\n" +
+	"\flesson_price\x18\b \x01(\v2\x0e.user.v1.MoneyH\x03R\vlessonPrice\x88\x01\x01\x12\x1a\n" +
+	"\bcurrency\x18\t \x01(\tR\bcurrency\x12)\n" +
+	"\x0eavatar_file_id\x18\n" +
+	" \x01(\tH\x04R\favatarFileId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"avatar_url\x18\v \x01(\tH\x05R\tavatarUrl\x88\x01\x01\x12&\n" +
+	"\fpayment_info\x18\f \x01(\tH\x06R\vpaymentInfo\x88\x01\x01\x12\x1b\n" +
+	"\tis_public\x18\r \x01(\bR\bisPublicB\r\n" +
+	"\v_first_nameB\f\n" +
+	"\n" +
+	"_last_nameB\x06\n" +
+	"\x04_bioB\x0f\n" +
+	"\r_lesson_priceB\x11\n" +
+	"\x0f_avatar_file_idB\r\n" +
+	"\v_avatar_urlB\x0f\n" +
+	"\r_payment_info\"\xf5\x04\n" +
 	"\fTutorStudent\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\btutor_id\x18\x02 \x01(\tR\atutorId\x12\x1d\n" +
 	"\n" +
-	"student_id\x18\x03 \x01(\tR\tstudentId\x12-\n" +
-	"\x10lesson_price_rub\x18\x04 \x01(\x05H\x00R\x0elessonPriceRub\x88\x01\x01\x129\n" +
-	"\x16lesson_connection_link\x18\x05 \x01(\tH\x01R\x14lessonConnectionLink\x88\x01\x01\x12\x16\n" +
+	"student_id\x18\x03 \x01(\tR\tstudentId\x129\n" +
+	"\x16lesson_connection_link\x18\x05 \x01(\tH\x00R\x14lessonConnectionLink\x88\x01\x01\x12\x16\n" +
 	"\x06status\x18\x06 \x01(\tR\x06status\x129\n" +
 	"\n" +
 	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x127\n" +
-	"\tedited_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\beditedAtB\x13\n" +
-	"\x11_lesson_price_rubB\x19\n" +
-	"\x17_lesson_connection_link2\xad\t\n" +
+	"\tedited_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\beditedAt\x126\n" +
+	"\flesson_price\x18\t \x01(\v2\x0e.user.v1.MoneyH\x01R\vlessonPrice\x88\x01\x01\x12(\n" +
+	"\rstatus_reason\x18\n" +
+	" \x01(\tH\x02R\fstatusReason\x88\x01\x01\x12<\n" +
+	"\tpaused_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampH\x03R\bpausedAt\x88\x01\x01\x12@\n" +
+	"\varchived_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampH\x04R\n" +
+	"archivedAt\x88\x01\x01B\x19\n" +
+	"\x17_lesson_connection_linkB\x0f\n" +
+	"\r_lesson_priceB\x10\n" +
+	"\x0e_status_reasonB\f\n" +
+	"\n" +
+	"_paused_atB\x0e\n" +
+	"\f_archived_atJ\x04\b\x04\x10\x05R\x10lesson_price_rub\"\x93\x02\n" +
+	"\x17TutorStudentStatusEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12(\n" +
+	"\x10tutor_student_id\x18\x02 \x01(\tR\x0etutorStudentId\x12\x1f\n" +
+	"\vfrom_status\x18\x03 \x01(\tR\n" +
+	"fromStatus\x12\x1b\n" +
+	"\tto_status\x18\x04 \x01(\tR\btoStatus\x12\x1b\n" +
+	"\x06reason\x18\x05 \x01(\tH\x00R\x06reason\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"changed_by\x18\x06 \x01(\tR\tchangedBy\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\t\n" +
+	"\a_reason\"\x8e\x04\n" +
+	"\x06Invite\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\btutor_id\x18\x02 \x01(\tR\atutorId\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12\x17\n" +
+	"\x04link\x18\x04 \x01(\tH\x00R\x04link\x88\x01\x01\x126\n" +
+	"\flesson_price\x18\x05 \x01(\v2\x0e.user.v1.MoneyH\x01R\vlessonPrice\x88\x01\x01\x129\n" +
+	"\x16lesson_connection_link\x18\x06 \x01(\tH\x02R\x14lessonConnectionLink\x88\x01\x01\x12\x1e\n" +
+	"\bmax_uses\x18\a \x01(\x05H\x03R\amaxUses\x88\x01\x01\x12\x12\n" +
+	"\x04uses\x18\b \x01(\x05R\x04uses\x129\n" +
+	"\n" +
+	"expires_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12>\n" +
+	"\n" +
+	"revoked_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampH\x04R\trevokedAt\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\a\n" +
+	"\x05_linkB\x0f\n" +
+	"\r_lesson_priceB\x19\n" +
+	"\x17_lesson_connection_linkB\v\n" +
+	"\t_max_usesB\r\n" +
+	"\v_revoked_at\"\xed\x01\n" +
+	"\x0fGuardianStudent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vguardian_id\x18\x02 \x01(\tR\n" +
+	"guardianId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x03 \x01(\tR\tstudentId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x127\n" +
+	"\tedited_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\beditedAt\"\xf3\x01\n" +
+	"\n" +
+	"AuditEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\badmin_id\x18\x02 \x01(\tR\aadminId\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x1f\n" +
+	"\vtarget_type\x18\x04 \x01(\tR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x05 \x01(\tR\btargetId\x12\x1d\n" +
+	"\adetails\x18\x06 \x01(\tH\x00R\adetails\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\n" +
+	"\n" +
+	"\b_details\"\xa9\x03\n" +
+	"\aDataJob\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x17\n" +
+	"\x04step\x18\x05 \x01(\tH\x00R\x04step\x88\x01\x01\x12)\n" +
+	"\x0eresult_file_id\x18\x06 \x01(\tH\x01R\fresultFileId\x88\x01\x01\x12\x19\n" +
+	"\x05error\x18\a \x01(\tH\x02R\x05error\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x127\n" +
+	"\tedited_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\beditedAt\x12@\n" +
+	"\vfinished_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampH\x03R\n" +
+	"finishedAt\x88\x01\x01B\a\n" +
+	"\x05_stepB\x11\n" +
+	"\x0f_result_file_idB\b\n" +
+	"\x06_errorB\x0e\n" +
+	"\f_finished_at2\xda\x1b\n" +
 	"\vUserService\x12I\n" +
 	"\x13RegisterViaTelegram\x12#.user.v1.RegisterViaTelegramRequest\x1a\r.user.v1.User\x12M\n" +
-	"\x15AuthorizeByAuthHeader\x12%.user.v1.AuthorizeByAuthHeaderRequest\x1a\r.user.v1.User\x12&\n" +
+	"\x15AuthorizeByAuthHeader\x12%.user.v1.AuthorizeByAuthHeaderRequest\x1a\r.user.v1.User\x12F\n" +
+	"\rCreateSession\x12\x1d.user.v1.CreateSessionRequest\x1a\x16.user.v1.SessionTokens\x12H\n" +
+	"\x0eRefreshSession\x12\x1e.user.v1.RefreshSessionRequest\x1a\x16.user.v1.SessionTokens\x12>\n" +
+	"\rRevokeSession\x12\x1d.user.v1.RevokeSessionRequest\x1a\x0e.user.v1.Empty\x12K\n" +
+	"\fCheckSession\x12\x1c.user.v1.CheckSessionRequest\x1a\x1d.user.v1.CheckSessionResponse\x128\n" +
+	"\x0eGetSigningKeys\x12\x0e.user.v1.Empty\x1a\x16.user.v1.SigningKeySet\x12F\n" +
+	"\x11RequestEmailLogin\x12!.user.v1.RequestEmailLoginRequest\x1a\x0e.user.v1.Empty\x12L\n" +
+	"\x10VerifyEmailLogin\x12 .user.v1.VerifyEmailLoginRequest\x1a\x16.user.v1.SessionTokens\x12L\n" +
+	"\x0eStartOidcLogin\x12\x1e.user.v1.StartOidcLoginRequest\x1a\x1a.user.v1.OidcAuthorization\x12N\n" +
+	"\x11CompleteOidcLogin\x12!.user.v1.CompleteOidcLoginRequest\x1a\x16.user.v1.SessionTokens\x12D\n" +
+	"\x10RequestEmailLink\x12 .user.v1.RequestEmailLinkRequest\x1a\x0e.user.v1.Empty\x12;\n" +
+	"\rStartOidcLink\x12\x0e.user.v1.Empty\x1a\x1a.user.v1.OidcAuthorization\x12C\n" +
+	"\x10ListMyIdentities\x12\x0e.user.v1.Empty\x1a\x1f.user.v1.ListIdentitiesResponse\x12@\n" +
+	"\x0eDeleteIdentity\x12\x1e.user.v1.DeleteIdentityRequest\x1a\x0e.user.v1.Empty\x12&\n" +
 	"\x05GetMe\x12\x0e.user.v1.Empty\x1a\r.user.v1.User\x127\n" +
 	"\aGetUser\x12\x17.user.v1.GetUserRequest\x1a\x13.user.v1.UserPublic\x127\n" +
 	"\n" +
-	"UpdateUser\x12\x1a.user.v1.UpdateUserRequest\x1a\r.user.v1.User\x12O\n" +
+	"UpdateUser\x12\x1a.user.v1.UpdateUserRequest\x1a\r.user.v1.User\x121\n" +
+	"\rDeleteAccount\x12\x0e.user.v1.Empty\x1a\x10.user.v1.DataJob\x120\n" +
+	"\fExportMyData\x12\x0e.user.v1.Empty\x1a\x10.user.v1.DataJob\x12:\n" +
+	"\n" +
+	"GetDataJob\x12\x1a.user.v1.GetDataJobRequest\x1a\x10.user.v1.DataJob\x12O\n" +
 	"\x12UpdateTutorProfile\x12\".user.v1.UpdateTutorProfileRequest\x1a\x15.user.v1.TutorProfile\x12Y\n" +
-	"\x17GetTutorProfileByUserId\x12'.user.v1.GetTutorProfileByUserIdRequest\x1a\x15.user.v1.TutorProfile\x12I\n" +
+	"\x17GetTutorProfileByUserId\x12'.user.v1.GetTutorProfileByUserIdRequest\x1a\x15.user.v1.TutorProfile\x12[\n" +
+	"\x15GetTutorPublicProfile\x12%.user.v1.GetTutorPublicProfileRequest\x1a\x1b.user.v1.TutorPublicProfile\x12K\n" +
+	"\fSearchTutors\x12\x1c.user.v1.SearchTutorsRequest\x1a\x1d.user.v1.SearchTutorsResponse\x12I\n" +
 	"\x0fGetTutorStudent\x12\x1f.user.v1.GetTutorStudentRequest\x1a\x15.user.v1.TutorStudent\x12O\n" +
 	"\x12CreateTutorStudent\x12\".user.v1.CreateTutorStudentRequest\x1a\x15.user.v1.TutorStudent\x12O\n" +
 	"\x12UpdateTutorStudent\x12\".user.v1.UpdateTutorStudentRequest\x1a\x15.user.v1.TutorStudent\x12H\n" +
-	"\x12DeleteTutorStudent\x12\".user.v1.DeleteTutorStudentRequest\x1a\x0e.user.v1.Empty\x12Z\n" +
+	"\x12DeleteTutorStudent\x12\".user.v1.DeleteTutorStudentRequest\x1a\x0e.user.v1.Empty\x12[\n" +
+	"\x18ChangeTutorStudentStatus\x12(.user.v1.ChangeTutorStudentStatusRequest\x1a\x15.user.v1.TutorStudent\x12{\n" +
+	"\x1cListTutorStudentStatusEvents\x12,.user.v1.ListTutorStudentStatusEventsRequest\x1a-.user.v1.ListTutorStudentStatusEventsResponse\x12Z\n" +
 	"\x11ListTutorStudents\x12!.user.v1.ListTutorStudentsRequest\x1a\".user.v1.ListTutorStudentsResponse\x12c\n" +
 	"\x14ListTutorsForStudent\x12$.user.v1.ListTutorsForStudentRequest\x1a%.user.v1.ListTutorsForStudentResponse\x12n\n" +
 	"\x1aResolveTutorStudentContext\x12*.user.v1.ResolveTutorStudentContextRequest\x1a$.user.v1.ResolvedTutorStudentContext\x12V\n" +
-	"\x19AcceptInvitationFromTutor\x12).user.v1.AcceptInvitationFromTutorRequest\x1a\x0e.user.v1.EmptyB\tZ\apkg/apib\x06proto3"
+	"\x19AcceptInvitationFromTutor\x12).user.v1.AcceptInvitationFromTutorRequest\x1a\x0e.user.v1.Empty\x12D\n" +
+	"\x10RejectInvitation\x12 .user.v1.RejectInvitationRequest\x1a\x0e.user.v1.Empty\x12=\n" +
+	"\fCreateInvite\x12\x1c.user.v1.CreateInviteRequest\x1a\x0f.user.v1.Invite\x12H\n" +
+	"\vListInvites\x12\x1b.user.v1.ListInvitesRequest\x1a\x1c.user.v1.ListInvitesResponse\x12=\n" +
+	"\fRevokeInvite\x12\x1c.user.v1.RevokeInviteRequest\x1a\x0f.user.v1.Invite\x12C\n" +
+	"\fRedeemInvite\x12\x1c.user.v1.RedeemInviteRequest\x1a\x15.user.v1.TutorStudent\x12X\n" +
+	"\x15CreateGuardianStudent\x12%.user.v1.CreateGuardianStudentRequest\x1a\x18.user.v1.GuardianStudent\x12R\n" +
+	"\x12GetGuardianStudent\x12\".user.v1.GetGuardianStudentRequest\x1a\x18.user.v1.GuardianStudent\x12N\n" +
+	"\x15DeleteGuardianStudent\x12%.user.v1.DeleteGuardianStudentRequest\x1a\x0e.user.v1.Empty\x12c\n" +
+	"\x14ListGuardianStudents\x12$.user.v1.ListGuardianStudentsRequest\x1a%.user.v1.ListGuardianStudentsResponse\x12i\n" +
+	"\x17ListGuardiansForStudent\x12'.user.v1.ListGuardiansForStudentRequest\x1a%.user.v1.ListGuardianStudentsResponse\x12N\n" +
+	"\x15AcceptGuardianRequest\x12%.user.v1.AcceptGuardianRequestRequest\x1a\x0e.user.v1.Empty2\xd4\x03\n" +
+	"\fAdminService\x12H\n" +
+	"\vSearchUsers\x12\x1b.user.v1.SearchUsersRequest\x1a\x1c.user.v1.SearchUsersResponse\x129\n" +
+	"\vSuspendUser\x12\x1b.user.v1.SuspendUserRequest\x1a\r.user.v1.User\x12?\n" +
+	"\x0eReactivateUser\x12\x1e.user.v1.ReactivateUserRequest\x1a\r.user.v1.User\x12b\n" +
+	"\x15ListUserTutorStudents\x12%.user.v1.ListUserTutorStudentsRequest\x1a\".user.v1.ListTutorStudentsResponse\x12D\n" +
+	"\x10RecordAuditEvent\x12 .user.v1.RecordAuditEventRequest\x1a\x0e.user.v1.Empty\x12T\n" +
+	"\x0fListAuditEvents\x12\x1f.user.v1.ListAuditEventsRequest\x1a .user.v1.ListAuditEventsResponseB\tZ\apkg/apib\x06proto3"
 
 var (
 	file_user_service_proto_rawDescOnce sync.Once
@@ -1540,76 +5133,241 @@ func file_user_service_proto_rawDescGZIP() []byte {
 	return file_user_service_proto_rawDescData
 }
 
-var file_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 75)
 var file_user_service_proto_goTypes = []any{
-	(*RegisterViaTelegramRequest)(nil),        // 0: user.v1.RegisterViaTelegramRequest
-	(*AuthorizeByAuthHeaderRequest)(nil),      // 1: user.v1.AuthorizeByAuthHeaderRequest
-	(*GetUserRequest)(nil),                    // 2: user.v1.GetUserRequest
-	(*UpdateUserRequest)(nil),                 // 3: user.v1.UpdateUserRequest
-	(*GetTutorProfileByUserIdRequest)(nil),    // 4: user.v1.GetTutorProfileByUserIdRequest
-	(*UpdateTutorProfileRequest)(nil),         // 5: user.v1.UpdateTutorProfileRequest
-	(*GetTutorStudentRequest)(nil),            // 6: user.v1.GetTutorStudentRequest
-	(*CreateTutorStudentRequest)(nil),         // 7: user.v1.CreateTutorStudentRequest
-	(*UpdateTutorStudentRequest)(nil),         // 8: user.v1.UpdateTutorStudentRequest
-	(*DeleteTutorStudentRequest)(nil),         // 9: user.v1.DeleteTutorStudentRequest
-	(*ListTutorStudentsRequest)(nil),          // 10: user.v1.ListTutorStudentsRequest
-	(*ListTutorStudentsResponse)(nil),         // 11: user.v1.ListTutorStudentsResponse
-	(*ListTutorsForStudentRequest)(nil),       // 12: user.v1.ListTutorsForStudentRequest
-	(*ListTutorsForStudentResponse)(nil),      // 13: user.v1.ListTutorsForStudentResponse
-	(*ResolveTutorStudentContextRequest)(nil), // 14: user.v1.ResolveTutorStudentContextRequest
-	(*ResolvedTutorStudentContext)(nil),       // 15: user.v1.ResolvedTutorStudentContext
-	(*AcceptInvitationFromTutorRequest)(nil),  // 16: user.v1.AcceptInvitationFromTutorRequest
-	(*Empty)(nil),                             // 17: user.v1.Empty
-	(*User)(nil),                              // 18: user.v1.User
-	(*UserPublic)(nil),                        // 19: user.v1.UserPublic
-	(*TutorProfile)(nil),                      // 20: user.v1.TutorProfile
-	(*TutorStudent)(nil),                      // 21: user.v1.TutorStudent
-	(*timestamppb.Timestamp)(nil),             // 22: google.protobuf.Timestamp
+	(*RegisterViaTelegramRequest)(nil),           // 0: user.v1.RegisterViaTelegramRequest
+	(*AuthorizeByAuthHeaderRequest)(nil),         // 1: user.v1.AuthorizeByAuthHeaderRequest
+	(*CreateSessionRequest)(nil),                 // 2: user.v1.CreateSessionRequest
+	(*RefreshSessionRequest)(nil),                // 3: user.v1.RefreshSessionRequest
+	(*RevokeSessionRequest)(nil),                 // 4: user.v1.RevokeSessionRequest
+	(*CheckSessionRequest)(nil),                  // 5: user.v1.CheckSessionRequest
+	(*CheckSessionResponse)(nil),                 // 6: user.v1.CheckSessionResponse
+	(*RequestEmailLoginRequest)(nil),             // 7: user.v1.RequestEmailLoginRequest
+	(*VerifyEmailLoginRequest)(nil),              // 8: user.v1.VerifyEmailLoginRequest
+	(*StartOidcLoginRequest)(nil),                // 9: user.v1.StartOidcLoginRequest
+	(*CompleteOidcLoginRequest)(nil),             // 10: user.v1.CompleteOidcLoginRequest
+	(*RequestEmailLinkRequest)(nil),              // 11: user.v1.RequestEmailLinkRequest
+	(*ListIdentitiesResponse)(nil),               // 12: user.v1.ListIdentitiesResponse
+	(*DeleteIdentityRequest)(nil),                // 13: user.v1.DeleteIdentityRequest
+	(*GetUserRequest)(nil),                       // 14: user.v1.GetUserRequest
+	(*UpdateUserRequest)(nil),                    // 15: user.v1.UpdateUserRequest
+	(*GetTutorProfileByUserIdRequest)(nil),       // 16: user.v1.GetTutorProfileByUserIdRequest
+	(*GetTutorPublicProfileRequest)(nil),         // 17: user.v1.GetTutorPublicProfileRequest
+	(*SearchTutorsRequest)(nil),                  // 18: user.v1.SearchTutorsRequest
+	(*SearchTutorsResponse)(nil),                 // 19: user.v1.SearchTutorsResponse
+	(*StringList)(nil),                           // 20: user.v1.StringList
+	(*UpdateTutorProfileRequest)(nil),            // 21: user.v1.UpdateTutorProfileRequest
+	(*GetTutorStudentRequest)(nil),               // 22: user.v1.GetTutorStudentRequest
+	(*CreateTutorStudentRequest)(nil),            // 23: user.v1.CreateTutorStudentRequest
+	(*UpdateTutorStudentRequest)(nil),            // 24: user.v1.UpdateTutorStudentRequest
+	(*DeleteTutorStudentRequest)(nil),            // 25: user.v1.DeleteTutorStudentRequest
+	(*ChangeTutorStudentStatusRequest)(nil),      // 26: user.v1.ChangeTutorStudentStatusRequest
+	(*ListTutorStudentStatusEventsRequest)(nil),  // 27: user.v1.ListTutorStudentStatusEventsRequest
+	(*ListTutorStudentStatusEventsResponse)(nil), // 28: user.v1.ListTutorStudentStatusEventsResponse
+	(*ListTutorStudentsRequest)(nil),             // 29: user.v1.ListTutorStudentsRequest
+	(*ListTutorStudentsResponse)(nil),            // 30: user.v1.ListTutorStudentsResponse
+	(*ListTutorsForStudentRequest)(nil),          // 31: user.v1.ListTutorsForStudentRequest
+	(*ListTutorsForStudentResponse)(nil),         // 32: user.v1.ListTutorsForStudentResponse
+	(*ResolveTutorStudentContextRequest)(nil),    // 33: user.v1.ResolveTutorStudentContextRequest
+	(*ResolvedTutorStudentContext)(nil),          // 34: user.v1.ResolvedTutorStudentContext
+	(*AcceptInvitationFromTutorRequest)(nil),     // 35: user.v1.AcceptInvitationFromTutorRequest
+	(*RejectInvitationRequest)(nil),              // 36: user.v1.RejectInvitationRequest
+	(*CreateInviteRequest)(nil),                  // 37: user.v1.CreateInviteRequest
+	(*ListInvitesRequest)(nil),                   // 38: user.v1.ListInvitesRequest
+	(*ListInvitesResponse)(nil),                  // 39: user.v1.ListInvitesResponse
+	(*RevokeInviteRequest)(nil),                  // 40: user.v1.RevokeInviteRequest
+	(*GetDataJobRequest)(nil),                    // 41: user.v1.GetDataJobRequest
+	(*RedeemInviteRequest)(nil),                  // 42: user.v1.RedeemInviteRequest
+	(*CreateGuardianStudentRequest)(nil),         // 43: user.v1.CreateGuardianStudentRequest
+	(*GetGuardianStudentRequest)(nil),            // 44: user.v1.GetGuardianStudentRequest
+	(*DeleteGuardianStudentRequest)(nil),         // 45: user.v1.DeleteGuardianStudentRequest
+	(*ListGuardianStudentsRequest)(nil),          // 46: user.v1.ListGuardianStudentsRequest
+	(*ListGuardiansForStudentRequest)(nil),       // 47: user.v1.ListGuardiansForStudentRequest
+	(*ListGuardianStudentsResponse)(nil),         // 48: user.v1.ListGuardianStudentsResponse
+	(*AcceptGuardianRequestRequest)(nil),         // 49: user.v1.AcceptGuardianRequestRequest
+	(*SearchUsersRequest)(nil),                   // 50: user.v1.SearchUsersRequest
+	(*SearchUsersResponse)(nil),                  // 51: user.v1.SearchUsersResponse
+	(*SuspendUserRequest)(nil),                   // 52: user.v1.SuspendUserRequest
+	(*ReactivateUserRequest)(nil),                // 53: user.v1.ReactivateUserRequest
+	(*ListUserTutorStudentsRequest)(nil),         // 54: user.v1.ListUserTutorStudentsRequest
+	(*RecordAuditEventRequest)(nil),              // 55: user.v1.RecordAuditEventRequest
+	(*ListAuditEventsRequest)(nil),               // 56: user.v1.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil),              // 57: user.v1.ListAuditEventsResponse
+	(*Empty)(nil),                                // 58: user.v1.Empty
+	(*Money)(nil),                                // 59: user.v1.Money
+	(*SessionTokens)(nil),                        // 60: user.v1.SessionTokens
+	(*SigningKey)(nil),                           // 61: user.v1.SigningKey
+	(*SigningKeySet)(nil),                        // 62: user.v1.SigningKeySet
+	(*OidcAuthorization)(nil),                    // 63: user.v1.OidcAuthorization
+	(*Identity)(nil),                             // 64: user.v1.Identity
+	(*User)(nil),                                 // 65: user.v1.User
+	(*UserPublic)(nil),                           // 66: user.v1.UserPublic
+	(*TutorProfile)(nil),                         // 67: user.v1.TutorProfile
+	(*TutorPublicProfile)(nil),                   // 68: user.v1.TutorPublicProfile
+	(*TutorStudent)(nil),                         // 69: user.v1.TutorStudent
+	(*TutorStudentStatusEvent)(nil),              // 70: user.v1.TutorStudentStatusEvent
+	(*Invite)(nil),                               // 71: user.v1.Invite
+	(*GuardianStudent)(nil),                      // 72: user.v1.GuardianStudent
+	(*AuditEvent)(nil),                           // 73: user.v1.AuditEvent
+	(*DataJob)(nil),                              // 74: user.v1.DataJob
+	(*timestamppb.Timestamp)(nil),                // 75: google.protobuf.Timestamp
 }
 var file_user_service_proto_depIdxs = []int32{
-	21, // 0: user.v1.ListTutorStudentsResponse.students:type_name -> user.v1.TutorStudent
-	21, // 1: user.v1.ListTutorsForStudentResponse.tutors:type_name -> user.v1.TutorStudent
-	22, // 2: user.v1.User.created_at:type_name -> google.protobuf.Timestamp
-	22, // 3: user.v1.User.edited_at:type_name -> google.protobuf.Timestamp
-	22, // 4: user.v1.TutorProfile.created_at:type_name -> google.protobuf.Timestamp
-	22, // 5: user.v1.TutorProfile.edited_at:type_name -> google.protobuf.Timestamp
-	22, // 6: user.v1.TutorStudent.created_at:type_name -> google.protobuf.Timestamp
-	22, // 7: user.v1.TutorStudent.edited_at:type_name -> google.protobuf.Timestamp
-	0,  // 8: user.v1.UserService.RegisterViaTelegram:input_type -> user.v1.RegisterViaTelegramRequest
-	1,  // 9: user.v1.UserService.AuthorizeByAuthHeader:input_type -> user.v1.AuthorizeByAuthHeaderRequest
-	17, // 10: user.v1.UserService.GetMe:input_type -> user.v1.Empty
-	2,  // 11: user.v1.UserService.GetUser:input_type -> user.v1.GetUserRequest
-	3,  // 12: user.v1.UserService.UpdateUser:input_type -> user.v1.UpdateUserRequest
-	5,  // 13: user.v1.UserService.UpdateTutorProfile:input_type -> user.v1.UpdateTutorProfileRequest
-	4,  // 14: user.v1.UserService.GetTutorProfileByUserId:input_type -> user.v1.GetTutorProfileByUserIdRequest
-	6,  // 15: user.v1.UserService.GetTutorStudent:input_type -> user.v1.GetTutorStudentRequest
-	7,  // 16: user.v1.UserService.CreateTutorStudent:input_type -> user.v1.CreateTutorStudentRequest
-	8,  // 17: user.v1.UserService.UpdateTutorStudent:input_type -> user.v1.UpdateTutorStudentRequest
-	9,  // 18: user.v1.UserService.DeleteTutorStudent:input_type -> user.v1.DeleteTutorStudentRequest
-	10, // 19: user.v1.UserService.ListTutorStudents:input_type -> user.v1.ListTutorStudentsRequest
-	12, // 20: user.v1.UserService.ListTutorsForStudent:input_type -> user.v1.ListTutorsForStudentRequest
-	14, // 21: user.v1.UserService.ResolveTutorStudentContext:input_type -> user.v1.ResolveTutorStudentContextRequest
-	16, // 22: user.v1.UserService.AcceptInvitationFromTutor:input_type -> user.v1.AcceptInvitationFromTutorRequest
-	18, // 23: user.v1.UserService.RegisterViaTelegram:output_type -> user.v1.User
-	18, // 24: user.v1.UserService.AuthorizeByAuthHeader:output_type -> user.v1.User
-	18, // 25: user.v1.UserService.GetMe:output_type -> user.v1.User
-	19, // 26: user.v1.UserService.GetUser:output_type -> user.v1.UserPublic
-	18, // 27: user.v1.UserService.UpdateUser:output_type -> user.v1.User
-	20, // 28: user.v1.UserService.UpdateTutorProfile:output_type -> user.v1.TutorProfile
-	20, // 29: user.v1.UserService.GetTutorProfileByUserId:output_type -> user.v1.TutorProfile
-	21, // 30: user.v1.UserService.GetTutorStudent:output_type -> user.v1.TutorStudent
-	21, // 31: user.v1.UserService.CreateTutorStudent:output_type -> user.v1.TutorStudent
-	21, // 32: user.v1.UserService.UpdateTutorStudent:output_type -> user.v1.TutorStudent
-	17, // 33: user.v1.UserService.DeleteTutorStudent:output_type -> user.v1.Empty
-	11, // 34: user.v1.UserService.ListTutorStudents:output_type -> user.v1.ListTutorStudentsResponse
-	13, // 35: user.v1.UserService.ListTutorsForStudent:output_type -> user.v1.ListTutorsForStudentResponse
-	15, // 36: user.v1.UserService.ResolveTutorStudentContext:output_type -> user.v1.ResolvedTutorStudentContext
-	17, // 37: user.v1.UserService.AcceptInvitationFromTutor:output_type -> user.v1.Empty
-	23, // [23:38] is the sub-list for method output_type
-	8,  // [8:23] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	64, // 0: user.v1.ListIdentitiesResponse.identities:type_name -> user.v1.Identity
+	59, // 1: user.v1.SearchTutorsRequest.min_price:type_name -> user.v1.Money
+	59, // 2: user.v1.SearchTutorsRequest.max_price:type_name -> user.v1.Money
+	68, // 3: user.v1.SearchTutorsResponse.tutors:type_name -> user.v1.TutorPublicProfile
+	59, // 4: user.v1.UpdateTutorProfileRequest.lesson_price:type_name -> user.v1.Money
+	20, // 5: user.v1.UpdateTutorProfileRequest.subjects:type_name -> user.v1.StringList
+	20, // 6: user.v1.UpdateTutorProfileRequest.languages:type_name -> user.v1.StringList
+	20, // 7: user.v1.UpdateTutorProfileRequest.grade_levels:type_name -> user.v1.StringList
+	59, // 8: user.v1.CreateTutorStudentRequest.lesson_price:type_name -> user.v1.Money
+	59, // 9: user.v1.UpdateTutorStudentRequest.lesson_price:type_name -> user.v1.Money
+	70, // 10: user.v1.ListTutorStudentStatusEventsResponse.events:type_name -> user.v1.TutorStudentStatusEvent
+	69, // 11: user.v1.ListTutorStudentsResponse.students:type_name -> user.v1.TutorStudent
+	69, // 12: user.v1.ListTutorsForStudentResponse.tutors:type_name -> user.v1.TutorStudent
+	59, // 13: user.v1.ResolvedTutorStudentContext.lesson_price:type_name -> user.v1.Money
+	59, // 14: user.v1.CreateInviteRequest.lesson_price:type_name -> user.v1.Money
+	75, // 15: user.v1.CreateInviteRequest.expires_at:type_name -> google.protobuf.Timestamp
+	71, // 16: user.v1.ListInvitesResponse.invites:type_name -> user.v1.Invite
+	72, // 17: user.v1.ListGuardianStudentsResponse.guardian_students:type_name -> user.v1.GuardianStudent
+	65, // 18: user.v1.SearchUsersResponse.users:type_name -> user.v1.User
+	73, // 19: user.v1.ListAuditEventsResponse.events:type_name -> user.v1.AuditEvent
+	75, // 20: user.v1.SessionTokens.access_token_expires_at:type_name -> google.protobuf.Timestamp
+	75, // 21: user.v1.SessionTokens.refresh_token_expires_at:type_name -> google.protobuf.Timestamp
+	61, // 22: user.v1.SigningKeySet.keys:type_name -> user.v1.SigningKey
+	75, // 23: user.v1.Identity.created_at:type_name -> google.protobuf.Timestamp
+	75, // 24: user.v1.User.created_at:type_name -> google.protobuf.Timestamp
+	75, // 25: user.v1.User.edited_at:type_name -> google.protobuf.Timestamp
+	75, // 26: user.v1.TutorProfile.created_at:type_name -> google.protobuf.Timestamp
+	75, // 27: user.v1.TutorProfile.edited_at:type_name -> google.protobuf.Timestamp
+	59, // 28: user.v1.TutorProfile.lesson_price:type_name -> user.v1.Money
+	59, // 29: user.v1.TutorPublicProfile.lesson_price:type_name -> user.v1.Money
+	75, // 30: user.v1.TutorStudent.created_at:type_name -> google.protobuf.Timestamp
+	75, // 31: user.v1.TutorStudent.edited_at:type_name -> google.protobuf.Timestamp
+	59, // 32: user.v1.TutorStudent.lesson_price:type_name -> user.v1.Money
+	75, // 33: user.v1.TutorStudent.paused_at:type_name -> google.protobuf.Timestamp
+	75, // 34: user.v1.TutorStudent.archived_at:type_name -> google.protobuf.Timestamp
+	75, // 35: user.v1.TutorStudentStatusEvent.created_at:type_name -> google.protobuf.Timestamp
+	59, // 36: user.v1.Invite.lesson_price:type_name -> user.v1.Money
+	75, // 37: user.v1.Invite.expires_at:type_name -> google.protobuf.Timestamp
+	75, // 38: user.v1.Invite.revoked_at:type_name -> google.protobuf.Timestamp
+	75, // 39: user.v1.Invite.created_at:type_name -> google.protobuf.Timestamp
+	75, // 40: user.v1.GuardianStudent.created_at:type_name -> google.protobuf.Timestamp
+	75, // 41: user.v1.GuardianStudent.edited_at:type_name -> google.protobuf.Timestamp
+	75, // 42: user.v1.AuditEvent.created_at:type_name -> google.protobuf.Timestamp
+	75, // 43: user.v1.DataJob.created_at:type_name -> google.protobuf.Timestamp
+	75, // 44: user.v1.DataJob.edited_at:type_name -> google.protobuf.Timestamp
+	75, // 45: user.v1.DataJob.finished_at:type_name -> google.protobuf.Timestamp
+	0,  // 46: user.v1.UserService.RegisterViaTelegram:input_type -> user.v1.RegisterViaTelegramRequest
+	1,  // 47: user.v1.UserService.AuthorizeByAuthHeader:input_type -> user.v1.AuthorizeByAuthHeaderRequest
+	2,  // 48: user.v1.UserService.CreateSession:input_type -> user.v1.CreateSessionRequest
+	3,  // 49: user.v1.UserService.RefreshSession:input_type -> user.v1.RefreshSessionRequest
+	4,  // 50: user.v1.UserService.RevokeSession:input_type -> user.v1.RevokeSessionRequest
+	5,  // 51: user.v1.UserService.CheckSession:input_type -> user.v1.CheckSessionRequest
+	58, // 52: user.v1.UserService.GetSigningKeys:input_type -> user.v1.Empty
+	7,  // 53: user.v1.UserService.RequestEmailLogin:input_type -> user.v1.RequestEmailLoginRequest
+	8,  // 54: user.v1.UserService.VerifyEmailLogin:input_type -> user.v1.VerifyEmailLoginRequest
+	9,  // 55: user.v1.UserService.StartOidcLogin:input_type -> user.v1.StartOidcLoginRequest
+	10, // 56: user.v1.UserService.CompleteOidcLogin:input_type -> user.v1.CompleteOidcLoginRequest
+	11, // 57: user.v1.UserService.RequestEmailLink:input_type -> user.v1.RequestEmailLinkRequest
+	58, // 58: user.v1.UserService.StartOidcLink:input_type -> user.v1.Empty
+	58, // 59: user.v1.UserService.ListMyIdentities:input_type -> user.v1.Empty
+	13, // 60: user.v1.UserService.DeleteIdentity:input_type -> user.v1.DeleteIdentityRequest
+	58, // 61: user.v1.UserService.GetMe:input_type -> user.v1.Empty
+	14, // 62: user.v1.UserService.GetUser:input_type -> user.v1.GetUserRequest
+	15, // 63: user.v1.UserService.UpdateUser:input_type -> user.v1.UpdateUserRequest
+	58, // 64: user.v1.UserService.DeleteAccount:input_type -> user.v1.Empty
+	58, // 65: user.v1.UserService.ExportMyData:input_type -> user.v1.Empty
+	41, // 66: user.v1.UserService.GetDataJob:input_type -> user.v1.GetDataJobRequest
+	21, // 67: user.v1.UserService.UpdateTutorProfile:input_type -> user.v1.UpdateTutorProfileRequest
+	16, // 68: user.v1.UserService.GetTutorProfileByUserId:input_type -> user.v1.GetTutorProfileByUserIdRequest
+	17, // 69: user.v1.UserService.GetTutorPublicProfile:input_type -> user.v1.GetTutorPublicProfileRequest
+	18, // 70: user.v1.UserService.SearchTutors:input_type -> user.v1.SearchTutorsRequest
+	22, // 71: user.v1.UserService.GetTutorStudent:input_type -> user.v1.GetTutorStudentRequest
+	23, // 72: user.v1.UserService.CreateTutorStudent:input_type -> user.v1.CreateTutorStudentRequest
+	24, // 73: user.v1.UserService.UpdateTutorStudent:input_type -> user.v1.UpdateTutorStudentRequest
+	25, // 74: user.v1.UserService.DeleteTutorStudent:input_type -> user.v1.DeleteTutorStudentRequest
+	26, // 75: user.v1.UserService.ChangeTutorStudentStatus:input_type -> user.v1.ChangeTutorStudentStatusRequest
+	27, // 76: user.v1.UserService.ListTutorStudentStatusEvents:input_type -> user.v1.ListTutorStudentStatusEventsRequest
+	29, // 77: user.v1.UserService.ListTutorStudents:input_type -> user.v1.ListTutorStudentsRequest
+	31, // 78: user.v1.UserService.ListTutorsForStudent:input_type -> user.v1.ListTutorsForStudentRequest
+	33, // 79: user.v1.UserService.ResolveTutorStudentContext:input_type -> user.v1.ResolveTutorStudentContextRequest
+	35, // 80: user.v1.UserService.AcceptInvitationFromTutor:input_type -> user.v1.AcceptInvitationFromTutorRequest
+	36, // 81: user.v1.UserService.RejectInvitation:input_type -> user.v1.RejectInvitationRequest
+	37, // 82: user.v1.UserService.CreateInvite:input_type -> user.v1.CreateInviteRequest
+	38, // 83: user.v1.UserService.ListInvites:input_type -> user.v1.ListInvitesRequest
+	40, // 84: user.v1.UserService.RevokeInvite:input_type -> user.v1.RevokeInviteRequest
+	42, // 85: user.v1.UserService.RedeemInvite:input_type -> user.v1.RedeemInviteRequest
+	43, // 86: user.v1.UserService.CreateGuardianStudent:input_type -> user.v1.CreateGuardianStudentRequest
+	44, // 87: user.v1.UserService.GetGuardianStudent:input_type -> user.v1.GetGuardianStudentRequest
+	45, // 88: user.v1.UserService.DeleteGuardianStudent:input_type -> user.v1.DeleteGuardianStudentRequest
+	46, // 89: user.v1.UserService.ListGuardianStudents:input_type -> user.v1.ListGuardianStudentsRequest
+	47, // 90: user.v1.UserService.ListGuardiansForStudent:input_type -> user.v1.ListGuardiansForStudentRequest
+	49, // 91: user.v1.UserService.AcceptGuardianRequest:input_type -> user.v1.AcceptGuardianRequestRequest
+	50, // 92: user.v1.AdminService.SearchUsers:input_type -> user.v1.SearchUsersRequest
+	52, // 93: user.v1.AdminService.SuspendUser:input_type -> user.v1.SuspendUserRequest
+	53, // 94: user.v1.AdminService.ReactivateUser:input_type -> user.v1.ReactivateUserRequest
+	54, // 95: user.v1.AdminService.ListUserTutorStudents:input_type -> user.v1.ListUserTutorStudentsRequest
+	55, // 96: user.v1.AdminService.RecordAuditEvent:input_type -> user.v1.RecordAuditEventRequest
+	56, // 97: user.v1.AdminService.ListAuditEvents:input_type -> user.v1.ListAuditEventsRequest
+	65, // 98: user.v1.UserService.RegisterViaTelegram:output_type -> user.v1.User
+	65, // 99: user.v1.UserService.AuthorizeByAuthHeader:output_type -> user.v1.User
+	60, // 100: user.v1.UserService.CreateSession:output_type -> user.v1.SessionTokens
+	60, // 101: user.v1.UserService.RefreshSession:output_type -> user.v1.SessionTokens
+	58, // 102: user.v1.UserService.RevokeSession:output_type -> user.v1.Empty
+	6,  // 103: user.v1.UserService.CheckSession:output_type -> user.v1.CheckSessionResponse
+	62, // 104: user.v1.UserService.GetSigningKeys:output_type -> user.v1.SigningKeySet
+	58, // 105: user.v1.UserService.RequestEmailLogin:output_type -> user.v1.Empty
+	60, // 106: user.v1.UserService.VerifyEmailLogin:output_type -> user.v1.SessionTokens
+	63, // 107: user.v1.UserService.StartOidcLogin:output_type -> user.v1.OidcAuthorization
+	60, // 108: user.v1.UserService.CompleteOidcLogin:output_type -> user.v1.SessionTokens
+	58, // 109: user.v1.UserService.RequestEmailLink:output_type -> user.v1.Empty
+	63, // 110: user.v1.UserService.StartOidcLink:output_type -> user.v1.OidcAuthorization
+	12, // 111: user.v1.UserService.ListMyIdentities:output_type -> user.v1.ListIdentitiesResponse
+	58, // 112: user.v1.UserService.DeleteIdentity:output_type -> user.v1.Empty
+	65, // 113: user.v1.UserService.GetMe:output_type -> user.v1.User
+	66, // 114: user.v1.UserService.GetUser:output_type -> user.v1.UserPublic
+	65, // 115: user.v1.UserService.UpdateUser:output_type -> user.v1.User
+	74, // 116: user.v1.UserService.DeleteAccount:output_type -> user.v1.DataJob
+	74, // 117: user.v1.UserService.ExportMyData:output_type -> user.v1.DataJob
+	74, // 118: user.v1.UserService.GetDataJob:output_type -> user.v1.DataJob
+	67, // 119: user.v1.UserService.UpdateTutorProfile:output_type -> user.v1.TutorProfile
+	67, // 120: user.v1.UserService.GetTutorProfileByUserId:output_type -> user.v1.TutorProfile
+	68, // 121: user.v1.UserService.GetTutorPublicProfile:output_type -> user.v1.TutorPublicProfile
+	19, // 122: user.v1.UserService.SearchTutors:output_type -> user.v1.SearchTutorsResponse
+	69, // 123: user.v1.UserService.GetTutorStudent:output_type -> user.v1.TutorStudent
+	69, // 124: user.v1.UserService.CreateTutorStudent:output_type -> user.v1.TutorStudent
+	69, // 125: user.v1.UserService.UpdateTutorStudent:output_type -> user.v1.TutorStudent
+	58, // 126: user.v1.UserService.DeleteTutorStudent:output_type -> user.v1.Empty
+	69, // 127: user.v1.UserService.ChangeTutorStudentStatus:output_type -> user.v1.TutorStudent
+	28, // 128: user.v1.UserService.ListTutorStudentStatusEvents:output_type -> user.v1.ListTutorStudentStatusEventsResponse
+	30, // 129: user.v1.UserService.ListTutorStudents:output_type -> user.v1.ListTutorStudentsResponse
+	32, // 130: user.v1.UserService.ListTutorsForStudent:output_type -> user.v1.ListTutorsForStudentResponse
+	34, // 131: user.v1.UserService.ResolveTutorStudentContext:output_type -> user.v1.ResolvedTutorStudentContext
+	58, // 132: user.v1.UserService.AcceptInvitationFromTutor:output_type -> user.v1.Empty
+	58, // 133: user.v1.UserService.RejectInvitation:output_type -> user.v1.Empty
+	71, // 134: user.v1.UserService.CreateInvite:output_type -> user.v1.Invite
+	39, // 135: user.v1.UserService.ListInvites:output_type -> user.v1.ListInvitesResponse
+	71, // 136: user.v1.UserService.RevokeInvite:output_type -> user.v1.Invite
+	69, // 137: user.v1.UserService.RedeemInvite:output_type -> user.v1.TutorStudent
+	72, // 138: user.v1.UserService.CreateGuardianStudent:output_type -> user.v1.GuardianStudent
+	72, // 139: user.v1.UserService.GetGuardianStudent:output_type -> user.v1.GuardianStudent
+	58, // 140: user.v1.UserService.DeleteGuardianStudent:output_type -> user.v1.Empty
+	48, // 141: user.v1.UserService.ListGuardianStudents:output_type -> user.v1.ListGuardianStudentsResponse
+	48, // 142: user.v1.UserService.ListGuardiansForStudent:output_type -> user.v1.ListGuardianStudentsResponse
+	58, // 143: user.v1.UserService.AcceptGuardianRequest:output_type -> user.v1.Empty
+	51, // 144: user.v1.AdminService.SearchUsers:output_type -> user.v1.SearchUsersResponse
+	65, // 145: user.v1.AdminService.SuspendUser:output_type -> user.v1.User
+	65, // 146: user.v1.AdminService.ReactivateUser:output_type -> user.v1.User
+	30, // 147: user.v1.AdminService.ListUserTutorStudents:output_type -> user.v1.ListTutorStudentsResponse
+	58, // 148: user.v1.AdminService.RecordAuditEvent:output_type -> user.v1.Empty
+	57, // 149: user.v1.AdminService.ListAuditEvents:output_type -> user.v1.ListAuditEventsResponse
+	98, // [98:150] is the sub-list for method output_type
+	46, // [46:98] is the sub-list for method input_type
+	46, // [46:46] is the sub-list for extension type_name
+	46, // [46:46] is the sub-list for extension extendee
+	0,  // [0:46] is the sub-list for field type_name
 }
 
 func init() { file_user_service_proto_init() }
@@ -1618,24 +5376,39 @@ func file_user_service_proto_init() {
 		return
 	}
 	file_user_service_proto_msgTypes[0].OneofWrappers = []any{}
-	file_user_service_proto_msgTypes[3].OneofWrappers = []any{}
-	file_user_service_proto_msgTypes[5].OneofWrappers = []any{}
 	file_user_service_proto_msgTypes[7].OneofWrappers = []any{}
-	file_user_service_proto_msgTypes[8].OneofWrappers = []any{}
+	file_user_service_proto_msgTypes[9].OneofWrappers = []any{}
 	file_user_service_proto_msgTypes[15].OneofWrappers = []any{}
 	file_user_service_proto_msgTypes[18].OneofWrappers = []any{}
-	file_user_service_proto_msgTypes[19].OneofWrappers = []any{}
-	file_user_service_proto_msgTypes[20].OneofWrappers = []any{}
 	file_user_service_proto_msgTypes[21].OneofWrappers = []any{}
+	file_user_service_proto_msgTypes[23].OneofWrappers = []any{}
+	file_user_service_proto_msgTypes[24].OneofWrappers = []any{}
+	file_user_service_proto_msgTypes[26].OneofWrappers = []any{}
+	file_user_service_proto_msgTypes[34].OneofWrappers = []any{}
+	file_user_service_proto_msgTypes[37].OneofWrappers = []any{}
+	file_user_service_proto_msgTypes[50].OneofWrappers = []any{}
+	file_user_service_proto_msgTypes[52].OneofWrappers = []any{}
+	file_user_service_proto_msgTypes[55].OneofWrappers = []any{}
+	file_user_service_proto_msgTypes[56].OneofWrappers = []any{}
+	file_user_service_proto_msgTypes[64].OneofWrappers = []any{}
+	file_user_service_proto_msgTypes[65].OneofWrappers = []any{}
+	file_user_service_proto_msgTypes[66].OneofWrappers = []any{}
+	file_user_service_proto_msgTypes[67].OneofWrappers = []any{}
+	file_user_service_proto_msgTypes[68].OneofWrappers = []any{}
+	file_user_service_proto_msgTypes[69].OneofWrappers = []any{}
+	file_user_service_proto_msgTypes[70].OneofWrappers = []any{}
+	file_user_service_proto_msgTypes[71].OneofWrappers = []any{}
+	file_user_service_proto_msgTypes[73].OneofWrappers = []any{}
+	file_user_service_proto_msgTypes[74].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_service_proto_rawDesc), len(file_user_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   22,
+			NumMessages:   75,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_user_service_proto_goTypes,
 		DependencyIndexes: file_user_service_proto_depIdxs,
@@ -19,21 +19,52 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_RegisterViaTelegram_FullMethodName        = "/user.v1.UserService/RegisterViaTelegram"
-	UserService_AuthorizeByAuthHeader_FullMethodName      = "/user.v1.UserService/AuthorizeByAuthHeader"
-	UserService_GetMe_FullMethodName                      = "/user.v1.UserService/GetMe"
-	UserService_GetUser_FullMethodName                    = "/user.v1.UserService/GetUser"
-	UserService_UpdateUser_FullMethodName                 = "/user.v1.UserService/UpdateUser"
-	UserService_UpdateTutorProfile_FullMethodName         = "/user.v1.UserService/UpdateTutorProfile"
-	UserService_GetTutorProfileByUserId_FullMethodName    = "/user.v1.UserService/GetTutorProfileByUserId"
-	UserService_GetTutorStudent_FullMethodName            = "/user.v1.UserService/GetTutorStudent"
-	UserService_CreateTutorStudent_FullMethodName         = "/user.v1.UserService/CreateTutorStudent"
-	UserService_UpdateTutorStudent_FullMethodName         = "/user.v1.UserService/UpdateTutorStudent"
-	UserService_DeleteTutorStudent_FullMethodName         = "/user.v1.UserService/DeleteTutorStudent"
-	UserService_ListTutorStudents_FullMethodName          = "/user.v1.UserService/ListTutorStudents"
-	UserService_ListTutorsForStudent_FullMethodName       = "/user.v1.UserService/ListTutorsForStudent"
-	UserService_ResolveTutorStudentContext_FullMethodName = "/user.v1.UserService/ResolveTutorStudentContext"
-	UserService_AcceptInvitationFromTutor_FullMethodName  = "/user.v1.UserService/AcceptInvitationFromTutor"
+	UserService_RegisterViaTelegram_FullMethodName          = "/user.v1.UserService/RegisterViaTelegram"
+	UserService_AuthorizeByAuthHeader_FullMethodName        = "/user.v1.UserService/AuthorizeByAuthHeader"
+	UserService_CreateSession_FullMethodName                = "/user.v1.UserService/CreateSession"
+	UserService_RefreshSession_FullMethodName               = "/user.v1.UserService/RefreshSession"
+	UserService_RevokeSession_FullMethodName                = "/user.v1.UserService/RevokeSession"
+	UserService_CheckSession_FullMethodName                 = "/user.v1.UserService/CheckSession"
+	UserService_GetSigningKeys_FullMethodName               = "/user.v1.UserService/GetSigningKeys"
+	UserService_RequestEmailLogin_FullMethodName            = "/user.v1.UserService/RequestEmailLogin"
+	UserService_VerifyEmailLogin_FullMethodName             = "/user.v1.UserService/VerifyEmailLogin"
+	UserService_StartOidcLogin_FullMethodName               = "/user.v1.UserService/StartOidcLogin"
+	UserService_CompleteOidcLogin_FullMethodName            = "/user.v1.UserService/CompleteOidcLogin"
+	UserService_RequestEmailLink_FullMethodName             = "/user.v1.UserService/RequestEmailLink"
+	UserService_StartOidcLink_FullMethodName                = "/user.v1.UserService/StartOidcLink"
+	UserService_ListMyIdentities_FullMethodName             = "/user.v1.UserService/ListMyIdentities"
+	UserService_DeleteIdentity_FullMethodName               = "/user.v1.UserService/DeleteIdentity"
+	UserService_GetMe_FullMethodName                        = "/user.v1.UserService/GetMe"
+	UserService_GetUser_FullMethodName                      = "/user.v1.UserService/GetUser"
+	UserService_UpdateUser_FullMethodName                   = "/user.v1.UserService/UpdateUser"
+	UserService_DeleteAccount_FullMethodName                = "/user.v1.UserService/DeleteAccount"
+	UserService_ExportMyData_FullMethodName                 = "/user.v1.UserService/ExportMyData"
+	UserService_GetDataJob_FullMethodName                   = "/user.v1.UserService/GetDataJob"
+	UserService_UpdateTutorProfile_FullMethodName           = "/user.v1.UserService/UpdateTutorProfile"
+	UserService_GetTutorProfileByUserId_FullMethodName      = "/user.v1.UserService/GetTutorProfileByUserId"
+	UserService_GetTutorPublicProfile_FullMethodName        = "/user.v1.UserService/GetTutorPublicProfile"
+	UserService_SearchTutors_FullMethodName                 = "/user.v1.UserService/SearchTutors"
+	UserService_GetTutorStudent_FullMethodName              = "/user.v1.UserService/GetTutorStudent"
+	UserService_CreateTutorStudent_FullMethodName           = "/user.v1.UserService/CreateTutorStudent"
+	UserService_UpdateTutorStudent_FullMethodName           = "/user.v1.UserService/UpdateTutorStudent"
+	UserService_DeleteTutorStudent_FullMethodName           = "/user.v1.UserService/DeleteTutorStudent"
+	UserService_ChangeTutorStudentStatus_FullMethodName     = "/user.v1.UserService/ChangeTutorStudentStatus"
+	UserService_ListTutorStudentStatusEvents_FullMethodName = "/user.v1.UserService/ListTutorStudentStatusEvents"
+	UserService_ListTutorStudents_FullMethodName            = "/user.v1.UserService/ListTutorStudents"
+	UserService_ListTutorsForStudent_FullMethodName         = "/user.v1.UserService/ListTutorsForStudent"
+	UserService_ResolveTutorStudentContext_FullMethodName   = "/user.v1.UserService/ResolveTutorStudentContext"
+	UserService_AcceptInvitationFromTutor_FullMethodName    = "/user.v1.UserService/AcceptInvitationFromTutor"
+	UserService_RejectInvitation_FullMethodName             = "/user.v1.UserService/RejectInvitation"
+	UserService_CreateInvite_FullMethodName                 = "/user.v1.UserService/CreateInvite"
+	UserService_ListInvites_FullMethodName                  = "/user.v1.UserService/ListInvites"
+	UserService_RevokeInvite_FullMethodName                 = "/user.v1.UserService/RevokeInvite"
+	UserService_RedeemInvite_FullMethodName                 = "/user.v1.UserService/RedeemInvite"
+	UserService_CreateGuardianStudent_FullMethodName        = "/user.v1.UserService/CreateGuardianStudent"
+	UserService_GetGuardianStudent_FullMethodName           = "/user.v1.UserService/GetGuardianStudent"
+	UserService_DeleteGuardianStudent_FullMethodName        = "/user.v1.UserService/DeleteGuardianStudent"
+	UserService_ListGuardianStudents_FullMethodName         = "/user.v1.UserService/ListGuardianStudents"
+	UserService_ListGuardiansForStudent_FullMethodName      = "/user.v1.UserService/ListGuardiansForStudent"
+	UserService_AcceptGuardianRequest_FullMethodName        = "/user.v1.UserService/AcceptGuardianRequest"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -42,19 +73,50 @@ const (
 type UserServiceClient interface {
 	RegisterViaTelegram(ctx context.Context, in *RegisterViaTelegramRequest, opts ...grpc.CallOption) (*User, error)
 	AuthorizeByAuthHeader(ctx context.Context, in *AuthorizeByAuthHeaderRequest, opts ...grpc.CallOption) (*User, error)
+	CreateSession(ctx context.Context, in *CreateSessionRequest, opts ...grpc.CallOption) (*SessionTokens, error)
+	RefreshSession(ctx context.Context, in *RefreshSessionRequest, opts ...grpc.CallOption) (*SessionTokens, error)
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*Empty, error)
+	CheckSession(ctx context.Context, in *CheckSessionRequest, opts ...grpc.CallOption) (*CheckSessionResponse, error)
+	GetSigningKeys(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SigningKeySet, error)
+	RequestEmailLogin(ctx context.Context, in *RequestEmailLoginRequest, opts ...grpc.CallOption) (*Empty, error)
+	VerifyEmailLogin(ctx context.Context, in *VerifyEmailLoginRequest, opts ...grpc.CallOption) (*SessionTokens, error)
+	StartOidcLogin(ctx context.Context, in *StartOidcLoginRequest, opts ...grpc.CallOption) (*OidcAuthorization, error)
+	CompleteOidcLogin(ctx context.Context, in *CompleteOidcLoginRequest, opts ...grpc.CallOption) (*SessionTokens, error)
+	RequestEmailLink(ctx context.Context, in *RequestEmailLinkRequest, opts ...grpc.CallOption) (*Empty, error)
+	StartOidcLink(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*OidcAuthorization, error)
+	ListMyIdentities(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListIdentitiesResponse, error)
+	DeleteIdentity(ctx context.Context, in *DeleteIdentityRequest, opts ...grpc.CallOption) (*Empty, error)
 	GetMe(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*User, error)
 	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*UserPublic, error)
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*User, error)
+	DeleteAccount(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*DataJob, error)
+	ExportMyData(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*DataJob, error)
+	GetDataJob(ctx context.Context, in *GetDataJobRequest, opts ...grpc.CallOption) (*DataJob, error)
 	UpdateTutorProfile(ctx context.Context, in *UpdateTutorProfileRequest, opts ...grpc.CallOption) (*TutorProfile, error)
 	GetTutorProfileByUserId(ctx context.Context, in *GetTutorProfileByUserIdRequest, opts ...grpc.CallOption) (*TutorProfile, error)
+	GetTutorPublicProfile(ctx context.Context, in *GetTutorPublicProfileRequest, opts ...grpc.CallOption) (*TutorPublicProfile, error)
+	SearchTutors(ctx context.Context, in *SearchTutorsRequest, opts ...grpc.CallOption) (*SearchTutorsResponse, error)
 	GetTutorStudent(ctx context.Context, in *GetTutorStudentRequest, opts ...grpc.CallOption) (*TutorStudent, error)
 	CreateTutorStudent(ctx context.Context, in *CreateTutorStudentRequest, opts ...grpc.CallOption) (*TutorStudent, error)
 	UpdateTutorStudent(ctx context.Context, in *UpdateTutorStudentRequest, opts ...grpc.CallOption) (*TutorStudent, error)
 	DeleteTutorStudent(ctx context.Context, in *DeleteTutorStudentRequest, opts ...grpc.CallOption) (*Empty, error)
+	ChangeTutorStudentStatus(ctx context.Context, in *ChangeTutorStudentStatusRequest, opts ...grpc.CallOption) (*TutorStudent, error)
+	ListTutorStudentStatusEvents(ctx context.Context, in *ListTutorStudentStatusEventsRequest, opts ...grpc.CallOption) (*ListTutorStudentStatusEventsResponse, error)
 	ListTutorStudents(ctx context.Context, in *ListTutorStudentsRequest, opts ...grpc.CallOption) (*ListTutorStudentsResponse, error)
 	ListTutorsForStudent(ctx context.Context, in *ListTutorsForStudentRequest, opts ...grpc.CallOption) (*ListTutorsForStudentResponse, error)
 	ResolveTutorStudentContext(ctx context.Context, in *ResolveTutorStudentContextRequest, opts ...grpc.CallOption) (*ResolvedTutorStudentContext, error)
 	AcceptInvitationFromTutor(ctx context.Context, in *AcceptInvitationFromTutorRequest, opts ...grpc.CallOption) (*Empty, error)
+	RejectInvitation(ctx context.Context, in *RejectInvitationRequest, opts ...grpc.CallOption) (*Empty, error)
+	CreateInvite(ctx context.Context, in *CreateInviteRequest, opts ...grpc.CallOption) (*Invite, error)
+	ListInvites(ctx context.Context, in *ListInvitesRequest, opts ...grpc.CallOption) (*ListInvitesResponse, error)
+	RevokeInvite(ctx context.Context, in *RevokeInviteRequest, opts ...grpc.CallOption) (*Invite, error)
+	RedeemInvite(ctx context.Context, in *RedeemInviteRequest, opts ...grpc.CallOption) (*TutorStudent, error)
+	CreateGuardianStudent(ctx context.Context, in *CreateGuardianStudentRequest, opts ...grpc.CallOption) (*GuardianStudent, error)
+	GetGuardianStudent(ctx context.Context, in *GetGuardianStudentRequest, opts ...grpc.CallOption) (*GuardianStudent, error)
+	DeleteGuardianStudent(ctx context.Context, in *DeleteGuardianStudentRequest, opts ...grpc.CallOption) (*Empty, error)
+	ListGuardianStudents(ctx context.Context, in *ListGuardianStudentsRequest, opts ...grpc.CallOption) (*ListGuardianStudentsResponse, error)
+	ListGuardiansForStudent(ctx context.Context, in *ListGuardiansForStudentRequest, opts ...grpc.CallOption) (*ListGuardianStudentsResponse, error)
+	AcceptGuardianRequest(ctx context.Context, in *AcceptGuardianRequestRequest, opts ...grpc.CallOption) (*Empty, error)
 }
 
 type userServiceClient struct {
@@ -85,6 +147,136 @@ func (c *userServiceClient) AuthorizeByAuthHeader(ctx context.Context, in *Autho
 	return out, nil
 }
 
+func (c *userServiceClient) CreateSession(ctx context.Context, in *CreateSessionRequest, opts ...grpc.CallOption) (*SessionTokens, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SessionTokens)
+	err := c.cc.Invoke(ctx, UserService_CreateSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RefreshSession(ctx context.Context, in *RefreshSessionRequest, opts ...grpc.CallOption) (*SessionTokens, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SessionTokens)
+	err := c.cc.Invoke(ctx, UserService_RefreshSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, UserService_RevokeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CheckSession(ctx context.Context, in *CheckSessionRequest, opts ...grpc.CallOption) (*CheckSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckSessionResponse)
+	err := c.cc.Invoke(ctx, UserService_CheckSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetSigningKeys(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SigningKeySet, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SigningKeySet)
+	err := c.cc.Invoke(ctx, UserService_GetSigningKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RequestEmailLogin(ctx context.Context, in *RequestEmailLoginRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, UserService_RequestEmailLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) VerifyEmailLogin(ctx context.Context, in *VerifyEmailLoginRequest, opts ...grpc.CallOption) (*SessionTokens, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SessionTokens)
+	err := c.cc.Invoke(ctx, UserService_VerifyEmailLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) StartOidcLogin(ctx context.Context, in *StartOidcLoginRequest, opts ...grpc.CallOption) (*OidcAuthorization, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OidcAuthorization)
+	err := c.cc.Invoke(ctx, UserService_StartOidcLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CompleteOidcLogin(ctx context.Context, in *CompleteOidcLoginRequest, opts ...grpc.CallOption) (*SessionTokens, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SessionTokens)
+	err := c.cc.Invoke(ctx, UserService_CompleteOidcLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RequestEmailLink(ctx context.Context, in *RequestEmailLinkRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, UserService_RequestEmailLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) StartOidcLink(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*OidcAuthorization, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OidcAuthorization)
+	err := c.cc.Invoke(ctx, UserService_StartOidcLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListMyIdentities(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListIdentitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIdentitiesResponse)
+	err := c.cc.Invoke(ctx, UserService_ListMyIdentities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteIdentity(ctx context.Context, in *DeleteIdentityRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, UserService_DeleteIdentity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) GetMe(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*User, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(User)
@@ -115,6 +307,36 @@ func (c *userServiceClient) UpdateUser(ctx context.Context, in *UpdateUserReques
 	return out, nil
 }
 
+func (c *userServiceClient) DeleteAccount(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*DataJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DataJob)
+	err := c.cc.Invoke(ctx, UserService_DeleteAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ExportMyData(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*DataJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DataJob)
+	err := c.cc.Invoke(ctx, UserService_ExportMyData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetDataJob(ctx context.Context, in *GetDataJobRequest, opts ...grpc.CallOption) (*DataJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DataJob)
+	err := c.cc.Invoke(ctx, UserService_GetDataJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) UpdateTutorProfile(ctx context.Context, in *UpdateTutorProfileRequest, opts ...grpc.CallOption) (*TutorProfile, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TutorProfile)
@@ -135,6 +357,26 @@ func (c *userServiceClient) GetTutorProfileByUserId(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *userServiceClient) GetTutorPublicProfile(ctx context.Context, in *GetTutorPublicProfileRequest, opts ...grpc.CallOption) (*TutorPublicProfile, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TutorPublicProfile)
+	err := c.cc.Invoke(ctx, UserService_GetTutorPublicProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SearchTutors(ctx context.Context, in *SearchTutorsRequest, opts ...grpc.CallOption) (*SearchTutorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchTutorsResponse)
+	err := c.cc.Invoke(ctx, UserService_SearchTutors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) GetTutorStudent(ctx context.Context, in *GetTutorStudentRequest, opts ...grpc.CallOption) (*TutorStudent, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TutorStudent)
@@ -175,6 +417,26 @@ func (c *userServiceClient) DeleteTutorStudent(ctx context.Context, in *DeleteTu
 	return out, nil
 }
 
+func (c *userServiceClient) ChangeTutorStudentStatus(ctx context.Context, in *ChangeTutorStudentStatusRequest, opts ...grpc.CallOption) (*TutorStudent, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TutorStudent)
+	err := c.cc.Invoke(ctx, UserService_ChangeTutorStudentStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListTutorStudentStatusEvents(ctx context.Context, in *ListTutorStudentStatusEventsRequest, opts ...grpc.CallOption) (*ListTutorStudentStatusEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTutorStudentStatusEventsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListTutorStudentStatusEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) ListTutorStudents(ctx context.Context, in *ListTutorStudentsRequest, opts ...grpc.CallOption) (*ListTutorStudentsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListTutorStudentsResponse)
@@ -215,25 +477,166 @@ func (c *userServiceClient) AcceptInvitationFromTutor(ctx context.Context, in *A
 	return out, nil
 }
 
+func (c *userServiceClient) RejectInvitation(ctx context.Context, in *RejectInvitationRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, UserService_RejectInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreateInvite(ctx context.Context, in *CreateInviteRequest, opts ...grpc.CallOption) (*Invite, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Invite)
+	err := c.cc.Invoke(ctx, UserService_CreateInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListInvites(ctx context.Context, in *ListInvitesRequest, opts ...grpc.CallOption) (*ListInvitesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInvitesResponse)
+	err := c.cc.Invoke(ctx, UserService_ListInvites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RevokeInvite(ctx context.Context, in *RevokeInviteRequest, opts ...grpc.CallOption) (*Invite, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Invite)
+	err := c.cc.Invoke(ctx, UserService_RevokeInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RedeemInvite(ctx context.Context, in *RedeemInviteRequest, opts ...grpc.CallOption) (*TutorStudent, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TutorStudent)
+	err := c.cc.Invoke(ctx, UserService_RedeemInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreateGuardianStudent(ctx context.Context, in *CreateGuardianStudentRequest, opts ...grpc.CallOption) (*GuardianStudent, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GuardianStudent)
+	err := c.cc.Invoke(ctx, UserService_CreateGuardianStudent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetGuardianStudent(ctx context.Context, in *GetGuardianStudentRequest, opts ...grpc.CallOption) (*GuardianStudent, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GuardianStudent)
+	err := c.cc.Invoke(ctx, UserService_GetGuardianStudent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteGuardianStudent(ctx context.Context, in *DeleteGuardianStudentRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, UserService_DeleteGuardianStudent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListGuardianStudents(ctx context.Context, in *ListGuardianStudentsRequest, opts ...grpc.CallOption) (*ListGuardianStudentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGuardianStudentsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListGuardianStudents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListGuardiansForStudent(ctx context.Context, in *ListGuardiansForStudentRequest, opts ...grpc.CallOption) (*ListGuardianStudentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGuardianStudentsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListGuardiansForStudent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) AcceptGuardianRequest(ctx context.Context, in *AcceptGuardianRequestRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, UserService_AcceptGuardianRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
 type UserServiceServer interface {
 	RegisterViaTelegram(context.Context, *RegisterViaTelegramRequest) (*User, error)
 	AuthorizeByAuthHeader(context.Context, *AuthorizeByAuthHeaderRequest) (*User, error)
+	CreateSession(context.Context, *CreateSessionRequest) (*SessionTokens, error)
+	RefreshSession(context.Context, *RefreshSessionRequest) (*SessionTokens, error)
+	RevokeSession(context.Context, *RevokeSessionRequest) (*Empty, error)
+	CheckSession(context.Context, *CheckSessionRequest) (*CheckSessionResponse, error)
+	GetSigningKeys(context.Context, *Empty) (*SigningKeySet, error)
+	RequestEmailLogin(context.Context, *RequestEmailLoginRequest) (*Empty, error)
+	VerifyEmailLogin(context.Context, *VerifyEmailLoginRequest) (*SessionTokens, error)
+	StartOidcLogin(context.Context, *StartOidcLoginRequest) (*OidcAuthorization, error)
+	CompleteOidcLogin(context.Context, *CompleteOidcLoginRequest) (*SessionTokens, error)
+	RequestEmailLink(context.Context, *RequestEmailLinkRequest) (*Empty, error)
+	StartOidcLink(context.Context, *Empty) (*OidcAuthorization, error)
+	ListMyIdentities(context.Context, *Empty) (*ListIdentitiesResponse, error)
+	DeleteIdentity(context.Context, *DeleteIdentityRequest) (*Empty, error)
 	GetMe(context.Context, *Empty) (*User, error)
 	GetUser(context.Context, *GetUserRequest) (*UserPublic, error)
 	UpdateUser(context.Context, *UpdateUserRequest) (*User, error)
+	DeleteAccount(context.Context, *Empty) (*DataJob, error)
+	ExportMyData(context.Context, *Empty) (*DataJob, error)
+	GetDataJob(context.Context, *GetDataJobRequest) (*DataJob, error)
 	UpdateTutorProfile(context.Context, *UpdateTutorProfileRequest) (*TutorProfile, error)
 	GetTutorProfileByUserId(context.Context, *GetTutorProfileByUserIdRequest) (*TutorProfile, error)
+	GetTutorPublicProfile(context.Context, *GetTutorPublicProfileRequest) (*TutorPublicProfile, error)
+	SearchTutors(context.Context, *SearchTutorsRequest) (*SearchTutorsResponse, error)
 	GetTutorStudent(context.Context, *GetTutorStudentRequest) (*TutorStudent, error)
 	CreateTutorStudent(context.Context, *CreateTutorStudentRequest) (*TutorStudent, error)
 	UpdateTutorStudent(context.Context, *UpdateTutorStudentRequest) (*TutorStudent, error)
 	DeleteTutorStudent(context.Context, *DeleteTutorStudentRequest) (*Empty, error)
+	ChangeTutorStudentStatus(context.Context, *ChangeTutorStudentStatusRequest) (*TutorStudent, error)
+	ListTutorStudentStatusEvents(context.Context, *ListTutorStudentStatusEventsRequest) (*ListTutorStudentStatusEventsResponse, error)
 	ListTutorStudents(context.Context, *ListTutorStudentsRequest) (*ListTutorStudentsResponse, error)
 	ListTutorsForStudent(context.Context, *ListTutorsForStudentRequest) (*ListTutorsForStudentResponse, error)
 	ResolveTutorStudentContext(context.Context, *ResolveTutorStudentContextRequest) (*ResolvedTutorStudentContext, error)
 	AcceptInvitationFromTutor(context.Context, *AcceptInvitationFromTutorRequest) (*Empty, error)
+	RejectInvitation(context.Context, *RejectInvitationRequest) (*Empty, error)
+	CreateInvite(context.Context, *CreateInviteRequest) (*Invite, error)
+	ListInvites(context.Context, *ListInvitesRequest) (*ListInvitesResponse, error)
+	RevokeInvite(context.Context, *RevokeInviteRequest) (*Invite, error)
+	RedeemInvite(context.Context, *RedeemInviteRequest) (*TutorStudent, error)
+	CreateGuardianStudent(context.Context, *CreateGuardianStudentRequest) (*GuardianStudent, error)
+	GetGuardianStudent(context.Context, *GetGuardianStudentRequest) (*GuardianStudent, error)
+	DeleteGuardianStudent(context.Context, *DeleteGuardianStudentRequest) (*Empty, error)
+	ListGuardianStudents(context.Context, *ListGuardianStudentsRequest) (*ListGuardianStudentsResponse, error)
+	ListGuardiansForStudent(context.Context, *ListGuardiansForStudentRequest) (*ListGuardianStudentsResponse, error)
+	AcceptGuardianRequest(context.Context, *AcceptGuardianRequestRequest) (*Empty, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -250,6 +653,45 @@ func (UnimplementedUserServiceServer) RegisterViaTelegram(context.Context, *Regi
 func (UnimplementedUserServiceServer) AuthorizeByAuthHeader(context.Context, *AuthorizeByAuthHeaderRequest) (*User, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AuthorizeByAuthHeader not implemented")
 }
+func (UnimplementedUserServiceServer) CreateSession(context.Context, *CreateSessionRequest) (*SessionTokens, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSession not implemented")
+}
+func (UnimplementedUserServiceServer) RefreshSession(context.Context, *RefreshSessionRequest) (*SessionTokens, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshSession not implemented")
+}
+func (UnimplementedUserServiceServer) RevokeSession(context.Context, *RevokeSessionRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedUserServiceServer) CheckSession(context.Context, *CheckSessionRequest) (*CheckSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckSession not implemented")
+}
+func (UnimplementedUserServiceServer) GetSigningKeys(context.Context, *Empty) (*SigningKeySet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSigningKeys not implemented")
+}
+func (UnimplementedUserServiceServer) RequestEmailLogin(context.Context, *RequestEmailLoginRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestEmailLogin not implemented")
+}
+func (UnimplementedUserServiceServer) VerifyEmailLogin(context.Context, *VerifyEmailLoginRequest) (*SessionTokens, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyEmailLogin not implemented")
+}
+func (UnimplementedUserServiceServer) StartOidcLogin(context.Context, *StartOidcLoginRequest) (*OidcAuthorization, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartOidcLogin not implemented")
+}
+func (UnimplementedUserServiceServer) CompleteOidcLogin(context.Context, *CompleteOidcLoginRequest) (*SessionTokens, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteOidcLogin not implemented")
+}
+func (UnimplementedUserServiceServer) RequestEmailLink(context.Context, *RequestEmailLinkRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestEmailLink not implemented")
+}
+func (UnimplementedUserServiceServer) StartOidcLink(context.Context, *Empty) (*OidcAuthorization, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartOidcLink not implemented")
+}
+func (UnimplementedUserServiceServer) ListMyIdentities(context.Context, *Empty) (*ListIdentitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyIdentities not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteIdentity(context.Context, *DeleteIdentityRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteIdentity not implemented")
+}
 func (UnimplementedUserServiceServer) GetMe(context.Context, *Empty) (*User, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMe not implemented")
 }
@@ -259,12 +701,27 @@ func (UnimplementedUserServiceServer) GetUser(context.Context, *GetUserRequest)
 func (UnimplementedUserServiceServer) UpdateUser(context.Context, *UpdateUserRequest) (*User, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
 }
+func (UnimplementedUserServiceServer) DeleteAccount(context.Context, *Empty) (*DataJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
+}
+func (UnimplementedUserServiceServer) ExportMyData(context.Context, *Empty) (*DataJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportMyData not implemented")
+}
+func (UnimplementedUserServiceServer) GetDataJob(context.Context, *GetDataJobRequest) (*DataJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataJob not implemented")
+}
 func (UnimplementedUserServiceServer) UpdateTutorProfile(context.Context, *UpdateTutorProfileRequest) (*TutorProfile, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateTutorProfile not implemented")
 }
 func (UnimplementedUserServiceServer) GetTutorProfileByUserId(context.Context, *GetTutorProfileByUserIdRequest) (*TutorProfile, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTutorProfileByUserId not implemented")
 }
+func (UnimplementedUserServiceServer) GetTutorPublicProfile(context.Context, *GetTutorPublicProfileRequest) (*TutorPublicProfile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTutorPublicProfile not implemented")
+}
+func (UnimplementedUserServiceServer) SearchTutors(context.Context, *SearchTutorsRequest) (*SearchTutorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchTutors not implemented")
+}
 func (UnimplementedUserServiceServer) GetTutorStudent(context.Context, *GetTutorStudentRequest) (*TutorStudent, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTutorStudent not implemented")
 }
@@ -277,6 +734,12 @@ func (UnimplementedUserServiceServer) UpdateTutorStudent(context.Context, *Updat
 func (UnimplementedUserServiceServer) DeleteTutorStudent(context.Context, *DeleteTutorStudentRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteTutorStudent not implemented")
 }
+func (UnimplementedUserServiceServer) ChangeTutorStudentStatus(context.Context, *ChangeTutorStudentStatusRequest) (*TutorStudent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeTutorStudentStatus not implemented")
+}
+func (UnimplementedUserServiceServer) ListTutorStudentStatusEvents(context.Context, *ListTutorStudentStatusEventsRequest) (*ListTutorStudentStatusEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTutorStudentStatusEvents not implemented")
+}
 func (UnimplementedUserServiceServer) ListTutorStudents(context.Context, *ListTutorStudentsRequest) (*ListTutorStudentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTutorStudents not implemented")
 }
@@ -289,17 +752,50 @@ func (UnimplementedUserServiceServer) ResolveTutorStudentContext(context.Context
 func (UnimplementedUserServiceServer) AcceptInvitationFromTutor(context.Context, *AcceptInvitationFromTutorRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AcceptInvitationFromTutor not implemented")
 }
-func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
-func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
-
-// UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to UserServiceServer will
-// result in compilation errors.
-type UnsafeUserServiceServer interface {
-	mustEmbedUnimplementedUserServiceServer()
+func (UnimplementedUserServiceServer) RejectInvitation(context.Context, *RejectInvitationRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectInvitation not implemented")
 }
-
-func RegisterUserServiceServer(s grpc.ServiceRegistrar, srv UserServiceServer) {
+func (UnimplementedUserServiceServer) CreateInvite(context.Context, *CreateInviteRequest) (*Invite, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInvite not implemented")
+}
+func (UnimplementedUserServiceServer) ListInvites(context.Context, *ListInvitesRequest) (*ListInvitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInvites not implemented")
+}
+func (UnimplementedUserServiceServer) RevokeInvite(context.Context, *RevokeInviteRequest) (*Invite, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeInvite not implemented")
+}
+func (UnimplementedUserServiceServer) RedeemInvite(context.Context, *RedeemInviteRequest) (*TutorStudent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemInvite not implemented")
+}
+func (UnimplementedUserServiceServer) CreateGuardianStudent(context.Context, *CreateGuardianStudentRequest) (*GuardianStudent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGuardianStudent not implemented")
+}
+func (UnimplementedUserServiceServer) GetGuardianStudent(context.Context, *GetGuardianStudentRequest) (*GuardianStudent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGuardianStudent not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteGuardianStudent(context.Context, *DeleteGuardianStudentRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGuardianStudent not implemented")
+}
+func (UnimplementedUserServiceServer) ListGuardianStudents(context.Context, *ListGuardianStudentsRequest) (*ListGuardianStudentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGuardianStudents not implemented")
+}
+func (UnimplementedUserServiceServer) ListGuardiansForStudent(context.Context, *ListGuardiansForStudentRequest) (*ListGuardianStudentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGuardiansForStudent not implemented")
+}
+func (UnimplementedUserServiceServer) AcceptGuardianRequest(context.Context, *AcceptGuardianRequestRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptGuardianRequest not implemented")
+}
+func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
+func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserServiceServer will
+// result in compilation errors.
+type UnsafeUserServiceServer interface {
+	mustEmbedUnimplementedUserServiceServer()
+}
+
+func RegisterUserServiceServer(s grpc.ServiceRegistrar, srv UserServiceServer) {
 	// If the following call pancis, it indicates UnimplementedUserServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
@@ -346,306 +842,1288 @@ func _UserService_AuthorizeByAuthHeader_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_GetMe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _UserService_CreateSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateSession(ctx, req.(*CreateSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RefreshSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RefreshSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RefreshSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RefreshSession(ctx, req.(*RefreshSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CheckSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CheckSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CheckSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CheckSession(ctx, req.(*CheckSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetSigningKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).GetMe(ctx, in)
+		return srv.(UserServiceServer).GetSigningKeys(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_GetMe_FullMethodName,
+		FullMethod: UserService_GetSigningKeys_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).GetMe(ctx, req.(*Empty))
+		return srv.(UserServiceServer).GetSigningKeys(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserRequest)
+func _UserService_RequestEmailLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestEmailLoginRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).GetUser(ctx, in)
+		return srv.(UserServiceServer).RequestEmailLogin(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_GetUser_FullMethodName,
+		FullMethod: UserService_RequestEmailLogin_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).GetUser(ctx, req.(*GetUserRequest))
+		return srv.(UserServiceServer).RequestEmailLogin(ctx, req.(*RequestEmailLoginRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserRequest)
+func _UserService_VerifyEmailLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyEmailLoginRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).UpdateUser(ctx, in)
+		return srv.(UserServiceServer).VerifyEmailLogin(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_UpdateUser_FullMethodName,
+		FullMethod: UserService_VerifyEmailLogin_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+		return srv.(UserServiceServer).VerifyEmailLogin(ctx, req.(*VerifyEmailLoginRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_UpdateTutorProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTutorProfileRequest)
+func _UserService_StartOidcLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartOidcLoginRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).UpdateTutorProfile(ctx, in)
+		return srv.(UserServiceServer).StartOidcLogin(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_UpdateTutorProfile_FullMethodName,
+		FullMethod: UserService_StartOidcLogin_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).UpdateTutorProfile(ctx, req.(*UpdateTutorProfileRequest))
+		return srv.(UserServiceServer).StartOidcLogin(ctx, req.(*StartOidcLoginRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_GetTutorProfileByUserId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTutorProfileByUserIdRequest)
+func _UserService_CompleteOidcLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteOidcLoginRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).GetTutorProfileByUserId(ctx, in)
+		return srv.(UserServiceServer).CompleteOidcLogin(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_GetTutorProfileByUserId_FullMethodName,
+		FullMethod: UserService_CompleteOidcLogin_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).GetTutorProfileByUserId(ctx, req.(*GetTutorProfileByUserIdRequest))
+		return srv.(UserServiceServer).CompleteOidcLogin(ctx, req.(*CompleteOidcLoginRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_GetTutorStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTutorStudentRequest)
+func _UserService_RequestEmailLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestEmailLinkRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).GetTutorStudent(ctx, in)
+		return srv.(UserServiceServer).RequestEmailLink(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_GetTutorStudent_FullMethodName,
+		FullMethod: UserService_RequestEmailLink_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).GetTutorStudent(ctx, req.(*GetTutorStudentRequest))
+		return srv.(UserServiceServer).RequestEmailLink(ctx, req.(*RequestEmailLinkRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_CreateTutorStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTutorStudentRequest)
+func _UserService_StartOidcLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).CreateTutorStudent(ctx, in)
+		return srv.(UserServiceServer).StartOidcLink(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_CreateTutorStudent_FullMethodName,
+		FullMethod: UserService_StartOidcLink_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).CreateTutorStudent(ctx, req.(*CreateTutorStudentRequest))
+		return srv.(UserServiceServer).StartOidcLink(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_UpdateTutorStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTutorStudentRequest)
+func _UserService_ListMyIdentities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).UpdateTutorStudent(ctx, in)
+		return srv.(UserServiceServer).ListMyIdentities(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_UpdateTutorStudent_FullMethodName,
+		FullMethod: UserService_ListMyIdentities_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).UpdateTutorStudent(ctx, req.(*UpdateTutorStudentRequest))
+		return srv.(UserServiceServer).ListMyIdentities(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_DeleteTutorStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTutorStudentRequest)
+func _UserService_DeleteIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteIdentityRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).DeleteTutorStudent(ctx, in)
+		return srv.(UserServiceServer).DeleteIdentity(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_DeleteTutorStudent_FullMethodName,
+		FullMethod: UserService_DeleteIdentity_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).DeleteTutorStudent(ctx, req.(*DeleteTutorStudentRequest))
+		return srv.(UserServiceServer).DeleteIdentity(ctx, req.(*DeleteIdentityRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_ListTutorStudents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTutorStudentsRequest)
+func _UserService_GetMe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).ListTutorStudents(ctx, in)
+		return srv.(UserServiceServer).GetMe(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_ListTutorStudents_FullMethodName,
+		FullMethod: UserService_GetMe_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).ListTutorStudents(ctx, req.(*ListTutorStudentsRequest))
+		return srv.(UserServiceServer).GetMe(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_ListTutorsForStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTutorsForStudentRequest)
+func _UserService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).ListTutorsForStudent(ctx, in)
+		return srv.(UserServiceServer).GetUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_ListTutorsForStudent_FullMethodName,
+		FullMethod: UserService_GetUser_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).ListTutorsForStudent(ctx, req.(*ListTutorsForStudentRequest))
+		return srv.(UserServiceServer).GetUser(ctx, req.(*GetUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_ResolveTutorStudentContext_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResolveTutorStudentContextRequest)
+func _UserService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).ResolveTutorStudentContext(ctx, in)
+		return srv.(UserServiceServer).UpdateUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_ResolveTutorStudentContext_FullMethodName,
+		FullMethod: UserService_UpdateUser_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).ResolveTutorStudentContext(ctx, req.(*ResolveTutorStudentContextRequest))
+		return srv.(UserServiceServer).UpdateUser(ctx, req.(*UpdateUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_AcceptInvitationFromTutor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AcceptInvitationFromTutorRequest)
+func _UserService_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).AcceptInvitationFromTutor(ctx, in)
+		return srv.(UserServiceServer).DeleteAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_AcceptInvitationFromTutor_FullMethodName,
+		FullMethod: UserService_DeleteAccount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).AcceptInvitationFromTutor(ctx, req.(*AcceptInvitationFromTutorRequest))
+		return srv.(UserServiceServer).DeleteAccount(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var UserService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "user.v1.UserService",
-	HandlerType: (*UserServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RegisterViaTelegram",
-			Handler:    _UserService_RegisterViaTelegram_Handler,
-		},
-		{
-			MethodName: "AuthorizeByAuthHeader",
-			Handler:    _UserService_AuthorizeByAuthHeader_Handler,
-		},
-		{
-			MethodName: "GetMe",
-			Handler:    _UserService_GetMe_Handler,
-		},
-		{
-			MethodName: "GetUser",
-			Handler:    _UserService_GetUser_Handler,
-		},
-		{
-			MethodName: "UpdateUser",
-			Handler:    _UserService_UpdateUser_Handler,
-		},
-		{
-			MethodName: "UpdateTutorProfile",
-			Handler:    _UserService_UpdateTutorProfile_Handler,
-		},
-		{
-			MethodName: "GetTutorProfileByUserId",
-			Handler:    _UserService_GetTutorProfileByUserId_Handler,
-		},
-		{
-			MethodName: "GetTutorStudent",
-			Handler:    _UserService_GetTutorStudent_Handler,
-		},
-		{
-			MethodName: "CreateTutorStudent",
-			Handler:    _UserService_CreateTutorStudent_Handler,
-		},
-		{
-			MethodName: "UpdateTutorStudent",
-			Handler:    _UserService_UpdateTutorStudent_Handler,
-		},
-		{
-			MethodName: "DeleteTutorStudent",
-			Handler:    _UserService_DeleteTutorStudent_Handler,
-		},
-		{
-			MethodName: "ListTutorStudents",
-			Handler:    _UserService_ListTutorStudents_Handler,
-		},
-		{
-			MethodName: "ListTutorsForStudent",
-			Handler:    _UserService_ListTutorsForStudent_Handler,
-		},
-		{
-			MethodName: "ResolveTutorStudentContext",
-			Handler:    _UserService_ResolveTutorStudentContext_Handler,
-		},
-		{
-			MethodName: "AcceptInvitationFromTutor",
-			Handler:    _UserService_AcceptInvitationFromTutor_Handler,
+func _UserService_ExportMyData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ExportMyData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ExportMyData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ExportMyData(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetDataJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetDataJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetDataJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetDataJob(ctx, req.(*GetDataJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdateTutorProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTutorProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateTutorProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateTutorProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateTutorProfile(ctx, req.(*UpdateTutorProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetTutorProfileByUserId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTutorProfileByUserIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetTutorProfileByUserId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetTutorProfileByUserId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetTutorProfileByUserId(ctx, req.(*GetTutorProfileByUserIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetTutorPublicProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTutorPublicProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetTutorPublicProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetTutorPublicProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetTutorPublicProfile(ctx, req.(*GetTutorPublicProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SearchTutors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchTutorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SearchTutors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SearchTutors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SearchTutors(ctx, req.(*SearchTutorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetTutorStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTutorStudentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetTutorStudent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetTutorStudent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetTutorStudent(ctx, req.(*GetTutorStudentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreateTutorStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTutorStudentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateTutorStudent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateTutorStudent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateTutorStudent(ctx, req.(*CreateTutorStudentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdateTutorStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTutorStudentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateTutorStudent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateTutorStudent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateTutorStudent(ctx, req.(*UpdateTutorStudentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteTutorStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTutorStudentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteTutorStudent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteTutorStudent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteTutorStudent(ctx, req.(*DeleteTutorStudentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ChangeTutorStudentStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeTutorStudentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ChangeTutorStudentStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ChangeTutorStudentStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ChangeTutorStudentStatus(ctx, req.(*ChangeTutorStudentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListTutorStudentStatusEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTutorStudentStatusEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListTutorStudentStatusEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListTutorStudentStatusEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListTutorStudentStatusEvents(ctx, req.(*ListTutorStudentStatusEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListTutorStudents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTutorStudentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListTutorStudents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListTutorStudents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListTutorStudents(ctx, req.(*ListTutorStudentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListTutorsForStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTutorsForStudentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListTutorsForStudent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListTutorsForStudent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListTutorsForStudent(ctx, req.(*ListTutorsForStudentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ResolveTutorStudentContext_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveTutorStudentContextRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ResolveTutorStudentContext(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ResolveTutorStudentContext_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ResolveTutorStudentContext(ctx, req.(*ResolveTutorStudentContextRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_AcceptInvitationFromTutor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptInvitationFromTutorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AcceptInvitationFromTutor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_AcceptInvitationFromTutor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AcceptInvitationFromTutor(ctx, req.(*AcceptInvitationFromTutorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RejectInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RejectInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RejectInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RejectInvitation(ctx, req.(*RejectInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreateInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateInvite(ctx, req.(*CreateInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListInvites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListInvites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListInvites(ctx, req.(*ListInvitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RevokeInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RevokeInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RevokeInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RevokeInvite(ctx, req.(*RevokeInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RedeemInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeemInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RedeemInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RedeemInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RedeemInvite(ctx, req.(*RedeemInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreateGuardianStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGuardianStudentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateGuardianStudent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateGuardianStudent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateGuardianStudent(ctx, req.(*CreateGuardianStudentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetGuardianStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGuardianStudentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetGuardianStudent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetGuardianStudent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetGuardianStudent(ctx, req.(*GetGuardianStudentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteGuardianStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGuardianStudentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteGuardianStudent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteGuardianStudent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteGuardianStudent(ctx, req.(*DeleteGuardianStudentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListGuardianStudents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGuardianStudentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListGuardianStudents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListGuardianStudents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListGuardianStudents(ctx, req.(*ListGuardianStudentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListGuardiansForStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGuardiansForStudentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListGuardiansForStudent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListGuardiansForStudent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListGuardiansForStudent(ctx, req.(*ListGuardiansForStudentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_AcceptGuardianRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptGuardianRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AcceptGuardianRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_AcceptGuardianRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AcceptGuardianRequest(ctx, req.(*AcceptGuardianRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UserService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user.v1.UserService",
+	HandlerType: (*UserServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterViaTelegram",
+			Handler:    _UserService_RegisterViaTelegram_Handler,
+		},
+		{
+			MethodName: "AuthorizeByAuthHeader",
+			Handler:    _UserService_AuthorizeByAuthHeader_Handler,
+		},
+		{
+			MethodName: "CreateSession",
+			Handler:    _UserService_CreateSession_Handler,
+		},
+		{
+			MethodName: "RefreshSession",
+			Handler:    _UserService_RefreshSession_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _UserService_RevokeSession_Handler,
+		},
+		{
+			MethodName: "CheckSession",
+			Handler:    _UserService_CheckSession_Handler,
+		},
+		{
+			MethodName: "GetSigningKeys",
+			Handler:    _UserService_GetSigningKeys_Handler,
+		},
+		{
+			MethodName: "RequestEmailLogin",
+			Handler:    _UserService_RequestEmailLogin_Handler,
+		},
+		{
+			MethodName: "VerifyEmailLogin",
+			Handler:    _UserService_VerifyEmailLogin_Handler,
+		},
+		{
+			MethodName: "StartOidcLogin",
+			Handler:    _UserService_StartOidcLogin_Handler,
+		},
+		{
+			MethodName: "CompleteOidcLogin",
+			Handler:    _UserService_CompleteOidcLogin_Handler,
+		},
+		{
+			MethodName: "RequestEmailLink",
+			Handler:    _UserService_RequestEmailLink_Handler,
+		},
+		{
+			MethodName: "StartOidcLink",
+			Handler:    _UserService_StartOidcLink_Handler,
+		},
+		{
+			MethodName: "ListMyIdentities",
+			Handler:    _UserService_ListMyIdentities_Handler,
+		},
+		{
+			MethodName: "DeleteIdentity",
+			Handler:    _UserService_DeleteIdentity_Handler,
+		},
+		{
+			MethodName: "GetMe",
+			Handler:    _UserService_GetMe_Handler,
+		},
+		{
+			MethodName: "GetUser",
+			Handler:    _UserService_GetUser_Handler,
+		},
+		{
+			MethodName: "UpdateUser",
+			Handler:    _UserService_UpdateUser_Handler,
+		},
+		{
+			MethodName: "DeleteAccount",
+			Handler:    _UserService_DeleteAccount_Handler,
+		},
+		{
+			MethodName: "ExportMyData",
+			Handler:    _UserService_ExportMyData_Handler,
+		},
+		{
+			MethodName: "GetDataJob",
+			Handler:    _UserService_GetDataJob_Handler,
+		},
+		{
+			MethodName: "UpdateTutorProfile",
+			Handler:    _UserService_UpdateTutorProfile_Handler,
+		},
+		{
+			MethodName: "GetTutorProfileByUserId",
+			Handler:    _UserService_GetTutorProfileByUserId_Handler,
+		},
+		{
+			MethodName: "GetTutorPublicProfile",
+			Handler:    _UserService_GetTutorPublicProfile_Handler,
+		},
+		{
+			MethodName: "SearchTutors",
+			Handler:    _UserService_SearchTutors_Handler,
+		},
+		{
+			MethodName: "GetTutorStudent",
+			Handler:    _UserService_GetTutorStudent_Handler,
+		},
+		{
+			MethodName: "CreateTutorStudent",
+			Handler:    _UserService_CreateTutorStudent_Handler,
+		},
+		{
+			MethodName: "UpdateTutorStudent",
+			Handler:    _UserService_UpdateTutorStudent_Handler,
+		},
+		{
+			MethodName: "DeleteTutorStudent",
+			Handler:    _UserService_DeleteTutorStudent_Handler,
+		},
+		{
+			MethodName: "ChangeTutorStudentStatus",
+			Handler:    _UserService_ChangeTutorStudentStatus_Handler,
+		},
+		{
+			MethodName: "ListTutorStudentStatusEvents",
+			Handler:    _UserService_ListTutorStudentStatusEvents_Handler,
+		},
+		{
+			MethodName: "ListTutorStudents",
+			Handler:    _UserService_ListTutorStudents_Handler,
+		},
+		{
+			MethodName: "ListTutorsForStudent",
+			Handler:    _UserService_ListTutorsForStudent_Handler,
+		},
+		{
+			MethodName: "ResolveTutorStudentContext",
+			Handler:    _UserService_ResolveTutorStudentContext_Handler,
+		},
+		{
+			MethodName: "AcceptInvitationFromTutor",
+			Handler:    _UserService_AcceptInvitationFromTutor_Handler,
+		},
+		{
+			MethodName: "RejectInvitation",
+			Handler:    _UserService_RejectInvitation_Handler,
+		},
+		{
+			MethodName: "CreateInvite",
+			Handler:    _UserService_CreateInvite_Handler,
+		},
+		{
+			MethodName: "ListInvites",
+			Handler:    _UserService_ListInvites_Handler,
+		},
+		{
+			MethodName: "RevokeInvite",
+			Handler:    _UserService_RevokeInvite_Handler,
+		},
+		{
+			MethodName: "RedeemInvite",
+			Handler:    _UserService_RedeemInvite_Handler,
+		},
+		{
+			MethodName: "CreateGuardianStudent",
+			Handler:    _UserService_CreateGuardianStudent_Handler,
+		},
+		{
+			MethodName: "GetGuardianStudent",
+			Handler:    _UserService_GetGuardianStudent_Handler,
+		},
+		{
+			MethodName: "DeleteGuardianStudent",
+			Handler:    _UserService_DeleteGuardianStudent_Handler,
+		},
+		{
+			MethodName: "ListGuardianStudents",
+			Handler:    _UserService_ListGuardianStudents_Handler,
+		},
+		{
+			MethodName: "ListGuardiansForStudent",
+			Handler:    _UserService_ListGuardiansForStudent_Handler,
+		},
+		{
+			MethodName: "AcceptGuardianRequest",
+			Handler:    _UserService_AcceptGuardianRequest_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "user_service.proto",
+}
+
+const (
+	AdminService_SearchUsers_FullMethodName           = "/user.v1.AdminService/SearchUsers"
+	AdminService_SuspendUser_FullMethodName           = "/user.v1.AdminService/SuspendUser"
+	AdminService_ReactivateUser_FullMethodName        = "/user.v1.AdminService/ReactivateUser"
+	AdminService_ListUserTutorStudents_FullMethodName = "/user.v1.AdminService/ListUserTutorStudents"
+	AdminService_RecordAuditEvent_FullMethodName      = "/user.v1.AdminService/RecordAuditEvent"
+	AdminService_ListAuditEvents_FullMethodName       = "/user.v1.AdminService/ListAuditEvents"
+)
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AdminService is the back office, every method requires the admin role.
+// All actions except reading the audit log are recorded in it.
+type AdminServiceClient interface {
+	SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error)
+	SuspendUser(ctx context.Context, in *SuspendUserRequest, opts ...grpc.CallOption) (*User, error)
+	ReactivateUser(ctx context.Context, in *ReactivateUserRequest, opts ...grpc.CallOption) (*User, error)
+	ListUserTutorStudents(ctx context.Context, in *ListUserTutorStudentsRequest, opts ...grpc.CallOption) (*ListTutorStudentsResponse, error)
+	// RecordAuditEvent is called by other services for admin actions performed there
+	RecordAuditEvent(ctx context.Context, in *RecordAuditEventRequest, opts ...grpc.CallOption) (*Empty, error)
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchUsersResponse)
+	err := c.cc.Invoke(ctx, AdminService_SearchUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SuspendUser(ctx context.Context, in *SuspendUserRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, AdminService_SuspendUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ReactivateUser(ctx context.Context, in *ReactivateUserRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, AdminService_ReactivateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListUserTutorStudents(ctx context.Context, in *ListUserTutorStudentsRequest, opts ...grpc.CallOption) (*ListTutorStudentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTutorStudentsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListUserTutorStudents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RecordAuditEvent(ctx context.Context, in *RecordAuditEventRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, AdminService_RecordAuditEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListAuditEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
+// for forward compatibility.
+//
+// AdminService is the back office, every method requires the admin role.
+// All actions except reading the audit log are recorded in it.
+type AdminServiceServer interface {
+	SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error)
+	SuspendUser(context.Context, *SuspendUserRequest) (*User, error)
+	ReactivateUser(context.Context, *ReactivateUserRequest) (*User, error)
+	ListUserTutorStudents(context.Context, *ListUserTutorStudentsRequest) (*ListTutorStudentsResponse, error)
+	// RecordAuditEvent is called by other services for admin actions performed there
+	RecordAuditEvent(context.Context, *RecordAuditEventRequest) (*Empty, error)
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+// UnimplementedAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAdminServiceServer struct{}
+
+func (UnimplementedAdminServiceServer) SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchUsers not implemented")
+}
+func (UnimplementedAdminServiceServer) SuspendUser(context.Context, *SuspendUserRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendUser not implemented")
+}
+func (UnimplementedAdminServiceServer) ReactivateUser(context.Context, *ReactivateUserRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReactivateUser not implemented")
+}
+func (UnimplementedAdminServiceServer) ListUserTutorStudents(context.Context, *ListUserTutorStudentsRequest) (*ListTutorStudentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserTutorStudents not implemented")
+}
+func (UnimplementedAdminServiceServer) RecordAuditEvent(context.Context, *RecordAuditEventRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordAuditEvent not implemented")
+}
+func (UnimplementedAdminServiceServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_SearchUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SearchUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SearchUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SearchUsers(ctx, req.(*SearchUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SuspendUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SuspendUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SuspendUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SuspendUser(ctx, req.(*SuspendUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ReactivateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactivateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ReactivateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ReactivateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ReactivateUser(ctx, req.(*ReactivateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListUserTutorStudents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserTutorStudentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListUserTutorStudents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListUserTutorStudents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListUserTutorStudents(ctx, req.(*ListUserTutorStudentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RecordAuditEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordAuditEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RecordAuditEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RecordAuditEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RecordAuditEvent(ctx, req.(*RecordAuditEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user.v1.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SearchUsers",
+			Handler:    _AdminService_SearchUsers_Handler,
+		},
+		{
+			MethodName: "SuspendUser",
+			Handler:    _AdminService_SuspendUser_Handler,
+		},
+		{
+			MethodName: "ReactivateUser",
+			Handler:    _AdminService_ReactivateUser_Handler,
+		},
+		{
+			MethodName: "ListUserTutorStudents",
+			Handler:    _AdminService_ListUserTutorStudents_Handler,
+		},
+		{
+			MethodName: "RecordAuditEvent",
+			Handler:    _AdminService_RecordAuditEvent_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _AdminService_ListAuditEvents_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},